@@ -2,23 +2,27 @@ package analysis
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
+	"github.com/argoproj/argo-rollouts/metricproviders"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 const (
@@ -40,8 +44,8 @@ type metricTask struct {
 	incompleteMeasurement *v1alpha1.Measurement
 }
 
-func (c *Controller) reconcileAnalysisRun(origRun *v1alpha1.AnalysisRun) *v1alpha1.AnalysisRun {
-	logger := logutil.WithAnalysisRun(origRun)
+func (c *Controller) reconcileAnalysisRun(ctx context.Context, origRun *v1alpha1.AnalysisRun) *v1alpha1.AnalysisRun {
+	logger := logutil.WithTrace(ctx, logutil.WithAnalysisRun(origRun))
 	if origRun.Status.Phase.Completed() {
 		err := c.maybeGarbageCollectAnalysisRun(origRun, logger)
 		if err != nil {
@@ -98,7 +102,7 @@ func (c *Controller) reconcileAnalysisRun(origRun *v1alpha1.AnalysisRun) *v1alph
 
 	tasks := generateMetricTasks(run, resolvedMetrics)
 	logger.Infof("Taking %d Measurement(s)...", len(tasks))
-	err = c.runMeasurements(run, tasks, dryRunMetricsMap)
+	err = c.runMeasurements(ctx, run, tasks, dryRunMetricsMap)
 	if err != nil {
 		message := fmt.Sprintf("Unable to resolve metric arguments: %v", err)
 		logger.Warn(message)
@@ -309,7 +313,7 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 }
 
 // runMeasurements iterates a list of metric tasks, and runs, resumes, or terminates measurements
-func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTask, dryRunMetricsMap map[string]bool) error {
+func (c *Controller) runMeasurements(ctx context.Context, run *v1alpha1.AnalysisRun, tasks []metricTask, dryRunMetricsMap map[string]bool) error {
 	var wg sync.WaitGroup
 	// resultsLock should be held whenever we are accessing or setting status.metricResults since
 	// we are performing queries in parallel
@@ -329,7 +333,7 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 		go func(t metricTask) error {
 			defer wg.Done()
 			//redact secret values from logs
-			logger := logutil.WithRedactor(*logutil.WithAnalysisRun(run).WithField("metric", t.metric.Name), secrets)
+			logger := logutil.WithRedactor(*logutil.WithTrace(ctx, logutil.WithAnalysisRun(run)).WithField("metric", t.metric.Name), secrets)
 
 			var newMeasurement v1alpha1.Measurement
 			provider, providerErr := c.newProvider(*logger, run.Namespace, t.metric)
@@ -345,17 +349,23 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 				newMeasurement.Message = providerErr.Error()
 			} else {
 				if t.incompleteMeasurement == nil {
-					newMeasurement = provider.Run(run, t.metric)
+					newMeasurement = traceMeasurement(ctx, "Run", t.metric, func() v1alpha1.Measurement {
+						return provider.Run(run, t.metric)
+					})
 				} else {
 					// metric is incomplete. either terminate or resume it
 					if terminating {
 						logger.Infof("Terminating in-progress measurement")
-						newMeasurement = provider.Terminate(run, t.metric, *t.incompleteMeasurement)
+						newMeasurement = traceMeasurement(ctx, "Terminate", t.metric, func() v1alpha1.Measurement {
+							return provider.Terminate(run, t.metric, *t.incompleteMeasurement)
+						})
 						if newMeasurement.Phase == v1alpha1.AnalysisPhaseSuccessful {
 							newMeasurement.Message = "Metric Terminated"
 						}
 					} else {
-						newMeasurement = provider.Resume(run, t.metric, *t.incompleteMeasurement)
+						newMeasurement = traceMeasurement(ctx, "Resume", t.metric, func() v1alpha1.Measurement {
+							return provider.Resume(run, t.metric, *t.incompleteMeasurement)
+						})
 					}
 				}
 			}
@@ -430,6 +440,27 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 	return nil
 }
 
+// traceMeasurement records a span for an operation of the metric provider of a metric. Measurements which error
+// are recorded as errors of the span.
+func traceMeasurement(ctx context.Context, operation string, m v1alpha1.Metric, measure func() v1alpha1.Measurement) v1alpha1.Measurement {
+	attrs := []attribute.KeyValue{
+		attribute.String("metric.name", m.Name),
+		attribute.String("metric.provider", metricproviders.Type(m)),
+	}
+	for name := range m.Provider.Plugin {
+		attrs = append(attrs, attribute.String("plugin.name", name))
+	}
+	_, span := tracing.StartSpan(ctx, "metricprovider."+operation, attrs...)
+	measurement := measure()
+	span.SetAttributes(attribute.String("metric.phase", string(measurement.Phase)))
+	var err error
+	if measurement.Phase == v1alpha1.AnalysisPhaseError {
+		err = errors.New(measurement.Message)
+	}
+	tracing.EndSpan(span, err)
+	return measurement
+}
+
 // assessRunStatus assesses the overall status of this AnalysisRun
 // If any metric is not yet completed, the AnalysisRun is still considered Running
 // Once all metrics are complete, the worst status is used as the overall AnalysisRun status
@@ -14,6 +14,10 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,8 +29,10 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 func timePtr(t metav1.Time) *metav1.Time {
@@ -1105,7 +1111,7 @@ func TestReconcileAnalysisRunInitial(t *testing.T) {
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
 	{
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 		assert.Equal(t, v1alpha1.AnalysisPhaseRunning, newRun.Status.MetricResults[0].Phase)
 		assert.Equal(t, v1alpha1.AnalysisPhaseRunning, newRun.Status.Phase)
 		assert.Equal(t, 1, len(newRun.Status.MetricResults[0].Measurements))
@@ -1115,7 +1121,7 @@ func TestReconcileAnalysisRunInitial(t *testing.T) {
 		// now set count to one and run should be completed immediately
 		newCount := intstr.FromInt(1)
 		run.Spec.Metrics[0].Count = &newCount
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.MetricResults[0].Phase)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 		assert.Equal(t, 1, len(newRun.Status.MetricResults[0].Measurements))
@@ -1126,7 +1132,7 @@ func TestReconcileAnalysisRunInitial(t *testing.T) {
 		count := intstr.FromInt(0)
 		run.Spec.Metrics[0].Count = &count
 		run.Spec.Metrics[0].Interval = ""
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.MetricResults[0].Phase)
 		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 		assert.Equal(t, 1, len(newRun.Status.MetricResults[0].Measurements))
@@ -1145,7 +1151,7 @@ func TestReconcileAnalysisRunInvalid(t *testing.T) {
 			}},
 		},
 	}
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, newRun.Status.Phase)
 }
 
@@ -1161,7 +1167,7 @@ func TestReconcileAnalysisRunTerminateSiblingAfterFail(t *testing.T) {
 
 	for _, status := range []v1alpha1.AnalysisPhase{v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseInconclusive, v1alpha1.AnalysisPhaseError} {
 		run := newTerminatingRun(status, false)
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 
 		assert.Equal(t, status, newRun.Status.Phase)
 		assert.Equal(t, status, newRun.Status.MetricResults[1].Phase)
@@ -1204,7 +1210,7 @@ func TestReconcileAnalysisRunResumeInProgress(t *testing.T) {
 	// mocks resume to complete the in-progress measurement
 	f.provider.On("Resume", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 
-	newRun := c.reconcileAnalysisRun(&run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), &run)
 
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.MetricResults[0].Phase)
@@ -1243,7 +1249,7 @@ func TestRunMeasurementsResetConsecutiveErrorCounter(t *testing.T) {
 		}
 		f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(status), nil)
 
-		newRun := c.reconcileAnalysisRun(&run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), &run)
 		if status == v1alpha1.AnalysisPhaseError {
 			assert.Equal(t, int32(5), newRun.Status.MetricResults[0].ConsecutiveError)
 			assert.Equal(t, int32(5), newRun.Status.MetricResults[0].Error)
@@ -1430,7 +1436,7 @@ func TestResolveMetricArgsUnableToSubstitute(t *testing.T) {
 				DryRun: dryRunArray,
 			},
 		}
-		newRun := c.reconcileAnalysisRun(run)
+		newRun := c.reconcileAnalysisRun(context.TODO(), run)
 		assert.Equal(t, v1alpha1.AnalysisPhaseError, newRun.Status.Phase)
 		assert.Equal(t, "Unable to resolve metric arguments: failed to resolve {{args.metric-name}}", newRun.Status.Message)
 	}
@@ -1463,7 +1469,7 @@ func TestGetMetadataIsCalled(t *testing.T) {
 	metricMetadata := map[string]string{"foo": "bar"}
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(metricMetadata, nil)
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 	assert.Equal(t, metricMetadata, newRun.Status.MetricResults[0].Metadata)
 }
@@ -1513,7 +1519,7 @@ func TestSecretContentReferenceSuccess(t *testing.T) {
 	}
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 }
 
@@ -1578,7 +1584,7 @@ func TestSecretContentReferenceProviderError(t *testing.T) {
 
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(measurement)
 	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	logMessage := buf.String()
 
 	assert.Equal(t, expectedValue, newRun.Status.MetricResults[0].Measurements[0].Message)
@@ -1640,7 +1646,7 @@ func TestSecretContentReferenceAndMultipleArgResolutionSuccess(t *testing.T) {
 
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 }
 
@@ -1917,7 +1923,7 @@ func StartAssessRunStatusWorstMessageInReconcileAnalysisRun(t *testing.T, isDryR
 
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseFailed), nil)
 
-	return c.reconcileAnalysisRun(run)
+	return c.reconcileAnalysisRun(context.TODO(), run)
 }
 
 // TestAssessRunStatusWithOnlyDryRunMetrics verifies that if only dry-run metrics are getting evaluated then, the final
@@ -1962,7 +1968,7 @@ func TestAssessRunStatusWithOnlyDryRunMetrics(t *testing.T) {
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseFailed), nil)
 	f.provider.On("Resume", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 
-	newRun := c.reconcileAnalysisRun(&run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), &run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 }
 
@@ -2024,7 +2030,7 @@ func TestAssessRunStatusWithMixedMetrics(t *testing.T) {
 	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseFailed), nil)
 	f.provider.On("Resume", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newMeasurement(v1alpha1.AnalysisPhaseSuccessful), nil)
 
-	newRun := c.reconcileAnalysisRun(&run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), &run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, newRun.Status.Phase)
 	assert.Equal(t, "Metric \"run-forever\" assessed Inconclusive due to inconclusive (1) > inconclusiveLimit (0)", newRun.Status.Message)
 }
@@ -2090,7 +2096,7 @@ func StartTerminatingAnalysisRun(t *testing.T, isDryRun bool) *v1alpha1.Analysis
 			Phase:     v1alpha1.AnalysisPhaseRunning,
 		},
 	}
-	return c.reconcileAnalysisRun(run)
+	return c.reconcileAnalysisRun(context.TODO(), run)
 }
 
 func TestTerminateAnalysisRun(t *testing.T) {
@@ -2149,7 +2155,7 @@ func TestInvalidDryRunConfigThrowsError(t *testing.T) {
 			Phase:     v1alpha1.AnalysisPhaseRunning,
 		},
 	}
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, newRun.Status.Phase)
 	assert.Equal(t, "Analysis spec invalid: dryRun[0]: Rule didn't match any metric name(s)", newRun.Status.Message)
 }
@@ -2190,7 +2196,7 @@ func TestInvalidMeasurementsRetentionConfigThrowsError(t *testing.T) {
 			Phase:     v1alpha1.AnalysisPhaseRunning,
 		},
 	}
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(context.TODO(), run)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, newRun.Status.Phase)
 	assert.Equal(t, "Analysis spec invalid: measurementRetention[0]: Rule didn't match any metric name(s)", newRun.Status.Message)
 }
@@ -2216,7 +2222,7 @@ func TestExceededTtlChecked(t *testing.T) {
 			},
 			Status: *expiredStatus,
 		}
-		_ = c.reconcileAnalysisRun(ttlExpiredRun)
+		_ = c.reconcileAnalysisRun(context.TODO(), ttlExpiredRun)
 		if notExpiredStatus != nil {
 			ttlNotExpiredRun := &v1alpha1.AnalysisRun{
 				ObjectMeta: metav1.ObjectMeta{
@@ -2228,7 +2234,7 @@ func TestExceededTtlChecked(t *testing.T) {
 				},
 				Status: *notExpiredStatus,
 			}
-			_ = c.reconcileAnalysisRun(ttlNotExpiredRun)
+			_ = c.reconcileAnalysisRun(context.TODO(), ttlNotExpiredRun)
 		}
 
 		pi := f.expectDeleteAnalysisRunAction(ttlExpiredRun)
@@ -2385,7 +2391,7 @@ func TestTtlNotGCInProgressAnalysisRun(t *testing.T) {
 			},
 		},
 	}
-	newRun := c.reconcileAnalysisRun(origRun)
+	newRun := c.reconcileAnalysisRun(context.TODO(), origRun)
 	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, newRun.Status.Phase)
 	assert.Nil(t, newRun.Status.CompletedAt)
 	// Nothing else is deleted
@@ -2429,7 +2435,7 @@ func TestCompletedTimeFilled(t *testing.T) {
 			},
 		},
 	}
-	newRun := c.reconcileAnalysisRun(origRun)
+	newRun := c.reconcileAnalysisRun(context.TODO(), origRun)
 	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, newRun.Status.Phase)
 	assert.NotNil(t, newRun.Status.CompletedAt)
 	assert.Equal(t, f.now, newRun.Status.CompletedAt.Time)
@@ -2462,7 +2468,7 @@ func TestReconcileAnalysisRunOnRunNotFound(t *testing.T) {
 			CompletedAt: timePtr(metav1.NewTime(f.now.Add(-2 * time.Second))),
 		},
 	}
-	_ = c.reconcileAnalysisRun(origRun)
+	_ = c.reconcileAnalysisRun(context.TODO(), origRun)
 	logMessage := buf.String()
 	assert.Contains(t, logMessage, "Trying to cleanup TTL exceeded analysis run")
 	assert.NotContains(t, logMessage, "Failed to garbage collect analysis run")
@@ -2499,7 +2505,7 @@ func TestReconcileAnalysisRunOnOtherRunErrors(t *testing.T) {
 			CompletedAt: timePtr(metav1.NewTime(f.now.Add(-2 * time.Second))),
 		},
 	}
-	_ = c.reconcileAnalysisRun(origRun)
+	_ = c.reconcileAnalysisRun(context.TODO(), origRun)
 	logMessage := buf.String()
 	assert.Contains(t, logMessage, "Failed to garbage collect analysis run")
 	// One deletion issued.
@@ -2603,3 +2609,37 @@ func TestMaybeGarbageCollectAnalysisRunNoGCIfNoCompletedAt(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, f.client.Fake.Actions())
 }
+
+func TestRunMeasurementsTracing(t *testing.T) {
+	exporter := testutil.RecordSpans(t)
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	run := newRun()
+	run.Status = v1alpha1.AnalysisRunStatus{}
+	errorMeasurement := newMeasurement(v1alpha1.AnalysisPhaseError)
+	errorMeasurement.Message = "intentional error"
+	f.provider.On("Run", mock.Anything, mock.Anything).Return(errorMeasurement, nil)
+	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{}, nil)
+
+	ctx, span := tracing.StartSpan(context.TODO(), "analysisrun.reconcile")
+	c.reconcileAnalysisRun(ctx, run)
+	span.End()
+
+	spans := exporter.GetSpans()
+	measurementSpans := tracetest.SpanStubs{}
+	for _, s := range spans {
+		if s.Name == "metricprovider.Run" {
+			measurementSpans = append(measurementSpans, s)
+		}
+	}
+	require.Len(t, measurementSpans, len(run.Spec.Metrics))
+	for _, s := range measurementSpans {
+		assert.Equal(t, span.SpanContext().SpanID(), s.Parent.SpanID())
+		assert.Contains(t, s.Attributes, attribute.String("metric.provider", "Job"))
+		assert.Contains(t, s.Attributes, attribute.String("metric.phase", "Error"))
+		assert.Equal(t, codes.Error, s.Status.Code)
+		assert.Equal(t, "intentional error", s.Status.Description)
+	}
+}
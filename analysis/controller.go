@@ -15,6 +15,7 @@ import (
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	batchv1 "k8s.io/api/batch/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -34,6 +35,7 @@ import (
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 var (
@@ -163,12 +165,14 @@ func (c *Controller) Run(ctx context.Context, threadiness int) error {
 	return nil
 }
 
-func (c *Controller) syncHandler(ctx context.Context, key string) error {
+func (c *Controller) syncHandler(ctx context.Context, key string) (err error) {
 	startTime := timeutil.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return err
 	}
+	ctx, span := tracing.StartSpan(ctx, "analysisrun.reconcile", attribute.String("analysisrun.namespace", namespace), attribute.String("analysisrun.name", name))
+	defer func() { tracing.EndSpan(span, err) }()
 	log.WithField(logutil.AnalysisRunKey, name).WithField(logutil.NamespaceKey, namespace).Infof("Started syncing Analysis at (%v)", startTime)
 	run, err := c.analysisRunLister.AnalysisRuns(namespace).Get(name)
 	if k8serrors.IsNotFound(err) {
@@ -182,7 +186,7 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 	defer func() {
 		duration := time.Since(startTime)
 		c.metricsServer.IncAnalysisRunReconcile(run, duration)
-		logCtx := logutil.WithAnalysisRun(run).WithField("time_ms", duration.Seconds()*1e3)
+		logCtx := logutil.WithTrace(ctx, logutil.WithAnalysisRun(run)).WithField("time_ms", duration.Seconds()*1e3)
 		logCtx.Info("Reconciliation completed")
 	}()
 
@@ -191,7 +195,7 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 		return nil
 	}
 
-	newRun := c.reconcileAnalysisRun(run)
+	newRun := c.reconcileAnalysisRun(ctx, run)
 	return c.persistAnalysisRunStatus(run, newRun.Status)
 }
 
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -11,7 +12,12 @@ import (
 	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
@@ -29,6 +35,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/controller"
 	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/controller/sharding"
 	jobprovider "github.com/argoproj/argo-rollouts/metricproviders/job"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-rollouts/pkg/signals"
@@ -38,6 +45,7 @@ import (
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/tolerantinformer"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	"github.com/argoproj/argo-rollouts/utils/version"
 )
 
@@ -60,6 +68,10 @@ func newCommand() *cobra.Command {
 		logFormat                      string
 		klogLevel                      int
 		metricsPort                    int
+		metricsTeamLabel               string
+		rolloutPriorityQueue           bool
+		serverSideApply                bool
+		serverSideApplyForce           bool
 		healthzPort                    int
 		instanceID                     string
 		qps                            float32
@@ -77,6 +89,7 @@ func newCommand() *cobra.Command {
 		ambassadorVersion              string
 		ingressVersion                 string
 		appmeshCRDVersion              string
+		linkerdHTTPRouteVersion        string
 		albIngressClasses              []string
 		nginxIngressClasses            []string
 		awsVerifyTargetGroup           bool
@@ -85,8 +98,10 @@ func newCommand() *cobra.Command {
 		selfServiceNotificationEnabled bool
 		controllersEnabled             []string
 		pprofAddress                   string
+		tracingOpts                    tracing.Options
 	)
 	electOpts := controller.NewLeaderElectionOptions()
+	webhookOpts := controller.NewWebhookOptions()
 	var command = cobra.Command{
 		Use:   cliName,
 		Short: "argo-rollouts is a controller to operate on rollout CRD",
@@ -115,11 +130,27 @@ func newCommand() *cobra.Command {
 			defaults.SetAppMeshCRDVersion(appmeshCRDVersion)
 			defaults.SetTraefikAPIGroup(traefikAPIGroup)
 			defaults.SetTraefikVersion(traefikVersion)
+			defaults.SetLinkerdHTTPRouteVersion(linkerdHTTPRouteVersion)
+			defaults.SetMetricsTeamLabelKey(metricsTeamLabel)
+			defaults.SetRolloutPriorityQueueEnabled(rolloutPriorityQueue)
+			defaults.SetServerSideApplyEnabled(serverSideApply)
+			defaults.SetServerSideApplyForce(serverSideApplyForce)
+
+			shutdownTracing, err := tracing.Init(ctx, tracingOpts)
+			errors.CheckError(err)
+			defer func() {
+				if err := shutdownTracing(context.Background()); err != nil {
+					log.Warnf("Failed to flush traces: %v", err)
+				}
+			}()
 
 			config, err := clientConfig.ClientConfig()
 			errors.CheckError(err)
 			config.QPS = qps
 			config.Burst = burst
+			if tracingOpts.Address != "" {
+				config.Wrap(tracing.NewTransport)
+			}
 			namespace := metav1.NamespaceAll
 			configNS, _, err := clientConfig.Namespace()
 			errors.CheckError(err)
@@ -142,6 +173,9 @@ func newCommand() *cobra.Command {
 			smiClient, err := smiclientset.NewForConfig(config)
 			errors.CheckError(err)
 			resyncDuration := time.Duration(rolloutResyncPeriod) * time.Second
+			// With sharding, the informers of the namespaced resources only cache the objects of the owned shards
+			shardManager := sharding.NewManager(kubeClient, electOpts.Shards)
+			informerDynamicClient := shardManager.DynamicClient(dynamicClient)
 			kubeInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(
 				kubeClient,
 				resyncDuration,
@@ -166,7 +200,7 @@ func newCommand() *cobra.Command {
 				}))
 			// We need three dynamic informer factories:
 			// 1. The first is the dynamic informer for rollouts, analysisruns, analysistemplates, experiments
-			dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, resyncDuration, namespace, instanceIDTweakListFunc)
+			dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(informerDynamicClient, resyncDuration, namespace, instanceIDTweakListFunc)
 			// 2. The second is for the clusteranalysistemplate. Notice we must instantiate this with
 			// metav1.NamespaceAll. The reason why we need a cluster specific dynamic informer factory
 			// is to support the mode when the rollout controller is started and only operating against
@@ -177,7 +211,7 @@ func newCommand() *cobra.Command {
 			if istioPrimaryDynamicClient == nil {
 				istioPrimaryDynamicClient = dynamicClient
 			}
-			istioDynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(istioPrimaryDynamicClient, resyncDuration, namespace, nil)
+			istioDynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(shardManager.DynamicClient(istioPrimaryDynamicClient), resyncDuration, namespace, nil)
 
 			var notificationConfigNamespace string
 			if selfServiceNotificationEnabled {
@@ -205,8 +239,18 @@ func newCommand() *cobra.Command {
 				}),
 			)
 
+			enabledControllers, err := getEnabledControllers(controllersEnabled)
+			errors.CheckError(err)
+
 			mode, err := ingressutil.DetermineIngressMode(ingressVersion, kubeClient.DiscoveryClient)
 			errors.CheckError(err)
+			if !enabledControllers[controllerAnalysis] {
+				var ingress runtime.Object = &networkingv1.Ingress{}
+				if mode == ingressutil.IngressModeExtensions {
+					ingress = &extensionsv1beta1.Ingress{}
+				}
+				shardManager.RegisterInformers(kubeInformerFactory, nil, &appsv1.ReplicaSet{}, &corev1.Service{}, ingress)
+			}
 			ingressWrapper, err := ingressutil.NewIngressWrapper(mode, kubeClient, kubeInformerFactory)
 			errors.CheckError(err)
 
@@ -217,9 +261,6 @@ func newCommand() *cobra.Command {
 
 			var cm *controller.Manager
 
-			enabledControllers, err := getEnabledControllers(controllersEnabled)
-			errors.CheckError(err)
-
 			// currently only supports running analysis controller independently
 			if enabledControllers[controllerAnalysis] {
 				log.Info("Running only analysis controller")
@@ -239,7 +280,8 @@ func newCommand() *cobra.Command {
 					clusterDynamicInformerFactory,
 					namespaced,
 					kubeInformerFactory,
-					jobInformerFactory)
+					jobInformerFactory,
+					shardManager)
 			} else {
 				cm = controller.NewManager(
 					namespace,
@@ -257,6 +299,10 @@ func newCommand() *cobra.Command {
 					tolerantinformer.NewTolerantAnalysisRunInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantAnalysisTemplateInformer(dynamicInformerFactory),
 					tolerantinformer.NewTolerantClusterAnalysisTemplateInformer(clusterDynamicInformerFactory),
+					tolerantinformer.NewTolerantRolloutPolicyInformer(dynamicInformerFactory),
+					tolerantinformer.NewTolerantClusterRolloutPolicyInformer(clusterDynamicInformerFactory),
+					tolerantinformer.NewTolerantRolloutStrategyTemplateInformer(dynamicInformerFactory),
+					tolerantinformer.NewTolerantClusterRolloutStrategyTemplateInformer(clusterDynamicInformerFactory),
 					istioPrimaryDynamicClient,
 					istioDynamicInformerFactory.ForResource(istioutil.GetIstioVirtualServiceGVR()).Informer(),
 					istioDynamicInformerFactory.ForResource(istioutil.GetIstioDestinationRuleGVR()).Informer(),
@@ -275,7 +321,9 @@ func newCommand() *cobra.Command {
 					namespaced,
 					kubeInformerFactory,
 					jobInformerFactory,
-					ephemeralMetadataThreads)
+					ephemeralMetadataThreads,
+					webhookOpts,
+					shardManager)
 			}
 			if err = cm.Run(ctx, rolloutThreads, serviceThreads, ingressThreads, experimentThreads, analysisThreads, electOpts); err != nil {
 				log.Fatalf("Error running controller: %s", err.Error())
@@ -294,6 +342,10 @@ func newCommand() *cobra.Command {
 	command.Flags().StringVar(&logFormat, "logformat", "", "Set the logging format. One of: text|json")
 	command.Flags().IntVar(&klogLevel, "kloglevel", 0, "Set the klog logging level")
 	command.Flags().IntVar(&metricsPort, "metricsport", controller.DefaultMetricsPort, "Set the port the metrics endpoint should be exposed over")
+	command.Flags().StringVar(&metricsTeamLabel, "metrics-team-label", "", "Key of the label of rollouts whose value is reported as the team label of the rollout lifecycle metrics")
+	command.Flags().BoolVar(&rolloutPriorityQueue, "rollout-priority-queue", false, "Reconcile the progressing rollouts, the rollouts paused until a timer elapses and the rollouts with pending promotes or aborts ahead of the steady-state resyncs, fairly across namespaces")
+	command.Flags().BoolVar(&serverSideApply, "server-side-apply", false, "Write the fields the controller owns in Services, Ingresses, traffic router resources and Pods with server-side apply, using the argo-rollouts-controller field manager")
+	command.Flags().BoolVar(&serverSideApplyForce, "server-side-apply-force-conflicts", false, "Take the ownership of the fields owned by other field managers when they conflict with the server-side applies of the controller, instead of failing")
 	command.Flags().IntVar(&healthzPort, "healthzPort", controller.DefaultHealthzPort, "Set the port the healthz endpoint should be exposed over")
 	command.Flags().StringVar(&instanceID, "instance-id", "", "Indicates which argo rollout objects the controller should operate on")
 	command.Flags().Float32Var(&qps, "qps", defaults.DefaultQPS, "Maximum QPS (queries per second) to the K8s API server")
@@ -309,6 +361,7 @@ func newCommand() *cobra.Command {
 	command.Flags().StringVar(&trafficSplitVersion, "traffic-split-api-version", defaults.DefaultSMITrafficSplitVersion, "Set the default TrafficSplit apiVersion that controller uses when creating TrafficSplits.")
 	command.Flags().StringVar(&traefikAPIGroup, "traefik-api-group", defaults.DefaultTraefikAPIGroup, "Set the default Traefik apiGroup that controller uses.")
 	command.Flags().StringVar(&traefikVersion, "traefik-api-version", defaults.DefaultTraefikVersion, "Set the default Traefik apiVersion that controller uses.")
+	command.Flags().StringVar(&linkerdHTTPRouteVersion, "linkerd-httproute-api-version", defaults.DefaultLinkerdHTTPRouteVersion, "Set the default Linkerd HTTPRoute apiVersion that controller uses.")
 	command.Flags().StringVar(&ingressVersion, "ingress-api-version", "", "Set the Ingress apiVersion that the controller should use.")
 	command.Flags().StringVar(&appmeshCRDVersion, "appmesh-crd-version", defaults.DefaultAppMeshCRDVersion, "Set the default AppMesh CRD Version that controller uses when manipulating resources.")
 	command.Flags().StringArrayVar(&albIngressClasses, "alb-ingress-classes", defaultALBIngressClass, "Defines all the ingress class annotations that the alb ingress controller operates on. Defaults to alb")
@@ -321,8 +374,17 @@ func newCommand() *cobra.Command {
 	command.Flags().DurationVar(&electOpts.LeaderElectionLeaseDuration, "leader-election-lease-duration", controller.DefaultLeaderElectionLeaseDuration, "The duration that non-leader candidates will wait after observing a leadership renewal until attempting to acquire leadership of a led but unrenewed leader slot. This is effectively the maximum duration that a leader can be stopped before it is replaced by another candidate. This is only applicable if leader election is enabled.")
 	command.Flags().DurationVar(&electOpts.LeaderElectionRenewDeadline, "leader-election-renew-deadline", controller.DefaultLeaderElectionRenewDeadline, "The interval between attempts by the acting master to renew a leadership slot before it stops leading. This must be less than or equal to the lease duration. This is only applicable if leader election is enabled.")
 	command.Flags().DurationVar(&electOpts.LeaderElectionRetryPeriod, "leader-election-retry-period", controller.DefaultLeaderElectionRetryPeriod, "The duration the clients should wait between attempting acquisition and renewal of a leadership. This is only applicable if leader election is enabled.")
+	command.Flags().IntVar(&electOpts.Shards, "shards", 0, "Number of shards to split the namespaces into. When greater than zero, leader election is replaced by per-shard leases so that every controller instance processes the namespaces of the shards it holds. The leader election lease duration, renew deadline and retry period also apply to the shard leases.")
 	command.Flags().BoolVar(&selfServiceNotificationEnabled, "self-service-notification-enabled", false, "Allows rollouts controller to pull notification config from the namespace that the rollout resource is in. This is useful for self-service notification.")
 	command.Flags().StringSliceVar(&controllersEnabled, "controllers", nil, "Explicitly specify the list of controllers to run, currently only supports 'analysis', eg. --controller=analysis. Default: all controllers are enabled")
+	command.Flags().BoolVar(&webhookOpts.Enabled, "admission-webhook-enabled", false, "Serve the validating and defaulting admission webhook of Rollouts, AnalysisTemplates, ClusterAnalysisTemplates and Experiments")
+	command.Flags().IntVar(&webhookOpts.Port, "admission-webhook-port", controller.DefaultWebhookPort, "Set the port the admission webhook should be served over")
+	command.Flags().StringVar(&webhookOpts.ServiceName, "admission-webhook-service", webhookOpts.ServiceName, "Name of the Service exposing the admission webhook, used as the name of its self-managed certificate")
+	command.Flags().StringVar(&webhookOpts.ReferencePolicy, "admission-webhook-reference-policy", webhookOpts.ReferencePolicy, "How the admission webhook handles references to missing or invalid resources. One of: deny|warn|ignore")
+	command.Flags().StringVar(&tracingOpts.Address, "otlp-address", "", "OTLP gRPC address of the collector the traces of the reconciliations are exported to. Tracing is disabled when empty")
+	command.Flags().BoolVar(&tracingOpts.Insecure, "otlp-insecure", false, "Disable the TLS of the connection to the OTLP collector")
+	command.Flags().StringToStringVar(&tracingOpts.Headers, "otlp-headers", nil, "Headers sent with the traces to the OTLP collector, e.g. --otlp-headers=authorization=token")
+	command.Flags().Float64Var(&tracingOpts.SampleRatio, "otlp-sample-ratio", 1, "Ratio of the reconciliations which are traced, between 0 and 1")
 	command.Flags().StringVar(&pprofAddress, "enable-pprof-address", "", "Enable pprof profiling on controller by providing a server address.")
 	return &command
 }
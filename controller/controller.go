@@ -28,6 +28,8 @@ import (
 	"github.com/pkg/errors"
 	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -46,14 +48,18 @@ import (
 
 	"github.com/argoproj/argo-rollouts/analysis"
 	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/controller/sharding"
+	"github.com/argoproj/argo-rollouts/controller/webhook"
 	"github.com/argoproj/argo-rollouts/experiments"
 	"github.com/argoproj/argo-rollouts/ingress"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	rolloutscheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout"
 	"github.com/argoproj/argo-rollouts/service"
+	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	"github.com/argoproj/argo-rollouts/utils/queue"
@@ -85,6 +91,9 @@ const (
 	// DefaultIngressThreads is the default number of ingress worker threads to start with the controller
 	DefaultIngressThreads = 10
 
+	// DefaultWebhookPort is the default port the admission webhook is served over
+	DefaultWebhookPort = 8443
+
 	// DefaultLeaderElect is the default true leader election should be enabled
 	DefaultLeaderElect = true
 
@@ -107,6 +116,9 @@ type LeaderElectionOptions struct {
 	LeaderElectionLeaseDuration time.Duration
 	LeaderElectionRenewDeadline time.Duration
 	LeaderElectionRetryPeriod   time.Duration
+	// Shards is the number of shards the namespaces are split into. When greater than zero, leader election is
+	// replaced by per-shard leases so that every replica processes the shards it holds.
+	Shards int
 }
 
 func NewLeaderElectionOptions() *LeaderElectionOptions {
@@ -119,11 +131,30 @@ func NewLeaderElectionOptions() *LeaderElectionOptions {
 	}
 }
 
+// WebhookOptions configures the admission webhook served by the controller
+type WebhookOptions struct {
+	Enabled         bool
+	Port            int
+	Namespace       string
+	ServiceName     string
+	ReferencePolicy string
+}
+
+func NewWebhookOptions() *WebhookOptions {
+	return &WebhookOptions{
+		Port:            DefaultWebhookPort,
+		Namespace:       defaults.Namespace(),
+		ServiceName:     webhook.DefaultServiceName,
+		ReferencePolicy: string(webhook.ReferencePolicyDeny),
+	}
+}
+
 // Manager is the controller implementation for Argo-Rollout resources
 type Manager struct {
 	wg                      *sync.WaitGroup
 	metricsServer           *metrics.MetricsServer
 	healthzServer           *http.Server
+	webhookServer           *webhook.Server
 	rolloutController       *rollout.Controller
 	experimentController    *experiments.Controller
 	analysisController      *analysis.Controller
@@ -131,17 +162,23 @@ type Manager struct {
 	ingressController       *ingress.Controller
 	notificationsController notificationcontroller.NotificationController
 
-	rolloutSynced                 cache.InformerSynced
-	experimentSynced              cache.InformerSynced
-	analysisRunSynced             cache.InformerSynced
-	analysisTemplateSynced        cache.InformerSynced
-	clusterAnalysisTemplateSynced cache.InformerSynced
-	serviceSynced                 cache.InformerSynced
-	ingressSynced                 cache.InformerSynced
-	jobSynced                     cache.InformerSynced
-	replicasSetSynced             cache.InformerSynced
-	configMapSynced               cache.InformerSynced
-	secretSynced                  cache.InformerSynced
+	rolloutSynced                        cache.InformerSynced
+	experimentSynced                     cache.InformerSynced
+	analysisRunSynced                    cache.InformerSynced
+	analysisTemplateSynced               cache.InformerSynced
+	clusterAnalysisTemplateSynced        cache.InformerSynced
+	rolloutPolicySynced                  cache.InformerSynced
+	clusterRolloutPolicySynced           cache.InformerSynced
+	rolloutStrategyTemplateSynced        cache.InformerSynced
+	clusterRolloutStrategyTemplateSynced cache.InformerSynced
+	serviceSynced                        cache.InformerSynced
+	ingressSynced                        cache.InformerSynced
+	jobSynced                            cache.InformerSynced
+	replicasSetSynced                    cache.InformerSynced
+	configMapSynced                      cache.InformerSynced
+	secretSynced                         cache.InformerSynced
+	configSnapshotConfigMapSynced        cache.InformerSynced
+	configSnapshotSecretSynced           cache.InformerSynced
 
 	rolloutWorkqueue     workqueue.RateLimitingInterface
 	serviceWorkqueue     workqueue.RateLimitingInterface
@@ -151,6 +188,11 @@ type Manager struct {
 
 	refResolver rollout.TemplateRefResolver
 
+	shardManager      *sharding.Manager
+	rolloutLister     listers.RolloutLister
+	experimentLister  listers.ExperimentLister
+	analysisRunLister listers.AnalysisRunLister
+
 	kubeClientSet kubernetes.Interface
 
 	namespace string
@@ -160,6 +202,7 @@ type Manager struct {
 	istioDynamicInformerFactory          dynamicinformer.DynamicSharedInformerFactory
 	namespaced                           bool
 	kubeInformerFactory                  kubeinformers.SharedInformerFactory
+	configSnapshotInformerFactory        kubeinformers.SharedInformerFactory
 	notificationConfigMapInformerFactory kubeinformers.SharedInformerFactory
 	notificationSecretInformerFactory    kubeinformers.SharedInformerFactory
 	jobInformerFactory                   kubeinformers.SharedInformerFactory
@@ -185,6 +228,7 @@ func NewAnalysisManager(
 	namespaced bool,
 	kubeInformerFactory kubeinformers.SharedInformerFactory,
 	jobInformerFactory kubeinformers.SharedInformerFactory,
+	shardManager *sharding.Manager,
 ) *Manager {
 	runtime.Must(rolloutscheme.AddToScheme(scheme.Scheme))
 	log.Info("Creating event broadcaster")
@@ -201,7 +245,7 @@ func NewAnalysisManager(
 	})
 
 	healthzServer := NewHealthzServer(fmt.Sprintf(listenAddr, healthzPort))
-	analysisRunWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns")
+	analysisRunWorkqueue := sharding.NewQueue(workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns"), shardManager)
 	recorder := record.NewEventRecorder(kubeclientset, metrics.MetricRolloutEventsTotal, metrics.MetricNotificationFailedTotal, metrics.MetricNotificationSuccessTotal, metrics.MetricNotificationSend, nil)
 	analysisController := analysis.NewController(analysis.ControllerConfig{
 		KubeClientSet:        kubeclientset,
@@ -224,6 +268,8 @@ func NewAnalysisManager(
 		clusterAnalysisTemplateSynced: clusterAnalysisTemplateInformer.Informer().HasSynced,
 		analysisRunWorkqueue:          analysisRunWorkqueue,
 		analysisController:            analysisController,
+		shardManager:                  shardManager,
+		analysisRunLister:             analysisRunInformer.Lister(),
 		namespace:                     namespace,
 		kubeClientSet:                 kubeclientset,
 		dynamicInformerFactory:        dynamicInformerFactory,
@@ -264,6 +310,10 @@ func NewManager(
 	analysisRunInformer informers.AnalysisRunInformer,
 	analysisTemplateInformer informers.AnalysisTemplateInformer,
 	clusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer,
+	rolloutPolicyInformer informers.RolloutPolicyInformer,
+	clusterRolloutPolicyInformer informers.ClusterRolloutPolicyInformer,
+	rolloutStrategyTemplateInformer informers.RolloutStrategyTemplateInformer,
+	clusterRolloutStrategyTemplateInformer informers.ClusterRolloutStrategyTemplateInformer,
 	istioPrimaryDynamicClient dynamic.Interface,
 	istioVirtualServiceInformer cache.SharedIndexInformer,
 	istioDestinationRuleInformer cache.SharedIndexInformer,
@@ -283,6 +333,8 @@ func NewManager(
 	kubeInformerFactory kubeinformers.SharedInformerFactory,
 	jobInformerFactory kubeinformers.SharedInformerFactory,
 	ephemeralMetadataThreads int,
+	webhookOpts *WebhookOptions,
+	shardManager *sharding.Manager,
 ) *Manager {
 	runtime.Must(rolloutscheme.AddToScheme(scheme.Scheme))
 	log.Info("Creating event broadcaster")
@@ -299,11 +351,17 @@ func NewManager(
 	})
 
 	healthzServer := NewHealthzServer(fmt.Sprintf(listenAddr, healthzPort))
-	rolloutWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts")
-	experimentWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Experiments")
-	analysisRunWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns")
-	serviceWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Services")
-	ingressWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Ingresses")
+	var rolloutWorkqueue workqueue.RateLimitingInterface
+	if defaults.IsRolloutPriorityQueueEnabled() {
+		rolloutWorkqueue = queue.NewPriorityRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts", rollout.NewQueuePriorityFunc(rolloutsInformer.Lister()), metrics.NewPriorityQueueMetrics("Rollouts"))
+	} else {
+		rolloutWorkqueue = workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts")
+	}
+	rolloutWorkqueue = sharding.NewQueue(rolloutWorkqueue, shardManager)
+	experimentWorkqueue := sharding.NewQueue(workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Experiments"), shardManager)
+	analysisRunWorkqueue := sharding.NewQueue(workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns"), shardManager)
+	serviceWorkqueue := sharding.NewQueue(workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Services"), shardManager)
+	ingressWorkqueue := sharding.NewQueue(workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Ingresses"), shardManager)
 
 	refResolver := rollout.NewInformerBasedWorkloadRefResolver(namespace, dynamicclientset, discoveryClient, argoprojclientset, rolloutsInformer.Informer())
 	apiFactory := notificationapi.NewFactory(record.NewAPIFactorySettings(analysisRunInformer), defaults.Namespace(), notificationSecretInformerFactory.Core().V1().Secrets().Informer(), notificationConfigMapInformerFactory.Core().V1().ConfigMaps().Informer())
@@ -321,33 +379,67 @@ func NewManager(
 			}
 			return res, nil
 		}),
+		notificationcontroller.WithSkipProcessing(func(obj metav1.Object) (bool, string) {
+			if !shardManager.Owns(obj.GetNamespace()) {
+				return true, "namespace belongs to a shard owned by another controller replica"
+			}
+			return false, ""
+		}),
 	)
 
+	// The pods, StatefulSets, ControllerRevisions, DaemonSets and nodes are only read for the Rollouts progressing a
+	// StatefulSet or using DaemonSet canaries: their informers are started by the rollout controller the first time
+	// such a Rollout is reconciled
+	workloadInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeclientset, resyncPeriod, kubeinformers.WithNamespace(namespace))
+	shardManager.RegisterInformers(workloadInformerFactory, nil, &corev1.Pod{}, &appsv1.StatefulSet{}, &appsv1.ControllerRevision{}, &appsv1.DaemonSet{})
+
+	// Only the snapshots of the ConfigRefs are cached: the ConfigMaps and Secrets they snapshot are read with a GET
+	configSnapshotInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeclientset, resyncPeriod, kubeinformers.WithNamespace(namespace), kubeinformers.WithTweakListOptions(rollout.ConfigSnapshotsTweakListOptions))
+	shardManager.RegisterInformers(configSnapshotInformerFactory, rollout.ConfigSnapshotsTweakListOptions, &corev1.ConfigMap{}, &corev1.Secret{})
+
+	// The nodes are cluster scoped: they are only read by the DaemonSet canaries of a controller in cluster-wide mode
+	var nodeInformer coreinformers.NodeInformer
+	if !namespaced {
+		nodeInformer = workloadInformerFactory.Core().V1().Nodes()
+	}
+
 	rolloutController := rollout.NewController(rollout.ControllerConfig{
-		Namespace:                       namespace,
-		KubeClientSet:                   kubeclientset,
-		ArgoProjClientset:               argoprojclientset,
-		DynamicClientSet:                dynamicclientset,
-		RefResolver:                     refResolver,
-		SmiClientSet:                    smiclientset,
-		ExperimentInformer:              experimentsInformer,
-		AnalysisRunInformer:             analysisRunInformer,
-		AnalysisTemplateInformer:        analysisTemplateInformer,
-		ClusterAnalysisTemplateInformer: clusterAnalysisTemplateInformer,
-		IstioPrimaryDynamicClient:       istioPrimaryDynamicClient,
-		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
-		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
-		ReplicaSetInformer:              replicaSetInformer,
-		ServicesInformer:                servicesInformer,
-		IngressWrapper:                  ingressWrap,
-		RolloutsInformer:                rolloutsInformer,
-		ResyncPeriod:                    resyncPeriod,
-		RolloutWorkQueue:                rolloutWorkqueue,
-		ServiceWorkQueue:                serviceWorkqueue,
-		IngressWorkQueue:                ingressWorkqueue,
-		MetricsServer:                   metricsServer,
-		Recorder:                        recorder,
-		EphemeralMetadataThreads:        ephemeralMetadataThreads,
+		Namespace:                              namespace,
+		KubeClientSet:                          kubeclientset,
+		ArgoProjClientset:                      argoprojclientset,
+		DynamicClientSet:                       dynamicclientset,
+		RefResolver:                            refResolver,
+		SmiClientSet:                           smiclientset,
+		ExperimentInformer:                     experimentsInformer,
+		AnalysisRunInformer:                    analysisRunInformer,
+		AnalysisTemplateInformer:               analysisTemplateInformer,
+		ClusterAnalysisTemplateInformer:        clusterAnalysisTemplateInformer,
+		RolloutPolicyInformer:                  rolloutPolicyInformer,
+		ClusterRolloutPolicyInformer:           clusterRolloutPolicyInformer,
+		RolloutStrategyTemplateInformer:        rolloutStrategyTemplateInformer,
+		ClusterRolloutStrategyTemplateInformer: clusterRolloutStrategyTemplateInformer,
+		IstioPrimaryDynamicClient:              istioPrimaryDynamicClient,
+		IstioVirtualServiceInformer:            istioVirtualServiceInformer,
+		IstioDestinationRuleInformer:           istioDestinationRuleInformer,
+		ReplicaSetInformer:                     replicaSetInformer,
+		ServicesInformer:                       servicesInformer,
+		PodInformer:                            workloadInformerFactory.Core().V1().Pods(),
+		StatefulSetInformer:                    workloadInformerFactory.Apps().V1().StatefulSets(),
+		ControllerRevisionInformer:             workloadInformerFactory.Apps().V1().ControllerRevisions(),
+		DaemonSetInformer:                      workloadInformerFactory.Apps().V1().DaemonSets(),
+		NodeInformer:                           nodeInformer,
+		ConfigMapSnapshotInformer:              configSnapshotInformerFactory.Core().V1().ConfigMaps(),
+		SecretSnapshotInformer:                 configSnapshotInformerFactory.Core().V1().Secrets(),
+		WorkloadInformerFactory:                controllerutil.NewLazyInformerFactory(workloadInformerFactory),
+		IngressWrapper:                         ingressWrap,
+		RolloutsInformer:                       rolloutsInformer,
+		ResyncPeriod:                           resyncPeriod,
+		RolloutWorkQueue:                       rolloutWorkqueue,
+		ServiceWorkQueue:                       serviceWorkqueue,
+		IngressWorkQueue:                       ingressWorkqueue,
+		MetricsServer:                          metricsServer,
+		Recorder:                               recorder,
+		EphemeralMetadataThreads:               ephemeralMetadataThreads,
 	})
 
 	experimentController := experiments.NewController(experiments.ControllerConfig{
@@ -414,9 +506,15 @@ func NewManager(
 		analysisRunSynced:                    analysisRunInformer.Informer().HasSynced,
 		analysisTemplateSynced:               analysisTemplateInformer.Informer().HasSynced,
 		clusterAnalysisTemplateSynced:        clusterAnalysisTemplateInformer.Informer().HasSynced,
+		rolloutPolicySynced:                  rolloutPolicyInformer.Informer().HasSynced,
+		clusterRolloutPolicySynced:           clusterRolloutPolicyInformer.Informer().HasSynced,
+		rolloutStrategyTemplateSynced:        rolloutStrategyTemplateInformer.Informer().HasSynced,
+		clusterRolloutStrategyTemplateSynced: clusterRolloutStrategyTemplateInformer.Informer().HasSynced,
 		replicasSetSynced:                    replicaSetInformer.Informer().HasSynced,
 		configMapSynced:                      notificationConfigMapInformerFactory.Core().V1().ConfigMaps().Informer().HasSynced,
 		secretSynced:                         notificationSecretInformerFactory.Core().V1().Secrets().Informer().HasSynced,
+		configSnapshotConfigMapSynced:        configSnapshotInformerFactory.Core().V1().ConfigMaps().Informer().HasSynced,
+		configSnapshotSecretSynced:           configSnapshotInformerFactory.Core().V1().Secrets().Informer().HasSynced,
 		rolloutWorkqueue:                     rolloutWorkqueue,
 		experimentWorkqueue:                  experimentWorkqueue,
 		analysisRunWorkqueue:                 analysisRunWorkqueue,
@@ -429,6 +527,10 @@ func NewManager(
 		analysisController:                   analysisController,
 		notificationsController:              notificationsController,
 		refResolver:                          refResolver,
+		shardManager:                         shardManager,
+		rolloutLister:                        rolloutsInformer.Lister(),
+		experimentLister:                     experimentsInformer.Lister(),
+		analysisRunLister:                    analysisRunInformer.Lister(),
 		namespace:                            namespace,
 		kubeClientSet:                        kubeclientset,
 		dynamicInformerFactory:               dynamicInformerFactory,
@@ -436,12 +538,29 @@ func NewManager(
 		istioDynamicInformerFactory:          istioDynamicInformerFactory,
 		namespaced:                           namespaced,
 		kubeInformerFactory:                  kubeInformerFactory,
+		configSnapshotInformerFactory:        configSnapshotInformerFactory,
 		jobInformerFactory:                   jobInformerFactory,
 		istioPrimaryDynamicClient:            istioPrimaryDynamicClient,
 		notificationConfigMapInformerFactory: notificationConfigMapInformerFactory,
 		notificationSecretInformerFactory:    notificationSecretInformerFactory,
 	}
 
+	if webhookOpts != nil && webhookOpts.Enabled {
+		cm.webhookServer = webhook.NewServer(webhook.ServerConfig{
+			Addr:                          fmt.Sprintf(listenAddr, webhookOpts.Port),
+			Namespace:                     webhookOpts.Namespace,
+			ServiceName:                   webhookOpts.ServiceName,
+			KubeClientSet:                 kubeclientset,
+			DynamicClientSet:              dynamicclientset,
+			RolloutValidator:              rolloutController,
+			AnalysisTemplateLister:        analysisTemplateInformer.Lister(),
+			ClusterAnalysisTemplateLister: clusterAnalysisTemplateInformer.Lister(),
+			HasSynced:                     cm.webhookCachesSynced,
+			CachesNamespace:               shardManager.Caches,
+			ReferencePolicy:               webhook.ReferencePolicy(webhookOpts.ReferencePolicy),
+		})
+	}
+
 	_, err := rolloutsConfig.InitializeConfig(kubeclientset, defaults.DefaultRolloutsConfigMapName)
 	if err != nil {
 		log.Fatalf("Failed to init config: %v", err)
@@ -464,6 +583,10 @@ func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadines
 		log.Infof("Exiting Main Run function")
 	}()
 
+	if electOpts.Shards > 0 && c.namespaced {
+		return fmt.Errorf("sharding is not supported in namespaced mode")
+	}
+
 	go func() {
 		log.Infof("Starting Healthz Server at %s", c.healthzServer.Addr)
 		err := c.healthzServer.ListenAndServe()
@@ -480,23 +603,44 @@ func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadines
 		}
 	}()
 
-	if !electOpts.LeaderElect {
+	if c.webhookServer != nil {
+		// every replica serves admission requests, so the informers used to validate references are started
+		// regardless of leader election
+		c.startInformers(ctx)
+		go func() {
+			if err := c.webhookServer.Run(ctx); err != nil {
+				log.Error(errors.Wrap(err, "Admission Webhook Server Error"))
+			}
+		}()
+	}
+
+	if electOpts.Shards > 0 {
+		id := controllerIdentity()
+		stopped, err := c.shardManager.Start(ctx, sharding.Options{
+			Namespace:     electOpts.LeaderElectionNamespace,
+			Name:          controllerHostname(),
+			Identity:      id,
+			LeaseDuration: electOpts.LeaderElectionLeaseDuration,
+			RenewDeadline: electOpts.LeaderElectionRenewDeadline,
+			RetryPeriod:   electOpts.LeaderElectionRetryPeriod,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to start sharding")
+		}
+		log.Infof("Sharding is enabled. Running as %s with %d shards", id, electOpts.Shards)
+		go c.startLeading(ctx, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness)
+		<-ctx.Done()
+		<-stopped
+	} else if !electOpts.LeaderElect {
 		log.Info("Leader election is turned off. Running in single-instance mode")
 		go c.startLeading(ctx, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness)
 		<-ctx.Done()
 	} else {
-		// id used to distinguish between multiple controller manager instances
-		id, err := os.Hostname()
-		if err != nil {
-			log.Fatalf("Error getting hostname for leader election %v", err)
-		}
-
 		if electOpts.LeaderElectionNamespace == "" {
 			log.Fatalf("Error LeaderElectionNamespace is empty")
 		}
 
-		// add a uniquifier so that two processes on the same host don't accidentally both become active
-		id = id + "_" + string(uuid.NewUUID())
+		id := controllerIdentity()
 		log.Infof("Leaderelection get id %s", id)
 		leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
 			Lock: &resourcelock.LeaseLock{
@@ -538,17 +682,18 @@ func (c *Manager) Run(ctx context.Context, rolloutThreadiness, serviceThreadines
 	defer cancel()
 	c.healthzServer.Shutdown(ctxWithTimeout)
 	c.metricsServer.Shutdown(ctxWithTimeout)
+	if c.webhookServer != nil {
+		c.webhookServer.Shutdown(ctxWithTimeout)
+	}
 
 	c.wg.Wait()
 
 	return nil
 }
 
-func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness int) {
-	defer runtime.HandleCrash()
-	// Start the informer factories to begin populating the informer caches
-	log.Info("Starting Controllers")
-
+// startInformers starts the informer factories of the Rollouts, Experiments, AnalysisRuns, AnalysisTemplates and
+// of the Kubernetes resources
+func (c *Manager) startInformers(ctx context.Context) {
 	// notice that there is no need to run Start methods in a separate goroutine. (i.e. go kubeInformerFactory.Start(stopCh)
 	// Start method is non-blocking and runs all registered informers in a dedicated goroutine.
 	c.dynamicInformerFactory.Start(ctx.Done())
@@ -556,6 +701,39 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 		c.clusterDynamicInformerFactory.Start(ctx.Done())
 	}
 	c.kubeInformerFactory.Start(ctx.Done())
+}
+
+// webhookCachesSynced returns whether the informer caches used by the admission webhook to validate references
+// are synced
+func (c *Manager) webhookCachesSynced() bool {
+	synced := c.rolloutSynced() && c.serviceSynced() && c.ingressSynced() && c.analysisTemplateSynced()
+	if c.namespace == metav1.NamespaceAll {
+		synced = synced && c.clusterAnalysisTemplateSynced()
+	}
+	return synced
+}
+
+// controllerHostname returns the hostname of the controller, that is the name of its pod
+func controllerHostname() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.Fatalf("Error getting hostname for leader election %v", err)
+	}
+	return hostname
+}
+
+// controllerIdentity returns the id used to distinguish between multiple controller manager instances
+func controllerIdentity() string {
+	// add a uniquifier so that two processes on the same host don't accidentally both become active
+	return controllerHostname() + "_" + string(uuid.NewUUID())
+}
+
+func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness int) {
+	defer runtime.HandleCrash()
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting Controllers")
+
+	c.startInformers(ctx)
 
 	c.jobInformerFactory.Start(ctx.Done())
 
@@ -579,6 +757,8 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 			log.Fatalf("failed to wait for configmap/secret caches to sync, exiting")
 		}
 
+		c.configSnapshotInformerFactory.Start(ctx.Done())
+
 		// Check if Istio installed on cluster before starting dynamicInformerFactory
 		if istioutil.DoesIstioExist(c.istioPrimaryDynamicClient, c.namespace) {
 			c.istioDynamicInformerFactory.Start(ctx.Done())
@@ -586,12 +766,12 @@ func (c *Manager) startLeading(ctx context.Context, rolloutThreadiness, serviceT
 
 		// Wait for the caches to be synced before starting workers
 		log.Info("Waiting for controller's informer caches to sync")
-		if ok := cache.WaitForCacheSync(ctx.Done(), c.serviceSynced, c.ingressSynced, c.jobSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
+		if ok := cache.WaitForCacheSync(ctx.Done(), c.serviceSynced, c.ingressSynced, c.jobSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.rolloutPolicySynced, c.rolloutStrategyTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced, c.configSnapshotConfigMapSynced, c.configSnapshotSecretSynced); !ok {
 			log.Fatalf("failed to wait for caches to sync, exiting")
 		}
 		// only wait for cluster scoped informers to sync if we are running in cluster-wide mode
 		if c.namespace == metav1.NamespaceAll {
-			if ok := cache.WaitForCacheSync(ctx.Done(), c.clusterAnalysisTemplateSynced); !ok {
+			if ok := cache.WaitForCacheSync(ctx.Done(), c.clusterAnalysisTemplateSynced, c.clusterRolloutPolicySynced, c.clusterRolloutStrategyTemplateSynced); !ok {
 				log.Fatalf("failed to wait for cluster-scoped caches to sync, exiting")
 			}
 		}
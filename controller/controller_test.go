@@ -25,6 +25,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/analysis"
 	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/controller/sharding"
 	experimentsController "github.com/argoproj/argo-rollouts/experiments"
 	"github.com/argoproj/argo-rollouts/ingress"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -74,10 +75,16 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		analysisRunSynced:                    alwaysReady,
 		analysisTemplateSynced:               alwaysReady,
 		clusterAnalysisTemplateSynced:        alwaysReady,
+		rolloutPolicySynced:                  alwaysReady,
+		clusterRolloutPolicySynced:           alwaysReady,
+		rolloutStrategyTemplateSynced:        alwaysReady,
+		clusterRolloutStrategyTemplateSynced: alwaysReady,
 		serviceSynced:                        alwaysReady,
 		ingressSynced:                        alwaysReady,
 		jobSynced:                            alwaysReady,
 		replicasSetSynced:                    alwaysReady,
+		configSnapshotConfigMapSynced:        alwaysReady,
+		configSnapshotSecretSynced:           alwaysReady,
 		configMapSynced:                      alwaysReady,
 		secretSynced:                         alwaysReady,
 		rolloutWorkqueue:                     rolloutWorkqueue,
@@ -89,6 +96,7 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		namespace:                            "",
 		namespaced:                           false,
 		notificationSecretInformerFactory:    kubeinformers.NewSharedInformerFactoryWithOptions(f.kubeclient, noResyncPeriodFunc()),
+		configSnapshotInformerFactory:        kubeinformers.NewSharedInformerFactoryWithOptions(f.kubeclient, noResyncPeriodFunc()),
 		notificationConfigMapInformerFactory: kubeinformers.NewSharedInformerFactoryWithOptions(f.kubeclient, noResyncPeriodFunc()),
 	}
 
@@ -132,27 +140,38 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 	assert.NoError(t, err)
 
 	cm.rolloutController = rolloutController.NewController(rolloutController.ControllerConfig{
-		Namespace:                       metav1.NamespaceAll,
-		KubeClientSet:                   f.kubeclient,
-		ArgoProjClientset:               f.client,
-		DynamicClientSet:                dynamicClient,
-		ExperimentInformer:              i.Argoproj().V1alpha1().Experiments(),
-		AnalysisRunInformer:             i.Argoproj().V1alpha1().AnalysisRuns(),
-		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
-		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
-		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
-		ServicesInformer:                k8sI.Core().V1().Services(),
-		IngressWrapper:                  ingressWrapper,
-		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
-		IstioPrimaryDynamicClient:       dynamicClient,
-		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
-		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
-		ResyncPeriod:                    noResyncPeriodFunc(),
-		RolloutWorkQueue:                rolloutWorkqueue,
-		ServiceWorkQueue:                serviceWorkqueue,
-		IngressWorkQueue:                ingressWorkqueue,
-		MetricsServer:                   cm.metricsServer,
-		Recorder:                        record.NewFakeEventRecorder(),
+		Namespace:                              metav1.NamespaceAll,
+		KubeClientSet:                          f.kubeclient,
+		ArgoProjClientset:                      f.client,
+		DynamicClientSet:                       dynamicClient,
+		ExperimentInformer:                     i.Argoproj().V1alpha1().Experiments(),
+		AnalysisRunInformer:                    i.Argoproj().V1alpha1().AnalysisRuns(),
+		AnalysisTemplateInformer:               i.Argoproj().V1alpha1().AnalysisTemplates(),
+		ClusterAnalysisTemplateInformer:        i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		RolloutPolicyInformer:                  i.Argoproj().V1alpha1().RolloutPolicies(),
+		ClusterRolloutPolicyInformer:           i.Argoproj().V1alpha1().ClusterRolloutPolicies(),
+		RolloutStrategyTemplateInformer:        i.Argoproj().V1alpha1().RolloutStrategyTemplates(),
+		ClusterRolloutStrategyTemplateInformer: i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates(),
+		ReplicaSetInformer:                     k8sI.Apps().V1().ReplicaSets(),
+		ServicesInformer:                       k8sI.Core().V1().Services(),
+		PodInformer:                            k8sI.Core().V1().Pods(),
+		StatefulSetInformer:                    k8sI.Apps().V1().StatefulSets(),
+		ControllerRevisionInformer:             k8sI.Apps().V1().ControllerRevisions(),
+		DaemonSetInformer:                      k8sI.Apps().V1().DaemonSets(),
+		NodeInformer:                           k8sI.Core().V1().Nodes(),
+		ConfigMapSnapshotInformer:              k8sI.Core().V1().ConfigMaps(),
+		SecretSnapshotInformer:                 k8sI.Core().V1().Secrets(),
+		IngressWrapper:                         ingressWrapper,
+		RolloutsInformer:                       i.Argoproj().V1alpha1().Rollouts(),
+		IstioPrimaryDynamicClient:              dynamicClient,
+		IstioVirtualServiceInformer:            istioVirtualServiceInformer,
+		IstioDestinationRuleInformer:           istioDestinationRuleInformer,
+		ResyncPeriod:                           noResyncPeriodFunc(),
+		RolloutWorkQueue:                       rolloutWorkqueue,
+		ServiceWorkQueue:                       serviceWorkqueue,
+		IngressWorkQueue:                       ingressWorkqueue,
+		MetricsServer:                          cm.metricsServer,
+		Recorder:                               record.NewFakeEventRecorder(),
 	})
 
 	cm.analysisController = analysis.NewController(analysis.ControllerConfig{
@@ -251,6 +270,10 @@ func TestNewManager(t *testing.T) {
 		i.Argoproj().V1alpha1().AnalysisRuns(),
 		i.Argoproj().V1alpha1().AnalysisTemplates(),
 		i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		i.Argoproj().V1alpha1().RolloutPolicies(),
+		i.Argoproj().V1alpha1().ClusterRolloutPolicies(),
+		i.Argoproj().V1alpha1().RolloutStrategyTemplates(),
+		i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates(),
 		dynamicClient,
 		istioVirtualServiceInformer,
 		istioDestinationRuleInformer,
@@ -267,9 +290,11 @@ func TestNewManager(t *testing.T) {
 		nil,
 		nil,
 		false,
-		nil,
+		k8sI,
 		nil,
 		rolloutController.DefaultEphemeralMetadataThreads,
+		nil,
+		sharding.NewManager(f.kubeclient, 0),
 	)
 
 	assert.NotNil(t, cm)
@@ -304,6 +329,7 @@ func TestNewAnalysisManager(t *testing.T) {
 		false,
 		nil,
 		nil,
+		sharding.NewManager(f.kubeclient, 0),
 	)
 
 	assert.NotNil(t, cm)
@@ -335,3 +361,32 @@ func TestPrimaryControllerSingleInstanceWithShutdown(t *testing.T) {
 	}()
 	cm.Run(ctx, 1, 1, 1, 1, 1, electOpts)
 }
+
+func TestShardedController(t *testing.T) {
+	f := newFixture(t)
+
+	cm := f.newManager(t)
+	cm.shardManager = sharding.NewManager(f.kubeclient, 2)
+	electOpts := NewLeaderElectionOptions()
+	electOpts.LeaderElectionNamespace = "argo-rollouts"
+	electOpts.Shards = 2
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(5 * time.Second)
+		cancel()
+	}()
+	assert.NoError(t, cm.Run(ctx, 1, 1, 1, 1, 1, electOpts))
+	assert.Equal(t, []int{0, 1}, cm.shardManager.OwnedShards())
+}
+
+func TestShardedControllerNamespaced(t *testing.T) {
+	f := newFixture(t)
+
+	cm := f.newManager(t)
+	cm.namespaced = true
+	cm.shardManager = sharding.NewManager(f.kubeclient, 2)
+	electOpts := NewLeaderElectionOptions()
+	electOpts.Shards = 2
+	err := cm.Run(context.Background(), 1, 1, 1, 1, 1, electOpts)
+	assert.EqualError(t, err, "sharding is not supported in namespaced mode")
+}
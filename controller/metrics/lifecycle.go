@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+// lifecycleLabelValues returns the namespace, name and team label values of the lifecycle metrics of a rollout
+func lifecycleLabelValues(rollout *v1alpha1.Rollout, extra ...string) []string {
+	team := ""
+	if key := defaults.GetMetricsTeamLabelKey(); key != "" {
+		team = rollout.Labels[key]
+	}
+	return append([]string{rollout.Namespace, rollout.Name, team}, extra...)
+}
+
+// ObserveRolloutCompleted records the full promotion of a revision of a rollout, and the duration of its update
+// when it is known
+func ObserveRolloutCompleted(rollout *v1alpha1.Rollout, duration *time.Duration) {
+	MetricRolloutDeploymentsTotal.WithLabelValues(lifecycleLabelValues(rollout)...).Inc()
+	if duration != nil {
+		MetricRolloutDuration.WithLabelValues(lifecycleLabelValues(rollout)...).Observe(duration.Seconds())
+	}
+}
+
+// ObserveRolloutStep records the time spent in a completed step of a rollout
+func ObserveRolloutStep(rollout *v1alpha1.Rollout, stepType string, duration time.Duration) {
+	MetricRolloutStepDuration.WithLabelValues(lifecycleLabelValues(rollout, stepType)...).Observe(duration.Seconds())
+}
+
+// ObserveRolloutAborted records the abort of the update of a rollout, and the time since the update started when it
+// is known
+func ObserveRolloutAborted(rollout *v1alpha1.Rollout, reason string, timeToAbort *time.Duration) {
+	MetricRolloutAbortsTotal.WithLabelValues(lifecycleLabelValues(rollout, reason)...).Inc()
+	if timeToAbort != nil {
+		MetricRolloutTimeToAbort.WithLabelValues(lifecycleLabelValues(rollout)...).Observe(timeToAbort.Seconds())
+	}
+}
+
+// IncRolloutRollback records the update of a rollout to a previous revision
+func IncRolloutRollback(rollout *v1alpha1.Rollout) {
+	MetricRolloutRollbacksTotal.WithLabelValues(lifecycleLabelValues(rollout)...).Inc()
+}
+
+// IncRolloutAnalysisFailure records an unsuccessful analysis run of a rollout created from an analysis template
+func IncRolloutAnalysisFailure(rollout *v1alpha1.Rollout, template string, phase v1alpha1.AnalysisPhase) {
+	MetricRolloutAnalysisFailuresTotal.WithLabelValues(lifecycleLabelValues(rollout, template, string(phase))...).Inc()
+}
+
+func deleteLifecycleMetrics(namespace, name string) {
+	labels := map[string]string{"namespace": namespace, "name": name}
+	MetricRolloutDuration.DeletePartialMatch(labels)
+	MetricRolloutStepDuration.DeletePartialMatch(labels)
+	MetricRolloutTimeToAbort.DeletePartialMatch(labels)
+	MetricRolloutAbortsTotal.DeletePartialMatch(labels)
+	MetricRolloutRollbacksTotal.DeletePartialMatch(labels)
+	MetricRolloutAnalysisFailuresTotal.DeletePartialMatch(labels)
+	MetricRolloutDeploymentsTotal.DeletePartialMatch(labels)
+}
@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+func TestLifecycleMetricsTeamLabel(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "lifecycle-team",
+			Namespace: "default",
+			Labels:    map[string]string{"team": "payments"},
+		},
+	}
+	IncRolloutRollback(ro)
+	assert.Equal(t, float64(1), testutil.ToFloat64(MetricRolloutRollbacksTotal.WithLabelValues("default", "lifecycle-team", "")))
+
+	defaults.SetMetricsTeamLabelKey("team")
+	defer defaults.SetMetricsTeamLabelKey("")
+	IncRolloutRollback(ro)
+	assert.Equal(t, float64(1), testutil.ToFloat64(MetricRolloutRollbacksTotal.WithLabelValues("default", "lifecycle-team", "payments")))
+}
+
+func TestDeleteLifecycleMetrics(t *testing.T) {
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "lifecycle-deleted", Namespace: "default"}}
+	duration := time.Minute
+	ObserveRolloutCompleted(ro, &duration)
+	ObserveRolloutAborted(ro, "Manual", nil)
+	IncRolloutAnalysisFailure(ro, "success-rate", v1alpha1.AnalysisPhaseFailed)
+	assert.Equal(t, 1, testutil.CollectAndCount(MetricRolloutDeploymentsTotal))
+	assert.Equal(t, 1, testutil.CollectAndCount(MetricRolloutAbortsTotal))
+
+	deleteLifecycleMetrics("default", "lifecycle-deleted")
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutDeploymentsTotal))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutAbortsTotal))
+	assert.Equal(t, 0, testutil.CollectAndCount(MetricRolloutAnalysisFailuresTotal))
+}
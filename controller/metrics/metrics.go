@@ -78,6 +78,13 @@ func NewMetricsServer(cfg ServerConfig) *MetricsServer {
 	reg.MustRegister(MetricRolloutReconcile)
 	reg.MustRegister(MetricRolloutReconcileError)
 	reg.MustRegister(MetricRolloutEventsTotal)
+	reg.MustRegister(MetricRolloutDuration)
+	reg.MustRegister(MetricRolloutStepDuration)
+	reg.MustRegister(MetricRolloutTimeToAbort)
+	reg.MustRegister(MetricRolloutAbortsTotal)
+	reg.MustRegister(MetricRolloutRollbacksTotal)
+	reg.MustRegister(MetricRolloutAnalysisFailuresTotal)
+	reg.MustRegister(MetricRolloutDeploymentsTotal)
 	reg.MustRegister(MetricExperimentReconcile)
 	reg.MustRegister(MetricExperimentReconcileError)
 	reg.MustRegister(MetricAnalysisRunReconcile)
@@ -86,6 +93,11 @@ func NewMetricsServer(cfg ServerConfig) *MetricsServer {
 	reg.MustRegister(MetricNotificationFailedTotal)
 	reg.MustRegister(MetricNotificationSend)
 	reg.MustRegister(MetricVersionGauge)
+	reg.MustRegister(MetricControllerShardOwned)
+	reg.MustRegister(MetricControllerShardMembers)
+	reg.MustRegister(MetricControllerShardTransitionsTotal)
+	reg.MustRegister(MetricWorkqueuePriorityDepth)
+	reg.MustRegister(MetricWorkqueuePriorityQueueDuration)
 	reg.MustRegister(buildInfo)
 
 	recordBuildInfo()
@@ -163,6 +175,8 @@ func (m *MetricsServer) Remove(namespace string, name string, kind string) {
 			MetricRolloutReconcileError.Delete(map[string]string{"namespace": namespace, "name": name})
 
 			MetricRolloutEventsTotal.DeletePartialMatch(map[string]string{"namespace": namespace, "name": name})
+
+			deleteLifecycleMetrics(namespace, name)
 		case log.AnalysisRunKey:
 			m.reconcileAnalysisRunHistogram.Delete(map[string]string{"namespace": namespace, "name": name})
 			m.errorAnalysisRunCounter.Delete(map[string]string{"namespace": namespace, "name": name})
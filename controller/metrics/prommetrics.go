@@ -10,6 +10,9 @@ import (
 // https://prometheus.io/docs/practices/naming/
 var (
 	namespaceNameLabels = []string{"namespace", "name"}
+	// lifecycleLabels are the labels of the rollout lifecycle metrics, whose team is the value of a label of the
+	// rollout (see SetTeamLabelKey)
+	lifecycleLabels = []string{"namespace", "name", "team"}
 )
 
 // Rollout metrics
@@ -83,6 +86,68 @@ var (
 	)
 )
 
+// Rollout lifecycle metrics
+var (
+	MetricRolloutDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rollout_duration_seconds",
+			Help:    "Duration of the updates of a rollout, from their start until the new revision is fully promoted.",
+			Buckets: []float64{60, 300, 600, 1800, 3600, 7200, 21600, 43200, 86400},
+		},
+		lifecycleLabels,
+	)
+
+	MetricRolloutStepDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rollout_step_duration_seconds",
+			Help:    "Time spent in the completed canary steps of a rollout, by step type.",
+			Buckets: []float64{5, 30, 60, 300, 600, 1800, 3600, 7200, 21600},
+		},
+		append(lifecycleLabels, "step_type"),
+	)
+
+	MetricRolloutTimeToAbort = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "rollout_time_to_abort_seconds",
+			Help:    "Time from the start of the updates of a rollout until they were aborted.",
+			Buckets: []float64{30, 60, 300, 600, 1800, 3600, 7200, 21600, 86400},
+		},
+		lifecycleLabels,
+	)
+
+	MetricRolloutAbortsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rollout_aborts_total",
+			Help: "Count of aborted updates of a rollout, by reason.",
+		},
+		append(lifecycleLabels, "reason"),
+	)
+
+	MetricRolloutRollbacksTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rollout_rollbacks_total",
+			Help: "Count of updates of a rollout to a previous revision.",
+		},
+		lifecycleLabels,
+	)
+
+	MetricRolloutAnalysisFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rollout_analysis_failures_total",
+			Help: "Count of unsuccessful analysis runs of a rollout, by analysis template and phase.",
+		},
+		append(lifecycleLabels, "template", "phase"),
+	)
+
+	MetricRolloutDeploymentsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rollout_deployments_total",
+			Help: "Count of revisions of a rollout which were fully promoted.",
+		},
+		lifecycleLabels,
+	)
+)
+
 // AnalysisRun metrics
 var (
 	MetricAnalysisRunReconcile = prometheus.NewHistogramVec(
@@ -211,6 +276,52 @@ var (
 	)
 )
 
+// Sharding metrics
+var (
+	MetricControllerShardOwned = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "controller_shard_owned",
+			Help: "Whether the shard is owned by this controller replica.",
+		},
+		[]string{"shard"},
+	)
+
+	MetricControllerShardMembers = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "controller_shard_members",
+			Help: "Number of live controller replicas sharing the shards.",
+		},
+	)
+
+	MetricControllerShardTransitionsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "controller_shard_transitions_total",
+			Help: "Count of shards acquired, released or lost by this controller replica.",
+		},
+		[]string{"shard", "event"},
+	)
+)
+
+// Priority workqueue metrics
+var (
+	MetricWorkqueuePriorityDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "controller_workqueue_priority_depth",
+			Help: "Number of items waiting in a priority class of a workqueue.",
+		},
+		[]string{"name", "priority"},
+	)
+
+	MetricWorkqueuePriorityQueueDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "controller_workqueue_priority_queue_duration_seconds",
+			Help:    "How long the items of a priority class of a workqueue waited before being processed.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+		},
+		[]string{"name", "priority"},
+	)
+)
+
 // K8s Client metrics
 var (
 	// Custom events metric
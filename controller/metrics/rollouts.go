@@ -77,7 +77,7 @@ func calculatePhase(rollout *v1alpha1.Rollout) RolloutPhase {
 		if progressing.Reason == conditions.TimedOutReason {
 			phase = RolloutTimeout
 		}
-		if progressing.Reason == conditions.RolloutAbortedReason {
+		if progressing.Reason == conditions.RolloutAbortedReason || progressing.Reason == conditions.StepTimedOutReason {
 			phase = RolloutAbort
 		}
 	}
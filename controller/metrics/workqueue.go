@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/argoproj/argo-rollouts/utils/queue"
+)
+
+// priorityQueueMetrics records the metrics of the priority classes of a named priority workqueue
+type priorityQueueMetrics struct {
+	name string
+}
+
+// NewPriorityQueueMetrics returns the metrics provider of the priority classes of a priority workqueue
+func NewPriorityQueueMetrics(name string) queue.PriorityMetricsProvider {
+	return &priorityQueueMetrics{name: name}
+}
+
+func (m *priorityQueueMetrics) SetDepth(priority queue.Priority, depth int) {
+	MetricWorkqueuePriorityDepth.WithLabelValues(m.name, priority.String()).Set(float64(depth))
+}
+
+func (m *priorityQueueMetrics) ObserveLatency(priority queue.Priority, latency time.Duration) {
+	MetricWorkqueuePriorityQueueDuration.WithLabelValues(m.name, priority.String()).Observe(latency.Seconds())
+}
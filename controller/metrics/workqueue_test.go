@@ -0,0 +1,19 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/utils/queue"
+)
+
+func TestPriorityQueueMetrics(t *testing.T) {
+	m := NewPriorityQueueMetrics("Rollouts")
+	m.SetDepth(queue.PriorityHigh, 3)
+	m.ObserveLatency(queue.PriorityLow, time.Second)
+	assert.Equal(t, float64(3), testutil.ToFloat64(MetricWorkqueuePriorityDepth.WithLabelValues("Rollouts", "high")))
+	assert.Equal(t, 1, testutil.CollectAndCount(MetricWorkqueuePriorityQueueDuration))
+}
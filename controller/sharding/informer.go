@@ -0,0 +1,285 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RegisterInformers registers in the informer factory the informers of the given namespaced types, such as
+// *appsv1.ReplicaSet, so that they only cache the objects of the namespaces owned by this replica. It must be called
+// before the informers are obtained from the factory, and is a no-op without sharding. The tweakListOptions must be
+// the ones of the factory.
+func (m *Manager) RegisterInformers(factory kubeinformers.SharedInformerFactory, tweakListOptions func(*metav1.ListOptions), objs ...runtime.Object) {
+	if m.shards == 0 {
+		return
+	}
+	for _, obj := range objs {
+		obj := obj
+		factory.InformerFor(obj, func(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+			restClient, resource := restClientFor(client, obj)
+			lw := cache.NewFilteredListWatchFromClient(restClient, resource, metav1.NamespaceAll, tweakListOptions)
+			return cache.NewSharedIndexInformer(m.ListerWatcher(lw), obj, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		})
+	}
+}
+
+func restClientFor(client kubernetes.Interface, obj runtime.Object) (rest.Interface, string) {
+	switch obj.(type) {
+	case *appsv1.ReplicaSet:
+		return client.AppsV1().RESTClient(), "replicasets"
+	case *appsv1.StatefulSet:
+		return client.AppsV1().RESTClient(), "statefulsets"
+	case *appsv1.DaemonSet:
+		return client.AppsV1().RESTClient(), "daemonsets"
+	case *appsv1.ControllerRevision:
+		return client.AppsV1().RESTClient(), "controllerrevisions"
+	case *corev1.Pod:
+		return client.CoreV1().RESTClient(), "pods"
+	case *corev1.Service:
+		return client.CoreV1().RESTClient(), "services"
+	case *corev1.ConfigMap:
+		return client.CoreV1().RESTClient(), "configmaps"
+	case *corev1.Secret:
+		return client.CoreV1().RESTClient(), "secrets"
+	case *networkingv1.Ingress:
+		return client.NetworkingV1().RESTClient(), "ingresses"
+	case *extensionsv1beta1.Ingress:
+		return client.ExtensionsV1beta1().RESTClient(), "ingresses"
+	}
+	panic(fmt.Sprintf("sharded informers of %T are not supported", obj))
+}
+
+// ListerWatcher wraps the lister and watcher of an informer so that it only caches the objects of the namespaces
+// owned by this replica. The watches are closed with an expired error every time the owned namespaces change, so
+// that the informer relists the objects of the namespaces it gained and forgets those of the namespaces it lost.
+func (m *Manager) ListerWatcher(lw cache.ListerWatcher) cache.ListerWatcher {
+	if m.shards == 0 {
+		return lw
+	}
+	return &listerWatcher{lw: lw, filter: &listWatchFilter{manager: m}}
+}
+
+type listerWatcher struct {
+	lw     cache.ListerWatcher
+	filter *listWatchFilter
+}
+
+func (l *listerWatcher) List(options metav1.ListOptions) (runtime.Object, error) {
+	return l.filter.list(options, func() (runtime.Object, error) {
+		return l.lw.List(options)
+	})
+}
+
+func (l *listerWatcher) Watch(options metav1.ListOptions) (watch.Interface, error) {
+	return l.filter.watch(l.lw.Watch(options))
+}
+
+// DynamicClient wraps a dynamic client so that the informers created from it only cache the objects of the
+// namespaces owned by this replica, like ListerWatcher. It returns the client as is without sharding.
+func (m *Manager) DynamicClient(client dynamic.Interface) dynamic.Interface {
+	if m.shards == 0 {
+		return client
+	}
+	return &dynamicClient{Interface: client, manager: m, filters: map[string]*listWatchFilter{}}
+}
+
+type dynamicClient struct {
+	dynamic.Interface
+	manager *Manager
+
+	lock sync.Mutex
+	// filters holds the filter of each informer, identified by the resource, namespace and selectors it lists
+	filters map[string]*listWatchFilter
+}
+
+func (c *dynamicClient) filterFor(gvr schema.GroupVersionResource, namespace string, options metav1.ListOptions) *listWatchFilter {
+	key := strings.Join([]string{gvr.String(), namespace, options.LabelSelector, options.FieldSelector}, "|")
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	filter, ok := c.filters[key]
+	if !ok {
+		filter = &listWatchFilter{manager: c.manager}
+		c.filters[key] = filter
+	}
+	return filter
+}
+
+func (c *dynamicClient) Resource(gvr schema.GroupVersionResource) dynamic.NamespaceableResourceInterface {
+	return &dynamicNamespaceableResource{NamespaceableResourceInterface: c.Interface.Resource(gvr), client: c, gvr: gvr}
+}
+
+type dynamicNamespaceableResource struct {
+	dynamic.NamespaceableResourceInterface
+	client *dynamicClient
+	gvr    schema.GroupVersionResource
+}
+
+func (r *dynamicNamespaceableResource) Namespace(namespace string) dynamic.ResourceInterface {
+	return &dynamicResource{
+		ResourceInterface: r.NamespaceableResourceInterface.Namespace(namespace),
+		client:            r.client,
+		gvr:               r.gvr,
+		namespace:         namespace,
+	}
+}
+
+type dynamicResource struct {
+	dynamic.ResourceInterface
+	client    *dynamicClient
+	gvr       schema.GroupVersionResource
+	namespace string
+}
+
+func (r *dynamicResource) List(ctx context.Context, options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	list, err := r.client.filterFor(r.gvr, r.namespace, options).list(options, func() (runtime.Object, error) {
+		return r.ResourceInterface.List(ctx, options)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.(*unstructured.UnstructuredList), nil
+}
+
+func (r *dynamicResource) Watch(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+	return r.client.filterFor(r.gvr, r.namespace, options).watch(r.ResourceInterface.Watch(ctx, options))
+}
+
+// listWatchFilter filters the objects listed and watched by an informer. It keeps the change notification channel
+// of the manager as of the last list, so that a watch started after the owned namespaces changed is closed
+// right away.
+type listWatchFilter struct {
+	manager *Manager
+
+	lock    sync.Mutex
+	changed <-chan struct{}
+}
+
+func (f *listWatchFilter) list(options metav1.ListOptions, list func() (runtime.Object, error)) (runtime.Object, error) {
+	changed := f.manager.changedCh()
+	obj, err := list()
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		return nil, err
+	}
+	var cached []runtime.Object
+	for _, item := range items {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+		if f.manager.Caches(accessor.GetNamespace()) {
+			cached = append(cached, item)
+		}
+	}
+	if err := meta.SetList(obj, cached); err != nil {
+		return nil, err
+	}
+	// the later pages of a list are consistent with its first page
+	if options.Continue == "" {
+		f.lock.Lock()
+		f.changed = changed
+		f.lock.Unlock()
+	}
+	return obj, nil
+}
+
+func (f *listWatchFilter) watch(source watch.Interface, err error) (watch.Interface, error) {
+	if err != nil {
+		return nil, err
+	}
+	f.lock.Lock()
+	changed := f.changed
+	f.lock.Unlock()
+	if changed == nil {
+		changed = f.manager.changedCh()
+	}
+	w := &filteredWatch{
+		source: source,
+		result: make(chan watch.Event),
+		stop:   make(chan struct{}),
+	}
+	go w.run(f.manager.Caches, changed)
+	return w, nil
+}
+
+// filteredWatch forwards the events of the objects of the cached namespaces, until the owned namespaces change
+type filteredWatch struct {
+	source   watch.Interface
+	result   chan watch.Event
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func (w *filteredWatch) run(caches func(namespace string) bool, changed <-chan struct{}) {
+	defer close(w.result)
+	defer w.source.Stop()
+	for {
+		select {
+		case <-w.stop:
+			return
+		case <-changed:
+			w.send(watch.Event{Type: watch.Error, Object: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusGone,
+				Reason:  metav1.StatusReasonExpired,
+				Message: "the namespaces owned by the controller replica changed",
+			}})
+			return
+		case event, ok := <-w.source.ResultChan():
+			if !ok {
+				return
+			}
+			switch event.Type {
+			case watch.Added, watch.Modified, watch.Deleted:
+				if accessor, err := meta.Accessor(event.Object); err == nil && !caches(accessor.GetNamespace()) {
+					continue
+				}
+			}
+			if !w.send(event) {
+				return
+			}
+		}
+	}
+}
+
+func (w *filteredWatch) send(event watch.Event) bool {
+	select {
+	case w.result <- event:
+		return true
+	case <-w.stop:
+		return false
+	}
+}
+
+func (w *filteredWatch) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+}
+
+func (w *filteredWatch) ResultChan() <-chan watch.Event {
+	return w.result
+}
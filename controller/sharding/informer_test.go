@@ -0,0 +1,157 @@
+package sharding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+// namespacesOfShards returns a namespace of the first shard and a namespace of another shard
+func namespacesOfShards() (string, string) {
+	owned, other := "owned", "other"
+	for ShardForNamespace(other, 4) == ShardForNamespace(owned, 4) {
+		other = other + "x"
+	}
+	return owned, other
+}
+
+func newPod(namespace, name string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+}
+
+func podListWatch(client kubernetes.Interface) *cache.ListWatch {
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return client.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return client.CoreV1().Pods(metav1.NamespaceAll).Watch(context.TODO(), options)
+		},
+	}
+}
+
+func TestListerWatcherWithoutSharding(t *testing.T) {
+	lw := podListWatch(fake.NewSimpleClientset())
+	assert.Same(t, lw, NewManager(fake.NewSimpleClientset(), 0).ListerWatcher(lw))
+}
+
+func TestListerWatcherFiltersNamespaces(t *testing.T) {
+	owned, other := namespacesOfShards()
+	client := fake.NewSimpleClientset(newPod(owned, "a"), newPod(other, "b"))
+	now := time.Now()
+	m := newTestManager(client, "a", &now)
+	m.renewedAt[ShardForNamespace(owned, 4)] = now
+	lw := m.ListerWatcher(podListWatch(client))
+
+	list, err := lw.List(metav1.ListOptions{})
+	require.NoError(t, err)
+	pods := list.(*corev1.PodList).Items
+	require.Len(t, pods, 1)
+	assert.Equal(t, "a", pods[0].Name)
+
+	w, err := lw.Watch(metav1.ListOptions{})
+	require.NoError(t, err)
+	defer w.Stop()
+	_, err = client.CoreV1().Pods(other).Create(context.TODO(), newPod(other, "c"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = client.CoreV1().Pods(owned).Create(context.TODO(), newPod(owned, "d"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	event := <-w.ResultChan()
+	assert.Equal(t, watch.Added, event.Type)
+	assert.Equal(t, "d", event.Object.(*corev1.Pod).Name)
+
+	// the watch expires once the owned shards change, so that the informer relists
+	m.notifyChanged()
+	event = <-w.ResultChan()
+	assert.Equal(t, watch.Error, event.Type)
+	assert.True(t, k8serrors.IsResourceExpired(k8serrors.FromObject(event.Object)))
+	_, ok := <-w.ResultChan()
+	assert.False(t, ok)
+}
+
+func TestListerWatcherExpiresWatchesOfChangesSinceList(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	m := newTestManager(client, "a", &now)
+	lw := m.ListerWatcher(podListWatch(client))
+
+	_, err := lw.List(metav1.ListOptions{})
+	require.NoError(t, err)
+	m.notifyChanged()
+	w, err := lw.Watch(metav1.ListOptions{})
+	require.NoError(t, err)
+	event := <-w.ResultChan()
+	assert.Equal(t, watch.Error, event.Type)
+}
+
+func TestInformerRelistsAcquiredShards(t *testing.T) {
+	owned, other := namespacesOfShards()
+	client := fake.NewSimpleClientset(newPod(owned, "a"), newPod(other, "b"))
+	now := time.Now()
+	m := newTestManager(client, "a", &now)
+	m.renewedAt[ShardForNamespace(owned, 4)] = now
+	informer := cache.NewSharedIndexInformer(m.ListerWatcher(podListWatch(client)), &corev1.Pod{}, 0, cache.Indexers{})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go informer.Run(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), informer.HasSynced))
+	assert.Equal(t, []string{owned + "/a"}, informer.GetStore().ListKeys())
+
+	m.lock.Lock()
+	m.renewedAt[ShardForNamespace(other, 4)] = now
+	delete(m.renewedAt, ShardForNamespace(owned, 4))
+	m.lock.Unlock()
+	m.notifyChanged()
+	assert.Eventually(t, func() bool {
+		keys := informer.GetStore().ListKeys()
+		return len(keys) == 1 && keys[0] == other+"/b"
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestDynamicClientFiltersNamespaces(t *testing.T) {
+	owned, other := namespacesOfShards()
+	gvr := schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+	newRollout := func(namespace, name string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion("argoproj.io/v1alpha1")
+		obj.SetKind("Rollout")
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		return obj
+	}
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "RolloutList"},
+		newRollout(owned, "a"), newRollout(other, "b"))
+	now := time.Now()
+	m := newTestManager(fake.NewSimpleClientset(), "a", &now)
+	m.renewedAt[ShardForNamespace(owned, 4)] = now
+	resource := m.DynamicClient(client).Resource(gvr).Namespace(metav1.NamespaceAll)
+
+	list, err := resource.List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	assert.Equal(t, "a", list.Items[0].GetName())
+
+	w, err := resource.Watch(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	defer w.Stop()
+	_, err = client.Resource(gvr).Namespace(other).Create(context.TODO(), newRollout(other, "c"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	_, err = client.Resource(gvr).Namespace(owned).Create(context.TODO(), newRollout(owned, "d"), metav1.CreateOptions{})
+	require.NoError(t, err)
+	event := <-w.ResultChan()
+	assert.Equal(t, "d", event.Object.(*unstructured.Unstructured).GetName())
+}
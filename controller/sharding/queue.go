@@ -0,0 +1,55 @@
+package sharding
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// queue is a workqueue which drops the namespace/name keys of objects which belong to shards not owned by this
+// replica. The informers feeding the queue only cache the objects of the owned shards, but the keys of a shard may
+// still be queued by its previous owner, or through the objects of another namespace.
+type queue struct {
+	workqueue.RateLimitingInterface
+	manager *Manager
+}
+
+// NewQueue wraps a workqueue so that only the objects of the shards owned by this replica are queued and processed
+func NewQueue(q workqueue.RateLimitingInterface, manager *Manager) workqueue.RateLimitingInterface {
+	return &queue{RateLimitingInterface: q, manager: manager}
+}
+
+func (q *queue) owns(item any) bool {
+	key, ok := item.(string)
+	return !ok || q.manager.OwnsKey(key)
+}
+
+func (q *queue) Add(item any) {
+	if q.owns(item) {
+		q.RateLimitingInterface.Add(item)
+	}
+}
+
+func (q *queue) AddAfter(item any, duration time.Duration) {
+	if q.owns(item) {
+		q.RateLimitingInterface.AddAfter(item, duration)
+	}
+}
+
+func (q *queue) AddRateLimited(item any) {
+	if q.owns(item) {
+		q.RateLimitingInterface.AddRateLimited(item)
+	}
+}
+
+// Get skips the keys queued before their shard was released or lost by this replica
+func (q *queue) Get() (any, bool) {
+	for {
+		item, shutdown := q.RateLimitingInterface.Get()
+		if shutdown || q.owns(item) {
+			return item, shutdown
+		}
+		q.RateLimitingInterface.Forget(item)
+		q.RateLimitingInterface.Done(item)
+	}
+}
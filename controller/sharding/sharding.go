@@ -0,0 +1,522 @@
+package sharding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/controller/metrics"
+)
+
+const (
+	// ShardLabelKey is the label of a Namespace which assigns the objects of the namespace to an explicit shard,
+	// instead of the shard derived from the hash of the namespace name
+	ShardLabelKey = "argo-rollouts.argoproj.io/shard"
+	// MemberLabelKey is the label of the Leases through which the controller replicas advertise their membership
+	MemberLabelKey = "argo-rollouts.argoproj.io/controller-member"
+
+	shardLeaseNameFormat  = "argo-rollouts-controller-shard-%d"
+	memberLeaseNamePrefix = "argo-rollouts-controller-member-"
+)
+
+// Options configures the Leases through which the controller replicas claim the shards
+type Options struct {
+	// Namespace is the namespace of the shard and membership Leases
+	Namespace string
+	// Name identifies the controller replica across restarts, such as the name of its pod. It names the membership
+	// Lease of the replica.
+	Name string
+	// Identity uniquely identifies the running controller replica
+	Identity string
+	// LeaseDuration is the duration after which the Lease of a shard which is not renewed can be claimed by
+	// another replica
+	LeaseDuration time.Duration
+	// RenewDeadline is the duration after which a replica stops processing a shard it failed to renew
+	RenewDeadline time.Duration
+	// RetryPeriod is the interval between attempts to renew, claim and rebalance shards
+	RetryPeriod time.Duration
+}
+
+// Manager claims shards of the namespaces through per-shard Leases, so that controller replicas work in parallel
+// on disjoint sets of namespaces. The shards are rebalanced as replicas join or leave: each replica holds at most
+// its fair share of the shards, releasing the extra shards for other replicas to claim.
+type Manager struct {
+	kubeclientset kubernetes.Interface
+	// shards is the number of shards the namespaces are split into. Sharding is disabled when zero.
+	shards int
+	now    func() time.Time
+
+	lock            sync.RWMutex
+	opts            Options
+	namespaceLister corelisters.NamespaceLister
+	// renewedAt holds the last renewal of the Lease of each owned shard
+	renewedAt map[int]time.Time
+	members   int
+	// changed is closed and replaced every time the shards owned by this replica or the shard of a namespace change
+	changed chan struct{}
+}
+
+// NewManager returns a shard manager which splits the namespaces into the given number of shards. Without shards,
+// the manager owns every namespace. Otherwise it owns none until it is started and claims shards.
+func NewManager(kubeclientset kubernetes.Interface, shards int) *Manager {
+	return &Manager{
+		kubeclientset: kubeclientset,
+		shards:        shards,
+		now:           time.Now,
+		renewedAt:     map[int]time.Time{},
+		changed:       make(chan struct{}),
+	}
+}
+
+// Start validates the options and starts claiming shards in the background until the context is cancelled. Like
+// leader election, the shard Leases are not released on cancellation: a replica may still be processing its shards
+// at that time, so they are claimed by other replicas once the Leases expire. The membership Lease is deleted
+// though, so that the other replicas rebalance the shards without waiting for it to expire. The returned channel
+// is closed once the membership Lease is deleted.
+func (m *Manager) Start(ctx context.Context, opts Options) (<-chan struct{}, error) {
+	if m.shards <= 0 {
+		return nil, fmt.Errorf("number of shards must be greater than zero")
+	}
+	if opts.Name == "" {
+		return nil, fmt.Errorf("name of the controller replica is empty")
+	}
+	if opts.Identity == "" {
+		return nil, fmt.Errorf("identity of the controller replica is empty")
+	}
+	if opts.Namespace == "" {
+		return nil, fmt.Errorf("namespace of the shard leases is empty")
+	}
+	if opts.RenewDeadline <= 0 || opts.RenewDeadline > opts.LeaseDuration {
+		return nil, fmt.Errorf("renew deadline must be greater than zero and less than or equal to the lease duration")
+	}
+	if opts.RetryPeriod <= 0 || opts.RetryPeriod >= opts.RenewDeadline {
+		return nil, fmt.Errorf("retry period must be greater than zero and less than the renew deadline")
+	}
+
+	informerFactory := kubeinformers.NewSharedInformerFactory(m.kubeclientset, 0)
+	namespaceInformer := informerFactory.Core().V1().Namespaces()
+	_, err := namespaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj any, isInInitialList bool) {
+			// the objects of a namespace may be observed before the namespace itself
+			if !isInInitialList && hasShardLabel(obj) {
+				m.notifyChanged()
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			if shardLabelOf(oldObj) != shardLabelOf(newObj) {
+				m.notifyChanged()
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.lock.Lock()
+	m.opts = opts
+	m.namespaceLister = namespaceInformer.Lister()
+	m.lock.Unlock()
+
+	informerFactory.Start(ctx.Done())
+	if ok := cache.WaitForCacheSync(ctx.Done(), namespaceInformer.Informer().HasSynced); !ok {
+		return nil, fmt.Errorf("failed to wait for namespace caches to sync")
+	}
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		wait.UntilWithContext(ctx, m.sync, opts.RetryPeriod)
+		m.deleteMembership()
+	}()
+	return stopped, nil
+}
+
+func shardLabelOf(obj any) string {
+	if ns, ok := obj.(*corev1.Namespace); ok {
+		return ns.Labels[ShardLabelKey]
+	}
+	return ""
+}
+
+func hasShardLabel(obj any) bool {
+	return shardLabelOf(obj) != ""
+}
+
+// ShardForNamespace returns the shard of a namespace from the hash of its name
+func ShardForNamespace(namespace string, shards int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace))
+	return int(h.Sum32() % uint32(shards))
+}
+
+// ShardOf returns the shard of a namespace, honoring the shard label of the namespace
+func (m *Manager) ShardOf(namespace string) int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.shardOf(namespace)
+}
+
+func (m *Manager) shardOf(namespace string) int {
+	if m.shards == 0 {
+		return 0
+	}
+	if m.namespaceLister != nil {
+		if ns, err := m.namespaceLister.Get(namespace); err == nil {
+			if value, ok := ns.Labels[ShardLabelKey]; ok {
+				shard, err := strconv.Atoi(value)
+				if err == nil && shard >= 0 && shard < m.shards {
+					return shard
+				}
+				log.Warnf("Ignoring invalid shard label '%s' of namespace %s", value, namespace)
+			}
+		}
+	}
+	return ShardForNamespace(namespace, m.shards)
+}
+
+// Owns returns whether the objects of the namespace should be processed by this replica
+func (m *Manager) Owns(namespace string) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if m.shards == 0 {
+		return true
+	}
+	renewedAt, ok := m.renewedAt[m.shardOf(namespace)]
+	return ok && m.now().Sub(renewedAt) < m.opts.RenewDeadline
+}
+
+// Caches returns whether the informers cache the objects of the namespace: unlike Owns, the objects of a shard
+// which could not be renewed lately are still cached until the shard is lost or released. Cluster scoped objects
+// are always cached.
+func (m *Manager) Caches(namespace string) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if m.shards == 0 || namespace == "" {
+		return true
+	}
+	_, ok := m.renewedAt[m.shardOf(namespace)]
+	return ok
+}
+
+// OwnsKey returns whether the object of the namespace/name key should be processed by this replica
+func (m *Manager) OwnsKey(key string) bool {
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return true
+	}
+	return m.Owns(namespace)
+}
+
+// OwnedShards returns the shards owned by this replica
+func (m *Manager) OwnedShards() []int {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	var shards []int
+	for shard := 0; shard < m.shards; shard++ {
+		if _, ok := m.renewedAt[shard]; ok {
+			shards = append(shards, shard)
+		}
+	}
+	return shards
+}
+
+// changedCh returns a channel which is closed the next time the shards owned by this replica or the shard of a
+// namespace change
+func (m *Manager) changedCh() <-chan struct{} {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.changed
+}
+
+func (m *Manager) notifyChanged() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	close(m.changed)
+	m.changed = make(chan struct{})
+}
+
+// sync renews the membership of this replica and its shards, then rebalances the shards to the fair share of
+// every live replica
+func (m *Manager) sync(ctx context.Context) {
+	m.lock.RLock()
+	opts := m.opts
+	members := m.members
+	m.lock.RUnlock()
+	ownedBefore := m.OwnedShards()
+	defer func() {
+		if !reflect.DeepEqual(ownedBefore, m.OwnedShards()) {
+			m.notifyChanged()
+		}
+	}()
+
+	liveMembers, err := m.renewMembership(ctx)
+	if err != nil {
+		log.Warnf("Failed to renew shard membership: %v", err)
+	} else {
+		members = liveMembers
+	}
+	if members < 1 {
+		members = 1
+	}
+	target := (m.shards + members - 1) / members
+
+	var owned []int
+	for _, shard := range m.OwnedShards() {
+		if err := m.renewShard(ctx, shard); err != nil {
+			var notHolder *notHolderError
+			if !errors.As(err, &notHolder) {
+				// keep the shard until the renew deadline, after which it is no longer processed
+				log.Warnf("Failed to renew shard %d: %v", shard, err)
+				owned = append(owned, shard)
+				continue
+			}
+			log.Warnf("Lost shard %d: %v", shard, err)
+			m.dropShard(shard)
+			metrics.MetricControllerShardTransitionsTotal.WithLabelValues(strconv.Itoa(shard), "lost").Inc()
+			continue
+		}
+		owned = append(owned, shard)
+	}
+	for len(owned) > target {
+		shard := owned[len(owned)-1]
+		owned = owned[:len(owned)-1]
+		m.releaseShard(ctx, shard)
+	}
+	// start from an offset specific to this replica so that replicas do not all contend for the same shards
+	offset := ShardForNamespace(opts.Identity, m.shards)
+	for i := 0; i < m.shards && len(owned) < target; i++ {
+		shard := (offset + i) % m.shards
+		if m.isOwned(shard) {
+			continue
+		}
+		acquired, err := m.acquireShard(ctx, shard)
+		if err != nil {
+			log.Warnf("Failed to claim shard %d: %v", shard, err)
+			continue
+		}
+		if acquired {
+			owned = append(owned, shard)
+		}
+	}
+
+	m.lock.Lock()
+	m.members = members
+	m.lock.Unlock()
+	metrics.MetricControllerShardMembers.Set(float64(members))
+	for shard := 0; shard < m.shards; shard++ {
+		value := float64(0)
+		if m.isOwned(shard) {
+			value = 1
+		}
+		metrics.MetricControllerShardOwned.WithLabelValues(strconv.Itoa(shard)).Set(value)
+	}
+}
+
+func (m *Manager) isOwned(shard int) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	_, ok := m.renewedAt[shard]
+	return ok
+}
+
+func (m *Manager) dropShard(shard int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.renewedAt, shard)
+}
+
+func (m *Manager) leases() coordinationv1client.LeaseInterface {
+	return m.kubeclientset.CoordinationV1().Leases(m.opts.Namespace)
+}
+
+func (m *Manager) memberLeaseName() string {
+	return memberLeaseNamePrefix + m.opts.Name
+}
+
+// renewMembership renews the membership Lease of this replica and returns the number of live replicas. The Lease
+// is named after the replica, so that a restarted replica takes over the Lease of its previous run.
+func (m *Manager) renewMembership(ctx context.Context) (int, error) {
+	now := metav1.NewMicroTime(m.now())
+	lease, err := m.leases().Get(ctx, m.memberLeaseName(), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		lease = m.newLease(m.memberLeaseName(), now)
+		lease.Labels = map[string]string{MemberLabelKey: "true"}
+		_, err = m.leases().Create(ctx, lease, metav1.CreateOptions{})
+	} else if err == nil {
+		lease.Spec.HolderIdentity = &m.opts.Identity
+		lease.Spec.RenewTime = &now
+		_, err = m.leases().Update(ctx, lease, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	list, err := m.leases().List(ctx, metav1.ListOptions{LabelSelector: MemberLabelKey})
+	if err != nil {
+		return 0, err
+	}
+	members := 0
+	for i := range list.Items {
+		if m.isHeld(&list.Items[i]) {
+			members++
+		}
+	}
+	return members, nil
+}
+
+// deleteMembership deletes the membership Lease of this replica once it stops
+func (m *Manager) deleteMembership() {
+	ctx, cancel := context.WithTimeout(context.Background(), m.opts.RenewDeadline)
+	defer cancel()
+	err := m.leases().Delete(ctx, m.memberLeaseName(), metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		log.Warnf("Failed to delete the membership lease: %v", err)
+	}
+}
+
+// renewShard renews the Lease of an owned shard
+func (m *Manager) renewShard(ctx context.Context, shard int) error {
+	lease, err := m.leases().Get(ctx, shardLeaseName(shard), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !m.isHolder(lease) {
+		return &notHolderError{holder: holderOf(lease)}
+	}
+	now := m.now()
+	renewTime := metav1.NewMicroTime(now)
+	lease.Spec.RenewTime = &renewTime
+	if _, err := m.leases().Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	m.lock.Lock()
+	m.renewedAt[shard] = now
+	m.lock.Unlock()
+	return nil
+}
+
+// acquireShard claims the Lease of a shard unless it is held by another replica
+func (m *Manager) acquireShard(ctx context.Context, shard int) (bool, error) {
+	now := m.now()
+	microNow := metav1.NewMicroTime(now)
+	name := shardLeaseName(shard)
+	lease, err := m.leases().Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = m.leases().Create(ctx, m.newLease(name, microNow), metav1.CreateOptions{})
+	} else if err == nil {
+		if !m.isHolder(lease) && m.isHeld(lease) {
+			return false, nil
+		}
+		transitions := int32(0)
+		if lease.Spec.LeaseTransitions != nil {
+			transitions = *lease.Spec.LeaseTransitions
+		}
+		if !m.isHolder(lease) {
+			transitions++
+		}
+		leaseDurationSeconds := int32(m.opts.LeaseDuration.Seconds())
+		lease.Spec.HolderIdentity = &m.opts.Identity
+		lease.Spec.LeaseDurationSeconds = &leaseDurationSeconds
+		lease.Spec.AcquireTime = &microNow
+		lease.Spec.RenewTime = &microNow
+		lease.Spec.LeaseTransitions = &transitions
+		_, err = m.leases().Update(ctx, lease, metav1.UpdateOptions{})
+	}
+	if k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err) {
+		// another replica claimed the shard first
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	log.Infof("Acquired shard %d", shard)
+	metrics.MetricControllerShardTransitionsTotal.WithLabelValues(strconv.Itoa(shard), "acquired").Inc()
+	m.lock.Lock()
+	m.renewedAt[shard] = now
+	m.lock.Unlock()
+	return true, nil
+}
+
+// releaseShard stops processing a shard and clears the holder of its Lease, for another replica to claim it
+func (m *Manager) releaseShard(ctx context.Context, shard int) {
+	m.dropShard(shard)
+	log.Infof("Released shard %d", shard)
+	metrics.MetricControllerShardTransitionsTotal.WithLabelValues(strconv.Itoa(shard), "released").Inc()
+	lease, err := m.leases().Get(ctx, shardLeaseName(shard), metav1.GetOptions{})
+	if err != nil || !m.isHolder(lease) {
+		return
+	}
+	lease.Spec.HolderIdentity = nil
+	if _, err := m.leases().Update(ctx, lease, metav1.UpdateOptions{}); err != nil {
+		log.Warnf("Failed to release the lease of shard %d: %v", shard, err)
+	}
+}
+
+func (m *Manager) newLease(name string, now metav1.MicroTime) *coordinationv1.Lease {
+	leaseDurationSeconds := int32(m.opts.LeaseDuration.Seconds())
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: m.opts.Namespace,
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &m.opts.Identity,
+			LeaseDurationSeconds: &leaseDurationSeconds,
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	}
+}
+
+// isHolder returns whether the Lease is held by this replica
+func (m *Manager) isHolder(lease *coordinationv1.Lease) bool {
+	return holderOf(lease) == m.opts.Identity
+}
+
+// isHeld returns whether the Lease is held by a replica which renewed it within the lease duration
+func (m *Manager) isHeld(lease *coordinationv1.Lease) bool {
+	if holderOf(lease) == "" || lease.Spec.RenewTime == nil {
+		return false
+	}
+	leaseDuration := m.opts.LeaseDuration
+	if lease.Spec.LeaseDurationSeconds != nil {
+		leaseDuration = time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second
+	}
+	return lease.Spec.RenewTime.Add(leaseDuration).After(m.now())
+}
+
+// notHolderError is returned when renewing the Lease of a shard which was claimed by another replica
+type notHolderError struct {
+	holder string
+}
+
+func (e *notHolderError) Error() string {
+	return fmt.Sprintf("lease is held by '%s'", e.holder)
+}
+
+func holderOf(lease *coordinationv1.Lease) string {
+	if lease.Spec.HolderIdentity == nil {
+		return ""
+	}
+	return *lease.Spec.HolderIdentity
+}
+
+func shardLeaseName(shard int) string {
+	return fmt.Sprintf(shardLeaseNameFormat, shard)
+}
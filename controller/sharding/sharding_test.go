@@ -0,0 +1,240 @@
+package sharding
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func newTestManager(client kubernetes.Interface, id string, now *time.Time) *Manager {
+	m := NewManager(client, 4)
+	m.now = func() time.Time { return *now }
+	m.opts = Options{
+		Namespace:     "argo-rollouts",
+		Name:          "pod-" + id,
+		Identity:      id,
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+	}
+	return m
+}
+
+func TestOwnsWithoutSharding(t *testing.T) {
+	m := NewManager(fake.NewSimpleClientset(), 0)
+	assert.True(t, m.Owns("default"))
+	assert.True(t, m.OwnsKey("default/guestbook"))
+	assert.True(t, m.Caches("default"))
+	assert.Equal(t, 0, m.ShardOf("default"))
+}
+
+func TestShardOf(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "pinned", Labels: map[string]string{ShardLabelKey: "3"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "invalid", Labels: map[string]string{ShardLabelKey: "7"}}},
+	)
+	now := time.Now()
+	m := newTestManager(client, "a", &now)
+	informerFactory := kubeinformers.NewSharedInformerFactory(client, 0)
+	namespaceInformer := informerFactory.Core().V1().Namespaces()
+	for _, ns := range []string{"pinned", "invalid"} {
+		obj, err := client.CoreV1().Namespaces().Get(context.TODO(), ns, metav1.GetOptions{})
+		require.NoError(t, err)
+		require.NoError(t, namespaceInformer.Informer().GetIndexer().Add(obj))
+	}
+	m.namespaceLister = namespaceInformer.Lister()
+
+	assert.Equal(t, 3, m.ShardOf("pinned"))
+	assert.Equal(t, ShardForNamespace("invalid", 4), m.ShardOf("invalid"))
+	assert.Equal(t, ShardForNamespace("unknown", 4), m.ShardOf("unknown"))
+	assert.Equal(t, ShardForNamespace("unknown", 4), ShardForNamespace("unknown", 4))
+}
+
+func TestSyncClaimsAllShardsWhenAlone(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	m := newTestManager(client, "a", &now)
+	changed := m.changedCh()
+
+	m.sync(context.TODO())
+	assert.Equal(t, []int{0, 1, 2, 3}, m.OwnedShards())
+	assert.True(t, isClosed(changed))
+	for shard := 0; shard < 4; shard++ {
+		lease, err := client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), shardLeaseName(shard), metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "a", *lease.Spec.HolderIdentity)
+	}
+	assert.True(t, m.Owns("default"))
+
+	// renewing the same shards does not notify a change
+	changed = m.changedCh()
+	m.sync(context.TODO())
+	assert.False(t, isClosed(changed))
+
+	// shards are no longer processed once they could not be renewed within the renew deadline, but their objects
+	// stay cached until they are lost
+	now = now.Add(10 * time.Second)
+	assert.False(t, m.Owns("default"))
+	assert.True(t, m.Caches("default"))
+}
+
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestMembershipLease(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	m := newTestManager(client, "a", &now)
+	m.sync(context.TODO())
+	lease, err := client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), "argo-rollouts-controller-member-pod-a", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "a", *lease.Spec.HolderIdentity)
+
+	// a restarted replica takes over the Lease of its previous run
+	restarted := newTestManager(client, "a2", &now)
+	restarted.opts.Name = "pod-a"
+	members, err := restarted.renewMembership(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, 1, members)
+	lease, err = client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), "argo-rollouts-controller-member-pod-a", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "a2", *lease.Spec.HolderIdentity)
+
+	restarted.deleteMembership()
+	_, err = client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), "argo-rollouts-controller-member-pod-a", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestStartDeletesMembershipLeaseOnStop(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	m := NewManager(client, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped, err := m.Start(ctx, Options{
+		Namespace:     "argo-rollouts",
+		Name:          "pod-a",
+		Identity:      "a",
+		LeaseDuration: 15 * time.Second,
+		RenewDeadline: 10 * time.Second,
+		RetryPeriod:   2 * time.Second,
+	})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, err := client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), "argo-rollouts-controller-member-pod-a", metav1.GetOptions{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-stopped
+	_, err = client.CoordinationV1().Leases("argo-rollouts").Get(context.TODO(), "argo-rollouts-controller-member-pod-a", metav1.GetOptions{})
+	assert.True(t, k8serrors.IsNotFound(err))
+}
+
+func TestSyncRebalancesShards(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	a := newTestManager(client, "a", &now)
+	b := newTestManager(client, "b", &now)
+
+	a.sync(context.TODO())
+	assert.Len(t, a.OwnedShards(), 4)
+
+	// b joins, but every shard is held by a
+	b.sync(context.TODO())
+	assert.Empty(t, b.OwnedShards())
+
+	// a releases the shards above its fair share
+	a.sync(context.TODO())
+	assert.Len(t, a.OwnedShards(), 2)
+
+	b.sync(context.TODO())
+	assert.Len(t, b.OwnedShards(), 2)
+	assert.ElementsMatch(t, []int{0, 1, 2, 3}, append(a.OwnedShards(), b.OwnedShards()...))
+	for _, ns := range []string{"default", "team-a", "team-b", "team-c", "team-d"} {
+		assert.NotEqual(t, a.Owns(ns), b.Owns(ns))
+	}
+}
+
+func TestSyncReclaimsShardsOfLeftMember(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	a := newTestManager(client, "a", &now)
+	b := newTestManager(client, "b", &now)
+	a.sync(context.TODO())
+	b.sync(context.TODO())
+	a.sync(context.TODO())
+	b.sync(context.TODO())
+	require.Len(t, b.OwnedShards(), 2)
+
+	// a stops renewing its leases, which expire
+	now = now.Add(16 * time.Second)
+	b.sync(context.TODO())
+	b.sync(context.TODO())
+	assert.Equal(t, []int{0, 1, 2, 3}, b.OwnedShards())
+
+	// a loses the shards claimed by b
+	a.sync(context.TODO())
+	for _, shard := range a.OwnedShards() {
+		assert.NotContains(t, b.OwnedShards(), shard)
+	}
+}
+
+func TestQueueDropsKeysOfOtherShards(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	m := newTestManager(client, "a", &now)
+	m.renewedAt[ShardForNamespace("owned", 4)] = now
+	other := "other"
+	for i := 0; ShardForNamespace(other, 4) == ShardForNamespace("owned", 4); i++ {
+		other = other + "x"
+	}
+
+	q := NewQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()), m)
+	defer q.ShutDown()
+	q.Add("owned/foo")
+	q.Add(other + "/foo")
+	q.AddRateLimited(other + "/bar")
+	q.AddAfter(other+"/baz", 0)
+	assert.Equal(t, 1, q.Len())
+	item, _ := q.Get()
+	assert.Equal(t, "owned/foo", item)
+}
+
+func TestQueueSkipsKeysOfReleasedShards(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	m := newTestManager(client, "a", &now)
+	released := ShardForNamespace("released", 4)
+	m.renewedAt[released] = now
+	owned := "owned"
+	for i := 0; ShardForNamespace(owned, 4) == released; i++ {
+		owned = fmt.Sprintf("owned-%d", i)
+	}
+	m.renewedAt[ShardForNamespace(owned, 4)] = now
+
+	q := NewQueue(workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()), m)
+	defer q.ShutDown()
+	q.Add("released/foo")
+	q.Add(owned + "/foo")
+	m.dropShard(released)
+	item, _ := q.Get()
+	assert.Equal(t, owned+"/foo", item)
+	q.Done(item)
+	assert.Equal(t, 0, q.Len())
+}
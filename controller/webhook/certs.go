@@ -0,0 +1,296 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/strings/slices"
+)
+
+const (
+	// caCertKey is the key of the CA certificate in the certificates Secret
+	caCertKey = "ca.crt"
+
+	certValidity = 365 * 24 * time.Hour
+	// certRotationThreshold is the remaining validity below which the certificates are renewed
+	certRotationThreshold = 30 * 24 * time.Hour
+	certCheckInterval     = time.Hour
+)
+
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// certManager provisions a self-signed CA and the serving certificate of the admission webhook in a Secret shared
+// by all the controller replicas, renews them before they expire, and injects the CA into the webhook
+// configurations and the CRDs converted by the webhook
+type certManager struct {
+	kubeclientset            kubernetes.Interface
+	dynamicclientset         dynamic.Interface
+	namespace                string
+	serviceName              string
+	secretName               string
+	webhookConfigurationName string
+	now                      func() time.Time
+
+	lock sync.RWMutex
+	cert *tls.Certificate
+}
+
+func newCertManager(cfg ServerConfig) *certManager {
+	return &certManager{
+		kubeclientset:            cfg.KubeClientSet,
+		dynamicclientset:         cfg.DynamicClientSet,
+		namespace:                cfg.Namespace,
+		serviceName:              cfg.ServiceName,
+		secretName:               cfg.SecretName,
+		webhookConfigurationName: cfg.WebhookConfigurationName,
+		now:                      time.Now,
+	}
+}
+
+// run periodically renews the certificates until the context is cancelled
+func (m *certManager) run(ctx context.Context) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := m.reconcile(ctx); err != nil {
+			log.Warnf("Failed to reconcile webhook certificates: %v", err)
+		}
+	}, certCheckInterval)
+}
+
+func (m *certManager) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	if m.cert == nil {
+		return nil, fmt.Errorf("webhook certificates are not provisioned")
+	}
+	return m.cert, nil
+}
+
+// reconcile loads the certificates from the Secret, generating them when missing or about to expire, and injects
+// the CA into the webhook configurations and the CRDs
+func (m *certManager) reconcile(ctx context.Context) error {
+	secrets := m.kubeclientset.CoreV1().Secrets(m.namespace)
+	secret, err := secrets.Get(ctx, m.secretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		secret = nil
+	} else if err != nil {
+		return err
+	}
+	if secret == nil || !m.isValid(secret) {
+		data, err := m.generate()
+		if err != nil {
+			return err
+		}
+		if secret == nil {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: m.secretName, Namespace: m.namespace},
+				Type:       corev1.SecretTypeOpaque,
+				Data:       data,
+			}
+			secret, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+		} else {
+			secret.Data = data
+			secret, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+		}
+		if k8serrors.IsAlreadyExists(err) || k8serrors.IsConflict(err) {
+			// another replica renewed the certificates first
+			secret, err = secrets.Get(ctx, m.secretName, metav1.GetOptions{})
+		}
+		if err != nil {
+			return err
+		}
+		log.Infof("Generated webhook certificates in secret %s/%s", m.namespace, m.secretName)
+	}
+
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return err
+	}
+	m.lock.Lock()
+	m.cert = &cert
+	m.lock.Unlock()
+	if err := m.injectCABundle(ctx, secret.Data[caCertKey]); err != nil {
+		return err
+	}
+	return m.injectCRDCABundle(ctx, secret.Data[caCertKey])
+}
+
+// dnsNames returns the names of the Service of the admission webhook
+func (m *certManager) dnsNames() []string {
+	return []string{
+		m.serviceName,
+		fmt.Sprintf("%s.%s", m.serviceName, m.namespace),
+		fmt.Sprintf("%s.%s.svc", m.serviceName, m.namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", m.serviceName, m.namespace),
+	}
+}
+
+// isValid returns whether the Secret holds certificates for the Service which do not expire soon
+func (m *certManager) isValid(secret *corev1.Secret) bool {
+	if len(secret.Data[caCertKey]) == 0 || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		return false
+	}
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil {
+		return false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false
+	}
+	for _, name := range m.dnsNames() {
+		if !slices.Contains(cert.DNSNames, name) {
+			return false
+		}
+	}
+	return cert.NotAfter.Sub(m.now()) > certRotationThreshold
+}
+
+// generate returns the data of a Secret holding a new self-signed CA and a serving certificate signed by the CA
+func (m *certManager) generate() (map[string][]byte, error) {
+	notBefore := m.now().Add(-time.Hour)
+	notAfter := m.now().Add(certValidity)
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(notBefore.UnixNano()),
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%s-ca", m.serviceName)},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	dnsNames := m.dnsNames()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(notBefore.UnixNano() + 1),
+		Subject:      pkix.Name{CommonName: dnsNames[2]},
+		DNSNames:     dnsNames,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		caCertKey:               pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		corev1.TLSCertKey:       pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+// injectCABundle sets the CA of the webhooks of the validating and mutating webhook configurations, when installed
+func (m *certManager) injectCABundle(ctx context.Context, caBundle []byte) error {
+	admissionClient := m.kubeclientset.AdmissionregistrationV1()
+	validating, err := admissionClient.ValidatingWebhookConfigurations().Get(ctx, m.webhookConfigurationName, metav1.GetOptions{})
+	if err == nil {
+		changed := false
+		for i := range validating.Webhooks {
+			if !bytes.Equal(validating.Webhooks[i].ClientConfig.CABundle, caBundle) {
+				validating.Webhooks[i].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			_, err = admissionClient.ValidatingWebhookConfigurations().Update(ctx, validating, metav1.UpdateOptions{})
+		}
+	}
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to inject CA into validating webhook configuration: %w", err)
+	}
+
+	mutating, err := admissionClient.MutatingWebhookConfigurations().Get(ctx, m.webhookConfigurationName, metav1.GetOptions{})
+	if err == nil {
+		changed := false
+		for i := range mutating.Webhooks {
+			if !bytes.Equal(mutating.Webhooks[i].ClientConfig.CABundle, caBundle) {
+				mutating.Webhooks[i].ClientConfig.CABundle = caBundle
+				changed = true
+			}
+		}
+		if changed {
+			_, err = admissionClient.MutatingWebhookConfigurations().Update(ctx, mutating, metav1.UpdateOptions{})
+		}
+	}
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("failed to inject CA into mutating webhook configuration: %w", err)
+	}
+	return nil
+}
+
+// injectCRDCABundle sets the CA of the conversion webhook of the CRDs whose conversion strategy is Webhook. The CRDs
+// installed without the webhook keep the None strategy, and are left unchanged.
+func (m *certManager) injectCRDCABundle(ctx context.Context, caBundle []byte) error {
+	if m.dynamicclientset == nil {
+		return nil
+	}
+	encoded := base64.StdEncoding.EncodeToString(caBundle)
+	crdClient := m.dynamicclientset.Resource(crdGVR)
+	for _, name := range ConvertedCRDs {
+		crd, err := crdClient.Get(ctx, name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get CRD %s: %w", name, err)
+		}
+		strategy, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "strategy")
+		if strategy != "Webhook" {
+			continue
+		}
+		current, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "webhook", "clientConfig", "caBundle")
+		if current == encoded {
+			continue
+		}
+		if err := unstructured.SetNestedField(crd.Object, encoded, "spec", "conversion", "webhook", "clientConfig", "caBundle"); err != nil {
+			return err
+		}
+		if _, err := crdClient.Update(ctx, crd, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to inject CA into CRD %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestCertManager(objs ...runtime.Object) (*certManager, *fake.Clientset) {
+	client := fake.NewSimpleClientset(objs...)
+	m := newCertManager(ServerConfig{
+		Namespace:                "argo-rollouts",
+		ServiceName:              DefaultServiceName,
+		SecretName:               DefaultSecretName,
+		WebhookConfigurationName: DefaultWebhookConfigurationName,
+		KubeClientSet:            client,
+	})
+	return m, client
+}
+
+func parseCert(t *testing.T, data []byte) *x509.Certificate {
+	t.Helper()
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestReconcileProvisionsCertificates(t *testing.T) {
+	validating := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: DefaultWebhookConfigurationName},
+		Webhooks:   []admissionregistrationv1.ValidatingWebhook{{Name: "validate.rollouts.argoproj.io"}},
+	}
+	m, client := newTestCertManager(validating)
+	_, err := m.getCertificate(nil)
+	assert.Error(t, err)
+
+	require.NoError(t, m.reconcile(context.TODO()))
+	secret, err := client.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), DefaultSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	cert := parseCert(t, secret.Data[corev1.TLSCertKey])
+	assert.Contains(t, cert.DNSNames, "argo-rollouts-webhook.argo-rollouts.svc")
+	ca := parseCert(t, secret.Data[caCertKey])
+	assert.NoError(t, cert.CheckSignatureFrom(ca))
+
+	tlsCert, err := m.getCertificate(nil)
+	require.NoError(t, err)
+	assert.NotNil(t, tlsCert)
+
+	// the CA is injected in the validating webhook configuration, and the missing mutating one is ignored
+	validating, err = client.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(context.TODO(), DefaultWebhookConfigurationName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, secret.Data[caCertKey], validating.Webhooks[0].ClientConfig.CABundle)
+}
+
+func TestReconcileReusesValidCertificates(t *testing.T) {
+	m, client := newTestCertManager()
+	require.NoError(t, m.reconcile(context.TODO()))
+	secret, err := client.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), DefaultSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// another replica loads the certificates of the Secret
+	other, _ := newTestCertManager(secret)
+	require.NoError(t, other.reconcile(context.TODO()))
+	reused, err := other.kubeclientset.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), DefaultSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, secret.Data, reused.Data)
+}
+
+func TestReconcileRotatesCertificates(t *testing.T) {
+	// the Secret pre-created by the manifests is empty
+	empty := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: DefaultSecretName, Namespace: "argo-rollouts"}}
+	m, client := newTestCertManager(empty)
+	require.NoError(t, m.reconcile(context.TODO()))
+	secret, err := client.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), DefaultSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotEmpty(t, secret.Data[corev1.TLSCertKey])
+
+	// the certificates are renewed once they expire in less than 30 days
+	m.now = func() time.Time { return time.Now().Add(certValidity - 29*24*time.Hour) }
+	require.NoError(t, m.reconcile(context.TODO()))
+	rotated, err := client.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), DefaultSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.NotEqual(t, secret.Data[caCertKey], rotated.Data[caCertKey])
+	assert.True(t, parseCert(t, rotated.Data[corev1.TLSCertKey]).NotAfter.After(parseCert(t, secret.Data[corev1.TLSCertKey]).NotAfter))
+
+	// certificates of another Service are renewed
+	m.serviceName = "other"
+	assert.False(t, m.isValid(rotated))
+}
+
+func TestReconcileInjectsCRDCABundle(t *testing.T) {
+	newCRD := func(name, strategy string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "apiextensions.k8s.io/v1",
+			"kind":       "CustomResourceDefinition",
+			"metadata":   map[string]any{"name": name},
+			"spec":       map[string]any{"conversion": map[string]any{"strategy": strategy}},
+		}}
+	}
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(),
+		newCRD("rollouts.argoproj.io", "Webhook"),
+		newCRD("experiments.argoproj.io", "None"),
+	)
+	m, client := newTestCertManager()
+	m.dynamicclientset = dynamicClient
+	require.NoError(t, m.reconcile(context.TODO()))
+	secret, err := client.CoreV1().Secrets("argo-rollouts").Get(context.TODO(), DefaultSecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	crd, err := dynamicClient.Resource(crdGVR).Get(context.TODO(), "rollouts.argoproj.io", metav1.GetOptions{})
+	require.NoError(t, err)
+	caBundle, _, _ := unstructured.NestedString(crd.Object, "spec", "conversion", "webhook", "clientConfig", "caBundle")
+	assert.Equal(t, base64.StdEncoding.EncodeToString(secret.Data[caCertKey]), caBundle)
+
+	// the CRDs which are not converted by the webhook are left unchanged
+	crd, err = dynamicClient.Resource(crdGVR).Get(context.TODO(), "experiments.argoproj.io", metav1.GetOptions{})
+	require.NoError(t, err)
+	_, ok, _ := unstructured.NestedFieldNoCopy(crd.Object, "spec", "conversion", "webhook")
+	assert.False(t, ok)
+
+	// the CA is not updated again
+	dynamicClient.ClearActions()
+	require.NoError(t, m.reconcile(context.TODO()))
+	for _, action := range dynamicClient.Actions() {
+		assert.Equal(t, "get", action.GetVerb())
+	}
+}
@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+)
+
+// ConvertPath is the endpoint of the conversion webhook of the CRDs, which converts the objects between the
+// v1alpha1 and v1beta1 versions
+const ConvertPath = "/convert"
+
+// ConvertedCRDs are the names of the CRDs whose conversion webhook is served by the controller, and whose CA
+// bundle is kept in sync with the certificates of the webhook
+var ConvertedCRDs = []string{
+	rollouts.RolloutFullName,
+	rollouts.ExperimentFullName,
+	rollouts.AnalysisTemplateFullName,
+	rollouts.ClusterAnalysisTemplateFullName,
+	rollouts.AnalysisRunFullName,
+}
+
+// serveConversion decodes a ConversionReview, and responds with the objects converted to the desired version
+func (s *Server) serveConversion(w http.ResponseWriter, r *http.Request) {
+	var review apiextensionsv1.ConversionReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode conversion review: %v", err), http.StatusBadRequest)
+		return
+	}
+	if review.Request == nil {
+		http.Error(w, "conversion review has no request", http.StatusBadRequest)
+		return
+	}
+	review.Response = convert(review.Request)
+	review.Request = nil
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&review); err != nil {
+		log.Errorf("Failed to encode conversion review: %v", err)
+	}
+}
+
+// convert converts the objects of a conversion request, failing the whole request when any object cannot be
+// converted, as required by the API server
+func convert(req *apiextensionsv1.ConversionRequest) *apiextensionsv1.ConversionResponse {
+	response := &apiextensionsv1.ConversionResponse{UID: req.UID}
+	desired, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		return conversionFailed(response, err)
+	}
+	for _, obj := range req.Objects {
+		converted, err := convertObject(obj.Raw, desired)
+		if err != nil {
+			return conversionFailed(response, err)
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Raw: converted})
+	}
+	response.Result = metav1.Status{Status: metav1.StatusSuccess}
+	return response
+}
+
+// convertObject converts a serialized object to the desired version of its kind
+func convertObject(raw []byte, desired schema.GroupVersion) ([]byte, error) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+	gvk := typeMeta.GroupVersionKind()
+	if gvk.GroupVersion() == desired {
+		return raw, nil
+	}
+	if gvk.Group != desired.Group {
+		return nil, fmt.Errorf("cannot convert %s to %s", gvk.GroupVersion(), desired)
+	}
+	in, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, in); err != nil {
+		return nil, err
+	}
+	desiredGVK := desired.WithKind(gvk.Kind)
+	out, err := scheme.Scheme.New(desiredGVK)
+	if err != nil {
+		return nil, err
+	}
+	if err := scheme.Scheme.Convert(in, out, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert %s %s to %s: %w", gvk.Kind, objectName(in), desired, err)
+	}
+	out.GetObjectKind().SetGroupVersionKind(desiredGVK)
+	return json.Marshal(out)
+}
+
+func objectName(obj runtime.Object) string {
+	if meta, ok := obj.(metav1.Object); ok {
+		if meta.GetNamespace() != "" {
+			return meta.GetNamespace() + "/" + meta.GetName()
+		}
+		return meta.GetName()
+	}
+	return ""
+}
+
+func conversionFailed(response *apiextensionsv1.ConversionResponse, err error) *apiextensionsv1.ConversionResponse {
+	response.ConvertedObjects = nil
+	response.Result = metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+	return response
+}
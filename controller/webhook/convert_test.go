@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const alphaRollout = `{
+  "apiVersion": "argoproj.io/v1alpha1",
+  "kind": "Rollout",
+  "metadata": {"name": "guestbook", "namespace": "default", "labels": {"app": "guestbook"}},
+  "spec": {
+    "strategy": {
+      "canary": {
+        "steps": [{"setWeight": 10, "timeout": "1m"}],
+        "trafficRouting": {"managedRoutes": [{"name": "header"}]}
+      }
+    }
+  },
+  "status": {"phase": "Healthy"}
+}`
+
+func convertReview(t *testing.T, s *Server, desiredAPIVersion string, objs ...string) *apiextensionsv1.ConversionResponse {
+	t.Helper()
+	req := &apiextensionsv1.ConversionRequest{
+		UID:               types.UID("review-uid"),
+		DesiredAPIVersion: desiredAPIVersion,
+	}
+	for _, obj := range objs {
+		req.Objects = append(req.Objects, runtime.RawExtension{Raw: []byte(obj)})
+	}
+	body, err := json.Marshal(apiextensionsv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "ConversionReview"},
+		Request:  req,
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(s.Handler)
+	defer server.Close()
+	resp, err := http.Post(server.URL+ConvertPath, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var result apiextensionsv1.ConversionReview
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	require.NotNil(t, result.Response)
+	assert.Equal(t, req.UID, result.Response.UID)
+	return result.Response
+}
+
+func TestConvertRollout(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	resp := convertReview(t, s, "argoproj.io/v1beta1", alphaRollout)
+	require.Equal(t, metav1.StatusSuccess, resp.Result.Status, resp.Result.Message)
+	require.Len(t, resp.ConvertedObjects, 1)
+
+	var beta unstructured.Unstructured
+	require.NoError(t, beta.UnmarshalJSON(resp.ConvertedObjects[0].Raw))
+	assert.Equal(t, "argoproj.io/v1beta1", beta.GetAPIVersion())
+	assert.Equal(t, "Rollout", beta.GetKind())
+	assert.Equal(t, "guestbook", beta.GetName())
+	assert.Equal(t, map[string]string{"app": "guestbook"}, beta.GetLabels())
+	steps, _, _ := unstructured.NestedSlice(beta.Object, "spec", "strategy", "canary", "steps")
+	assert.Equal(t, "1m0s", steps[0].(map[string]any)["timeout"])
+	phase, _, _ := unstructured.NestedString(beta.Object, "status", "phase")
+	assert.Equal(t, "Healthy", phase)
+
+	// and back
+	resp = convertReview(t, s, "argoproj.io/v1alpha1", string(resp.ConvertedObjects[0].Raw))
+	require.Equal(t, metav1.StatusSuccess, resp.Result.Status, resp.Result.Message)
+	var alpha unstructured.Unstructured
+	require.NoError(t, alpha.UnmarshalJSON(resp.ConvertedObjects[0].Raw))
+	assert.Equal(t, "argoproj.io/v1alpha1", alpha.GetAPIVersion())
+	routes, _, _ := unstructured.NestedSlice(alpha.Object, "spec", "strategy", "canary", "trafficRouting", "managedRoutes")
+	assert.Equal(t, []any{map[string]any{"name": "header"}}, routes)
+	// the original durations are restored
+	steps, _, _ = unstructured.NestedSlice(alpha.Object, "spec", "strategy", "canary", "steps")
+	assert.Equal(t, "1m", steps[0].(map[string]any)["timeout"])
+	assert.Empty(t, alpha.GetAnnotations())
+}
+
+func TestConvertSameVersion(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	resp := convertReview(t, s, "argoproj.io/v1alpha1", alphaRollout)
+	require.Equal(t, metav1.StatusSuccess, resp.Result.Status)
+	assert.JSONEq(t, alphaRollout, string(resp.ConvertedObjects[0].Raw))
+}
+
+func TestConvertInvalidDuration(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	invalid := `{"apiVersion": "argoproj.io/v1alpha1", "kind": "Experiment", "metadata": {"name": "exp", "namespace": "default"}, "spec": {"duration": "forever"}}`
+	resp := convertReview(t, s, "argoproj.io/v1beta1", alphaRollout, invalid)
+	require.Equal(t, metav1.StatusSuccess, resp.Result.Status, resp.Result.Message)
+	require.Len(t, resp.ConvertedObjects, 2)
+	var beta unstructured.Unstructured
+	require.NoError(t, beta.UnmarshalJSON(resp.ConvertedObjects[1].Raw))
+	_, found, _ := unstructured.NestedFieldNoCopy(beta.Object, "spec", "duration")
+	assert.False(t, found)
+
+	resp = convertReview(t, s, "argoproj.io/v1alpha1", string(resp.ConvertedObjects[1].Raw))
+	require.Equal(t, metav1.StatusSuccess, resp.Result.Status, resp.Result.Message)
+	var alpha unstructured.Unstructured
+	require.NoError(t, alpha.UnmarshalJSON(resp.ConvertedObjects[0].Raw))
+	duration, _, _ := unstructured.NestedString(alpha.Object, "spec", "duration")
+	assert.Equal(t, "forever", duration)
+}
+
+func TestConvertFailure(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	invalid := `{"apiVersion": "argoproj.io/v1alpha1", "kind": "Experiment", "metadata": {"name": "exp", "namespace": "default"}, "spec": {"templates": "one"}}`
+	resp := convertReview(t, s, "argoproj.io/v1beta1", alphaRollout, invalid)
+	assert.Equal(t, metav1.StatusFailure, resp.Result.Status)
+	assert.Empty(t, resp.ConvertedObjects)
+
+	resp = convertReview(t, s, "apps/v1", alphaRollout)
+	assert.Equal(t, metav1.StatusFailure, resp.Result.Status)
+}
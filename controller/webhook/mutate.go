@@ -0,0 +1,76 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	admissionv1 "k8s.io/api/admission/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+// patchOperation is a JSON patch operation
+type patchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// mutate sets the defaults of the unset fields of Rollouts and Experiments, as assumed by the controller
+func (s *Server) mutate(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return allowed()
+	}
+	var patch []patchOperation
+	switch req.Kind.Kind {
+	case "Rollout":
+		var ro v1alpha1.Rollout
+		if err := json.Unmarshal(req.Object.Raw, &ro); err != nil {
+			return denied(err)
+		}
+		patch = defaultRollout(&ro)
+	case "Experiment":
+		var ex v1alpha1.Experiment
+		if err := json.Unmarshal(req.Object.Raw, &ex); err != nil {
+			return denied(err)
+		}
+		patch = defaultExperiment(&ex)
+	}
+	if len(patch) == 0 {
+		return allowed()
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return denied(err)
+	}
+	patchType := admissionv1.PatchTypeJSONPatch
+	response := allowed()
+	response.Patch = patchBytes
+	response.PatchType = &patchType
+	return response
+}
+
+func defaultRollout(ro *v1alpha1.Rollout) []patchOperation {
+	var patch []patchOperation
+	if ro.Spec.Replicas == nil {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/replicas", Value: defaults.GetReplicasOrDefault(nil)})
+	}
+	if ro.Spec.RevisionHistoryLimit == nil {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/revisionHistoryLimit", Value: defaults.GetRevisionHistoryLimitOrDefault(ro)})
+	}
+	if ro.Spec.ProgressDeadlineSeconds == nil {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/progressDeadlineSeconds", Value: defaults.GetProgressDeadlineSecondsOrDefault(ro)})
+	}
+	return patch
+}
+
+func defaultExperiment(ex *v1alpha1.Experiment) []patchOperation {
+	var patch []patchOperation
+	if ex.Spec.ProgressDeadlineSeconds == nil {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/progressDeadlineSeconds", Value: defaults.GetExperimentProgressDeadlineSecondsOrDefault(ex)})
+	}
+	if ex.Spec.ScaleDownDelaySeconds == nil {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/scaleDownDelaySeconds", Value: defaults.GetExperimentScaleDownDelaySecondsOrDefault(ex)})
+	}
+	return patch
+}
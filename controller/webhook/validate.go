@@ -0,0 +1,185 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
+)
+
+// validate validates the spec of an object, then the resources it references according to the reference policy.
+// Updates which do not change the spec are always admitted, so that the controller can update the metadata and
+// status of objects which are already invalid.
+func (s *Server) validate(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return allowed()
+	}
+	switch req.Kind.Kind {
+	case "Rollout":
+		var ro, oldRo v1alpha1.Rollout
+		if err := decode(req, &ro, &oldRo); err != nil {
+			return denied(err)
+		}
+		if req.Operation == admissionv1.Update && reflect.DeepEqual(ro.Spec, oldRo.Spec) {
+			return allowed()
+		}
+		if errs := validation.ValidateRollout(&ro); len(errs) > 0 {
+			return denied(invalidError("Rollout", ro.Name, errs))
+		}
+		if s.cfg.RolloutValidator == nil {
+			return allowed()
+		}
+		return s.validateReferences(ro.Namespace, func() error {
+			if err := s.cfg.RolloutValidator.ValidateRolloutReferences(&ro); err != nil {
+				return fmt.Errorf("Rollout \"%s\" is invalid: %w", ro.Name, err)
+			}
+			return nil
+		})
+	case "AnalysisTemplate":
+		var template, oldTemplate v1alpha1.AnalysisTemplate
+		if err := decode(req, &template, &oldTemplate); err != nil {
+			return denied(err)
+		}
+		if req.Operation == admissionv1.Update && reflect.DeepEqual(template.Spec, oldTemplate.Spec) {
+			return allowed()
+		}
+		return s.validateAnalysisTemplate("AnalysisTemplate", template.Namespace, template.Name, template.Spec)
+	case "ClusterAnalysisTemplate":
+		var template, oldTemplate v1alpha1.ClusterAnalysisTemplate
+		if err := decode(req, &template, &oldTemplate); err != nil {
+			return denied(err)
+		}
+		if req.Operation == admissionv1.Update && reflect.DeepEqual(template.Spec, oldTemplate.Spec) {
+			return allowed()
+		}
+		return s.validateAnalysisTemplate("ClusterAnalysisTemplate", "", template.Name, template.Spec)
+	case "Experiment":
+		var ex, oldEx v1alpha1.Experiment
+		if err := decode(req, &ex, &oldEx); err != nil {
+			return denied(err)
+		}
+		if req.Operation == admissionv1.Update && reflect.DeepEqual(ex.Spec, oldEx.Spec) {
+			return allowed()
+		}
+		if errs := validation.ValidateExperiment(&ex); len(errs) > 0 {
+			return denied(invalidError("Experiment", ex.Name, errs))
+		}
+		refs := make([]v1alpha1.AnalysisTemplateRef, len(ex.Spec.Analyses))
+		for i, analysis := range ex.Spec.Analyses {
+			refs[i] = v1alpha1.AnalysisTemplateRef{TemplateName: analysis.TemplateName, ClusterScope: analysis.ClusterScope}
+		}
+		return s.validateReferences(ex.Namespace, func() error {
+			errs := s.validateTemplateRefs(ex.Namespace, refs, field.NewPath("spec", "analyses"))
+			return invalidError("Experiment", ex.Name, errs)
+		})
+	case "RolloutStrategyTemplate":
+		var template, oldTemplate v1alpha1.RolloutStrategyTemplate
+		if err := decode(req, &template, &oldTemplate); err != nil {
+			return denied(err)
+		}
+		if req.Operation == admissionv1.Update && reflect.DeepEqual(template.Spec, oldTemplate.Spec) {
+			return allowed()
+		}
+		if errs := validation.ValidateRolloutStrategyTemplateSpec(template.Spec, field.NewPath("spec")); len(errs) > 0 {
+			return denied(invalidError("RolloutStrategyTemplate", template.Name, errs))
+		}
+	case "ClusterRolloutStrategyTemplate":
+		var template, oldTemplate v1alpha1.ClusterRolloutStrategyTemplate
+		if err := decode(req, &template, &oldTemplate); err != nil {
+			return denied(err)
+		}
+		if req.Operation == admissionv1.Update && reflect.DeepEqual(template.Spec, oldTemplate.Spec) {
+			return allowed()
+		}
+		if errs := validation.ValidateRolloutStrategyTemplateSpec(template.Spec, field.NewPath("spec")); len(errs) > 0 {
+			return denied(invalidError("ClusterRolloutStrategyTemplate", template.Name, errs))
+		}
+	}
+	return allowed()
+}
+
+func (s *Server) validateAnalysisTemplate(kind, namespace, name string, spec v1alpha1.AnalysisTemplateSpec) *admissionv1.AdmissionResponse {
+	if errs := validation.ValidateAnalysisTemplateSpec(spec, field.NewPath("spec")); len(errs) > 0 {
+		return denied(invalidError(kind, name, errs))
+	}
+	return s.validateReferences(namespace, func() error {
+		errs := s.validateTemplateRefs(namespace, spec.Templates, field.NewPath("spec", "templates"))
+		return invalidError(kind, name, errs)
+	})
+}
+
+// validateReferences applies the reference policy to the result of the validation of the references of an object
+// of the namespace
+func (s *Server) validateReferences(namespace string, validate func() error) *admissionv1.AdmissionResponse {
+	if s.cfg.ReferencePolicy == ReferencePolicyIgnore {
+		return allowed()
+	}
+	if s.cfg.HasSynced != nil && !s.cfg.HasSynced() {
+		return allowed("references were not validated: the informer caches of the controller are not synced")
+	}
+	if s.cfg.CachesNamespace != nil && !s.cfg.CachesNamespace(namespace) {
+		return allowed(fmt.Sprintf("references were not validated: namespace '%s' belongs to a shard owned by another controller replica", namespace))
+	}
+	err := validate()
+	if err == nil {
+		return allowed()
+	}
+	if s.cfg.ReferencePolicy == ReferencePolicyWarn {
+		return allowed(err.Error())
+	}
+	return denied(err)
+}
+
+// validateTemplateRefs checks that the referenced AnalysisTemplates and ClusterAnalysisTemplates exist
+func (s *Server) validateTemplateRefs(namespace string, refs []v1alpha1.AnalysisTemplateRef, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, ref := range refs {
+		var err error
+		kind := "AnalysisTemplate"
+		if ref.ClusterScope {
+			kind = "ClusterAnalysisTemplate"
+			if s.cfg.ClusterAnalysisTemplateLister == nil {
+				continue
+			}
+			_, err = s.cfg.ClusterAnalysisTemplateLister.Get(ref.TemplateName)
+		} else {
+			if s.cfg.AnalysisTemplateLister == nil {
+				continue
+			}
+			_, err = s.cfg.AnalysisTemplateLister.AnalysisTemplates(namespace).Get(ref.TemplateName)
+		}
+		if k8serrors.IsNotFound(err) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("templateName"), ref.TemplateName, fmt.Sprintf("%s '%s' not found", kind, ref.TemplateName)))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Index(i).Child("templateName"), err))
+		}
+	}
+	return allErrs
+}
+
+// decode decodes the object of an admission request, and the old object of an update
+func decode(req *admissionv1.AdmissionRequest, obj, oldObj any) error {
+	if err := json.Unmarshal(req.Object.Raw, obj); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", req.Kind.Kind, err)
+	}
+	if req.Operation == admissionv1.Update && len(req.OldObject.Raw) > 0 {
+		if err := json.Unmarshal(req.OldObject.Raw, oldObj); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", req.Kind.Kind, err)
+		}
+	}
+	return nil
+}
+
+// invalidError formats validation errors like the API server
+func invalidError(kind, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s \"%s\" is invalid: %s", kind, name, errs.ToAggregate().Error())
+}
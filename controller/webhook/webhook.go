@@ -0,0 +1,175 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+const (
+	// ValidatePath is the endpoint of the validating admission webhook
+	ValidatePath = "/validate"
+	// MutatePath is the endpoint of the mutating admission webhook, which sets the defaults of the specs
+	MutatePath = "/mutate"
+
+	// DefaultServiceName is the default name of the Service exposing the admission webhook
+	DefaultServiceName = "argo-rollouts-webhook"
+	// DefaultSecretName is the default name of the Secret holding the certificates of the admission webhook
+	DefaultSecretName = "argo-rollouts-webhook-certs"
+	// DefaultWebhookConfigurationName is the name of the validating and mutating webhook configurations, whose
+	// CA bundle is kept in sync with the certificates of the admission webhook
+	DefaultWebhookConfigurationName = "argo-rollouts-webhook"
+)
+
+// ReferencePolicy defines how the admission webhook handles objects with invalid references to other resources
+type ReferencePolicy string
+
+const (
+	// ReferencePolicyDeny rejects objects with invalid references
+	ReferencePolicyDeny ReferencePolicy = "deny"
+	// ReferencePolicyWarn admits objects with invalid references with a warning, e.g. when a Rollout may be
+	// applied before its Services
+	ReferencePolicyWarn ReferencePolicy = "warn"
+	// ReferencePolicyIgnore does not validate references
+	ReferencePolicyIgnore ReferencePolicy = "ignore"
+)
+
+// RolloutValidator validates the resources referenced by a Rollout
+type RolloutValidator interface {
+	ValidateRolloutReferences(rollout *v1alpha1.Rollout) error
+}
+
+// ServerConfig describes the data required to instantiate the admission webhook server
+type ServerConfig struct {
+	Addr string
+	// Namespace is the namespace of the Service and the certificates Secret of the admission webhook
+	Namespace                string
+	ServiceName              string
+	SecretName               string
+	WebhookConfigurationName string
+	KubeClientSet            kubernetes.Interface
+	// DynamicClientSet is used to inject the CA of the certificates into the CRDs converted by the webhook
+	DynamicClientSet              dynamic.Interface
+	RolloutValidator              RolloutValidator
+	AnalysisTemplateLister        listers.AnalysisTemplateLister
+	ClusterAnalysisTemplateLister listers.ClusterAnalysisTemplateLister
+	// HasSynced returns whether the informer caches used to validate references are synced
+	HasSynced cache.InformerSynced
+	// CachesNamespace returns whether the informer caches hold the objects of a namespace. With sharding, they only
+	// hold the namespaces of the shards owned by the controller replica.
+	CachesNamespace func(namespace string) bool
+	ReferencePolicy ReferencePolicy
+}
+
+// Server serves the validating and mutating admission webhooks of Rollouts, AnalysisTemplates,
+// ClusterAnalysisTemplates and Experiments, and the conversion webhook of the CRDs, over TLS, with self-managed
+// certificates
+type Server struct {
+	*http.Server
+	cfg   ServerConfig
+	certs *certManager
+}
+
+// NewServer returns a new admission webhook server
+func NewServer(cfg ServerConfig) *Server {
+	if cfg.ServiceName == "" {
+		cfg.ServiceName = DefaultServiceName
+	}
+	if cfg.SecretName == "" {
+		cfg.SecretName = DefaultSecretName
+	}
+	if cfg.WebhookConfigurationName == "" {
+		cfg.WebhookConfigurationName = DefaultWebhookConfigurationName
+	}
+	if cfg.ReferencePolicy == "" {
+		cfg.ReferencePolicy = ReferencePolicyDeny
+	}
+	certs := newCertManager(cfg)
+	s := &Server{
+		cfg:   cfg,
+		certs: certs,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(ValidatePath, s.serveAdmission(s.validate))
+	mux.HandleFunc(MutatePath, s.serveAdmission(s.mutate))
+	mux.HandleFunc(ConvertPath, s.serveConversion)
+	s.Server = &http.Server{
+		Addr:    cfg.Addr,
+		Handler: mux,
+		TLSConfig: &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: certs.getCertificate,
+		},
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return s
+}
+
+// Run provisions the certificates of the admission webhook, keeps them rotated, and serves the admission requests
+// until the server is shut down
+func (s *Server) Run(ctx context.Context) error {
+	if err := s.certs.reconcile(ctx); err != nil {
+		return fmt.Errorf("failed to provision webhook certificates: %w", err)
+	}
+	go s.certs.run(ctx)
+	log.Infof("Starting Admission Webhook Server at %s", s.Addr)
+	if err := s.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// serveAdmission decodes an AdmissionReview, and responds with the response of the admit function
+func (s *Server) serveAdmission(admit func(*admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var review admissionv1.AdmissionReview
+		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode admission review: %v", err), http.StatusBadRequest)
+			return
+		}
+		if review.Request == nil {
+			http.Error(w, "admission review has no request", http.StatusBadRequest)
+			return
+		}
+		response := admit(review.Request)
+		response.UID = review.Request.UID
+		review.Request = nil
+		review.Response = response
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&review); err != nil {
+			log.Errorf("Failed to encode admission review: %v", err)
+		}
+	}
+}
+
+func allowed(warnings ...string) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed:  true,
+		Warnings: warnings,
+	}
+}
+
+func denied(err error) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Allowed: false,
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Reason:  metav1.StatusReasonInvalid,
+			Code:    http.StatusUnprocessableEntity,
+			Message: err.Error(),
+		},
+	}
+}
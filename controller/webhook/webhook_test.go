@@ -0,0 +1,334 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+)
+
+type fakeRolloutValidator struct {
+	err error
+}
+
+func (v *fakeRolloutValidator) ValidateRolloutReferences(*v1alpha1.Rollout) error {
+	return v.err
+}
+
+func newTestServer(t *testing.T, policy ReferencePolicy, validator RolloutValidator, objs ...runtime.Object) *Server {
+	t.Helper()
+	factory := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	analysisTemplateInformer := factory.Argoproj().V1alpha1().AnalysisTemplates()
+	clusterAnalysisTemplateInformer := factory.Argoproj().V1alpha1().ClusterAnalysisTemplates()
+	for _, obj := range objs {
+		switch obj.(type) {
+		case *v1alpha1.AnalysisTemplate:
+			require.NoError(t, analysisTemplateInformer.Informer().GetIndexer().Add(obj))
+		case *v1alpha1.ClusterAnalysisTemplate:
+			require.NoError(t, clusterAnalysisTemplateInformer.Informer().GetIndexer().Add(obj))
+		}
+	}
+	return NewServer(ServerConfig{
+		Namespace:                     "argo-rollouts",
+		RolloutValidator:              validator,
+		AnalysisTemplateLister:        analysisTemplateInformer.Lister(),
+		ClusterAnalysisTemplateLister: clusterAnalysisTemplateInformer.Lister(),
+		ReferencePolicy:               policy,
+	})
+}
+
+func newRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"},
+		Spec: v1alpha1.RolloutSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+			Template: newPodTemplate(),
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}},
+		},
+	}
+}
+
+func newAnalysisTemplate(name string, templates ...string) *v1alpha1.AnalysisTemplate {
+	template := &v1alpha1.AnalysisTemplate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "AnalysisTemplate"},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Metrics: []v1alpha1.Metric{{
+				Name:     "success-rate",
+				Provider: v1alpha1.MetricProvider{Web: &v1alpha1.WebMetric{URL: "http://metrics"}},
+			}},
+		},
+	}
+	for _, t := range templates {
+		template.Spec.Templates = append(template.Spec.Templates, v1alpha1.AnalysisTemplateRef{TemplateName: t})
+	}
+	return template
+}
+
+func newPodTemplate() corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "guestbook"}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "guestbook", Image: "guestbook:v1"}}},
+	}
+}
+
+// review sends an admission review of an object to an endpoint of the admission webhook
+func review(t *testing.T, s *Server, path string, operation admissionv1.Operation, obj, oldObj runtime.Object) *admissionv1.AdmissionResponse {
+	t.Helper()
+	req := &admissionv1.AdmissionRequest{
+		UID:       types.UID("review-uid"),
+		Kind:      metav1.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: obj.GetObjectKind().GroupVersionKind().Kind},
+		Operation: operation,
+		Object:    runtime.RawExtension{Object: obj},
+	}
+	if oldObj != nil {
+		req.OldObject = runtime.RawExtension{Object: oldObj}
+	}
+	body, err := json.Marshal(admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "admission.k8s.io/v1", Kind: "AdmissionReview"},
+		Request:  req,
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(s.Handler)
+	defer server.Close()
+	resp, err := http.Post(server.URL+path, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var result admissionv1.AdmissionReview
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+	require.NotNil(t, result.Response)
+	assert.Equal(t, req.UID, result.Response.UID)
+	return result.Response
+}
+
+func TestValidateRollout(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, &fakeRolloutValidator{})
+	resp := review(t, s, ValidatePath, admissionv1.Create, newRollout(), nil)
+	assert.True(t, resp.Allowed)
+
+	invalid := newRollout()
+	invalid.Spec.Selector = nil
+	resp = review(t, s, ValidatePath, admissionv1.Create, invalid, nil)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, int32(http.StatusUnprocessableEntity), resp.Result.Code)
+	assert.Contains(t, resp.Result.Message, `spec.selector: Required value`)
+
+	// the controller can still update the metadata and status of invalid Rollouts
+	updated := invalid.DeepCopy()
+	updated.Annotations = map[string]string{"foo": "bar"}
+	resp = review(t, s, ValidatePath, admissionv1.Update, updated, invalid)
+	assert.True(t, resp.Allowed)
+
+	resp = review(t, s, ValidatePath, admissionv1.Delete, invalid, nil)
+	assert.True(t, resp.Allowed)
+}
+
+func TestValidateRolloutReferencePolicies(t *testing.T) {
+	validator := &fakeRolloutValidator{err: errors.New(`spec.strategy.canary.stableService: Invalid value: "stable": service "stable" not found`)}
+
+	s := newTestServer(t, ReferencePolicyDeny, validator)
+	resp := review(t, s, ValidatePath, admissionv1.Create, newRollout(), nil)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, `Rollout "guestbook" is invalid: spec.strategy.canary.stableService: Invalid value: "stable": service "stable" not found`, resp.Result.Message)
+
+	s = newTestServer(t, ReferencePolicyWarn, validator)
+	resp = review(t, s, ValidatePath, admissionv1.Create, newRollout(), nil)
+	assert.True(t, resp.Allowed)
+	assert.Equal(t, []string{`Rollout "guestbook" is invalid: spec.strategy.canary.stableService: Invalid value: "stable": service "stable" not found`}, resp.Warnings)
+
+	s = newTestServer(t, ReferencePolicyIgnore, validator)
+	resp = review(t, s, ValidatePath, admissionv1.Create, newRollout(), nil)
+	assert.True(t, resp.Allowed)
+	assert.Empty(t, resp.Warnings)
+}
+
+func TestValidateWithUnsyncedCaches(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, &fakeRolloutValidator{err: errors.New("not found")})
+	s.cfg.HasSynced = func() bool { return false }
+	resp := review(t, s, ValidatePath, admissionv1.Create, newRollout(), nil)
+	assert.True(t, resp.Allowed)
+	assert.Equal(t, []string{"references were not validated: the informer caches of the controller are not synced"}, resp.Warnings)
+}
+
+func TestValidateWithNamespaceOfOtherShard(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, &fakeRolloutValidator{err: errors.New("not found")})
+	s.cfg.CachesNamespace = func(namespace string) bool { return namespace != "default" }
+	resp := review(t, s, ValidatePath, admissionv1.Create, newRollout(), nil)
+	assert.True(t, resp.Allowed)
+	assert.Equal(t, []string{"references were not validated: namespace 'default' belongs to a shard owned by another controller replica"}, resp.Warnings)
+}
+
+func TestValidateAnalysisTemplate(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil, newAnalysisTemplate("success-rate"))
+
+	resp := review(t, s, ValidatePath, admissionv1.Create, newAnalysisTemplate("composite", "success-rate"), nil)
+	assert.True(t, resp.Allowed)
+
+	resp = review(t, s, ValidatePath, admissionv1.Create, newAnalysisTemplate("composite", "missing"), nil)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, `AnalysisTemplate "composite" is invalid: spec.templates[0].templateName: Invalid value: "missing": AnalysisTemplate 'missing' not found`, resp.Result.Message)
+
+	invalid := newAnalysisTemplate("invalid")
+	invalid.Spec.Metrics = append(invalid.Spec.Metrics, invalid.Spec.Metrics[0])
+	resp = review(t, s, ValidatePath, admissionv1.Create, invalid, nil)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, `AnalysisTemplate "invalid" is invalid: spec.metrics[1].name: Duplicate value: "success-rate"`, resp.Result.Message)
+}
+
+func TestValidateClusterAnalysisTemplate(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	template := &v1alpha1.ClusterAnalysisTemplate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "ClusterAnalysisTemplate"},
+		ObjectMeta: metav1.ObjectMeta{Name: "composite"},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "success-rate", ClusterScope: true}},
+		},
+	}
+	resp := review(t, s, ValidatePath, admissionv1.Create, template, nil)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, `ClusterAnalysisTemplate "composite" is invalid: spec.templates[0].templateName: Invalid value: "success-rate": ClusterAnalysisTemplate 'success-rate' not found`, resp.Result.Message)
+}
+
+func TestValidateExperiment(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil, newAnalysisTemplate("success-rate"))
+	ex := &v1alpha1.Experiment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Experiment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "experiment", Namespace: "default"},
+		Spec: v1alpha1.ExperimentSpec{
+			Templates: []v1alpha1.TemplateSpec{{
+				Name:     "canary",
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+				Template: newPodTemplate(),
+			}},
+			Analyses: []v1alpha1.ExperimentAnalysisTemplateRef{{Name: "analysis", TemplateName: "success-rate"}},
+		},
+	}
+	resp := review(t, s, ValidatePath, admissionv1.Create, ex, nil)
+	assert.True(t, resp.Allowed)
+
+	ex.Spec.Analyses[0].TemplateName = "missing"
+	resp = review(t, s, ValidatePath, admissionv1.Create, ex, nil)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, `Experiment "experiment" is invalid: spec.analyses[0].templateName: Invalid value: "missing": AnalysisTemplate 'missing' not found`, resp.Result.Message)
+
+	ex.Spec.Templates[0].Selector = nil
+	resp = review(t, s, ValidatePath, admissionv1.Create, ex, nil)
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, `Experiment "experiment" is invalid: spec.templates`)
+}
+
+func TestValidateRolloutStrategyTemplate(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	// the services are set by the Rollouts referencing the template
+	template := &v1alpha1.RolloutStrategyTemplate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "RolloutStrategyTemplate"},
+		ObjectMeta: metav1.ObjectMeta{Name: "canary", Namespace: "default"},
+		Spec: v1alpha1.RolloutStrategyTemplateSpec{Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{
+			TrafficRouting: &v1alpha1.RolloutTrafficRouting{Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "guestbook"}},
+			Steps:          []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}},
+		}}},
+	}
+	resp := review(t, s, ValidatePath, admissionv1.Create, template, nil)
+	assert.True(t, resp.Allowed, resp.Result)
+
+	invalid := template.DeepCopy()
+	invalid.Spec.Strategy.Canary.Steps = append(invalid.Spec.Strategy.Canary.Steps, v1alpha1.CanaryStep{SetWeight: pointer.Int32(110)})
+	resp = review(t, s, ValidatePath, admissionv1.Create, invalid, nil)
+	assert.False(t, resp.Allowed)
+	assert.Equal(t, `RolloutStrategyTemplate "canary" is invalid: spec.strategy.steps[1].setWeight: Invalid value: 110: SetWeight needs to be between 0 and 100`, resp.Result.Message)
+
+	// the templates which are already invalid can still be updated
+	updated := invalid.DeepCopy()
+	updated.Labels = map[string]string{"foo": "bar"}
+	resp = review(t, s, ValidatePath, admissionv1.Update, updated, invalid)
+	assert.True(t, resp.Allowed)
+}
+
+func TestValidateClusterRolloutStrategyTemplate(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	template := &v1alpha1.ClusterRolloutStrategyTemplate{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "ClusterRolloutStrategyTemplate"},
+		ObjectMeta: metav1.ObjectMeta{Name: "blue-green"},
+		Spec:       v1alpha1.RolloutStrategyTemplateSpec{Strategy: v1alpha1.RolloutStrategy{BlueGreen: &v1alpha1.BlueGreenStrategy{}}},
+	}
+	resp := review(t, s, ValidatePath, admissionv1.Create, template, nil)
+	assert.True(t, resp.Allowed, resp.Result)
+
+	template.Spec.Strategy.BlueGreen.TrafficSteps = []v1alpha1.BlueGreenTrafficStep{{SetWeight: pointer.Int32(10)}}
+	resp = review(t, s, ValidatePath, admissionv1.Create, template, nil)
+	assert.False(t, resp.Allowed)
+	assert.Contains(t, resp.Result.Message, `ClusterRolloutStrategyTemplate "blue-green" is invalid: spec.strategy.trafficRouting: Required value`)
+}
+
+func TestMutateRollout(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	resp := review(t, s, MutatePath, admissionv1.Create, newRollout(), nil)
+	assert.True(t, resp.Allowed)
+	require.NotNil(t, resp.PatchType)
+	assert.Equal(t, admissionv1.PatchTypeJSONPatch, *resp.PatchType)
+	assert.JSONEq(t, `[
+		{"op": "add", "path": "/spec/replicas", "value": 1},
+		{"op": "add", "path": "/spec/revisionHistoryLimit", "value": 10},
+		{"op": "add", "path": "/spec/progressDeadlineSeconds", "value": 600}
+	]`, string(resp.Patch))
+
+	ro := newRollout()
+	ro.Spec.Replicas = pointer.Int32(3)
+	ro.Spec.RevisionHistoryLimit = pointer.Int32(2)
+	ro.Spec.ProgressDeadlineSeconds = pointer.Int32(300)
+	resp = review(t, s, MutatePath, admissionv1.Create, ro, nil)
+	assert.True(t, resp.Allowed)
+	assert.Nil(t, resp.Patch)
+}
+
+func TestMutateExperiment(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	ex := &v1alpha1.Experiment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "argoproj.io/v1alpha1", Kind: "Experiment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "experiment", Namespace: "default"},
+	}
+	resp := review(t, s, MutatePath, admissionv1.Create, ex, nil)
+	assert.True(t, resp.Allowed)
+	assert.JSONEq(t, `[
+		{"op": "add", "path": "/spec/progressDeadlineSeconds", "value": 600},
+		{"op": "add", "path": "/spec/scaleDownDelaySeconds", "value": 30}
+	]`, string(resp.Patch))
+
+	resp = review(t, s, MutatePath, admissionv1.Create, newAnalysisTemplate("success-rate"), nil)
+	assert.True(t, resp.Allowed)
+	assert.Nil(t, resp.Patch)
+}
+
+func TestServeAdmissionInvalidReview(t *testing.T) {
+	s := newTestServer(t, ReferencePolicyDeny, nil)
+	server := httptest.NewServer(s.Handler)
+	defer server.Close()
+
+	resp, err := http.Post(server.URL+ValidatePath, "application/json", bytes.NewReader([]byte("{")))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, err = http.Post(server.URL+ValidatePath, "application/json", bytes.NewReader([]byte("{}")))
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	appsv1 "k8s.io/api/apps/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -36,6 +37,7 @@ import (
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 )
 
@@ -244,13 +246,15 @@ func (ec *Controller) Run(ctx context.Context, threadiness int) error {
 	return nil
 }
 
-func (ec *Controller) syncHandler(ctx context.Context, key string) error {
+func (ec *Controller) syncHandler(ctx context.Context, key string) (err error) {
 	startTime := timeutil.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return err
 	}
-	logCtx := log.WithField(logutil.ExperimentKey, name).WithField(logutil.NamespaceKey, namespace)
+	ctx, span := tracing.StartSpan(ctx, "experiment.reconcile", attribute.String("experiment.namespace", namespace), attribute.String("experiment.name", name))
+	defer func() { tracing.EndSpan(span, err) }()
+	logCtx := logutil.WithTrace(ctx, log.WithField(logutil.ExperimentKey, name).WithField(logutil.NamespaceKey, namespace))
 	logCtx.Infof("Started syncing Experiment at (%v)", startTime)
 	experiment, err := ec.experimentsLister.Experiments(namespace).Get(name)
 	if k8serrors.IsNotFound(err) {
@@ -299,6 +303,8 @@ func (ec *Controller) syncHandler(ctx context.Context, key string) error {
 	}
 
 	exCtx := newExperimentContext(
+		// the requests of the reconciliation are not cancelled on shutdown, only traced as part of its span
+		context.WithoutCancel(ctx),
 		experiment,
 		templateRSs,
 		templateServices,
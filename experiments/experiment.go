@@ -22,6 +22,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 	appslisters "k8s.io/client-go/listers/apps/v1"
@@ -35,6 +36,8 @@ const (
 
 type experimentContext struct {
 	// parameters supplied to the context
+	// ctx is the context of the reconciliation, which carries its tracing span
+	ctx                           context.Context
 	ex                            *v1alpha1.Experiment
 	templateRSs                   map[string]*appsv1.ReplicaSet
 	templateServices              map[string]*corev1.Service
@@ -58,6 +61,7 @@ type experimentContext struct {
 }
 
 func newExperimentContext(
+	ctx context.Context,
 	experiment *v1alpha1.Experiment,
 	templateRSs map[string]*appsv1.ReplicaSet,
 	templateServices map[string]*corev1.Service,
@@ -73,6 +77,7 @@ func newExperimentContext(
 	enqueueExperimentAfter func(obj any, duration time.Duration),
 ) *experimentContext {
 	exCtx := experimentContext{
+		ctx:                           ctx,
 		ex:                            experiment,
 		templateRSs:                   templateRSs,
 		templateServices:              templateServices,
@@ -87,7 +92,7 @@ func newExperimentContext(
 		enqueueExperimentAfter:        enqueueExperimentAfter,
 		resyncPeriod:                  resyncPeriod,
 
-		log:           log.WithField(logutil.ExperimentKey, experiment.Name).WithField(logutil.NamespaceKey, experiment.Namespace),
+		log:           logutil.WithTrace(ctx, log.WithField(logutil.ExperimentKey, experiment.Name).WithField(logutil.NamespaceKey, experiment.Namespace)),
 		newStatus:     experiment.Status.DeepCopy(),
 		isTerminating: experimentutil.IsTerminating(experiment),
 	}
@@ -234,7 +239,7 @@ func (ec *experimentContext) addScaleDownDelayToTemplateRS(rs *appsv1.ReplicaSet
 		ec.log.Warnf("Unable to add scaleDownDelay label on rs '%s'", rs.Name)
 	} else {
 		if rsIsUpdated {
-			ctx := context.TODO()
+			ctx := ec.ctx
 			rs, err = ec.kubeclientset.AppsV1().ReplicaSets(ec.ex.Namespace).Get(ctx, rs.Name, metav1.GetOptions{})
 			if err != nil {
 				ec.log.Warnf("Unable to get rs '%s' with added scaleDownDelay", rs.Name)
@@ -259,7 +264,7 @@ func (ec *experimentContext) scaleTemplateRS(rs *appsv1.ReplicaSet, template v1a
 			desiredReplicaCount = experimentReplicas
 		}
 	} else {
-		ctx := context.TODO()
+		ctx := ec.ctx
 		updatedRS, err := ec.kubeclientset.AppsV1().ReplicaSets(ec.ex.Namespace).Get(ctx, rs.Name, metav1.GetOptions{})
 		if err != nil {
 			ec.log.Warnf("Unable to get updated rs '%s'", updatedRS.Name)
@@ -388,6 +393,19 @@ func calculateEnqueueDuration(ex *v1alpha1.Experiment, newStatus *v1alpha1.Exper
 }
 
 // reconcileAnalysisRun reconciles a single analysis run, creating or terminating it as necessary.
+// relatedAnalysisRun returns the AnalysisRun with the given name, as the related object of the events about it, or
+// nil when it is not found
+func (ec *experimentContext) relatedAnalysisRun(name string) runtime.Object {
+	if name == "" {
+		return nil
+	}
+	run, err := ec.analysisRunLister.AnalysisRuns(ec.ex.Namespace).Get(name)
+	if err != nil {
+		return nil
+	}
+	return run
+}
+
 // Updates the analysis run statuses, which may subsequently fail the experiment.
 func (ec *experimentContext) reconcileAnalysisRun(analysis v1alpha1.ExperimentAnalysisTemplateRef, dryRunMetrics []v1alpha1.DryRun, measurementRetentionMetrics []v1alpha1.MeasurementRetention, analysisRunMetadata *v1alpha1.AnalysisRunMetadata) {
 	logCtx := ec.log.WithField("analysis", analysis.Name)
@@ -414,18 +432,19 @@ func (ec *experimentContext) reconcileAnalysisRun(analysis v1alpha1.ExperimentAn
 			case v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseInconclusive:
 				eventType = corev1.EventTypeWarning
 			}
-			ec.recorder.Eventf(ec.ex, record.EventOptions{EventType: eventType, EventReason: "AnalysisRun" + string(newStatus.Phase)}, msg)
+			related := ec.relatedAnalysisRun(newStatus.AnalysisRun)
+			ec.recorder.Eventf(ec.ex, record.EventOptions{EventType: eventType, EventReason: "AnalysisRun" + string(newStatus.Phase), RelatedObject: related}, msg)
 
 			// Handle the case where the Analysis Run belongs to an Experiment, and the Experiment is a Step in the Rollout
 			// This makes sure the rollout gets the Analysis Run events, which will then trigger any subscribed notifications
 			// #4009
 			roRef := experimentutil.GetRolloutOwnerRef(ec.ex)
 			if roRef != nil {
-				rollout, err := ec.argoProjClientset.ArgoprojV1alpha1().Rollouts(ec.ex.Namespace).Get(context.TODO(), roRef.Name, metav1.GetOptions{})
+				rollout, err := ec.argoProjClientset.ArgoprojV1alpha1().Rollouts(ec.ex.Namespace).Get(ec.ctx, roRef.Name, metav1.GetOptions{})
 				if err != nil {
 					ec.log.Warnf("Failed to get parent Rollout of the Experiment '%s': %v", roRef.Name, err)
 				} else {
-					ec.recorder.Eventf(rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: "AnalysisRun" + string(newStatus.Phase)}, msg)
+					ec.recorder.Eventf(rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: "AnalysisRun" + string(newStatus.Phase), RelatedObject: related}, msg)
 				}
 			}
 		}
@@ -487,7 +506,7 @@ func (ec *experimentContext) reconcileAnalysisRun(analysis v1alpha1.ExperimentAn
 
 	if ec.isTerminating {
 		if !run.Status.Phase.Completed() && !run.Spec.Terminate {
-			ec.recorder.Eventf(ec.ex, record.EventOptions{EventReason: "AnalysisRunTerminating"}, "Terminating %s (%s)", analysis.Name, run.Name)
+			ec.recorder.Eventf(ec.ex, record.EventOptions{EventReason: "AnalysisRunTerminating", RelatedObject: run}, "Terminating %s (%s)", analysis.Name, run.Name)
 			analysisRunIf := ec.argoProjClientset.ArgoprojV1alpha1().AnalysisRuns(ec.ex.Namespace)
 			err := analysisutil.TerminateRun(analysisRunIf, run.Name)
 			if err != nil {
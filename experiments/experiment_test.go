@@ -1,6 +1,7 @@
 package experiments
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -50,6 +51,7 @@ func newTestContext(ex *v1alpha1.Experiment, objects ...runtime.Object) *experim
 	serviceLister := k8sI.Core().V1().Services().Lister()
 
 	return newExperimentContext(
+		context.TODO(),
 		ex,
 		make(map[string]*appsv1.ReplicaSet),
 		make(map[string]*corev1.Service),
@@ -1,7 +1,6 @@
 package experiments
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -74,7 +73,7 @@ func (c *Controller) getReplicaSetsForExperiment(experiment *v1alpha1.Experiment
 
 // createReplicaSet creates a new replicaset based on the template
 func (ec *experimentContext) createReplicaSet(template v1alpha1.TemplateSpec, collisionCount *int32) (*appsv1.ReplicaSet, error) {
-	ctx := context.TODO()
+	ctx := ec.ctx
 	newRS := newReplicaSetFromTemplate(ec.ex, template, collisionCount)
 
 	newReplicasCount := experimentutil.CalculateTemplateReplicasCount(ec.ex, template)
@@ -146,7 +145,7 @@ func (ec *experimentContext) createReplicaSet(template v1alpha1.TemplateSpec, co
 	}
 
 	if !alreadyExists && newReplicasCount > int32(0) {
-		ec.recorder.Eventf(ec.ex, record.EventOptions{EventReason: conditions.NewReplicaSetReason}, conditions.NewReplicaSetMessage+" with size %d", createdRS.Name, newReplicasCount)
+		ec.recorder.Eventf(ec.ex, record.EventOptions{EventReason: conditions.NewReplicaSetReason, RelatedObject: createdRS}, conditions.NewReplicaSetMessage+" with size %d", createdRS.Name, newReplicasCount)
 	}
 
 	return createdRS, nil
@@ -217,7 +216,7 @@ func (ec *experimentContext) addScaleDownDelay(rs *appsv1.ReplicaSet) (bool, err
 	if rs == nil {
 		return rsIsUpdated, nil
 	}
-	ctx := context.TODO()
+	ctx := ec.ctx
 	scaleDownDelaySeconds := time.Duration(defaults.GetExperimentScaleDownDelaySecondsOrDefault(ec.ex))
 	if scaleDownDelaySeconds == 0 {
 		// If scaledown deadline is zero, it means we need to remove any replicasets with the delay
@@ -245,7 +244,7 @@ func (ec *experimentContext) addScaleDownDelay(rs *appsv1.ReplicaSet) (bool, err
 // removeScaleDownDelay removes the `scale-down-deadline` annotation from the ReplicaSet (if it exists)
 // returns True if ReplicaSet is patched, otherwise False
 func (ec *experimentContext) removeScaleDownDelay(rs *appsv1.ReplicaSet) (bool, error) {
-	ctx := context.TODO()
+	ctx := ec.ctx
 	rsIsUpdated := false
 	if !replicasetutil.HasScaleDownDeadline(rs) {
 		return rsIsUpdated, nil
@@ -286,7 +285,7 @@ func (ec *experimentContext) scaleReplicaSetAndRecordEvent(rs *appsv1.ReplicaSet
 }
 
 func (ec *experimentContext) scaleReplicaSet(rs *appsv1.ReplicaSet, newScale int32, scalingOperation string) (bool, *appsv1.ReplicaSet, error) {
-	ctx := context.TODO()
+	ctx := ec.ctx
 	oldScale := *(rs.Spec.Replicas)
 	sizeNeedsUpdate := oldScale != newScale
 	scaled := false
@@ -297,7 +296,7 @@ func (ec *experimentContext) scaleReplicaSet(rs *appsv1.ReplicaSet, newScale int
 		rs, err = ec.kubeclientset.AppsV1().ReplicaSets(rsCopy.Namespace).Update(ctx, rsCopy, metav1.UpdateOptions{})
 		if err == nil && sizeNeedsUpdate {
 			scaled = true
-			ec.recorder.Eventf(ec.ex, record.EventOptions{EventReason: conditions.ScalingReplicaSetReason}, "Scaled %s ReplicaSet %s from %d to %d", scalingOperation, rs.Name, oldScale, newScale)
+			ec.recorder.Eventf(ec.ex, record.EventOptions{EventReason: conditions.ScalingReplicaSetReason, RelatedObject: rs}, "Scaled %s ReplicaSet %s from %d to %d", scalingOperation, rs.Name, oldScale, newScale)
 		}
 	}
 	return scaled, rs, err
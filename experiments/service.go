@@ -1,7 +1,6 @@
 package experiments
 
 import (
-	"context"
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -60,7 +59,7 @@ func GetServiceForExperiment(experiment *v1alpha1.Experiment, svc *corev1.Servic
 }
 
 func (ec *experimentContext) CreateService(serviceName string, template v1alpha1.TemplateSpec, selector map[string]string, ports []corev1.ServicePort) (*corev1.Service, error) {
-	ctx := context.TODO()
+	ctx := ec.ctx
 	serviceAnnotations := newServiceAnnotations(ec.ex.Name, template.Name)
 	newService := &corev1.Service{
 		TypeMeta: metav1.TypeMeta{},
@@ -99,7 +98,7 @@ func (ec *experimentContext) CreateService(serviceName string, template v1alpha1
 }
 
 func (ec *experimentContext) deleteService(service corev1.Service) error {
-	ctx := context.TODO()
+	ctx := ec.ctx
 	ec.log.Infof("Trying to cleanup service '%s'", service.Name)
 	err := ec.kubeclientset.CoreV1().Services(ec.ex.Namespace).Delete(ctx, service.Name, metav1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
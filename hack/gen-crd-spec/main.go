@@ -36,11 +36,15 @@ var preserveUnknownFields = map[string]any{
 }
 
 var crdPaths = map[string]string{
-	"Rollout":                 "manifests/crds/rollout-crd.yaml",
-	"Experiment":              "manifests/crds/experiment-crd.yaml",
-	"AnalysisTemplate":        "manifests/crds/analysis-template-crd.yaml",
-	"ClusterAnalysisTemplate": "manifests/crds/cluster-analysis-template-crd.yaml",
-	"AnalysisRun":             "manifests/crds/analysis-run-crd.yaml",
+	"Rollout":                        "manifests/crds/rollout-crd.yaml",
+	"Experiment":                     "manifests/crds/experiment-crd.yaml",
+	"AnalysisTemplate":               "manifests/crds/analysis-template-crd.yaml",
+	"ClusterAnalysisTemplate":        "manifests/crds/cluster-analysis-template-crd.yaml",
+	"AnalysisRun":                    "manifests/crds/analysis-run-crd.yaml",
+	"RolloutPolicy":                  "manifests/crds/rollout-policy-crd.yaml",
+	"ClusterRolloutPolicy":           "manifests/crds/cluster-rollout-policy-crd.yaml",
+	"RolloutStrategyTemplate":        "manifests/crds/rollout-strategy-template-crd.yaml",
+	"ClusterRolloutStrategyTemplate": "manifests/crds/cluster-rollout-strategy-template-crd.yaml",
 }
 
 func setValidationOverride(un *unstructured.Unstructured, fieldOverride map[string]any, path string) {
@@ -101,6 +105,10 @@ func NewCustomResourceDefinition() []*extensionsobj.CustomResourceDefinition {
 	deleteFile("config/crd/argoproj.io_clusteranalysistemplates.yaml")
 	deleteFile("config/crd/argoproj.io_experiments.yaml")
 	deleteFile("config/crd/argoproj.io_rollouts.yaml")
+	deleteFile("config/crd/argoproj.io_rolloutpolicies.yaml")
+	deleteFile("config/crd/argoproj.io_clusterrolloutpolicies.yaml")
+	deleteFile("config/crd/argoproj.io_rolloutstrategytemplates.yaml")
+	deleteFile("config/crd/argoproj.io_clusterrolloutstrategytemplates.yaml")
 	deleteFile("config/crd")
 	deleteFile("config")
 
@@ -216,6 +224,10 @@ func createMetadataValidation(un *unstructured.Unstructured) {
 			analysisJobValidated = append(analysisJobValidated, v)
 		}
 		unstructured.SetNestedSlice(un.Object, analysisJobValidated, prePath...)
+	case "RolloutPolicy", "ClusterRolloutPolicy":
+		// the policies have no object metadata in their spec
+	case "RolloutStrategyTemplate", "ClusterRolloutStrategyTemplate":
+		// the strategies have no pod templates
 	default:
 		panic(fmt.Sprintf("unknown kind: %s", kind))
 	}
@@ -234,6 +246,10 @@ func removeK8S118Fields(un *unstructured.Unstructured) {
 		// Replace this with "spec.template.spec.volumes[].ephemeral.volumeClaimTemplate.spec.resources.{limits/requests}"
 		// when it's ok to only support k8s 1.17+
 		setValidationOverride(un, preserveUnknownFields, "spec.template.spec.volumes[]")
+		// The pod templates of the hook Jobs are validated by the Jobs, which keeps the size of the CRD manageable
+		setValidationOverride(un, preserveUnknownFields, "spec.hooks.preRollout.spec.template")
+		setValidationOverride(un, preserveUnknownFields, "spec.hooks.postPromotion.spec.template")
+		setValidationOverride(un, preserveUnknownFields, "spec.hooks.onAbort.spec.template")
 	case "Experiment":
 		setValidationOverride(un, preserveUnknownFields, "spec.templates[].template.spec.containers[].resources.limits")
 		setValidationOverride(un, preserveUnknownFields, "spec.templates[].template.spec.containers[].resources.requests")
@@ -254,6 +270,15 @@ func removeK8S118Fields(un *unstructured.Unstructured) {
 		// Replace this with "spec.metrics[].provider.job.spec.template.spec.volumes[].ephemeral.volumeClaimTemplate.spec.resources.{limits/requests}"
 		// when it's ok to only support k8s 1.17+
 		setValidationOverride(un, preserveUnknownFields, "spec.metrics[].provider.job.spec.template.spec.volumes")
+	case "RolloutPolicy", "ClusterRolloutPolicy":
+		// the policies have no pod templates
+	case "RolloutStrategyTemplate", "ClusterRolloutStrategyTemplate":
+		// The steps and the traffic routing of the strategies are validated by the controller once resolved for a
+		// Rollout, which keeps the size of the CRDs manageable
+		setValidationOverride(un, preserveUnknownFields, "spec.strategy.canary.steps")
+		setValidationOverride(un, preserveUnknownFields, "spec.strategy.canary.trafficRouting")
+		setValidationOverride(un, preserveUnknownFields, "spec.strategy.blueGreen.trafficSteps")
+		setValidationOverride(un, preserveUnknownFields, "spec.strategy.blueGreen.trafficRouting")
 	default:
 		panic(fmt.Sprintf("unknown kind: %s", kind))
 	}
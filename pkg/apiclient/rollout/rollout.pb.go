@@ -728,6 +728,7 @@ type RolloutInfo struct {
 	Containers           []*ContainerInfo       `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
 	Steps                []*v1alpha1.CanaryStep `protobuf:"bytes,20,rep,name=steps,proto3" json:"steps,omitempty"`
 	InitContainers       []*ContainerInfo       `protobuf:"bytes,21,rep,name=initContainers,proto3" json:"initContainers,omitempty"`
+	Hooks                []*HookInfo            `protobuf:"bytes,22,rep,name=hooks,proto3" json:"hooks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -913,6 +914,13 @@ func (m *RolloutInfo) GetInitContainers() []*ContainerInfo {
 	return nil
 }
 
+func (m *RolloutInfo) GetHooks() []*HookInfo {
+	if m != nil {
+		return m.Hooks
+	}
+	return nil
+}
+
 type ExperimentInfo struct {
 	ObjectMeta           *v1.ObjectMeta     `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
 	Icon                 string             `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
@@ -1317,6 +1325,85 @@ func (m *ContainerInfo) GetImage() string {
 	return ""
 }
 
+type HookInfo struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	JobName              string   `protobuf:"bytes,2,opt,name=jobName,proto3" json:"jobName,omitempty"`
+	Status               string   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Icon                 string   `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HookInfo) Reset()         { *m = HookInfo{} }
+func (m *HookInfo) String() string { return proto.CompactTextString(m) }
+func (*HookInfo) ProtoMessage()    {}
+func (*HookInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_99101d942e8912a7, []int{17}
+}
+func (m *HookInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HookInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HookInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HookInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookInfo.Merge(m, src)
+}
+func (m *HookInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *HookInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookInfo proto.InternalMessageInfo
+
+func (m *HookInfo) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *HookInfo) GetJobName() string {
+	if m != nil {
+		return m.JobName
+	}
+	return ""
+}
+
+func (m *HookInfo) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *HookInfo) GetIcon() string {
+	if m != nil {
+		return m.Icon
+	}
+	return ""
+}
+
+func (m *HookInfo) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type JobInfo struct {
 	ObjectMeta           *v1.ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
 	Status               string         `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1332,7 +1419,7 @@ func (m *JobInfo) Reset()         { *m = JobInfo{} }
 func (m *JobInfo) String() string { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()    {}
 func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{17}
+	return fileDescriptor_99101d942e8912a7, []int{18}
 }
 func (m *JobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1408,7 +1495,7 @@ func (m *AnalysisRunSpecAndStatus) Reset()         { *m = AnalysisRunSpecAndStat
 func (m *AnalysisRunSpecAndStatus) String() string { return proto.CompactTextString(m) }
 func (*AnalysisRunSpecAndStatus) ProtoMessage()    {}
 func (*AnalysisRunSpecAndStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{18}
+	return fileDescriptor_99101d942e8912a7, []int{19}
 }
 func (m *AnalysisRunSpecAndStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1476,7 +1563,7 @@ func (m *AnalysisRunInfo) Reset()         { *m = AnalysisRunInfo{} }
 func (m *AnalysisRunInfo) String() string { return proto.CompactTextString(m) }
 func (*AnalysisRunInfo) ProtoMessage()    {}
 func (*AnalysisRunInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{19}
+	return fileDescriptor_99101d942e8912a7, []int{20}
 }
 func (m *AnalysisRunInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1603,7 +1690,7 @@ func (m *NonJobInfo) Reset()         { *m = NonJobInfo{} }
 func (m *NonJobInfo) String() string { return proto.CompactTextString(m) }
 func (*NonJobInfo) ProtoMessage()    {}
 func (*NonJobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{20}
+	return fileDescriptor_99101d942e8912a7, []int{21}
 }
 func (m *NonJobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1675,7 +1762,7 @@ func (m *Metrics) Reset()         { *m = Metrics{} }
 func (m *Metrics) String() string { return proto.CompactTextString(m) }
 func (*Metrics) ProtoMessage()    {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_99101d942e8912a7, []int{21}
+	return fileDescriptor_99101d942e8912a7, []int{22}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1757,6 +1844,7 @@ func init() {
 	proto.RegisterType((*ReplicaSetInfo)(nil), "rollout.ReplicaSetInfo")
 	proto.RegisterType((*PodInfo)(nil), "rollout.PodInfo")
 	proto.RegisterType((*ContainerInfo)(nil), "rollout.ContainerInfo")
+	proto.RegisterType((*HookInfo)(nil), "rollout.HookInfo")
 	proto.RegisterType((*JobInfo)(nil), "rollout.JobInfo")
 	proto.RegisterType((*AnalysisRunSpecAndStatus)(nil), "rollout.AnalysisRunSpecAndStatus")
 	proto.RegisterType((*AnalysisRunInfo)(nil), "rollout.AnalysisRunInfo")
@@ -1769,123 +1857,126 @@ func init() {
 }
 
 var fileDescriptor_99101d942e8912a7 = []byte{
-	// 1848 bytes of a gzipped FileDescriptorProto
+	// 1891 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x5f, 0x6f, 0x1c, 0x49,
-	0x11, 0xd7, 0x78, 0xbd, 0xf6, 0xba, 0xd6, 0x7f, 0xdb, 0x49, 0x6e, 0x6e, 0x2f, 0x58, 0xbe, 0x39,
-	0x24, 0x1c, 0x03, 0x33, 0x8e, 0x2f, 0xca, 0x71, 0xfc, 0x93, 0x8c, 0x63, 0xf9, 0x82, 0x92, 0xbb,
-	0x30, 0x06, 0x4e, 0x20, 0x41, 0xd4, 0x9e, 0x6d, 0xaf, 0x27, 0x99, 0x9d, 0x1e, 0xa6, 0x7b, 0x36,
-	0xac, 0x2c, 0x3f, 0xc0, 0x17, 0xe0, 0x81, 0xaf, 0xc0, 0x03, 0x3c, 0x21, 0x24, 0x5e, 0x78, 0xe0,
-	0x15, 0xf1, 0x88, 0xc4, 0x17, 0x40, 0x11, 0x02, 0xf1, 0xc0, 0x03, 0xdf, 0x00, 0x75, 0x4d, 0x4f,
-	0xcf, 0x1f, 0xaf, 0x1d, 0x47, 0x36, 0xe4, 0x9e, 0x76, 0xaa, 0xaa, 0xab, 0xea, 0xd7, 0xdd, 0x55,
-	0xd5, 0xdd, 0xb5, 0xf0, 0x5e, 0xf2, 0x7c, 0xe0, 0xd1, 0x24, 0x0c, 0xa2, 0x90, 0xc5, 0xd2, 0x4b,
-	0x79, 0x14, 0xf1, 0xcc, 0xfc, 0xba, 0x49, 0xca, 0x25, 0x27, 0xb3, 0x9a, 0xec, 0xdd, 0x1e, 0x70,
-	0x3e, 0x88, 0x98, 0x52, 0xf0, 0x68, 0x1c, 0x73, 0x49, 0x65, 0xc8, 0x63, 0x91, 0x0f, 0xeb, 0x3d,
-	0x1a, 0x84, 0xf2, 0x38, 0x3b, 0x74, 0x03, 0x3e, 0xf4, 0x68, 0x3a, 0xe0, 0x49, 0xca, 0x9f, 0xe1,
-	0xc7, 0x97, 0xb5, 0xbe, 0xf0, 0xb4, 0x37, 0xe1, 0x19, 0xce, 0xe8, 0x2e, 0x8d, 0x92, 0x63, 0x7a,
-	0xd7, 0x1b, 0xb0, 0x98, 0xa5, 0x54, 0xb2, 0xbe, 0xb6, 0x76, 0xef, 0xf9, 0x57, 0x84, 0x1b, 0x72,
-	0x35, 0x7c, 0x48, 0x83, 0xe3, 0x30, 0x66, 0xe9, 0xb8, 0xd4, 0x1f, 0x32, 0x49, 0xbd, 0xd1, 0x59,
-	0xad, 0x77, 0x34, 0x42, 0xa4, 0x0e, 0xb3, 0x23, 0x8f, 0x0d, 0x13, 0x39, 0xce, 0x85, 0xce, 0x03,
-	0x58, 0xf6, 0x73, 0xbf, 0x0f, 0xe3, 0x23, 0xfe, 0x9d, 0x8c, 0xa5, 0x63, 0x42, 0x60, 0x3a, 0xa6,
-	0x43, 0x66, 0x5b, 0xeb, 0xd6, 0xc6, 0x9c, 0x8f, 0xdf, 0xe4, 0x36, 0xcc, 0xa9, 0x5f, 0x91, 0xd0,
-	0x80, 0xd9, 0x53, 0x28, 0x28, 0x19, 0xce, 0x3d, 0xb8, 0x51, 0xb1, 0xf2, 0x28, 0x14, 0x32, 0xb7,
-	0x54, 0xd3, 0xb2, 0x9a, 0x5a, 0xbf, 0xb0, 0x60, 0xe9, 0x80, 0xc9, 0x87, 0x43, 0x3a, 0x60, 0x3e,
-	0xfb, 0x49, 0xc6, 0x84, 0x24, 0x36, 0x14, 0x2b, 0xab, 0xc7, 0x17, 0xa4, 0xb2, 0x15, 0xf0, 0x58,
-	0x52, 0x35, 0xeb, 0x02, 0x81, 0x61, 0x90, 0x1b, 0xd0, 0x0e, 0x95, 0x1d, 0xbb, 0x85, 0x92, 0x9c,
-	0x20, 0xcb, 0xd0, 0x92, 0x74, 0x60, 0x4f, 0x23, 0x4f, 0x7d, 0xd6, 0x11, 0xb5, 0x9b, 0x88, 0x8e,
-	0x81, 0x7c, 0x2f, 0xee, 0x73, 0x3d, 0x97, 0x57, 0x63, 0xea, 0x41, 0x27, 0x65, 0xa3, 0x50, 0x84,
-	0x3c, 0x46, 0x48, 0x2d, 0xdf, 0xd0, 0x75, 0x4f, 0xad, 0xa6, 0xa7, 0x87, 0x70, 0xd3, 0x67, 0x42,
-	0xd2, 0x54, 0x36, 0x9c, 0xbd, 0xfe, 0xe2, 0xff, 0x08, 0x6e, 0x3e, 0x49, 0xf9, 0x90, 0x4b, 0x76,
-	0x55, 0x53, 0x4a, 0xe3, 0x28, 0x8b, 0x22, 0x84, 0xdb, 0xf1, 0xf1, 0xdb, 0xd9, 0x87, 0xd5, 0x9d,
-	0x43, 0x7e, 0x0d, 0x38, 0xf7, 0x61, 0xd5, 0x67, 0x32, 0x1d, 0x5f, 0xd9, 0xd0, 0x53, 0x58, 0xd1,
-	0x36, 0x3e, 0xa5, 0x32, 0x38, 0xde, 0x1b, 0xb1, 0x18, 0xcd, 0xc8, 0x71, 0x62, 0xcc, 0xa8, 0x6f,
-	0x72, 0x1f, 0xba, 0x69, 0x19, 0x96, 0x68, 0xa8, 0xbb, 0x7d, 0xc3, 0x2d, 0x32, 0xb9, 0x12, 0xb2,
-	0x7e, 0x75, 0xa0, 0xf3, 0x14, 0x16, 0x3e, 0x2e, 0xbc, 0x29, 0xc6, 0xc5, 0x71, 0x4c, 0xb6, 0x60,
-	0x95, 0x8e, 0x68, 0x18, 0xd1, 0xc3, 0x88, 0x19, 0x3d, 0x61, 0x4f, 0xad, 0xb7, 0x36, 0xe6, 0xfc,
-	0x49, 0x22, 0x67, 0x17, 0x96, 0x1a, 0xf9, 0x42, 0xb6, 0xa0, 0x53, 0x14, 0x00, 0xdb, 0x5a, 0x6f,
-	0x9d, 0x0b, 0xd4, 0x8c, 0x72, 0x3e, 0x80, 0xee, 0xf7, 0x59, 0xaa, 0x62, 0x0d, 0x31, 0x6e, 0xc0,
-	0x52, 0x21, 0xd2, 0x6c, 0x8d, 0xb4, 0xc9, 0x76, 0xfe, 0x39, 0x03, 0xdd, 0x8a, 0x49, 0xf2, 0x04,
-	0x80, 0x1f, 0x3e, 0x63, 0x81, 0x7c, 0xcc, 0x24, 0x45, 0xa5, 0xee, 0xf6, 0x96, 0x9b, 0xd7, 0x1a,
-	0xb7, 0x5a, 0x6b, 0xdc, 0xe4, 0xf9, 0x40, 0x31, 0x84, 0xab, 0x6a, 0x8d, 0x3b, 0xba, 0xeb, 0x7e,
-	0x62, 0xf4, 0xfc, 0x8a, 0x0d, 0x72, 0x0b, 0x66, 0x84, 0xa4, 0x32, 0x13, 0x7a, 0xf3, 0x34, 0xa5,
-	0x32, 0x69, 0xc8, 0x84, 0x28, 0xf3, 0xb4, 0x20, 0xd5, 0xf6, 0x85, 0x01, 0x8f, 0x75, 0xaa, 0xe2,
-	0xb7, 0xca, 0x2e, 0x21, 0x55, 0x25, 0x1b, 0x8c, 0x75, 0xaa, 0x1a, 0x5a, 0x8d, 0x17, 0x92, 0x25,
-	0xf6, 0x4c, 0x3e, 0x5e, 0x7d, 0xab, 0x5d, 0x12, 0x4c, 0x7e, 0xca, 0xc2, 0xc1, 0xb1, 0xb4, 0x67,
-	0xf3, 0x5d, 0x32, 0x0c, 0xe2, 0xc0, 0x3c, 0x0d, 0x64, 0x46, 0x23, 0x3d, 0xa0, 0x83, 0x03, 0x6a,
-	0x3c, 0x55, 0x45, 0x52, 0x46, 0xfb, 0x63, 0x7b, 0x6e, 0xdd, 0xda, 0x68, 0xfb, 0x39, 0xa1, 0x50,
-	0x07, 0x59, 0x9a, 0xb2, 0x58, 0xda, 0x80, 0xfc, 0x82, 0x54, 0x92, 0x3e, 0x13, 0x61, 0xca, 0xfa,
-	0x76, 0x37, 0x97, 0x68, 0x52, 0x49, 0xb2, 0xa4, 0xaf, 0xaa, 0xb0, 0x3d, 0x9f, 0x4b, 0x34, 0xa9,
-	0x50, 0x9a, 0x90, 0xb0, 0x17, 0x50, 0x56, 0x32, 0xc8, 0x3a, 0x74, 0xd3, 0xbc, 0x2e, 0xb0, 0xfe,
-	0x8e, 0xb4, 0x17, 0x11, 0x64, 0x95, 0x45, 0xd6, 0x00, 0x74, 0x85, 0x57, 0x5b, 0xbc, 0x84, 0x03,
-	0x2a, 0x1c, 0xf2, 0xa1, 0xb2, 0x90, 0x44, 0x61, 0x40, 0x0f, 0x98, 0x14, 0xf6, 0x32, 0xc6, 0xd2,
-	0x5b, 0x65, 0x2c, 0x19, 0x99, 0x8e, 0xfb, 0x72, 0xac, 0x52, 0x65, 0x3f, 0x4d, 0x58, 0x1a, 0x0e,
-	0x59, 0x2c, 0x85, 0xbd, 0xd2, 0x50, 0xdd, 0x33, 0xb2, 0x5c, 0xb5, 0x32, 0x96, 0x7c, 0x1d, 0xe6,
-	0x69, 0x4c, 0xa3, 0xb1, 0x08, 0x85, 0x9f, 0xc5, 0xc2, 0x26, 0xa8, 0x6b, 0x1b, 0xdd, 0x9d, 0x52,
-	0x88, 0xca, 0xb5, 0xd1, 0xe4, 0x3e, 0x80, 0x29, 0xe5, 0xc2, 0x5e, 0x45, 0xdd, 0x5b, 0x46, 0x77,
-	0xb7, 0x10, 0xa1, 0x66, 0x65, 0x24, 0xf9, 0x31, 0xb4, 0xd5, 0xce, 0x0b, 0xfb, 0x06, 0xaa, 0x7c,
-	0xe4, 0x96, 0xc7, 0xad, 0x5b, 0x1c, 0xb7, 0xf8, 0xf1, 0xb4, 0xc8, 0x81, 0x32, 0x84, 0x0d, 0xa7,
-	0x38, 0x6e, 0xdd, 0x5d, 0x1a, 0xd3, 0x74, 0x7c, 0x20, 0x59, 0xe2, 0xe7, 0x66, 0xc9, 0x37, 0x61,
-	0x31, 0x8c, 0x43, 0xb9, 0x5b, 0x62, 0xbb, 0x79, 0x21, 0xb6, 0xc6, 0x68, 0xe7, 0x8f, 0x53, 0xb0,
-	0x58, 0x5f, 0xb5, 0xff, 0x41, 0xb2, 0x15, 0xa9, 0x33, 0x55, 0x4f, 0x1d, 0x73, 0x30, 0xb5, 0x1a,
-	0x07, 0x53, 0x99, 0x9c, 0xd3, 0xe7, 0x25, 0x67, 0xbb, 0x9e, 0x9c, 0x8d, 0x90, 0x9a, 0x79, 0x8d,
-	0x90, 0x6a, 0xc6, 0xc5, 0xec, 0xeb, 0xc4, 0x85, 0xf3, 0xeb, 0x69, 0x58, 0xac, 0x5b, 0xff, 0x3f,
-	0x16, 0xab, 0x62, 0x5d, 0x5b, 0xe7, 0xac, 0xeb, 0xf4, 0xc4, 0x75, 0x55, 0x59, 0xdd, 0xc6, 0xe3,
-	0x53, 0x53, 0x8a, 0x1f, 0x60, 0x64, 0x61, 0xb1, 0xea, 0xf8, 0x9a, 0x52, 0x7c, 0x1a, 0xc8, 0x70,
-	0xc4, 0xb0, 0x56, 0x75, 0x7c, 0x4d, 0xa9, 0x7d, 0x48, 0x94, 0x51, 0xf6, 0x02, 0x6b, 0x54, 0xc7,
-	0x2f, 0xc8, 0xdc, 0x3b, 0xae, 0x86, 0xd0, 0x15, 0xca, 0xd0, 0xf5, 0xb2, 0x02, 0xcd, 0xb2, 0xd2,
-	0x83, 0x8e, 0x64, 0xc3, 0x24, 0xa2, 0x92, 0x61, 0xa5, 0x9a, 0xf3, 0x0d, 0x4d, 0xbe, 0x04, 0x2b,
-	0x22, 0xa0, 0x11, 0x7b, 0xc0, 0x5f, 0xc4, 0x0f, 0x18, 0xed, 0x47, 0x61, 0xcc, 0xb0, 0x68, 0xcd,
-	0xf9, 0x67, 0x05, 0x0a, 0x35, 0xde, 0xad, 0x84, 0xbd, 0x80, 0xe7, 0x9b, 0xa6, 0xc8, 0xe7, 0x61,
-	0x3a, 0xe1, 0x7d, 0x61, 0x2f, 0xe2, 0x06, 0x2f, 0x9b, 0x0d, 0x7e, 0xc2, 0xfb, 0xb8, 0xb1, 0x28,
-	0x55, 0x6b, 0x9a, 0x84, 0xf1, 0x00, 0xcb, 0x56, 0xc7, 0xc7, 0x6f, 0xe4, 0xf1, 0x78, 0x60, 0x2f,
-	0x6b, 0x1e, 0x8f, 0x07, 0xea, 0x48, 0xad, 0xa5, 0xd2, 0xc3, 0xdc, 0xe5, 0x4a, 0x7e, 0xa4, 0x4e,
-	0x10, 0x39, 0x7f, 0xb0, 0x60, 0x56, 0xfb, 0x7a, 0xc3, 0x31, 0x62, 0x0e, 0x91, 0x3c, 0xbd, 0xf4,
-	0x21, 0x82, 0x7b, 0x87, 0x55, 0x5c, 0x60, 0x7c, 0xe0, 0xde, 0xe5, 0xb4, 0xf3, 0x21, 0x2c, 0xd4,
-	0xea, 0xc8, 0xc4, 0x3b, 0x91, 0xb9, 0xe1, 0x4e, 0x55, 0x6e, 0xb8, 0xce, 0x7f, 0x2c, 0x98, 0xfd,
-	0x36, 0x3f, 0xfc, 0x0c, 0x4c, 0x7b, 0x0d, 0x60, 0xc8, 0x64, 0x1a, 0x06, 0xea, 0x9e, 0xa3, 0xe7,
-	0x5e, 0xe1, 0x90, 0x8f, 0x60, 0xae, 0x3c, 0xd7, 0xda, 0x08, 0x6e, 0xf3, 0x72, 0xe0, 0xbe, 0x1b,
-	0x0e, 0x99, 0x5f, 0x2a, 0x3b, 0xff, 0xb0, 0xc0, 0xae, 0xd4, 0x8d, 0x83, 0x84, 0x05, 0x3b, 0x71,
-	0xff, 0x20, 0x87, 0x46, 0x61, 0x5a, 0x24, 0x2c, 0xd0, 0xd3, 0x7f, 0x7c, 0xb5, 0x13, 0xa1, 0xe1,
-	0xc5, 0x47, 0xd3, 0x64, 0x50, 0x5b, 0x95, 0xee, 0xf6, 0x27, 0xd7, 0xe7, 0x04, 0xcd, 0x16, 0xcb,
-	0xec, 0xfc, 0xbb, 0x05, 0x4b, 0x8d, 0x02, 0xf9, 0x19, 0x3e, 0x3f, 0xd6, 0x00, 0x44, 0x16, 0x04,
-	0x4c, 0x88, 0xa3, 0x2c, 0xd2, 0x31, 0x5e, 0xe1, 0x28, 0xbd, 0x23, 0x1a, 0x46, 0xac, 0x8f, 0x75,
-	0xb0, 0xed, 0x6b, 0x4a, 0x5d, 0xcc, 0xc2, 0x38, 0xe0, 0x71, 0x10, 0x65, 0xa2, 0xa8, 0x86, 0x6d,
-	0xbf, 0xc6, 0x53, 0xc1, 0xcf, 0xd2, 0x94, 0xa7, 0x58, 0x11, 0xdb, 0x7e, 0x4e, 0xa8, 0x9a, 0xf3,
-	0x8c, 0x1f, 0xaa, 0x5a, 0x58, 0xaf, 0x39, 0x3a, 0x21, 0x7c, 0x94, 0x92, 0xf7, 0x01, 0x62, 0x1e,
-	0x6b, 0x9e, 0x0d, 0x38, 0x76, 0xd5, 0x8c, 0xfd, 0xd8, 0x88, 0xfc, 0xca, 0x30, 0xb2, 0xa9, 0x0e,
-	0x43, 0x15, 0xbb, 0xc2, 0xee, 0x36, 0xac, 0x3f, 0xce, 0xf9, 0x7e, 0x31, 0x80, 0xec, 0xc3, 0x82,
-	0xa8, 0xc6, 0x20, 0x16, 0xcf, 0xee, 0xf6, 0xbb, 0x93, 0x0e, 0xb9, 0x5a, 0xb0, 0xfa, 0x75, 0x3d,
-	0xe7, 0x57, 0x16, 0x40, 0x89, 0x47, 0x4d, 0x7a, 0x44, 0xa3, 0xac, 0x28, 0x03, 0x39, 0x71, 0x6e,
-	0x4e, 0xd6, 0xf3, 0xaf, 0x75, 0x71, 0xfe, 0x4d, 0x5f, 0x25, 0xff, 0x7e, 0x67, 0xc1, 0xac, 0x5e,
-	0x84, 0x89, 0x95, 0x6a, 0x13, 0x96, 0xf5, 0xb6, 0xef, 0xf2, 0xb8, 0x1f, 0xca, 0xd0, 0x04, 0xd7,
-	0x19, 0xbe, 0x9a, 0x63, 0xc0, 0xb3, 0x58, 0x22, 0xe0, 0xb6, 0x9f, 0x13, 0xea, 0x48, 0xaa, 0x6e,
-	0xff, 0xa3, 0x70, 0x18, 0xe6, 0x98, 0xdb, 0xfe, 0x59, 0x81, 0x0a, 0x20, 0x15, 0x4a, 0x59, 0xaa,
-	0x07, 0xe6, 0xa1, 0x57, 0xe3, 0x6d, 0xff, 0x6b, 0x01, 0x16, 0xf5, 0x9b, 0xe7, 0x80, 0xa5, 0xa3,
-	0x30, 0x60, 0x44, 0xc0, 0xe2, 0x3e, 0x93, 0xd5, 0x87, 0xd0, 0xdb, 0x93, 0x5e, 0x5c, 0xd8, 0xc9,
-	0xe8, 0x4d, 0x7c, 0x8c, 0x39, 0x5b, 0x3f, 0xff, 0xeb, 0xdf, 0x7f, 0x39, 0xb5, 0x49, 0x36, 0xb0,
-	0xfd, 0x33, 0xba, 0x5b, 0xf6, 0x70, 0x4e, 0xcc, 0xf3, 0xf0, 0x34, 0xff, 0x3e, 0xf5, 0x42, 0xe5,
-	0xe2, 0x14, 0x96, 0xf1, 0xd1, 0x7a, 0x25, 0xb7, 0xf7, 0xd1, 0xed, 0x16, 0x71, 0x2f, 0xeb, 0xd6,
-	0x7b, 0xa1, 0x7c, 0x6e, 0x59, 0x64, 0x04, 0xcb, 0xea, 0xb5, 0x59, 0x31, 0x26, 0xc8, 0xe7, 0x26,
-	0xf9, 0x30, 0x3d, 0x9c, 0x9e, 0x7d, 0x9e, 0xd8, 0xb9, 0x83, 0x30, 0xde, 0x23, 0xef, 0x5e, 0x08,
-	0x03, 0xa7, 0xfd, 0x33, 0x0b, 0x56, 0x9a, 0xf3, 0x7e, 0xa5, 0xe7, 0x5e, 0x53, 0x5c, 0x3e, 0xf7,
-	0x1d, 0x0f, 0x7d, 0xdf, 0x21, 0x5f, 0x78, 0xa5, 0x6f, 0x33, 0xf7, 0x1f, 0xc0, 0xfc, 0x3e, 0x93,
-	0xe6, 0x15, 0x4e, 0x6e, 0xb9, 0x79, 0x63, 0xcc, 0x2d, 0x1a, 0x63, 0xee, 0xde, 0x30, 0x91, 0xe3,
-	0x5e, 0x79, 0xb9, 0xaf, 0x35, 0x01, 0x9c, 0xb7, 0xd1, 0xe5, 0x2a, 0x59, 0x29, 0x5c, 0x96, 0x1d,
-	0x80, 0xdf, 0x5a, 0xea, 0x9e, 0x5a, 0x6d, 0xe7, 0x90, 0xb5, 0xca, 0xf5, 0x78, 0x42, 0x9f, 0xa7,
-	0xb7, 0x77, 0xb5, 0x43, 0x43, 0x5b, 0x2b, 0x42, 0xa1, 0xf7, 0xc5, 0xcb, 0x84, 0x82, 0xbe, 0x70,
-	0x7c, 0xd5, 0xda, 0x44, 0xc4, 0xf5, 0xae, 0x51, 0x05, 0xf1, 0xc4, 0x76, 0xd2, 0x1b, 0x41, 0x9c,
-	0xe4, 0x48, 0x14, 0xe2, 0xdf, 0x58, 0x30, 0x5f, 0x6d, 0x44, 0x91, 0xdb, 0x65, 0x7d, 0x3d, 0xdb,
-	0x9f, 0xba, 0x2e, 0xb4, 0xf7, 0x10, 0xad, 0xdb, 0xbb, 0x73, 0x19, 0xb4, 0x54, 0xe1, 0x50, 0x58,
-	0xff, 0x94, 0x77, 0x36, 0x8b, 0xa8, 0xc6, 0x5e, 0x64, 0x99, 0x47, 0x8d, 0x9e, 0xe7, 0x75, 0x41,
-	0xf5, 0x11, 0xea, 0xa3, 0xde, 0xfe, 0xc5, 0x50, 0x35, 0xf7, 0xd4, 0x13, 0x4c, 0x7a, 0x27, 0xe6,
-	0x31, 0x7d, 0xea, 0x9d, 0xe0, 0x8d, 0xf2, 0x1b, 0x9b, 0x9b, 0xa7, 0xde, 0x89, 0xa4, 0x83, 0x53,
-	0x35, 0x91, 0xdf, 0x5b, 0xd0, 0xad, 0x74, 0x44, 0xc9, 0x3b, 0x66, 0x12, 0x67, 0xfb, 0xa4, 0xd7,
-	0x35, 0x8f, 0x1d, 0x9c, 0xc7, 0xd7, 0x7a, 0xf7, 0x2f, 0x39, 0x8f, 0x2c, 0xee, 0x73, 0xef, 0xa4,
-	0xb8, 0x9e, 0x9c, 0x16, 0xb1, 0x52, 0xed, 0x35, 0x56, 0x62, 0x65, 0x42, 0x0b, 0xf2, 0x8d, 0xc4,
-	0x4a, 0xaa, 0x70, 0x28, 0xac, 0x4f, 0x60, 0x56, 0x37, 0xe6, 0xce, 0xad, 0x48, 0xe5, 0x29, 0x50,
-	0x69, 0xf8, 0x39, 0x6f, 0xa1, 0xbb, 0x15, 0xb2, 0x54, 0xb8, 0x1b, 0xe5, 0xc2, 0x6f, 0xed, 0xfd,
-	0xf9, 0xe5, 0x9a, 0xf5, 0x97, 0x97, 0x6b, 0xd6, 0xdf, 0x5e, 0xae, 0x59, 0x3f, 0xfc, 0xe0, 0xd2,
-	0x7f, 0x41, 0xd4, 0xff, 0xf0, 0x38, 0x9c, 0x41, 0x14, 0xef, 0xff, 0x37, 0x00, 0x00, 0xff, 0xff,
-	0x6c, 0x69, 0x4c, 0x07, 0x10, 0x19, 0x00, 0x00,
+	0x11, 0xd7, 0x78, 0xbd, 0xd9, 0x75, 0x6d, 0xfc, 0xaf, 0x9d, 0xe4, 0xe6, 0xf6, 0x82, 0xe5, 0x9b,
+	0x43, 0x3a, 0xc7, 0xc0, 0xac, 0xe3, 0x8b, 0x72, 0x1c, 0xff, 0x24, 0xe3, 0x58, 0x4e, 0x50, 0x72,
+	0x17, 0xc6, 0xc0, 0x09, 0x24, 0x88, 0x7a, 0x67, 0xdb, 0xeb, 0x89, 0x67, 0xa7, 0x87, 0xe9, 0x9e,
+	0x0d, 0x2b, 0xcb, 0x48, 0xf0, 0x05, 0x40, 0xe2, 0x2b, 0xf0, 0x00, 0x4f, 0x08, 0x89, 0x17, 0x1e,
+	0x78, 0x45, 0x3c, 0x22, 0xf1, 0x05, 0x50, 0x84, 0x90, 0x78, 0xe0, 0x81, 0x6f, 0x80, 0xba, 0xba,
+	0xe7, 0xaf, 0xd7, 0x8e, 0x23, 0x1b, 0x72, 0x4f, 0xdb, 0x55, 0xd5, 0x55, 0xf5, 0xeb, 0xee, 0xaa,
+	0xea, 0x9e, 0x5a, 0x78, 0x2f, 0x3e, 0x1a, 0xf6, 0x68, 0x1c, 0xf8, 0x61, 0xc0, 0x22, 0xd9, 0x4b,
+	0x78, 0x18, 0xf2, 0x34, 0xff, 0x75, 0xe3, 0x84, 0x4b, 0x4e, 0x5a, 0x86, 0xec, 0xde, 0x1e, 0x72,
+	0x3e, 0x0c, 0x99, 0x52, 0xe8, 0xd1, 0x28, 0xe2, 0x92, 0xca, 0x80, 0x47, 0x42, 0x4f, 0xeb, 0x3e,
+	0x1e, 0x06, 0xf2, 0x30, 0xed, 0xbb, 0x3e, 0x1f, 0xf5, 0x68, 0x32, 0xe4, 0x71, 0xc2, 0x9f, 0xe3,
+	0xe0, 0x4b, 0x46, 0x5f, 0xf4, 0x8c, 0x37, 0xd1, 0xcb, 0x39, 0xe3, 0xbb, 0x34, 0x8c, 0x0f, 0xe9,
+	0xdd, 0xde, 0x90, 0x45, 0x2c, 0xa1, 0x92, 0x0d, 0x8c, 0xb5, 0x7b, 0x47, 0x5f, 0x16, 0x6e, 0xc0,
+	0xd5, 0xf4, 0x11, 0xf5, 0x0f, 0x83, 0x88, 0x25, 0x93, 0x42, 0x7f, 0xc4, 0x24, 0xed, 0x8d, 0x4f,
+	0x6b, 0xbd, 0x63, 0x10, 0x22, 0xd5, 0x4f, 0x0f, 0x7a, 0x6c, 0x14, 0xcb, 0x89, 0x16, 0x3a, 0x0f,
+	0x60, 0xc9, 0xd3, 0x7e, 0x1f, 0x45, 0x07, 0xfc, 0xdb, 0x29, 0x4b, 0x26, 0x84, 0xc0, 0x6c, 0x44,
+	0x47, 0xcc, 0xb6, 0xd6, 0xac, 0xf5, 0x39, 0x0f, 0xc7, 0xe4, 0x36, 0xcc, 0xa9, 0x5f, 0x11, 0x53,
+	0x9f, 0xd9, 0x33, 0x28, 0x28, 0x18, 0xce, 0x3d, 0xb8, 0x51, 0xb2, 0xf2, 0x38, 0x10, 0x52, 0x5b,
+	0xaa, 0x68, 0x59, 0x75, 0xad, 0x5f, 0x58, 0xb0, 0xb8, 0xcf, 0xe4, 0xa3, 0x11, 0x1d, 0x32, 0x8f,
+	0xfd, 0x38, 0x65, 0x42, 0x12, 0x1b, 0xb2, 0x9d, 0x35, 0xf3, 0x33, 0x52, 0xd9, 0xf2, 0x79, 0x24,
+	0xa9, 0x5a, 0x75, 0x86, 0x20, 0x67, 0x90, 0x1b, 0xd0, 0x0c, 0x94, 0x1d, 0xbb, 0x81, 0x12, 0x4d,
+	0x90, 0x25, 0x68, 0x48, 0x3a, 0xb4, 0x67, 0x91, 0xa7, 0x86, 0x55, 0x44, 0xcd, 0x3a, 0xa2, 0x43,
+	0x20, 0xdf, 0x8d, 0x06, 0xdc, 0xac, 0xe5, 0xd5, 0x98, 0xba, 0xd0, 0x4e, 0xd8, 0x38, 0x10, 0x01,
+	0x8f, 0x10, 0x52, 0xc3, 0xcb, 0xe9, 0xaa, 0xa7, 0x46, 0xdd, 0xd3, 0x23, 0xb8, 0xe9, 0x31, 0x21,
+	0x69, 0x22, 0x6b, 0xce, 0x5e, 0x7f, 0xf3, 0x7f, 0x08, 0x37, 0x9f, 0x26, 0x7c, 0xc4, 0x25, 0xbb,
+	0xac, 0x29, 0xa5, 0x71, 0x90, 0x86, 0x21, 0xc2, 0x6d, 0x7b, 0x38, 0x76, 0xf6, 0x60, 0x65, 0xbb,
+	0xcf, 0xaf, 0x00, 0xe7, 0x1e, 0xac, 0x78, 0x4c, 0x26, 0x93, 0x4b, 0x1b, 0x7a, 0x06, 0xcb, 0xc6,
+	0xc6, 0xa7, 0x54, 0xfa, 0x87, 0xbb, 0x63, 0x16, 0xa1, 0x19, 0x39, 0x89, 0x73, 0x33, 0x6a, 0x4c,
+	0xee, 0x43, 0x27, 0x29, 0xc2, 0x12, 0x0d, 0x75, 0xb6, 0x6e, 0xb8, 0x86, 0xe7, 0x96, 0x42, 0xd6,
+	0x2b, 0x4f, 0x74, 0x9e, 0xc1, 0xfc, 0xc7, 0x99, 0x37, 0xc5, 0x38, 0x3f, 0x8e, 0xc9, 0x26, 0xac,
+	0xd0, 0x31, 0x0d, 0x42, 0xda, 0x0f, 0x59, 0xae, 0x27, 0xec, 0x99, 0xb5, 0xc6, 0xfa, 0x9c, 0x37,
+	0x4d, 0xe4, 0xec, 0xc0, 0x62, 0x2d, 0x5f, 0xc8, 0x26, 0xb4, 0xb3, 0x02, 0x60, 0x5b, 0x6b, 0x8d,
+	0x33, 0x81, 0xe6, 0xb3, 0x9c, 0x0f, 0xa1, 0xf3, 0x3d, 0x96, 0xa8, 0x58, 0x43, 0x8c, 0xeb, 0xb0,
+	0x98, 0x89, 0x0c, 0xdb, 0x20, 0xad, 0xb3, 0x9d, 0x5f, 0xb6, 0xa0, 0x53, 0x32, 0x49, 0x9e, 0x02,
+	0xf0, 0xfe, 0x73, 0xe6, 0xcb, 0x27, 0x4c, 0x52, 0x54, 0xea, 0x6c, 0x6d, 0xba, 0xba, 0xd6, 0xb8,
+	0xe5, 0x5a, 0xe3, 0xc6, 0x47, 0x43, 0xc5, 0x10, 0xae, 0xaa, 0x35, 0xee, 0xf8, 0xae, 0xfb, 0x49,
+	0xae, 0xe7, 0x95, 0x6c, 0x90, 0x5b, 0x70, 0x4d, 0x48, 0x2a, 0x53, 0x61, 0x0e, 0xcf, 0x50, 0x2a,
+	0x93, 0x46, 0x4c, 0x88, 0x22, 0x4f, 0x33, 0x52, 0x1d, 0x5f, 0xe0, 0xf3, 0xc8, 0xa4, 0x2a, 0x8e,
+	0x55, 0x76, 0x09, 0xa9, 0x2a, 0xd9, 0x70, 0x62, 0x52, 0x35, 0xa7, 0xd5, 0x7c, 0x21, 0x59, 0x6c,
+	0x5f, 0xd3, 0xf3, 0xd5, 0x58, 0x9d, 0x92, 0x60, 0xf2, 0x53, 0x16, 0x0c, 0x0f, 0xa5, 0xdd, 0xd2,
+	0xa7, 0x94, 0x33, 0x88, 0x03, 0xd7, 0xa9, 0x2f, 0x53, 0x1a, 0x9a, 0x09, 0x6d, 0x9c, 0x50, 0xe1,
+	0xa9, 0x2a, 0x92, 0x30, 0x3a, 0x98, 0xd8, 0x73, 0x6b, 0xd6, 0x7a, 0xd3, 0xd3, 0x84, 0x42, 0xed,
+	0xa7, 0x49, 0xc2, 0x22, 0x69, 0x03, 0xf2, 0x33, 0x52, 0x49, 0x06, 0x4c, 0x04, 0x09, 0x1b, 0xd8,
+	0x1d, 0x2d, 0x31, 0xa4, 0x92, 0xa4, 0xf1, 0x40, 0x55, 0x61, 0xfb, 0xba, 0x96, 0x18, 0x52, 0xa1,
+	0xcc, 0x43, 0xc2, 0x9e, 0x47, 0x59, 0xc1, 0x20, 0x6b, 0xd0, 0x49, 0x74, 0x5d, 0x60, 0x83, 0x6d,
+	0x69, 0x2f, 0x20, 0xc8, 0x32, 0x8b, 0xac, 0x02, 0x98, 0x0a, 0xaf, 0x8e, 0x78, 0x11, 0x27, 0x94,
+	0x38, 0xe4, 0x23, 0x65, 0x21, 0x0e, 0x03, 0x9f, 0xee, 0x33, 0x29, 0xec, 0x25, 0x8c, 0xa5, 0xb7,
+	0x8a, 0x58, 0xca, 0x65, 0x26, 0xee, 0x8b, 0xb9, 0x4a, 0x95, 0xfd, 0x24, 0x66, 0x49, 0x30, 0x62,
+	0x91, 0x14, 0xf6, 0x72, 0x4d, 0x75, 0x37, 0x97, 0x69, 0xd5, 0xd2, 0x5c, 0xf2, 0x35, 0xb8, 0x4e,
+	0x23, 0x1a, 0x4e, 0x44, 0x20, 0xbc, 0x34, 0x12, 0x36, 0x41, 0x5d, 0x3b, 0xd7, 0xdd, 0x2e, 0x84,
+	0xa8, 0x5c, 0x99, 0x4d, 0xee, 0x03, 0xe4, 0xa5, 0x5c, 0xd8, 0x2b, 0xa8, 0x7b, 0x2b, 0xd7, 0xdd,
+	0xc9, 0x44, 0xa8, 0x59, 0x9a, 0x49, 0x7e, 0x04, 0x4d, 0x75, 0xf2, 0xc2, 0xbe, 0x81, 0x2a, 0x0f,
+	0xdd, 0xe2, 0xba, 0x75, 0xb3, 0xeb, 0x16, 0x07, 0xcf, 0xb2, 0x1c, 0x28, 0x42, 0x38, 0xe7, 0x64,
+	0xd7, 0xad, 0xbb, 0x43, 0x23, 0x9a, 0x4c, 0xf6, 0x25, 0x8b, 0x3d, 0x6d, 0x96, 0x7c, 0x03, 0x16,
+	0x82, 0x28, 0x90, 0x3b, 0x05, 0xb6, 0x9b, 0xe7, 0x62, 0xab, 0xcd, 0x26, 0xef, 0x43, 0xf3, 0x90,
+	0xf3, 0x23, 0x61, 0xdf, 0x42, 0xb5, 0xe5, 0x5c, 0xed, 0x21, 0xe7, 0x47, 0xa8, 0xa1, 0xe5, 0xce,
+	0x9f, 0x66, 0x60, 0xa1, 0xba, 0xbd, 0xff, 0x83, 0xac, 0xcc, 0x72, 0x6c, 0xa6, 0x9a, 0x63, 0xf9,
+	0x0d, 0xd6, 0xa8, 0xdd, 0x60, 0x45, 0x16, 0xcf, 0x9e, 0x95, 0xc5, 0xcd, 0x6a, 0x16, 0xd7, 0x62,
+	0xef, 0xda, 0x6b, 0xc4, 0x5e, 0x3d, 0x80, 0x5a, 0xaf, 0x13, 0x40, 0xce, 0x6f, 0x66, 0x61, 0xa1,
+	0x6a, 0xfd, 0xff, 0x58, 0xd5, 0xb2, 0x7d, 0x6d, 0x9c, 0xb1, 0xaf, 0xb3, 0x53, 0xf7, 0xb5, 0x1f,
+	0xea, 0xed, 0x6b, 0x7b, 0x86, 0x52, 0x7c, 0x1f, 0x43, 0x10, 0xab, 0x5a, 0xdb, 0x33, 0x94, 0xe2,
+	0x53, 0x5f, 0x06, 0x63, 0x86, 0x45, 0xad, 0xed, 0x19, 0x4a, 0x9d, 0x43, 0xac, 0x8c, 0xb2, 0x17,
+	0x58, 0xcc, 0xda, 0x5e, 0x46, 0x6a, 0xef, 0xb8, 0x1b, 0xc2, 0x94, 0xb2, 0x9c, 0xae, 0xd6, 0x1f,
+	0xa8, 0xd7, 0x9f, 0x2e, 0xb4, 0x25, 0x1b, 0xc5, 0x21, 0x95, 0x0c, 0x4b, 0xda, 0x9c, 0x97, 0xd3,
+	0xe4, 0x8b, 0xb0, 0x2c, 0x7c, 0x1a, 0xb2, 0x07, 0xfc, 0x45, 0xf4, 0x80, 0xd1, 0x41, 0x18, 0x44,
+	0x0c, 0xab, 0xdb, 0x9c, 0x77, 0x5a, 0xa0, 0x50, 0xe3, 0x23, 0x4c, 0xd8, 0xf3, 0x78, 0x11, 0x1a,
+	0x8a, 0x7c, 0x1e, 0x66, 0x63, 0x3e, 0x10, 0xf6, 0x02, 0x1e, 0xf0, 0x52, 0x7e, 0xc0, 0x4f, 0xf9,
+	0x00, 0x0f, 0x16, 0xa5, 0x6a, 0x4f, 0xe3, 0x20, 0x1a, 0x62, 0x7d, 0x6b, 0x7b, 0x38, 0x46, 0x1e,
+	0x8f, 0x86, 0xf6, 0x92, 0xe1, 0xf1, 0x68, 0xa8, 0xee, 0xde, 0x4a, 0xce, 0x3d, 0xd2, 0x2e, 0x97,
+	0xf5, 0xdd, 0x3b, 0x45, 0xe4, 0xfc, 0xd1, 0x82, 0x96, 0xf1, 0xf5, 0x86, 0x63, 0x24, 0xbf, 0x6d,
+	0x74, 0x7a, 0x69, 0x42, 0x9f, 0x1d, 0x96, 0x7b, 0x61, 0x37, 0xb3, 0xb3, 0xd3, 0xb4, 0xf3, 0x11,
+	0xcc, 0x57, 0x0a, 0xce, 0xd4, 0xc7, 0x53, 0xfe, 0x14, 0x9e, 0x29, 0x3d, 0x85, 0x9d, 0x9f, 0x42,
+	0x3b, 0x2b, 0x3a, 0x53, 0xdf, 0x4a, 0x36, 0xb4, 0x9e, 0xf3, 0xbe, 0x7a, 0xa3, 0x18, 0xbd, 0x8c,
+	0x2c, 0x2d, 0xa9, 0x31, 0x75, 0x49, 0xe5, 0x2b, 0xfb, 0xcc, 0xd2, 0xe0, 0xfc, 0xc7, 0x82, 0xd6,
+	0xb7, 0x78, 0xff, 0x33, 0xb0, 0xed, 0xab, 0x00, 0x23, 0x26, 0x93, 0xc0, 0xc7, 0xc5, 0x6a, 0xf4,
+	0x25, 0x0e, 0x79, 0x08, 0x73, 0xc5, 0x05, 0xdc, 0x44, 0x70, 0x1b, 0x17, 0x03, 0xf7, 0x9d, 0x60,
+	0xc4, 0xbc, 0x42, 0xd9, 0xf9, 0xa7, 0x05, 0x76, 0xa9, 0x6e, 0xed, 0xc7, 0xcc, 0xdf, 0x8e, 0x06,
+	0xfb, 0x1a, 0x1a, 0x85, 0x59, 0x11, 0x33, 0xdf, 0x2c, 0xff, 0xc9, 0xe5, 0xae, 0xae, 0x9a, 0x17,
+	0x0f, 0x4d, 0x93, 0x61, 0x65, 0x57, 0x3a, 0x5b, 0x9f, 0x5c, 0x9d, 0x13, 0x34, 0x9b, 0x6d, 0xb3,
+	0xf3, 0xef, 0x06, 0x2c, 0xd6, 0x0a, 0xf4, 0x67, 0xf8, 0xfe, 0x5a, 0x05, 0x10, 0xa9, 0xef, 0x33,
+	0x21, 0x0e, 0xd2, 0xd0, 0xe4, 0x58, 0x89, 0xa3, 0xf4, 0x0e, 0x68, 0x10, 0xb2, 0x01, 0xd6, 0xe1,
+	0xa6, 0x67, 0x28, 0xf5, 0x82, 0x0c, 0x22, 0x9f, 0x47, 0x7e, 0x98, 0x8a, 0xac, 0x1a, 0x37, 0xbd,
+	0x0a, 0x4f, 0x25, 0x1f, 0x4b, 0x12, 0x9e, 0x60, 0x45, 0x6e, 0x7a, 0x9a, 0x50, 0x35, 0xef, 0x39,
+	0xef, 0xab, 0x5a, 0x5c, 0xad, 0x79, 0x26, 0x21, 0x3c, 0x94, 0x92, 0x0f, 0x00, 0x22, 0x1e, 0x19,
+	0x9e, 0x0d, 0x38, 0x77, 0x25, 0x9f, 0xfb, 0x71, 0x2e, 0xf2, 0x4a, 0xd3, 0xc8, 0x06, 0xb4, 0x74,
+	0xec, 0x0a, 0xbb, 0x53, 0xb3, 0xfe, 0x44, 0xf3, 0xbd, 0x6c, 0x02, 0xd9, 0x83, 0x79, 0x51, 0x8e,
+	0x41, 0x2c, 0xde, 0x9d, 0xad, 0x77, 0xa7, 0x5d, 0xb2, 0x95, 0x60, 0xf5, 0xaa, 0x7a, 0xce, 0xaf,
+	0x2d, 0x80, 0x02, 0x8f, 0x5a, 0xf4, 0x98, 0x86, 0x69, 0x56, 0x50, 0x34, 0x71, 0x66, 0x4e, 0x56,
+	0xf3, 0xaf, 0x71, 0x7e, 0xfe, 0xcd, 0x5e, 0x26, 0xff, 0x7e, 0x6f, 0x41, 0xcb, 0x6c, 0xc2, 0xd4,
+	0x4a, 0xb9, 0x01, 0x4b, 0xe6, 0xd8, 0x77, 0x78, 0x34, 0x08, 0x64, 0x90, 0x07, 0xd7, 0x29, 0xbe,
+	0x5a, 0xa3, 0xcf, 0xd3, 0x48, 0x22, 0xe0, 0xa6, 0xa7, 0x09, 0x75, 0x25, 0x96, 0x8f, 0xff, 0x71,
+	0x30, 0x0a, 0x34, 0xe6, 0xa6, 0x77, 0x5a, 0xa0, 0x02, 0x48, 0x85, 0x52, 0x9a, 0x98, 0x89, 0x3a,
+	0xf4, 0x2a, 0xbc, 0xad, 0x7f, 0xcd, 0xc3, 0x82, 0xf9, 0x38, 0xdb, 0x67, 0xc9, 0x38, 0xf0, 0x19,
+	0x11, 0xb0, 0xb0, 0xc7, 0x64, 0xf9, 0x8b, 0xed, 0xed, 0x69, 0x9f, 0x86, 0xd8, 0x72, 0xe9, 0x4e,
+	0xfd, 0x6a, 0x74, 0x36, 0x7f, 0xfe, 0xb7, 0x7f, 0xfc, 0x6a, 0x66, 0x83, 0xac, 0x63, 0x9f, 0x6a,
+	0x7c, 0xb7, 0x68, 0x36, 0x1d, 0xe7, 0xdf, 0xb1, 0x27, 0x7a, 0x7c, 0xd2, 0x0b, 0x94, 0x8b, 0x13,
+	0x58, 0xc2, 0xaf, 0xeb, 0x4b, 0xb9, 0xbd, 0x8f, 0x6e, 0x37, 0x89, 0x7b, 0x51, 0xb7, 0xbd, 0x17,
+	0xca, 0xe7, 0xa6, 0x45, 0xc6, 0xb0, 0xa4, 0x3e, 0x8b, 0x4b, 0xc6, 0x04, 0xf9, 0xdc, 0x34, 0x1f,
+	0x79, 0xb3, 0xa9, 0x6b, 0x9f, 0x25, 0x76, 0xee, 0x20, 0x8c, 0xf7, 0xc8, 0xbb, 0xe7, 0xc2, 0xc0,
+	0x65, 0xff, 0xcc, 0x82, 0xe5, 0xfa, 0xba, 0x5f, 0xe9, 0xb9, 0x5b, 0x17, 0x17, 0x7d, 0x09, 0xa7,
+	0x87, 0xbe, 0xef, 0x90, 0xf7, 0x5f, 0xe9, 0x3b, 0x5f, 0xfb, 0xf7, 0xe1, 0xfa, 0x1e, 0x93, 0x79,
+	0xbb, 0x80, 0xdc, 0x72, 0x75, 0x07, 0xcf, 0xcd, 0x3a, 0x78, 0xee, 0xae, 0xea, 0xe0, 0x75, 0x8b,
+	0xaf, 0x90, 0x4a, 0xb7, 0xc2, 0x79, 0x1b, 0x5d, 0xae, 0x90, 0xe5, 0xcc, 0x65, 0xee, 0x88, 0xfc,
+	0xce, 0x52, 0xef, 0xe4, 0x72, 0xdf, 0x89, 0xac, 0x16, 0xe0, 0xa7, 0x35, 0xa4, 0xba, 0xbb, 0x97,
+	0xbb, 0x34, 0x8c, 0xb5, 0x2c, 0x14, 0xba, 0x5f, 0xb8, 0x48, 0x28, 0x98, 0x07, 0xcf, 0x57, 0xac,
+	0x0d, 0x44, 0x5c, 0x6d, 0x6f, 0x95, 0x10, 0x4f, 0xed, 0x7b, 0xbd, 0x11, 0xc4, 0xb1, 0x46, 0xa2,
+	0x10, 0xff, 0xd6, 0x82, 0xeb, 0xe5, 0x8e, 0x19, 0xb9, 0x5d, 0xd4, 0xd7, 0xd3, 0x8d, 0xb4, 0xab,
+	0x42, 0x7b, 0x0f, 0xd1, 0xba, 0xdd, 0x3b, 0x17, 0x41, 0x4b, 0x15, 0x0e, 0x85, 0xf5, 0xcf, 0xba,
+	0x05, 0x9b, 0x45, 0x35, 0x36, 0x4d, 0x8b, 0x3c, 0xaa, 0x35, 0x67, 0xaf, 0x0a, 0xaa, 0x87, 0x50,
+	0x1f, 0x77, 0xf7, 0xce, 0x87, 0x6a, 0xb8, 0x27, 0x3d, 0xc1, 0x64, 0xef, 0x38, 0xff, 0xea, 0x3f,
+	0xe9, 0x1d, 0xe3, 0x8b, 0xf6, 0xeb, 0x1b, 0x1b, 0x27, 0xbd, 0x63, 0x49, 0x87, 0x27, 0x6a, 0x21,
+	0x7f, 0xb0, 0xa0, 0x53, 0x6a, 0xdd, 0x92, 0x77, 0xf2, 0x45, 0x9c, 0x6e, 0xe8, 0x5e, 0xd5, 0x3a,
+	0xb6, 0x71, 0x1d, 0x5f, 0xed, 0xde, 0xbf, 0xe0, 0x3a, 0xd2, 0x68, 0xc0, 0x7b, 0xc7, 0xd9, 0xf3,
+	0xe4, 0x24, 0x8b, 0x95, 0x72, 0x53, 0xb4, 0x14, 0x2b, 0x53, 0x7a, 0xa5, 0x6f, 0x24, 0x56, 0x12,
+	0x85, 0x43, 0x61, 0x7d, 0x0a, 0x2d, 0xd3, 0x41, 0x3c, 0xb3, 0x22, 0x15, 0xb7, 0x40, 0xa9, 0x33,
+	0xe9, 0xbc, 0x85, 0xee, 0x96, 0xc9, 0x62, 0xe6, 0x6e, 0xac, 0x85, 0xdf, 0xdc, 0xfd, 0xcb, 0xcb,
+	0x55, 0xeb, 0xaf, 0x2f, 0x57, 0xad, 0xbf, 0xbf, 0x5c, 0xb5, 0x7e, 0xf0, 0xe1, 0x85, 0xff, 0x2b,
+	0xa9, 0xfe, 0x33, 0xd3, 0xbf, 0x86, 0x28, 0x3e, 0xf8, 0xef, 0x00, 0x2d, 0x43, 0xc6, 0x1d, 0xb9,
+	0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2964,6 +3055,22 @@ func (m *RolloutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if len(m.InitContainers) > 0 {
 		for iNdEx := len(m.InitContainers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3540,6 +3647,68 @@ func (m *ContainerInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HookInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HookInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HookInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Icon) > 0 {
+		i -= len(m.Icon)
+		copy(dAtA[i:], m.Icon)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Icon)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobName) > 0 {
+		i -= len(m.JobName)
+		copy(dAtA[i:], m.JobName)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.JobName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *JobInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4269,6 +4438,12 @@ func (m *RolloutInfo) Size() (n int) {
 			n += 2 + l + sovRollout(uint64(l))
 		}
 	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4446,6 +4621,38 @@ func (m *ContainerInfo) Size() (n int) {
 	return n
 }
 
+func (m *HookInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.JobName)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Icon)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovRollout(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *JobInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6699,6 +6906,40 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hooks = append(m.Hooks, &HookInfo{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -7798,6 +8039,217 @@ func (m *ContainerInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HookInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HookInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HookInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Icon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Icon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -43,4 +43,24 @@ const (
 	AnalysisRunSingular string = "analysisrun"
 	AnalysisRunPlural   string = "analysisruns"
 	AnalysisRunFullName string = AnalysisRunPlural + "." + Group
+
+	RolloutPolicyKind     string = "RolloutPolicy"
+	RolloutPolicySingular string = "rolloutpolicy"
+	RolloutPolicyPlural   string = "rolloutpolicies"
+	RolloutPolicyFullName string = RolloutPolicyPlural + "." + Group
+
+	ClusterRolloutPolicyKind     string = "ClusterRolloutPolicy"
+	ClusterRolloutPolicySingular string = "clusterrolloutpolicy"
+	ClusterRolloutPolicyPlural   string = "clusterrolloutpolicies"
+	ClusterRolloutPolicyFullName string = ClusterRolloutPolicyPlural + "." + Group
+
+	RolloutStrategyTemplateKind     string = "RolloutStrategyTemplate"
+	RolloutStrategyTemplateSingular string = "rolloutstrategytemplate"
+	RolloutStrategyTemplatePlural   string = "rolloutstrategytemplates"
+	RolloutStrategyTemplateFullName string = RolloutStrategyTemplatePlural + "." + Group
+
+	ClusterRolloutStrategyTemplateKind     string = "ClusterRolloutStrategyTemplate"
+	ClusterRolloutStrategyTemplateSingular string = "clusterrolloutstrategytemplate"
+	ClusterRolloutStrategyTemplatePlural   string = "clusterrolloutstrategytemplates"
+	ClusterRolloutStrategyTemplateFullName string = ClusterRolloutStrategyTemplatePlural + "." + Group
 )
@@ -803,10 +803,66 @@ func (m *BlueGreenStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BlueGreenStrategy proto.InternalMessageInfo
 
+func (m *BlueGreenTrafficStep) Reset()      { *m = BlueGreenTrafficStep{} }
+func (*BlueGreenTrafficStep) ProtoMessage() {}
+func (*BlueGreenTrafficStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{27}
+}
+func (m *BlueGreenTrafficStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlueGreenTrafficStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlueGreenTrafficStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlueGreenTrafficStep.Merge(m, src)
+}
+func (m *BlueGreenTrafficStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlueGreenTrafficStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlueGreenTrafficStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlueGreenTrafficStep proto.InternalMessageInfo
+
+func (m *CanaryAbortPolicy) Reset()      { *m = CanaryAbortPolicy{} }
+func (*CanaryAbortPolicy) ProtoMessage() {}
+func (*CanaryAbortPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{28}
+}
+func (m *CanaryAbortPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanaryAbortPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CanaryAbortPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanaryAbortPolicy.Merge(m, src)
+}
+func (m *CanaryAbortPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanaryAbortPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanaryAbortPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanaryAbortPolicy proto.InternalMessageInfo
+
 func (m *CanaryStatus) Reset()      { *m = CanaryStatus{} }
 func (*CanaryStatus) ProtoMessage() {}
 func (*CanaryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{27}
+	return fileDescriptor_e0e705f843545fab, []int{29}
 }
 func (m *CanaryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +890,7 @@ var xxx_messageInfo_CanaryStatus proto.InternalMessageInfo
 func (m *CanaryStep) Reset()      { *m = CanaryStep{} }
 func (*CanaryStep) ProtoMessage() {}
 func (*CanaryStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{28}
+	return fileDescriptor_e0e705f843545fab, []int{30}
 }
 func (m *CanaryStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +918,7 @@ var xxx_messageInfo_CanaryStep proto.InternalMessageInfo
 func (m *CanaryStrategy) Reset()      { *m = CanaryStrategy{} }
 func (*CanaryStrategy) ProtoMessage() {}
 func (*CanaryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{29}
+	return fileDescriptor_e0e705f843545fab, []int{31}
 }
 func (m *CanaryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -890,7 +946,7 @@ var xxx_messageInfo_CanaryStrategy proto.InternalMessageInfo
 func (m *CloudWatchMetric) Reset()      { *m = CloudWatchMetric{} }
 func (*CloudWatchMetric) ProtoMessage() {}
 func (*CloudWatchMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{30}
+	return fileDescriptor_e0e705f843545fab, []int{32}
 }
 func (m *CloudWatchMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +974,7 @@ var xxx_messageInfo_CloudWatchMetric proto.InternalMessageInfo
 func (m *CloudWatchMetricDataQuery) Reset()      { *m = CloudWatchMetricDataQuery{} }
 func (*CloudWatchMetricDataQuery) ProtoMessage() {}
 func (*CloudWatchMetricDataQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{31}
+	return fileDescriptor_e0e705f843545fab, []int{33}
 }
 func (m *CloudWatchMetricDataQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +1002,7 @@ var xxx_messageInfo_CloudWatchMetricDataQuery proto.InternalMessageInfo
 func (m *CloudWatchMetricStat) Reset()      { *m = CloudWatchMetricStat{} }
 func (*CloudWatchMetricStat) ProtoMessage() {}
 func (*CloudWatchMetricStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{32}
+	return fileDescriptor_e0e705f843545fab, []int{34}
 }
 func (m *CloudWatchMetricStat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1030,7 @@ var xxx_messageInfo_CloudWatchMetricStat proto.InternalMessageInfo
 func (m *CloudWatchMetricStatMetric) Reset()      { *m = CloudWatchMetricStatMetric{} }
 func (*CloudWatchMetricStatMetric) ProtoMessage() {}
 func (*CloudWatchMetricStatMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{33}
+	return fileDescriptor_e0e705f843545fab, []int{35}
 }
 func (m *CloudWatchMetricStatMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1058,7 @@ var xxx_messageInfo_CloudWatchMetricStatMetric proto.InternalMessageInfo
 func (m *CloudWatchMetricStatMetricDimension) Reset()      { *m = CloudWatchMetricStatMetricDimension{} }
 func (*CloudWatchMetricStatMetricDimension) ProtoMessage() {}
 func (*CloudWatchMetricStatMetricDimension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{34}
+	return fileDescriptor_e0e705f843545fab, []int{36}
 }
 func (m *CloudWatchMetricStatMetricDimension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1086,7 @@ var xxx_messageInfo_CloudWatchMetricStatMetricDimension proto.InternalMessageInf
 func (m *ClusterAnalysisTemplate) Reset()      { *m = ClusterAnalysisTemplate{} }
 func (*ClusterAnalysisTemplate) ProtoMessage() {}
 func (*ClusterAnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{35}
+	return fileDescriptor_e0e705f843545fab, []int{37}
 }
 func (m *ClusterAnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1114,7 @@ var xxx_messageInfo_ClusterAnalysisTemplate proto.InternalMessageInfo
 func (m *ClusterAnalysisTemplateList) Reset()      { *m = ClusterAnalysisTemplateList{} }
 func (*ClusterAnalysisTemplateList) ProtoMessage() {}
 func (*ClusterAnalysisTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{36}
+	return fileDescriptor_e0e705f843545fab, []int{38}
 }
 func (m *ClusterAnalysisTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,10 +1139,234 @@ func (m *ClusterAnalysisTemplateList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterAnalysisTemplateList proto.InternalMessageInfo
 
+func (m *ClusterRolloutPolicy) Reset()      { *m = ClusterRolloutPolicy{} }
+func (*ClusterRolloutPolicy) ProtoMessage() {}
+func (*ClusterRolloutPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{39}
+}
+func (m *ClusterRolloutPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRolloutPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterRolloutPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRolloutPolicy.Merge(m, src)
+}
+func (m *ClusterRolloutPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRolloutPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRolloutPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRolloutPolicy proto.InternalMessageInfo
+
+func (m *ClusterRolloutPolicyList) Reset()      { *m = ClusterRolloutPolicyList{} }
+func (*ClusterRolloutPolicyList) ProtoMessage() {}
+func (*ClusterRolloutPolicyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{40}
+}
+func (m *ClusterRolloutPolicyList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRolloutPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterRolloutPolicyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRolloutPolicyList.Merge(m, src)
+}
+func (m *ClusterRolloutPolicyList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRolloutPolicyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRolloutPolicyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRolloutPolicyList proto.InternalMessageInfo
+
+func (m *ClusterRolloutStrategyTemplate) Reset()      { *m = ClusterRolloutStrategyTemplate{} }
+func (*ClusterRolloutStrategyTemplate) ProtoMessage() {}
+func (*ClusterRolloutStrategyTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{41}
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRolloutStrategyTemplate.Merge(m, src)
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRolloutStrategyTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRolloutStrategyTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRolloutStrategyTemplate proto.InternalMessageInfo
+
+func (m *ClusterRolloutStrategyTemplateList) Reset()      { *m = ClusterRolloutStrategyTemplateList{} }
+func (*ClusterRolloutStrategyTemplateList) ProtoMessage() {}
+func (*ClusterRolloutStrategyTemplateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{42}
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterRolloutStrategyTemplateList.Merge(m, src)
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterRolloutStrategyTemplateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterRolloutStrategyTemplateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterRolloutStrategyTemplateList proto.InternalMessageInfo
+
+func (m *ConfigRef) Reset()      { *m = ConfigRef{} }
+func (*ConfigRef) ProtoMessage() {}
+func (*ConfigRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{43}
+}
+func (m *ConfigRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConfigRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ConfigRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigRef.Merge(m, src)
+}
+func (m *ConfigRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConfigRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigRef proto.InternalMessageInfo
+
+func (m *ContourTrafficRouting) Reset()      { *m = ContourTrafficRouting{} }
+func (*ContourTrafficRouting) ProtoMessage() {}
+func (*ContourTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{44}
+}
+func (m *ContourTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContourTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContourTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContourTrafficRouting.Merge(m, src)
+}
+func (m *ContourTrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContourTrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContourTrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContourTrafficRouting proto.InternalMessageInfo
+
+func (m *DNSTrafficRouting) Reset()      { *m = DNSTrafficRouting{} }
+func (*DNSTrafficRouting) ProtoMessage() {}
+func (*DNSTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{45}
+}
+func (m *DNSTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DNSTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DNSTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DNSTrafficRouting.Merge(m, src)
+}
+func (m *DNSTrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *DNSTrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_DNSTrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DNSTrafficRouting proto.InternalMessageInfo
+
+func (m *DaemonSetCanaryStrategy) Reset()      { *m = DaemonSetCanaryStrategy{} }
+func (*DaemonSetCanaryStrategy) ProtoMessage() {}
+func (*DaemonSetCanaryStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{46}
+}
+func (m *DaemonSetCanaryStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DaemonSetCanaryStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DaemonSetCanaryStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DaemonSetCanaryStrategy.Merge(m, src)
+}
+func (m *DaemonSetCanaryStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DaemonSetCanaryStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DaemonSetCanaryStrategy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DaemonSetCanaryStrategy proto.InternalMessageInfo
+
 func (m *DatadogMetric) Reset()      { *m = DatadogMetric{} }
 func (*DatadogMetric) ProtoMessage() {}
 func (*DatadogMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{37}
+	return fileDescriptor_e0e705f843545fab, []int{47}
 }
 func (m *DatadogMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1394,7 @@ var xxx_messageInfo_DatadogMetric proto.InternalMessageInfo
 func (m *DryRun) Reset()      { *m = DryRun{} }
 func (*DryRun) ProtoMessage() {}
 func (*DryRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{38}
+	return fileDescriptor_e0e705f843545fab, []int{48}
 }
 func (m *DryRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,7 +1422,7 @@ var xxx_messageInfo_DryRun proto.InternalMessageInfo
 func (m *Experiment) Reset()      { *m = Experiment{} }
 func (*Experiment) ProtoMessage() {}
 func (*Experiment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{39}
+	return fileDescriptor_e0e705f843545fab, []int{49}
 }
 func (m *Experiment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1450,7 @@ var xxx_messageInfo_Experiment proto.InternalMessageInfo
 func (m *ExperimentAnalysisRunStatus) Reset()      { *m = ExperimentAnalysisRunStatus{} }
 func (*ExperimentAnalysisRunStatus) ProtoMessage() {}
 func (*ExperimentAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{40}
+	return fileDescriptor_e0e705f843545fab, []int{50}
 }
 func (m *ExperimentAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1478,7 @@ var xxx_messageInfo_ExperimentAnalysisRunStatus proto.InternalMessageInfo
 func (m *ExperimentAnalysisTemplateRef) Reset()      { *m = ExperimentAnalysisTemplateRef{} }
 func (*ExperimentAnalysisTemplateRef) ProtoMessage() {}
 func (*ExperimentAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{41}
+	return fileDescriptor_e0e705f843545fab, []int{51}
 }
 func (m *ExperimentAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +1506,7 @@ var xxx_messageInfo_ExperimentAnalysisTemplateRef proto.InternalMessageInfo
 func (m *ExperimentCondition) Reset()      { *m = ExperimentCondition{} }
 func (*ExperimentCondition) ProtoMessage() {}
 func (*ExperimentCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{42}
+	return fileDescriptor_e0e705f843545fab, []int{52}
 }
 func (m *ExperimentCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1534,7 @@ var xxx_messageInfo_ExperimentCondition proto.InternalMessageInfo
 func (m *ExperimentList) Reset()      { *m = ExperimentList{} }
 func (*ExperimentList) ProtoMessage() {}
 func (*ExperimentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{43}
+	return fileDescriptor_e0e705f843545fab, []int{53}
 }
 func (m *ExperimentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,7 +1562,7 @@ var xxx_messageInfo_ExperimentList proto.InternalMessageInfo
 func (m *ExperimentSpec) Reset()      { *m = ExperimentSpec{} }
 func (*ExperimentSpec) ProtoMessage() {}
 func (*ExperimentSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{44}
+	return fileDescriptor_e0e705f843545fab, []int{54}
 }
 func (m *ExperimentSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1590,7 @@ var xxx_messageInfo_ExperimentSpec proto.InternalMessageInfo
 func (m *ExperimentStatus) Reset()      { *m = ExperimentStatus{} }
 func (*ExperimentStatus) ProtoMessage() {}
 func (*ExperimentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{45}
+	return fileDescriptor_e0e705f843545fab, []int{55}
 }
 func (m *ExperimentStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1338,7 +1618,7 @@ var xxx_messageInfo_ExperimentStatus proto.InternalMessageInfo
 func (m *FieldRef) Reset()      { *m = FieldRef{} }
 func (*FieldRef) ProtoMessage() {}
 func (*FieldRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{46}
+	return fileDescriptor_e0e705f843545fab, []int{56}
 }
 func (m *FieldRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1366,7 +1646,7 @@ var xxx_messageInfo_FieldRef proto.InternalMessageInfo
 func (m *GraphiteMetric) Reset()      { *m = GraphiteMetric{} }
 func (*GraphiteMetric) ProtoMessage() {}
 func (*GraphiteMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{47}
+	return fileDescriptor_e0e705f843545fab, []int{57}
 }
 func (m *GraphiteMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1394,7 +1674,7 @@ var xxx_messageInfo_GraphiteMetric proto.InternalMessageInfo
 func (m *HeaderRoutingMatch) Reset()      { *m = HeaderRoutingMatch{} }
 func (*HeaderRoutingMatch) ProtoMessage() {}
 func (*HeaderRoutingMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{48}
+	return fileDescriptor_e0e705f843545fab, []int{58}
 }
 func (m *HeaderRoutingMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1422,7 +1702,7 @@ var xxx_messageInfo_HeaderRoutingMatch proto.InternalMessageInfo
 func (m *InfluxdbMetric) Reset()      { *m = InfluxdbMetric{} }
 func (*InfluxdbMetric) ProtoMessage() {}
 func (*InfluxdbMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{49}
+	return fileDescriptor_e0e705f843545fab, []int{59}
 }
 func (m *InfluxdbMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1450,7 +1730,7 @@ var xxx_messageInfo_InfluxdbMetric proto.InternalMessageInfo
 func (m *IstioDestinationRule) Reset()      { *m = IstioDestinationRule{} }
 func (*IstioDestinationRule) ProtoMessage() {}
 func (*IstioDestinationRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{50}
+	return fileDescriptor_e0e705f843545fab, []int{60}
 }
 func (m *IstioDestinationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1475,10 +1755,38 @@ func (m *IstioDestinationRule) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IstioDestinationRule proto.InternalMessageInfo
 
+func (m *IstioLocality) Reset()      { *m = IstioLocality{} }
+func (*IstioLocality) ProtoMessage() {}
+func (*IstioLocality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{61}
+}
+func (m *IstioLocality) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IstioLocality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *IstioLocality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IstioLocality.Merge(m, src)
+}
+func (m *IstioLocality) XXX_Size() int {
+	return m.Size()
+}
+func (m *IstioLocality) XXX_DiscardUnknown() {
+	xxx_messageInfo_IstioLocality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IstioLocality proto.InternalMessageInfo
+
 func (m *IstioTrafficRouting) Reset()      { *m = IstioTrafficRouting{} }
 func (*IstioTrafficRouting) ProtoMessage() {}
 func (*IstioTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{51}
+	return fileDescriptor_e0e705f843545fab, []int{62}
 }
 func (m *IstioTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1506,7 +1814,7 @@ var xxx_messageInfo_IstioTrafficRouting proto.InternalMessageInfo
 func (m *IstioVirtualService) Reset()      { *m = IstioVirtualService{} }
 func (*IstioVirtualService) ProtoMessage() {}
 func (*IstioVirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{52}
+	return fileDescriptor_e0e705f843545fab, []int{63}
 }
 func (m *IstioVirtualService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1534,7 +1842,7 @@ var xxx_messageInfo_IstioVirtualService proto.InternalMessageInfo
 func (m *JobMetric) Reset()      { *m = JobMetric{} }
 func (*JobMetric) ProtoMessage() {}
 func (*JobMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{53}
+	return fileDescriptor_e0e705f843545fab, []int{64}
 }
 func (m *JobMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1562,7 +1870,7 @@ var xxx_messageInfo_JobMetric proto.InternalMessageInfo
 func (m *KayentaMetric) Reset()      { *m = KayentaMetric{} }
 func (*KayentaMetric) ProtoMessage() {}
 func (*KayentaMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{54}
+	return fileDescriptor_e0e705f843545fab, []int{65}
 }
 func (m *KayentaMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1590,7 +1898,7 @@ var xxx_messageInfo_KayentaMetric proto.InternalMessageInfo
 func (m *KayentaScope) Reset()      { *m = KayentaScope{} }
 func (*KayentaScope) ProtoMessage() {}
 func (*KayentaScope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{55}
+	return fileDescriptor_e0e705f843545fab, []int{66}
 }
 func (m *KayentaScope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1618,7 +1926,7 @@ var xxx_messageInfo_KayentaScope proto.InternalMessageInfo
 func (m *KayentaThreshold) Reset()      { *m = KayentaThreshold{} }
 func (*KayentaThreshold) ProtoMessage() {}
 func (*KayentaThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{56}
+	return fileDescriptor_e0e705f843545fab, []int{67}
 }
 func (m *KayentaThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1643,10 +1951,66 @@ func (m *KayentaThreshold) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_KayentaThreshold proto.InternalMessageInfo
 
+func (m *KongTrafficRouting) Reset()      { *m = KongTrafficRouting{} }
+func (*KongTrafficRouting) ProtoMessage() {}
+func (*KongTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{68}
+}
+func (m *KongTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KongTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KongTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KongTrafficRouting.Merge(m, src)
+}
+func (m *KongTrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *KongTrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_KongTrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KongTrafficRouting proto.InternalMessageInfo
+
+func (m *LinkerdTrafficRouting) Reset()      { *m = LinkerdTrafficRouting{} }
+func (*LinkerdTrafficRouting) ProtoMessage() {}
+func (*LinkerdTrafficRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{69}
+}
+func (m *LinkerdTrafficRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkerdTrafficRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LinkerdTrafficRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkerdTrafficRouting.Merge(m, src)
+}
+func (m *LinkerdTrafficRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkerdTrafficRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkerdTrafficRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkerdTrafficRouting proto.InternalMessageInfo
+
 func (m *MangedRoutes) Reset()      { *m = MangedRoutes{} }
 func (*MangedRoutes) ProtoMessage() {}
 func (*MangedRoutes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{57}
+	return fileDescriptor_e0e705f843545fab, []int{70}
 }
 func (m *MangedRoutes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1674,7 +2038,7 @@ var xxx_messageInfo_MangedRoutes proto.InternalMessageInfo
 func (m *Measurement) Reset()      { *m = Measurement{} }
 func (*Measurement) ProtoMessage() {}
 func (*Measurement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{58}
+	return fileDescriptor_e0e705f843545fab, []int{71}
 }
 func (m *Measurement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +2066,7 @@ var xxx_messageInfo_Measurement proto.InternalMessageInfo
 func (m *MeasurementRetention) Reset()      { *m = MeasurementRetention{} }
 func (*MeasurementRetention) ProtoMessage() {}
 func (*MeasurementRetention) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{59}
+	return fileDescriptor_e0e705f843545fab, []int{72}
 }
 func (m *MeasurementRetention) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,7 +2094,7 @@ var xxx_messageInfo_MeasurementRetention proto.InternalMessageInfo
 func (m *Metric) Reset()      { *m = Metric{} }
 func (*Metric) ProtoMessage() {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{60}
+	return fileDescriptor_e0e705f843545fab, []int{73}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1758,7 +2122,7 @@ var xxx_messageInfo_Metric proto.InternalMessageInfo
 func (m *MetricProvider) Reset()      { *m = MetricProvider{} }
 func (*MetricProvider) ProtoMessage() {}
 func (*MetricProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{61}
+	return fileDescriptor_e0e705f843545fab, []int{74}
 }
 func (m *MetricProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1786,7 +2150,7 @@ var xxx_messageInfo_MetricProvider proto.InternalMessageInfo
 func (m *MetricResult) Reset()      { *m = MetricResult{} }
 func (*MetricResult) ProtoMessage() {}
 func (*MetricResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{62}
+	return fileDescriptor_e0e705f843545fab, []int{75}
 }
 func (m *MetricResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1814,7 +2178,7 @@ var xxx_messageInfo_MetricResult proto.InternalMessageInfo
 func (m *NewRelicMetric) Reset()      { *m = NewRelicMetric{} }
 func (*NewRelicMetric) ProtoMessage() {}
 func (*NewRelicMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{63}
+	return fileDescriptor_e0e705f843545fab, []int{76}
 }
 func (m *NewRelicMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1842,7 +2206,7 @@ var xxx_messageInfo_NewRelicMetric proto.InternalMessageInfo
 func (m *NginxTrafficRouting) Reset()      { *m = NginxTrafficRouting{} }
 func (*NginxTrafficRouting) ProtoMessage() {}
 func (*NginxTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{64}
+	return fileDescriptor_e0e705f843545fab, []int{77}
 }
 func (m *NginxTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1870,7 +2234,7 @@ var xxx_messageInfo_NginxTrafficRouting proto.InternalMessageInfo
 func (m *OAuth2Config) Reset()      { *m = OAuth2Config{} }
 func (*OAuth2Config) ProtoMessage() {}
 func (*OAuth2Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{65}
+	return fileDescriptor_e0e705f843545fab, []int{78}
 }
 func (m *OAuth2Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1898,7 +2262,7 @@ var xxx_messageInfo_OAuth2Config proto.InternalMessageInfo
 func (m *ObjectRef) Reset()      { *m = ObjectRef{} }
 func (*ObjectRef) ProtoMessage() {}
 func (*ObjectRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{66}
+	return fileDescriptor_e0e705f843545fab, []int{79}
 }
 func (m *ObjectRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1926,7 +2290,7 @@ var xxx_messageInfo_ObjectRef proto.InternalMessageInfo
 func (m *PauseCondition) Reset()      { *m = PauseCondition{} }
 func (*PauseCondition) ProtoMessage() {}
 func (*PauseCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{67}
+	return fileDescriptor_e0e705f843545fab, []int{80}
 }
 func (m *PauseCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1954,7 +2318,7 @@ var xxx_messageInfo_PauseCondition proto.InternalMessageInfo
 func (m *PingPongSpec) Reset()      { *m = PingPongSpec{} }
 func (*PingPongSpec) ProtoMessage() {}
 func (*PingPongSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{68}
+	return fileDescriptor_e0e705f843545fab, []int{81}
 }
 func (m *PingPongSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1982,7 +2346,7 @@ var xxx_messageInfo_PingPongSpec proto.InternalMessageInfo
 func (m *PluginStep) Reset()      { *m = PluginStep{} }
 func (*PluginStep) ProtoMessage() {}
 func (*PluginStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{69}
+	return fileDescriptor_e0e705f843545fab, []int{82}
 }
 func (m *PluginStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2010,7 +2374,7 @@ var xxx_messageInfo_PluginStep proto.InternalMessageInfo
 func (m *PodTemplateMetadata) Reset()      { *m = PodTemplateMetadata{} }
 func (*PodTemplateMetadata) ProtoMessage() {}
 func (*PodTemplateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{70}
+	return fileDescriptor_e0e705f843545fab, []int{83}
 }
 func (m *PodTemplateMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2040,7 +2404,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*PreferredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*PreferredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{71}
+	return fileDescriptor_e0e705f843545fab, []int{84}
 }
 func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2065,10 +2429,38 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PreferredDuringSchedulingIgnoredDuringExecution proto.InternalMessageInfo
 
+func (m *PreserveAbortedPods) Reset()      { *m = PreserveAbortedPods{} }
+func (*PreserveAbortedPods) ProtoMessage() {}
+func (*PreserveAbortedPods) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{85}
+}
+func (m *PreserveAbortedPods) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreserveAbortedPods) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PreserveAbortedPods) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreserveAbortedPods.Merge(m, src)
+}
+func (m *PreserveAbortedPods) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreserveAbortedPods) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreserveAbortedPods.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PreserveAbortedPods proto.InternalMessageInfo
+
 func (m *PrometheusMetric) Reset()      { *m = PrometheusMetric{} }
 func (*PrometheusMetric) ProtoMessage() {}
 func (*PrometheusMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{72}
+	return fileDescriptor_e0e705f843545fab, []int{86}
 }
 func (m *PrometheusMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2096,7 +2488,7 @@ var xxx_messageInfo_PrometheusMetric proto.InternalMessageInfo
 func (m *PrometheusRangeQueryArgs) Reset()      { *m = PrometheusRangeQueryArgs{} }
 func (*PrometheusRangeQueryArgs) ProtoMessage() {}
 func (*PrometheusRangeQueryArgs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{73}
+	return fileDescriptor_e0e705f843545fab, []int{87}
 }
 func (m *PrometheusRangeQueryArgs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2126,7 +2518,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*RequiredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*RequiredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{74}
+	return fileDescriptor_e0e705f843545fab, []int{88}
 }
 func (m *RequiredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2154,7 +2546,7 @@ var xxx_messageInfo_RequiredDuringSchedulingIgnoredDuringExecution proto.Interna
 func (m *RollbackWindowSpec) Reset()      { *m = RollbackWindowSpec{} }
 func (*RollbackWindowSpec) ProtoMessage() {}
 func (*RollbackWindowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{75}
+	return fileDescriptor_e0e705f843545fab, []int{89}
 }
 func (m *RollbackWindowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2182,7 +2574,7 @@ var xxx_messageInfo_RollbackWindowSpec proto.InternalMessageInfo
 func (m *Rollout) Reset()      { *m = Rollout{} }
 func (*Rollout) ProtoMessage() {}
 func (*Rollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{76}
+	return fileDescriptor_e0e705f843545fab, []int{90}
 }
 func (m *Rollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2210,7 +2602,7 @@ var xxx_messageInfo_Rollout proto.InternalMessageInfo
 func (m *RolloutAnalysis) Reset()      { *m = RolloutAnalysis{} }
 func (*RolloutAnalysis) ProtoMessage() {}
 func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{77}
+	return fileDescriptor_e0e705f843545fab, []int{91}
 }
 func (m *RolloutAnalysis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2238,7 +2630,7 @@ var xxx_messageInfo_RolloutAnalysis proto.InternalMessageInfo
 func (m *RolloutAnalysisBackground) Reset()      { *m = RolloutAnalysisBackground{} }
 func (*RolloutAnalysisBackground) ProtoMessage() {}
 func (*RolloutAnalysisBackground) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{78}
+	return fileDescriptor_e0e705f843545fab, []int{92}
 }
 func (m *RolloutAnalysisBackground) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,7 +2658,7 @@ var xxx_messageInfo_RolloutAnalysisBackground proto.InternalMessageInfo
 func (m *RolloutAnalysisRunStatus) Reset()      { *m = RolloutAnalysisRunStatus{} }
 func (*RolloutAnalysisRunStatus) ProtoMessage() {}
 func (*RolloutAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{79}
+	return fileDescriptor_e0e705f843545fab, []int{93}
 }
 func (m *RolloutAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2294,7 +2686,7 @@ var xxx_messageInfo_RolloutAnalysisRunStatus proto.InternalMessageInfo
 func (m *RolloutCondition) Reset()      { *m = RolloutCondition{} }
 func (*RolloutCondition) ProtoMessage() {}
 func (*RolloutCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{80}
+	return fileDescriptor_e0e705f843545fab, []int{94}
 }
 func (m *RolloutCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2322,7 +2714,7 @@ var xxx_messageInfo_RolloutCondition proto.InternalMessageInfo
 func (m *RolloutExperimentStep) Reset()      { *m = RolloutExperimentStep{} }
 func (*RolloutExperimentStep) ProtoMessage() {}
 func (*RolloutExperimentStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{81}
+	return fileDescriptor_e0e705f843545fab, []int{95}
 }
 func (m *RolloutExperimentStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2352,7 +2744,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Reset() {
 }
 func (*RolloutExperimentStepAnalysisTemplateRef) ProtoMessage() {}
 func (*RolloutExperimentStepAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{82}
+	return fileDescriptor_e0e705f843545fab, []int{96}
 }
 func (m *RolloutExperimentStepAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2380,7 +2772,7 @@ var xxx_messageInfo_RolloutExperimentStepAnalysisTemplateRef proto.InternalMessa
 func (m *RolloutExperimentTemplate) Reset()      { *m = RolloutExperimentTemplate{} }
 func (*RolloutExperimentTemplate) ProtoMessage() {}
 func (*RolloutExperimentTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{83}
+	return fileDescriptor_e0e705f843545fab, []int{97}
 }
 func (m *RolloutExperimentTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2405,10 +2797,122 @@ func (m *RolloutExperimentTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutExperimentTemplate proto.InternalMessageInfo
 
+func (m *RolloutHook) Reset()      { *m = RolloutHook{} }
+func (*RolloutHook) ProtoMessage() {}
+func (*RolloutHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{98}
+}
+func (m *RolloutHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHook.Merge(m, src)
+}
+func (m *RolloutHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutHook proto.InternalMessageInfo
+
+func (m *RolloutHookStatus) Reset()      { *m = RolloutHookStatus{} }
+func (*RolloutHookStatus) ProtoMessage() {}
+func (*RolloutHookStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{99}
+}
+func (m *RolloutHookStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutHookStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutHookStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHookStatus.Merge(m, src)
+}
+func (m *RolloutHookStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutHookStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHookStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutHookStatus proto.InternalMessageInfo
+
+func (m *RolloutHooks) Reset()      { *m = RolloutHooks{} }
+func (*RolloutHooks) ProtoMessage() {}
+func (*RolloutHooks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{100}
+}
+func (m *RolloutHooks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutHooks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutHooks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutHooks.Merge(m, src)
+}
+func (m *RolloutHooks) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutHooks) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutHooks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutHooks proto.InternalMessageInfo
+
+func (m *RolloutLifecycleStatus) Reset()      { *m = RolloutLifecycleStatus{} }
+func (*RolloutLifecycleStatus) ProtoMessage() {}
+func (*RolloutLifecycleStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{101}
+}
+func (m *RolloutLifecycleStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutLifecycleStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutLifecycleStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutLifecycleStatus.Merge(m, src)
+}
+func (m *RolloutLifecycleStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutLifecycleStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutLifecycleStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutLifecycleStatus proto.InternalMessageInfo
+
 func (m *RolloutList) Reset()      { *m = RolloutList{} }
 func (*RolloutList) ProtoMessage() {}
 func (*RolloutList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{84}
+	return fileDescriptor_e0e705f843545fab, []int{102}
 }
 func (m *RolloutList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +2940,7 @@ var xxx_messageInfo_RolloutList proto.InternalMessageInfo
 func (m *RolloutPause) Reset()      { *m = RolloutPause{} }
 func (*RolloutPause) ProtoMessage() {}
 func (*RolloutPause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{85}
+	return fileDescriptor_e0e705f843545fab, []int{103}
 }
 func (m *RolloutPause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2461,10 +2965,206 @@ func (m *RolloutPause) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutPause proto.InternalMessageInfo
 
+func (m *RolloutPolicy) Reset()      { *m = RolloutPolicy{} }
+func (*RolloutPolicy) ProtoMessage() {}
+func (*RolloutPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{104}
+}
+func (m *RolloutPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutPolicy.Merge(m, src)
+}
+func (m *RolloutPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutPolicy proto.InternalMessageInfo
+
+func (m *RolloutPolicyList) Reset()      { *m = RolloutPolicyList{} }
+func (*RolloutPolicyList) ProtoMessage() {}
+func (*RolloutPolicyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{105}
+}
+func (m *RolloutPolicyList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutPolicyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutPolicyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutPolicyList.Merge(m, src)
+}
+func (m *RolloutPolicyList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutPolicyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutPolicyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutPolicyList proto.InternalMessageInfo
+
+func (m *RolloutPolicyRule) Reset()      { *m = RolloutPolicyRule{} }
+func (*RolloutPolicyRule) ProtoMessage() {}
+func (*RolloutPolicyRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{106}
+}
+func (m *RolloutPolicyRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutPolicyRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutPolicyRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutPolicyRule.Merge(m, src)
+}
+func (m *RolloutPolicyRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutPolicyRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutPolicyRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutPolicyRule proto.InternalMessageInfo
+
+func (m *RolloutPolicySpec) Reset()      { *m = RolloutPolicySpec{} }
+func (*RolloutPolicySpec) ProtoMessage() {}
+func (*RolloutPolicySpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{107}
+}
+func (m *RolloutPolicySpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutPolicySpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutPolicySpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutPolicySpec.Merge(m, src)
+}
+func (m *RolloutPolicySpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutPolicySpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutPolicySpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutPolicySpec proto.InternalMessageInfo
+
+func (m *RolloutRetryBackoff) Reset()      { *m = RolloutRetryBackoff{} }
+func (*RolloutRetryBackoff) ProtoMessage() {}
+func (*RolloutRetryBackoff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{108}
+}
+func (m *RolloutRetryBackoff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutRetryBackoff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutRetryBackoff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutRetryBackoff.Merge(m, src)
+}
+func (m *RolloutRetryBackoff) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutRetryBackoff) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutRetryBackoff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutRetryBackoff proto.InternalMessageInfo
+
+func (m *RolloutRetryPolicy) Reset()      { *m = RolloutRetryPolicy{} }
+func (*RolloutRetryPolicy) ProtoMessage() {}
+func (*RolloutRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{109}
+}
+func (m *RolloutRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutRetryPolicy.Merge(m, src)
+}
+func (m *RolloutRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutRetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutRetryPolicy proto.InternalMessageInfo
+
+func (m *RolloutRetryStatus) Reset()      { *m = RolloutRetryStatus{} }
+func (*RolloutRetryStatus) ProtoMessage() {}
+func (*RolloutRetryStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{110}
+}
+func (m *RolloutRetryStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutRetryStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutRetryStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutRetryStatus.Merge(m, src)
+}
+func (m *RolloutRetryStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutRetryStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutRetryStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutRetryStatus proto.InternalMessageInfo
+
 func (m *RolloutSpec) Reset()      { *m = RolloutSpec{} }
 func (*RolloutSpec) ProtoMessage() {}
 func (*RolloutSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{86}
+	return fileDescriptor_e0e705f843545fab, []int{111}
 }
 func (m *RolloutSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2492,7 +3192,7 @@ var xxx_messageInfo_RolloutSpec proto.InternalMessageInfo
 func (m *RolloutStatus) Reset()      { *m = RolloutStatus{} }
 func (*RolloutStatus) ProtoMessage() {}
 func (*RolloutStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{87}
+	return fileDescriptor_e0e705f843545fab, []int{112}
 }
 func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2517,10 +3217,38 @@ func (m *RolloutStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
 
+func (m *RolloutStepStatus) Reset()      { *m = RolloutStepStatus{} }
+func (*RolloutStepStatus) ProtoMessage() {}
+func (*RolloutStepStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{113}
+}
+func (m *RolloutStepStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStepStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStepStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStepStatus.Merge(m, src)
+}
+func (m *RolloutStepStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStepStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStepStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStepStatus proto.InternalMessageInfo
+
 func (m *RolloutStrategy) Reset()      { *m = RolloutStrategy{} }
 func (*RolloutStrategy) ProtoMessage() {}
 func (*RolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{88}
+	return fileDescriptor_e0e705f843545fab, []int{114}
 }
 func (m *RolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2545,10 +3273,122 @@ func (m *RolloutStrategy) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutStrategy proto.InternalMessageInfo
 
+func (m *RolloutStrategyTemplate) Reset()      { *m = RolloutStrategyTemplate{} }
+func (*RolloutStrategyTemplate) ProtoMessage() {}
+func (*RolloutStrategyTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{115}
+}
+func (m *RolloutStrategyTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategyTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategyTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategyTemplate.Merge(m, src)
+}
+func (m *RolloutStrategyTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategyTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategyTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategyTemplate proto.InternalMessageInfo
+
+func (m *RolloutStrategyTemplateList) Reset()      { *m = RolloutStrategyTemplateList{} }
+func (*RolloutStrategyTemplateList) ProtoMessage() {}
+func (*RolloutStrategyTemplateList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{116}
+}
+func (m *RolloutStrategyTemplateList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategyTemplateList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategyTemplateList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategyTemplateList.Merge(m, src)
+}
+func (m *RolloutStrategyTemplateList) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategyTemplateList) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategyTemplateList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategyTemplateList proto.InternalMessageInfo
+
+func (m *RolloutStrategyTemplateSpec) Reset()      { *m = RolloutStrategyTemplateSpec{} }
+func (*RolloutStrategyTemplateSpec) ProtoMessage() {}
+func (*RolloutStrategyTemplateSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{117}
+}
+func (m *RolloutStrategyTemplateSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategyTemplateSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategyTemplateSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategyTemplateSpec.Merge(m, src)
+}
+func (m *RolloutStrategyTemplateSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategyTemplateSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategyTemplateSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategyTemplateSpec proto.InternalMessageInfo
+
+func (m *RolloutStrategyTemplateStatus) Reset()      { *m = RolloutStrategyTemplateStatus{} }
+func (*RolloutStrategyTemplateStatus) ProtoMessage() {}
+func (*RolloutStrategyTemplateStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{118}
+}
+func (m *RolloutStrategyTemplateStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutStrategyTemplateStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutStrategyTemplateStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutStrategyTemplateStatus.Merge(m, src)
+}
+func (m *RolloutStrategyTemplateStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutStrategyTemplateStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutStrategyTemplateStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutStrategyTemplateStatus proto.InternalMessageInfo
+
 func (m *RolloutTrafficRouting) Reset()      { *m = RolloutTrafficRouting{} }
 func (*RolloutTrafficRouting) ProtoMessage() {}
 func (*RolloutTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{89}
+	return fileDescriptor_e0e705f843545fab, []int{119}
 }
 func (m *RolloutTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2576,7 +3416,7 @@ var xxx_messageInfo_RolloutTrafficRouting proto.InternalMessageInfo
 func (m *RouteMatch) Reset()      { *m = RouteMatch{} }
 func (*RouteMatch) ProtoMessage() {}
 func (*RouteMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{90}
+	return fileDescriptor_e0e705f843545fab, []int{120}
 }
 func (m *RouteMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2604,7 +3444,7 @@ var xxx_messageInfo_RouteMatch proto.InternalMessageInfo
 func (m *RunSummary) Reset()      { *m = RunSummary{} }
 func (*RunSummary) ProtoMessage() {}
 func (*RunSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{91}
+	return fileDescriptor_e0e705f843545fab, []int{121}
 }
 func (m *RunSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2632,7 +3472,7 @@ var xxx_messageInfo_RunSummary proto.InternalMessageInfo
 func (m *SMITrafficRouting) Reset()      { *m = SMITrafficRouting{} }
 func (*SMITrafficRouting) ProtoMessage() {}
 func (*SMITrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{92}
+	return fileDescriptor_e0e705f843545fab, []int{122}
 }
 func (m *SMITrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2660,7 +3500,7 @@ var xxx_messageInfo_SMITrafficRouting proto.InternalMessageInfo
 func (m *ScopeDetail) Reset()      { *m = ScopeDetail{} }
 func (*ScopeDetail) ProtoMessage() {}
 func (*ScopeDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{93}
+	return fileDescriptor_e0e705f843545fab, []int{123}
 }
 func (m *ScopeDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2688,7 +3528,7 @@ var xxx_messageInfo_ScopeDetail proto.InternalMessageInfo
 func (m *SecretKeyRef) Reset()      { *m = SecretKeyRef{} }
 func (*SecretKeyRef) ProtoMessage() {}
 func (*SecretKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{94}
+	return fileDescriptor_e0e705f843545fab, []int{124}
 }
 func (m *SecretKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2716,7 +3556,7 @@ var xxx_messageInfo_SecretKeyRef proto.InternalMessageInfo
 func (m *SecretRef) Reset()      { *m = SecretRef{} }
 func (*SecretRef) ProtoMessage() {}
 func (*SecretRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{95}
+	return fileDescriptor_e0e705f843545fab, []int{125}
 }
 func (m *SecretRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2741,10 +3581,38 @@ func (m *SecretRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SecretRef proto.InternalMessageInfo
 
+func (m *SetCanaryNodes) Reset()      { *m = SetCanaryNodes{} }
+func (*SetCanaryNodes) ProtoMessage() {}
+func (*SetCanaryNodes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{126}
+}
+func (m *SetCanaryNodes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCanaryNodes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SetCanaryNodes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCanaryNodes.Merge(m, src)
+}
+func (m *SetCanaryNodes) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCanaryNodes) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCanaryNodes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCanaryNodes proto.InternalMessageInfo
+
 func (m *SetCanaryScale) Reset()      { *m = SetCanaryScale{} }
 func (*SetCanaryScale) ProtoMessage() {}
 func (*SetCanaryScale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{96}
+	return fileDescriptor_e0e705f843545fab, []int{127}
 }
 func (m *SetCanaryScale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2772,7 +3640,7 @@ var xxx_messageInfo_SetCanaryScale proto.InternalMessageInfo
 func (m *SetHeaderRoute) Reset()      { *m = SetHeaderRoute{} }
 func (*SetHeaderRoute) ProtoMessage() {}
 func (*SetHeaderRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{97}
+	return fileDescriptor_e0e705f843545fab, []int{128}
 }
 func (m *SetHeaderRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2800,7 +3668,7 @@ var xxx_messageInfo_SetHeaderRoute proto.InternalMessageInfo
 func (m *SetMirrorRoute) Reset()      { *m = SetMirrorRoute{} }
 func (*SetMirrorRoute) ProtoMessage() {}
 func (*SetMirrorRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{98}
+	return fileDescriptor_e0e705f843545fab, []int{129}
 }
 func (m *SetMirrorRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2828,7 +3696,7 @@ var xxx_messageInfo_SetMirrorRoute proto.InternalMessageInfo
 func (m *Sigv4Config) Reset()      { *m = Sigv4Config{} }
 func (*Sigv4Config) ProtoMessage() {}
 func (*Sigv4Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{99}
+	return fileDescriptor_e0e705f843545fab, []int{130}
 }
 func (m *Sigv4Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2856,7 +3724,7 @@ var xxx_messageInfo_Sigv4Config proto.InternalMessageInfo
 func (m *SkyWalkingMetric) Reset()      { *m = SkyWalkingMetric{} }
 func (*SkyWalkingMetric) ProtoMessage() {}
 func (*SkyWalkingMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{100}
+	return fileDescriptor_e0e705f843545fab, []int{131}
 }
 func (m *SkyWalkingMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2884,7 +3752,7 @@ var xxx_messageInfo_SkyWalkingMetric proto.InternalMessageInfo
 func (m *StepPluginStatus) Reset()      { *m = StepPluginStatus{} }
 func (*StepPluginStatus) ProtoMessage() {}
 func (*StepPluginStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{101}
+	return fileDescriptor_e0e705f843545fab, []int{132}
 }
 func (m *StepPluginStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2909,10 +3777,38 @@ func (m *StepPluginStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StepPluginStatus proto.InternalMessageInfo
 
+func (m *StepTimeout) Reset()      { *m = StepTimeout{} }
+func (*StepTimeout) ProtoMessage() {}
+func (*StepTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{133}
+}
+func (m *StepTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StepTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StepTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StepTimeout.Merge(m, src)
+}
+func (m *StepTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *StepTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_StepTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StepTimeout proto.InternalMessageInfo
+
 func (m *StickinessConfig) Reset()      { *m = StickinessConfig{} }
 func (*StickinessConfig) ProtoMessage() {}
 func (*StickinessConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{102}
+	return fileDescriptor_e0e705f843545fab, []int{134}
 }
 func (m *StickinessConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2937,10 +3833,38 @@ func (m *StickinessConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StickinessConfig proto.InternalMessageInfo
 
+func (m *StrategyRef) Reset()      { *m = StrategyRef{} }
+func (*StrategyRef) ProtoMessage() {}
+func (*StrategyRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{135}
+}
+func (m *StrategyRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrategyRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StrategyRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategyRef.Merge(m, src)
+}
+func (m *StrategyRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrategyRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategyRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategyRef proto.InternalMessageInfo
+
 func (m *StringMatch) Reset()      { *m = StringMatch{} }
 func (*StringMatch) ProtoMessage() {}
 func (*StringMatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{103}
+	return fileDescriptor_e0e705f843545fab, []int{136}
 }
 func (m *StringMatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2968,7 +3892,7 @@ var xxx_messageInfo_StringMatch proto.InternalMessageInfo
 func (m *TCPRoute) Reset()      { *m = TCPRoute{} }
 func (*TCPRoute) ProtoMessage() {}
 func (*TCPRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{104}
+	return fileDescriptor_e0e705f843545fab, []int{137}
 }
 func (m *TCPRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2996,7 +3920,7 @@ var xxx_messageInfo_TCPRoute proto.InternalMessageInfo
 func (m *TLSRoute) Reset()      { *m = TLSRoute{} }
 func (*TLSRoute) ProtoMessage() {}
 func (*TLSRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{105}
+	return fileDescriptor_e0e705f843545fab, []int{138}
 }
 func (m *TLSRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3024,7 +3948,7 @@ var xxx_messageInfo_TLSRoute proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{106}
+	return fileDescriptor_e0e705f843545fab, []int{139}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3052,7 +3976,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TemplateService) Reset()      { *m = TemplateService{} }
 func (*TemplateService) ProtoMessage() {}
 func (*TemplateService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{107}
+	return fileDescriptor_e0e705f843545fab, []int{140}
 }
 func (m *TemplateService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3080,7 +4004,7 @@ var xxx_messageInfo_TemplateService proto.InternalMessageInfo
 func (m *TemplateSpec) Reset()      { *m = TemplateSpec{} }
 func (*TemplateSpec) ProtoMessage() {}
 func (*TemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{108}
+	return fileDescriptor_e0e705f843545fab, []int{141}
 }
 func (m *TemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3108,7 +4032,7 @@ var xxx_messageInfo_TemplateSpec proto.InternalMessageInfo
 func (m *TemplateStatus) Reset()      { *m = TemplateStatus{} }
 func (*TemplateStatus) ProtoMessage() {}
 func (*TemplateStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{109}
+	return fileDescriptor_e0e705f843545fab, []int{142}
 }
 func (m *TemplateStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3136,7 +4060,7 @@ var xxx_messageInfo_TemplateStatus proto.InternalMessageInfo
 func (m *TraefikTrafficRouting) Reset()      { *m = TraefikTrafficRouting{} }
 func (*TraefikTrafficRouting) ProtoMessage() {}
 func (*TraefikTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{110}
+	return fileDescriptor_e0e705f843545fab, []int{143}
 }
 func (m *TraefikTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3161,10 +4085,38 @@ func (m *TraefikTrafficRouting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TraefikTrafficRouting proto.InternalMessageInfo
 
+func (m *TrafficStickiness) Reset()      { *m = TrafficStickiness{} }
+func (*TrafficStickiness) ProtoMessage() {}
+func (*TrafficStickiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{144}
+}
+func (m *TrafficStickiness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrafficStickiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TrafficStickiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrafficStickiness.Merge(m, src)
+}
+func (m *TrafficStickiness) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrafficStickiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrafficStickiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrafficStickiness proto.InternalMessageInfo
+
 func (m *TrafficWeights) Reset()      { *m = TrafficWeights{} }
 func (*TrafficWeights) ProtoMessage() {}
 func (*TrafficWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{111}
+	return fileDescriptor_e0e705f843545fab, []int{145}
 }
 func (m *TrafficWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3192,7 +4144,7 @@ var xxx_messageInfo_TrafficWeights proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{112}
+	return fileDescriptor_e0e705f843545fab, []int{146}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3217,10 +4169,66 @@ func (m *ValueFrom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 
+func (m *WaitForStatus) Reset()      { *m = WaitForStatus{} }
+func (*WaitForStatus) ProtoMessage() {}
+func (*WaitForStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{147}
+}
+func (m *WaitForStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitForStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WaitForStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForStatus.Merge(m, src)
+}
+func (m *WaitForStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitForStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForStatus proto.InternalMessageInfo
+
+func (m *WaitForStep) Reset()      { *m = WaitForStep{} }
+func (*WaitForStep) ProtoMessage() {}
+func (*WaitForStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{148}
+}
+func (m *WaitForStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WaitForStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WaitForStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WaitForStep.Merge(m, src)
+}
+func (m *WaitForStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *WaitForStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_WaitForStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WaitForStep proto.InternalMessageInfo
+
 func (m *WavefrontMetric) Reset()      { *m = WavefrontMetric{} }
 func (*WavefrontMetric) ProtoMessage() {}
 func (*WavefrontMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{113}
+	return fileDescriptor_e0e705f843545fab, []int{149}
 }
 func (m *WavefrontMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3248,7 +4256,7 @@ var xxx_messageInfo_WavefrontMetric proto.InternalMessageInfo
 func (m *WebMetric) Reset()      { *m = WebMetric{} }
 func (*WebMetric) ProtoMessage() {}
 func (*WebMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{114}
+	return fileDescriptor_e0e705f843545fab, []int{150}
 }
 func (m *WebMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3276,7 +4284,7 @@ var xxx_messageInfo_WebMetric proto.InternalMessageInfo
 func (m *WebMetricHeader) Reset()      { *m = WebMetricHeader{} }
 func (*WebMetricHeader) ProtoMessage() {}
 func (*WebMetricHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{115}
+	return fileDescriptor_e0e705f843545fab, []int{151}
 }
 func (m *WebMetricHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3304,7 +4312,7 @@ var xxx_messageInfo_WebMetricHeader proto.InternalMessageInfo
 func (m *WeightDestination) Reset()      { *m = WeightDestination{} }
 func (*WeightDestination) ProtoMessage() {}
 func (*WeightDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{116}
+	return fileDescriptor_e0e705f843545fab, []int{152}
 }
 func (m *WeightDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3359,6 +4367,8 @@ func init() {
 	proto.RegisterType((*AwsResourceRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AwsResourceRef")
 	proto.RegisterType((*BlueGreenStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenStatus")
 	proto.RegisterType((*BlueGreenStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenStrategy")
+	proto.RegisterType((*BlueGreenTrafficStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenTrafficStep")
+	proto.RegisterType((*CanaryAbortPolicy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryAbortPolicy")
 	proto.RegisterType((*CanaryStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStatus")
 	proto.RegisterType((*CanaryStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStep")
 	proto.RegisterType((*CanaryStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStrategy")
@@ -3369,6 +4379,14 @@ func init() {
 	proto.RegisterType((*CloudWatchMetricStatMetricDimension)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CloudWatchMetricStatMetricDimension")
 	proto.RegisterType((*ClusterAnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplate")
 	proto.RegisterType((*ClusterAnalysisTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterAnalysisTemplateList")
+	proto.RegisterType((*ClusterRolloutPolicy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterRolloutPolicy")
+	proto.RegisterType((*ClusterRolloutPolicyList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterRolloutPolicyList")
+	proto.RegisterType((*ClusterRolloutStrategyTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterRolloutStrategyTemplate")
+	proto.RegisterType((*ClusterRolloutStrategyTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ClusterRolloutStrategyTemplateList")
+	proto.RegisterType((*ConfigRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ConfigRef")
+	proto.RegisterType((*ContourTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ContourTrafficRouting")
+	proto.RegisterType((*DNSTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DNSTrafficRouting")
+	proto.RegisterType((*DaemonSetCanaryStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DaemonSetCanaryStrategy")
 	proto.RegisterType((*DatadogMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DatadogMetric.QueriesEntry")
 	proto.RegisterType((*DryRun)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.DryRun")
@@ -3384,12 +4402,15 @@ func init() {
 	proto.RegisterType((*HeaderRoutingMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.HeaderRoutingMatch")
 	proto.RegisterType((*InfluxdbMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.InfluxdbMetric")
 	proto.RegisterType((*IstioDestinationRule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioDestinationRule")
+	proto.RegisterType((*IstioLocality)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioLocality")
 	proto.RegisterType((*IstioTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioTrafficRouting")
 	proto.RegisterType((*IstioVirtualService)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioVirtualService")
 	proto.RegisterType((*JobMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.JobMetric")
 	proto.RegisterType((*KayentaMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaMetric")
 	proto.RegisterType((*KayentaScope)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaScope")
 	proto.RegisterType((*KayentaThreshold)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KayentaThreshold")
+	proto.RegisterType((*KongTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.KongTrafficRouting")
+	proto.RegisterType((*LinkerdTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.LinkerdTrafficRouting")
 	proto.RegisterType((*MangedRoutes)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MangedRoutes")
 	proto.RegisterType((*Measurement)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Measurement.MetadataEntry")
@@ -3412,6 +4433,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PodTemplateMetadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PodTemplateMetadata.LabelsEntry")
 	proto.RegisterType((*PreferredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution")
+	proto.RegisterType((*PreserveAbortedPods)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PreserveAbortedPods")
 	proto.RegisterType((*PrometheusMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusMetric")
 	proto.RegisterType((*PrometheusRangeQueryArgs)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusRangeQueryArgs")
 	proto.RegisterType((*RequiredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution")
@@ -3424,11 +4446,27 @@ func init() {
 	proto.RegisterType((*RolloutExperimentStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStep")
 	proto.RegisterType((*RolloutExperimentStepAnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStepAnalysisTemplateRef")
 	proto.RegisterType((*RolloutExperimentTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentTemplate")
+	proto.RegisterType((*RolloutHook)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutHook")
+	proto.RegisterType((*RolloutHookStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutHookStatus")
+	proto.RegisterType((*RolloutHooks)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutHooks")
+	proto.RegisterType((*RolloutLifecycleStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutLifecycleStatus")
 	proto.RegisterType((*RolloutList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutList")
 	proto.RegisterType((*RolloutPause)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPause")
+	proto.RegisterType((*RolloutPolicy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPolicy")
+	proto.RegisterType((*RolloutPolicyList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPolicyList")
+	proto.RegisterType((*RolloutPolicyRule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPolicyRule")
+	proto.RegisterType((*RolloutPolicySpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPolicySpec")
+	proto.RegisterType((*RolloutRetryBackoff)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutRetryBackoff")
+	proto.RegisterType((*RolloutRetryPolicy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutRetryPolicy")
+	proto.RegisterType((*RolloutRetryStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutRetryStatus")
 	proto.RegisterType((*RolloutSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutSpec")
 	proto.RegisterType((*RolloutStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStatus")
+	proto.RegisterType((*RolloutStepStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStepStatus")
 	proto.RegisterType((*RolloutStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategy")
+	proto.RegisterType((*RolloutStrategyTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategyTemplate")
+	proto.RegisterType((*RolloutStrategyTemplateList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategyTemplateList")
+	proto.RegisterType((*RolloutStrategyTemplateSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategyTemplateSpec")
+	proto.RegisterType((*RolloutStrategyTemplateStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategyTemplateStatus")
 	proto.RegisterType((*RolloutTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutTrafficRouting")
 	proto.RegisterMapType((map[string]encoding_json.RawMessage)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutTrafficRouting.PluginsEntry")
 	proto.RegisterType((*RouteMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RouteMatch")
@@ -3438,13 +4476,16 @@ func init() {
 	proto.RegisterType((*ScopeDetail)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ScopeDetail")
 	proto.RegisterType((*SecretKeyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretKeyRef")
 	proto.RegisterType((*SecretRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretRef")
+	proto.RegisterType((*SetCanaryNodes)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetCanaryNodes")
 	proto.RegisterType((*SetCanaryScale)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetCanaryScale")
 	proto.RegisterType((*SetHeaderRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetHeaderRoute")
 	proto.RegisterType((*SetMirrorRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetMirrorRoute")
 	proto.RegisterType((*Sigv4Config)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Sigv4Config")
 	proto.RegisterType((*SkyWalkingMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SkyWalkingMetric")
 	proto.RegisterType((*StepPluginStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StepPluginStatus")
+	proto.RegisterType((*StepTimeout)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StepTimeout")
 	proto.RegisterType((*StickinessConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StickinessConfig")
+	proto.RegisterType((*StrategyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StrategyRef")
 	proto.RegisterType((*StringMatch)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StringMatch")
 	proto.RegisterType((*TCPRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TCPRoute")
 	proto.RegisterType((*TLSRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TLSRoute")
@@ -3453,8 +4494,11 @@ func init() {
 	proto.RegisterType((*TemplateSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateSpec")
 	proto.RegisterType((*TemplateStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TemplateStatus")
 	proto.RegisterType((*TraefikTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TraefikTrafficRouting")
+	proto.RegisterType((*TrafficStickiness)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TrafficStickiness")
 	proto.RegisterType((*TrafficWeights)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TrafficWeights")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ValueFrom")
+	proto.RegisterType((*WaitForStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WaitForStatus")
+	proto.RegisterType((*WaitForStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WaitForStep")
 	proto.RegisterType((*WavefrontMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WavefrontMetric")
 	proto.RegisterType((*WebMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetric")
 	proto.RegisterType((*WebMetricHeader)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetricHeader")
@@ -3466,571 +4510,689 @@ func init() {
 }
 
 var fileDescriptor_e0e705f843545fab = []byte{
-	// 9012 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x5d, 0x6c, 0x24, 0xd9,
-	0x75, 0x18, 0xac, 0x62, 0xb3, 0xc9, 0xee, 0xd3, 0x1c, 0x92, 0x73, 0x67, 0x66, 0x87, 0xcb, 0xdd,
-	0x19, 0x8e, 0x6a, 0xfd, 0xed, 0x37, 0x6b, 0xad, 0x48, 0x69, 0x76, 0xd7, 0x59, 0x69, 0x95, 0x4d,
-	0xba, 0xc9, 0x99, 0x1d, 0xce, 0x92, 0x33, 0xdc, 0xd3, 0x9c, 0x1d, 0xeb, 0x67, 0x6d, 0x15, 0xbb,
-	0x2f, 0x9b, 0x35, 0xec, 0xae, 0x6a, 0x55, 0x55, 0x73, 0x86, 0xab, 0x85, 0xb5, 0x92, 0xb0, 0x96,
-	0xac, 0x48, 0xb0, 0x62, 0x5b, 0x08, 0xf2, 0x83, 0x40, 0x31, 0x1c, 0x38, 0x7f, 0x0f, 0x81, 0xa1,
-	0x20, 0x79, 0x30, 0x90, 0x20, 0x8a, 0x03, 0x19, 0x88, 0x02, 0xf9, 0x21, 0x91, 0x13, 0xc0, 0x74,
-	0x44, 0xe7, 0x25, 0x46, 0x02, 0xc1, 0x81, 0x03, 0x21, 0xfb, 0x60, 0x04, 0xf7, 0xb7, 0x6e, 0x55,
-	0x57, 0xf3, 0xaf, 0x8b, 0xb3, 0xeb, 0xc4, 0x6f, 0xdd, 0xf7, 0x9c, 0x7b, 0xce, 0xa9, 0xfb, 0x7b,
-	0xee, 0xb9, 0xe7, 0x9c, 0x0b, 0x2b, 0x2d, 0x37, 0xda, 0xea, 0x6d, 0xcc, 0x37, 0xfc, 0xce, 0x82,
-	0x13, 0xb4, 0xfc, 0x6e, 0xe0, 0xdf, 0xe7, 0x3f, 0x3e, 0x1c, 0xf8, 0xed, 0xb6, 0xdf, 0x8b, 0xc2,
-	0x85, 0xee, 0x76, 0x6b, 0xc1, 0xe9, 0xba, 0xe1, 0x82, 0x2e, 0xd9, 0xf9, 0xa8, 0xd3, 0xee, 0x6e,
-	0x39, 0x1f, 0x5d, 0x68, 0x51, 0x8f, 0x06, 0x4e, 0x44, 0x9b, 0xf3, 0xdd, 0xc0, 0x8f, 0x7c, 0xf2,
-	0x89, 0x98, 0xda, 0xbc, 0xa2, 0xc6, 0x7f, 0xfc, 0xbc, 0xaa, 0x3b, 0xdf, 0xdd, 0x6e, 0xcd, 0x33,
-	0x6a, 0xf3, 0xba, 0x44, 0x51, 0x9b, 0xfd, 0xb0, 0x21, 0x4b, 0xcb, 0x6f, 0xf9, 0x0b, 0x9c, 0xe8,
-	0x46, 0x6f, 0x93, 0xff, 0xe3, 0x7f, 0xf8, 0x2f, 0xc1, 0x6c, 0xf6, 0xa9, 0xed, 0x17, 0xc3, 0x79,
-	0xd7, 0x67, 0xb2, 0x2d, 0x6c, 0x38, 0x51, 0x63, 0x6b, 0x61, 0xa7, 0x4f, 0xa2, 0x59, 0xdb, 0x40,
-	0x6a, 0xf8, 0x01, 0xcd, 0xc2, 0x79, 0x3e, 0xc6, 0xe9, 0x38, 0x8d, 0x2d, 0xd7, 0xa3, 0xc1, 0x6e,
-	0xfc, 0xd5, 0x1d, 0x1a, 0x39, 0x59, 0xb5, 0x16, 0x06, 0xd5, 0x0a, 0x7a, 0x5e, 0xe4, 0x76, 0x68,
-	0x5f, 0x85, 0x9f, 0x39, 0xac, 0x42, 0xd8, 0xd8, 0xa2, 0x1d, 0xa7, 0xaf, 0xde, 0x73, 0x83, 0xea,
-	0xf5, 0x22, 0xb7, 0xbd, 0xe0, 0x7a, 0x51, 0x18, 0x05, 0xe9, 0x4a, 0xf6, 0x8f, 0x0b, 0x50, 0xae,
-	0xae, 0xd4, 0xea, 0x91, 0x13, 0xf5, 0x42, 0xf2, 0x8b, 0x16, 0x4c, 0xb4, 0x7d, 0xa7, 0x59, 0x73,
-	0xda, 0x8e, 0xd7, 0xa0, 0xc1, 0x8c, 0x75, 0xc5, 0xba, 0x5a, 0xb9, 0xb6, 0x32, 0x3f, 0x4c, 0x7f,
-	0xcd, 0x57, 0x1f, 0x84, 0x48, 0x43, 0xbf, 0x17, 0x34, 0x28, 0xd2, 0xcd, 0xda, 0xf9, 0xef, 0xed,
-	0xcd, 0x7d, 0x60, 0x7f, 0x6f, 0x6e, 0x62, 0xc5, 0xe0, 0x84, 0x09, 0xbe, 0xe4, 0x5b, 0x16, 0x9c,
-	0x6d, 0x38, 0x9e, 0x13, 0xec, 0xae, 0x3b, 0x41, 0x8b, 0x46, 0xaf, 0x04, 0x7e, 0xaf, 0x3b, 0x33,
-	0x72, 0x0a, 0xd2, 0x3c, 0x2e, 0xa5, 0x39, 0xbb, 0x98, 0x66, 0x87, 0xfd, 0x12, 0x70, 0xb9, 0xc2,
-	0xc8, 0xd9, 0x68, 0x53, 0x53, 0xae, 0xc2, 0x69, 0xca, 0x55, 0x4f, 0xb3, 0xc3, 0x7e, 0x09, 0xc8,
-	0x33, 0x30, 0xee, 0x7a, 0xad, 0x80, 0x86, 0xe1, 0xcc, 0xe8, 0x15, 0xeb, 0x6a, 0xb9, 0x36, 0x25,
-	0xab, 0x8f, 0x2f, 0x8b, 0x62, 0x54, 0x70, 0xfb, 0xb7, 0x0a, 0x70, 0xb6, 0xba, 0x52, 0x5b, 0x0f,
-	0x9c, 0xcd, 0x4d, 0xb7, 0x81, 0x7e, 0x2f, 0x72, 0xbd, 0x96, 0x49, 0xc0, 0x3a, 0x98, 0x00, 0x79,
-	0x01, 0x2a, 0x21, 0x0d, 0x76, 0xdc, 0x06, 0x5d, 0xf3, 0x83, 0x88, 0x77, 0x4a, 0xb1, 0x76, 0x4e,
-	0xa2, 0x57, 0xea, 0x31, 0x08, 0x4d, 0x3c, 0x56, 0x2d, 0xf0, 0xfd, 0x48, 0xc2, 0x79, 0x9b, 0x95,
-	0xe3, 0x6a, 0x18, 0x83, 0xd0, 0xc4, 0x23, 0x4b, 0x30, 0xed, 0x78, 0x9e, 0x1f, 0x39, 0x91, 0xeb,
-	0x7b, 0x6b, 0x01, 0xdd, 0x74, 0x1f, 0xca, 0x4f, 0x9c, 0x91, 0x75, 0xa7, 0xab, 0x29, 0x38, 0xf6,
-	0xd5, 0x20, 0xdf, 0xb4, 0x60, 0x3a, 0x8c, 0xdc, 0xc6, 0xb6, 0xeb, 0xd1, 0x30, 0x5c, 0xf4, 0xbd,
-	0x4d, 0xb7, 0x35, 0x53, 0xe4, 0xdd, 0x76, 0x7b, 0xb8, 0x6e, 0xab, 0xa7, 0xa8, 0xd6, 0xce, 0x33,
-	0x91, 0xd2, 0xa5, 0xd8, 0xc7, 0x9d, 0x7c, 0x08, 0xca, 0xb2, 0x45, 0x69, 0x38, 0x33, 0x76, 0xa5,
-	0x70, 0xb5, 0x5c, 0x3b, 0xb3, 0xbf, 0x37, 0x57, 0x5e, 0x56, 0x85, 0x18, 0xc3, 0xed, 0x25, 0x98,
-	0xa9, 0x76, 0x36, 0x9c, 0x30, 0x74, 0x9a, 0x7e, 0x90, 0xea, 0xba, 0xab, 0x50, 0xea, 0x38, 0xdd,
-	0xae, 0xeb, 0xb5, 0x58, 0xdf, 0x31, 0x3a, 0x13, 0xfb, 0x7b, 0x73, 0xa5, 0x55, 0x59, 0x86, 0x1a,
-	0x6a, 0xff, 0xa7, 0x11, 0xa8, 0x54, 0x3d, 0xa7, 0xbd, 0x1b, 0xba, 0x21, 0xf6, 0x3c, 0xf2, 0x59,
-	0x28, 0xb1, 0x55, 0xab, 0xe9, 0x44, 0x8e, 0x9c, 0xe9, 0x1f, 0x99, 0x17, 0x8b, 0xc8, 0xbc, 0xb9,
-	0x88, 0xc4, 0x9f, 0xcf, 0xb0, 0xe7, 0x77, 0x3e, 0x3a, 0x7f, 0x67, 0xe3, 0x3e, 0x6d, 0x44, 0xab,
-	0x34, 0x72, 0x6a, 0x44, 0xf6, 0x02, 0xc4, 0x65, 0xa8, 0xa9, 0x12, 0x1f, 0x46, 0xc3, 0x2e, 0x6d,
-	0xc8, 0x99, 0xbb, 0x3a, 0xe4, 0x0c, 0x89, 0x45, 0xaf, 0x77, 0x69, 0xa3, 0x36, 0x21, 0x59, 0x8f,
-	0xb2, 0x7f, 0xc8, 0x19, 0x91, 0x07, 0x30, 0x16, 0xf2, 0xb5, 0x4c, 0x4e, 0xca, 0x3b, 0xf9, 0xb1,
-	0xe4, 0x64, 0x6b, 0x93, 0x92, 0xe9, 0x98, 0xf8, 0x8f, 0x92, 0x9d, 0xfd, 0x9f, 0x2d, 0x38, 0x67,
-	0x60, 0x57, 0x83, 0x56, 0xaf, 0x43, 0xbd, 0x88, 0x5c, 0x81, 0x51, 0xcf, 0xe9, 0x50, 0x39, 0xab,
-	0xb4, 0xc8, 0xb7, 0x9d, 0x0e, 0x45, 0x0e, 0x21, 0x4f, 0x41, 0x71, 0xc7, 0x69, 0xf7, 0x28, 0x6f,
-	0xa4, 0x72, 0xed, 0x8c, 0x44, 0x29, 0xbe, 0xce, 0x0a, 0x51, 0xc0, 0xc8, 0x5b, 0x50, 0xe6, 0x3f,
-	0x6e, 0x04, 0x7e, 0x27, 0xa7, 0x4f, 0x93, 0x12, 0xbe, 0xae, 0xc8, 0x8a, 0xe1, 0xa7, 0xff, 0x62,
-	0xcc, 0xd0, 0xfe, 0x43, 0x0b, 0xa6, 0x8c, 0x8f, 0x5b, 0x71, 0xc3, 0x88, 0x7c, 0xa6, 0x6f, 0xf0,
-	0xcc, 0x1f, 0x6d, 0xf0, 0xb0, 0xda, 0x7c, 0xe8, 0x4c, 0xcb, 0x2f, 0x2d, 0xa9, 0x12, 0x63, 0xe0,
-	0x78, 0x50, 0x74, 0x23, 0xda, 0x09, 0x67, 0x46, 0xae, 0x14, 0xae, 0x56, 0xae, 0x2d, 0xe7, 0xd6,
-	0x8d, 0x71, 0xfb, 0x2e, 0x33, 0xfa, 0x28, 0xd8, 0xd8, 0xdf, 0x29, 0x24, 0xba, 0x6f, 0x55, 0xc9,
-	0xf1, 0x8e, 0x05, 0x63, 0x6d, 0x67, 0x83, 0xb6, 0xc5, 0xdc, 0xaa, 0x5c, 0x7b, 0x23, 0x37, 0x49,
-	0x14, 0x8f, 0xf9, 0x15, 0x4e, 0xff, 0xba, 0x17, 0x05, 0xbb, 0xf1, 0xf0, 0x12, 0x85, 0x28, 0x99,
-	0x93, 0xbf, 0x69, 0x41, 0x25, 0x5e, 0xd5, 0x54, 0xb3, 0x6c, 0xe4, 0x2f, 0x4c, 0xbc, 0x98, 0x4a,
-	0x89, 0xf4, 0x12, 0x6d, 0x40, 0xd0, 0x94, 0x65, 0xf6, 0x63, 0x50, 0x31, 0x3e, 0x81, 0x4c, 0x43,
-	0x61, 0x9b, 0xee, 0x8a, 0x01, 0x8f, 0xec, 0x27, 0x39, 0x9f, 0x18, 0xe1, 0x72, 0x48, 0x7f, 0x7c,
-	0xe4, 0x45, 0x6b, 0xf6, 0x65, 0x98, 0x4e, 0x33, 0x3c, 0x4e, 0x7d, 0xfb, 0x9f, 0x16, 0x13, 0x03,
-	0x93, 0x2d, 0x04, 0xc4, 0x87, 0xf1, 0x0e, 0x8d, 0x02, 0xb7, 0xa1, 0xba, 0x6c, 0x69, 0xb8, 0x56,
-	0x5a, 0xe5, 0xc4, 0xe2, 0x0d, 0x51, 0xfc, 0x0f, 0x51, 0x71, 0x21, 0x5b, 0x30, 0xea, 0x04, 0x2d,
-	0xd5, 0x27, 0x37, 0xf2, 0x99, 0x96, 0xf1, 0x52, 0x51, 0x0d, 0x5a, 0x21, 0x72, 0x0e, 0x64, 0x01,
-	0xca, 0x11, 0x0d, 0x3a, 0xae, 0xe7, 0x44, 0x62, 0x07, 0x2d, 0xd5, 0xce, 0x4a, 0xb4, 0xf2, 0xba,
-	0x02, 0x60, 0x8c, 0x43, 0xda, 0x30, 0xd6, 0x0c, 0x76, 0xb1, 0xe7, 0xcd, 0x8c, 0xe6, 0xd1, 0x14,
-	0x4b, 0x9c, 0x56, 0x3c, 0x48, 0xc5, 0x7f, 0x94, 0x3c, 0xc8, 0x6f, 0x58, 0x70, 0xbe, 0x43, 0x9d,
-	0xb0, 0x17, 0x50, 0xf6, 0x09, 0x48, 0x23, 0xea, 0xb1, 0x8e, 0x9d, 0x29, 0x72, 0xe6, 0x38, 0x6c,
-	0x3f, 0xf4, 0x53, 0xae, 0x3d, 0x29, 0x45, 0x39, 0x9f, 0x05, 0xc5, 0x4c, 0x69, 0xc8, 0x5b, 0x50,
-	0x89, 0xa2, 0x76, 0x3d, 0x62, 0x7a, 0x70, 0x6b, 0x77, 0x66, 0x8c, 0x2f, 0x5e, 0x43, 0xae, 0x30,
-	0xeb, 0xeb, 0x2b, 0x8a, 0x60, 0x6d, 0x8a, 0xcd, 0x16, 0xa3, 0x00, 0x4d, 0x76, 0xf6, 0xbf, 0x28,
-	0xc2, 0xd9, 0xbe, 0x6d, 0x85, 0x3c, 0x0f, 0xc5, 0xee, 0x96, 0x13, 0xaa, 0x7d, 0xe2, 0xb2, 0x5a,
-	0xa4, 0xd6, 0x58, 0xe1, 0xbb, 0x7b, 0x73, 0x67, 0x54, 0x15, 0x5e, 0x80, 0x02, 0x99, 0x69, 0x6d,
-	0x1d, 0x1a, 0x86, 0x4e, 0x4b, 0x6d, 0x1e, 0xc6, 0x20, 0xe5, 0xc5, 0xa8, 0xe0, 0xe4, 0x2b, 0x16,
-	0x9c, 0x11, 0x03, 0x16, 0x69, 0xd8, 0x6b, 0x47, 0x6c, 0x83, 0x64, 0x9d, 0x72, 0x2b, 0x8f, 0xc9,
-	0x21, 0x48, 0xd6, 0x2e, 0x48, 0xee, 0x67, 0xcc, 0xd2, 0x10, 0x93, 0x7c, 0xc9, 0x3d, 0x28, 0x87,
-	0x91, 0x13, 0x44, 0xb4, 0x59, 0x8d, 0xb8, 0x2a, 0x57, 0xb9, 0xf6, 0xd3, 0x47, 0xdb, 0x39, 0xd6,
-	0xdd, 0x0e, 0x15, 0xbb, 0x54, 0x5d, 0x11, 0xc0, 0x98, 0x16, 0x79, 0x0b, 0x20, 0xe8, 0x79, 0xf5,
-	0x5e, 0xa7, 0xe3, 0x04, 0xbb, 0x52, 0xbb, 0xbb, 0x39, 0xdc, 0xe7, 0xa1, 0xa6, 0x17, 0x2b, 0x3a,
-	0x71, 0x19, 0x1a, 0xfc, 0xc8, 0x17, 0x2d, 0x38, 0x23, 0xe6, 0x81, 0x92, 0x60, 0x2c, 0x67, 0x09,
-	0xce, 0xb2, 0xa6, 0x5d, 0x32, 0x59, 0x60, 0x92, 0x23, 0x79, 0x03, 0x2a, 0x0d, 0xbf, 0xd3, 0x6d,
-	0x53, 0xd1, 0xb8, 0xe3, 0xc7, 0x6e, 0x5c, 0x3e, 0x74, 0x17, 0x63, 0x12, 0x68, 0xd2, 0xb3, 0xff,
-	0x43, 0x52, 0xc7, 0x51, 0x43, 0x9a, 0x7c, 0x1a, 0x1e, 0x0f, 0x7b, 0x8d, 0x06, 0x0d, 0xc3, 0xcd,
-	0x5e, 0x1b, 0x7b, 0xde, 0x4d, 0x37, 0x8c, 0xfc, 0x60, 0x77, 0xc5, 0xed, 0xb8, 0x11, 0x1f, 0xd0,
-	0xc5, 0xda, 0xa5, 0xfd, 0xbd, 0xb9, 0xc7, 0xeb, 0x83, 0x90, 0x70, 0x70, 0x7d, 0xe2, 0xc0, 0x13,
-	0x3d, 0x6f, 0x30, 0x79, 0x71, 0xfc, 0x98, 0xdb, 0xdf, 0x9b, 0x7b, 0xe2, 0xee, 0x60, 0x34, 0x3c,
-	0x88, 0x86, 0xfd, 0xc7, 0x16, 0xdb, 0x86, 0xc4, 0x77, 0xad, 0xd3, 0x4e, 0xb7, 0xcd, 0x96, 0xce,
-	0xd3, 0x57, 0x8e, 0xa3, 0x84, 0x72, 0x8c, 0xf9, 0xec, 0xe5, 0x4a, 0xfe, 0x41, 0x1a, 0xb2, 0xfd,
-	0xdf, 0x2c, 0x38, 0x9f, 0x46, 0x7e, 0x04, 0x0a, 0x5d, 0x98, 0x54, 0xe8, 0x6e, 0xe7, 0xfb, 0xb5,
-	0x03, 0xb4, 0xba, 0x5f, 0x32, 0x06, 0xac, 0x42, 0x45, 0xba, 0x49, 0x5e, 0x84, 0x89, 0x48, 0xfe,
-	0xbd, 0x1d, 0x2b, 0xe7, 0xda, 0x30, 0xb1, 0x6e, 0xc0, 0x30, 0x81, 0xc9, 0x6a, 0x36, 0xda, 0xbd,
-	0x30, 0xa2, 0x41, 0xbd, 0xe1, 0x77, 0xc5, 0xb2, 0x5b, 0x8a, 0x6b, 0x2e, 0x1a, 0x30, 0x4c, 0x60,
-	0xda, 0x7f, 0xad, 0xd8, 0xdf, 0xee, 0xff, 0xb7, 0xeb, 0x2b, 0xb1, 0xfa, 0x51, 0x78, 0x2f, 0xd5,
-	0x8f, 0xd1, 0xf7, 0x95, 0xfa, 0xf1, 0x25, 0x8b, 0x69, 0x71, 0x62, 0x00, 0x84, 0x52, 0x35, 0x7a,
-	0x2d, 0xdf, 0xe9, 0x80, 0x74, 0xd3, 0x54, 0x0c, 0x25, 0x2f, 0x8c, 0xd9, 0xda, 0xff, 0x60, 0x14,
-	0x26, 0xaa, 0x5e, 0xe4, 0x56, 0x37, 0x37, 0x5d, 0xcf, 0x8d, 0x76, 0xc9, 0xd7, 0x47, 0x60, 0xa1,
-	0x1b, 0xd0, 0x4d, 0x1a, 0x04, 0xb4, 0xb9, 0xd4, 0x0b, 0x5c, 0xaf, 0x55, 0x6f, 0x6c, 0xd1, 0x66,
-	0xaf, 0xed, 0x7a, 0xad, 0xe5, 0x96, 0xe7, 0xeb, 0xe2, 0xeb, 0x0f, 0x69, 0xa3, 0xc7, 0xdb, 0x55,
-	0xac, 0x12, 0x9d, 0xe1, 0x64, 0x5f, 0x3b, 0x1e, 0xd3, 0xda, 0x73, 0xfb, 0x7b, 0x73, 0x0b, 0xc7,
-	0xac, 0x84, 0xc7, 0xfd, 0x34, 0xf2, 0xd5, 0x11, 0x98, 0x0f, 0xe8, 0xe7, 0x7a, 0xee, 0xd1, 0x5b,
-	0x43, 0x2c, 0xe3, 0xed, 0x21, 0xb7, 0xfb, 0x63, 0xf1, 0xac, 0x5d, 0xdb, 0xdf, 0x9b, 0x3b, 0x66,
-	0x1d, 0x3c, 0xe6, 0x77, 0xd9, 0x6b, 0x50, 0xa9, 0x76, 0xdd, 0xd0, 0x7d, 0x88, 0x7e, 0x2f, 0xa2,
-	0x47, 0x30, 0x68, 0xcc, 0x41, 0x31, 0xe8, 0xb5, 0xa9, 0x58, 0x60, 0xca, 0xb5, 0x32, 0x5b, 0x96,
-	0x91, 0x15, 0xa0, 0x28, 0xb7, 0xbf, 0xc4, 0xb6, 0x20, 0x4e, 0x32, 0x65, 0xca, 0xba, 0x0f, 0xc5,
-	0x80, 0x31, 0x91, 0x23, 0x6b, 0xd8, 0x53, 0x7f, 0x2c, 0xb5, 0x14, 0x82, 0xfd, 0x44, 0xc1, 0xc2,
-	0xfe, 0xee, 0x08, 0x5c, 0xa8, 0x76, 0xbb, 0xab, 0x34, 0xdc, 0x4a, 0x49, 0xf1, 0xcb, 0x16, 0x4c,
-	0xee, 0xb8, 0x41, 0xd4, 0x73, 0xda, 0xca, 0x5a, 0x29, 0xe4, 0xa9, 0x0f, 0x2b, 0x0f, 0xe7, 0xf6,
-	0x7a, 0x82, 0x74, 0x8d, 0xec, 0xef, 0xcd, 0x4d, 0x26, 0xcb, 0x30, 0xc5, 0x9e, 0xfc, 0x0d, 0x0b,
-	0xa6, 0x65, 0xd1, 0x6d, 0xbf, 0x49, 0x4d, 0x6b, 0xf8, 0xdd, 0x3c, 0x65, 0xd2, 0xc4, 0x85, 0x15,
-	0x33, 0x5d, 0x8a, 0x7d, 0x42, 0xd8, 0xff, 0x63, 0x04, 0x2e, 0x0e, 0xa0, 0x41, 0x7e, 0xd3, 0x82,
-	0xf3, 0xc2, 0x84, 0x6e, 0x80, 0x90, 0x6e, 0xca, 0xd6, 0xfc, 0x64, 0xde, 0x92, 0x23, 0x9b, 0xe2,
-	0xd4, 0x6b, 0xd0, 0xda, 0x0c, 0x5b, 0x92, 0x17, 0x33, 0x58, 0x63, 0xa6, 0x40, 0x5c, 0x52, 0x61,
-	0x54, 0x4f, 0x49, 0x3a, 0xf2, 0x48, 0x24, 0xad, 0x67, 0xb0, 0xc6, 0x4c, 0x81, 0xec, 0xbf, 0x02,
-	0x4f, 0x1c, 0x40, 0xee, 0xf0, 0xc9, 0x69, 0xbf, 0xa1, 0x47, 0x7d, 0x72, 0xcc, 0x1d, 0x61, 0x5e,
-	0xdb, 0x30, 0xc6, 0xa7, 0x8e, 0x9a, 0xd8, 0xc0, 0xf6, 0x60, 0x3e, 0xa7, 0x42, 0x94, 0x10, 0xfb,
-	0xbb, 0x16, 0x94, 0x8e, 0x61, 0xfb, 0x9c, 0x4b, 0xda, 0x3e, 0xcb, 0x7d, 0x76, 0xcf, 0xa8, 0xdf,
-	0xee, 0xf9, 0xca, 0x70, 0xbd, 0x71, 0x14, 0x7b, 0xe7, 0x8f, 0x2d, 0x38, 0xdb, 0x67, 0x1f, 0x25,
-	0x5b, 0x70, 0xbe, 0xeb, 0x37, 0xd5, 0x76, 0x7a, 0xd3, 0x09, 0xb7, 0x38, 0x4c, 0x7e, 0xde, 0xf3,
-	0xac, 0x27, 0xd7, 0x32, 0xe0, 0xef, 0xee, 0xcd, 0xcd, 0x68, 0x22, 0x29, 0x04, 0xcc, 0xa4, 0x48,
-	0xba, 0x50, 0xda, 0x74, 0x69, 0xbb, 0x19, 0x0f, 0xc1, 0x21, 0xb5, 0xb4, 0x1b, 0x92, 0x9a, 0xb8,
-	0x1a, 0x50, 0xff, 0x50, 0x73, 0xb1, 0xff, 0xd4, 0x82, 0xc9, 0x6a, 0x2f, 0xda, 0x62, 0x3a, 0x4a,
-	0x83, 0x5b, 0xe3, 0x88, 0x07, 0xc5, 0xd0, 0x6d, 0xed, 0x3c, 0x9f, 0xcf, 0x62, 0x5c, 0x67, 0xa4,
-	0xe4, 0x15, 0x89, 0x56, 0xd6, 0x79, 0x21, 0x0a, 0x36, 0x24, 0x80, 0x31, 0xdf, 0xe9, 0x45, 0x5b,
-	0xd7, 0xe4, 0x27, 0x0f, 0x69, 0x99, 0xb8, 0xc3, 0x3e, 0xe7, 0x9a, 0xe4, 0xa8, 0x55, 0x46, 0x51,
-	0x8a, 0x92, 0x93, 0xfd, 0x05, 0x98, 0x4c, 0xde, 0xbb, 0x1d, 0x61, 0xcc, 0x5e, 0x82, 0x82, 0x13,
-	0x78, 0x72, 0xc4, 0x56, 0x24, 0x42, 0xa1, 0x8a, 0xb7, 0x91, 0x95, 0x93, 0x67, 0xa1, 0xb4, 0xd9,
-	0x6b, 0xb7, 0xf9, 0xb9, 0x42, 0x5c, 0x72, 0xe9, 0x63, 0xd1, 0x0d, 0x59, 0x8e, 0x1a, 0xc3, 0xfe,
-	0xdf, 0xa3, 0x30, 0x55, 0x6b, 0xf7, 0xe8, 0x2b, 0x01, 0xa5, 0xca, 0x16, 0x54, 0x85, 0xa9, 0x6e,
-	0x40, 0x77, 0x5c, 0xfa, 0xa0, 0x4e, 0xdb, 0xb4, 0x11, 0xf9, 0x81, 0x94, 0xe6, 0xa2, 0x24, 0x34,
-	0xb5, 0x96, 0x04, 0x63, 0x1a, 0x9f, 0xbc, 0x0c, 0x93, 0x4e, 0x23, 0x72, 0x77, 0xa8, 0xa6, 0x20,
-	0xc4, 0x7d, 0x4c, 0x52, 0x98, 0xac, 0x26, 0xa0, 0x98, 0xc2, 0x26, 0x9f, 0x81, 0x99, 0xb0, 0xe1,
-	0xb4, 0xe9, 0xdd, 0xae, 0x64, 0xb5, 0xb8, 0x45, 0x1b, 0xdb, 0x6b, 0xbe, 0xeb, 0x45, 0xd2, 0xee,
-	0x78, 0x45, 0x52, 0x9a, 0xa9, 0x0f, 0xc0, 0xc3, 0x81, 0x14, 0xc8, 0xbf, 0xb4, 0xe0, 0x52, 0x37,
-	0xa0, 0x6b, 0x81, 0xdf, 0xf1, 0xd9, 0x50, 0xeb, 0x33, 0x87, 0x49, 0xb3, 0xd0, 0xeb, 0x43, 0xea,
-	0x52, 0xa2, 0xa4, 0xff, 0x0e, 0xe7, 0x83, 0xfb, 0x7b, 0x73, 0x97, 0xd6, 0x0e, 0x12, 0x00, 0x0f,
-	0x96, 0x8f, 0xfc, 0x6b, 0x0b, 0x2e, 0x77, 0xfd, 0x30, 0x3a, 0xe0, 0x13, 0x8a, 0xa7, 0xfa, 0x09,
-	0xf6, 0xfe, 0xde, 0xdc, 0xe5, 0xb5, 0x03, 0x25, 0xc0, 0x43, 0x24, 0xb4, 0xf7, 0x2b, 0x70, 0xd6,
-	0x18, 0x7b, 0xd2, 0x98, 0xf3, 0x12, 0x9c, 0x51, 0x83, 0x21, 0xd6, 0x7d, 0xca, 0xb1, 0x6d, 0xaf,
-	0x6a, 0x02, 0x31, 0x89, 0xcb, 0xc6, 0x9d, 0x1e, 0x8a, 0xa2, 0x76, 0x6a, 0xdc, 0xad, 0x25, 0xa0,
-	0x98, 0xc2, 0x26, 0xcb, 0x70, 0x4e, 0x96, 0x20, 0xed, 0xb6, 0xdd, 0x86, 0xb3, 0xe8, 0xf7, 0xe4,
-	0x90, 0x2b, 0xd6, 0x2e, 0xee, 0xef, 0xcd, 0x9d, 0x5b, 0xeb, 0x07, 0x63, 0x56, 0x1d, 0xb2, 0x02,
-	0xe7, 0x9d, 0x5e, 0xe4, 0xeb, 0xef, 0xbf, 0xee, 0xb1, 0xed, 0xb4, 0xc9, 0x87, 0x56, 0x49, 0xec,
-	0xbb, 0xd5, 0x0c, 0x38, 0x66, 0xd6, 0x22, 0x6b, 0x29, 0x6a, 0x75, 0xda, 0xf0, 0xbd, 0xa6, 0xe8,
-	0xe5, 0x62, 0x7c, 0x0c, 0xac, 0x66, 0xe0, 0x60, 0x66, 0x4d, 0xd2, 0x86, 0xc9, 0x8e, 0xf3, 0xf0,
-	0xae, 0xe7, 0xec, 0x38, 0x6e, 0x9b, 0x31, 0x91, 0xf6, 0xc2, 0xc1, 0x56, 0xa6, 0x5e, 0xe4, 0xb6,
-	0xe7, 0x85, 0x1f, 0xc7, 0xfc, 0xb2, 0x17, 0xdd, 0x09, 0xea, 0x11, 0xd3, 0xd4, 0x85, 0x06, 0xb9,
-	0x9a, 0xa0, 0x85, 0x29, 0xda, 0xe4, 0x0e, 0x5c, 0xe0, 0xd3, 0x71, 0xc9, 0x7f, 0xe0, 0x2d, 0xd1,
-	0xb6, 0xb3, 0xab, 0x3e, 0x60, 0x9c, 0x7f, 0xc0, 0xe3, 0xfb, 0x7b, 0x73, 0x17, 0xea, 0x59, 0x08,
-	0x98, 0x5d, 0x8f, 0x38, 0xf0, 0x44, 0x12, 0x80, 0x74, 0xc7, 0x0d, 0x5d, 0xdf, 0x13, 0x66, 0xb9,
-	0x52, 0x6c, 0x96, 0xab, 0x0f, 0x46, 0xc3, 0x83, 0x68, 0x90, 0xbf, 0x6d, 0xc1, 0xf9, 0xac, 0x69,
-	0x38, 0x53, 0xce, 0xe3, 0x36, 0x39, 0x35, 0xb5, 0xc4, 0x88, 0xc8, 0x5c, 0x14, 0x32, 0x85, 0x20,
-	0x6f, 0x5b, 0x30, 0xe1, 0x18, 0x27, 0xe8, 0x19, 0xc8, 0x63, 0xd7, 0x32, 0xcf, 0xe4, 0xb5, 0xe9,
-	0xfd, 0xbd, 0xb9, 0xc4, 0x29, 0x1d, 0x13, 0x1c, 0xc9, 0xdf, 0xb5, 0xe0, 0x42, 0xe6, 0x1c, 0x9f,
-	0xa9, 0x9c, 0x46, 0x0b, 0xf1, 0x41, 0x92, 0xbd, 0xe6, 0x64, 0x8b, 0x41, 0xbe, 0x69, 0xe9, 0xad,
-	0x4c, 0x5d, 0x30, 0xce, 0x4c, 0x70, 0xd1, 0x86, 0x34, 0x78, 0x18, 0x6a, 0x94, 0x22, 0x5c, 0x3b,
-	0x67, 0xec, 0x8c, 0xaa, 0x10, 0xd3, 0xec, 0xc9, 0x37, 0x2c, 0xb5, 0x35, 0x6a, 0x89, 0xce, 0x9c,
-	0x96, 0x44, 0x24, 0xde, 0x69, 0xb5, 0x40, 0x29, 0xe6, 0xe4, 0xe7, 0x60, 0xd6, 0xd9, 0xf0, 0x83,
-	0x28, 0x73, 0xf2, 0xcd, 0x4c, 0xf2, 0x69, 0x74, 0x79, 0x7f, 0x6f, 0x6e, 0xb6, 0x3a, 0x10, 0x0b,
-	0x0f, 0xa0, 0x60, 0xff, 0xee, 0x18, 0x4c, 0x88, 0x93, 0x90, 0xdc, 0xba, 0x7e, 0xdb, 0x82, 0x27,
-	0x1b, 0xbd, 0x20, 0xa0, 0x5e, 0x54, 0x8f, 0x68, 0xb7, 0x7f, 0xe3, 0xb2, 0x4e, 0x75, 0xe3, 0xba,
-	0xb2, 0xbf, 0x37, 0xf7, 0xe4, 0xe2, 0x01, 0xfc, 0xf1, 0x40, 0xe9, 0xc8, 0xbf, 0xb7, 0xc0, 0x96,
-	0x08, 0x35, 0xa7, 0xb1, 0xdd, 0x0a, 0xfc, 0x9e, 0xd7, 0xec, 0xff, 0x88, 0x91, 0x53, 0xfd, 0x88,
-	0xa7, 0xf7, 0xf7, 0xe6, 0xec, 0xc5, 0x43, 0xa5, 0xc0, 0x23, 0x48, 0x4a, 0x5e, 0x81, 0xb3, 0x12,
-	0xeb, 0xfa, 0xc3, 0x2e, 0x0d, 0x5c, 0x76, 0xe6, 0x90, 0x8a, 0x63, 0xec, 0x9b, 0x96, 0x46, 0xc0,
-	0xfe, 0x3a, 0x24, 0x84, 0xf1, 0x07, 0xd4, 0x6d, 0x6d, 0x45, 0x4a, 0x7d, 0x1a, 0xd2, 0x21, 0x4d,
-	0x5a, 0x45, 0xee, 0x09, 0x9a, 0xb5, 0xca, 0xfe, 0xde, 0xdc, 0xb8, 0xfc, 0x83, 0x8a, 0x13, 0xb9,
-	0x0d, 0x93, 0xe2, 0x9c, 0xba, 0xe6, 0x7a, 0xad, 0x35, 0xdf, 0x13, 0x5e, 0x55, 0xe5, 0xda, 0xd3,
-	0x6a, 0xc3, 0xaf, 0x27, 0xa0, 0xef, 0xee, 0xcd, 0x4d, 0xa8, 0xdf, 0xeb, 0xbb, 0x5d, 0x8a, 0xa9,
-	0xda, 0xe4, 0x6f, 0x59, 0x40, 0xc2, 0x88, 0x76, 0xd7, 0xda, 0xbd, 0x96, 0x2b, 0x9b, 0x48, 0xfa,
-	0x47, 0xe5, 0xe0, 0xaa, 0x95, 0xa4, 0x5b, 0x9b, 0x95, 0x42, 0x92, 0x7a, 0x1f, 0x47, 0xcc, 0x90,
-	0xc2, 0xfe, 0xce, 0x38, 0x80, 0x9a, 0x4b, 0xb4, 0x4b, 0x3e, 0x04, 0xe5, 0x90, 0x46, 0xa2, 0x49,
-	0xe4, 0x35, 0x97, 0xb8, 0x9c, 0x54, 0x85, 0x18, 0xc3, 0xc9, 0x36, 0x14, 0xbb, 0x4e, 0x2f, 0xa4,
-	0xf9, 0x1c, 0x6e, 0xe4, 0xc8, 0x5c, 0x63, 0x14, 0xc5, 0xa9, 0x99, 0xff, 0x44, 0xc1, 0x83, 0x7c,
-	0xd9, 0x02, 0xa0, 0xc9, 0xd1, 0x34, 0xb4, 0xf5, 0x4a, 0xb2, 0x8c, 0x07, 0x1c, 0x6b, 0x83, 0xda,
-	0xe4, 0xfe, 0xde, 0x1c, 0x18, 0xe3, 0xd2, 0x60, 0x4b, 0x1e, 0x40, 0xc9, 0x51, 0x1b, 0xd2, 0xe8,
-	0x69, 0x6c, 0x48, 0xfc, 0x30, 0xab, 0x67, 0x94, 0x66, 0x46, 0xbe, 0x6a, 0xc1, 0x64, 0x48, 0x23,
-	0xd9, 0x55, 0x6c, 0x59, 0x94, 0xda, 0xf8, 0x90, 0x33, 0xa2, 0x9e, 0xa0, 0x29, 0x96, 0xf7, 0x64,
-	0x19, 0xa6, 0xf8, 0x2a, 0x51, 0x6e, 0x52, 0xa7, 0x49, 0x03, 0x6e, 0x2b, 0x91, 0x6a, 0xde, 0xf0,
-	0xa2, 0x18, 0x34, 0xb5, 0x28, 0x46, 0x19, 0xa6, 0xf8, 0x2a, 0x51, 0x56, 0xdd, 0x20, 0xf0, 0xa5,
-	0x28, 0xa5, 0x9c, 0x44, 0x31, 0x68, 0x6a, 0x51, 0x8c, 0x32, 0x4c, 0xf1, 0x25, 0x6d, 0x18, 0xeb,
-	0xf2, 0xa9, 0x25, 0x55, 0xb9, 0x21, 0xef, 0xc8, 0xd5, 0x34, 0xa5, 0x5d, 0x61, 0x93, 0x12, 0xff,
-	0x51, 0xf2, 0xb0, 0xbf, 0x7d, 0x06, 0x26, 0xd5, 0xb4, 0x8d, 0x0f, 0x39, 0xc2, 0x10, 0x38, 0xe0,
-	0x90, 0xb3, 0x68, 0x02, 0x31, 0x89, 0xcb, 0x2a, 0x8b, 0x55, 0x2b, 0x79, 0xc6, 0xd1, 0x95, 0xeb,
-	0x26, 0x10, 0x93, 0xb8, 0xa4, 0x03, 0x45, 0xb6, 0xb2, 0x28, 0xf7, 0x8b, 0x21, 0xbf, 0x3c, 0x5e,
-	0x8d, 0x0c, 0xa3, 0x0a, 0x23, 0x8f, 0x82, 0x0b, 0xb7, 0x65, 0x47, 0x09, 0xf3, 0xb6, 0x9c, 0x8a,
-	0xf9, 0xac, 0x06, 0x49, 0xcb, 0xb9, 0xe8, 0xfb, 0x64, 0x19, 0xa6, 0xd8, 0x67, 0x9c, 0x7b, 0x8a,
-	0xa7, 0x78, 0xee, 0xf9, 0x14, 0x94, 0x3a, 0xce, 0xc3, 0x7a, 0x2f, 0x68, 0x9d, 0xfc, 0x7c, 0x25,
-	0xdd, 0x69, 0x05, 0x15, 0xd4, 0xf4, 0xc8, 0x17, 0x2d, 0x63, 0x81, 0x13, 0xbe, 0x16, 0xf7, 0xf2,
-	0x5d, 0xe0, 0xb4, 0xda, 0x30, 0x70, 0xa9, 0xeb, 0x3b, 0x85, 0x94, 0x1e, 0xf9, 0x29, 0x84, 0x69,
-	0xd4, 0x62, 0x82, 0x68, 0x8d, 0xba, 0x7c, 0xaa, 0x1a, 0xf5, 0x62, 0x82, 0x19, 0xa6, 0x98, 0x73,
-	0x79, 0xc4, 0x9c, 0xd3, 0xf2, 0xc0, 0xa9, 0xca, 0x53, 0x4f, 0x30, 0xc3, 0x14, 0xf3, 0xc1, 0x47,
-	0xef, 0xca, 0xe9, 0x1c, 0xbd, 0x27, 0x72, 0x38, 0x7a, 0x1f, 0x7c, 0x2a, 0x39, 0x33, 0xec, 0xa9,
-	0x84, 0xdc, 0x02, 0xd2, 0xdc, 0xf5, 0x9c, 0x8e, 0xdb, 0x90, 0x8b, 0x25, 0xdf, 0xa4, 0x27, 0xb9,
-	0x69, 0x46, 0x6b, 0x65, 0x4b, 0x7d, 0x18, 0x98, 0x51, 0x8b, 0x44, 0x50, 0xea, 0x2a, 0xe5, 0x73,
-	0x2a, 0x8f, 0xd1, 0xaf, 0x94, 0x51, 0xe1, 0x42, 0xc3, 0x26, 0x9e, 0x2a, 0x41, 0xcd, 0x89, 0xac,
-	0xc0, 0xf9, 0x8e, 0xeb, 0xad, 0xf9, 0xcd, 0x70, 0x8d, 0x06, 0xd2, 0xf0, 0x54, 0xa7, 0xd1, 0xcc,
-	0x34, 0x6f, 0x1b, 0x6e, 0x4c, 0x58, 0xcd, 0x80, 0x63, 0x66, 0x2d, 0xfb, 0x7f, 0x59, 0x30, 0xbd,
-	0xd8, 0xf6, 0x7b, 0xcd, 0x7b, 0x4e, 0xd4, 0xd8, 0x12, 0x1e, 0x1b, 0xe4, 0x65, 0x28, 0xb9, 0x5e,
-	0x44, 0x83, 0x1d, 0xa7, 0x2d, 0xf7, 0x27, 0x5b, 0x59, 0x92, 0x97, 0x65, 0xf9, 0xbb, 0x7b, 0x73,
-	0x93, 0x4b, 0xbd, 0x80, 0x1b, 0xec, 0xc5, 0x6a, 0x85, 0xba, 0x0e, 0xf9, 0xb6, 0x05, 0x67, 0x85,
-	0xcf, 0xc7, 0x92, 0x13, 0x39, 0xaf, 0xf5, 0x68, 0xe0, 0x52, 0xe5, 0xf5, 0x31, 0xe4, 0x42, 0x95,
-	0x96, 0x55, 0x31, 0xd8, 0x8d, 0xcf, 0x2c, 0xab, 0x69, 0xce, 0xd8, 0x2f, 0x8c, 0xfd, 0xab, 0x05,
-	0x78, 0x7c, 0x20, 0x2d, 0x32, 0x0b, 0x23, 0x6e, 0x53, 0x7e, 0x3a, 0x48, 0xba, 0x23, 0xcb, 0x4d,
-	0x1c, 0x71, 0x9b, 0x64, 0x9e, 0x6b, 0xb8, 0x01, 0x0d, 0x43, 0x75, 0xf7, 0x5e, 0xd6, 0xca, 0xa8,
-	0x2c, 0x45, 0x03, 0x83, 0xcc, 0x41, 0x91, 0xbb, 0x52, 0xcb, 0xa3, 0x15, 0xd7, 0x99, 0xb9, 0xd7,
-	0x32, 0x8a, 0x72, 0xf2, 0x25, 0x0b, 0x40, 0x08, 0xc8, 0xf4, 0x7d, 0xb9, 0x4b, 0x62, 0xbe, 0xcd,
-	0xc4, 0x28, 0x0b, 0x29, 0xe3, 0xff, 0x68, 0x70, 0x25, 0xeb, 0x30, 0xc6, 0xd4, 0x67, 0xbf, 0x79,
-	0xe2, 0x4d, 0x51, 0x28, 0x40, 0x9c, 0x06, 0x4a, 0x5a, 0xac, 0xad, 0x02, 0x1a, 0xf5, 0x02, 0x8f,
-	0x35, 0x2d, 0xdf, 0x06, 0x4b, 0x42, 0x0a, 0xd4, 0xa5, 0x68, 0x60, 0xd8, 0xff, 0x7c, 0x04, 0xce,
-	0x67, 0x89, 0xce, 0x76, 0x9b, 0x31, 0x21, 0xad, 0xb4, 0x12, 0xfc, 0x6c, 0xfe, 0xed, 0x23, 0xdd,
-	0x97, 0xf4, 0x8d, 0x8d, 0xf4, 0x25, 0x95, 0x7c, 0xc9, 0xcf, 0xea, 0x16, 0x1a, 0x39, 0x61, 0x0b,
-	0x69, 0xca, 0xa9, 0x56, 0xba, 0x02, 0xa3, 0x21, 0xeb, 0xf9, 0x42, 0xf2, 0xe6, 0x87, 0xf7, 0x11,
-	0x87, 0x30, 0x8c, 0x9e, 0xe7, 0x46, 0x32, 0xfe, 0x48, 0x63, 0xdc, 0xf5, 0xdc, 0x08, 0x39, 0xc4,
-	0xfe, 0xd6, 0x08, 0xcc, 0x0e, 0xfe, 0x28, 0xf2, 0x2d, 0x0b, 0xa0, 0xc9, 0x0e, 0x47, 0x21, 0x77,
-	0xe2, 0x17, 0xee, 0x5e, 0xce, 0x69, 0xb5, 0xe1, 0x92, 0xe2, 0x14, 0xfb, 0x21, 0xea, 0xa2, 0x10,
-	0x0d, 0x41, 0xc8, 0x35, 0x35, 0xf4, 0xf9, 0xad, 0x95, 0x98, 0x4c, 0xba, 0xce, 0xaa, 0x86, 0xa0,
-	0x81, 0xc5, 0x4e, 0xbf, 0x9e, 0xd3, 0xa1, 0x61, 0xd7, 0xd1, 0xd1, 0x5c, 0xfc, 0xf4, 0x7b, 0x5b,
-	0x15, 0x62, 0x0c, 0xb7, 0xdb, 0xf0, 0xd4, 0x11, 0xe4, 0xcc, 0x29, 0x58, 0xc6, 0xfe, 0x13, 0x0b,
-	0x2e, 0x4a, 0x4f, 0xbc, 0xff, 0x67, 0xdc, 0x3a, 0x7f, 0x62, 0xc1, 0x13, 0x03, 0xbe, 0xf9, 0x11,
-	0x78, 0x77, 0xbe, 0x99, 0xf4, 0xee, 0xbc, 0x3b, 0xec, 0x90, 0xce, 0xfc, 0x8e, 0x01, 0x4e, 0x9e,
-	0xdf, 0x1d, 0x85, 0x33, 0x6c, 0xd9, 0x6a, 0xfa, 0xad, 0x9c, 0x36, 0xce, 0xa7, 0xa0, 0xf8, 0x39,
-	0xb6, 0x01, 0xa5, 0x07, 0x19, 0xdf, 0x95, 0x50, 0xc0, 0xc8, 0x97, 0x2d, 0x18, 0xff, 0x9c, 0xdc,
-	0x53, 0xc5, 0x59, 0x6e, 0xc8, 0xc5, 0x30, 0xf1, 0x0d, 0xf3, 0x72, 0x87, 0x14, 0x31, 0x38, 0xda,
-	0x97, 0x53, 0x6d, 0xa5, 0x8a, 0x33, 0x79, 0x06, 0xc6, 0x37, 0xfd, 0xa0, 0xd3, 0x6b, 0x3b, 0xe9,
-	0xc0, 0xcf, 0x1b, 0xa2, 0x18, 0x15, 0x9c, 0x4d, 0x72, 0xa7, 0xeb, 0xbe, 0x4e, 0x83, 0x50, 0x84,
-	0x64, 0x24, 0x26, 0x79, 0x55, 0x43, 0xd0, 0xc0, 0xe2, 0x75, 0x5a, 0xad, 0x80, 0xb6, 0x9c, 0xc8,
-	0x0f, 0xf8, 0xce, 0x61, 0xd6, 0xd1, 0x10, 0x34, 0xb0, 0xc8, 0x43, 0x28, 0x87, 0xb4, 0x11, 0xd0,
-	0x08, 0xe9, 0xa6, 0x3c, 0x16, 0xbd, 0x32, 0xac, 0x85, 0x41, 0x92, 0x8b, 0x9d, 0x1a, 0x75, 0x11,
-	0xc6, 0xcc, 0x66, 0x3f, 0x0e, 0x13, 0x66, 0xb3, 0x1d, 0x2b, 0x92, 0xe8, 0x13, 0x20, 0xdd, 0x49,
-	0x53, 0x8b, 0xa1, 0x75, 0x94, 0xc5, 0xd0, 0xfe, 0x8f, 0x23, 0x60, 0x58, 0xc1, 0x1e, 0xc1, 0x22,
-	0xe3, 0x25, 0x16, 0x99, 0x21, 0x2d, 0x38, 0x86, 0x4d, 0x6f, 0x50, 0x5c, 0xe5, 0x4e, 0x2a, 0xae,
-	0xf2, 0x76, 0x6e, 0x1c, 0x0f, 0x0e, 0xab, 0xfc, 0xa1, 0x05, 0x4f, 0xc4, 0xc8, 0xfd, 0xd6, 0xf3,
-	0xc3, 0x77, 0x8c, 0x17, 0xa0, 0xe2, 0xc4, 0xd5, 0xe4, 0x94, 0x36, 0x82, 0xda, 0x34, 0x08, 0x4d,
-	0xbc, 0x38, 0x20, 0xa7, 0x70, 0xc2, 0x80, 0x9c, 0xd1, 0x83, 0x03, 0x72, 0xec, 0x3f, 0x1d, 0x81,
-	0x4b, 0xfd, 0x5f, 0x66, 0x7a, 0xa9, 0x1f, 0xfe, 0x6d, 0x69, 0x3f, 0xf6, 0x91, 0x13, 0xfb, 0xb1,
-	0x17, 0x8e, 0xea, 0xc7, 0xae, 0xbd, 0xc7, 0x47, 0x4f, 0xdd, 0x7b, 0xbc, 0x0e, 0x17, 0x94, 0xab,
-	0xea, 0x0d, 0x3f, 0x90, 0x51, 0x29, 0x6a, 0xed, 0x2a, 0xd5, 0x2e, 0xc9, 0x2a, 0x17, 0x30, 0x0b,
-	0x09, 0xb3, 0xeb, 0xda, 0x3f, 0x2c, 0xc0, 0xb9, 0xb8, 0xd9, 0x17, 0x7d, 0xaf, 0xe9, 0x72, 0x6f,
-	0xa7, 0x97, 0x60, 0x34, 0xda, 0xed, 0xaa, 0xc6, 0xfe, 0xff, 0x95, 0x38, 0xeb, 0xbb, 0x5d, 0xd6,
-	0xdb, 0x17, 0x33, 0xaa, 0xf0, 0xfb, 0x0b, 0x5e, 0x89, 0xac, 0xe8, 0xd9, 0x21, 0x7a, 0xe0, 0xf9,
-	0xe4, 0x68, 0x7e, 0x77, 0x6f, 0x2e, 0x23, 0xbf, 0xc4, 0xbc, 0xa6, 0x94, 0x1c, 0xf3, 0xe4, 0x3e,
-	0x4c, 0xb6, 0x9d, 0x30, 0xba, 0xdb, 0x6d, 0x3a, 0x11, 0x5d, 0x77, 0xa5, 0x1f, 0xd1, 0xf1, 0x02,
-	0x79, 0xb4, 0xc3, 0xc5, 0x4a, 0x82, 0x12, 0xa6, 0x28, 0x93, 0x1d, 0x20, 0xac, 0x64, 0x3d, 0x70,
-	0xbc, 0x50, 0x7c, 0x15, 0xe3, 0x77, 0xfc, 0xa8, 0x2c, 0x7d, 0x68, 0x5f, 0xe9, 0xa3, 0x86, 0x19,
-	0x1c, 0xc8, 0xd3, 0x30, 0x16, 0x50, 0x27, 0xd4, 0x1b, 0x91, 0x9e, 0xff, 0xc8, 0x4b, 0x51, 0x42,
-	0xcd, 0x09, 0x35, 0x76, 0xc8, 0x84, 0xfa, 0x03, 0x0b, 0x26, 0xe3, 0x6e, 0x7a, 0x04, 0x4a, 0x4f,
-	0x27, 0xa9, 0xf4, 0xdc, 0xcc, 0x6b, 0x49, 0x1c, 0xa0, 0xe7, 0xfc, 0xf1, 0xb8, 0xf9, 0x7d, 0x3c,
-	0x74, 0xe4, 0xf3, 0x66, 0x24, 0x81, 0x95, 0x47, 0x3c, 0x5f, 0x42, 0xcf, 0x3c, 0x30, 0x84, 0x80,
-	0x69, 0x59, 0x4d, 0xa9, 0x41, 0xc9, 0x61, 0xaf, 0xb5, 0x2c, 0xa5, 0x59, 0x65, 0x69, 0x59, 0xaa,
-	0x0e, 0xb9, 0x0b, 0x17, 0xbb, 0x81, 0xcf, 0x33, 0x1c, 0x2c, 0x51, 0xa7, 0xd9, 0x76, 0x3d, 0xaa,
-	0x0c, 0x4c, 0xc2, 0xdf, 0xe7, 0x89, 0xfd, 0xbd, 0xb9, 0x8b, 0x6b, 0xd9, 0x28, 0x38, 0xa8, 0x6e,
-	0x32, 0x46, 0x76, 0xf4, 0x08, 0x31, 0xb2, 0xbf, 0xa4, 0xcd, 0xb8, 0x3a, 0x1c, 0xe3, 0xd3, 0x79,
-	0x75, 0x65, 0x56, 0x60, 0x86, 0x1e, 0x52, 0x55, 0xc9, 0x14, 0x35, 0xfb, 0xc1, 0xb6, 0xc2, 0xb1,
-	0x13, 0xda, 0x0a, 0xe3, 0x08, 0x9c, 0xf1, 0xf7, 0x32, 0x02, 0xa7, 0xf4, 0xbe, 0x8a, 0xc0, 0xf9,
-	0xb6, 0x05, 0xe7, 0x9c, 0xfe, 0xd8, 0xf7, 0x7c, 0xcc, 0xd6, 0x19, 0x41, 0xf5, 0xb5, 0x27, 0xa4,
-	0x90, 0x59, 0x29, 0x06, 0x30, 0x4b, 0x14, 0xfb, 0x9d, 0x22, 0x4c, 0xa7, 0x95, 0xa4, 0xd3, 0x0f,
-	0x12, 0xfe, 0x15, 0x0b, 0xa6, 0xd5, 0x04, 0xd7, 0x77, 0xef, 0xe2, 0x70, 0xb3, 0x92, 0xd3, 0xba,
-	0x22, 0xd4, 0x3d, 0x9d, 0xbb, 0x65, 0x3d, 0xc5, 0x0d, 0xfb, 0xf8, 0x93, 0x37, 0xa0, 0xa2, 0xef,
-	0x73, 0x4e, 0x14, 0x31, 0xcc, 0x83, 0x5a, 0xab, 0x31, 0x09, 0x34, 0xe9, 0x91, 0x77, 0x2c, 0x80,
-	0x86, 0xda, 0x89, 0x73, 0x8a, 0xc7, 0xca, 0xd0, 0x16, 0x62, 0x7d, 0x5e, 0x17, 0x85, 0x68, 0x30,
-	0x26, 0xbf, 0xca, 0x6f, 0x72, 0xf4, 0x48, 0x50, 0x3e, 0x0f, 0x9f, 0xcc, 0x7b, 0x29, 0x8a, 0xbd,
-	0x58, 0xb4, 0xb6, 0x67, 0x80, 0x42, 0x4c, 0x08, 0x61, 0xbf, 0x04, 0xda, 0x5b, 0x9c, 0xad, 0xac,
-	0xdc, 0x5f, 0x7c, 0xcd, 0x89, 0xb6, 0xe4, 0x10, 0xd4, 0x2b, 0xeb, 0x0d, 0x05, 0xc0, 0x18, 0xc7,
-	0xfe, 0x2c, 0x4c, 0xbe, 0x12, 0x38, 0xdd, 0x2d, 0x97, 0xdf, 0x98, 0xb0, 0x93, 0xf9, 0x33, 0x30,
-	0xee, 0x34, 0x9b, 0x59, 0x69, 0x86, 0xaa, 0xa2, 0x18, 0x15, 0xfc, 0x48, 0x87, 0x70, 0xfb, 0xdf,
-	0x5a, 0x40, 0xe2, 0x3b, 0x6e, 0xd7, 0x6b, 0xad, 0x3a, 0x51, 0x63, 0x8b, 0x1d, 0xe1, 0xb6, 0x78,
-	0x69, 0xd6, 0x11, 0xee, 0xa6, 0x86, 0xa0, 0x81, 0x45, 0xde, 0x82, 0x8a, 0xf8, 0xf7, 0xba, 0x3e,
-	0x20, 0x0e, 0xef, 0xf4, 0xce, 0xf7, 0x3c, 0x2e, 0x93, 0x18, 0x85, 0x37, 0x63, 0x0e, 0x68, 0xb2,
-	0x63, 0x4d, 0xb5, 0xec, 0x6d, 0xb6, 0x7b, 0x0f, 0x9b, 0x1b, 0x71, 0x53, 0x75, 0x03, 0x7f, 0xd3,
-	0x6d, 0xd3, 0x74, 0x53, 0xad, 0x89, 0x62, 0x54, 0xf0, 0xa3, 0x35, 0xd5, 0xbf, 0xb1, 0xe0, 0xfc,
-	0x72, 0x18, 0xb9, 0xfe, 0x12, 0x0d, 0x23, 0xb6, 0xf3, 0xb1, 0xf5, 0xb1, 0xd7, 0x3e, 0x4a, 0xe0,
-	0xc7, 0x12, 0x4c, 0xcb, 0x1b, 0xf0, 0xde, 0x46, 0x48, 0x23, 0xe3, 0xa8, 0xa1, 0xe7, 0xf1, 0x62,
-	0x0a, 0x8e, 0x7d, 0x35, 0x18, 0x15, 0x79, 0x15, 0x1e, 0x53, 0x29, 0x24, 0xa9, 0xd4, 0x53, 0x70,
-	0xec, 0xab, 0x61, 0xff, 0xa0, 0x00, 0xe7, 0xf8, 0x67, 0xa4, 0x82, 0xb6, 0xbe, 0x31, 0x28, 0x68,
-	0x6b, 0xc8, 0xa9, 0xcc, 0x79, 0x9d, 0x20, 0x64, 0xeb, 0xaf, 0x5b, 0x30, 0xd5, 0x4c, 0xb6, 0x74,
-	0x3e, 0x16, 0xc1, 0xac, 0x3e, 0x14, 0xbe, 0x8f, 0xa9, 0x42, 0x4c, 0xf3, 0x27, 0xbf, 0x66, 0xc1,
-	0x54, 0x52, 0x4c, 0xb5, 0xba, 0x9f, 0x42, 0x23, 0xe9, 0x60, 0x85, 0x64, 0x79, 0x88, 0x69, 0x11,
-	0xec, 0xef, 0x8f, 0xc8, 0x2e, 0x3d, 0x8d, 0x88, 0x24, 0xf2, 0x00, 0xca, 0x51, 0x3b, 0x14, 0x85,
-	0xf2, 0x6b, 0x87, 0x3c, 0xb4, 0xae, 0xaf, 0xd4, 0x85, 0xab, 0x4b, 0xac, 0x57, 0xca, 0x12, 0xa6,
-	0x1f, 0x2b, 0x5e, 0x9c, 0x71, 0xa3, 0x2b, 0x19, 0xe7, 0x72, 0x5a, 0x5e, 0x5f, 0x5c, 0x4b, 0x33,
-	0x96, 0x25, 0x8c, 0xb1, 0xe2, 0x65, 0xff, 0x63, 0x0b, 0xca, 0xb7, 0x7c, 0xb5, 0x8e, 0xfc, 0x5c,
-	0x0e, 0xb6, 0x28, 0xad, 0xb2, 0x6a, 0xa5, 0x25, 0x3e, 0x05, 0xbd, 0x9c, 0xb0, 0x44, 0x3d, 0x69,
-	0xd0, 0x9e, 0xe7, 0xd9, 0x16, 0x19, 0xa9, 0x5b, 0xfe, 0xc6, 0x40, 0xc3, 0xf5, 0xaf, 0x17, 0xe1,
-	0xcc, 0xab, 0xce, 0x2e, 0xf5, 0x22, 0xe7, 0xf8, 0x9b, 0xc4, 0x0b, 0x50, 0x71, 0xba, 0xfc, 0x16,
-	0xd5, 0x38, 0x86, 0xc4, 0xc6, 0x9d, 0x18, 0x84, 0x26, 0x5e, 0xbc, 0xa0, 0x89, 0xf0, 0xa0, 0xac,
-	0xa5, 0x68, 0x31, 0x05, 0xc7, 0xbe, 0x1a, 0xe4, 0x16, 0x10, 0x19, 0x52, 0x5f, 0x6d, 0x34, 0xfc,
-	0x9e, 0x27, 0x96, 0x34, 0x61, 0xf7, 0xd1, 0xe7, 0xe1, 0xd5, 0x3e, 0x0c, 0xcc, 0xa8, 0x45, 0x3e,
-	0x03, 0x33, 0x0d, 0x4e, 0x59, 0x9e, 0x8e, 0x4c, 0x8a, 0xe2, 0x84, 0xac, 0x03, 0x6e, 0x16, 0x07,
-	0xe0, 0xe1, 0x40, 0x0a, 0x4c, 0xd2, 0x30, 0xf2, 0x03, 0xa7, 0x45, 0x4d, 0xba, 0x63, 0x49, 0x49,
-	0xeb, 0x7d, 0x18, 0x98, 0x51, 0x8b, 0x7c, 0x01, 0xca, 0xd1, 0x56, 0x40, 0xc3, 0x2d, 0xbf, 0xdd,
-	0x94, 0xe6, 0xdd, 0x21, 0x8d, 0x81, 0xb2, 0xf7, 0xd7, 0x15, 0x55, 0x63, 0x78, 0xab, 0x22, 0x8c,
-	0x79, 0x92, 0x00, 0xc6, 0xc2, 0x86, 0xdf, 0xa5, 0xa1, 0x3c, 0x55, 0xdc, 0xca, 0x85, 0x3b, 0x37,
-	0x6e, 0x19, 0x66, 0x48, 0xce, 0x01, 0x25, 0x27, 0xfb, 0x77, 0x46, 0x60, 0xc2, 0x44, 0x3c, 0xc2,
-	0xda, 0xf4, 0x65, 0x0b, 0x26, 0x1a, 0xbe, 0x17, 0x05, 0x7e, 0x3b, 0x4e, 0x15, 0x31, 0xbc, 0x46,
-	0xc1, 0x48, 0x2d, 0xd1, 0xc8, 0x71, 0xdb, 0x86, 0xb5, 0xce, 0x60, 0x83, 0x09, 0xa6, 0xe4, 0xeb,
-	0x16, 0x4c, 0xc5, 0x2e, 0x99, 0xb1, 0xad, 0x2f, 0x57, 0x41, 0xf4, 0x52, 0x7f, 0x3d, 0xc9, 0x09,
-	0xd3, 0xac, 0xed, 0x0d, 0x98, 0x4e, 0xf7, 0x36, 0x6b, 0xca, 0xae, 0x23, 0xe7, 0x7a, 0x21, 0x6e,
-	0xca, 0x35, 0x27, 0x0c, 0x91, 0x43, 0xc8, 0xb3, 0x50, 0xea, 0x38, 0x41, 0xcb, 0xf5, 0x9c, 0x36,
-	0x6f, 0xc5, 0x82, 0xb1, 0x20, 0xc9, 0x72, 0xd4, 0x18, 0xf6, 0x47, 0x60, 0x62, 0xd5, 0xf1, 0x5a,
-	0xb4, 0x29, 0xd7, 0xe1, 0xc3, 0x63, 0x62, 0xff, 0x68, 0x14, 0x2a, 0xc6, 0xf1, 0xf1, 0xf4, 0xcf,
-	0x59, 0x89, 0x14, 0x48, 0x85, 0x1c, 0x53, 0x20, 0x7d, 0x0a, 0x60, 0xd3, 0xf5, 0xdc, 0x70, 0xeb,
-	0x84, 0xc9, 0x95, 0xb8, 0x57, 0xc0, 0x0d, 0x4d, 0x01, 0x0d, 0x6a, 0xf1, 0xd5, 0x6b, 0xf1, 0x80,
-	0x3c, 0x85, 0xef, 0x58, 0xc6, 0x76, 0x33, 0x96, 0x87, 0xab, 0x89, 0xd1, 0x31, 0xf3, 0x6a, 0xfb,
-	0x11, 0xb7, 0x62, 0x07, 0xed, 0x4a, 0xeb, 0x50, 0x0a, 0x68, 0xd8, 0xeb, 0xd0, 0x13, 0xa5, 0x41,
-	0xe2, 0x4e, 0x3f, 0x28, 0xeb, 0xa3, 0xa6, 0x34, 0xfb, 0x12, 0x9c, 0x49, 0x88, 0x70, 0xac, 0x1b,
-	0x26, 0x1f, 0x32, 0x6d, 0x14, 0x27, 0xb9, 0x6f, 0x62, 0x7d, 0xd1, 0x36, 0xd2, 0x1f, 0xe9, 0xbe,
-	0x10, 0xae, 0x5d, 0x02, 0x66, 0xff, 0xd9, 0x38, 0x48, 0xef, 0x89, 0x23, 0x2c, 0x57, 0xe6, 0x9d,
-	0xe9, 0xc8, 0x09, 0xee, 0x4c, 0x6f, 0xc1, 0x84, 0xeb, 0xb9, 0x91, 0xeb, 0xb4, 0xb9, 0xfd, 0x49,
-	0x6e, 0xa7, 0x2a, 0x0c, 0x60, 0x62, 0xd9, 0x80, 0x65, 0xd0, 0x49, 0xd4, 0x25, 0xaf, 0x41, 0x91,
-	0xef, 0x37, 0x72, 0x00, 0x1f, 0xdf, 0xc5, 0x83, 0x7b, 0xf7, 0x88, 0xd8, 0x40, 0x41, 0x89, 0x1f,
-	0x3e, 0x44, 0xfe, 0x27, 0x7d, 0xfc, 0x96, 0xe3, 0x38, 0x3e, 0x7c, 0xa4, 0xe0, 0xd8, 0x57, 0x83,
-	0x51, 0xd9, 0x74, 0xdc, 0x76, 0x2f, 0xa0, 0x31, 0x95, 0xb1, 0x24, 0x95, 0x1b, 0x29, 0x38, 0xf6,
-	0xd5, 0x20, 0x9b, 0x30, 0x21, 0xcb, 0x84, 0xc3, 0xde, 0xf8, 0x09, 0xbf, 0x92, 0x3b, 0x66, 0xde,
-	0x30, 0x28, 0x61, 0x82, 0x2e, 0xe9, 0xc1, 0x59, 0xd7, 0x6b, 0xf8, 0x5e, 0xa3, 0xdd, 0x0b, 0xdd,
-	0x1d, 0x1a, 0x07, 0xe6, 0x9d, 0x84, 0xd9, 0x85, 0xfd, 0xbd, 0xb9, 0xb3, 0xcb, 0x69, 0x72, 0xd8,
-	0xcf, 0x81, 0x7c, 0xd1, 0x82, 0x0b, 0x0d, 0xdf, 0x0b, 0x79, 0xfe, 0x90, 0x1d, 0x7a, 0x3d, 0x08,
-	0xfc, 0x40, 0xf0, 0x2e, 0x9f, 0x90, 0x37, 0x37, 0x7b, 0x2e, 0x66, 0x91, 0xc4, 0x6c, 0x4e, 0xe4,
-	0x4d, 0x28, 0x75, 0x03, 0x7f, 0xc7, 0x6d, 0xd2, 0x40, 0x3a, 0x7f, 0xae, 0xe4, 0x91, 0x54, 0x69,
-	0x4d, 0xd2, 0x8c, 0x97, 0x1e, 0x55, 0x82, 0x9a, 0x1f, 0xf9, 0x8a, 0x05, 0x17, 0x0d, 0xa9, 0xe4,
-	0xb0, 0x12, 0x2d, 0x50, 0x39, 0x61, 0x0b, 0x70, 0x53, 0xf8, 0x62, 0x36, 0x51, 0x1c, 0xc4, 0xcd,
-	0xfe, 0xb3, 0x0a, 0x4c, 0x26, 0x05, 0x27, 0xbf, 0x00, 0xd0, 0x0d, 0xfc, 0x0e, 0x8d, 0xb6, 0xa8,
-	0x0e, 0xf5, 0xba, 0x3d, 0x6c, 0x02, 0x1f, 0x45, 0x4f, 0xb9, 0x6e, 0xb1, 0x85, 0x2b, 0x2e, 0x45,
-	0x83, 0x23, 0x09, 0x60, 0x7c, 0x5b, 0x28, 0x00, 0x52, 0x1f, 0x7a, 0x35, 0x17, 0xed, 0x4d, 0x72,
-	0xe6, 0x31, 0x4a, 0xb2, 0x08, 0x15, 0x23, 0xb2, 0x01, 0x85, 0x07, 0x74, 0x23, 0x9f, 0xec, 0x11,
-	0xf7, 0xa8, 0x3c, 0x57, 0xd5, 0xc6, 0xf7, 0xf7, 0xe6, 0x0a, 0xf7, 0xe8, 0x06, 0x32, 0xe2, 0xec,
-	0xbb, 0x9a, 0xc2, 0x7f, 0x43, 0x2e, 0x5a, 0xaf, 0xe6, 0xe8, 0x0c, 0x22, 0xbe, 0x4b, 0x16, 0xa1,
-	0x62, 0x44, 0xde, 0x84, 0xf2, 0x03, 0x67, 0x87, 0x6e, 0x06, 0xbe, 0x17, 0x49, 0x7f, 0xc1, 0x21,
-	0x03, 0x6c, 0xee, 0x29, 0x72, 0x92, 0x2f, 0x57, 0x34, 0x74, 0x21, 0xc6, 0xec, 0xc8, 0x0e, 0x94,
-	0x3c, 0xfa, 0x00, 0x69, 0xdb, 0x6d, 0xe4, 0x13, 0xd0, 0x72, 0x5b, 0x52, 0x93, 0x9c, 0xf9, 0x0e,
-	0xac, 0xca, 0x50, 0xf3, 0x62, 0x7d, 0x79, 0xdf, 0xdf, 0xc8, 0xc7, 0xad, 0x44, 0x9f, 0x91, 0x45,
-	0x5f, 0xde, 0xf2, 0x37, 0x90, 0x11, 0x67, 0x73, 0xa4, 0xa1, 0x9d, 0xd5, 0xe4, 0x82, 0x79, 0x3b,
-	0x5f, 0x27, 0x3d, 0x31, 0x47, 0xe2, 0x52, 0x34, 0x38, 0xb2, 0xb6, 0x6d, 0x49, 0xb3, 0xa9, 0x5c,
-	0x32, 0x87, 0x6c, 0xdb, 0xa4, 0x11, 0x56, 0xb4, 0xad, 0x2a, 0x43, 0xcd, 0x8b, 0xf1, 0x75, 0xa5,
-	0x0d, 0x32, 0x9f, 0x45, 0x33, 0x69, 0xd1, 0x14, 0x7c, 0x55, 0x19, 0x6a, 0x5e, 0xac, 0xbd, 0xc3,
-	0xed, 0xdd, 0x07, 0x4e, 0x7b, 0xdb, 0xf5, 0x5a, 0x72, 0x89, 0x1c, 0x36, 0xd4, 0x6f, 0x7b, 0xf7,
-	0x9e, 0xa0, 0x67, 0xb6, 0x77, 0x5c, 0x8a, 0x06, 0x47, 0xf2, 0x77, 0x2c, 0x1d, 0x8e, 0x34, 0x91,
-	0x87, 0x23, 0x57, 0x72, 0xc9, 0x95, 0xd1, 0x49, 0x42, 0x65, 0xfd, 0x69, 0xed, 0x7b, 0xca, 0x0b,
-	0xbf, 0xf6, 0x87, 0x73, 0x33, 0xd4, 0x6b, 0xf8, 0x4d, 0xd7, 0x6b, 0x2d, 0xdc, 0x0f, 0x7d, 0x6f,
-	0x1e, 0x9d, 0x07, 0xea, 0xb4, 0x20, 0x65, 0x9a, 0xfd, 0x18, 0x54, 0x0c, 0x12, 0x87, 0xa9, 0x9c,
-	0x13, 0xa6, 0xca, 0xf9, 0x93, 0x31, 0x98, 0x30, 0x73, 0xb1, 0x1e, 0x41, 0x0f, 0xd4, 0x67, 0x9f,
-	0x91, 0xe3, 0x9c, 0x7d, 0xd8, 0x61, 0xd7, 0xb8, 0x6a, 0x53, 0x86, 0xb6, 0xe5, 0xdc, 0x54, 0xff,
-	0xf8, 0xb0, 0x6b, 0x14, 0x86, 0x98, 0x60, 0x7a, 0x0c, 0xef, 0x1b, 0xa6, 0x40, 0x0b, 0x15, 0xb3,
-	0x98, 0x54, 0xa0, 0x13, 0x4a, 0xe3, 0x35, 0x80, 0x38, 0x69, 0xa8, 0xbc, 0x82, 0xd5, 0x9a, 0xb9,
-	0x91, 0xcc, 0xd4, 0xc0, 0x22, 0x4f, 0xc3, 0x18, 0x53, 0xc2, 0x68, 0x53, 0x66, 0x56, 0xd0, 0x16,
-	0x85, 0x1b, 0xbc, 0x14, 0x25, 0x94, 0xbc, 0xc8, 0xf4, 0xe5, 0x58, 0x75, 0x92, 0x09, 0x13, 0xce,
-	0xc7, 0xfa, 0x72, 0x0c, 0xc3, 0x04, 0x26, 0x13, 0x9d, 0x32, 0x4d, 0x87, 0xaf, 0x0d, 0x86, 0xe8,
-	0x5c, 0xfd, 0x41, 0x01, 0xe3, 0x16, 0xae, 0x94, 0x66, 0xc4, 0xe7, 0x74, 0xd1, 0xb0, 0x70, 0xa5,
-	0xe0, 0xd8, 0x57, 0x83, 0x7d, 0x8c, 0xbc, 0x3d, 0xae, 0x08, 0xa7, 0xf1, 0x01, 0xf7, 0xbe, 0xbf,
-	0x68, 0x9e, 0xfa, 0x72, 0x9c, 0x43, 0x62, 0xd4, 0x1e, 0xe3, 0xd8, 0x77, 0x0b, 0x48, 0xbf, 0x32,
-	0x24, 0xe3, 0x55, 0xb4, 0xa1, 0xab, 0x5f, 0x8f, 0xc2, 0x8c, 0x5a, 0xc3, 0x1d, 0xf6, 0xbe, 0x62,
-	0xc1, 0x64, 0x72, 0x4b, 0xcb, 0xfb, 0x42, 0x87, 0xfc, 0x7f, 0x30, 0x1e, 0xb9, 0x1d, 0xea, 0xf7,
-	0x84, 0x09, 0xa1, 0x20, 0xb4, 0x84, 0x75, 0x51, 0x84, 0x0a, 0x66, 0xff, 0xfd, 0x31, 0x38, 0x77,
-	0xbb, 0xe5, 0x7a, 0xe9, 0x5c, 0x7b, 0x59, 0x0f, 0x6b, 0x58, 0xc7, 0x7e, 0x58, 0x43, 0xc7, 0x42,
-	0xca, 0x67, 0x2b, 0xb2, 0x63, 0x21, 0xd5, 0x1b, 0x22, 0x49, 0x5c, 0xf2, 0x07, 0x16, 0x3c, 0xe9,
-	0x34, 0xc5, 0xa9, 0xc8, 0x69, 0xcb, 0x52, 0x23, 0x1f, 0xbc, 0x5c, 0x45, 0xc2, 0x21, 0x35, 0x8b,
-	0xfe, 0x8f, 0x9f, 0xaf, 0x1e, 0xc0, 0x55, 0x8c, 0xb2, 0x9f, 0x92, 0x5f, 0xf0, 0xe4, 0x41, 0xa8,
-	0x78, 0xa0, 0xf8, 0xe4, 0x2f, 0xc3, 0x54, 0xe2, 0x83, 0xe5, 0x3d, 0x40, 0x59, 0x5c, 0xd7, 0xd4,
-	0x93, 0x20, 0x4c, 0xe3, 0x92, 0xef, 0x5b, 0x30, 0x23, 0x8c, 0xce, 0x19, 0x4d, 0x23, 0xee, 0xa9,
-	0xfd, 0xfc, 0x9b, 0x66, 0x71, 0x00, 0x47, 0xd1, 0x2c, 0xb1, 0x15, 0x7a, 0x00, 0x1a, 0x0e, 0x14,
-	0x79, 0xf6, 0x0e, 0x7c, 0xf0, 0xd0, 0x76, 0x3f, 0xd6, 0xeb, 0x01, 0xaf, 0xc2, 0xa5, 0x03, 0xa5,
-	0x3d, 0xd6, 0x8c, 0xfd, 0x9e, 0x05, 0x13, 0x66, 0xce, 0x30, 0xf2, 0x2c, 0x94, 0x22, 0x7f, 0x9b,
-	0x7a, 0x77, 0x03, 0xe5, 0x45, 0xae, 0x57, 0x9e, 0x75, 0x5e, 0x8e, 0x2b, 0xa8, 0x31, 0x18, 0x76,
-	0xa3, 0xed, 0x52, 0x2f, 0x5a, 0x6e, 0xca, 0x39, 0xa0, 0xb1, 0x17, 0x45, 0xf9, 0x12, 0x6a, 0x0c,
-	0xe1, 0x7e, 0xc9, 0x7e, 0x0b, 0x3f, 0x66, 0x69, 0x2d, 0x31, 0xdc, 0x2f, 0x63, 0x18, 0x26, 0x30,
-	0x89, 0xad, 0xad, 0xdf, 0xa3, 0xf1, 0x95, 0x57, 0xca, 0x5a, 0xfd, 0x1d, 0x0b, 0xca, 0xe2, 0xf6,
-	0x06, 0xe9, 0x66, 0xca, 0xef, 0x3b, 0x65, 0x5f, 0xaa, 0xae, 0x2d, 0x67, 0xf9, 0x7d, 0x5f, 0x81,
-	0xd1, 0x6d, 0xd7, 0x53, 0x5f, 0xa2, 0xf5, 0x84, 0x57, 0x5d, 0xaf, 0x89, 0x1c, 0xa2, 0x35, 0x89,
-	0xc2, 0x40, 0x4d, 0x62, 0x01, 0xca, 0xda, 0x27, 0x49, 0xee, 0xc7, 0xb1, 0xfb, 0xb6, 0x02, 0x60,
-	0x8c, 0x63, 0xff, 0x86, 0x05, 0x93, 0x3c, 0x8d, 0x41, 0x6c, 0x2a, 0x79, 0x41, 0xbb, 0x09, 0x0a,
-	0xb9, 0x2f, 0x25, 0xdd, 0x04, 0xdf, 0xdd, 0x9b, 0xab, 0x88, 0xc4, 0x07, 0x49, 0xaf, 0xc1, 0x4f,
-	0x4b, 0xfb, 0x2a, 0x77, 0x66, 0x1c, 0x39, 0xb6, 0xf9, 0x2f, 0x16, 0x53, 0x11, 0xc1, 0x98, 0x9e,
-	0xfd, 0x16, 0x4c, 0x98, 0x11, 0x82, 0xe4, 0x05, 0xa8, 0x74, 0x5d, 0xaf, 0x95, 0x8c, 0x24, 0xd7,
-	0x77, 0x50, 0x6b, 0x31, 0x08, 0x4d, 0x3c, 0x5e, 0xcd, 0x8f, 0xab, 0xa5, 0xae, 0xae, 0xd6, 0x7c,
-	0xb3, 0x5a, 0xfc, 0xc7, 0xf6, 0x00, 0xe2, 0x70, 0xf7, 0x23, 0xd9, 0xf5, 0xc6, 0xc4, 0xb5, 0x90,
-	0xd0, 0x0e, 0x79, 0xea, 0x92, 0x31, 0x31, 0xc2, 0xdf, 0xdd, 0x3b, 0x48, 0xfb, 0x14, 0xb5, 0xf8,
-	0xc3, 0x28, 0x19, 0x91, 0xaf, 0xb9, 0x3f, 0x8c, 0x92, 0xc1, 0xe3, 0xbd, 0x7b, 0x18, 0x25, 0x4b,
-	0x98, 0x3f, 0x5f, 0x0f, 0xa3, 0x7c, 0x12, 0x8e, 0x9b, 0x23, 0x99, 0x29, 0x7b, 0x0f, 0xcc, 0x5c,
-	0x26, 0xba, 0xc5, 0x65, 0x32, 0x13, 0x09, 0xb5, 0x7f, 0x77, 0x14, 0xa6, 0xd3, 0x36, 0x9f, 0xbc,
-	0x1d, 0x7b, 0xc8, 0xd7, 0x2d, 0x98, 0x74, 0x12, 0xf9, 0x28, 0x73, 0x7a, 0x65, 0x2d, 0x41, 0xd3,
-	0xc8, 0x87, 0x98, 0x28, 0xc7, 0x14, 0x6f, 0x53, 0xd7, 0x1a, 0x1d, 0xac, 0x6b, 0xb1, 0x4d, 0xc0,
-	0xe5, 0x7a, 0x64, 0x40, 0xa5, 0x93, 0xfa, 0x74, 0x6c, 0x44, 0x17, 0xe5, 0xa8, 0x31, 0xc8, 0x43,
-	0x18, 0x17, 0x2e, 0x40, 0xca, 0xd7, 0x6b, 0x35, 0x27, 0xdb, 0x94, 0xf0, 0x32, 0x8a, 0xbb, 0x40,
-	0xfc, 0x0f, 0x51, 0xb1, 0x63, 0xfa, 0x3a, 0x04, 0x8e, 0xd7, 0xa2, 0xbc, 0xcd, 0xa5, 0x35, 0xe5,
-	0xf5, 0xbc, 0xcc, 0x80, 0xa8, 0x29, 0x57, 0x83, 0x56, 0x28, 0x23, 0x4d, 0x75, 0x19, 0x1a, 0x9c,
-	0xed, 0x5f, 0xb1, 0x60, 0x66, 0x50, 0x45, 0x36, 0x50, 0xf8, 0xaa, 0x2b, 0x47, 0x94, 0x91, 0xe0,
-	0xc2, 0x09, 0x22, 0x14, 0x30, 0x72, 0x09, 0x0a, 0x54, 0x6f, 0x54, 0x3a, 0x1b, 0xe7, 0x75, 0xaf,
-	0x89, 0xac, 0x9c, 0x5c, 0x83, 0xd1, 0x30, 0xa2, 0xdd, 0x54, 0x14, 0xc7, 0x28, 0x5b, 0x3c, 0x33,
-	0xae, 0x21, 0x38, 0xae, 0xfd, 0x11, 0x38, 0x66, 0x4a, 0x6d, 0xfb, 0x3a, 0x10, 0xf4, 0xdb, 0xed,
-	0x0d, 0xa7, 0xb1, 0x7d, 0xcf, 0xf5, 0x9a, 0xfe, 0x03, 0xbe, 0x31, 0x2c, 0x40, 0x39, 0x90, 0x51,
-	0xf5, 0xa1, 0x9c, 0x53, 0x7a, 0x67, 0x51, 0xe1, 0xf6, 0x21, 0xc6, 0x38, 0xf6, 0xf7, 0x47, 0x60,
-	0x5c, 0xa6, 0x80, 0x78, 0x04, 0x21, 0x44, 0xdb, 0x09, 0xc7, 0x8d, 0xe5, 0x5c, 0x32, 0x57, 0x0c,
-	0x8c, 0x1f, 0x0a, 0x53, 0xf1, 0x43, 0xaf, 0xe6, 0xc3, 0xee, 0xe0, 0xe0, 0xa1, 0xef, 0x16, 0x61,
-	0x2a, 0x95, 0x52, 0x23, 0x95, 0x7d, 0xdf, 0x7a, 0x4f, 0xb2, 0xef, 0x93, 0x30, 0xf1, 0x02, 0x43,
-	0x7e, 0x0e, 0xc7, 0x7f, 0xf1, 0x18, 0x43, 0x5e, 0xae, 0xe0, 0xc5, 0xf7, 0x8f, 0x2b, 0xf8, 0x7f,
-	0xb5, 0xe0, 0xf1, 0x81, 0x89, 0x61, 0x78, 0x8a, 0xc5, 0x20, 0x09, 0x95, 0xeb, 0x45, 0xce, 0xc9,
-	0xb6, 0xb4, 0x93, 0x47, 0x3a, 0x2b, 0x5e, 0x9a, 0x3d, 0x79, 0x1e, 0x26, 0xf8, 0xda, 0xcc, 0x56,
-	0x4e, 0xb6, 0xf6, 0x8a, 0x3b, 0x6a, 0x7e, 0x5b, 0x59, 0x37, 0xca, 0x31, 0x81, 0x65, 0x7f, 0xdb,
-	0x82, 0x99, 0x41, 0x09, 0xf7, 0x8e, 0xa0, 0xe7, 0xfe, 0xa5, 0x54, 0x08, 0xd6, 0x5c, 0x5f, 0x08,
-	0x56, 0xca, 0x72, 0xa9, 0xa2, 0xad, 0x0c, 0xa3, 0x61, 0xe1, 0x90, 0x08, 0xa3, 0xdf, 0x2b, 0xc0,
-	0xb4, 0x14, 0x31, 0x3e, 0xa2, 0xbc, 0x98, 0x08, 0x1c, 0xfb, 0xa9, 0x54, 0xe0, 0xd8, 0xf9, 0x34,
-	0xfe, 0x5f, 0x44, 0x8d, 0xbd, 0xbf, 0xa2, 0xc6, 0xbe, 0x56, 0x84, 0x0b, 0x99, 0xa9, 0xed, 0xc8,
-	0x57, 0x33, 0x76, 0x8a, 0x7b, 0x39, 0xe7, 0xd0, 0xd3, 0xa1, 0xed, 0xa7, 0x1b, 0x6a, 0xf5, 0x6b,
-	0x66, 0x88, 0x93, 0x58, 0xfd, 0x37, 0x4f, 0x21, 0x1b, 0xe0, 0x71, 0xa3, 0x9d, 0x1e, 0xed, 0xeb,
-	0x84, 0x7f, 0x0e, 0x96, 0xfa, 0xaf, 0x15, 0xe0, 0xea, 0x51, 0x5b, 0xf6, 0x7d, 0x1a, 0x1e, 0x1c,
-	0x26, 0xc2, 0x83, 0x1f, 0x91, 0x6a, 0x73, 0x2a, 0x91, 0xc2, 0x7f, 0x6f, 0x54, 0xef, 0xbb, 0xfd,
-	0x13, 0xf6, 0x48, 0x96, 0x97, 0x71, 0xa6, 0xfa, 0xaa, 0x37, 0x1c, 0xe2, 0xbd, 0x61, 0xbc, 0x2e,
-	0x8a, 0xdf, 0xdd, 0x9b, 0x3b, 0x1b, 0xe7, 0x80, 0x92, 0x85, 0xa8, 0x2a, 0x91, 0xab, 0x50, 0x0a,
-	0x04, 0x54, 0x05, 0x44, 0x4a, 0xb7, 0x34, 0x51, 0x86, 0x1a, 0x4a, 0xbe, 0x60, 0x9c, 0x15, 0x46,
-	0x4f, 0x2b, 0xd5, 0xd9, 0x41, 0xd7, 0x2e, 0x6f, 0x40, 0x29, 0x54, 0x0f, 0x0d, 0x88, 0xe9, 0xf4,
-	0xdc, 0x11, 0xe3, 0x6c, 0x9d, 0x0d, 0xda, 0x56, 0xaf, 0x0e, 0x88, 0xef, 0xd3, 0x6f, 0x12, 0x68,
-	0x92, 0xc4, 0xd6, 0x96, 0x09, 0x71, 0x07, 0x07, 0xfd, 0x56, 0x09, 0x12, 0xc1, 0xb8, 0x7c, 0x6d,
-	0x5c, 0x1e, 0x67, 0x57, 0x73, 0x0a, 0x58, 0x93, 0xe1, 0x0c, 0xfc, 0xc0, 0xaf, 0x2c, 0x72, 0x8a,
-	0x95, 0xfd, 0x43, 0x0b, 0x2a, 0x72, 0x8c, 0x3c, 0x82, 0x80, 0xe3, 0xfb, 0xc9, 0x80, 0xe3, 0xeb,
-	0xb9, 0x2c, 0xe1, 0x03, 0xa2, 0x8d, 0xef, 0xc3, 0x84, 0x99, 0x64, 0x96, 0x7c, 0xca, 0xd8, 0x82,
-	0xac, 0x61, 0x12, 0x29, 0xaa, 0x4d, 0x2a, 0xde, 0x9e, 0xec, 0x7f, 0x52, 0xd6, 0xad, 0xc8, 0x0f,
-	0xce, 0xe6, 0xc8, 0xb7, 0x0e, 0x1c, 0xf9, 0xe6, 0xc0, 0x1b, 0xc9, 0x7f, 0xe0, 0xbd, 0x06, 0x25,
-	0xb5, 0x2c, 0x4a, 0x6d, 0xea, 0x29, 0x33, 0xbe, 0x81, 0xa9, 0x64, 0x8c, 0x98, 0x31, 0x5d, 0xf8,
-	0x01, 0x38, 0xbe, 0x27, 0x50, 0xcb, 0xb5, 0x26, 0x43, 0xde, 0x84, 0xca, 0x03, 0x3f, 0xd8, 0x6e,
-	0xfb, 0x0e, 0x7f, 0xdd, 0x05, 0xf2, 0x70, 0x64, 0xd1, 0xb6, 0x7e, 0x11, 0x64, 0x76, 0x2f, 0xa6,
-	0x8f, 0x26, 0x33, 0x52, 0x85, 0xa9, 0x8e, 0xeb, 0x21, 0x75, 0x9a, 0x3a, 0xae, 0x78, 0x54, 0xbc,
-	0xac, 0xa0, 0x74, 0xfb, 0xd5, 0x24, 0x18, 0xd3, 0xf8, 0xdc, 0x2e, 0x17, 0x24, 0x4c, 0x1d, 0x32,
-	0x7d, 0xfa, 0xda, 0xf0, 0x83, 0x31, 0x69, 0x3e, 0x11, 0x51, 0x56, 0xc9, 0x72, 0x4c, 0xf1, 0x26,
-	0x9f, 0x87, 0x52, 0xa8, 0xde, 0xf1, 0x2d, 0xe6, 0x78, 0xea, 0xd1, 0x6f, 0xf9, 0xea, 0xae, 0xd4,
-	0x8f, 0xf9, 0x6a, 0x86, 0x64, 0x05, 0xce, 0x2b, 0xdb, 0x4d, 0xe2, 0x49, 0xd2, 0xb1, 0x38, 0x05,
-	0x20, 0x66, 0xc0, 0x31, 0xb3, 0x16, 0xd3, 0x6d, 0x79, 0xf2, 0x66, 0xe1, 0x38, 0x60, 0xdc, 0xb5,
-	0xf3, 0xf9, 0xd7, 0x44, 0x09, 0x3d, 0x28, 0x6c, 0xbe, 0x34, 0x44, 0xd8, 0x7c, 0x1d, 0x2e, 0xa4,
-	0x41, 0x3c, 0xb7, 0x23, 0x4f, 0x27, 0x69, 0x6c, 0xa1, 0x6b, 0x59, 0x48, 0x98, 0x5d, 0x97, 0xdc,
-	0x83, 0x72, 0x40, 0xf9, 0x29, 0xaf, 0xaa, 0xbc, 0x3f, 0x8f, 0xed, 0xe7, 0x8e, 0x8a, 0x00, 0xc6,
-	0xb4, 0x58, 0xbf, 0x3b, 0xc9, 0xb7, 0x0e, 0x5e, 0xcb, 0xf1, 0xa1, 0x7f, 0xd9, 0xf7, 0x03, 0x72,
-	0xae, 0xda, 0xff, 0x6e, 0x0a, 0xce, 0x24, 0x0c, 0x50, 0xe4, 0x29, 0x28, 0xf2, 0x64, 0x97, 0x7c,
-	0xb5, 0x2a, 0xc5, 0x2b, 0xaa, 0x68, 0x1c, 0x01, 0x23, 0xbf, 0x6c, 0xc1, 0x54, 0x37, 0x71, 0xbd,
-	0xa5, 0x16, 0xf2, 0x21, 0x6d, 0xda, 0xc9, 0x3b, 0x33, 0xe3, 0x95, 0xa0, 0x24, 0x33, 0x4c, 0x73,
-	0x67, 0xeb, 0x81, 0x0c, 0x16, 0x69, 0xd3, 0x80, 0x63, 0x4b, 0x45, 0x4f, 0x93, 0x58, 0x4c, 0x82,
-	0x31, 0x8d, 0xcf, 0x7a, 0x98, 0x7f, 0xdd, 0x30, 0x8f, 0x39, 0x57, 0x15, 0x01, 0x8c, 0x69, 0x91,
-	0x97, 0x61, 0x52, 0xa6, 0xb8, 0x5f, 0xf3, 0x9b, 0x37, 0x9d, 0x70, 0x4b, 0x1e, 0xf9, 0xf4, 0x11,
-	0x75, 0x31, 0x01, 0xc5, 0x14, 0x36, 0xff, 0xb6, 0xf8, 0x1d, 0x01, 0x4e, 0x60, 0x2c, 0xf9, 0x88,
-	0xd2, 0x62, 0x12, 0x8c, 0x69, 0x7c, 0xf2, 0xac, 0xb1, 0x0d, 0x09, 0x67, 0x1e, 0xbd, 0x1a, 0x64,
-	0x6c, 0x45, 0x55, 0x98, 0xea, 0xf1, 0x13, 0x72, 0x53, 0x01, 0xe5, 0x7c, 0xd4, 0x0c, 0xef, 0x26,
-	0xc1, 0x98, 0xc6, 0x27, 0x2f, 0xc1, 0x99, 0x80, 0x2d, 0xb6, 0x9a, 0x80, 0xf0, 0xf0, 0xd1, 0xce,
-	0x14, 0x68, 0x02, 0x31, 0x89, 0x4b, 0x5e, 0x81, 0xb3, 0x71, 0x1a, 0x64, 0x45, 0x40, 0xb8, 0xfc,
-	0xe8, 0x9c, 0x9c, 0xd5, 0x34, 0x02, 0xf6, 0xd7, 0x21, 0x7f, 0x15, 0xa6, 0x8d, 0x96, 0x58, 0xf6,
-	0x9a, 0xf4, 0xa1, 0x4c, 0x55, 0xcb, 0x1f, 0x05, 0x5c, 0x4c, 0xc1, 0xb0, 0x0f, 0x9b, 0x7c, 0x1c,
-	0x26, 0x1b, 0x7e, 0xbb, 0xcd, 0xd7, 0x38, 0xf1, 0x80, 0x8f, 0xc8, 0x49, 0x2b, 0xb2, 0xf7, 0x26,
-	0x20, 0x98, 0xc2, 0x24, 0xb7, 0x80, 0xf8, 0x1b, 0x4c, 0xbd, 0xa2, 0xcd, 0x57, 0xa8, 0x47, 0xa5,
-	0xc6, 0x71, 0x26, 0x19, 0xaa, 0x76, 0xa7, 0x0f, 0x03, 0x33, 0x6a, 0xf1, 0x94, 0x9e, 0x46, 0x68,
-	0xff, 0x64, 0x1e, 0x8f, 0x08, 0xa4, 0xed, 0x39, 0x87, 0xc6, 0xf5, 0x07, 0x30, 0x26, 0x3c, 0x22,
-	0xf2, 0x49, 0x4e, 0x6b, 0xbe, 0xe5, 0x11, 0xef, 0x11, 0xa2, 0x14, 0x25, 0x27, 0xf2, 0x0b, 0x50,
-	0xde, 0x50, 0x0f, 0x3b, 0xf1, 0x8c, 0xb4, 0x43, 0xef, 0x8b, 0xa9, 0x37, 0xca, 0x62, 0x7b, 0x85,
-	0x06, 0x60, 0xcc, 0x92, 0x3c, 0x0d, 0x95, 0x9b, 0x6b, 0x55, 0x3d, 0x0a, 0xcf, 0xf2, 0xde, 0x1f,
-	0x65, 0x55, 0xd0, 0x04, 0xb0, 0x19, 0xa6, 0xd5, 0x37, 0x92, 0x74, 0x9a, 0xc8, 0xd0, 0xc6, 0x18,
-	0x36, 0x77, 0x91, 0xc1, 0xfa, 0xcc, 0xb9, 0x14, 0xb6, 0x2c, 0x47, 0x8d, 0x41, 0xde, 0x80, 0x8a,
-	0xdc, 0x2f, 0xf8, 0xda, 0x74, 0xfe, 0x64, 0x69, 0x23, 0x30, 0x26, 0x81, 0x26, 0x3d, 0x7e, 0x7d,
-	0xcf, 0xdf, 0xbb, 0xa1, 0x37, 0x7a, 0xed, 0xf6, 0xcc, 0x05, 0xbe, 0x6e, 0xc6, 0xd7, 0xf7, 0x31,
-	0x08, 0x4d, 0x3c, 0xf2, 0x9c, 0x72, 0xaf, 0x7c, 0x2c, 0xe1, 0xcf, 0xa0, 0xdd, 0x2b, 0xb5, 0xd2,
-	0x3d, 0x20, 0xb2, 0xec, 0xe2, 0x21, 0x7e, 0x8d, 0x1b, 0x30, 0xab, 0x34, 0xbe, 0xfe, 0x49, 0x32,
-	0x33, 0x93, 0xb0, 0x1d, 0xcd, 0xde, 0x1b, 0x88, 0x89, 0x07, 0x50, 0x21, 0x1b, 0x50, 0x70, 0xda,
-	0x1b, 0x33, 0x8f, 0xe7, 0xa1, 0xba, 0x56, 0x57, 0x6a, 0x72, 0x44, 0x71, 0x1f, 0xec, 0xea, 0x4a,
-	0x0d, 0x19, 0x71, 0xe2, 0xc2, 0xa8, 0xd3, 0xde, 0x08, 0x67, 0x66, 0xf9, 0x9c, 0xcd, 0x8d, 0x49,
-	0x6c, 0x3c, 0x58, 0xa9, 0x85, 0xc8, 0x59, 0xd8, 0x5f, 0x1c, 0xd1, 0xb7, 0x44, 0xfa, 0x7d, 0x80,
-	0xb7, 0xcc, 0x09, 0x24, 0x8e, 0x3b, 0x77, 0x72, 0x9b, 0x40, 0x52, 0xbd, 0x38, 0x33, 0x70, 0xfa,
-	0x74, 0xf5, 0x92, 0x91, 0x4b, 0x7a, 0xbf, 0xe4, 0xdb, 0x07, 0xe2, 0xf4, 0x9c, 0x5c, 0x30, 0xec,
-	0x2f, 0x55, 0xb4, 0x15, 0x34, 0xe5, 0x26, 0x18, 0x40, 0xd1, 0x0d, 0x23, 0xd7, 0xcf, 0x31, 0x9b,
-	0x42, 0xea, 0xd1, 0x00, 0x1e, 0xac, 0xc5, 0x01, 0x28, 0x58, 0x31, 0x9e, 0x5e, 0xcb, 0xf5, 0x1e,
-	0xca, 0xcf, 0x7f, 0x2d, 0x77, 0x27, 0x37, 0xc1, 0x93, 0x03, 0x50, 0xb0, 0x22, 0xf7, 0xc5, 0xa0,
-	0x2e, 0xe4, 0xd1, 0xd7, 0xd5, 0x95, 0x5a, 0x8a, 0x5f, 0x72, 0x70, 0xdf, 0x87, 0x42, 0xd8, 0x71,
-	0xa5, 0xba, 0x34, 0x24, 0xaf, 0xfa, 0xea, 0x72, 0x16, 0xaf, 0xfa, 0xea, 0x32, 0x32, 0x26, 0xfc,
-	0xaa, 0xdf, 0xe9, 0x6c, 0x38, 0x61, 0xe8, 0x34, 0xb5, 0x75, 0x66, 0xc8, 0xab, 0xfe, 0xaa, 0xa6,
-	0x97, 0x62, 0xcd, 0xaf, 0xfa, 0x63, 0x28, 0x1a, 0x9c, 0xc9, 0x9b, 0x30, 0xee, 0x88, 0x87, 0x67,
-	0x65, 0xc0, 0x48, 0x3e, 0xaf, 0x29, 0xa7, 0x24, 0xe0, 0x66, 0x1a, 0x09, 0x42, 0xc5, 0x90, 0xf1,
-	0x8e, 0x02, 0x87, 0x6e, 0xba, 0xdb, 0xd2, 0x38, 0x54, 0x1f, 0xfa, 0x69, 0x24, 0x46, 0x2c, 0x8b,
-	0xb7, 0x04, 0xa1, 0x62, 0x48, 0xbe, 0x62, 0xc1, 0x99, 0x8e, 0xe3, 0x39, 0x3a, 0x20, 0x39, 0x9f,
-	0xb0, 0x75, 0x33, 0xc4, 0x39, 0xd6, 0x10, 0x57, 0x4d, 0x46, 0x98, 0xe4, 0x4b, 0x76, 0x60, 0xcc,
-	0xe1, 0x4f, 0x62, 0xcb, 0xa3, 0x18, 0xe6, 0xf1, 0xbc, 0x76, 0xaa, 0x0d, 0xf8, 0xe2, 0x22, 0x1f,
-	0xde, 0x96, 0xdc, 0xc8, 0x6f, 0x5a, 0x30, 0x2e, 0x62, 0x19, 0x98, 0x42, 0xca, 0xbe, 0xfd, 0xb3,
-	0xa7, 0xf0, 0xf8, 0x88, 0x8c, 0xb3, 0x90, 0xce, 0x59, 0x1f, 0xd2, 0xbe, 0xd5, 0xa2, 0xf4, 0xc0,
-	0x48, 0x0b, 0x25, 0x1d, 0x53, 0x7d, 0x3b, 0xce, 0xc3, 0xc4, 0xc3, 0x57, 0xa6, 0xea, 0xbb, 0x9a,
-	0x82, 0x61, 0x1f, 0xf6, 0xec, 0xc7, 0x61, 0xc2, 0x94, 0xe3, 0x58, 0xd1, 0x1a, 0x3f, 0x2e, 0x00,
-	0xf0, 0xae, 0x12, 0x49, 0x8c, 0x3a, 0x3c, 0xd7, 0xfa, 0x96, 0xdf, 0xcc, 0xe9, 0x01, 0x5e, 0x23,
-	0x17, 0x11, 0xc8, 0xc4, 0xea, 0x5b, 0x7e, 0x13, 0x25, 0x13, 0xd2, 0x82, 0xd1, 0xae, 0x13, 0x6d,
-	0xe5, 0x9f, 0xf8, 0xa8, 0x24, 0xa2, 0xf9, 0xa3, 0x2d, 0xe4, 0x0c, 0xc8, 0xdb, 0x56, 0xec, 0xf7,
-	0x54, 0xc8, 0x23, 0x5d, 0x74, 0xdc, 0x66, 0xf3, 0xd2, 0xd3, 0x29, 0x95, 0x35, 0x39, 0xed, 0xff,
-	0x34, 0xfb, 0x8e, 0x05, 0x13, 0x26, 0x6a, 0x46, 0x37, 0xfd, 0xbc, 0xd9, 0x4d, 0x79, 0xb6, 0x87,
-	0xd9, 0xe3, 0xff, 0xdd, 0x02, 0xc0, 0x9e, 0x57, 0xef, 0x75, 0x3a, 0x4c, 0x6d, 0xd7, 0x41, 0x29,
-	0xd6, 0x91, 0x83, 0x52, 0x46, 0x8e, 0x19, 0x94, 0x52, 0x38, 0x56, 0x50, 0xca, 0xe8, 0xf1, 0x83,
-	0x52, 0x8a, 0x83, 0x83, 0x52, 0xec, 0x6f, 0x5a, 0x70, 0xb6, 0x6f, 0xbf, 0x62, 0x9a, 0x74, 0xe0,
-	0xfb, 0xd1, 0x00, 0xff, 0x59, 0x8c, 0x41, 0x68, 0xe2, 0x91, 0x25, 0x98, 0x96, 0x2f, 0x0b, 0xd5,
-	0xbb, 0x6d, 0x37, 0x33, 0x29, 0xd5, 0x7a, 0x0a, 0x8e, 0x7d, 0x35, 0xec, 0x7f, 0x65, 0x41, 0xc5,
-	0x48, 0x65, 0xc1, 0x7d, 0xce, 0xf8, 0x8d, 0x57, 0xda, 0xe7, 0x8c, 0x5f, 0x75, 0x09, 0x98, 0xb8,
-	0x86, 0x6e, 0x19, 0xef, 0x4e, 0xc4, 0xd7, 0xd0, 0xac, 0x14, 0x25, 0x54, 0xbc, 0x28, 0x20, 0x9d,
-	0xcf, 0x0a, 0xe6, 0x8b, 0x02, 0xb4, 0x2b, 0x5c, 0xcd, 0x62, 0x17, 0xb7, 0xd1, 0xc3, 0x5d, 0xdc,
-	0x8a, 0xd9, 0x2e, 0x6e, 0xf6, 0x1d, 0x98, 0x10, 0xbe, 0xe1, 0xaf, 0xd2, 0xdd, 0x23, 0xbf, 0x60,
-	0xcd, 0x46, 0x7b, 0xca, 0x67, 0x8e, 0x55, 0x67, 0xe5, 0xb6, 0x03, 0x71, 0x7a, 0xed, 0x23, 0x50,
-	0xbb, 0x06, 0xa0, 0x13, 0xfd, 0x0b, 0x47, 0xbc, 0x52, 0x3c, 0x20, 0xf5, 0x6b, 0x00, 0x4d, 0x34,
-	0xb0, 0xec, 0x7f, 0x64, 0x41, 0xea, 0xe5, 0x34, 0xe3, 0x92, 0xc7, 0x1a, 0x78, 0xc9, 0x63, 0x5e,
-	0x0c, 0x8c, 0x1c, 0x78, 0x31, 0x70, 0x0b, 0x48, 0x87, 0xcd, 0xb6, 0xe4, 0x5a, 0x5e, 0x48, 0x3e,
-	0x30, 0xb3, 0xda, 0x87, 0x81, 0x19, 0xb5, 0xec, 0x7f, 0x28, 0x84, 0x35, 0xdf, 0x52, 0x3b, 0xbc,
-	0x55, 0x7a, 0x50, 0xe4, 0xa4, 0xa4, 0x89, 0x6f, 0x48, 0xf3, 0x78, 0x7f, 0x8e, 0xbb, 0x78, 0xac,
-	0xc8, 0x55, 0x85, 0x73, 0xb3, 0x7f, 0x4f, 0xc8, 0x6a, 0x3e, 0xb6, 0x76, 0xb8, 0xac, 0x9d, 0xa4,
-	0xac, 0x37, 0xf3, 0x5a, 0x8e, 0xb3, 0x65, 0x24, 0xf3, 0x00, 0x5d, 0x1a, 0x34, 0xa8, 0x17, 0xa9,
-	0x48, 0xbd, 0xa2, 0x8c, 0x19, 0xd7, 0xa5, 0x68, 0x60, 0xd8, 0xdf, 0x60, 0x73, 0x34, 0x7e, 0x3e,
-	0x9e, 0x5c, 0x4d, 0xfb, 0x1a, 0xa7, 0xe7, 0x9f, 0x76, 0x35, 0x36, 0x42, 0xae, 0x46, 0x0e, 0x09,
-	0xb9, 0x7a, 0x06, 0xc6, 0x03, 0xbf, 0x4d, 0xab, 0x81, 0x97, 0x76, 0x03, 0x42, 0x56, 0x8c, 0xb7,
-	0x51, 0xc1, 0xed, 0x5f, 0xb7, 0x60, 0x3a, 0x1d, 0x60, 0x9a, 0xbb, 0x03, 0xb4, 0x99, 0x8f, 0xa3,
-	0x70, 0xfc, 0x7c, 0x1c, 0xf6, 0x9f, 0x14, 0x61, 0x3a, 0xfd, 0xac, 0x25, 0xe3, 0xec, 0x72, 0x7b,
-	0x5e, 0x6a, 0x83, 0x11, 0x86, 0x3c, 0x01, 0xd3, 0xe3, 0x65, 0x64, 0xe0, 0x78, 0xb9, 0x01, 0x65,
-	0xbf, 0xab, 0x6c, 0x0a, 0x42, 0xb8, 0xab, 0xca, 0x1e, 0x74, 0x47, 0x01, 0xde, 0xdd, 0x9b, 0x3b,
-	0x17, 0x0b, 0xa0, 0x8b, 0x31, 0xae, 0x4a, 0x7e, 0x46, 0x19, 0x43, 0x46, 0x13, 0x19, 0xae, 0xb4,
-	0x31, 0x64, 0x2a, 0xae, 0x3f, 0xc8, 0x1e, 0x52, 0x3c, 0x4e, 0xa6, 0x9d, 0xb1, 0x1c, 0x33, 0xed,
-	0xdc, 0x83, 0xb2, 0x34, 0xdf, 0x9e, 0x28, 0xc3, 0x0c, 0x27, 0x7c, 0x57, 0x11, 0xc0, 0x98, 0x56,
-	0x2a, 0x85, 0x4f, 0x29, 0xd7, 0x14, 0x3e, 0x2f, 0xc1, 0xf8, 0x86, 0xd3, 0xd8, 0xf6, 0x37, 0x37,
-	0xf9, 0x11, 0xa0, 0x5c, 0xfb, 0xa0, 0x6a, 0xb8, 0x9a, 0x28, 0xce, 0x18, 0x52, 0xaa, 0x06, 0x5b,
-	0xe7, 0xa9, 0xf2, 0x78, 0x56, 0x96, 0x65, 0xbd, 0xce, 0x6b, 0x5f, 0xe8, 0x10, 0x0d, 0x2c, 0xf2,
-	0x2c, 0x94, 0x9a, 0x6e, 0x28, 0x1e, 0x5e, 0xaf, 0x24, 0x1d, 0xe2, 0x97, 0x64, 0x39, 0x6a, 0x0c,
-	0xf2, 0xb2, 0x76, 0x88, 0x9b, 0x88, 0x63, 0x55, 0xb4, 0x33, 0xdc, 0x01, 0xb1, 0x2a, 0xd2, 0xdf,
-	0xf7, 0x6d, 0x36, 0x31, 0x23, 0xb7, 0xb1, 0xed, 0x7a, 0x22, 0x6d, 0x0b, 0x5b, 0x2d, 0x9e, 0x81,
-	0x71, 0x2a, 0x9f, 0x7e, 0x17, 0xb7, 0x33, 0x7a, 0xb0, 0xa8, 0x17, 0xdf, 0x15, 0x9c, 0x54, 0x61,
-	0x4a, 0xdd, 0x49, 0xab, 0x2b, 0x35, 0x91, 0x6e, 0x4a, 0x9b, 0xf0, 0x97, 0x92, 0x60, 0x4c, 0xe3,
-	0xdb, 0x5f, 0x80, 0x8a, 0xa1, 0xeb, 0x71, 0xb5, 0xe8, 0xa1, 0xd3, 0xe8, 0x73, 0x61, 0xbf, 0xce,
-	0x0a, 0x51, 0xc0, 0xf8, 0xcd, 0x9f, 0x88, 0xbf, 0x4c, 0xa9, 0x13, 0x32, 0xea, 0x52, 0x42, 0x19,
-	0xb1, 0x80, 0xb6, 0xe8, 0x43, 0xf5, 0xda, 0x8e, 0x22, 0x86, 0xac, 0x10, 0x05, 0xcc, 0x7e, 0x16,
-	0x4a, 0x2a, 0x29, 0x20, 0xcf, 0xac, 0xa5, 0x6e, 0xa5, 0xcc, 0xcc, 0x5a, 0x7e, 0x10, 0x21, 0x87,
-	0xd8, 0xaf, 0x43, 0x49, 0xe5, 0x2e, 0x3c, 0x1c, 0x9b, 0x6d, 0xbf, 0xa1, 0xe7, 0xde, 0xf4, 0xc3,
-	0x48, 0x25, 0x5c, 0x14, 0x17, 0xe7, 0xb7, 0x97, 0x79, 0x19, 0x6a, 0xa8, 0xfd, 0x13, 0x0b, 0x2a,
-	0xeb, 0xeb, 0x2b, 0xda, 0x9e, 0x86, 0xf0, 0x58, 0x28, 0x5a, 0xa8, 0xba, 0x19, 0x51, 0xd3, 0x43,
-	0x47, 0xac, 0x44, 0xb3, 0xfb, 0x7b, 0x73, 0x8f, 0xd5, 0x33, 0x31, 0x70, 0x40, 0x4d, 0xb2, 0x0c,
-	0xe7, 0x4c, 0x88, 0x4c, 0x84, 0x23, 0xf5, 0x82, 0x8b, 0xfb, 0x6c, 0xf9, 0xe9, 0x07, 0x63, 0x56,
-	0x9d, 0x34, 0x29, 0x15, 0x37, 0x5c, 0xc8, 0x26, 0xa5, 0x82, 0x86, 0xb3, 0xea, 0xd8, 0xcf, 0xc1,
-	0x54, 0xca, 0x75, 0xe4, 0x08, 0x09, 0xc8, 0x7e, 0xa7, 0x00, 0x13, 0xa6, 0x07, 0xc1, 0x11, 0xf6,
-	0xec, 0xa3, 0xab, 0x42, 0x19, 0xb7, 0xfe, 0x85, 0x63, 0xde, 0xfa, 0x9b, 0x6e, 0x16, 0xa3, 0xa7,
-	0xeb, 0x66, 0x51, 0xcc, 0xc7, 0xcd, 0xc2, 0x70, 0x07, 0x1a, 0x7b, 0x74, 0xee, 0x40, 0xbf, 0x5d,
-	0x84, 0xc9, 0x64, 0x46, 0xeb, 0x23, 0xf4, 0xe4, 0xb3, 0x7d, 0x3d, 0x79, 0xcc, 0x6b, 0xc6, 0xc2,
-	0xb0, 0xd7, 0x8c, 0xa3, 0xc3, 0x5e, 0x33, 0x16, 0x4f, 0x70, 0xcd, 0xd8, 0x7f, 0x49, 0x38, 0x76,
-	0xe4, 0x4b, 0xc2, 0x4f, 0xe8, 0x8d, 0x62, 0x3c, 0xe1, 0x59, 0x17, 0x6f, 0x16, 0x24, 0xd9, 0x0d,
-	0x8b, 0x7e, 0x33, 0xd3, 0xe3, 0xbb, 0x74, 0x88, 0xfa, 0x10, 0x64, 0x3a, 0x3a, 0x1f, 0xdf, 0x93,
-	0xe1, 0xb1, 0x63, 0x38, 0x39, 0xbf, 0x00, 0x15, 0x39, 0x9e, 0xf8, 0x99, 0x16, 0x92, 0xe7, 0xe1,
-	0x7a, 0x0c, 0x42, 0x13, 0x8f, 0x0d, 0x8c, 0x6e, 0x3c, 0x41, 0xf8, 0x85, 0x77, 0x25, 0x79, 0xe1,
-	0xbd, 0x96, 0x04, 0x63, 0x1a, 0xdf, 0xfe, 0x3c, 0x5c, 0xc8, 0xb4, 0x6c, 0xf2, 0x5b, 0x25, 0x7e,
-	0x16, 0xa2, 0x4d, 0x89, 0x60, 0x88, 0x91, 0x7a, 0x62, 0x6b, 0xf6, 0xde, 0x40, 0x4c, 0x3c, 0x80,
-	0x8a, 0xfd, 0x5b, 0x05, 0x98, 0x4c, 0x3e, 0x39, 0x4f, 0x1e, 0xe8, 0x7b, 0x90, 0x5c, 0xae, 0x60,
-	0x04, 0x59, 0x23, 0x4b, 0xf2, 0xc0, 0xfb, 0xd3, 0x07, 0x7c, 0x7c, 0x6d, 0xe8, 0x94, 0xcd, 0xa7,
-	0xc7, 0x58, 0x5e, 0x5c, 0x4a, 0x76, 0xfc, 0xe1, 0xf6, 0x38, 0xa5, 0x80, 0x34, 0x8f, 0xe5, 0xce,
-	0x3d, 0x8e, 0xfe, 0xd6, 0xac, 0xd0, 0x60, 0xcb, 0xf6, 0x96, 0x1d, 0x1a, 0xb8, 0x9b, 0x2e, 0x6d,
-	0xca, 0x17, 0x34, 0xf8, 0xca, 0xfd, 0xba, 0x2c, 0x43, 0x0d, 0xb5, 0xdf, 0x1e, 0x81, 0x32, 0xcf,
-	0xff, 0x78, 0x23, 0xf0, 0x3b, 0xfc, 0x31, 0xe2, 0xd0, 0x30, 0x45, 0xc8, 0x6e, 0xbb, 0x95, 0xc7,
-	0xeb, 0x5f, 0x82, 0xa2, 0x8c, 0x22, 0x31, 0x4a, 0x30, 0xc1, 0x91, 0x74, 0xa1, 0xb4, 0x29, 0xf3,
-	0xd5, 0xcb, 0xbe, 0x1b, 0x32, 0xe7, 0xb2, 0xca, 0x7e, 0x2f, 0x9a, 0x40, 0xfd, 0x43, 0xcd, 0xc5,
-	0x76, 0x60, 0x2a, 0x95, 0x36, 0x2b, 0xf7, 0x2c, 0xf7, 0xff, 0x73, 0x14, 0xca, 0x3a, 0xb8, 0x93,
-	0x7c, 0x2c, 0x61, 0x17, 0x8e, 0x75, 0x78, 0x69, 0xd0, 0x65, 0xe7, 0x26, 0x8d, 0x9c, 0xb2, 0xf1,
-	0x5e, 0x82, 0x42, 0x2f, 0x68, 0xa7, 0x0d, 0x3f, 0x77, 0x71, 0x05, 0x59, 0xb9, 0x19, 0x90, 0x5a,
-	0x78, 0xb4, 0x01, 0xa9, 0x57, 0x60, 0x74, 0xc3, 0x6f, 0xee, 0xa6, 0x5f, 0xd6, 0xac, 0xf9, 0xcd,
-	0x5d, 0xe4, 0x10, 0xf2, 0x32, 0x4c, 0xca, 0x28, 0x5b, 0xa5, 0xc4, 0x14, 0xb9, 0x9e, 0xaa, 0xfd,
-	0x81, 0xd6, 0x13, 0x50, 0x4c, 0x61, 0xb3, 0x5d, 0x96, 0x1d, 0x1b, 0xf8, 0xdb, 0x05, 0x63, 0x49,
-	0xe7, 0x81, 0x5b, 0xf5, 0x3b, 0xb7, 0xb9, 0x7d, 0x5a, 0x63, 0x24, 0x02, 0x79, 0xc7, 0x0f, 0x0d,
-	0xe4, 0x5d, 0x12, 0xb4, 0x99, 0xb4, 0x7c, 0x47, 0x99, 0xa8, 0x5d, 0x55, 0x74, 0x59, 0xd9, 0x81,
-	0x67, 0x17, 0x5d, 0x33, 0x2b, 0xe4, 0xb9, 0xfc, 0xde, 0x85, 0x3c, 0xdb, 0x77, 0x61, 0x2a, 0xd5,
-	0x7f, 0xca, 0x6e, 0x68, 0x65, 0xdb, 0x0d, 0x8f, 0xf6, 0x36, 0xe7, 0x3f, 0xb3, 0xe0, 0x6c, 0xdf,
-	0x8a, 0x74, 0xd4, 0xd8, 0xf3, 0xf4, 0xde, 0x38, 0x72, 0xf2, 0xbd, 0xb1, 0x70, 0xbc, 0xbd, 0xb1,
-	0xb6, 0xf1, 0xbd, 0x1f, 0x5d, 0xfe, 0xc0, 0x0f, 0x7e, 0x74, 0xf9, 0x03, 0xbf, 0xff, 0xa3, 0xcb,
-	0x1f, 0x78, 0x7b, 0xff, 0xb2, 0xf5, 0xbd, 0xfd, 0xcb, 0xd6, 0x0f, 0xf6, 0x2f, 0x5b, 0xbf, 0xbf,
-	0x7f, 0xd9, 0xfa, 0x2f, 0xfb, 0x97, 0xad, 0x6f, 0xfe, 0xd1, 0xe5, 0x0f, 0x7c, 0xea, 0x13, 0x71,
-	0x4f, 0x2d, 0xa8, 0x9e, 0xe2, 0x3f, 0x3e, 0xac, 0xfa, 0x65, 0xa1, 0xbb, 0xdd, 0x5a, 0x60, 0x3d,
-	0xb5, 0xa0, 0x4b, 0x54, 0x4f, 0xfd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x12, 0x87, 0x61, 0xb2,
-	0x65, 0xaf, 0x00, 0x00,
+	// 10909 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x6c, 0x24, 0xd9,
+	0x75, 0x18, 0xac, 0xea, 0x07, 0xd9, 0x7d, 0xc9, 0xe1, 0xa3, 0x86, 0xb3, 0xd3, 0x3b, 0xbb, 0x33,
+	0x1c, 0xd5, 0x5a, 0xfa, 0x56, 0xb6, 0xc4, 0x91, 0x66, 0x57, 0xb6, 0xac, 0xd5, 0xa7, 0xb8, 0x49,
+	0xce, 0xec, 0x70, 0x96, 0xe4, 0xb4, 0x4e, 0x73, 0x76, 0xf4, 0xb4, 0x55, 0xec, 0xbe, 0x6c, 0xd6,
+	0xb0, 0xbb, 0xaa, 0x55, 0x55, 0xcd, 0x19, 0x4a, 0x82, 0x77, 0x65, 0x43, 0xb2, 0xa5, 0x58, 0x88,
+	0xe2, 0x07, 0x82, 0x28, 0x0f, 0x28, 0x86, 0x03, 0xc7, 0x4e, 0x7e, 0x24, 0x86, 0x82, 0xf8, 0x87,
+	0x8d, 0x18, 0x56, 0x1c, 0x28, 0x40, 0xec, 0x58, 0x3f, 0x12, 0x29, 0x01, 0x4c, 0x47, 0x54, 0xfe,
+	0xc4, 0x49, 0xa0, 0x28, 0x70, 0xe0, 0x60, 0x11, 0x38, 0xc1, 0x7d, 0xdf, 0x5b, 0x5d, 0xdd, 0x64,
+	0xb3, 0x8b, 0xdc, 0x75, 0xe4, 0x5f, 0x64, 0xdf, 0x73, 0xee, 0x39, 0xf7, 0xde, 0xba, 0x8f, 0x73,
+	0xcf, 0x3d, 0x0f, 0xb4, 0xde, 0xf2, 0xe2, 0xdd, 0xde, 0xf6, 0x52, 0x23, 0xe8, 0xdc, 0x70, 0xc3,
+	0x56, 0xd0, 0x0d, 0x83, 0x87, 0xf4, 0x9f, 0x77, 0x84, 0x41, 0xbb, 0x1d, 0xf4, 0xe2, 0xe8, 0x46,
+	0x77, 0xaf, 0x75, 0xc3, 0xed, 0x7a, 0xd1, 0x0d, 0x59, 0xb2, 0xff, 0x2e, 0xb7, 0xdd, 0xdd, 0x75,
+	0xdf, 0x75, 0xa3, 0x85, 0x7d, 0x1c, 0xba, 0x31, 0x6e, 0x2e, 0x75, 0xc3, 0x20, 0x0e, 0xec, 0xf7,
+	0x29, 0x6a, 0x4b, 0x82, 0x1a, 0xfd, 0xe7, 0x27, 0x44, 0xdd, 0xa5, 0xee, 0x5e, 0x6b, 0x89, 0x50,
+	0x5b, 0x92, 0x25, 0x82, 0xda, 0x95, 0x77, 0x68, 0x6d, 0x69, 0x05, 0xad, 0xe0, 0x06, 0x25, 0xba,
+	0xdd, 0xdb, 0xa1, 0xbf, 0xe8, 0x0f, 0xfa, 0x1f, 0x63, 0x76, 0xe5, 0x99, 0xbd, 0xf7, 0x44, 0x4b,
+	0x5e, 0x40, 0xda, 0x76, 0x63, 0xdb, 0x8d, 0x1b, 0xbb, 0x37, 0xf6, 0xfb, 0x5a, 0x74, 0xc5, 0xd1,
+	0x90, 0x1a, 0x41, 0x88, 0xd3, 0x70, 0x9e, 0x57, 0x38, 0x1d, 0xb7, 0xb1, 0xeb, 0xf9, 0x38, 0x3c,
+	0x50, 0xbd, 0xee, 0xe0, 0xd8, 0x4d, 0xab, 0x75, 0x63, 0x50, 0xad, 0xb0, 0xe7, 0xc7, 0x5e, 0x07,
+	0xf7, 0x55, 0xf8, 0xe1, 0xe3, 0x2a, 0x44, 0x8d, 0x5d, 0xdc, 0x71, 0xfb, 0xea, 0x3d, 0x37, 0xa8,
+	0x5e, 0x2f, 0xf6, 0xda, 0x37, 0x3c, 0x3f, 0x8e, 0xe2, 0x30, 0x59, 0xc9, 0xf9, 0x6e, 0x1e, 0x95,
+	0xab, 0xeb, 0xcb, 0xf5, 0xd8, 0x8d, 0x7b, 0x91, 0xfd, 0x39, 0x0b, 0x4d, 0xb7, 0x03, 0xb7, 0xb9,
+	0xec, 0xb6, 0x5d, 0xbf, 0x81, 0xc3, 0x8a, 0x75, 0xdd, 0x7a, 0x76, 0xea, 0xe6, 0xfa, 0xd2, 0x38,
+	0xdf, 0x6b, 0xa9, 0xfa, 0x28, 0x02, 0x1c, 0x05, 0xbd, 0xb0, 0x81, 0x01, 0xef, 0x2c, 0x2f, 0x7c,
+	0xfd, 0x70, 0xf1, 0x4d, 0x47, 0x87, 0x8b, 0xd3, 0xeb, 0x1a, 0x27, 0x30, 0xf8, 0xda, 0xbf, 0x64,
+	0xa1, 0xf9, 0x86, 0xeb, 0xbb, 0xe1, 0xc1, 0x96, 0x1b, 0xb6, 0x70, 0xfc, 0x62, 0x18, 0xf4, 0xba,
+	0x95, 0xdc, 0x19, 0xb4, 0xe6, 0x49, 0xde, 0x9a, 0xf9, 0x95, 0x24, 0x3b, 0xe8, 0x6f, 0x01, 0x6d,
+	0x57, 0x14, 0xbb, 0xdb, 0x6d, 0xac, 0xb7, 0x2b, 0x7f, 0x96, 0xed, 0xaa, 0x27, 0xd9, 0x41, 0x7f,
+	0x0b, 0xec, 0xb7, 0xa1, 0x49, 0xcf, 0x6f, 0x85, 0x38, 0x8a, 0x2a, 0x85, 0xeb, 0xd6, 0xb3, 0xe5,
+	0xe5, 0x59, 0x5e, 0x7d, 0x72, 0x8d, 0x15, 0x83, 0x80, 0x3b, 0xbf, 0x91, 0x47, 0xf3, 0xd5, 0xf5,
+	0xe5, 0xad, 0xd0, 0xdd, 0xd9, 0xf1, 0x1a, 0x10, 0xf4, 0x62, 0xcf, 0x6f, 0xe9, 0x04, 0xac, 0xe1,
+	0x04, 0xec, 0x77, 0xa3, 0xa9, 0x08, 0x87, 0xfb, 0x5e, 0x03, 0xd7, 0x82, 0x30, 0xa6, 0x1f, 0xa5,
+	0xb8, 0x7c, 0x91, 0xa3, 0x4f, 0xd5, 0x15, 0x08, 0x74, 0x3c, 0x52, 0x2d, 0x0c, 0x82, 0x98, 0xc3,
+	0xe9, 0x98, 0x95, 0x55, 0x35, 0x50, 0x20, 0xd0, 0xf1, 0xec, 0x55, 0x34, 0xe7, 0xfa, 0x7e, 0x10,
+	0xbb, 0xb1, 0x17, 0xf8, 0xb5, 0x10, 0xef, 0x78, 0x8f, 0x79, 0x17, 0x2b, 0xbc, 0xee, 0x5c, 0x35,
+	0x01, 0x87, 0xbe, 0x1a, 0xf6, 0x97, 0x2c, 0x34, 0x17, 0xc5, 0x5e, 0x63, 0xcf, 0xf3, 0x71, 0x14,
+	0xad, 0x04, 0xfe, 0x8e, 0xd7, 0xaa, 0x14, 0xe9, 0x67, 0xdb, 0x1c, 0xef, 0xb3, 0xd5, 0x13, 0x54,
+	0x97, 0x17, 0x48, 0x93, 0x92, 0xa5, 0xd0, 0xc7, 0xdd, 0xfe, 0x21, 0x54, 0xe6, 0x23, 0x8a, 0xa3,
+	0xca, 0xc4, 0xf5, 0xfc, 0xb3, 0xe5, 0xe5, 0x0b, 0x47, 0x87, 0x8b, 0xe5, 0x35, 0x51, 0x08, 0x0a,
+	0xee, 0xac, 0xa2, 0x4a, 0xb5, 0xb3, 0xed, 0x46, 0x91, 0xdb, 0x0c, 0xc2, 0xc4, 0xa7, 0x7b, 0x16,
+	0x95, 0x3a, 0x6e, 0xb7, 0xeb, 0xf9, 0x2d, 0xf2, 0xed, 0x08, 0x9d, 0xe9, 0xa3, 0xc3, 0xc5, 0xd2,
+	0x06, 0x2f, 0x03, 0x09, 0x75, 0xfe, 0x7d, 0x0e, 0x4d, 0x55, 0x7d, 0xb7, 0x7d, 0x10, 0x79, 0x11,
+	0xf4, 0x7c, 0xfb, 0xe3, 0xa8, 0x44, 0x76, 0xad, 0xa6, 0x1b, 0xbb, 0x7c, 0xa5, 0xbf, 0x73, 0x89,
+	0x6d, 0x22, 0x4b, 0xfa, 0x26, 0xa2, 0xba, 0x4f, 0xb0, 0x97, 0xf6, 0xdf, 0xb5, 0x74, 0x6f, 0xfb,
+	0x21, 0x6e, 0xc4, 0x1b, 0x38, 0x76, 0x97, 0x6d, 0xfe, 0x15, 0x90, 0x2a, 0x03, 0x49, 0xd5, 0x0e,
+	0x50, 0x21, 0xea, 0xe2, 0x06, 0x5f, 0xb9, 0x1b, 0x63, 0xae, 0x10, 0xd5, 0xf4, 0x7a, 0x17, 0x37,
+	0x96, 0xa7, 0x39, 0xeb, 0x02, 0xf9, 0x05, 0x94, 0x91, 0xfd, 0x08, 0x4d, 0x44, 0x74, 0x2f, 0xe3,
+	0x8b, 0xf2, 0x5e, 0x76, 0x2c, 0x29, 0xd9, 0xe5, 0x19, 0xce, 0x74, 0x82, 0xfd, 0x06, 0xce, 0xce,
+	0xf9, 0x0f, 0x16, 0xba, 0xa8, 0x61, 0x57, 0xc3, 0x56, 0xaf, 0x83, 0xfd, 0xd8, 0xbe, 0x8e, 0x0a,
+	0xbe, 0xdb, 0xc1, 0x7c, 0x55, 0xc9, 0x26, 0x6f, 0xba, 0x1d, 0x0c, 0x14, 0x62, 0x3f, 0x83, 0x8a,
+	0xfb, 0x6e, 0xbb, 0x87, 0xe9, 0x20, 0x95, 0x97, 0x2f, 0x70, 0x94, 0xe2, 0xcb, 0xa4, 0x10, 0x18,
+	0xcc, 0xfe, 0x34, 0x2a, 0xd3, 0x7f, 0x6e, 0x87, 0x41, 0x27, 0xa3, 0xae, 0xf1, 0x16, 0xbe, 0x2c,
+	0xc8, 0xb2, 0xe9, 0x27, 0x7f, 0x82, 0x62, 0xe8, 0xfc, 0xb1, 0x85, 0x66, 0xb5, 0xce, 0xad, 0x7b,
+	0x51, 0x6c, 0x7f, 0xb4, 0x6f, 0xf2, 0x2c, 0x9d, 0x6c, 0xf2, 0x90, 0xda, 0x74, 0xea, 0xcc, 0xf1,
+	0x9e, 0x96, 0x44, 0x89, 0x36, 0x71, 0x7c, 0x54, 0xf4, 0x62, 0xdc, 0x89, 0x2a, 0xb9, 0xeb, 0xf9,
+	0x67, 0xa7, 0x6e, 0xae, 0x65, 0xf6, 0x19, 0xd5, 0xf8, 0xae, 0x11, 0xfa, 0xc0, 0xd8, 0x38, 0x5f,
+	0xcd, 0x1b, 0x9f, 0x6f, 0x43, 0xb4, 0xe3, 0xb3, 0x16, 0x9a, 0x68, 0xbb, 0xdb, 0xb8, 0xcd, 0xd6,
+	0xd6, 0xd4, 0xcd, 0x8f, 0x65, 0xd6, 0x12, 0xc1, 0x63, 0x69, 0x9d, 0xd2, 0xbf, 0xe5, 0xc7, 0xe1,
+	0x81, 0x9a, 0x5e, 0xac, 0x10, 0x38, 0x73, 0xfb, 0x6f, 0x5a, 0x68, 0x4a, 0xed, 0x6a, 0x62, 0x58,
+	0xb6, 0xb3, 0x6f, 0x8c, 0xda, 0x4c, 0x79, 0x8b, 0xe4, 0x16, 0xad, 0x41, 0x40, 0x6f, 0xcb, 0x95,
+	0x1f, 0x45, 0x53, 0x5a, 0x17, 0xec, 0x39, 0x94, 0xdf, 0xc3, 0x07, 0x6c, 0xc2, 0x03, 0xf9, 0xd7,
+	0x5e, 0x30, 0x66, 0x38, 0x9f, 0xd2, 0xef, 0xcd, 0xbd, 0xc7, 0xba, 0xf2, 0x7e, 0x34, 0x97, 0x64,
+	0x38, 0x4a, 0x7d, 0xe7, 0x1f, 0x17, 0x8d, 0x89, 0x49, 0x36, 0x02, 0x3b, 0x40, 0x93, 0x1d, 0x1c,
+	0x87, 0x5e, 0x43, 0x7c, 0xb2, 0xd5, 0xf1, 0x46, 0x69, 0x83, 0x12, 0x53, 0x07, 0x22, 0xfb, 0x1d,
+	0x81, 0xe0, 0x62, 0xef, 0xa2, 0x82, 0x1b, 0xb6, 0xc4, 0x37, 0xb9, 0x9d, 0xcd, 0xb2, 0x54, 0x5b,
+	0x45, 0x35, 0x6c, 0x45, 0x40, 0x39, 0xd8, 0x37, 0x50, 0x39, 0xc6, 0x61, 0xc7, 0xf3, 0xdd, 0x98,
+	0x9d, 0xa0, 0xa5, 0xe5, 0x79, 0x8e, 0x56, 0xde, 0x12, 0x00, 0x50, 0x38, 0x76, 0x1b, 0x4d, 0x34,
+	0xc3, 0x03, 0xe8, 0xf9, 0x95, 0x42, 0x16, 0x43, 0xb1, 0x4a, 0x69, 0xa9, 0x49, 0xca, 0x7e, 0x03,
+	0xe7, 0x61, 0xff, 0x8a, 0x85, 0x16, 0x3a, 0xd8, 0x8d, 0x7a, 0x21, 0x26, 0x5d, 0x00, 0x1c, 0x63,
+	0x9f, 0x7c, 0xd8, 0x4a, 0x91, 0x32, 0x87, 0x71, 0xbf, 0x43, 0x3f, 0xe5, 0xe5, 0xa7, 0x79, 0x53,
+	0x16, 0xd2, 0xa0, 0x90, 0xda, 0x1a, 0xfb, 0xd3, 0x68, 0x2a, 0x8e, 0xdb, 0xf5, 0x38, 0x74, 0x63,
+	0xdc, 0x3a, 0xa8, 0x4c, 0x5c, 0xb7, 0xc6, 0xdf, 0x61, 0xb6, 0xb6, 0xd6, 0x05, 0xc1, 0xe5, 0x59,
+	0xb2, 0x5a, 0xb4, 0x02, 0xd0, 0xd9, 0x39, 0xbf, 0x59, 0x44, 0xf3, 0x7d, 0xc7, 0x8a, 0xfd, 0x3c,
+	0x2a, 0x76, 0x77, 0xdd, 0x48, 0x9c, 0x13, 0xd7, 0xc4, 0x26, 0x55, 0x23, 0x85, 0xaf, 0x1d, 0x2e,
+	0x5e, 0x10, 0x55, 0x68, 0x01, 0x30, 0x64, 0x22, 0xb5, 0x75, 0x70, 0x14, 0xb9, 0x2d, 0x71, 0x78,
+	0x68, 0x93, 0x94, 0x16, 0x83, 0x80, 0xdb, 0x3f, 0x63, 0xa1, 0x0b, 0x6c, 0xc2, 0x02, 0x8e, 0x7a,
+	0xed, 0x98, 0x1c, 0x90, 0xe4, 0xa3, 0xdc, 0xcd, 0x62, 0x71, 0x30, 0x92, 0xcb, 0x97, 0x38, 0xf7,
+	0x0b, 0x7a, 0x69, 0x04, 0x26, 0x5f, 0xfb, 0x01, 0x2a, 0x47, 0xb1, 0x1b, 0xc6, 0xb8, 0x59, 0x8d,
+	0xa9, 0x28, 0x37, 0x75, 0xf3, 0x07, 0x4f, 0x76, 0x72, 0x6c, 0x79, 0x1d, 0xcc, 0x4e, 0xa9, 0xba,
+	0x20, 0x00, 0x8a, 0x96, 0xfd, 0x69, 0x84, 0xc2, 0x9e, 0x5f, 0xef, 0x75, 0x3a, 0x6e, 0x78, 0xc0,
+	0xa5, 0xbb, 0x3b, 0xe3, 0x75, 0x0f, 0x24, 0x3d, 0x25, 0xe8, 0xa8, 0x32, 0xd0, 0xf8, 0xd9, 0x9f,
+	0xb1, 0xd0, 0x05, 0xb6, 0x0e, 0x44, 0x0b, 0x26, 0x32, 0x6e, 0xc1, 0x3c, 0x19, 0xda, 0x55, 0x9d,
+	0x05, 0x98, 0x1c, 0xed, 0x8f, 0xa1, 0xa9, 0x46, 0xd0, 0xe9, 0xb6, 0x31, 0x1b, 0xdc, 0xc9, 0x91,
+	0x07, 0x97, 0x4e, 0xdd, 0x15, 0x45, 0x02, 0x74, 0x7a, 0xce, 0xbf, 0x35, 0x65, 0x1c, 0x31, 0xa5,
+	0xed, 0x8f, 0xa0, 0x27, 0xa3, 0x5e, 0xa3, 0x81, 0xa3, 0x68, 0xa7, 0xd7, 0x86, 0x9e, 0x7f, 0xc7,
+	0x8b, 0xe2, 0x20, 0x3c, 0x58, 0xf7, 0x3a, 0x5e, 0x4c, 0x27, 0x74, 0x71, 0xf9, 0xea, 0xd1, 0xe1,
+	0xe2, 0x93, 0xf5, 0x41, 0x48, 0x30, 0xb8, 0xbe, 0xed, 0xa2, 0xa7, 0x7a, 0xfe, 0x60, 0xf2, 0xec,
+	0xfa, 0xb1, 0x78, 0x74, 0xb8, 0xf8, 0xd4, 0xfd, 0xc1, 0x68, 0x30, 0x8c, 0x86, 0xf3, 0x27, 0x16,
+	0x9a, 0x13, 0xfd, 0xda, 0xc2, 0x9d, 0x6e, 0x9b, 0x6c, 0x9d, 0x67, 0x2f, 0x1c, 0xc7, 0x86, 0x70,
+	0x0c, 0xd9, 0x9c, 0xe5, 0xa2, 0xfd, 0x83, 0x24, 0x64, 0xe7, 0x3f, 0x5b, 0x68, 0x21, 0x89, 0x7c,
+	0x0e, 0x02, 0x5d, 0x64, 0x0a, 0x74, 0x9b, 0xd9, 0xf6, 0x76, 0x80, 0x54, 0xf7, 0x79, 0x6d, 0xc2,
+	0x0a, 0x54, 0xc0, 0x3b, 0xf6, 0x7b, 0xd0, 0x74, 0xcc, 0x7f, 0x6e, 0x2a, 0xe1, 0x5c, 0x2a, 0x26,
+	0xb6, 0x34, 0x18, 0x18, 0x98, 0xa4, 0x66, 0xa3, 0xdd, 0x8b, 0x62, 0x1c, 0xd6, 0x1b, 0x41, 0x97,
+	0x6d, 0xbb, 0x25, 0x55, 0x73, 0x45, 0x83, 0x81, 0x81, 0xe9, 0xfc, 0xd5, 0x62, 0xff, 0xb8, 0xff,
+	0xbf, 0x2e, 0xaf, 0x28, 0xf1, 0x23, 0xff, 0x7a, 0x8a, 0x1f, 0x85, 0x37, 0x94, 0xf8, 0xf1, 0x53,
+	0x16, 0x91, 0xe2, 0xd8, 0x04, 0x88, 0xb8, 0x68, 0xf4, 0x81, 0x6c, 0x97, 0x03, 0x51, 0x20, 0x69,
+	0x82, 0x21, 0xe7, 0x05, 0x8a, 0xad, 0xf3, 0x0f, 0x0a, 0x68, 0xba, 0xea, 0xc7, 0x5e, 0x75, 0x67,
+	0xc7, 0xf3, 0xbd, 0xf8, 0xc0, 0xfe, 0xb9, 0x1c, 0xba, 0xd1, 0x0d, 0xf1, 0x0e, 0x0e, 0x43, 0xdc,
+	0x5c, 0xed, 0x85, 0x9e, 0xdf, 0xaa, 0x37, 0x76, 0x71, 0xb3, 0xd7, 0xf6, 0xfc, 0xd6, 0x5a, 0xcb,
+	0x0f, 0x64, 0xf1, 0xad, 0xc7, 0xb8, 0xd1, 0xa3, 0xe3, 0xca, 0x76, 0x89, 0xce, 0x78, 0x6d, 0xaf,
+	0x8d, 0xc6, 0x74, 0xf9, 0xb9, 0xa3, 0xc3, 0xc5, 0x1b, 0x23, 0x56, 0x82, 0x51, 0xbb, 0x66, 0xff,
+	0x6c, 0x0e, 0x2d, 0x85, 0xf8, 0x13, 0x3d, 0xef, 0xe4, 0xa3, 0xc1, 0xb6, 0xf1, 0xf6, 0x98, 0xc7,
+	0xfd, 0x48, 0x3c, 0x97, 0x6f, 0x1e, 0x1d, 0x2e, 0x8e, 0x58, 0x07, 0x46, 0xec, 0x97, 0x53, 0x43,
+	0x53, 0xd5, 0xae, 0x17, 0x79, 0x8f, 0x89, 0xc2, 0x09, 0x9f, 0x40, 0xa1, 0xb1, 0x88, 0x8a, 0x61,
+	0xaf, 0x8d, 0xd9, 0x06, 0x53, 0x5e, 0x2e, 0x93, 0x6d, 0x19, 0x48, 0x01, 0xb0, 0x72, 0xe7, 0xa7,
+	0xc8, 0x11, 0x44, 0x49, 0x26, 0x54, 0x59, 0x0f, 0x51, 0x31, 0x24, 0x4c, 0x2a, 0x56, 0x16, 0x32,
+	0xb9, 0xd6, 0x6a, 0xde, 0x08, 0xf2, 0x2f, 0x30, 0x16, 0xce, 0xd7, 0x72, 0xe8, 0x52, 0xb5, 0xdb,
+	0xdd, 0xc0, 0xd1, 0x6e, 0xa2, 0x15, 0x7f, 0xcd, 0x42, 0x33, 0xfb, 0x5e, 0x18, 0xf7, 0xdc, 0xb6,
+	0xd0, 0x56, 0xb2, 0xf6, 0xd4, 0xc7, 0x6d, 0x0f, 0xe5, 0xf6, 0xb2, 0x41, 0x7a, 0xd9, 0x3e, 0x3a,
+	0x5c, 0x9c, 0x31, 0xcb, 0x20, 0xc1, 0xde, 0xfe, 0x1b, 0x16, 0x9a, 0xe3, 0x45, 0x9b, 0x41, 0x13,
+	0xeb, 0xda, 0xf0, 0xfb, 0x59, 0xb6, 0x49, 0x12, 0x67, 0x5a, 0xcc, 0x64, 0x29, 0xf4, 0x35, 0xc2,
+	0xf9, 0x6f, 0x39, 0x74, 0x79, 0x00, 0x0d, 0xfb, 0x57, 0x2d, 0xb4, 0xc0, 0x54, 0xe8, 0x1a, 0x08,
+	0xf0, 0x0e, 0x1f, 0xcd, 0x0f, 0x65, 0xdd, 0x72, 0x20, 0x4b, 0x1c, 0xfb, 0x0d, 0xbc, 0x5c, 0x21,
+	0x5b, 0xf2, 0x4a, 0x0a, 0x6b, 0x48, 0x6d, 0x10, 0x6d, 0x29, 0x53, 0xaa, 0x27, 0x5a, 0x9a, 0x3b,
+	0x97, 0x96, 0xd6, 0x53, 0x58, 0x43, 0x6a, 0x83, 0x9c, 0xbf, 0x82, 0x9e, 0x1a, 0x42, 0xee, 0xf8,
+	0xc5, 0xe9, 0x7c, 0x0c, 0x5d, 0x32, 0x09, 0x88, 0x39, 0x76, 0xfc, 0xba, 0x76, 0xd0, 0x04, 0x5d,
+	0x3a, 0x62, 0x61, 0x23, 0x72, 0x06, 0xd3, 0x35, 0x15, 0x01, 0x87, 0x38, 0x5f, 0xb3, 0x50, 0x69,
+	0x04, 0xdd, 0xe7, 0xa2, 0xa9, 0xfb, 0x2c, 0xf7, 0xe9, 0x3d, 0xe3, 0x7e, 0xbd, 0xe7, 0x8b, 0xe3,
+	0x7d, 0x8d, 0x93, 0xe8, 0x3b, 0xbf, 0x6b, 0xa1, 0xf9, 0x3e, 0xfd, 0xa8, 0xbd, 0x8b, 0x16, 0xba,
+	0x41, 0x53, 0x1c, 0xa7, 0x77, 0xdc, 0x68, 0x97, 0xc2, 0x78, 0xf7, 0x9e, 0x27, 0x5f, 0xb2, 0x96,
+	0x02, 0x7f, 0xed, 0x70, 0xb1, 0x22, 0x89, 0x24, 0x10, 0x20, 0x95, 0xa2, 0xdd, 0x45, 0xa5, 0x1d,
+	0x0f, 0xb7, 0x9b, 0x6a, 0x0a, 0x8e, 0x29, 0xa5, 0xdd, 0xe6, 0xd4, 0xd8, 0xd3, 0x80, 0xf8, 0x05,
+	0x92, 0x8b, 0xf3, 0xa7, 0x16, 0x9a, 0xa9, 0xf6, 0xe2, 0x5d, 0x22, 0xa3, 0x34, 0xa8, 0x36, 0x8e,
+	0xa8, 0x60, 0x23, 0xaf, 0xb5, 0xff, 0x7c, 0x36, 0x9b, 0x71, 0x9d, 0x90, 0xe2, 0x4f, 0x24, 0x52,
+	0x58, 0xa7, 0x85, 0xc0, 0xd8, 0xd8, 0x21, 0x9a, 0x08, 0xdc, 0x5e, 0xbc, 0x7b, 0x93, 0x77, 0x79,
+	0x4c, 0xcd, 0xc4, 0x3d, 0xd2, 0x9d, 0x9b, 0x9c, 0xa3, 0x14, 0x19, 0x59, 0x29, 0x70, 0x4e, 0xce,
+	0x2b, 0x68, 0xc6, 0x7c, 0x77, 0x3b, 0xc1, 0x9c, 0xbd, 0x8a, 0xf2, 0x6e, 0xe8, 0xf3, 0x19, 0x3b,
+	0xc5, 0x11, 0xf2, 0x55, 0xd8, 0x04, 0x52, 0x6e, 0xbf, 0x1d, 0x95, 0x76, 0x7a, 0xed, 0x36, 0xa9,
+	0xc0, 0x1f, 0xb9, 0xe4, 0xb5, 0xe8, 0x36, 0x2f, 0x07, 0x89, 0xe1, 0x7c, 0x77, 0x12, 0xcd, 0x2e,
+	0xb7, 0x7b, 0xf8, 0xc5, 0x10, 0x63, 0xa1, 0x0b, 0xaa, 0xa2, 0xd9, 0x6e, 0x88, 0xf7, 0x3d, 0xfc,
+	0xa8, 0x8e, 0xdb, 0xb8, 0x11, 0x07, 0x21, 0x6f, 0xcd, 0x65, 0x4e, 0x68, 0xb6, 0x66, 0x82, 0x21,
+	0x89, 0x6f, 0xbf, 0x1f, 0xcd, 0xb8, 0x8d, 0xd8, 0xdb, 0xc7, 0x92, 0x02, 0x6b, 0xee, 0x13, 0x9c,
+	0xc2, 0x4c, 0xd5, 0x80, 0x42, 0x02, 0xdb, 0xfe, 0x28, 0xaa, 0x44, 0x0d, 0xb7, 0x8d, 0xef, 0x77,
+	0x39, 0xab, 0x95, 0x5d, 0xdc, 0xd8, 0xab, 0x05, 0x9e, 0x1f, 0x73, 0xbd, 0xe3, 0x75, 0x4e, 0xa9,
+	0x52, 0x1f, 0x80, 0x07, 0x03, 0x29, 0xd8, 0xff, 0xdc, 0x42, 0x57, 0xbb, 0x21, 0xae, 0x85, 0x41,
+	0x27, 0x20, 0x53, 0xad, 0x4f, 0x1d, 0xc6, 0xd5, 0x42, 0x2f, 0x8f, 0x29, 0x4b, 0xb1, 0x92, 0xfe,
+	0x37, 0x9c, 0x37, 0x1f, 0x1d, 0x2e, 0x5e, 0xad, 0x0d, 0x6b, 0x00, 0x0c, 0x6f, 0x9f, 0xfd, 0xbb,
+	0x16, 0xba, 0xd6, 0x0d, 0xa2, 0x78, 0x48, 0x17, 0x8a, 0x67, 0xda, 0x05, 0xe7, 0xe8, 0x70, 0xf1,
+	0x5a, 0x6d, 0x68, 0x0b, 0xe0, 0x98, 0x16, 0xda, 0x3f, 0x86, 0xe6, 0x62, 0x26, 0xf9, 0xd4, 0x63,
+	0xdc, 0x5d, 0xf3, 0x9b, 0xf8, 0x31, 0xd5, 0x59, 0x15, 0xd9, 0xe9, 0xbf, 0x95, 0x80, 0x41, 0x1f,
+	0xb6, 0xfd, 0x5b, 0x16, 0x7a, 0x5a, 0x2b, 0xec, 0x1f, 0x84, 0xc9, 0x33, 0x1d, 0x84, 0xeb, 0x47,
+	0x87, 0x8b, 0x4f, 0x6f, 0x0d, 0xe1, 0x0f, 0x43, 0x5b, 0x67, 0x47, 0x68, 0xf2, 0x11, 0xf6, 0x5a,
+	0xbb, 0x71, 0x54, 0x29, 0x65, 0xf1, 0x84, 0xcf, 0x9b, 0xf2, 0x80, 0xd1, 0x5c, 0x9e, 0x22, 0xb7,
+	0x6f, 0xfe, 0x03, 0x04, 0x27, 0xe7, 0xab, 0x73, 0x68, 0x5e, 0x5b, 0xf1, 0x5c, 0x85, 0xf6, 0x02,
+	0xba, 0x20, 0x96, 0xa0, 0x92, 0x38, 0xcb, 0x4a, 0xa3, 0x5a, 0xd5, 0x81, 0x60, 0xe2, 0x92, 0xd5,
+	0x2e, 0x37, 0x00, 0x56, 0x3b, 0xb1, 0xda, 0x6b, 0x06, 0x14, 0x12, 0xd8, 0xf6, 0x1a, 0xba, 0xc8,
+	0x4b, 0x00, 0x77, 0xdb, 0x5e, 0xc3, 0x5d, 0x09, 0x7a, 0x7c, 0xa1, 0x17, 0x97, 0x2f, 0x1f, 0x1d,
+	0x2e, 0x5e, 0xac, 0xf5, 0x83, 0x21, 0xad, 0x8e, 0xbd, 0x8e, 0x16, 0xdc, 0x5e, 0x1c, 0xc8, 0x59,
+	0x77, 0xcb, 0x27, 0x42, 0x4c, 0x93, 0x2e, 0xe8, 0x12, 0x93, 0x76, 0xaa, 0x29, 0x70, 0x48, 0xad,
+	0x65, 0xd7, 0x12, 0xd4, 0xea, 0xb8, 0x11, 0xf8, 0x4d, 0xb6, 0xb6, 0x8a, 0xea, 0xf2, 0x5d, 0x4d,
+	0xc1, 0x81, 0xd4, 0x9a, 0x76, 0x1b, 0xcd, 0x74, 0xdc, 0xc7, 0xf7, 0x7d, 0x77, 0xdf, 0xf5, 0xda,
+	0x84, 0x49, 0x65, 0xe2, 0x18, 0xdd, 0x5e, 0x2f, 0xf6, 0xda, 0x4b, 0xcc, 0x7a, 0x66, 0x69, 0xcd,
+	0x8f, 0xef, 0x85, 0xf5, 0x98, 0xdc, 0x8f, 0x98, 0xdc, 0xbe, 0x61, 0xd0, 0x82, 0x04, 0x6d, 0xfb,
+	0x1e, 0xba, 0x44, 0x37, 0xc1, 0xd5, 0xe0, 0x91, 0xbf, 0x8a, 0xdb, 0xee, 0x81, 0xe8, 0xc0, 0x24,
+	0xed, 0xc0, 0x93, 0x47, 0x87, 0x8b, 0x97, 0xea, 0x69, 0x08, 0x90, 0x5e, 0x8f, 0x28, 0x43, 0x4d,
+	0x00, 0xe0, 0x7d, 0x2f, 0xf2, 0x02, 0x9f, 0x29, 0x43, 0x4b, 0x4a, 0x19, 0x5a, 0x1f, 0x8c, 0x06,
+	0xc3, 0x68, 0xd8, 0x7f, 0xcb, 0x42, 0x0b, 0x69, 0x9b, 0x5f, 0xa5, 0x9c, 0xc5, 0x1b, 0x7e, 0x62,
+	0x2d, 0xb3, 0x19, 0x91, 0xba, 0x15, 0xa7, 0x36, 0xc2, 0x7e, 0xd5, 0x42, 0xd3, 0xae, 0xa6, 0xb7,
+	0xa8, 0xa0, 0x2c, 0x64, 0x05, 0x5d, 0x13, 0xb2, 0x3c, 0x47, 0x14, 0x79, 0x7a, 0x09, 0x18, 0x1c,
+	0xed, 0xbf, 0x6b, 0xa1, 0x4b, 0xa9, 0x3b, 0x6b, 0x65, 0xea, 0x2c, 0x46, 0x88, 0x4e, 0x92, 0xf4,
+	0x9d, 0x3e, 0xbd, 0x19, 0xc4, 0xd8, 0x45, 0x08, 0x04, 0xe2, 0x59, 0xb7, 0x32, 0x7d, 0xdd, 0x1a,
+	0x5f, 0xcd, 0xa4, 0x09, 0xaf, 0x82, 0xf0, 0xf2, 0x45, 0x4d, 0x1e, 0x11, 0x85, 0x90, 0x64, 0x6f,
+	0x7f, 0xd1, 0x12, 0x02, 0x89, 0x6c, 0xd1, 0x85, 0xb3, 0x6a, 0x91, 0xad, 0xe4, 0x1b, 0xd9, 0xa0,
+	0x04, 0x73, 0xfb, 0xc7, 0xd1, 0x15, 0x77, 0x3b, 0x08, 0xe3, 0xd4, 0xc5, 0x57, 0x99, 0xa1, 0xcb,
+	0xe8, 0xda, 0xd1, 0xe1, 0xe2, 0x95, 0xea, 0x40, 0x2c, 0x18, 0x42, 0x81, 0xaa, 0x10, 0x62, 0x43,
+	0xab, 0x50, 0x99, 0xcd, 0x42, 0x85, 0xc0, 0x27, 0x87, 0xa9, 0xb0, 0x60, 0x3d, 0x36, 0xcb, 0x20,
+	0xc1, 0xde, 0xfe, 0x39, 0x0b, 0x4d, 0x6b, 0x87, 0x61, 0x54, 0x99, 0xcb, 0x42, 0x29, 0x2a, 0x0f,
+	0x32, 0xed, 0x14, 0xd6, 0xf4, 0xe8, 0x1a, 0x3f, 0x30, 0xb8, 0xdb, 0xbf, 0x68, 0xd1, 0x33, 0x47,
+	0x4e, 0x5e, 0xf2, 0xfa, 0x14, 0xf4, 0xe2, 0xca, 0x7c, 0x26, 0x77, 0x8d, 0x18, 0x77, 0x39, 0x41,
+	0x79, 0x7c, 0x25, 0x39, 0x41, 0x1a, 0x7b, 0xa2, 0x68, 0x59, 0x30, 0x16, 0x95, 0x68, 0x97, 0x9d,
+	0x75, 0xbb, 0xd8, 0xc6, 0x97, 0xc2, 0x0a, 0x52, 0x1b, 0xe0, 0xfc, 0x5a, 0x0e, 0x2d, 0xa4, 0x8d,
+	0x36, 0xb1, 0x23, 0x8b, 0x70, 0xcc, 0xc4, 0x0c, 0xfe, 0xd8, 0xc6, 0x9e, 0x48, 0x45, 0x21, 0x28,
+	0xb8, 0xbd, 0x87, 0x8a, 0x5d, 0xb7, 0x17, 0xe1, 0x6c, 0xae, 0x58, 0x7c, 0x36, 0xd6, 0x08, 0x45,
+	0x76, 0x77, 0xa7, 0xff, 0x02, 0xe3, 0x61, 0x3f, 0x42, 0x25, 0x57, 0x6c, 0x8d, 0xf9, 0xb3, 0xd8,
+	0x1a, 0xe9, 0x65, 0x56, 0xfc, 0x02, 0xc9, 0xcc, 0xf9, 0x92, 0x85, 0xb8, 0x39, 0x27, 0x5d, 0xbe,
+	0xb5, 0xa0, 0xed, 0x35, 0x0e, 0xec, 0x4f, 0xa1, 0x52, 0x37, 0xc4, 0xc4, 0x24, 0x51, 0xe8, 0xf3,
+	0x3e, 0x30, 0xb6, 0xe6, 0x9a, 0x52, 0xa3, 0x4c, 0x70, 0xb3, 0x16, 0x34, 0x79, 0x93, 0x04, 0x00,
+	0x24, 0x43, 0xe7, 0xb7, 0x27, 0xd1, 0x34, 0x6b, 0x12, 0x97, 0x3d, 0x89, 0xe8, 0xdc, 0xe8, 0x85,
+	0x21, 0xf6, 0xe3, 0x74, 0xd1, 0xd9, 0x3a, 0x7b, 0xd1, 0x79, 0x65, 0x08, 0x7f, 0x18, 0xda, 0x3a,
+	0xfb, 0x0f, 0x2c, 0xe4, 0x70, 0x84, 0x65, 0xb7, 0xb1, 0xd7, 0x0a, 0x83, 0x9e, 0xdf, 0xec, 0xef,
+	0x44, 0xee, 0x4c, 0x3b, 0xf1, 0xd6, 0xa3, 0xc3, 0x45, 0x67, 0xe5, 0xd8, 0x56, 0xc0, 0x09, 0x5a,
+	0x6a, 0xbf, 0x88, 0xe6, 0x39, 0xd6, 0xad, 0xc7, 0x5d, 0x1c, 0x7a, 0x44, 0xf5, 0xc3, 0xef, 0xef,
+	0xca, 0x44, 0x38, 0x89, 0x00, 0xfd, 0x75, 0xf4, 0x4b, 0x45, 0xe1, 0xbc, 0x2e, 0x15, 0xf6, 0x26,
+	0x9a, 0x61, 0xea, 0xc2, 0x9a, 0xe7, 0xb7, 0x6a, 0x81, 0xcf, 0x8c, 0x5b, 0xcb, 0xcb, 0x6f, 0x15,
+	0x37, 0x80, 0xba, 0x01, 0x7d, 0xed, 0x70, 0x71, 0x5a, 0xfc, 0xbf, 0x75, 0xd0, 0xc5, 0x90, 0xa8,
+	0x6d, 0x7f, 0xd9, 0x42, 0x76, 0x14, 0xe3, 0x6e, 0xad, 0xdd, 0x6b, 0x79, 0x7c, 0x88, 0xb8, 0x99,
+	0x6a, 0x06, 0x16, 0xb3, 0x26, 0xdd, 0xe5, 0x2b, 0xbc, 0x91, 0x76, 0xbd, 0x8f, 0x23, 0xa4, 0xb4,
+	0xc2, 0x0e, 0xd1, 0xe4, 0x23, 0xd7, 0x8b, 0x6f, 0x07, 0x21, 0xbf, 0x5f, 0xbe, 0x34, 0x5e, 0x83,
+	0x1e, 0x30, 0x62, 0xbc, 0x35, 0x6c, 0x80, 0x59, 0x11, 0x08, 0x46, 0xce, 0x57, 0x11, 0x42, 0x62,
+	0xfd, 0xbe, 0xa1, 0x37, 0xdd, 0x9f, 0xb6, 0x10, 0xc2, 0xe6, 0x0c, 0xce, 0x4a, 0xea, 0x50, 0x93,
+	0x9c, 0x1e, 0xf3, 0x33, 0xc4, 0xb0, 0x41, 0x95, 0x81, 0xc6, 0xd6, 0xd8, 0xfa, 0x0b, 0xe7, 0xb8,
+	0xf5, 0xdb, 0x3f, 0x6b, 0xa1, 0x99, 0x08, 0xc7, 0xfc, 0x53, 0x11, 0xd9, 0xac, 0x52, 0xcc, 0x62,
+	0x15, 0xd6, 0x0d, 0x9a, 0x4c, 0xe2, 0x32, 0xcb, 0x20, 0xc1, 0x57, 0x34, 0xe5, 0x0e, 0x76, 0x9b,
+	0x38, 0xa4, 0x6a, 0xf2, 0xca, 0x44, 0x46, 0x4d, 0xd1, 0x68, 0xca, 0xa6, 0x68, 0x65, 0x90, 0xe0,
+	0x2b, 0x9a, 0xb2, 0xe1, 0x85, 0x61, 0xc0, 0x9b, 0x52, 0xca, 0xa8, 0x29, 0x1a, 0x4d, 0xd9, 0x14,
+	0xad, 0x0c, 0x12, 0x7c, 0x89, 0x49, 0x40, 0x97, 0x2e, 0xe7, 0x4a, 0x39, 0x0b, 0xf3, 0x28, 0xb1,
+	0x35, 0xe0, 0x2e, 0x7b, 0x8e, 0x60, 0xbf, 0x81, 0xf3, 0x30, 0xa7, 0x03, 0x79, 0x2a, 0x89, 0x2a,
+	0x28, 0xa3, 0x8e, 0x6b, 0x34, 0x13, 0xd3, 0x81, 0x96, 0x41, 0x82, 0xaf, 0xdd, 0x55, 0xbb, 0xd6,
+	0x54, 0x16, 0xc2, 0xa4, 0xdc, 0xb5, 0x70, 0x37, 0x7d, 0xcf, 0xb2, 0x5f, 0x40, 0x93, 0x31, 0x17,
+	0x5f, 0xa7, 0xe9, 0x69, 0xf0, 0x66, 0x61, 0x12, 0xc2, 0x85, 0xca, 0xd7, 0x0e, 0x17, 0x67, 0x56,
+	0x7b, 0x21, 0x55, 0xf7, 0x33, 0xad, 0x06, 0x88, 0x1a, 0xf6, 0x2a, 0x2a, 0x2b, 0xe9, 0xf7, 0x82,
+	0x71, 0x98, 0x94, 0xef, 0xf9, 0x8a, 0xc0, 0xbc, 0x26, 0xd4, 0x32, 0x41, 0x0b, 0x54, 0x45, 0xe7,
+	0xd7, 0x67, 0xd1, 0x8c, 0xd8, 0x36, 0x95, 0xa6, 0x8b, 0xbd, 0xc1, 0x0d, 0xd0, 0x74, 0xad, 0xe8,
+	0x40, 0x30, 0x71, 0x49, 0x65, 0x76, 0x52, 0x99, 0x8a, 0x2e, 0x59, 0xb9, 0xae, 0x03, 0xc1, 0xc4,
+	0xb5, 0x3b, 0xa8, 0x18, 0xd1, 0xab, 0x0f, 0x33, 0x46, 0x19, 0x73, 0xe6, 0xa9, 0xd3, 0x40, 0x7b,
+	0xcf, 0xa0, 0x37, 0x1d, 0xc6, 0x25, 0xed, 0x0e, 0x58, 0x78, 0x7d, 0xef, 0x80, 0xfd, 0xca, 0xaf,
+	0xe2, 0x19, 0x2a, 0xbf, 0x3e, 0x4c, 0xfc, 0x52, 0x1e, 0xd7, 0x7b, 0x61, 0xeb, 0xf4, 0x4a, 0x36,
+	0xee, 0xc9, 0xc2, 0xa8, 0x80, 0xa4, 0x47, 0x8c, 0x2d, 0xd5, 0x01, 0xc3, 0x84, 0x80, 0x07, 0xd9,
+	0x1e, 0x30, 0x52, 0x54, 0x1c, 0x78, 0xd4, 0xf4, 0xa9, 0xa2, 0x4a, 0xe7, 0xae, 0x8a, 0x22, 0x6a,
+	0x15, 0xb6, 0x40, 0xa4, 0x5a, 0xa5, 0x7c, 0xa6, 0x6a, 0x95, 0x15, 0x83, 0x19, 0x24, 0x98, 0xd3,
+	0xf6, 0xb0, 0x35, 0x27, 0xdb, 0x83, 0xce, 0xb4, 0x3d, 0x75, 0x83, 0x19, 0x24, 0x98, 0x0f, 0xd6,
+	0xbf, 0x4e, 0x9d, 0x8d, 0xfe, 0x75, 0x3a, 0x03, 0xfd, 0xeb, 0x70, 0xd5, 0xd4, 0x85, 0xb1, 0x55,
+	0x53, 0x77, 0x91, 0xdd, 0x3c, 0xf0, 0xdd, 0x0e, 0x51, 0x1f, 0xd0, 0xdd, 0x91, 0x60, 0x51, 0x95,
+	0x57, 0x49, 0x49, 0xe2, 0xab, 0x7d, 0x18, 0x90, 0x52, 0xcb, 0x8e, 0x51, 0xa9, 0x2b, 0x2e, 0x1c,
+	0xb3, 0x59, 0xcc, 0x7e, 0x71, 0x01, 0x61, 0xd6, 0xab, 0xf4, 0x2e, 0xcd, 0x4b, 0x40, 0x72, 0x22,
+	0x6f, 0x0c, 0x1d, 0xcf, 0x27, 0xd7, 0xed, 0x1a, 0x0e, 0xf9, 0xeb, 0x43, 0x1d, 0xc7, 0x95, 0x39,
+	0x3a, 0x36, 0x54, 0xb1, 0xb2, 0x91, 0x02, 0x87, 0xd4, 0x5a, 0xd4, 0x1c, 0xaf, 0xe9, 0xe2, 0x0e,
+	0x79, 0x23, 0x10, 0xfa, 0xa7, 0x31, 0x8d, 0x6a, 0x56, 0x05, 0x39, 0xf3, 0xe8, 0x63, 0x57, 0x04,
+	0x09, 0x04, 0xc5, 0x96, 0x34, 0x62, 0xca, 0x55, 0xba, 0x8a, 0x8a, 0x9d, 0x85, 0x87, 0x57, 0x9f,
+	0x0a, 0x84, 0x99, 0x77, 0x6b, 0x05, 0xa0, 0x33, 0x75, 0xfe, 0xa7, 0x85, 0xe6, 0x56, 0xda, 0x41,
+	0xaf, 0xf9, 0x80, 0x78, 0x49, 0x33, 0xb3, 0x51, 0xfb, 0xfd, 0xa8, 0xe4, 0xf9, 0x31, 0x0e, 0xf7,
+	0xdd, 0x36, 0x3f, 0xa9, 0x1d, 0xf1, 0x9c, 0xbd, 0xc6, 0xcb, 0x53, 0xc4, 0x08, 0x59, 0xc7, 0xfe,
+	0x8a, 0x85, 0xe6, 0x99, 0xe1, 0xe9, 0xaa, 0x1b, 0xbb, 0x1f, 0xe8, 0xe1, 0xd0, 0xc3, 0xc2, 0xf4,
+	0x74, 0xcc, 0x2d, 0x3b, 0xd9, 0x56, 0xc1, 0xe0, 0x40, 0xdd, 0xd8, 0x37, 0x92, 0x9c, 0xa1, 0xbf,
+	0x31, 0xce, 0x2f, 0xe4, 0xd1, 0x93, 0x03, 0x69, 0xd9, 0x57, 0x50, 0xce, 0x6b, 0xf2, 0xae, 0x23,
+	0x4e, 0x37, 0xb7, 0xd6, 0x84, 0x9c, 0xd7, 0xb4, 0x97, 0xe8, 0x5d, 0x2b, 0xc4, 0x51, 0x24, 0x0c,
+	0x00, 0xcb, 0xf2, 0x5a, 0xc4, 0x4b, 0x41, 0xc3, 0x20, 0xe6, 0x2e, 0xd4, 0x9f, 0x8b, 0x2b, 0x16,
+	0xe8, 0xed, 0x8d, 0xba, 0x4e, 0x01, 0x2b, 0x27, 0xf3, 0x00, 0xb1, 0x06, 0x92, 0xdb, 0x28, 0x97,
+	0x17, 0x20, 0xdb, 0x61, 0x22, 0x94, 0x59, 0x2b, 0xd5, 0x6f, 0xd0, 0xb8, 0xda, 0x5b, 0x68, 0x82,
+	0x5c, 0xe4, 0x82, 0xe6, 0xa9, 0xc5, 0x03, 0x26, 0x8a, 0x53, 0x1a, 0xc0, 0x69, 0x91, 0xb1, 0x0a,
+	0x71, 0xdc, 0x0b, 0x7d, 0x32, 0xb4, 0x54, 0x20, 0x28, 0xb1, 0x56, 0x80, 0x2c, 0x05, 0x0d, 0xc3,
+	0xf9, 0x67, 0x39, 0xb4, 0x90, 0xd6, 0x74, 0x72, 0xee, 0x4e, 0xb0, 0xd6, 0x72, 0x1d, 0xd9, 0x07,
+	0xb3, 0x1f, 0x1f, 0xf6, 0x9f, 0x32, 0x1b, 0x61, 0xbf, 0x81, 0xf3, 0xb5, 0x3f, 0x28, 0x47, 0x28,
+	0x77, 0xca, 0x11, 0x92, 0x94, 0x13, 0xa3, 0x74, 0x1d, 0x15, 0x22, 0xf2, 0xe5, 0xf3, 0xa6, 0xf9,
+	0x09, 0xfd, 0x46, 0x14, 0x42, 0x30, 0x7a, 0xbe, 0x17, 0x57, 0x0a, 0x26, 0xc6, 0x7d, 0xdf, 0x8b,
+	0x81, 0x42, 0x9c, 0x5f, 0xca, 0xa1, 0x2b, 0x83, 0x3b, 0x45, 0x7c, 0xd8, 0x51, 0x93, 0x5c, 0xd3,
+	0x23, 0xea, 0x49, 0xc8, 0x6c, 0xce, 0xdd, 0xb3, 0x1a, 0xc3, 0x55, 0xc1, 0x49, 0x39, 0x43, 0xc8,
+	0xa2, 0x08, 0xb4, 0x86, 0xd8, 0x37, 0xc5, 0xd4, 0xa7, 0xa6, 0x33, 0x6c, 0x31, 0xc9, 0x3a, 0x1b,
+	0x12, 0x02, 0x1a, 0x16, 0xd1, 0xc3, 0xf8, 0x6e, 0x07, 0x47, 0x5d, 0x57, 0xba, 0x94, 0xd3, 0x4d,
+	0x76, 0x53, 0x14, 0x82, 0x82, 0x3b, 0x6d, 0xf4, 0xcc, 0x09, 0xda, 0x99, 0x91, 0xc7, 0xae, 0xf3,
+	0x3d, 0x0b, 0x5d, 0xe6, 0xee, 0x00, 0xdf, 0x37, 0xbe, 0x25, 0x7f, 0x66, 0xa1, 0xa7, 0x06, 0xf4,
+	0xf9, 0x1c, 0x5c, 0x4c, 0x3e, 0x69, 0xba, 0x98, 0xdc, 0x1f, 0x77, 0x4a, 0xa7, 0xf6, 0x63, 0x80,
+	0xa7, 0xc9, 0x7f, 0xb1, 0xd0, 0x02, 0xaf, 0x21, 0x14, 0x74, 0xec, 0xd5, 0xe1, 0xec, 0x3f, 0xf5,
+	0x27, 0x8c, 0x4f, 0x7d, 0x2f, 0x1b, 0xed, 0x22, 0x6d, 0xfc, 0xc0, 0xef, 0xfc, 0x3d, 0x0b, 0x55,
+	0xd2, 0x7a, 0x7b, 0x0e, 0x1f, 0xf9, 0x91, 0xf9, 0x91, 0x21, 0x93, 0x8f, 0x6c, 0x74, 0x62, 0xc0,
+	0x17, 0xfe, 0x3f, 0x16, 0xba, 0x66, 0xa2, 0x0b, 0xb9, 0xee, 0x1c, 0x97, 0xf5, 0xa7, 0x8c, 0x6f,
+	0xfd, 0xa1, 0x4c, 0xbe, 0x75, 0xb2, 0x1b, 0x03, 0xbf, 0xfa, 0xe7, 0x72, 0xc8, 0x19, 0x3e, 0x02,
+	0xe7, 0xf0, 0xfd, 0x3f, 0x63, 0x99, 0x13, 0xe0, 0xa3, 0x59, 0x4e, 0x80, 0x64, 0x7f, 0x06, 0x4c,
+	0x85, 0x8f, 0xa3, 0x32, 0x0f, 0xeb, 0x81, 0x77, 0xec, 0x77, 0xa1, 0xc2, 0x9e, 0xe7, 0x0b, 0x09,
+	0xf1, 0xaa, 0x18, 0xb7, 0x97, 0x3c, 0xbf, 0x49, 0xfc, 0x76, 0x25, 0x22, 0x29, 0x00, 0x8a, 0x2a,
+	0x4f, 0x98, 0xdc, 0x40, 0x2b, 0xed, 0xbb, 0xe8, 0xd2, 0x4a, 0xe0, 0xc7, 0x41, 0x2f, 0x19, 0xec,
+	0xe3, 0x5d, 0x68, 0x6a, 0x37, 0x8e, 0xbb, 0xb5, 0x30, 0x78, 0xec, 0x61, 0x76, 0x78, 0x97, 0x99,
+	0x58, 0x7f, 0x67, 0x6b, 0xab, 0xc6, 0x8b, 0x41, 0xc7, 0x71, 0xbe, 0x95, 0x43, 0xf3, 0xab, 0x9b,
+	0xf5, 0x04, 0xa1, 0x77, 0xa3, 0xa9, 0x26, 0xf1, 0xb8, 0x6f, 0x76, 0xa9, 0x51, 0xa7, 0x65, 0x86,
+	0x63, 0x59, 0xdd, 0xac, 0x0b, 0x10, 0xe8, 0x78, 0xf6, 0x06, 0xba, 0x28, 0x94, 0x6a, 0xf1, 0x5a,
+	0x13, 0xfb, 0xb1, 0xb7, 0xe3, 0x61, 0x61, 0x5d, 0xfa, 0x14, 0xaf, 0x7e, 0xb1, 0xde, 0x8f, 0x02,
+	0x69, 0xf5, 0x08, 0x39, 0xa1, 0xe0, 0xd3, 0xc9, 0xe5, 0x4d, 0x72, 0x2b, 0xfd, 0x28, 0x90, 0x56,
+	0x8f, 0x18, 0xc2, 0xb1, 0x17, 0xb1, 0x5a, 0x18, 0x74, 0x71, 0x18, 0x1f, 0x54, 0x0a, 0xa6, 0x21,
+	0xdc, 0x03, 0x03, 0x0a, 0x09, 0x6c, 0x22, 0xa3, 0x12, 0x57, 0x6d, 0xc3, 0xca, 0x8c, 0xca, 0xa8,
+	0xc4, 0x9b, 0x9b, 0x95, 0x82, 0x86, 0xe1, 0xac, 0xa2, 0xcb, 0x03, 0xee, 0x7a, 0xc4, 0x35, 0x1b,
+	0x73, 0xdb, 0x37, 0x8b, 0xca, 0xba, 0xd2, 0x1f, 0x4f, 0x98, 0xbc, 0x09, 0xb8, 0xf3, 0xb5, 0x02,
+	0xba, 0x40, 0x44, 0xde, 0x66, 0xd0, 0xca, 0xe8, 0xd2, 0xf5, 0x0c, 0x2a, 0x7e, 0x82, 0x5c, 0x5e,
+	0x92, 0x02, 0x0a, 0xbd, 0xd1, 0x00, 0x83, 0x91, 0x97, 0xa2, 0xc9, 0x4f, 0xf0, 0xfb, 0x18, 0xd3,
+	0x88, 0x7e, 0x70, 0xdc, 0x6b, 0xaf, 0xd6, 0x87, 0x25, 0x7e, 0xbb, 0x62, 0x41, 0x24, 0x64, 0xe7,
+	0x79, 0x29, 0x08, 0xce, 0x64, 0x9c, 0x76, 0x82, 0xb0, 0xd3, 0x6b, 0xbb, 0xc9, 0xc8, 0x45, 0xb7,
+	0x59, 0x31, 0x08, 0x38, 0x11, 0x10, 0xdd, 0xae, 0xf7, 0x32, 0x0e, 0x23, 0x16, 0x53, 0xc0, 0x10,
+	0x10, 0xab, 0x12, 0x02, 0x1a, 0x16, 0xad, 0xd3, 0x6a, 0x85, 0xb8, 0xe5, 0xc6, 0x41, 0x58, 0x99,
+	0x48, 0xd4, 0x91, 0x10, 0xd0, 0xb0, 0xec, 0xc7, 0xe4, 0x71, 0xaf, 0x11, 0xe2, 0x98, 0x98, 0xdf,
+	0x4f, 0x66, 0xe1, 0x73, 0x50, 0x17, 0xe4, 0x94, 0x57, 0x9e, 0x2c, 0x02, 0xc5, 0xec, 0xca, 0x7b,
+	0xd1, 0xb4, 0x3e, 0x6c, 0x23, 0x85, 0xc2, 0x78, 0x1f, 0xe2, 0xfe, 0x90, 0x09, 0x41, 0xda, 0x3a,
+	0x89, 0x20, 0xed, 0xfc, 0xbb, 0x1c, 0xd2, 0xde, 0xf2, 0xce, 0xe1, 0x24, 0xf3, 0x8d, 0x93, 0x6c,
+	0xcc, 0xe7, 0x18, 0xed, 0x65, 0x72, 0x50, 0x60, 0xa0, 0xfd, 0x44, 0x60, 0xa0, 0xcd, 0xcc, 0x38,
+	0x0e, 0x8f, 0x0b, 0xf4, 0x4d, 0x0b, 0x3d, 0xa5, 0x90, 0xfb, 0xed, 0x0e, 0x8e, 0xbf, 0x6d, 0xbc,
+	0x9b, 0x44, 0x7e, 0x91, 0xd5, 0x2a, 0x39, 0x73, 0xa7, 0xd6, 0x28, 0x82, 0x8e, 0xa7, 0x22, 0x4a,
+	0xe4, 0x4f, 0x19, 0x51, 0xa2, 0x30, 0x3c, 0xa2, 0x84, 0xf3, 0xa7, 0x39, 0x74, 0xb5, 0xbf, 0x67,
+	0xba, 0x9b, 0xf5, 0xf1, 0x7d, 0x4b, 0x3a, 0x62, 0xe7, 0x4e, 0xed, 0x88, 0x9d, 0x3f, 0xa9, 0x23,
+	0xb6, 0x74, 0x7f, 0x2e, 0x9c, 0xb9, 0xfb, 0x73, 0x1d, 0x5d, 0x12, 0xbe, 0x96, 0xb7, 0x83, 0x90,
+	0x87, 0x55, 0x10, 0x7b, 0x57, 0x49, 0xca, 0x0a, 0x97, 0x20, 0x0d, 0x09, 0xd2, 0xeb, 0x3a, 0xdf,
+	0xcc, 0xa3, 0x8b, 0x6a, 0xd8, 0x57, 0x02, 0xbf, 0xe9, 0x91, 0x72, 0xfb, 0x05, 0x54, 0x88, 0x0f,
+	0xba, 0x62, 0xb0, 0xff, 0x3f, 0xd1, 0x1c, 0x62, 0xde, 0xf1, 0xda, 0xe1, 0xe2, 0xe5, 0x94, 0x2a,
+	0x04, 0x04, 0xb4, 0x92, 0xbd, 0x2e, 0x57, 0x07, 0xfb, 0x02, 0xcf, 0x9b, 0xb3, 0xf9, 0xb5, 0xc3,
+	0xc5, 0x94, 0x00, 0x89, 0x4b, 0x92, 0x92, 0x39, 0xe7, 0xed, 0x87, 0x68, 0xa6, 0xed, 0x46, 0xf1,
+	0xfd, 0x6e, 0xd3, 0x8d, 0x31, 0x79, 0x0a, 0xac, 0xe4, 0x47, 0x8e, 0x44, 0x21, 0x8f, 0xec, 0x75,
+	0x83, 0x12, 0x24, 0x28, 0xdb, 0xfb, 0xc8, 0x26, 0x25, 0x5b, 0xa1, 0xeb, 0x47, 0x9e, 0x30, 0x98,
+	0x3b, 0x45, 0x58, 0x11, 0xa9, 0xfa, 0x5e, 0xef, 0xa3, 0x06, 0x29, 0x1c, 0xec, 0xb7, 0xa2, 0x89,
+	0x10, 0xbb, 0x91, 0x3c, 0x88, 0xe4, 0xfa, 0x07, 0x5a, 0x0a, 0x1c, 0xaa, 0x2f, 0xa8, 0x89, 0x63,
+	0x16, 0xd4, 0x1f, 0x59, 0x68, 0x46, 0x7d, 0xa6, 0x73, 0x90, 0xa5, 0x3b, 0xa6, 0x28, 0x7d, 0x27,
+	0xab, 0x2d, 0x71, 0x80, 0xd8, 0xfc, 0x27, 0x93, 0x7a, 0xff, 0x68, 0xec, 0x83, 0x4f, 0xe9, 0xae,
+	0xf0, 0x56, 0x16, 0x01, 0x69, 0x8c, 0x5b, 0xcc, 0x50, 0x1f, 0x78, 0x22, 0x65, 0x35, 0xb9, 0x04,
+	0x55, 0xc9, 0x99, 0x52, 0x96, 0x90, 0xac, 0xd2, 0xa4, 0x2c, 0x51, 0xc7, 0xbe, 0x8f, 0x2e, 0x77,
+	0xc3, 0x80, 0x86, 0xe8, 0x5b, 0xc5, 0x6e, 0xb3, 0xed, 0xf9, 0x58, 0x88, 0x8e, 0xcc, 0x75, 0xe2,
+	0xa9, 0xa3, 0xc3, 0xc5, 0xcb, 0xb5, 0x74, 0x14, 0x18, 0x54, 0xd7, 0x0c, 0xf2, 0x54, 0x38, 0x41,
+	0x90, 0xa7, 0xcf, 0xcb, 0xc7, 0x50, 0x19, 0x4f, 0xe0, 0x23, 0x59, 0x7d, 0xca, 0xb4, 0xc8, 0x02,
+	0x72, 0x4a, 0x55, 0x39, 0x53, 0x90, 0xec, 0x07, 0xbf, 0xb8, 0x4d, 0x9c, 0xf2, 0xc5, 0x4d, 0x85,
+	0x90, 0x98, 0x7c, 0x3d, 0x43, 0x48, 0x94, 0xde, 0x50, 0x21, 0x24, 0xbe, 0x62, 0xa1, 0x8b, 0x6e,
+	0x7f, 0xf0, 0xb6, 0x6c, 0x1e, 0x7f, 0x53, 0xa2, 0xc2, 0xa9, 0xab, 0x58, 0x0a, 0x10, 0xd2, 0x9a,
+	0xe2, 0x7c, 0xb6, 0x88, 0xe6, 0x92, 0x42, 0xd2, 0xd9, 0x47, 0xb9, 0xfa, 0x79, 0x0b, 0xcd, 0x89,
+	0x05, 0x2e, 0xad, 0x16, 0xd9, 0xe5, 0x66, 0x3d, 0xa3, 0x7d, 0x85, 0x89, 0x7b, 0x32, 0xf8, 0xe8,
+	0x56, 0x82, 0x1b, 0xf4, 0xf1, 0x27, 0x51, 0x99, 0xa4, 0x55, 0xc4, 0xa9, 0x42, 0x5e, 0xb1, 0x67,
+	0x3b, 0x45, 0x02, 0x74, 0x7a, 0x24, 0x44, 0x21, 0x6a, 0x88, 0x93, 0x38, 0xa3, 0x80, 0x22, 0x29,
+	0xd2, 0x82, 0x92, 0xe7, 0x65, 0x51, 0x04, 0x1a, 0x63, 0xfb, 0x17, 0xa8, 0x3d, 0x84, 0x9c, 0x09,
+	0xc2, 0x5a, 0xf4, 0x43, 0x59, 0x6f, 0x45, 0xca, 0xfe, 0x57, 0x4a, 0x7b, 0x1a, 0x28, 0x02, 0xa3,
+	0x11, 0xce, 0x0b, 0x48, 0xba, 0x3b, 0x93, 0x9d, 0x95, 0x3a, 0x3c, 0xd7, 0xdc, 0x78, 0x97, 0x4f,
+	0x41, 0xb9, 0xb3, 0xde, 0x16, 0x00, 0x50, 0x38, 0xce, 0xc7, 0xd1, 0xcc, 0x8b, 0xa1, 0xdb, 0xdd,
+	0xf5, 0x62, 0xcc, 0x6f, 0xe6, 0x6f, 0x43, 0x93, 0x6e, 0xb3, 0x99, 0x16, 0x27, 0xb7, 0xca, 0x8a,
+	0x41, 0xc0, 0x4f, 0x74, 0x09, 0x77, 0xfe, 0xa5, 0x85, 0x6c, 0x65, 0xa9, 0xe7, 0xf9, 0xad, 0x0d,
+	0xf2, 0x38, 0x41, 0xae, 0x70, 0xbb, 0xb4, 0x34, 0xed, 0x0a, 0x77, 0x47, 0x42, 0x40, 0xc3, 0x22,
+	0x61, 0xed, 0xd8, 0xaf, 0x97, 0xe5, 0x05, 0x31, 0x03, 0x8f, 0x85, 0x50, 0xb4, 0x89, 0x6b, 0x99,
+	0x14, 0x07, 0xd0, 0xd9, 0x91, 0xa1, 0x5a, 0xf3, 0x77, 0xda, 0xbd, 0xc7, 0xcd, 0x6d, 0x35, 0x54,
+	0xdd, 0x30, 0xd8, 0xf1, 0xda, 0x38, 0x39, 0x54, 0x35, 0x56, 0x0c, 0x02, 0x7e, 0xb2, 0xa1, 0xfa,
+	0x17, 0x16, 0x5a, 0x58, 0x8b, 0x62, 0x2f, 0x58, 0xc5, 0x51, 0x4c, 0x4e, 0x3e, 0xb2, 0x3f, 0xf6,
+	0xda, 0x27, 0x89, 0x5c, 0xb0, 0x8a, 0xe6, 0xb8, 0xba, 0xa8, 0xb7, 0x1d, 0xe1, 0x58, 0xbb, 0x6a,
+	0xc8, 0x75, 0xbc, 0x92, 0x80, 0x43, 0x5f, 0x0d, 0x42, 0x85, 0xeb, 0xb0, 0x14, 0x95, 0xbc, 0x49,
+	0xa5, 0x9e, 0x80, 0x43, 0x5f, 0x0d, 0x67, 0x1b, 0x5d, 0xa0, 0xbd, 0x58, 0x0f, 0x1a, 0x6e, 0x9b,
+	0x18, 0xf1, 0x1c, 0xdf, 0xfc, 0x1b, 0xa8, 0xdc, 0xf1, 0x7c, 0x6e, 0x6d, 0xcc, 0x02, 0x9e, 0xc9,
+	0x79, 0xbb, 0x21, 0x00, 0xa0, 0x70, 0x9c, 0x6f, 0x14, 0xd0, 0x45, 0xca, 0x24, 0xa1, 0xf4, 0xfb,
+	0xe2, 0xa0, 0xc8, 0x26, 0x63, 0x6e, 0x17, 0x94, 0xd7, 0x29, 0xe2, 0x9a, 0xfc, 0x75, 0x0b, 0xcd,
+	0x36, 0xcd, 0xaf, 0x99, 0xcd, 0x8b, 0x55, 0xda, 0x3c, 0x61, 0xae, 0x6a, 0x89, 0x42, 0x48, 0xf2,
+	0x27, 0x9e, 0x49, 0xb3, 0x66, 0x33, 0xc5, 0x09, 0x72, 0x06, 0x83, 0x24, 0x3d, 0xfa, 0xcd, 0xf2,
+	0x08, 0x92, 0x4d, 0xb0, 0x5f, 0x41, 0xa8, 0xcd, 0x66, 0x8c, 0x87, 0xc5, 0xdd, 0xf5, 0xa5, 0x0c,
+	0x1a, 0x24, 0xa6, 0xa1, 0xda, 0x5e, 0xd6, 0x25, 0x1b, 0xd0, 0x58, 0x3a, 0xbf, 0x9f, 0xe3, 0x73,
+	0xea, 0x2c, 0xe2, 0x86, 0xd8, 0x8f, 0x50, 0x39, 0x6e, 0x47, 0xac, 0xb0, 0x92, 0xcf, 0xe2, 0x66,
+	0xbe, 0xb5, 0x5e, 0xa7, 0xe4, 0x34, 0xe1, 0x99, 0x97, 0x44, 0xa0, 0x78, 0x51, 0xc6, 0x8d, 0x2e,
+	0x67, 0x9c, 0x89, 0x4a, 0x60, 0x6b, 0xa5, 0x96, 0x64, 0xbc, 0x52, 0x93, 0x8c, 0x05, 0x2f, 0xe7,
+	0x1f, 0x5a, 0xa8, 0x7c, 0x37, 0x10, 0x9b, 0xe5, 0x8f, 0x67, 0xa0, 0x70, 0x93, 0x72, 0xb9, 0x94,
+	0xcc, 0xd4, 0x55, 0xef, 0xfd, 0x86, 0xba, 0xed, 0x69, 0x8d, 0xf6, 0x12, 0xcd, 0x89, 0x40, 0x48,
+	0xdd, 0x0d, 0xb6, 0x07, 0xbe, 0xfd, 0xfc, 0x72, 0x11, 0x5d, 0x78, 0xc9, 0x3d, 0xc0, 0x7e, 0xec,
+	0x8e, 0x7e, 0x12, 0x12, 0x0d, 0x56, 0x97, 0x1a, 0x5c, 0x69, 0x77, 0x2d, 0xa5, 0xc1, 0x52, 0x20,
+	0xd0, 0xf1, 0xd4, 0xae, 0xcd, 0xde, 0x50, 0xd2, 0xf6, 0xdb, 0x95, 0x04, 0x1c, 0xfa, 0x6a, 0x10,
+	0x7b, 0x37, 0x1e, 0xf8, 0xae, 0xda, 0x68, 0x04, 0x3d, 0x9f, 0xed, 0xdb, 0x4c, 0xb9, 0x25, 0x2f,
+	0xfd, 0x1b, 0x7d, 0x18, 0x90, 0x52, 0x8b, 0x84, 0xc5, 0x68, 0x50, 0xca, 0xfc, 0x0a, 0xa8, 0x53,
+	0x64, 0x6a, 0x00, 0x19, 0x16, 0x63, 0x65, 0x00, 0x1e, 0x0c, 0xa4, 0x40, 0x5a, 0x1a, 0xc5, 0x41,
+	0xe8, 0xb6, 0xb0, 0x4e, 0x77, 0xc2, 0x6c, 0x69, 0xbd, 0x0f, 0x03, 0x52, 0x6a, 0xd9, 0xaf, 0xa0,
+	0x72, 0xbc, 0x1b, 0xe2, 0x68, 0x37, 0x68, 0x37, 0x2b, 0x93, 0x59, 0x68, 0x3c, 0xf9, 0xd7, 0xdf,
+	0x12, 0x54, 0xb5, 0xe9, 0x2d, 0x8a, 0x40, 0xf1, 0x24, 0xd1, 0x5c, 0x22, 0xa2, 0x6e, 0x8b, 0x2a,
+	0xa5, 0x2c, 0xae, 0xf5, 0x9c, 0x3b, 0xd5, 0xe0, 0x69, 0xba, 0x56, 0xca, 0x01, 0x38, 0x27, 0xe7,
+	0xf7, 0x72, 0x68, 0x5a, 0x47, 0x3c, 0xc1, 0xde, 0xf4, 0xd3, 0x16, 0x9a, 0x6e, 0x04, 0x7e, 0x1c,
+	0x06, 0x6d, 0x15, 0xd0, 0x71, 0x7c, 0xb1, 0x89, 0x90, 0x5a, 0xc5, 0xb1, 0xeb, 0xb5, 0x35, 0x95,
+	0xa4, 0xc6, 0x06, 0x0c, 0xa6, 0xc4, 0x39, 0x77, 0x56, 0x79, 0xcf, 0x28, 0x85, 0x66, 0xa6, 0x0d,
+	0x91, 0x67, 0xcd, 0x2d, 0x93, 0x13, 0x24, 0x59, 0x3b, 0xdb, 0x68, 0x2e, 0xf9, 0xb5, 0xc9, 0x50,
+	0x76, 0x5d, 0xbe, 0xd6, 0xf3, 0x6a, 0x28, 0x6b, 0x6e, 0x14, 0x01, 0x85, 0x90, 0xc0, 0x37, 0x1d,
+	0x37, 0x6c, 0x79, 0xbe, 0xdb, 0xa6, 0xa3, 0x98, 0xd7, 0x36, 0x24, 0x5e, 0x0e, 0x12, 0xc3, 0x59,
+	0x45, 0xf6, 0x4b, 0xc4, 0xfb, 0xcc, 0x14, 0x50, 0x96, 0x10, 0x22, 0x4f, 0x97, 0x7c, 0x3b, 0x66,
+	0xaf, 0x9b, 0xf4, 0x01, 0x8e, 0xbc, 0x6e, 0xb2, 0x52, 0xd0, 0x30, 0x9c, 0x17, 0xd1, 0xa5, 0x75,
+	0xcf, 0xdf, 0xc3, 0x61, 0x73, 0x4c, 0x42, 0xef, 0x44, 0xd3, 0x1b, 0xae, 0xdf, 0xc2, 0x4d, 0xf6,
+	0xfb, 0x04, 0x81, 0xb4, 0xbe, 0x53, 0x40, 0x53, 0xda, 0x95, 0xfd, 0xec, 0xef, 0xb6, 0x46, 0xdc,
+	0xe4, 0x7c, 0x86, 0x71, 0x93, 0x3f, 0x8c, 0x10, 0xb1, 0x27, 0x8f, 0x76, 0x4f, 0x19, 0x91, 0x99,
+	0x8e, 0xeb, 0x6d, 0x49, 0x01, 0x34, 0x6a, 0xca, 0x54, 0xaa, 0x38, 0x24, 0xb9, 0xc1, 0x67, 0x2d,
+	0xed, 0xf4, 0x9b, 0xc8, 0xc2, 0x34, 0x54, 0xfb, 0x30, 0x4b, 0xe2, 0x34, 0x64, 0x2f, 0x91, 0xc3,
+	0x0e, 0xc9, 0x2d, 0x54, 0x0a, 0x71, 0xd4, 0xeb, 0xe0, 0x53, 0xc5, 0x4e, 0xa6, 0xe6, 0xca, 0xc0,
+	0xeb, 0x83, 0xa4, 0x74, 0xe5, 0x05, 0x74, 0xc1, 0x68, 0xc2, 0x48, 0xaf, 0x7a, 0x01, 0x4a, 0xd5,
+	0x0b, 0x9d, 0xe6, 0x8d, 0x8f, 0x7c, 0x8b, 0xb6, 0x16, 0x33, 0x59, 0x7e, 0x0b, 0x66, 0x94, 0xce,
+	0x60, 0xce, 0x9f, 0x4f, 0x22, 0x6e, 0xed, 0x78, 0x82, 0xdd, 0x53, 0x7f, 0xa7, 0xce, 0x9d, 0xe2,
+	0x9d, 0xfa, 0x2e, 0x9a, 0xf6, 0x7c, 0x2f, 0xf6, 0xdc, 0x36, 0xd5, 0xf9, 0x55, 0xf2, 0x86, 0x9f,
+	0xd1, 0xf4, 0x9a, 0x06, 0x4b, 0xa1, 0x63, 0xd4, 0xb5, 0x3f, 0x80, 0x8a, 0xf4, 0xf8, 0xab, 0x14,
+	0x8e, 0x11, 0x9f, 0x06, 0x99, 0x64, 0x52, 0x6b, 0x5c, 0x16, 0xda, 0x86, 0x51, 0xa2, 0x17, 0x3e,
+	0x16, 0x34, 0x5a, 0xaa, 0x3c, 0x2a, 0x45, 0x53, 0x00, 0xa9, 0x27, 0xe0, 0xd0, 0x57, 0x83, 0x50,
+	0xd9, 0x71, 0xbd, 0x76, 0x2f, 0xc4, 0x8a, 0xca, 0x84, 0x49, 0xe5, 0x76, 0x02, 0x0e, 0x7d, 0x35,
+	0xec, 0x1d, 0x34, 0xcd, 0xcb, 0x98, 0xab, 0xc1, 0xe4, 0x29, 0x7b, 0x49, 0x5d, 0x4a, 0x6e, 0x6b,
+	0x94, 0xc0, 0xa0, 0x6b, 0xf7, 0xd0, 0xbc, 0xe7, 0x37, 0x02, 0x9f, 0x3c, 0x99, 0x79, 0xfb, 0x58,
+	0xc5, 0x95, 0x39, 0x0d, 0xb3, 0x4b, 0xc4, 0x06, 0x7b, 0x2d, 0x49, 0x0e, 0xfa, 0x39, 0x10, 0xb3,
+	0x9e, 0x4b, 0x8d, 0xc0, 0x8f, 0x68, 0xd0, 0xd1, 0x7d, 0x7c, 0x2b, 0x0c, 0x83, 0x90, 0xf1, 0x2e,
+	0x9f, 0x92, 0x37, 0x55, 0x35, 0xaf, 0xa4, 0x91, 0x84, 0x74, 0x4e, 0xf6, 0x27, 0x49, 0x80, 0x80,
+	0x60, 0xdf, 0x6b, 0xe2, 0x30, 0x1b, 0x2f, 0x41, 0xb6, 0x8e, 0x6a, 0x9c, 0xa6, 0xda, 0x7a, 0x44,
+	0x09, 0x48, 0x7e, 0x24, 0x3c, 0xff, 0x65, 0xad, 0x55, 0x7c, 0x5a, 0xb1, 0x11, 0x98, 0x3a, 0xe5,
+	0x08, 0xd0, 0xe7, 0x87, 0x95, 0x74, 0xa2, 0x30, 0x88, 0x9b, 0xf3, 0xe7, 0x53, 0x68, 0xc6, 0x6c,
+	0xb8, 0xfd, 0x93, 0x08, 0x75, 0xc3, 0xa0, 0x83, 0xe3, 0x5d, 0x2c, 0x03, 0x13, 0x6c, 0x8e, 0x1b,
+	0x3b, 0x41, 0xd0, 0x13, 0xa6, 0xd6, 0x64, 0xe3, 0x52, 0xa5, 0xa0, 0x71, 0x24, 0x0e, 0xdf, 0x7b,
+	0x4c, 0x1e, 0xe1, 0xe2, 0xd9, 0x4b, 0x99, 0x08, 0x93, 0x9c, 0x33, 0x75, 0x9e, 0xe4, 0x45, 0x20,
+	0x18, 0xd9, 0xdb, 0x28, 0xff, 0x08, 0x6f, 0x67, 0x13, 0x72, 0xf2, 0x01, 0xe6, 0xd7, 0xbc, 0xe5,
+	0x49, 0x12, 0x2a, 0xf0, 0x01, 0xde, 0x06, 0x42, 0x9c, 0xf4, 0xab, 0xc9, 0x6c, 0x66, 0x2a, 0x85,
+	0x2c, 0xfa, 0x65, 0x18, 0xe0, 0xb0, 0x7e, 0xf1, 0x22, 0x10, 0x8c, 0xec, 0x4f, 0xa2, 0xf2, 0x23,
+	0x77, 0x1f, 0xef, 0x84, 0x81, 0x1f, 0x57, 0x8a, 0x59, 0xb8, 0x66, 0x3f, 0x10, 0xe4, 0x38, 0x5f,
+	0x2a, 0x68, 0xc8, 0x42, 0x50, 0xec, 0xec, 0x7d, 0x54, 0xf2, 0x49, 0xbc, 0xb0, 0xb6, 0xd7, 0xc8,
+	0xc6, 0x15, 0x7a, 0x93, 0x53, 0xe3, 0x9c, 0xe9, 0x09, 0x2c, 0xca, 0x40, 0xf2, 0x22, 0xdf, 0xf2,
+	0x61, 0xb0, 0x9d, 0x8d, 0x29, 0xcf, 0xdd, 0xc0, 0xf8, 0x96, 0x77, 0x83, 0x6d, 0x20, 0xc4, 0xc9,
+	0x1a, 0x69, 0x48, 0xe3, 0xf2, 0x4a, 0x29, 0x8b, 0x35, 0x92, 0x34, 0x56, 0x67, 0x6b, 0x44, 0x95,
+	0x82, 0xc6, 0x91, 0x8c, 0x6d, 0x8b, 0xab, 0xaa, 0x2b, 0xe5, 0x2c, 0xc6, 0xd6, 0x54, 0x7c, 0xb3,
+	0xb1, 0x15, 0x65, 0x20, 0x79, 0x11, 0xbe, 0x1e, 0xd7, 0xfb, 0x66, 0xb3, 0x69, 0x9a, 0x5a, 0x64,
+	0xc6, 0x57, 0x94, 0x81, 0xe4, 0x45, 0xc6, 0x3b, 0xda, 0x3b, 0x78, 0xe4, 0xb6, 0xf7, 0x88, 0x63,
+	0xed, 0x54, 0x26, 0xa9, 0xdc, 0xf6, 0x0e, 0x1e, 0x30, 0x7a, 0xfa, 0x78, 0xab, 0x52, 0xd0, 0x38,
+	0xda, 0x7f, 0xdb, 0x92, 0x8e, 0xec, 0xd3, 0x59, 0x18, 0xcf, 0x99, 0x5b, 0x2e, 0xf7, 0x6b, 0x67,
+	0x22, 0xeb, 0x0f, 0x4a, 0x5f, 0x11, 0x5a, 0xf8, 0x85, 0x3f, 0x5e, 0xac, 0x60, 0xbf, 0x11, 0x34,
+	0x3d, 0xbf, 0x75, 0xe3, 0x61, 0x14, 0xf8, 0x4b, 0xe0, 0x3e, 0x12, 0xb7, 0x05, 0xde, 0x26, 0x92,
+	0x93, 0x49, 0x23, 0x71, 0x9c, 0xc8, 0x39, 0xad, 0x8b, 0x9c, 0x7f, 0x36, 0x81, 0xa6, 0xf5, 0x04,
+	0x2e, 0x27, 0x90, 0x03, 0xe5, 0xdd, 0x27, 0x37, 0xca, 0xdd, 0x87, 0xdc, 0xbd, 0xb5, 0xe7, 0x4d,
+	0xa1, 0xf7, 0x5b, 0xcb, 0x4c, 0xf4, 0x57, 0x77, 0x6f, 0xad, 0x30, 0x02, 0x83, 0xe9, 0x08, 0x16,
+	0x4f, 0x44, 0x80, 0x66, 0x22, 0x66, 0xd1, 0x14, 0xa0, 0x0d, 0xa1, 0xf1, 0x26, 0x42, 0x2a, 0xd3,
+	0x08, 0x7f, 0xf6, 0x96, 0x92, 0xb9, 0x96, 0x01, 0x45, 0xc3, 0x22, 0xc6, 0x24, 0x44, 0x08, 0xc3,
+	0x4d, 0x1e, 0x18, 0x50, 0x2a, 0x38, 0x6e, 0xd3, 0x52, 0xe0, 0x50, 0x62, 0xf4, 0xa4, 0x8b, 0x4e,
+	0x3c, 0xde, 0xdf, 0x82, 0x92, 0x97, 0x15, 0x0c, 0x0c, 0x4c, 0xd2, 0x74, 0x1c, 0x86, 0x41, 0x58,
+	0x29, 0x9b, 0x4d, 0xa7, 0xe2, 0x0f, 0x30, 0x18, 0x55, 0xb8, 0x25, 0x24, 0x23, 0xba, 0xa6, 0x8b,
+	0x9a, 0xc2, 0x2d, 0x01, 0x87, 0xbe, 0x1a, 0xa4, 0x33, 0xfc, 0xc5, 0x7e, 0x8a, 0x39, 0x79, 0x0d,
+	0x78, 0x6b, 0xff, 0x9c, 0x7e, 0xeb, 0xcb, 0x70, 0x0d, 0xb1, 0x59, 0x3b, 0xc2, 0xb5, 0xef, 0x2e,
+	0xb2, 0xfb, 0x85, 0x21, 0xee, 0x69, 0x2b, 0xf5, 0x6e, 0xfd, 0x72, 0x14, 0xa4, 0xd4, 0x1a, 0xef,
+	0xb2, 0xf7, 0x33, 0x16, 0x9a, 0x31, 0x8f, 0xb4, 0xac, 0x1f, 0xd1, 0xec, 0xb7, 0xa8, 0x98, 0x10,
+	0x79, 0xaa, 0xa3, 0x99, 0xd2, 0xe2, 0x41, 0xc8, 0xe8, 0x0f, 0xce, 0xdf, 0x9f, 0x40, 0x17, 0x37,
+	0x5b, 0x9e, 0x9f, 0x0c, 0xd0, 0x9f, 0x96, 0x8d, 0xd3, 0x1a, 0x39, 0x1b, 0xa7, 0x8c, 0xe2, 0xc0,
+	0x73, 0x5d, 0xa6, 0x47, 0x71, 0xe0, 0x40, 0x30, 0x71, 0xed, 0x3f, 0xb2, 0xd0, 0xd3, 0x6e, 0x93,
+	0xdd, 0x8a, 0xdc, 0x36, 0x2f, 0xad, 0x6a, 0xa9, 0xf1, 0xd8, 0x2e, 0x12, 0x8d, 0x29, 0x59, 0xf4,
+	0x77, 0x7e, 0xa9, 0x3a, 0x84, 0x2b, 0x9b, 0x65, 0x3f, 0xc0, 0x7b, 0xf0, 0xf4, 0x30, 0x54, 0x18,
+	0xda, 0x7c, 0xfb, 0xff, 0x47, 0xb3, 0x46, 0x87, 0xf9, 0xb3, 0x44, 0x99, 0x3d, 0x5f, 0xd5, 0x4d,
+	0x10, 0x24, 0x71, 0xed, 0xdf, 0xb7, 0x50, 0x85, 0xe9, 0xc0, 0x53, 0x86, 0x86, 0xd9, 0x06, 0x04,
+	0xd9, 0x0f, 0xcd, 0xca, 0x00, 0x8e, 0x6c, 0x58, 0x94, 0x52, 0x7c, 0x00, 0x1a, 0x0c, 0x6c, 0xf2,
+	0x95, 0x7b, 0xe8, 0xcd, 0xc7, 0x8e, 0xfb, 0x48, 0x29, 0x07, 0x5f, 0x42, 0x57, 0x87, 0xb6, 0x76,
+	0xa4, 0x15, 0xfb, 0x75, 0x0b, 0x4d, 0xeb, 0x81, 0xc6, 0x89, 0x12, 0x34, 0x0e, 0xf6, 0xb0, 0x7f,
+	0x3f, 0x14, 0x96, 0xfb, 0x72, 0xe7, 0xd9, 0xa2, 0xe5, 0xb0, 0x0e, 0x12, 0x83, 0x60, 0x37, 0xda,
+	0x1e, 0xf6, 0xe3, 0xb5, 0x66, 0x25, 0x67, 0x62, 0xaf, 0xb0, 0xf2, 0x55, 0x90, 0x18, 0xcc, 0xe4,
+	0x95, 0xfc, 0xcf, 0x6c, 0xc7, 0xb9, 0xb6, 0x44, 0x33, 0x79, 0x55, 0x30, 0x30, 0x30, 0xc9, 0x0b,
+	0x1c, 0x57, 0xc6, 0x17, 0xd4, 0x0b, 0x5c, 0x42, 0x79, 0xfe, 0x55, 0x0b, 0x95, 0xd9, 0x63, 0x12,
+	0x31, 0x95, 0x30, 0x6d, 0xed, 0x13, 0xfa, 0xa5, 0x6a, 0x6d, 0x2d, 0xcd, 0xd6, 0xfe, 0x3a, 0xf7,
+	0x84, 0x49, 0xb8, 0xb5, 0xa4, 0x38, 0xbe, 0xe4, 0x87, 0x3d, 0x75, 0x4b, 0x3b, 0x30, 0x7e, 0x1e,
+	0x2b, 0x93, 0x79, 0x01, 0x00, 0x85, 0xe3, 0xfc, 0x8a, 0x85, 0x66, 0x68, 0x00, 0x2c, 0xa5, 0x2a,
+	0x79, 0xb7, 0x34, 0xcd, 0x34, 0x7d, 0x72, 0xb8, 0x69, 0xe6, 0x6b, 0x87, 0x8b, 0x53, 0xb4, 0x46,
+	0xc2, 0x52, 0xf3, 0x23, 0x5c, 0xbf, 0x4a, 0x0d, 0x48, 0x73, 0x23, 0xab, 0xff, 0x54, 0x33, 0x05,
+	0x11, 0x50, 0xf4, 0x9c, 0x4f, 0xa3, 0x69, 0x3d, 0xb6, 0x01, 0x79, 0x12, 0xeb, 0x92, 0xe4, 0x2b,
+	0x46, 0x0c, 0x1c, 0xf9, 0x24, 0x56, 0x53, 0x20, 0xd0, 0xf1, 0x68, 0xb5, 0x40, 0x55, 0x4b, 0xbc,
+	0xa4, 0xd5, 0x02, 0xbd, 0x9a, 0xfa, 0xe1, 0xf8, 0x08, 0xa9, 0x40, 0x49, 0x27, 0xd2, 0xeb, 0x4d,
+	0xb0, 0x57, 0x2a, 0x26, 0x1d, 0xd2, 0x40, 0x7b, 0x13, 0x6c, 0x86, 0xbf, 0x76, 0x38, 0x4c, 0xfa,
+	0x64, 0xb5, 0x68, 0x36, 0xd5, 0x94, 0x98, 0x1d, 0x99, 0x67, 0x53, 0x4d, 0xe1, 0xf1, 0xfa, 0x65,
+	0x53, 0x4d, 0x6b, 0xcc, 0x5f, 0xac, 0x6c, 0xaa, 0x1f, 0x42, 0xa3, 0x26, 0x56, 0x22, 0xc2, 0xde,
+	0x23, 0x3d, 0x0a, 0x9e, 0x1c, 0x71, 0x6e, 0x94, 0xc2, 0xa1, 0x4e, 0x0b, 0x5d, 0x4c, 0x09, 0x97,
+	0x49, 0x02, 0x22, 0x30, 0x89, 0x9a, 0xd5, 0xee, 0x57, 0xc1, 0xde, 0x40, 0xf9, 0x38, 0x16, 0xca,
+	0x65, 0xb1, 0x90, 0xf3, 0x5b, 0x5b, 0xeb, 0x29, 0xfa, 0x60, 0x82, 0xe9, 0xfc, 0xab, 0x02, 0x9a,
+	0x4b, 0x2a, 0x97, 0xb2, 0xb6, 0xda, 0x22, 0xef, 0x75, 0x33, 0xae, 0x91, 0x2d, 0x23, 0xa3, 0x1c,
+	0xf0, 0x06, 0x4d, 0x2d, 0x5b, 0x83, 0x51, 0x0e, 0x09, 0xde, 0xba, 0x50, 0x57, 0x18, 0x2c, 0xd4,
+	0x91, 0xd3, 0xc6, 0xa3, 0x02, 0x6b, 0x88, 0xb9, 0x07, 0xc2, 0x9c, 0xd2, 0xd6, 0xb3, 0x72, 0x90,
+	0x18, 0xf6, 0x63, 0x34, 0xc9, 0xec, 0xbb, 0x84, 0x21, 0xdf, 0x46, 0x46, 0x4a, 0x30, 0x66, 0x42,
+	0xa6, 0x3e, 0x01, 0xfb, 0x1d, 0x81, 0x60, 0x47, 0x2e, 0x06, 0x28, 0x74, 0xfd, 0x16, 0xa6, 0x63,
+	0x9e, 0x4d, 0x0e, 0x01, 0x4d, 0xb3, 0x28, 0x29, 0x13, 0x4f, 0x0d, 0x1e, 0x82, 0x42, 0x96, 0x81,
+	0xc6, 0xd9, 0xf9, 0x79, 0x0b, 0x55, 0x06, 0x55, 0x24, 0x13, 0x85, 0x6e, 0xef, 0x15, 0xcb, 0x9c,
+	0x28, 0x74, 0xfb, 0x07, 0x06, 0x23, 0xb9, 0x42, 0xb0, 0xdf, 0x4c, 0xe6, 0x0a, 0xb9, 0xe5, 0x37,
+	0x81, 0x94, 0xdb, 0x37, 0x49, 0xb4, 0x07, 0xdc, 0x4d, 0xb8, 0xe8, 0x14, 0xc8, 0x2e, 0x9d, 0x32,
+	0xbf, 0x29, 0xae, 0xf3, 0x4e, 0x34, 0x62, 0xc2, 0x2f, 0xe7, 0x16, 0xb2, 0x89, 0x7f, 0xeb, 0xb6,
+	0xdb, 0xd8, 0x7b, 0xe0, 0xf9, 0xcd, 0xe0, 0x11, 0x3d, 0x81, 0x6e, 0xa0, 0x72, 0xc8, 0x03, 0x0f,
+	0x45, 0x7c, 0xf9, 0xc9, 0x23, 0x4c, 0x44, 0x24, 0x8a, 0x40, 0xe1, 0x10, 0x03, 0xa0, 0x49, 0xee,
+	0x27, 0x7b, 0x0e, 0xfe, 0x61, 0x7b, 0x86, 0xc1, 0xca, 0x5a, 0x36, 0x9e, 0xce, 0x83, 0x9c, 0xc3,
+	0xa2, 0x84, 0x73, 0xd8, 0x4b, 0xd9, 0xb0, 0x1b, 0xee, 0x19, 0xf6, 0xb5, 0x22, 0x9a, 0x4d, 0x44,
+	0x1d, 0x4b, 0xe4, 0x06, 0xb4, 0x5e, 0x97, 0xdc, 0x80, 0x76, 0x64, 0xe4, 0x87, 0xcc, 0xce, 0x9a,
+	0xfc, 0x2f, 0x53, 0x45, 0x66, 0x65, 0xe7, 0x5f, 0x7c, 0xe3, 0xd8, 0xf9, 0xff, 0x27, 0x0b, 0x3d,
+	0x39, 0x30, 0x76, 0x1e, 0x4d, 0x45, 0x10, 0x9a, 0x50, 0xbe, 0x5f, 0x64, 0x1c, 0x0f, 0x56, 0x1a,
+	0xb7, 0x24, 0x00, 0x90, 0x64, 0x6f, 0x3f, 0x8f, 0xa6, 0xe9, 0xde, 0x4c, 0x76, 0x4e, 0xb2, 0xf7,
+	0xb2, 0xc7, 0x70, 0xfa, 0x2c, 0x5a, 0xd7, 0xca, 0xc1, 0xc0, 0x72, 0xbe, 0x62, 0xa1, 0xca, 0xa0,
+	0x38, 0xd4, 0x27, 0x10, 0xa8, 0x7f, 0x24, 0xe1, 0x5f, 0xb7, 0xd8, 0xe7, 0x5f, 0x97, 0x50, 0x91,
+	0x72, 0x74, 0x5d, 0x3b, 0x99, 0x3f, 0xc6, 0x7d, 0xec, 0x1b, 0x79, 0x34, 0xc7, 0x9b, 0xa8, 0xee,
+	0x42, 0xef, 0x31, 0xbc, 0x02, 0x7f, 0x20, 0xe1, 0x15, 0xb8, 0x90, 0xc4, 0xff, 0x4b, 0x97, 0xc0,
+	0x37, 0x96, 0x4b, 0xe0, 0x17, 0x8a, 0xe8, 0x52, 0x6a, 0xf4, 0x65, 0x12, 0xd9, 0xb6, 0xef, 0xa4,
+	0x78, 0x90, 0x71, 0x98, 0x67, 0x19, 0x06, 0xe3, 0x6c, 0xfd, 0xe8, 0x7e, 0x51, 0xf7, 0x5f, 0x63,
+	0xbb, 0xff, 0xce, 0x19, 0x04, 0xac, 0x1e, 0xd5, 0x95, 0x4d, 0x9d, 0x48, 0x85, 0x73, 0x38, 0x91,
+	0xfe, 0x02, 0x6c, 0xf5, 0x5f, 0xc8, 0xa3, 0x67, 0x4f, 0x3a, 0xb2, 0x6f, 0x50, 0xdf, 0xef, 0xc8,
+	0xf0, 0xfd, 0x3e, 0x27, 0xd1, 0xe6, 0x4c, 0xdc, 0xc0, 0xff, 0x5e, 0x01, 0x3d, 0xd9, 0xf7, 0x31,
+	0xc4, 0x98, 0x9d, 0x48, 0xc5, 0x33, 0x49, 0x44, 0x5f, 0x91, 0x61, 0x52, 0x9d, 0x0d, 0x93, 0x75,
+	0x56, 0x4c, 0x62, 0x3b, 0xab, 0x30, 0x99, 0xbc, 0x10, 0x44, 0x25, 0xfb, 0x59, 0x62, 0x2b, 0x47,
+	0xa1, 0xc2, 0xdb, 0x95, 0xdb, 0xbf, 0xb1, 0x32, 0x90, 0x50, 0xfb, 0x15, 0xed, 0xae, 0x50, 0x38,
+	0xab, 0x68, 0xb0, 0xc3, 0xde, 0x77, 0x3e, 0x86, 0x4a, 0x91, 0x48, 0x83, 0xc8, 0x96, 0xd3, 0x73,
+	0x27, 0x74, 0xa2, 0x26, 0x7a, 0x18, 0x91, 0x13, 0x91, 0xf5, 0x4f, 0xfc, 0x02, 0x49, 0x92, 0x28,
+	0x57, 0xb9, 0x0a, 0x84, 0x3d, 0xf6, 0xa1, 0x7e, 0xf5, 0x87, 0x1d, 0xa3, 0xc9, 0x88, 0xeb, 0xec,
+	0x26, 0xb3, 0x10, 0x7f, 0xa4, 0xd7, 0x21, 0x23, 0xca, 0x2e, 0xfc, 0xfc, 0x07, 0x08, 0x56, 0xce,
+	0x1f, 0x58, 0x68, 0x8a, 0xcf, 0x91, 0x3b, 0x41, 0xb0, 0x67, 0x7c, 0x09, 0xeb, 0xf5, 0xf8, 0x12,
+	0xe3, 0x7a, 0x21, 0xfc, 0x9b, 0x3c, 0x9a, 0xd7, 0x3a, 0xc4, 0xc5, 0xaf, 0xe7, 0x0c, 0x19, 0x67,
+	0x31, 0x21, 0xe3, 0xcc, 0x6a, 0x15, 0x34, 0xf1, 0x86, 0x24, 0xd9, 0x34, 0x53, 0xaf, 0xf2, 0x75,
+	0xa0, 0x92, 0x6c, 0x9a, 0x60, 0x48, 0xe2, 0x93, 0x73, 0xfc, 0x61, 0xb0, 0xad, 0xb9, 0x25, 0xc8,
+	0x73, 0xfc, 0x2e, 0x2b, 0x06, 0x01, 0xb7, 0x7f, 0x44, 0x3c, 0x90, 0x17, 0x8c, 0x40, 0xec, 0xf2,
+	0x81, 0x7c, 0x4e, 0x6b, 0xe4, 0x20, 0xfb, 0xe0, 0xe2, 0x28, 0xf6, 0xc1, 0x13, 0x67, 0x66, 0x1f,
+	0x3c, 0x99, 0xa5, 0x7d, 0xb0, 0xf3, 0x6a, 0x1e, 0x4d, 0x6b, 0x7d, 0x8f, 0xec, 0x03, 0x62, 0x6b,
+	0x86, 0x79, 0x51, 0x36, 0xa9, 0x67, 0x35, 0xfa, 0xc2, 0xcc, 0x4c, 0x30, 0x00, 0x8d, 0x19, 0xb9,
+	0x7c, 0x5f, 0x30, 0x72, 0x2f, 0x55, 0x72, 0x59, 0xb3, 0x9f, 0x27, 0xcf, 0x9b, 0x46, 0xd6, 0x27,
+	0x30, 0x59, 0x92, 0x34, 0x01, 0x81, 0x4f, 0x55, 0xa4, 0x95, 0x7c, 0xd6, 0xdc, 0xe9, 0x2e, 0x71,
+	0x8f, 0x51, 0x07, 0xc1, 0xc6, 0xf9, 0x4d, 0x0b, 0x3d, 0xc1, 0xb1, 0xd6, 0xbd, 0x1d, 0xdc, 0x38,
+	0x68, 0xb4, 0xb1, 0x96, 0x89, 0x36, 0xb1, 0x48, 0xac, 0x11, 0x17, 0xc9, 0x47, 0xf4, 0x59, 0x39,
+	0xee, 0xab, 0x4a, 0x62, 0x66, 0x3a, 0xdf, 0x54, 0x1b, 0xdc, 0x39, 0x84, 0xcb, 0x78, 0x68, 0x86,
+	0xcb, 0xb8, 0x95, 0xc9, 0x87, 0x19, 0x10, 0x2b, 0xe3, 0xa1, 0x5c, 0x16, 0xf4, 0xa9, 0x8a, 0x04,
+	0xd3, 0x97, 0x32, 0xb6, 0x35, 0x4e, 0x30, 0x7d, 0x21, 0x85, 0x2b, 0xf9, 0x9b, 0x5c, 0xe1, 0x2f,
+	0x7c, 0x1f, 0x04, 0xad, 0xfc, 0x8e, 0x85, 0xe6, 0x0d, 0xcc, 0x73, 0x98, 0x32, 0x5d, 0x73, 0xca,
+	0xbc, 0x94, 0x61, 0x3f, 0x07, 0x4c, 0x9c, 0xff, 0x95, 0xec, 0xe5, 0x09, 0x5d, 0xa4, 0x6f, 0xa6,
+	0x84, 0xb2, 0x96, 0x9f, 0x70, 0x40, 0x38, 0xeb, 0x93, 0x2b, 0x27, 0xec, 0x6d, 0x34, 0x8f, 0xfd,
+	0x9d, 0x20, 0x6c, 0x50, 0x0d, 0x57, 0xb5, 0xc1, 0x95, 0x6d, 0xba, 0x62, 0x61, 0xfe, 0x56, 0x12,
+	0x81, 0xc4, 0x2d, 0x62, 0x9d, 0xe8, 0x03, 0x41, 0x3f, 0x39, 0xe7, 0x5f, 0xe7, 0x12, 0x5d, 0xa7,
+	0x7a, 0x6e, 0x5d, 0xfa, 0xb3, 0xb2, 0x97, 0xfe, 0x52, 0x3b, 0x96, 0xcb, 0xb4, 0x63, 0x76, 0x8c,
+	0x8a, 0x61, 0xaf, 0x2d, 0x2f, 0xc7, 0x59, 0xae, 0x16, 0xea, 0x18, 0x2d, 0x67, 0x12, 0xf9, 0x15,
+	0x01, 0x63, 0xe6, 0xfc, 0xae, 0x85, 0x2e, 0x8a, 0x63, 0x12, 0x93, 0xb7, 0x4b, 0xb7, 0xb1, 0x17,
+	0xec, 0xec, 0x18, 0xd7, 0x7d, 0xeb, 0x14, 0xd7, 0x7d, 0x87, 0x18, 0xbb, 0xc9, 0x9c, 0xe4, 0x5c,
+	0x5e, 0xbe, 0x4d, 0x4b, 0x80, 0x43, 0xec, 0x17, 0xd1, 0x54, 0xc7, 0x7d, 0x2c, 0x48, 0xf0, 0xd9,
+	0xf5, 0x16, 0xf1, 0x76, 0xba, 0xe1, 0x3e, 0x1e, 0xc2, 0x49, 0xaf, 0xe9, 0xfc, 0x77, 0x0b, 0xd9,
+	0x7a, 0x27, 0xf8, 0x06, 0x27, 0x5d, 0x61, 0xac, 0xc1, 0xae, 0x30, 0xe4, 0x05, 0x6c, 0x9b, 0xf5,
+	0xb9, 0x92, 0xcb, 0x42, 0x5a, 0x4e, 0x19, 0x4c, 0x76, 0x24, 0xf3, 0x1f, 0x20, 0xd8, 0x91, 0xcc,
+	0x3d, 0x21, 0xc1, 0xba, 0xc7, 0xb4, 0xe1, 0x65, 0x9a, 0xb1, 0x7c, 0x12, 0x58, 0xd1, 0x6b, 0x87,
+	0x8b, 0xa2, 0x4b, 0xec, 0x24, 0x67, 0xda, 0x25, 0x51, 0xc3, 0xf9, 0xed, 0x9c, 0xd9, 0xe5, 0xec,
+	0xce, 0xf2, 0xb7, 0xa3, 0x92, 0x1b, 0x93, 0x9b, 0x78, 0x1c, 0x09, 0xb5, 0xa9, 0xd4, 0x9f, 0xf0,
+	0x72, 0x90, 0x18, 0xf6, 0x03, 0x34, 0xdb, 0x76, 0x23, 0xbd, 0x8d, 0xfc, 0x3b, 0xbe, 0x43, 0x30,
+	0x5c, 0x37, 0xc1, 0x03, 0x3a, 0x96, 0xa4, 0x42, 0xe2, 0xa9, 0xf8, 0xf8, 0x31, 0xeb, 0xdc, 0xe9,
+	0xe3, 0xa9, 0x6c, 0x2a, 0x12, 0xa0, 0xd3, 0x73, 0xbe, 0x7c, 0x41, 0x0a, 0x15, 0x74, 0x03, 0xd1,
+	0x6f, 0xba, 0xd6, 0xd0, 0x9b, 0xae, 0xbe, 0xd5, 0xe4, 0xb2, 0xdf, 0x6a, 0x3e, 0x80, 0x4a, 0x42,
+	0x0d, 0xc2, 0x65, 0xc3, 0x67, 0x34, 0xf2, 0x4b, 0x8d, 0x20, 0xc4, 0x84, 0x98, 0xf6, 0x19, 0xe9,
+	0x59, 0xa8, 0x0c, 0x90, 0x78, 0x29, 0x48, 0x32, 0xf6, 0x27, 0xd1, 0xd4, 0xa3, 0x20, 0xdc, 0x6b,
+	0x07, 0x2e, 0x09, 0x90, 0x52, 0x41, 0x59, 0x58, 0xc8, 0x4b, 0x23, 0x22, 0x36, 0xce, 0x0f, 0x14,
+	0x7d, 0xd0, 0x99, 0x91, 0x09, 0xd9, 0xf1, 0x7c, 0xc0, 0x6e, 0x53, 0x06, 0x89, 0x2a, 0xb0, 0x8c,
+	0xe3, 0x62, 0x7e, 0x6c, 0x98, 0x60, 0x48, 0xe2, 0xd3, 0x77, 0xf8, 0xd0, 0x78, 0xda, 0xe4, 0x69,
+	0x85, 0x6b, 0xe3, 0xaf, 0x54, 0xf3, 0xb9, 0x94, 0x85, 0xb3, 0x30, 0xcb, 0x21, 0xc1, 0x9b, 0x64,
+	0x18, 0x8d, 0x78, 0xfc, 0xd7, 0x6c, 0x5c, 0x2b, 0x12, 0x61, 0x89, 0xd5, 0xa7, 0x14, 0x25, 0x20,
+	0x19, 0x92, 0xf0, 0x2f, 0xe2, 0x7f, 0xf2, 0x29, 0x33, 0x4a, 0xa4, 0x2b, 0x09, 0xb2, 0x8f, 0xa9,
+	0x15, 0x80, 0xce, 0x8e, 0xe4, 0x64, 0x11, 0x2f, 0xc5, 0x77, 0xbc, 0x28, 0x0e, 0xc2, 0x03, 0xe6,
+	0xbb, 0x34, 0xa1, 0x72, 0xb2, 0x40, 0x0a, 0x1c, 0x52, 0x6b, 0x11, 0x4d, 0x3a, 0xcd, 0x66, 0xc8,
+	0xec, 0xa1, 0x35, 0x13, 0x62, 0x2a, 0x0c, 0x93, 0x6c, 0x09, 0xf4, 0xef, 0xb0, 0x08, 0x6c, 0xa5,
+	0x31, 0x22, 0xb0, 0xd5, 0xd1, 0xa5, 0x24, 0x88, 0xdd, 0xc8, 0xa6, 0x4d, 0x85, 0x5d, 0x2d, 0x0d,
+	0x09, 0xd2, 0xeb, 0x92, 0xeb, 0x79, 0x88, 0xe9, 0xd5, 0xa5, 0x2a, 0x9c, 0xda, 0x46, 0xbe, 0x9e,
+	0x83, 0x20, 0x00, 0x8a, 0x16, 0x99, 0x75, 0xae, 0x99, 0x81, 0x3c, 0x3b, 0xbd, 0xa6, 0x9c, 0x79,
+	0x83, 0x92, 0x60, 0x7d, 0x8a, 0xc6, 0x9e, 0x62, 0x11, 0xae, 0x49, 0xe2, 0xec, 0xfc, 0xf8, 0xfb,
+	0x87, 0x8c, 0x98, 0x6d, 0x44, 0x9c, 0xe2, 0x2c, 0x40, 0x63, 0x47, 0x12, 0x6b, 0xee, 0x12, 0xa5,
+	0x41, 0x36, 0xb9, 0x87, 0x74, 0x35, 0x04, 0xb3, 0x44, 0xa2, 0xff, 0x02, 0xe3, 0x41, 0xbc, 0x15,
+	0xa6, 0x42, 0x25, 0x42, 0x54, 0xe6, 0xb2, 0xda, 0x68, 0x4c, 0xd1, 0x84, 0xad, 0x33, 0xad, 0x00,
+	0x74, 0xae, 0xce, 0xe1, 0x82, 0xbc, 0xab, 0xf1, 0x73, 0xfd, 0x19, 0x54, 0xa4, 0x49, 0x7c, 0x78,
+	0x98, 0x69, 0x29, 0xca, 0xb0, 0xc9, 0xc8, 0x60, 0x24, 0x17, 0xdd, 0x6c, 0xd7, 0xb0, 0x92, 0x14,
+	0x57, 0x92, 0x31, 0x2d, 0x96, 0x4c, 0xd3, 0x4b, 0x4d, 0x96, 0x30, 0x99, 0x41, 0x92, 0x3b, 0xd9,
+	0xfd, 0x79, 0x08, 0x84, 0x36, 0x0e, 0x29, 0x36, 0x57, 0xe3, 0x4b, 0x12, 0x2b, 0x26, 0x18, 0x92,
+	0xf8, 0x64, 0x45, 0xb9, 0xcc, 0x96, 0xec, 0x54, 0x52, 0x00, 0x5d, 0x51, 0x55, 0x41, 0x00, 0x14,
+	0x2d, 0x12, 0x46, 0x9c, 0xe7, 0xf5, 0xad, 0x05, 0x4d, 0x2a, 0x29, 0x15, 0xcd, 0x30, 0xe2, 0x2b,
+	0x06, 0x14, 0x12, 0xd8, 0xb4, 0x6f, 0x2a, 0x79, 0x32, 0x25, 0x30, 0x61, 0x8a, 0x5a, 0x2b, 0x26,
+	0x18, 0x92, 0xf8, 0x44, 0xd4, 0x92, 0x42, 0xc7, 0xa4, 0x29, 0x6a, 0xa5, 0x08, 0x1e, 0x55, 0x34,
+	0xdb, 0xa3, 0xef, 0x9f, 0x4d, 0x01, 0xe4, 0xfb, 0x9f, 0x64, 0x78, 0xdf, 0x04, 0x43, 0x12, 0x9f,
+	0xd8, 0xe4, 0x87, 0xe4, 0x68, 0x95, 0x04, 0x98, 0xa3, 0x88, 0xb4, 0xc9, 0x07, 0x1d, 0x08, 0x26,
+	0x2e, 0x49, 0x9e, 0xac, 0xf2, 0x00, 0x0a, 0x02, 0xcc, 0x73, 0x44, 0xa6, 0x62, 0xaa, 0x26, 0x11,
+	0xa0, 0xbf, 0x8e, 0xfd, 0x63, 0x68, 0x4e, 0x1b, 0x89, 0x35, 0xbf, 0x89, 0x1f, 0xf3, 0x5c, 0x6d,
+	0x0b, 0xd4, 0xfb, 0x24, 0x01, 0x83, 0x3e, 0x6c, 0xfb, 0xbd, 0x68, 0xa6, 0x11, 0xb4, 0xdb, 0xf4,
+	0x4c, 0xa1, 0x96, 0x84, 0x3c, 0x29, 0x1b, 0x4b, 0x5f, 0x67, 0x40, 0x20, 0x81, 0x49, 0x1c, 0x41,
+	0x82, 0x6d, 0x6a, 0xa3, 0xd8, 0x7c, 0x11, 0xfb, 0x98, 0x5f, 0x3e, 0x2e, 0x98, 0x01, 0x58, 0xee,
+	0xf5, 0x61, 0x40, 0x4a, 0x2d, 0x9a, 0xc9, 0x49, 0x8b, 0xca, 0x37, 0x93, 0x45, 0xe6, 0xe4, 0xe4,
+	0x6b, 0xfd, 0xb1, 0x21, 0xf9, 0x42, 0x34, 0xc1, 0x0c, 0xeb, 0xb3, 0xd9, 0x21, 0xf5, 0x04, 0xe6,
+	0xea, 0x4c, 0x66, 0xa5, 0xc0, 0x39, 0xd9, 0x3f, 0x89, 0xca, 0xdb, 0x22, 0x4f, 0x7d, 0x65, 0x2e,
+	0x0b, 0x29, 0x48, 0xa6, 0xbd, 0xe7, 0x9c, 0xa5, 0x4e, 0x50, 0x02, 0x40, 0xb1, 0xb4, 0xdf, 0x8a,
+	0xa6, 0xee, 0xd4, 0xaa, 0x72, 0x16, 0xce, 0xd3, 0xaf, 0x5f, 0x20, 0x55, 0x40, 0x07, 0x90, 0x15,
+	0x26, 0x85, 0x75, 0xdb, 0xb4, 0xbd, 0x4f, 0x91, 0xbd, 0x09, 0x36, 0xf5, 0xb4, 0x80, 0x7a, 0xe5,
+	0x62, 0x02, 0x9b, 0x97, 0x83, 0xc4, 0x20, 0x37, 0x14, 0x7e, 0x3e, 0xd3, 0xbd, 0x69, 0xe1, 0x74,
+	0x37, 0x14, 0x50, 0x24, 0x40, 0xa7, 0x47, 0xad, 0xc0, 0xa9, 0xc2, 0x18, 0xdf, 0xee, 0xb5, 0xdb,
+	0x95, 0x4b, 0x74, 0xdf, 0x54, 0x56, 0xe0, 0x0a, 0x04, 0x3a, 0x9e, 0xfd, 0x9c, 0x78, 0x84, 0x78,
+	0xc2, 0xb0, 0xa6, 0x95, 0x8f, 0x10, 0x52, 0xe3, 0x38, 0xe0, 0x01, 0xe2, 0xf2, 0xb1, 0x3a, 0x9e,
+	0x2b, 0x42, 0xbe, 0xef, 0x5f, 0x24, 0x95, 0x8a, 0xa1, 0x2a, 0xb8, 0xf2, 0x60, 0x20, 0x26, 0x0c,
+	0xa1, 0x42, 0x5c, 0x79, 0xdd, 0xf6, 0x76, 0xe5, 0xc9, 0x2c, 0x2e, 0x2a, 0xd5, 0xf5, 0x65, 0x3e,
+	0xa3, 0xa8, 0x2b, 0x6f, 0x75, 0x7d, 0x19, 0x08, 0x71, 0xdb, 0x43, 0x05, 0xb7, 0xbd, 0x1d, 0x55,
+	0xae, 0x5c, 0xcf, 0x67, 0xc9, 0x44, 0x3d, 0x0d, 0xaf, 0x2f, 0x93, 0xa7, 0xe1, 0xf6, 0x76, 0x44,
+	0x34, 0x3b, 0x4c, 0x82, 0x79, 0x2a, 0x43, 0xcd, 0x8e, 0x7a, 0x1a, 0x53, 0xd2, 0x80, 0x21, 0xca,
+	0x7c, 0x02, 0x15, 0xa9, 0x4c, 0x51, 0x79, 0x3a, 0x6b, 0x19, 0x86, 0xb3, 0xa5, 0xd2, 0x13, 0x2d,
+	0x00, 0xc6, 0x89, 0x26, 0x38, 0xd4, 0xf6, 0xea, 0xca, 0xd5, 0x0c, 0xf5, 0xbe, 0x84, 0x20, 0x67,
+	0x4c, 0xd7, 0x8d, 0x76, 0x4a, 0x80, 0xce, 0x94, 0x04, 0x99, 0x28, 0xb7, 0xc5, 0x13, 0x47, 0xe5,
+	0x1a, 0x6d, 0xc2, 0x56, 0x26, 0x4d, 0x48, 0x3c, 0x9c, 0x30, 0xd9, 0x42, 0x16, 0x82, 0xe2, 0x6a,
+	0xff, 0x1d, 0x8b, 0x44, 0x91, 0x34, 0xd3, 0xcc, 0x54, 0x16, 0xaf, 0x5b, 0xe3, 0x07, 0x6d, 0x1e,
+	0x94, 0xce, 0x87, 0xc7, 0x4a, 0x65, 0xe1, 0x29, 0x4d, 0x18, 0xf4, 0x35, 0xc5, 0xf9, 0x27, 0x4a,
+	0x89, 0xaa, 0xc6, 0x95, 0xa5, 0xa5, 0xc3, 0xdd, 0xa4, 0xfe, 0x98, 0x0e, 0x31, 0x85, 0x64, 0xf1,
+	0x9e, 0xfa, 0x91, 0xf1, 0x02, 0x1c, 0x0d, 0x7d, 0x2a, 0x22, 0x8f, 0x98, 0xb1, 0xd7, 0xc1, 0xcd,
+	0x7b, 0xbd, 0xf8, 0xf4, 0x41, 0x8e, 0xb6, 0x24, 0x05, 0xd0, 0xa8, 0x39, 0x9f, 0xc9, 0x49, 0x4b,
+	0xde, 0xba, 0xba, 0x8e, 0x6b, 0xc7, 0xa0, 0x95, 0xc5, 0x6c, 0xd7, 0x8e, 0x41, 0x3d, 0x9f, 0x68,
+	0xea, 0x21, 0xd8, 0x95, 0x07, 0x7f, 0x26, 0xf9, 0x35, 0x12, 0x79, 0x4c, 0x51, 0xff, 0xb1, 0x4f,
+	0xde, 0x1d, 0x2e, 0x7f, 0x9f, 0xe6, 0xc5, 0x22, 0x59, 0xef, 0x5e, 0xbf, 0x84, 0x58, 0xd9, 0x66,
+	0xbd, 0x1b, 0x2d, 0x11, 0xd6, 0x97, 0x07, 0xf7, 0x9c, 0x87, 0xf7, 0x57, 0x0a, 0x31, 0xeb, 0x9c,
+	0x15, 0x62, 0xce, 0x3f, 0xca, 0xa1, 0xab, 0x43, 0xf7, 0xc4, 0x2c, 0x54, 0xe2, 0xc7, 0xa6, 0xf2,
+	0x1a, 0xc3, 0x58, 0xed, 0x26, 0x42, 0x2d, 0x25, 0x3f, 0x31, 0xcf, 0x1e, 0xb9, 0x0c, 0x34, 0x79,
+	0x49, 0xc3, 0x22, 0xdc, 0xc4, 0x00, 0x68, 0x17, 0x57, 0xc9, 0xad, 0xae, 0xc1, 0xc0, 0xc0, 0x74,
+	0xbe, 0x39, 0x2b, 0x4d, 0x4d, 0x13, 0x4e, 0xdf, 0x21, 0x2a, 0x7a, 0x51, 0xec, 0x05, 0x19, 0xc6,
+	0x0a, 0x36, 0x39, 0x30, 0x79, 0x81, 0x02, 0x80, 0xb1, 0x22, 0x3c, 0x7d, 0xe2, 0x67, 0x9c, 0xcd,
+	0xcb, 0x4b, 0x8a, 0xcb, 0x32, 0xe3, 0x49, 0x01, 0xc0, 0x58, 0xd9, 0x0f, 0x99, 0x6c, 0x99, 0xcf,
+	0x62, 0xb3, 0xae, 0xae, 0x2f, 0x27, 0xf8, 0x99, 0x32, 0xe6, 0x43, 0x94, 0x8f, 0x3a, 0x5e, 0xa5,
+	0x90, 0x05, 0xaf, 0xfa, 0xc6, 0x5a, 0x1a, 0xaf, 0xfa, 0xc6, 0x1a, 0x10, 0x26, 0xd4, 0x9f, 0xca,
+	0xed, 0x6c, 0xbb, 0x51, 0xe4, 0x36, 0xa5, 0x09, 0xdc, 0x98, 0xfe, 0x54, 0x55, 0x49, 0x2f, 0xc1,
+	0x9a, 0x9e, 0x93, 0x0a, 0x0a, 0x1a, 0x67, 0xfb, 0x93, 0x68, 0xd2, 0xed, 0x76, 0x37, 0x30, 0xd7,
+	0x87, 0x4c, 0xdd, 0xac, 0x8f, 0xd9, 0x08, 0x46, 0x2c, 0xd1, 0x02, 0xfa, 0xa4, 0xc6, 0x41, 0x20,
+	0x18, 0x12, 0xde, 0x71, 0xe8, 0xe2, 0x1d, 0x6f, 0xaf, 0x32, 0x99, 0x05, 0xef, 0x2d, 0x46, 0x2c,
+	0x8d, 0x37, 0x07, 0x81, 0x60, 0x48, 0x82, 0x7b, 0x5d, 0xe8, 0xb8, 0xbe, 0x2b, 0xc3, 0x4b, 0x66,
+	0x13, 0x13, 0x55, 0x0f, 0x58, 0xa9, 0x14, 0x35, 0x1b, 0x3a, 0x23, 0x30, 0xf9, 0x92, 0x2c, 0x58,
+	0x84, 0x98, 0xf7, 0x98, 0x6b, 0xa0, 0xc7, 0x4d, 0x0c, 0x4b, 0x69, 0x25, 0xc6, 0x80, 0x4a, 0x07,
+	0x0c, 0x02, 0x9c, 0x9b, 0xfd, 0xab, 0x16, 0x9a, 0x64, 0x91, 0x69, 0x88, 0x5e, 0x88, 0xf4, 0xfd,
+	0xe3, 0x99, 0x1c, 0x04, 0x26, 0x6b, 0x1e, 0x35, 0x87, 0xbb, 0xda, 0xfe, 0x90, 0x8c, 0x94, 0xc1,
+	0x4a, 0x87, 0xc6, 0xcd, 0x11, 0xad, 0x23, 0x1a, 0xa8, 0x8e, 0x2b, 0xba, 0xc4, 0x43, 0xae, 0x6b,
+	0x1a, 0xa8, 0x8d, 0x04, 0x0c, 0xfa, 0xb0, 0xc9, 0x4c, 0x6b, 0xb0, 0xdc, 0x8d, 0x95, 0xe9, 0x2c,
+	0x66, 0x5a, 0x6a, 0x22, 0x48, 0x36, 0xd3, 0x38, 0x08, 0x04, 0x43, 0x92, 0x55, 0x6d, 0x2f, 0xf0,
+	0x5b, 0xd9, 0xbc, 0x82, 0xf5, 0xc7, 0x67, 0x5d, 0x2e, 0x51, 0x87, 0xfe, 0x80, 0x38, 0x23, 0x12,
+	0x3e, 0xa4, 0xaf, 0x6d, 0x16, 0x7f, 0xb5, 0x32, 0x93, 0x45, 0x5f, 0x53, 0x83, 0xb9, 0xb2, 0xbe,
+	0x72, 0x10, 0x08, 0x86, 0x64, 0x0b, 0x6d, 0xfa, 0x42, 0xf7, 0x3f, 0xe6, 0x16, 0xda, 0x97, 0x1f,
+	0x93, 0x6d, 0xa1, 0xab, 0x9b, 0x75, 0x20, 0x4c, 0x48, 0xf4, 0xf5, 0x28, 0xf6, 0x1a, 0x7b, 0x9e,
+	0x4f, 0x7c, 0x88, 0xe7, 0xb2, 0x60, 0xc9, 0xf9, 0xd5, 0x25, 0x59, 0x1e, 0x6e, 0x4a, 0xfe, 0x06,
+	0x8d, 0x25, 0xc9, 0x0c, 0xa8, 0x4f, 0xee, 0x91, 0x02, 0x3a, 0x7d, 0x37, 0x8f, 0x10, 0x5d, 0xff,
+	0x2c, 0xb7, 0x44, 0x87, 0xa6, 0x4f, 0xdf, 0x0d, 0x9a, 0xd9, 0x58, 0x57, 0xea, 0x29, 0x22, 0x10,
+	0xcf, 0x95, 0xbe, 0x4b, 0x32, 0x9a, 0x33, 0x26, 0x76, 0x8b, 0x04, 0x0e, 0x8e, 0x77, 0xb3, 0xcf,
+	0x47, 0x51, 0x62, 0xf1, 0x87, 0xe3, 0x5d, 0xa0, 0x0c, 0x48, 0x5e, 0x78, 0xe9, 0xb1, 0x9c, 0xcf,
+	0x46, 0x16, 0x16, 0x63, 0xb6, 0xc4, 0x7d, 0x94, 0x13, 0xc9, 0x2c, 0x93, 0x9e, 0xcb, 0x57, 0x3e,
+	0x6b, 0xa1, 0x69, 0x1d, 0x35, 0xe5, 0x33, 0xfd, 0x84, 0xfe, 0x99, 0xb2, 0x1c, 0x0f, 0xfd, 0x8b,
+	0xff, 0x57, 0x0b, 0x21, 0xf2, 0x7a, 0xd7, 0xeb, 0x74, 0x88, 0x4a, 0xf6, 0x19, 0xd3, 0xcb, 0xfe,
+	0x24, 0x71, 0xab, 0x72, 0x23, 0xc6, 0xad, 0xca, 0x8f, 0x14, 0xb7, 0xaa, 0x30, 0x7a, 0xdc, 0xaa,
+	0xe2, 0xe0, 0xb8, 0x55, 0xce, 0x97, 0x2c, 0x34, 0xdf, 0x27, 0x04, 0x11, 0x2d, 0x69, 0x18, 0x04,
+	0xf1, 0x80, 0x10, 0x1b, 0xa0, 0x40, 0xa0, 0xe3, 0x91, 0x10, 0x47, 0x31, 0x5f, 0x9a, 0xdd, 0xb6,
+	0x97, 0x9a, 0x2b, 0x64, 0x2b, 0x01, 0x87, 0xbe, 0x1a, 0xce, 0xef, 0x58, 0x68, 0x4a, 0x0b, 0xbe,
+	0x4d, 0xfa, 0x41, 0xe3, 0xac, 0xf4, 0x79, 0x8b, 0x93, 0x42, 0x60, 0x30, 0xe6, 0x40, 0xd6, 0x52,
+	0x06, 0x64, 0x9a, 0x03, 0x59, 0xcb, 0x63, 0x0e, 0x64, 0x2d, 0x1e, 0x68, 0x45, 0xba, 0x8d, 0xe7,
+	0x53, 0xb5, 0x31, 0xd2, 0x39, 0xbd, 0x70, 0xbc, 0x73, 0x7a, 0x31, 0xdd, 0x39, 0xdd, 0xb9, 0x87,
+	0xa6, 0x59, 0xf8, 0x98, 0x97, 0xf0, 0xc1, 0xc9, 0x3c, 0x7a, 0xae, 0xb2, 0xd9, 0x9e, 0xf0, 0x76,
+	0x27, 0xd5, 0x49, 0xb9, 0xe3, 0x22, 0x95, 0xf5, 0xf4, 0x64, 0x16, 0x89, 0x32, 0x77, 0x3f, 0x73,
+	0xa1, 0x2f, 0xa9, 0x09, 0x29, 0x13, 0xfc, 0x37, 0x41, 0xc3, 0x72, 0x5e, 0x41, 0x33, 0x32, 0x15,
+	0xef, 0x66, 0xd0, 0xc4, 0x91, 0xdd, 0x41, 0xd3, 0x7e, 0xd0, 0xc4, 0xf5, 0x0c, 0x4c, 0x00, 0xe5,
+	0x7c, 0xdd, 0xd4, 0x08, 0x82, 0x41, 0xde, 0xf9, 0x75, 0x4b, 0x6b, 0x01, 0x0d, 0x58, 0xa3, 0xf9,
+	0x87, 0x58, 0x03, 0xfd, 0x43, 0x74, 0x1b, 0xa3, 0xdc, 0x50, 0x1b, 0x23, 0x92, 0xce, 0x80, 0x2c,
+	0x77, 0x53, 0x42, 0x61, 0x97, 0x4a, 0x95, 0xce, 0xa0, 0x0f, 0x03, 0x52, 0x6a, 0x39, 0xbf, 0xc6,
+	0x1a, 0xab, 0xf2, 0x0f, 0x9d, 0xc4, 0x50, 0xb4, 0x87, 0x8a, 0x94, 0x14, 0xd7, 0x37, 0x8c, 0x29,
+	0x63, 0xf4, 0xe7, 0x3e, 0x52, 0x93, 0x95, 0x6f, 0x6b, 0x94, 0x9b, 0xf3, 0x0d, 0xd6, 0xd6, 0x0d,
+	0x8f, 0x2e, 0xfc, 0x13, 0xb6, 0xb5, 0x63, 0xb6, 0xf5, 0x4e, 0x56, 0xe7, 0x41, 0x7a, 0x1b, 0x49,
+	0xd0, 0xf9, 0x2e, 0x0e, 0x1b, 0xd8, 0x8f, 0x45, 0x34, 0x41, 0x9e, 0x3e, 0xba, 0x26, 0x4b, 0x41,
+	0xc3, 0x70, 0xbe, 0x48, 0x36, 0x09, 0xaf, 0xb5, 0xff, 0x3c, 0x0f, 0x1e, 0xf5, 0x6c, 0x32, 0x4c,
+	0x49, 0x72, 0x03, 0x10, 0x60, 0x3d, 0x2c, 0x5c, 0xee, 0x98, 0xb0, 0x70, 0x6f, 0x43, 0x93, 0x61,
+	0xd0, 0xc6, 0xd5, 0xd0, 0x4f, 0x1a, 0xe9, 0x02, 0x29, 0x86, 0x4d, 0x10, 0x70, 0xe7, 0x97, 0x2d,
+	0x34, 0x97, 0x0c, 0x82, 0x99, 0x79, 0xec, 0x14, 0x3d, 0x66, 0x78, 0x7e, 0xf4, 0x98, 0xe1, 0xce,
+	0xf7, 0x8a, 0x68, 0x8e, 0xec, 0x74, 0x22, 0xa0, 0x91, 0x30, 0x82, 0xf0, 0xe8, 0x63, 0x71, 0xe2,
+	0x84, 0x63, 0xaf, 0xc4, 0x0c, 0x76, 0x02, 0x5d, 0xcd, 0x6d, 0x54, 0x0e, 0xba, 0xd8, 0x30, 0x3a,
+	0x7d, 0x96, 0xa3, 0x95, 0xef, 0x09, 0xc0, 0x6b, 0x34, 0xc1, 0xb9, 0x68, 0x80, 0x2c, 0x06, 0x55,
+	0xd5, 0xfe, 0x61, 0xd3, 0xdd, 0xe7, 0x7a, 0xf2, 0xa5, 0x6d, 0x56, 0xd5, 0x7f, 0xc3, 0x79, 0xfb,
+	0x3c, 0x40, 0x65, 0x6e, 0x1b, 0x70, 0x2a, 0x67, 0x1f, 0x4a, 0xf8, 0xbe, 0x20, 0x00, 0x8a, 0x56,
+	0xc2, 0x8d, 0xa8, 0x94, 0x69, 0x9a, 0x81, 0x17, 0x94, 0xa9, 0x6e, 0xd9, 0xf0, 0xb0, 0x12, 0xb6,
+	0xb5, 0x29, 0x53, 0x4a, 0xd4, 0x60, 0xa6, 0xef, 0x3c, 0x58, 0x8a, 0x30, 0x5b, 0xd0, 0x4c, 0xdf,
+	0x05, 0x04, 0x34, 0x2c, 0xf2, 0x1e, 0xdc, 0xf4, 0x22, 0x96, 0xdf, 0x7d, 0xca, 0x8c, 0xa5, 0xb3,
+	0xca, 0xcb, 0x41, 0x62, 0x90, 0x78, 0x5a, 0xdc, 0x97, 0x7e, 0x5a, 0xc5, 0xd3, 0x92, 0x7e, 0xf4,
+	0x43, 0xe2, 0x69, 0xb1, 0x5a, 0x44, 0xc0, 0x99, 0x22, 0x53, 0x86, 0x87, 0xf4, 0x21, 0xdd, 0x15,
+	0x01, 0x7f, 0x2c, 0xb3, 0xbb, 0x1c, 0x23, 0xad, 0xbb, 0xbc, 0x86, 0xbd, 0x8a, 0xca, 0xcc, 0x61,
+	0x9d, 0x54, 0xcf, 0x19, 0x11, 0xf7, 0xcb, 0xf7, 0x7c, 0x45, 0x60, 0x5e, 0xe3, 0xc8, 0x4d, 0x91,
+	0x54, 0x45, 0xe7, 0x55, 0xb2, 0x57, 0xc8, 0xfb, 0x09, 0xdf, 0xc0, 0x4e, 0x9e, 0xf4, 0x9e, 0xe8,
+	0x5e, 0x85, 0x45, 0xb8, 0x30, 0xd9, 0x63, 0x49, 0x43, 0xa4, 0xee, 0x75, 0xd5, 0x04, 0x43, 0x12,
+	0xdf, 0xf9, 0xdf, 0x79, 0xa4, 0x1b, 0x24, 0x9e, 0xcc, 0xe5, 0xd8, 0xd0, 0xc5, 0xe6, 0x46, 0x76,
+	0x1c, 0xce, 0x9f, 0xa7, 0xe3, 0xf0, 0x5b, 0xd0, 0x24, 0x93, 0x0f, 0x98, 0xc3, 0x72, 0x91, 0x5d,
+	0x84, 0xd9, 0xe1, 0x1d, 0x81, 0x80, 0x11, 0xd3, 0x1d, 0x9e, 0xab, 0x8e, 0x8b, 0xba, 0x45, 0x33,
+	0x9c, 0xe6, 0x8a, 0x0e, 0x04, 0x13, 0x57, 0xc5, 0xe2, 0x14, 0x95, 0x27, 0xd2, 0x62, 0x71, 0xca,
+	0xca, 0x06, 0x2e, 0xa9, 0xec, 0x36, 0x68, 0xf8, 0x53, 0xcd, 0xb9, 0x55, 0xab, 0x5c, 0xd5, 0x81,
+	0x60, 0xe2, 0x12, 0x2b, 0xab, 0x2e, 0xb1, 0xfe, 0xc4, 0x8f, 0x44, 0xed, 0x92, 0x69, 0x65, 0x55,
+	0x33, 0xa0, 0x90, 0xc0, 0x76, 0x5e, 0xa1, 0x5f, 0x5f, 0xa6, 0x4c, 0x24, 0x37, 0x85, 0xc7, 0x6e,
+	0xa3, 0x2f, 0x1e, 0xd3, 0x2d, 0x52, 0x08, 0x0c, 0x46, 0x0d, 0x4b, 0x59, 0xd4, 0xd2, 0x84, 0x84,
+	0xcd, 0x63, 0x95, 0x72, 0x28, 0x21, 0x16, 0xe2, 0x16, 0x7e, 0x5c, 0xc9, 0x9b, 0xc4, 0x80, 0x14,
+	0x02, 0x83, 0x39, 0x6f, 0x47, 0x25, 0x91, 0xd9, 0x8b, 0xcc, 0xbd, 0xae, 0x30, 0xc2, 0xd3, 0xd3,
+	0xe3, 0x04, 0x61, 0x0c, 0x14, 0xe2, 0xbc, 0x8c, 0x4a, 0x22, 0x01, 0xd9, 0xf1, 0xd8, 0x44, 0x20,
+	0x8c, 0x7c, 0xef, 0x4e, 0x10, 0xc5, 0x22, 0x6b, 0x1a, 0xb3, 0x0a, 0xdf, 0x5c, 0xa3, 0x65, 0x20,
+	0xa1, 0xe4, 0xf5, 0x69, 0x6a, 0x6b, 0x6b, 0x5d, 0x3e, 0x3c, 0x02, 0x7a, 0x22, 0x62, 0x0b, 0xa4,
+	0xba, 0x13, 0x63, 0xdd, 0xdd, 0x9c, 0x9d, 0x8d, 0x57, 0x8e, 0x0e, 0x17, 0x9f, 0xa8, 0xa7, 0x62,
+	0xc0, 0x80, 0x9a, 0xf6, 0x1a, 0xba, 0xa8, 0x43, 0x78, 0xfa, 0x08, 0x2e, 0xa9, 0x5e, 0x26, 0x41,
+	0x04, 0xea, 0xfd, 0x60, 0x48, 0xab, 0x93, 0x24, 0x25, 0xa2, 0xed, 0xe6, 0xd3, 0x49, 0x71, 0x30,
+	0xa4, 0xd5, 0x71, 0x9e, 0x43, 0xb3, 0x09, 0x3f, 0xe8, 0x13, 0xa4, 0xed, 0xf9, 0xbd, 0x3c, 0x9a,
+	0x36, 0xde, 0xa8, 0x8e, 0xdf, 0x35, 0x4e, 0x2e, 0x9c, 0xa7, 0x98, 0xb4, 0xe7, 0x47, 0x34, 0x69,
+	0xd7, 0x7d, 0x08, 0x0a, 0x67, 0xeb, 0x43, 0x50, 0xcc, 0xc6, 0x87, 0x40, 0xf3, 0x6d, 0x9f, 0x38,
+	0x3f, 0xdf, 0xf6, 0xdf, 0x2a, 0xa2, 0x99, 0xc4, 0x73, 0xde, 0xf1, 0x5f, 0xf2, 0xed, 0x7d, 0x5f,
+	0x72, 0x44, 0xab, 0xca, 0xfc, 0xb8, 0x56, 0x95, 0x85, 0x71, 0xad, 0x2a, 0x8b, 0xa7, 0xb0, 0xaa,
+	0xec, 0xb7, 0x89, 0x9c, 0x38, 0xb1, 0x4d, 0xe4, 0xfb, 0xa4, 0xe8, 0x32, 0x69, 0x84, 0x89, 0x50,
+	0xe2, 0x8b, 0x6d, 0x7e, 0x86, 0x95, 0xa0, 0x99, 0x1a, 0xbe, 0xa8, 0x74, 0x8c, 0x40, 0x1b, 0xa6,
+	0x46, 0xed, 0x19, 0xdd, 0x50, 0xfe, 0x89, 0x11, 0x22, 0xf6, 0xbc, 0x1b, 0x4d, 0xf1, 0xf9, 0x44,
+	0xd5, 0x3c, 0xc8, 0x54, 0x11, 0xd5, 0x15, 0x08, 0x74, 0xbc, 0xb4, 0x77, 0xe3, 0xa9, 0xd1, 0xde,
+	0x8d, 0x9d, 0x4f, 0xa1, 0x4b, 0xa9, 0x2f, 0x48, 0xd4, 0x88, 0x8e, 0x9e, 0xeb, 0xb8, 0xc9, 0x11,
+	0xb4, 0x66, 0x24, 0xfc, 0xed, 0xae, 0x3c, 0x18, 0x88, 0x09, 0x43, 0xa8, 0x38, 0x5f, 0xb0, 0xd0,
+	0x7c, 0x9f, 0xfa, 0x99, 0x88, 0xc1, 0x8d, 0x20, 0xd8, 0xf3, 0x70, 0x5a, 0x4a, 0xa9, 0x15, 0x09,
+	0x01, 0x0d, 0x2b, 0x0b, 0x29, 0xee, 0x37, 0xf2, 0x68, 0xc6, 0x50, 0x4b, 0x90, 0x9c, 0x9c, 0xc2,
+	0x7a, 0x25, 0x13, 0xc3, 0x19, 0x46, 0x56, 0x4b, 0xfc, 0x3a, 0xd0, 0x76, 0xf5, 0x11, 0x9d, 0xec,
+	0xdb, 0x32, 0x0b, 0xed, 0xd9, 0x31, 0xe6, 0x46, 0xa3, 0x9c, 0x1d, 0x71, 0x2e, 0x40, 0x2a, 0x2a,
+	0x78, 0x36, 0x7e, 0x9e, 0xfd, 0xdc, 0x55, 0x00, 0x67, 0xc9, 0x0a, 0x34, 0xb6, 0xe4, 0xa0, 0xdb,
+	0xc7, 0xa1, 0xb7, 0xe3, 0xe1, 0x26, 0xdd, 0xa8, 0x4a, 0xec, 0x18, 0x79, 0x99, 0x97, 0x81, 0x84,
+	0x3a, 0xaf, 0xe6, 0x50, 0x99, 0xa6, 0x70, 0xbb, 0x1d, 0x06, 0x1d, 0xa2, 0x79, 0x9f, 0x8e, 0x34,
+	0x55, 0x21, 0xff, 0x6c, 0x63, 0x3e, 0x6f, 0xea, 0xca, 0x47, 0x1e, 0x9f, 0x4d, 0x2b, 0x01, 0x83,
+	0xa3, 0xdd, 0x45, 0xa5, 0x1d, 0x9e, 0xe6, 0x9b, 0x7f, 0xbb, 0x31, 0xb3, 0xb8, 0x8a, 0xa4, 0xe1,
+	0x6c, 0x08, 0xc4, 0x2f, 0x90, 0x5c, 0x9c, 0xcf, 0xe7, 0xd0, 0x85, 0x07, 0xae, 0x17, 0xdf, 0x0e,
+	0xc2, 0x51, 0x94, 0x10, 0x67, 0x19, 0x0f, 0x81, 0x28, 0x60, 0x3b, 0x58, 0x68, 0x03, 0xa5, 0x02,
+	0x76, 0x03, 0xc7, 0x40, 0xca, 0xc9, 0xf1, 0x27, 0xac, 0xd6, 0xf8, 0xf7, 0x55, 0xe7, 0x3a, 0x2f,
+	0x07, 0x89, 0x31, 0x82, 0x32, 0xc2, 0xf9, 0x9d, 0x3c, 0x9a, 0x92, 0x63, 0x81, 0xbb, 0xaf, 0x67,
+	0xf4, 0x70, 0xa9, 0x0c, 0x4e, 0x46, 0x0f, 0x97, 0x1a, 0x63, 0x50, 0x38, 0xa4, 0x42, 0x23, 0x91,
+	0xda, 0x4d, 0x56, 0x50, 0xd9, 0xd8, 0x14, 0x0e, 0x19, 0x42, 0x72, 0x49, 0xa7, 0x19, 0xe4, 0x27,
+	0x4c, 0x3b, 0xf0, 0xbb, 0xf5, 0x7b, 0x9b, 0xa4, 0x1c, 0x24, 0x86, 0xca, 0x7e, 0x38, 0x39, 0x24,
+	0xfb, 0xa1, 0x76, 0x99, 0x2f, 0x8d, 0x7c, 0x99, 0x7f, 0x51, 0xbf, 0xcc, 0x33, 0xd5, 0xc7, 0xdb,
+	0xd2, 0x2e, 0xf3, 0x0b, 0xfc, 0xf3, 0x0c, 0xbc, 0xcf, 0xbb, 0x68, 0x36, 0x91, 0xc8, 0x29, 0xf3,
+	0x5c, 0xf7, 0xff, 0xa3, 0x80, 0xca, 0x32, 0x0a, 0xb0, 0xfd, 0xa3, 0xc6, 0x33, 0xa4, 0xea, 0x35,
+	0x7f, 0x3f, 0x24, 0x5a, 0x32, 0x89, 0x9c, 0x78, 0x52, 0xbc, 0x8a, 0xf2, 0xbd, 0xb0, 0x9d, 0x7c,
+	0x67, 0x20, 0xa1, 0xf5, 0x49, 0xb9, 0x1e, 0xb9, 0x38, 0x7f, 0xbe, 0x91, 0x8b, 0xaf, 0xa3, 0xc2,
+	0x76, 0xd0, 0x3c, 0xa8, 0x14, 0xcc, 0x19, 0xba, 0x1c, 0x34, 0x0f, 0x80, 0x42, 0xc8, 0xa5, 0x97,
+	0x7f, 0x3a, 0x71, 0x5e, 0x16, 0xe9, 0x79, 0x29, 0x2f, 0xbd, 0x5b, 0x06, 0x14, 0x12, 0xd8, 0x23,
+	0xce, 0x3f, 0x3d, 0xe2, 0xf3, 0xe4, 0xb1, 0x11, 0x9f, 0x57, 0x19, 0x6d, 0xd2, 0x5a, 0x3a, 0x13,
+	0xa7, 0x97, 0x9f, 0x15, 0x74, 0x49, 0xd9, 0x50, 0x4d, 0x95, 0xac, 0x99, 0x16, 0x1b, 0xbb, 0xfc,
+	0xfa, 0xc5, 0xc6, 0x76, 0xee, 0xa3, 0xd9, 0xc4, 0xf7, 0x13, 0xcf, 0x54, 0x56, 0xfa, 0x33, 0x95,
+	0x5a, 0xb4, 0xb9, 0xc1, 0x8b, 0xd6, 0xf9, 0xa7, 0x16, 0x9a, 0xef, 0x3b, 0x60, 0x4f, 0x1a, 0x0d,
+	0x3d, 0x29, 0x77, 0xe6, 0x4e, 0x2f, 0x77, 0xe6, 0x47, 0x93, 0x3b, 0x97, 0xb7, 0xbf, 0xfe, 0xed,
+	0x6b, 0x6f, 0xfa, 0xc3, 0x6f, 0x5f, 0x7b, 0xd3, 0xb7, 0xbe, 0x7d, 0xed, 0x4d, 0xaf, 0x1e, 0x5d,
+	0xb3, 0xbe, 0x7e, 0x74, 0xcd, 0xfa, 0xc3, 0xa3, 0x6b, 0xd6, 0xb7, 0x8e, 0xae, 0x59, 0xff, 0xf1,
+	0xe8, 0x9a, 0xf5, 0xa5, 0xef, 0x5c, 0x7b, 0xd3, 0x87, 0xdf, 0xa7, 0xbe, 0xd4, 0x0d, 0xf1, 0xa5,
+	0xe8, 0x3f, 0xef, 0x10, 0xdf, 0xe5, 0x46, 0x77, 0xaf, 0x45, 0x02, 0x7f, 0x46, 0x37, 0x64, 0x89,
+	0xf8, 0x52, 0xff, 0x77, 0x00, 0x00, 0x40, 0xa4, 0xcb, 0x2c, 0xde, 0x00, 0x00,
 }
 
 func (m *ALBStatus) Marshal() (dAtA []byte, err error) {
@@ -5313,6 +6475,35 @@ func (m *BlueGreenStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Weights != nil {
+		{
+			size, err := m.Weights.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TrafficStepAnalysisRunStatus != nil {
+		{
+			size, err := m.TrafficStepAnalysisRunStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TrafficStepIndex != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TrafficStepIndex))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.PostPromotionAnalysisRunStatus != nil {
 		{
 			size, err := m.PostPromotionAnalysisRunStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -5378,6 +6569,62 @@ func (m *BlueGreenStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PostPromotionTimeout != nil {
+		{
+			size, err := m.PostPromotionTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.PrePromotionTimeout != nil {
+		{
+			size, err := m.PrePromotionTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.TrafficSteps) > 0 {
+		for iNdEx := len(m.TrafficSteps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TrafficSteps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.TrafficRouting != nil {
+		{
+			size, err := m.TrafficRouting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.AbortScaleDownDelaySeconds != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.AbortScaleDownDelaySeconds))
 		i--
@@ -5496,6 +6743,93 @@ func (m *BlueGreenStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlueGreenTrafficStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlueGreenTrafficStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlueGreenTrafficStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Analysis != nil {
+		{
+			size, err := m.Analysis.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pause != nil {
+		{
+			size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SetWeight != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.SetWeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CanaryAbortPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanaryAbortPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanaryAbortPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Preserve != nil {
+		{
+			size, err := m.Preserve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CanaryStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5516,6 +6850,18 @@ func (m *CanaryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WaitFor != nil {
+		{
+			size, err := m.WaitFor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.StepPluginStatuses) > 0 {
 		for iNdEx := len(m.StepPluginStatuses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5599,6 +6945,40 @@ func (m *CanaryStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.OnTimeout)
+	copy(dAtA[i:], m.OnTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnTimeout)))
+	i--
+	dAtA[i] = 0x6a
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x62
+	if m.WaitFor != nil {
+		{
+			size, err := m.WaitFor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.SetCanaryNodes != nil {
+		{
+			size, err := m.SetCanaryNodes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Plugin != nil {
 		{
 			size, err := m.Plugin.MarshalToSizedBuffer(dAtA[:i])
@@ -5711,6 +7091,34 @@ func (m *CanaryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AbortPolicy != nil {
+		{
+			size, err := m.AbortPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.DaemonSet != nil {
+		{
+			size, err := m.DaemonSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
 	if m.MinPodsPerReplicaSet != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinPodsPerReplicaSet))
 		i--
@@ -6207,117 +7615,7 @@ func (m *ClusterAnalysisTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *DatadogMetric) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DatadogMetric) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DatadogMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	i -= len(m.Aggregator)
-	copy(dAtA[i:], m.Aggregator)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Aggregator)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.ApiVersion)
-	copy(dAtA[i:], m.ApiVersion)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApiVersion)))
-	i--
-	dAtA[i] = 0x2a
-	i -= len(m.Formula)
-	copy(dAtA[i:], m.Formula)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Formula)))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queries) > 0 {
-		keysForQueries := make([]string, 0, len(m.Queries))
-		for k := range m.Queries {
-			keysForQueries = append(keysForQueries, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
-		for iNdEx := len(keysForQueries) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Queries[string(keysForQueries[iNdEx])]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(keysForQueries[iNdEx])
-			copy(dAtA[i:], keysForQueries[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForQueries[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	i -= len(m.Query)
-	copy(dAtA[i:], m.Query)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Interval)
-	copy(dAtA[i:], m.Interval)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *DryRun) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *DryRun) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DryRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.MetricName)
-	copy(dAtA[i:], m.MetricName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MetricName)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *Experiment) Marshal() (dAtA []byte, err error) {
+func (m *ClusterRolloutPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6327,26 +7625,460 @@ func (m *Experiment) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Experiment) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClusterRolloutPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Experiment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClusterRolloutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterRolloutPolicyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterRolloutPolicyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterRolloutPolicyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterRolloutStrategyTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterRolloutStrategyTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterRolloutStrategyTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterRolloutStrategyTemplateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterRolloutStrategyTemplateList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterRolloutStrategyTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConfigRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConfigRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConfigRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContourTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContourTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContourTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HTTPProxies) > 0 {
+		for iNdEx := len(m.HTTPProxies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HTTPProxies[iNdEx])
+			copy(dAtA[i:], m.HTTPProxies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.HTTPProxies[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DNSTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DNSTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DNSTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TTLSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	i -= len(m.WeightProperty)
+	copy(dAtA[i:], m.WeightProperty)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.WeightProperty)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.CanarySetIdentifier)
+	copy(dAtA[i:], m.CanarySetIdentifier)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CanarySetIdentifier)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.StableSetIdentifier)
+	copy(dAtA[i:], m.StableSetIdentifier)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StableSetIdentifier)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.DNSEndpoint)
+	copy(dAtA[i:], m.DNSEndpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DNSEndpoint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DaemonSetCanaryStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DaemonSetCanaryStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DaemonSetCanaryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Enabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *DatadogMetric) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatadogMetric) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatadogMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SecretRef.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Aggregator)
+	copy(dAtA[i:], m.Aggregator)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Aggregator)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ApiVersion)
+	copy(dAtA[i:], m.ApiVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ApiVersion)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Formula)
+	copy(dAtA[i:], m.Formula)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Formula)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queries) > 0 {
+		keysForQueries := make([]string, 0, len(m.Queries))
+		for k := range m.Queries {
+			keysForQueries = append(keysForQueries, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForQueries)
+		for iNdEx := len(keysForQueries) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Queries[string(keysForQueries[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForQueries[iNdEx])
+			copy(dAtA[i:], keysForQueries[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForQueries[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Query)
+	copy(dAtA[i:], m.Query)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Interval)
+	copy(dAtA[i:], m.Interval)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Interval)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MetricName)
+	copy(dAtA[i:], m.MetricName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MetricName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Experiment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Experiment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Experiment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -6957,6 +8689,37 @@ func (m *IstioDestinationRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IstioLocality) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IstioLocality) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IstioLocality) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MinWeight))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IstioTrafficRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6977,6 +8740,20 @@ func (m *IstioTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Localities) > 0 {
+		for iNdEx := len(m.Localities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Localities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.VirtualServices) > 0 {
 		for iNdEx := len(m.VirtualServices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7280,6 +9057,70 @@ func (m *KayentaThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KongTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KongTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KongTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HTTPRoutes) > 0 {
+		for iNdEx := len(m.HTTPRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HTTPRoutes[iNdEx])
+			copy(dAtA[i:], m.HTTPRoutes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.HTTPRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkerdTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkerdTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkerdTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HTTPRoutes) > 0 {
+		for iNdEx := len(m.HTTPRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HTTPRoutes[iNdEx])
+			copy(dAtA[i:], m.HTTPRoutes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.HTTPRoutes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MangedRoutes) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8262,6 +10103,39 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) MarshalToSizedBuffer(d
 	return len(dAtA) - i, nil
 }
 
+func (m *PreserveAbortedPods) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreserveAbortedPods) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreserveAbortedPods) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TTL)
+	copy(dAtA[i:], m.TTL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TTL)))
+	i--
+	dAtA[i] = 0x12
+	if m.Count != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PrometheusMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8932,6 +10806,218 @@ func (m *RolloutExperimentTemplate) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutHookStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutHookStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutHookStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.JobName)
+	copy(dAtA[i:], m.JobName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JobName)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutHooks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutHooks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutHooks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OnAbort != nil {
+		{
+			size, err := m.OnAbort.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PostPromotion != nil {
+		{
+			size, err := m.PostPromotion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PreRollout != nil {
+		{
+			size, err := m.PreRollout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutLifecycleStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutLifecycleStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutLifecycleStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9014,6 +11100,326 @@ func (m *RolloutPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutPolicyList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutPolicyList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutPolicyList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutPolicyRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutPolicyRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.EnforcementAction)
+	copy(dAtA[i:], m.EnforcementAction)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EnforcementAction)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutPolicySpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutPolicySpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutPolicySpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.EnforcementAction)
+	copy(dAtA[i:], m.EnforcementAction)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EnforcementAction)))
+	i--
+	dAtA[i] = 0x12
+	if m.Selector != nil {
+		{
+			size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutRetryBackoff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutRetryBackoff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutRetryBackoff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MaxDuration)
+	copy(dAtA[i:], m.MaxDuration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxDuration)))
+	i--
+	dAtA[i] = 0x1a
+	if m.Factor != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.Factor))
+		i--
+		dAtA[i] = 0x10
+	}
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetryOn) > 0 {
+		for iNdEx := len(m.RetryOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryOn[iNdEx])
+			copy(dAtA[i:], m.RetryOn[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RetryOn[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Backoff != nil {
+		{
+			size, err := m.Backoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Limit))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutRetryStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutRetryStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutRetryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextRetryAt != nil {
+		{
+			size, err := m.NextRetryAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	i -= len(m.LastAbortReason)
+	copy(dAtA[i:], m.LastAbortReason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastAbortReason)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Attempts))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9034,6 +11440,60 @@ func (m *RolloutSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StrategyRef != nil {
+		{
+			size, err := m.StrategyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Hooks != nil {
+		{
+			size, err := m.Hooks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ConfigRefs) > 0 {
+		for iNdEx := len(m.ConfigRefs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfigRefs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.RollbackWindow != nil {
 		{
 			size, err := m.RollbackWindow.MarshalToSizedBuffer(dAtA[:i])
@@ -9171,6 +11631,78 @@ func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StrategyTemplate != nil {
+		{
+			size, err := m.StrategyTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.Lifecycle != nil {
+		{
+			size, err := m.Lifecycle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.CurrentStep != nil {
+		{
+			size, err := m.CurrentStep.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.Retry != nil {
+		{
+			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.Hooks) > 0 {
+		for iNdEx := len(m.Hooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
 	if len(m.ALBs) > 0 {
 		for iNdEx := len(m.ALBs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9383,6 +11915,61 @@ func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutStepStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStepStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStepStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimedOutAt != nil {
+		{
+			size, err := m.TimedOutAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Step)
+	copy(dAtA[i:], m.Step)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Step)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9430,7 +12017,7 @@ func (m *RolloutStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutTrafficRouting) Marshal() (dAtA []byte, err error) {
+func (m *RolloutStrategyTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9440,63 +12027,63 @@ func (m *RolloutTrafficRouting) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutStrategyTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RolloutStrategyTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MaxTrafficWeight != nil {
-		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxTrafficWeight))
-		i--
-		dAtA[i] = 0x58
-	}
-	if len(m.Plugins) > 0 {
-		keysForPlugins := make([]string, 0, len(m.Plugins))
-		for k := range m.Plugins {
-			keysForPlugins = append(keysForPlugins, string(k))
-		}
-		github_com_gogo_protobuf_sortkeys.Strings(keysForPlugins)
-		for iNdEx := len(keysForPlugins) - 1; iNdEx >= 0; iNdEx-- {
-			v := m.Plugins[string(keysForPlugins[iNdEx])]
-			baseI := i
-			if v != nil {
-				i -= len(v)
-				copy(dAtA[i:], v)
-				i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(keysForPlugins[iNdEx])
-			copy(dAtA[i:], keysForPlugins[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPlugins[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x52
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Apisix != nil {
-		{
-			size, err := m.Apisix.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x4a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.ManagedRoutes) > 0 {
-		for iNdEx := len(m.ManagedRoutes) - 1; iNdEx >= 0; iNdEx-- {
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutStrategyTemplateList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStrategyTemplateList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStrategyTemplateList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ManagedRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -9504,16 +12091,250 @@ func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x42
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Traefik != nil {
-		{
-			size, err := m.Traefik.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutStrategyTemplateSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStrategyTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStrategyTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Strategy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutStrategyTemplateStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutStrategyTemplateStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutStrategyTemplateStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.StrategyHash)
+	copy(dAtA[i:], m.StrategyHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StrategyHash)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.ClusterScope {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutTrafficRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutTrafficRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stickiness != nil {
+		{
+			size, err := m.Stickiness.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DNS != nil {
+		{
+			size, err := m.DNS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Linkerd != nil {
+		{
+			size, err := m.Linkerd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Kong != nil {
+		{
+			size, err := m.Kong.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Contour != nil {
+		{
+			size, err := m.Contour.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxTrafficWeight != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxTrafficWeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Plugins) > 0 {
+		keysForPlugins := make([]string, 0, len(m.Plugins))
+		for k := range m.Plugins {
+			keysForPlugins = append(keysForPlugins, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPlugins)
+		for iNdEx := len(keysForPlugins) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Plugins[string(keysForPlugins[iNdEx])]
+			baseI := i
+			if v != nil {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForPlugins[iNdEx])
+			copy(dAtA[i:], keysForPlugins[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForPlugins[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Apisix != nil {
+		{
+			size, err := m.Apisix.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ManagedRoutes) > 0 {
+		for iNdEx := len(m.ManagedRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ManagedRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Traefik != nil {
+		{
+			size, err := m.Traefik.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
@@ -9856,6 +12677,39 @@ func (m *SecretRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SetCanaryNodes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCanaryNodes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCanaryNodes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NodeSelector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *SetCanaryScale) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10167,6 +13021,39 @@ func (m *StepPluginStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StepTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StepTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StepTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.OnTimeout)
+	copy(dAtA[i:], m.OnTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnTimeout)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *StickinessConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10201,6 +13088,83 @@ func (m *StickinessConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StrategyRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrategyRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrategyRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.PreviewService)
+	copy(dAtA[i:], m.PreviewService)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PreviewService)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.ActiveService)
+	copy(dAtA[i:], m.ActiveService)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ActiveService)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.StableService)
+	copy(dAtA[i:], m.StableService)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StableService)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.CanaryService)
+	copy(dAtA[i:], m.CanaryService)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CanaryService)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.Weights[iNdEx]))
+			i--
+			dAtA[i] = 0x20
+		}
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i--
+	if m.ClusterScope {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *StringMatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10541,6 +13505,37 @@ func (m *TraefikTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TrafficStickiness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrafficStickiness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrafficStickiness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DurationSeconds))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.CookieName)
+	copy(dAtA[i:], m.CookieName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CookieName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TrafficWeights) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10655,6 +13650,131 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WaitForStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitForStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitForStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	i--
+	if m.TimedOut {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x20
+	i--
+	if m.Met {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *WaitForStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WaitForStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WaitForStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.OnTimeout)
+	copy(dAtA[i:], m.OnTimeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnTimeout)))
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x42
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.JSONPath)
+	copy(dAtA[i:], m.JSONPath)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.JSONPath)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Condition)
+	copy(dAtA[i:], m.Condition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Condition)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIVersion)
+	copy(dAtA[i:], m.APIVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIVersion)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WavefrontMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11344,6 +14464,17 @@ func (m *BlueGreenStatus) Size() (n int) {
 		l = m.PostPromotionAnalysisRunStatus.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.TrafficStepIndex != nil {
+		n += 1 + sovGenerated(uint64(*m.TrafficStepIndex))
+	}
+	if m.TrafficStepAnalysisRunStatus != nil {
+		l = m.TrafficStepAnalysisRunStatus.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Weights != nil {
+		l = m.Weights.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11397,6 +14528,57 @@ func (m *BlueGreenStrategy) Size() (n int) {
 	if m.AbortScaleDownDelaySeconds != nil {
 		n += 1 + sovGenerated(uint64(*m.AbortScaleDownDelaySeconds))
 	}
+	if m.TrafficRouting != nil {
+		l = m.TrafficRouting.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.TrafficSteps) > 0 {
+		for _, e := range m.TrafficSteps {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.PrePromotionTimeout != nil {
+		l = m.PrePromotionTimeout.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.PostPromotionTimeout != nil {
+		l = m.PostPromotionTimeout.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *BlueGreenTrafficStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetWeight != nil {
+		n += 1 + sovGenerated(uint64(*m.SetWeight))
+	}
+	if m.Pause != nil {
+		l = m.Pause.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Analysis != nil {
+		l = m.Analysis.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CanaryAbortPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Preserve != nil {
+		l = m.Preserve.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11428,6 +14610,10 @@ func (m *CanaryStatus) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.WaitFor != nil {
+		l = m.WaitFor.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11468,6 +14654,18 @@ func (m *CanaryStep) Size() (n int) {
 		l = m.Plugin.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SetCanaryNodes != nil {
+		l = m.SetCanaryNodes.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.WaitFor != nil {
+		l = m.WaitFor.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.OnTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -11532,6 +14730,14 @@ func (m *CanaryStrategy) Size() (n int) {
 	if m.MinPodsPerReplicaSet != nil {
 		n += 2 + sovGenerated(uint64(*m.MinPodsPerReplicaSet))
 	}
+	if m.DaemonSet != nil {
+		l = m.DaemonSet.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.AbortPolicy != nil {
+		l = m.AbortPolicy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11663,6 +14869,124 @@ func (m *ClusterAnalysisTemplateList) Size() (n int) {
 	return n
 }
 
+func (m *ClusterRolloutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterRolloutPolicyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClusterRolloutStrategyTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ClusterRolloutStrategyTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConfigRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *ContourTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HTTPProxies) > 0 {
+		for _, s := range m.HTTPProxies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DNSTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DNSEndpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StableSetIdentifier)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CanarySetIdentifier)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.WeightProperty)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TTLSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TTLSeconds))
+	}
+	return n
+}
+
+func (m *DaemonSetCanaryStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+
 func (m *DatadogMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11940,6 +15264,18 @@ func (m *IstioDestinationRule) Size() (n int) {
 	return n
 }
 
+func (m *IstioLocality) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MinWeight))
+	return n
+}
+
 func (m *IstioTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11960,6 +15296,12 @@ func (m *IstioTrafficRouting) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Localities) > 0 {
+		for _, e := range m.Localities {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -12060,6 +15402,36 @@ func (m *KayentaThreshold) Size() (n int) {
 	return n
 }
 
+func (m *KongTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HTTPRoutes) > 0 {
+		for _, s := range m.HTTPRoutes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LinkerdTrafficRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HTTPRoutes) > 0 {
+		for _, s := range m.HTTPRoutes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MangedRoutes) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12426,6 +15798,20 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Size() (n int) {
 	return n
 }
 
+func (m *PreserveAbortedPods) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != nil {
+		n += 1 + sovGenerated(uint64(*m.Count))
+	}
+	l = len(m.TTL)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *PrometheusMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12670,6 +16056,80 @@ func (m *RolloutExperimentTemplate) Size() (n int) {
 	return n
 }
 
+func (m *RolloutHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutHookStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JobName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *RolloutHooks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreRollout != nil {
+		l = m.PreRollout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PostPromotion != nil {
+		l = m.PostPromotion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.OnAbort != nil {
+		l = m.OnAbort.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *RolloutLifecycleStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RolloutList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12700,6 +16160,128 @@ func (m *RolloutPause) Size() (n int) {
 	return n
 }
 
+func (m *RolloutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutPolicyList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RolloutPolicyRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EnforcementAction)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutPolicySpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Selector != nil {
+		l = m.Selector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.EnforcementAction)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RolloutRetryBackoff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Duration)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Factor != nil {
+		n += 1 + sovGenerated(uint64(*m.Factor))
+	}
+	l = len(m.MaxDuration)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Limit))
+	if m.Backoff != nil {
+		l = m.Backoff.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.RetryOn) > 0 {
+		for _, s := range m.RetryOn {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RolloutRetryStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Attempts))
+	l = len(m.LastAbortReason)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.NextRetryAt != nil {
+		l = m.NextRetryAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *RolloutSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12742,6 +16324,24 @@ func (m *RolloutSpec) Size() (n int) {
 		l = m.RollbackWindow.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.ConfigRefs) > 0 {
+		for _, e := range m.ConfigRefs {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Hooks != nil {
+		l = m.Hooks.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.StrategyRef != nil {
+		l = m.StrategyRef.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12815,6 +16415,47 @@ func (m *RolloutStatus) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Hooks) > 0 {
+		for _, e := range m.Hooks {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.CurrentStep != nil {
+		l = m.CurrentStep.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Lifecycle != nil {
+		l = m.Lifecycle.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.StrategyTemplate != nil {
+		l = m.StrategyTemplate.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *RolloutStepStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Step)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TimedOutAt != nil {
+		l = m.TimedOutAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12835,6 +16476,64 @@ func (m *RolloutStrategy) Size() (n int) {
 	return n
 }
 
+func (m *RolloutStrategyTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutStrategyTemplateList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RolloutStrategyTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Strategy.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutStrategyTemplateStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 1 + sovGenerated(uint64(m.Generation))
+	l = len(m.StrategyHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RolloutTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -12894,6 +16593,26 @@ func (m *RolloutTrafficRouting) Size() (n int) {
 	if m.MaxTrafficWeight != nil {
 		n += 1 + sovGenerated(uint64(*m.MaxTrafficWeight))
 	}
+	if m.Contour != nil {
+		l = m.Contour.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kong != nil {
+		l = m.Kong.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Linkerd != nil {
+		l = m.Linkerd.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNS != nil {
+		l = m.DNS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Stickiness != nil {
+		l = m.Stickiness.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -12993,6 +16712,17 @@ func (m *SecretRef) Size() (n int) {
 	return n
 }
 
+func (m *SetCanaryNodes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NodeSelector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *SetCanaryScale) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13114,6 +16844,19 @@ func (m *StepPluginStatus) Size() (n int) {
 	return n
 }
 
+func (m *StepTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.OnTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *StickinessConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13125,6 +16868,37 @@ func (m *StickinessConfig) Size() (n int) {
 	return n
 }
 
+func (m *StrategyRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	l = len(m.CanaryService)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StableService)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ActiveService)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PreviewService)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *StringMatch) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13261,6 +17035,18 @@ func (m *TraefikTrafficRouting) Size() (n int) {
 	return n
 }
 
+func (m *TrafficStickiness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CookieName)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DurationSeconds))
+	return n
+}
+
 func (m *TrafficWeights) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13300,6 +17086,49 @@ func (m *ValueFrom) Size() (n int) {
 	return n
 }
 
+func (m *WaitForStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Index))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 2
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WaitForStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Condition)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.JSONPath)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.OnTimeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *WavefrontMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13761,6 +17590,9 @@ func (this *BlueGreenStatus) String() string {
 		`ScaleUpPreviewCheckPoint:` + fmt.Sprintf("%v", this.ScaleUpPreviewCheckPoint) + `,`,
 		`PrePromotionAnalysisRunStatus:` + strings.Replace(this.PrePromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
 		`PostPromotionAnalysisRunStatus:` + strings.Replace(this.PostPromotionAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`TrafficStepIndex:` + valueToStringGenerated(this.TrafficStepIndex) + `,`,
+		`TrafficStepAnalysisRunStatus:` + strings.Replace(this.TrafficStepAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
+		`Weights:` + strings.Replace(this.Weights.String(), "TrafficWeights", "TrafficWeights", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13769,6 +17601,11 @@ func (this *BlueGreenStrategy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForTrafficSteps := "[]BlueGreenTrafficStep{"
+	for _, f := range this.TrafficSteps {
+		repeatedStringForTrafficSteps += strings.Replace(strings.Replace(f.String(), "BlueGreenTrafficStep", "BlueGreenTrafficStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForTrafficSteps += "}"
 	s := strings.Join([]string{`&BlueGreenStrategy{`,
 		`ActiveService:` + fmt.Sprintf("%v", this.ActiveService) + `,`,
 		`PreviewService:` + fmt.Sprintf("%v", this.PreviewService) + `,`,
@@ -13784,6 +17621,32 @@ func (this *BlueGreenStrategy) String() string {
 		`PreviewMetadata:` + strings.Replace(this.PreviewMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
 		`ActiveMetadata:` + strings.Replace(this.ActiveMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
 		`AbortScaleDownDelaySeconds:` + valueToStringGenerated(this.AbortScaleDownDelaySeconds) + `,`,
+		`TrafficRouting:` + strings.Replace(this.TrafficRouting.String(), "RolloutTrafficRouting", "RolloutTrafficRouting", 1) + `,`,
+		`TrafficSteps:` + repeatedStringForTrafficSteps + `,`,
+		`PrePromotionTimeout:` + strings.Replace(this.PrePromotionTimeout.String(), "StepTimeout", "StepTimeout", 1) + `,`,
+		`PostPromotionTimeout:` + strings.Replace(this.PostPromotionTimeout.String(), "StepTimeout", "StepTimeout", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlueGreenTrafficStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlueGreenTrafficStep{`,
+		`SetWeight:` + valueToStringGenerated(this.SetWeight) + `,`,
+		`Pause:` + strings.Replace(this.Pause.String(), "RolloutPause", "RolloutPause", 1) + `,`,
+		`Analysis:` + strings.Replace(this.Analysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CanaryAbortPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CanaryAbortPolicy{`,
+		`Preserve:` + strings.Replace(this.Preserve.String(), "PreserveAbortedPods", "PreserveAbortedPods", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13804,6 +17667,7 @@ func (this *CanaryStatus) String() string {
 		`Weights:` + strings.Replace(this.Weights.String(), "TrafficWeights", "TrafficWeights", 1) + `,`,
 		`StablePingPong:` + fmt.Sprintf("%v", this.StablePingPong) + `,`,
 		`StepPluginStatuses:` + repeatedStringForStepPluginStatuses + `,`,
+		`WaitFor:` + strings.Replace(this.WaitFor.String(), "WaitForStatus", "WaitForStatus", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13821,6 +17685,10 @@ func (this *CanaryStep) String() string {
 		`SetHeaderRoute:` + strings.Replace(this.SetHeaderRoute.String(), "SetHeaderRoute", "SetHeaderRoute", 1) + `,`,
 		`SetMirrorRoute:` + strings.Replace(this.SetMirrorRoute.String(), "SetMirrorRoute", "SetMirrorRoute", 1) + `,`,
 		`Plugin:` + strings.Replace(this.Plugin.String(), "PluginStep", "PluginStep", 1) + `,`,
+		`SetCanaryNodes:` + strings.Replace(this.SetCanaryNodes.String(), "SetCanaryNodes", "SetCanaryNodes", 1) + `,`,
+		`WaitFor:` + strings.Replace(this.WaitFor.String(), "WaitForStep", "WaitForStep", 1) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`OnTimeout:` + fmt.Sprintf("%v", this.OnTimeout) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13851,6 +17719,8 @@ func (this *CanaryStrategy) String() string {
 		`DynamicStableScale:` + fmt.Sprintf("%v", this.DynamicStableScale) + `,`,
 		`PingPong:` + strings.Replace(this.PingPong.String(), "PingPongSpec", "PingPongSpec", 1) + `,`,
 		`MinPodsPerReplicaSet:` + valueToStringGenerated(this.MinPodsPerReplicaSet) + `,`,
+		`DaemonSet:` + strings.Replace(this.DaemonSet.String(), "DaemonSetCanaryStrategy", "DaemonSetCanaryStrategy", 1) + `,`,
+		`AbortPolicy:` + strings.Replace(this.AbortPolicy.String(), "CanaryAbortPolicy", "CanaryAbortPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13954,6 +17824,105 @@ func (this *ClusterAnalysisTemplateList) String() string {
 	}, "")
 	return s
 }
+func (this *ClusterRolloutPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterRolloutPolicy{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutPolicySpec", "RolloutPolicySpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterRolloutPolicyList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterRolloutPolicy{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterRolloutPolicy", "ClusterRolloutPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterRolloutPolicyList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterRolloutStrategyTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterRolloutStrategyTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutStrategyTemplateSpec", "RolloutStrategyTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterRolloutStrategyTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterRolloutStrategyTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterRolloutStrategyTemplate", "ClusterRolloutStrategyTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterRolloutStrategyTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ConfigRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ConfigRef{`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContourTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContourTrafficRouting{`,
+		`HTTPProxies:` + fmt.Sprintf("%v", this.HTTPProxies) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DNSTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DNSTrafficRouting{`,
+		`DNSEndpoint:` + fmt.Sprintf("%v", this.DNSEndpoint) + `,`,
+		`StableSetIdentifier:` + fmt.Sprintf("%v", this.StableSetIdentifier) + `,`,
+		`CanarySetIdentifier:` + fmt.Sprintf("%v", this.CanarySetIdentifier) + `,`,
+		`WeightProperty:` + fmt.Sprintf("%v", this.WeightProperty) + `,`,
+		`TTLSeconds:` + valueToStringGenerated(this.TTLSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DaemonSetCanaryStrategy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DaemonSetCanaryStrategy{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DatadogMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14188,6 +18157,17 @@ func (this *IstioDestinationRule) String() string {
 	}, "")
 	return s
 }
+func (this *IstioLocality) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IstioLocality{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`MinWeight:` + fmt.Sprintf("%v", this.MinWeight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *IstioTrafficRouting) String() string {
 	if this == nil {
 		return "nil"
@@ -14197,10 +18177,16 @@ func (this *IstioTrafficRouting) String() string {
 		repeatedStringForVirtualServices += strings.Replace(strings.Replace(f.String(), "IstioVirtualService", "IstioVirtualService", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForVirtualServices += "}"
+	repeatedStringForLocalities := "[]IstioLocality{"
+	for _, f := range this.Localities {
+		repeatedStringForLocalities += strings.Replace(strings.Replace(f.String(), "IstioLocality", "IstioLocality", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForLocalities += "}"
 	s := strings.Join([]string{`&IstioTrafficRouting{`,
 		`VirtualService:` + strings.Replace(this.VirtualService.String(), "IstioVirtualService", "IstioVirtualService", 1) + `,`,
 		`DestinationRule:` + strings.Replace(this.DestinationRule.String(), "IstioDestinationRule", "IstioDestinationRule", 1) + `,`,
 		`VirtualServices:` + repeatedStringForVirtualServices + `,`,
+		`Localities:` + repeatedStringForLocalities + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14284,6 +18270,26 @@ func (this *KayentaThreshold) String() string {
 	}, "")
 	return s
 }
+func (this *KongTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KongTrafficRouting{`,
+		`HTTPRoutes:` + fmt.Sprintf("%v", this.HTTPRoutes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LinkerdTrafficRouting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LinkerdTrafficRouting{`,
+		`HTTPRoutes:` + fmt.Sprintf("%v", this.HTTPRoutes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MangedRoutes) String() string {
 	if this == nil {
 		return "nil"
@@ -14565,6 +18571,17 @@ func (this *PreferredDuringSchedulingIgnoredDuringExecution) String() string {
 	}, "")
 	return s
 }
+func (this *PreserveAbortedPods) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PreserveAbortedPods{`,
+		`Count:` + valueToStringGenerated(this.Count) + `,`,
+		`TTL:` + fmt.Sprintf("%v", this.TTL) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PrometheusMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -14765,6 +18782,56 @@ func (this *RolloutExperimentTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutHook{`,
+		`Metadata:` + strings.Replace(strings.Replace(this.Metadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Spec), "JobSpec", "v11.JobSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutHookStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutHookStatus{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`JobName:` + fmt.Sprintf("%v", this.JobName) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutHooks) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutHooks{`,
+		`PreRollout:` + strings.Replace(this.PreRollout.String(), "RolloutHook", "RolloutHook", 1) + `,`,
+		`PostPromotion:` + strings.Replace(this.PostPromotion.String(), "RolloutHook", "RolloutHook", 1) + `,`,
+		`OnAbort:` + strings.Replace(this.OnAbort.String(), "RolloutHook", "RolloutHook", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutLifecycleStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutLifecycleStatus{`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutList) String() string {
 	if this == nil {
 		return "nil"
@@ -14791,10 +18858,109 @@ func (this *RolloutPause) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutPolicy{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutPolicySpec", "RolloutPolicySpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutPolicyList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]RolloutPolicy{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "RolloutPolicy", "RolloutPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&RolloutPolicyList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutPolicyRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutPolicyRule{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`EnforcementAction:` + fmt.Sprintf("%v", this.EnforcementAction) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutPolicySpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRules := "[]RolloutPolicyRule{"
+	for _, f := range this.Rules {
+		repeatedStringForRules += strings.Replace(strings.Replace(f.String(), "RolloutPolicyRule", "RolloutPolicyRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRules += "}"
+	s := strings.Join([]string{`&RolloutPolicySpec{`,
+		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`EnforcementAction:` + fmt.Sprintf("%v", this.EnforcementAction) + `,`,
+		`Rules:` + repeatedStringForRules + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutRetryBackoff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutRetryBackoff{`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Factor:` + valueToStringGenerated(this.Factor) + `,`,
+		`MaxDuration:` + fmt.Sprintf("%v", this.MaxDuration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutRetryPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutRetryPolicy{`,
+		`Limit:` + fmt.Sprintf("%v", this.Limit) + `,`,
+		`Backoff:` + strings.Replace(this.Backoff.String(), "RolloutRetryBackoff", "RolloutRetryBackoff", 1) + `,`,
+		`RetryOn:` + fmt.Sprintf("%v", this.RetryOn) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutRetryStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutRetryStatus{`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`LastAbortReason:` + fmt.Sprintf("%v", this.LastAbortReason) + `,`,
+		`NextRetryAt:` + strings.Replace(fmt.Sprintf("%v", this.NextRetryAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForConfigRefs := "[]ConfigRef{"
+	for _, f := range this.ConfigRefs {
+		repeatedStringForConfigRefs += strings.Replace(strings.Replace(f.String(), "ConfigRef", "ConfigRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConfigRefs += "}"
 	s := strings.Join([]string{`&RolloutSpec{`,
 		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
@@ -14809,6 +18975,10 @@ func (this *RolloutSpec) String() string {
 		`Analysis:` + strings.Replace(this.Analysis.String(), "AnalysisRunStrategy", "AnalysisRunStrategy", 1) + `,`,
 		`ProgressDeadlineAbort:` + fmt.Sprintf("%v", this.ProgressDeadlineAbort) + `,`,
 		`RollbackWindow:` + strings.Replace(this.RollbackWindow.String(), "RollbackWindowSpec", "RollbackWindowSpec", 1) + `,`,
+		`ConfigRefs:` + repeatedStringForConfigRefs + `,`,
+		`Hooks:` + strings.Replace(this.Hooks.String(), "RolloutHooks", "RolloutHooks", 1) + `,`,
+		`RetryPolicy:` + strings.Replace(this.RetryPolicy.String(), "RolloutRetryPolicy", "RolloutRetryPolicy", 1) + `,`,
+		`StrategyRef:` + strings.Replace(this.StrategyRef.String(), "StrategyRef", "StrategyRef", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14832,6 +19002,11 @@ func (this *RolloutStatus) String() string {
 		repeatedStringForALBs += strings.Replace(strings.Replace(f.String(), "ALBStatus", "ALBStatus", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForALBs += "}"
+	repeatedStringForHooks := "[]RolloutHookStatus{"
+	for _, f := range this.Hooks {
+		repeatedStringForHooks += strings.Replace(strings.Replace(f.String(), "RolloutHookStatus", "RolloutHookStatus", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHooks += "}"
 	s := strings.Join([]string{`&RolloutStatus{`,
 		`Abort:` + fmt.Sprintf("%v", this.Abort) + `,`,
 		`PauseConditions:` + repeatedStringForPauseConditions + `,`,
@@ -14859,6 +19034,24 @@ func (this *RolloutStatus) String() string {
 		`WorkloadObservedGeneration:` + fmt.Sprintf("%v", this.WorkloadObservedGeneration) + `,`,
 		`ALB:` + strings.Replace(this.ALB.String(), "ALBStatus", "ALBStatus", 1) + `,`,
 		`ALBs:` + repeatedStringForALBs + `,`,
+		`Hooks:` + repeatedStringForHooks + `,`,
+		`Retry:` + strings.Replace(this.Retry.String(), "RolloutRetryStatus", "RolloutRetryStatus", 1) + `,`,
+		`CurrentStep:` + strings.Replace(this.CurrentStep.String(), "RolloutStepStatus", "RolloutStepStatus", 1) + `,`,
+		`Lifecycle:` + strings.Replace(this.Lifecycle.String(), "RolloutLifecycleStatus", "RolloutLifecycleStatus", 1) + `,`,
+		`StrategyTemplate:` + strings.Replace(this.StrategyTemplate.String(), "RolloutStrategyTemplateStatus", "RolloutStrategyTemplateStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStepStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutStepStatus{`,
+		`Step:` + fmt.Sprintf("%v", this.Step) + `,`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`TimedOutAt:` + strings.Replace(fmt.Sprintf("%v", this.TimedOutAt), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14874,6 +19067,57 @@ func (this *RolloutStrategy) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutStrategyTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutStrategyTemplate{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "RolloutStrategyTemplateSpec", "RolloutStrategyTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStrategyTemplateList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]RolloutStrategyTemplate{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "RolloutStrategyTemplate", "RolloutStrategyTemplate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&RolloutStrategyTemplateList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStrategyTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutStrategyTemplateSpec{`,
+		`Strategy:` + strings.Replace(strings.Replace(this.Strategy.String(), "RolloutStrategy", "RolloutStrategy", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutStrategyTemplateStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutStrategyTemplateStatus{`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`StrategyHash:` + fmt.Sprintf("%v", this.StrategyHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutTrafficRouting) String() string {
 	if this == nil {
 		return "nil"
@@ -14905,6 +19149,11 @@ func (this *RolloutTrafficRouting) String() string {
 		`Apisix:` + strings.Replace(this.Apisix.String(), "ApisixTrafficRouting", "ApisixTrafficRouting", 1) + `,`,
 		`Plugins:` + mapStringForPlugins + `,`,
 		`MaxTrafficWeight:` + valueToStringGenerated(this.MaxTrafficWeight) + `,`,
+		`Contour:` + strings.Replace(this.Contour.String(), "ContourTrafficRouting", "ContourTrafficRouting", 1) + `,`,
+		`Kong:` + strings.Replace(this.Kong.String(), "KongTrafficRouting", "KongTrafficRouting", 1) + `,`,
+		`Linkerd:` + strings.Replace(this.Linkerd.String(), "LinkerdTrafficRouting", "LinkerdTrafficRouting", 1) + `,`,
+		`DNS:` + strings.Replace(this.DNS.String(), "DNSTrafficRouting", "DNSTrafficRouting", 1) + `,`,
+		`Stickiness:` + strings.Replace(this.Stickiness.String(), "TrafficStickiness", "TrafficStickiness", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -14992,6 +19241,16 @@ func (this *SecretRef) String() string {
 	}, "")
 	return s
 }
+func (this *SetCanaryNodes) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetCanaryNodes{`,
+		`NodeSelector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.NodeSelector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SetCanaryScale) String() string {
 	if this == nil {
 		return "nil"
@@ -15082,6 +19341,17 @@ func (this *StepPluginStatus) String() string {
 	}, "")
 	return s
 }
+func (this *StepTimeout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepTimeout{`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`OnTimeout:` + fmt.Sprintf("%v", this.OnTimeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StickinessConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -15093,6 +19363,28 @@ func (this *StickinessConfig) String() string {
 	}, "")
 	return s
 }
+func (this *StrategyRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForArgs := "[]AnalysisRunArgument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&StrategyRef{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ClusterScope:` + fmt.Sprintf("%v", this.ClusterScope) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`Weights:` + fmt.Sprintf("%v", this.Weights) + `,`,
+		`CanaryService:` + fmt.Sprintf("%v", this.CanaryService) + `,`,
+		`StableService:` + fmt.Sprintf("%v", this.StableService) + `,`,
+		`ActiveService:` + fmt.Sprintf("%v", this.ActiveService) + `,`,
+		`PreviewService:` + fmt.Sprintf("%v", this.PreviewService) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *StringMatch) String() string {
 	if this == nil {
 		return "nil"
@@ -15193,6 +19485,17 @@ func (this *TraefikTrafficRouting) String() string {
 	}, "")
 	return s
 }
+func (this *TrafficStickiness) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TrafficStickiness{`,
+		`CookieName:` + fmt.Sprintf("%v", this.CookieName) + `,`,
+		`DurationSeconds:` + fmt.Sprintf("%v", this.DurationSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TrafficWeights) String() string {
 	if this == nil {
 		return "nil"
@@ -15222,6 +19525,38 @@ func (this *ValueFrom) String() string {
 	}, "")
 	return s
 }
+func (this *WaitForStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WaitForStatus{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`Met:` + fmt.Sprintf("%v", this.Met) + `,`,
+		`TimedOut:` + fmt.Sprintf("%v", this.TimedOut) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WaitForStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WaitForStep{`,
+		`APIVersion:` + fmt.Sprintf("%v", this.APIVersion) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
+		`JSONPath:` + fmt.Sprintf("%v", this.JSONPath) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`OnTimeout:` + fmt.Sprintf("%v", this.OnTimeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WavefrontMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -19158,6 +23493,98 @@ func (m *BlueGreenStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStepIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrafficStepIndex = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStepAnalysisRunStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TrafficStepAnalysisRunStatus == nil {
+				m.TrafficStepAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			}
+			if err := m.TrafficStepAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Weights == nil {
+				m.Weights = &TrafficWeights{}
+			}
+			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19608,59 +24035,9 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AbortScaleDownDelaySeconds = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19687,16 +24064,16 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentStepAnalysisRunStatus == nil {
-				m.CurrentStepAnalysisRunStatus = &RolloutAnalysisRunStatus{}
+			if m.TrafficRouting == nil {
+				m.TrafficRouting = &RolloutTrafficRouting{}
 			}
-			if err := m.CurrentStepAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBackgroundAnalysisRunStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficSteps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19723,18 +24100,16 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentBackgroundAnalysisRunStatus == nil {
-				m.CurrentBackgroundAnalysisRunStatus = &RolloutAnalysisRunStatus{}
-			}
-			if err := m.CurrentBackgroundAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TrafficSteps = append(m.TrafficSteps, BlueGreenTrafficStep{})
+			if err := m.TrafficSteps[len(m.TrafficSteps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentExperiment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrePromotionTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19744,27 +24119,31 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentExperiment = string(dAtA[iNdEx:postIndex])
+			if m.PrePromotionTimeout == nil {
+				m.PrePromotionTimeout = &StepTimeout{}
+			}
+			if err := m.PrePromotionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotionTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19791,18 +24170,88 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Weights == nil {
-				m.Weights = &TrafficWeights{}
+			if m.PostPromotionTimeout == nil {
+				m.PostPromotionTimeout = &StepTimeout{}
 			}
-			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PostPromotionTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlueGreenTrafficStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlueGreenTrafficStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlueGreenTrafficStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetWeight = &v
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StablePingPong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19812,27 +24261,31 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StablePingPong = PingPongType(dAtA[iNdEx:postIndex])
+			if m.Pause == nil {
+				m.Pause = &RolloutPause{}
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepPluginStatuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19859,8 +24312,10 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StepPluginStatuses = append(m.StepPluginStatuses, StepPluginStatus{})
-			if err := m.StepPluginStatuses[len(m.StepPluginStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysis{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19885,7 +24340,7 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CanaryStep) Unmarshal(dAtA []byte) error {
+func (m *CanaryAbortPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19908,17 +24363,17 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanaryAbortPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanaryAbortPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preserve", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19928,15 +24383,81 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SetWeight = &v
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Preserve == nil {
+				m.Preserve = &PreserveAbortedPods{}
+			}
+			if err := m.Preserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanaryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanaryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepAnalysisRunStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19963,16 +24484,16 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pause == nil {
-				m.Pause = &RolloutPause{}
+			if m.CurrentStepAnalysisRunStatus == nil {
+				m.CurrentStepAnalysisRunStatus = &RolloutAnalysisRunStatus{}
 			}
-			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentStepAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Experiment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBackgroundAnalysisRunStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19999,18 +24520,18 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Experiment == nil {
-				m.Experiment = &RolloutExperimentStep{}
+			if m.CurrentBackgroundAnalysisRunStatus == nil {
+				m.CurrentBackgroundAnalysisRunStatus = &RolloutAnalysisRunStatus{}
 			}
-			if err := m.Experiment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentBackgroundAnalysisRunStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentExperiment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20020,31 +24541,27 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &RolloutAnalysis{}
-			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CurrentExperiment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetCanaryScale", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20071,18 +24588,18 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetCanaryScale == nil {
-				m.SetCanaryScale = &SetCanaryScale{}
+			if m.Weights == nil {
+				m.Weights = &TrafficWeights{}
 			}
-			if err := m.SetCanaryScale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetHeaderRoute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StablePingPong", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20092,31 +24609,27 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetHeaderRoute == nil {
-				m.SetHeaderRoute = &SetHeaderRoute{}
-			}
-			if err := m.SetHeaderRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StablePingPong = PingPongType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetMirrorRoute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StepPluginStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20143,16 +24656,14 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SetMirrorRoute == nil {
-				m.SetMirrorRoute = &SetMirrorRoute{}
-			}
-			if err := m.SetMirrorRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StepPluginStatuses = append(m.StepPluginStatuses, StepPluginStatus{})
+			if err := m.StepPluginStatuses[len(m.StepPluginStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitFor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20179,10 +24690,10 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = &PluginStep{}
+			if m.WaitFor == nil {
+				m.WaitFor = &WaitForStatus{}
 			}
-			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WaitFor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -20207,7 +24718,7 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
+func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20230,17 +24741,17 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanaryStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanaryStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetWeight", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20250,29 +24761,17 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CanaryService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SetWeight = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20282,27 +24781,31 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableService = string(dAtA[iNdEx:postIndex])
+			if m.Pause == nil {
+				m.Pause = &RolloutPause{}
+			}
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Experiment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20329,14 +24832,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, CanaryStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Experiment == nil {
+				m.Experiment = &RolloutExperimentStep{}
+			}
+			if err := m.Experiment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20363,16 +24868,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TrafficRouting == nil {
-				m.TrafficRouting = &RolloutTrafficRouting{}
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysis{}
 			}
-			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetCanaryScale", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20399,16 +24904,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUnavailable == nil {
-				m.MaxUnavailable = &intstr.IntOrString{}
+			if m.SetCanaryScale == nil {
+				m.SetCanaryScale = &SetCanaryScale{}
 			}
-			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SetCanaryScale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetHeaderRoute", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20435,16 +24940,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxSurge == nil {
-				m.MaxSurge = &intstr.IntOrString{}
+			if m.SetHeaderRoute == nil {
+				m.SetHeaderRoute = &SetHeaderRoute{}
 			}
-			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SetHeaderRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetMirrorRoute", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20471,52 +24976,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &RolloutAnalysisBackground{}
-			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AntiAffinity == nil {
-				m.AntiAffinity = &AntiAffinity{}
+			if m.SetMirrorRoute == nil {
+				m.SetMirrorRoute = &SetMirrorRoute{}
 			}
-			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SetMirrorRoute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20543,16 +25012,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CanaryMetadata == nil {
-				m.CanaryMetadata = &PodTemplateMetadata{}
+			if m.Plugin == nil {
+				m.Plugin = &PluginStep{}
 			}
-			if err := m.CanaryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plugin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetCanaryNodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20579,96 +25048,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StableMetadata == nil {
-				m.StableMetadata = &PodTemplateMetadata{}
+			if m.SetCanaryNodes == nil {
+				m.SetCanaryNodes = &SetCanaryNodes{}
 			}
-			if err := m.StableMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SetCanaryNodes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ScaleDownDelaySeconds = &v
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelayRevisionLimit", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ScaleDownDelayRevisionLimit = &v
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbortScaleDownDelaySeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AbortScaleDownDelaySeconds = &v
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DynamicStableScale", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.DynamicStableScale = bool(v != 0)
-		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingPong", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitFor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20695,86 +25084,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PingPong == nil {
-				m.PingPong = &PingPongSpec{}
+			if m.WaitFor == nil {
+				m.WaitFor = &WaitForStep{}
 			}
-			if err := m.PingPong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WaitFor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPodsPerReplicaSet", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MinPodsPerReplicaSet = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetric: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetric: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20802,13 +25121,13 @@ func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.Timeout = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricDataQueries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnTimeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20818,25 +25137,23 @@ func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricDataQueries = append(m.MetricDataQueries, CloudWatchMetricDataQuery{})
-			if err := m.MetricDataQueries[len(m.MetricDataQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OnTimeout = StepTimeoutPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20859,7 +25176,7 @@ func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
+func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20882,15 +25199,15 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetricDataQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetricDataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20918,11 +25235,11 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.CanaryService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20950,14 +25267,13 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Expression = &s
+			m.StableService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20967,28 +25283,29 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Label = &s
+			m.Steps = append(m.Steps, CanaryStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricStat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21015,16 +25332,16 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetricStat == nil {
-				m.MetricStat = &CloudWatchMetricStat{}
+			if m.TrafficRouting == nil {
+				m.TrafficRouting = &RolloutTrafficRouting{}
 			}
-			if err := m.MetricStat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21051,18 +25368,18 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Period == nil {
-				m.Period = &intstr.IntOrString{}
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
 			}
-			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21072,66 +25389,31 @@ func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.ReturnData = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.MaxSurge == nil {
+				m.MaxSurge = &intstr.IntOrString{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetricStat: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetricStat: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21158,13 +25440,16 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metric.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysisBackground{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21191,15 +25476,18 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AntiAffinity == nil {
+				m.AntiAffinity = &AntiAffinity{}
+			}
+			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21209,29 +25497,33 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stat = string(dAtA[iNdEx:postIndex])
+			if m.CanaryMetadata == nil {
+				m.CanaryMetadata = &PodTemplateMetadata{}
+			}
+			if err := m.CanaryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21241,79 +25533,33 @@ func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.StableMetadata == nil {
+				m.StableMetadata = &PodTemplateMetadata{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.StableMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetricStatMetric: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetricStatMetric: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21323,31 +25569,17 @@ func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Dimensions = append(m.Dimensions, CloudWatchMetricStatMetricDimension{})
-			if err := m.Dimensions[len(m.Dimensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+			m.ScaleDownDelaySeconds = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelayRevisionLimit", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21357,29 +25589,17 @@ func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			m.ScaleDownDelayRevisionLimit = &v
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortScaleDownDelaySeconds", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21389,80 +25609,17 @@ func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Namespace = &s
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloudWatchMetricStatMetricDimension) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloudWatchMetricStatMetricDimension: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudWatchMetricStatMetricDimension: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			m.AbortScaleDownDelaySeconds = &v
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicStableScale", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21472,29 +25629,17 @@ func (m *CloudWatchMetricStatMetricDimension) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.DynamicStableScale = bool(v != 0)
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PingPong", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21504,77 +25649,51 @@ func (m *CloudWatchMetricStatMetricDimension) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.PingPong == nil {
+				m.PingPong = &PingPongSpec{}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if err := m.PingPong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPodsPerReplicaSet", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.MinPodsPerReplicaSet = &v
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DaemonSet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21601,13 +25720,16 @@ func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DaemonSet == nil {
+				m.DaemonSet = &DaemonSetCanaryStrategy{}
+			}
+			if err := m.DaemonSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21634,7 +25756,10 @@ func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AbortPolicy == nil {
+				m.AbortPolicy = &CanaryAbortPolicy{}
+			}
+			if err := m.AbortPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21659,7 +25784,7 @@ func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21682,17 +25807,17 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterAnalysisTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21702,28 +25827,27 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricDataQueries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21750,8 +25874,8 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterAnalysisTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MetricDataQueries = append(m.MetricDataQueries, CloudWatchMetricDataQuery{})
+			if err := m.MetricDataQueries[len(m.MetricDataQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -21776,7 +25900,7 @@ func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetricDataQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21799,15 +25923,15 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatadogMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetricDataQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatadogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetricDataQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21835,11 +25959,11 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21867,138 +25991,12 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Expression = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Queries[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22026,13 +26024,14 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Formula = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Label = &s
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricStat", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22042,59 +26041,31 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Aggregator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.MetricStat == nil {
+				m.MetricStat = &CloudWatchMetricStat{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.MetricStat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Aggregator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22121,65 +26092,18 @@ func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Period == nil {
+				m.Period = &intstr.IntOrString{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DryRun) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DryRun: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DryRun: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22189,24 +26113,13 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.ReturnData = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22228,7 +26141,7 @@ func (m *DryRun) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Experiment) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetricStat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22251,15 +26164,15 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetricStat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetricStat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22286,13 +26199,13 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metric.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22319,15 +26232,15 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Period.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stat", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22337,24 +26250,55 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22377,7 +26321,7 @@ func (m *Experiment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetricStatMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22400,17 +26344,17 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetricStatMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetricStatMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dimensions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22420,27 +26364,29 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Dimensions = append(m.Dimensions, CloudWatchMetricStatMetricDimension{})
+			if err := m.Dimensions[len(m.Dimensions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22468,43 +26414,11 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
+			m.MetricName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22532,7 +26446,8 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22555,7 +26470,7 @@ func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+func (m *CloudWatchMetricStatMetricDimension) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22578,10 +26493,10 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudWatchMetricStatMetricDimension: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudWatchMetricStatMetricDimension: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22618,7 +26533,7 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22646,82 +26561,8 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ClusterScope = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, Argument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequiredForCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22743,7 +26584,7 @@ func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
+func (m *ClusterAnalysisTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22766,17 +26607,17 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterAnalysisTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22786,29 +26627,30 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ExperimentConditionType(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22818,60 +26660,78 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterAnalysisTemplateList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterAnalysisTemplateList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterAnalysisTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22898,15 +26758,15 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22916,55 +26776,25 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Items = append(m.Items, ClusterAnalysisTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22987,7 +26817,7 @@ func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentList) Unmarshal(dAtA []byte) error {
+func (m *ClusterRolloutPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23010,15 +26840,15 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterRolloutPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterRolloutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23045,13 +26875,13 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23078,8 +26908,7 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Experiment{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23104,7 +26933,7 @@ func (m *ExperimentList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
+func (m *ClusterRolloutPolicyList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23127,15 +26956,15 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterRolloutPolicyList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterRolloutPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23162,86 +26991,13 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, TemplateSpec{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = DurationString(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ProgressDeadlineSeconds = &v
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Terminate = bool(v != 0)
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23268,68 +27024,64 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyses = append(m.Analyses, ExperimentAnalysisTemplateRef{})
-			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ClusterRolloutPolicy{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ScaleDownDelaySeconds = &v
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterRolloutStrategyTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterRolloutStrategyTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterRolloutStrategyTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23356,14 +27108,13 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
-			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23390,7 +27141,7 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23415,7 +27166,7 @@ func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
+func (m *ClusterRolloutStrategyTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23438,17 +27189,17 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExperimentStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterRolloutStrategyTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExperimentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterRolloutStrategyTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23458,59 +27209,28 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateStatuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23537,52 +27257,66 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateStatuses = append(m.TemplateStatuses, TemplateStatus{})
-			if err := m.TemplateStatuses[len(m.TemplateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ClusterRolloutStrategyTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AvailableAt == nil {
-				m.AvailableAt = &v1.Time{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.AvailableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23592,31 +27326,29 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, ExperimentCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Kind = ConfigRefKind(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23626,25 +27358,23 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisRuns = append(m.AnalysisRuns, ExperimentAnalysisRunStatus{})
-			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23667,7 +27397,7 @@ func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FieldRef) Unmarshal(dAtA []byte) error {
+func (m *ContourTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23690,15 +27420,15 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FieldRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContourTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FieldRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContourTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPProxies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23726,7 +27456,7 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FieldPath = string(dAtA[iNdEx:postIndex])
+			m.HTTPProxies = append(m.HTTPProxies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23749,7 +27479,7 @@ func (m *FieldRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
+func (m *DNSTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23772,15 +27502,15 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GraphiteMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: DNSTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GraphiteMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DNSTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DNSEndpoint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23808,11 +27538,11 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.DNSEndpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSetIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23840,61 +27570,11 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			m.StableSetIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HeaderRoutingMatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HeaderRoutingMatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySetIdentifier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23922,13 +27602,13 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HeaderName = string(dAtA[iNdEx:postIndex])
+			m.CanarySetIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeaderValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightProperty", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23938,28 +27618,44 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeaderValue == nil {
-				m.HeaderValue = &StringMatch{}
+			m.WeightProperty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSeconds", wireType)
 			}
-			if err := m.HeaderValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.TTLSeconds = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23981,7 +27677,7 @@ func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
+func (m *DaemonSetCanaryStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24004,49 +27700,17 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfluxdbMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: DaemonSetCanaryStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfluxdbMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DaemonSetCanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24056,24 +27720,12 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24095,7 +27747,7 @@ func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
+func (m *DatadogMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24118,15 +27770,15 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatadogMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatadogMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24154,11 +27806,11 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24186,11 +27838,138 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queries == nil {
+				m.Queries = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24218,7 +27997,104 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
+			m.Formula = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24241,7 +28117,7 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *DryRun) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24264,15 +28140,97 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: DryRun: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DryRun: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Experiment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Experiment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Experiment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24299,16 +28257,13 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VirtualService == nil {
-				m.VirtualService = &IstioVirtualService{}
-			}
-			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24335,16 +28290,13 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DestinationRule == nil {
-				m.DestinationRule = &IstioDestinationRule{}
-			}
-			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualServices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24371,8 +28323,7 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VirtualServices = append(m.VirtualServices, IstioVirtualService{})
-			if err := m.VirtualServices[len(m.VirtualServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -24397,7 +28348,7 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
+func (m *ExperimentAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24420,10 +28371,10 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -24460,7 +28411,7 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24488,13 +28439,13 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
+			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24504,31 +28455,29 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSRoutes = append(m.TLSRoutes, TLSRoute{})
-			if err := m.TLSRoutes[len(m.TLSRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24538,25 +28487,23 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TCPRoutes = append(m.TCPRoutes, TCPRoute{})
-			if err := m.TCPRoutes[len(m.TCPRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24579,7 +28526,7 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobMetric) Unmarshal(dAtA []byte) error {
+func (m *ExperimentAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24602,17 +28549,17 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24622,28 +28569,79 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24670,10 +28668,31 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, Argument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredForCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24695,7 +28714,7 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
+func (m *ExperimentCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24718,15 +28737,15 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24754,11 +28773,11 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Type = ExperimentConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24786,13 +28805,13 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = string(dAtA[iNdEx:postIndex])
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24802,29 +28821,30 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24834,27 +28854,28 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24882,11 +28903,11 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24914,11 +28935,61 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExperimentList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExperimentList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExperimentList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24945,13 +29016,13 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24978,8 +29049,8 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, KayentaScope{})
-			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Experiment{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25004,7 +29075,7 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaScope) Unmarshal(dAtA []byte) error {
+func (m *ExperimentSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25027,17 +29098,17 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaScope: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaScope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25047,29 +29118,31 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Templates = append(m.Templates, TemplateSpec{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25079,28 +29152,67 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ControlScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineSeconds = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Terminate = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExperimentScope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25127,65 +29239,16 @@ func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExperimentScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Analyses = append(m.Analyses, ExperimentAnalysisTemplateRef{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaThreshold: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownDelaySeconds", wireType)
 			}
-			m.Pass = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25195,16 +29258,17 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Pass |= int64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
+			m.ScaleDownDelaySeconds = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			m.Marginal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25214,66 +29278,65 @@ func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Marginal |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
+			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MangedRoutes: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MangedRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25283,23 +29346,24 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -25322,7 +29386,7 @@ func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Measurement) Unmarshal(dAtA []byte) error {
+func (m *ExperimentStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25345,10 +29409,10 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExperimentStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExperimentStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25417,7 +29481,7 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25444,16 +29508,14 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TemplateStatuses = append(m.TemplateStatuses, TemplateStatus{})
+			if err := m.TemplateStatuses[len(m.TemplateStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25480,48 +29542,16 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
+			if m.AvailableAt == nil {
+				m.AvailableAt = &v1.Time{}
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AvailableAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25548,107 +29578,14 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Conditions = append(m.Conditions, ExperimentCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRuns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25675,10 +29612,8 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResumeAt == nil {
-				m.ResumeAt = &v1.Time{}
-			}
-			if err := m.ResumeAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AnalysisRuns = append(m.AnalysisRuns, ExperimentAnalysisRunStatus{})
+			if err := m.AnalysisRuns[len(m.AnalysisRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -25703,7 +29638,7 @@ func (m *Measurement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
+func (m *FieldRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25726,15 +29661,15 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MeasurementRetention: wiretype end group for non-group")
+			return fmt.Errorf("proto: FieldRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MeasurementRetention: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FieldRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25762,32 +29697,13 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricName = string(dAtA[iNdEx:postIndex])
+			m.FieldPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
@@ -25804,7 +29720,7 @@ func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metric) Unmarshal(dAtA []byte) error {
+func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25827,15 +29743,15 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
+			return fmt.Errorf("proto: GraphiteMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GraphiteMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25863,11 +29779,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25895,11 +29811,61 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeaderRoutingMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeaderRoutingMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeaderRoutingMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25927,11 +29893,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialDelay = DurationString(dAtA[iNdEx:postIndex])
+			m.HeaderName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderValue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25958,16 +29924,66 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Count == nil {
-				m.Count = &intstr.IntOrString{}
+			if m.HeaderValue == nil {
+				m.HeaderValue = &StringMatch{}
 			}
-			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HeaderValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InfluxdbMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InfluxdbMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InfluxdbMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25995,11 +30011,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
+			m.Profile = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26027,13 +30043,63 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26043,33 +30109,29 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailureLimit == nil {
-				m.FailureLimit = &intstr.IntOrString{}
-			}
-			if err := m.FailureLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26079,33 +30141,29 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InconclusiveLimit == nil {
-				m.InconclusiveLimit = &intstr.IntOrString{}
-			}
-			if err := m.InconclusiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveErrorLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26115,33 +30173,79 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsecutiveErrorLimit == nil {
-				m.ConsecutiveErrorLimit = &intstr.IntOrString{}
-			}
-			if err := m.ConsecutiveErrorLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 10:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IstioLocality) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioLocality: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioLocality: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26151,30 +30255,29 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccessLimit", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWeight", wireType)
 			}
-			var msglen int
+			m.MinWeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26184,28 +30287,11 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinWeight |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConsecutiveSuccessLimit == nil {
-				m.ConsecutiveSuccessLimit = &intstr.IntOrString{}
-			}
-			if err := m.ConsecutiveSuccessLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26227,7 +30313,7 @@ func (m *Metric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricProvider) Unmarshal(dAtA []byte) error {
+func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26250,15 +30336,15 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26285,16 +30371,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Prometheus == nil {
-				m.Prometheus = &PrometheusMetric{}
+			if m.VirtualService == nil {
+				m.VirtualService = &IstioVirtualService{}
 			}
-			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kayenta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26321,16 +30407,16 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kayenta == nil {
-				m.Kayenta = &KayentaMetric{}
+			if m.DestinationRule == nil {
+				m.DestinationRule = &IstioDestinationRule{}
 			}
-			if err := m.Kayenta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualServices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26357,16 +30443,14 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Web == nil {
-				m.Web = &WebMetric{}
-			}
-			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VirtualServices = append(m.VirtualServices, IstioVirtualService{})
+			if err := m.VirtualServices[len(m.VirtualServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Localities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26393,18 +30477,66 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datadog == nil {
-				m.Datadog = &DatadogMetric{}
-			}
-			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Localities = append(m.Localities, IstioLocality{})
+			if err := m.Localities[len(m.Localities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26414,33 +30546,29 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Wavefront == nil {
-				m.Wavefront = &WavefrontMetric{}
-			}
-			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26450,31 +30578,27 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewRelic == nil {
-				m.NewRelic = &NewRelicMetric{}
-			}
-			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26501,16 +30625,14 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &JobMetric{}
-			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TLSRoutes = append(m.TLSRoutes, TLSRoute{})
+			if err := m.TLSRoutes[len(m.TLSRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CloudWatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TCPRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26537,88 +30659,64 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CloudWatch == nil {
-				m.CloudWatch = &CloudWatchMetric{}
-			}
-			if err := m.CloudWatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TCPRoutes = append(m.TCPRoutes, TCPRoute{})
+			if err := m.TCPRoutes[len(m.TCPRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Graphite", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Graphite == nil {
-				m.Graphite = &GraphiteMetric{}
-			}
-			if err := m.Graphite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Influxdb == nil {
-				m.Influxdb = &InfluxdbMetric{}
-			}
-			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SkyWalking", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26645,16 +30743,13 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SkyWalking == nil {
-				m.SkyWalking = &SkyWalkingMetric{}
-			}
-			if err := m.SkyWalking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -26681,104 +30776,9 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugin == nil {
-				m.Plugin = make(map[string]encoding_json.RawMessage)
-			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Plugin[mapkey] = ((encoding_json.RawMessage)(mapvalue))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -26801,7 +30801,7 @@ func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetricResult) Unmarshal(dAtA []byte) error {
+func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -26824,15 +30824,15 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26860,11 +30860,11 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26892,13 +30892,13 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Application = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26908,29 +30908,27 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Measurements = append(m.Measurements, Measurement{})
-			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -26958,13 +30956,13 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26974,35 +30972,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Successful = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Failed = 0
+			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27012,35 +31004,29 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Inconclusive = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Error = 0
+			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27050,53 +31036,28 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ConsecutiveError = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConsecutiveError |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DryRun = bool(v != 0)
-		case 12:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -27123,123 +31084,11 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Scopes = append(m.Scopes, KayentaScope{})
+			if err := m.Scopes[len(m.Scopes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccess", wireType)
-			}
-			m.ConsecutiveSuccess = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConsecutiveSuccess |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27261,7 +31110,7 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+func (m *KayentaScope) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27284,15 +31133,15 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaScope: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaScope: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27320,13 +31169,13 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlScope", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27336,29 +31185,30 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			if err := m.ControlScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExperimentScope", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27368,12 +31218,25 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Timeout = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExperimentScope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27395,7 +31258,7 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *KayentaThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27418,17 +31281,17 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: KayentaThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KayentaThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pass", wireType)
 			}
-			var stringLen uint64
+			m.Pass = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27438,29 +31301,16 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Pass |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marginal", wireType)
 			}
-			var stringLen uint64
+			m.Marginal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -27470,154 +31320,64 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Marginal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableIngress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KongTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AdditionalIngressAnnotations == nil {
-				m.AdditionalIngressAnnotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AdditionalIngressAnnotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KongTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KongTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableIngresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPRoutes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27645,134 +31405,7 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableIngresses = append(m.StableIngresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryIngressAnnotations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CanaryIngressAnnotations == nil {
-				m.CanaryIngressAnnotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.CanaryIngressAnnotations[mapkey] = mapvalue
+			m.HTTPRoutes = append(m.HTTPRoutes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27795,7 +31428,7 @@ func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
+func (m *LinkerdTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27818,15 +31451,15 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OAuth2Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkerdTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OAuth2Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkerdTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPRoutes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27854,75 +31487,61 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			m.HTTPRoutes = append(m.HTTPRoutes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MangedRoutes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientSecret = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MangedRoutes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MangedRoutes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -27950,7 +31569,7 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27973,7 +31592,7 @@ func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+func (m *Measurement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -27996,15 +31615,15 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: Measurement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Measurement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28032,11 +31651,11 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28064,13 +31683,13 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28080,29 +31699,33 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28112,77 +31735,31 @@ func (m *ObjectRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScaleDown = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PauseCondition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28210,11 +31787,11 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28241,7 +31818,137 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResumeAt == nil {
+				m.ResumeAt = &v1.Time{}
+			}
+			if err := m.ResumeAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -28266,7 +31973,7 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
+func (m *MeasurementRetention) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28289,15 +31996,15 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PingPongSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MeasurementRetention: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PingPongSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MeasurementRetention: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PingService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28325,13 +32032,13 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PingService = string(dAtA[iNdEx:postIndex])
+			m.MetricName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PongService", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28341,24 +32048,11 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PongService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28380,7 +32074,7 @@ func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PluginStep) Unmarshal(dAtA []byte) error {
+func (m *Metric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28403,10 +32097,10 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PluginStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PluginStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -28443,9 +32137,9 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28455,79 +32149,59 @@ func (m *PluginStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
-			if m.Config == nil {
-				m.Config = []byte{}
-			}
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDelay", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.InitialDelay = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28554,107 +32228,80 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Count == nil {
+				m.Count = &intstr.IntOrString{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.Count.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCondition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureCondition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28681,159 +32328,123 @@ func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.FailureLimit == nil {
+				m.FailureLimit = &intstr.IntOrString{}
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.FailureLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InconclusiveLimit == nil {
+				m.InconclusiveLimit = &intstr.IntOrString{}
+			}
+			if err := m.InconclusiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveErrorLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if m.ConsecutiveErrorLimit == nil {
+				m.ConsecutiveErrorLimit = &intstr.IntOrString{}
 			}
-			if iNdEx >= l {
+			if err := m.ConsecutiveErrorLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccessLimit", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28843,11 +32454,28 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsecutiveSuccessLimit == nil {
+				m.ConsecutiveSuccessLimit = &intstr.IntOrString{}
+			}
+			if err := m.ConsecutiveSuccessLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28869,7 +32497,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
+func (m *MetricProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28892,17 +32520,17 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28912,29 +32540,33 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusMetric{}
+			}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kayenta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28944,27 +32576,31 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			if m.Kayenta == nil {
+				m.Kayenta = &KayentaMetric{}
+			}
+			if err := m.Kayenta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Web", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28991,15 +32627,18 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Web == nil {
+				m.Web = &WebMetric{}
+			}
+			if err := m.Web.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datadog", wireType)
 			}
-			var v int64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29009,17 +32648,33 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Timeout = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datadog == nil {
+				m.Datadog = &DatadogMetric{}
+			}
+			if err := m.Datadog.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wavefront", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29029,15 +32684,31 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Insecure = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Wavefront == nil {
+				m.Wavefront = &WavefrontMetric{}
+			}
+			if err := m.Wavefront.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRelic", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29064,14 +32735,16 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, WebMetricHeader{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NewRelic == nil {
+				m.NewRelic = &NewRelicMetric{}
+			}
+			if err := m.NewRelic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29098,68 +32771,54 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RangeQuery == nil {
-				m.RangeQuery = &PrometheusRangeQueryArgs{}
+			if m.Job == nil {
+				m.Job = &JobMetric{}
 			}
-			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloudWatch", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.CloudWatch == nil {
+				m.CloudWatch = &CloudWatchMetric{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PrometheusRangeQueryArgs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrometheusRangeQueryArgs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.CloudWatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Graphite", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29169,29 +32828,33 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			if m.Graphite == nil {
+				m.Graphite = &GraphiteMetric{}
+			}
+			if err := m.Graphite.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Influxdb", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29201,29 +32864,33 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = string(dAtA[iNdEx:postIndex])
+			if m.Influxdb == nil {
+				m.Influxdb = &InfluxdbMetric{}
+			}
+			if err := m.Influxdb.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SkyWalking", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29233,74 +32900,156 @@ func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Step = DurationString(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if m.SkyWalking == nil {
+				m.SkyWalking = &SkyWalkingMetric{}
+			}
+			if err := m.SkyWalking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugin", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Plugin == nil {
+				m.Plugin = make(map[string]encoding_json.RawMessage)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Plugin[mapkey] = ((encoding_json.RawMessage)(mapvalue))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -29322,7 +33071,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
+func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29345,17 +33094,17 @@ func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RollbackWindowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetricResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RollbackWindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetricResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Revisions = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29365,66 +33114,7273 @@ func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Revisions |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Measurements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Measurements = append(m.Measurements, Measurement{})
+			if err := m.Measurements[len(m.Measurements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Rollout) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			if iNdEx >= l {
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			}
+			m.Successful = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Successful |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			}
+			m.Inconclusive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Inconclusive |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			m.Error = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Error |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveError", wireType)
+			}
+			m.ConsecutiveError = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveError |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveSuccess", wireType)
+			}
+			m.ConsecutiveSuccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveSuccess |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NginxTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NginxTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NginxTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnotationPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnotationPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableIngress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalIngressAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AdditionalIngressAnnotations == nil {
+				m.AdditionalIngressAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AdditionalIngressAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableIngresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableIngresses = append(m.StableIngresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryIngressAnnotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanaryIngressAnnotations == nil {
+				m.CanaryIngressAnnotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.CanaryIngressAnnotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OAuth2Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OAuth2Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OAuth2Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientSecret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientSecret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDown", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScaleDown = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = PauseReason(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PingPongSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PingPongSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PingService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PingService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PongService", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PongService = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Config = append(m.Config[:0], dAtA[iNdEx:postIndex]...)
+			if m.Config == nil {
+				m.Config = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodTemplateMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodTemplateMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodTemplateMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreferredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreferredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PreserveAbortedPods) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PreserveAbortedPods: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PreserveAbortedPods: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Count = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TTL = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeout = &v
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Insecure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Insecure = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, WebMetricHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeQuery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RangeQuery == nil {
+				m.RangeQuery = &PrometheusRangeQueryArgs{}
+			}
+			if err := m.RangeQuery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrometheusRangeQueryArgs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusRangeQueryArgs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusRangeQueryArgs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequiredDuringSchedulingIgnoredDuringExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackWindowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackWindowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackWindowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			m.Revisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revisions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Rollout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, AnalysisTemplateRef{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
+			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartingStep = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = RolloutConditionType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutExperimentStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutExperimentStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Templates = append(m.Templates, RolloutExperimentTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyses = append(m.Analyses, RolloutExperimentStepAnalysisTemplateRef{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredForCompletion = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutExperimentTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutExperimentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecRef = ReplicaSetSpecRef(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weight = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &TemplateService{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutHookStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutHookStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutHookStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = RolloutHookType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = RolloutHookPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutHooks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutHooks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutHooks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreRollout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreRollout == nil {
+				m.PreRollout = &RolloutHook{}
+			}
+			if err := m.PreRollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostPromotion", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PostPromotion == nil {
+				m.PostPromotion = &RolloutHook{}
+			}
+			if err := m.PostPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnAbort", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OnAbort == nil {
+				m.OnAbort = &RolloutHook{}
+			}
+			if err := m.OnAbort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutLifecycleStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutLifecycleStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutLifecycleStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Rollout{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &intstr.IntOrString{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutPolicyList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPolicyList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPolicyList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, RolloutPolicy{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutPolicyRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPolicyRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPolicyRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforcementAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnforcementAction = PolicyEnforcementAction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutPolicySpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPolicySpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPolicySpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforcementAction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnforcementAction = PolicyEnforcementAction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, RolloutPolicyRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutRetryBackoff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutRetryBackoff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutRetryBackoff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Factor = &v
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Backoff == nil {
+				m.Backoff = &RolloutRetryBackoff{}
+			}
+			if err := m.Backoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryOn = append(m.RetryOn, RolloutAbortReason(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutRetryStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutRetryStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutRetryStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAbortReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastAbortReason = RolloutAbortReason(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextRetryAt == nil {
+				m.NextRetryAt = &v1.Time{}
+			}
+			if err := m.NextRetryAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReadySeconds", wireType)
+			}
+			m.MinReadySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReadySeconds |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RevisionHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineSeconds = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartAt == nil {
+				m.RestartAt = &v1.Time{}
+			}
+			if err := m.RestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkloadRef == nil {
+				m.WorkloadRef = &ObjectRef{}
+			}
+			if err := m.WorkloadRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &AnalysisRunStrategy{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineAbort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineAbort = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RollbackWindow == nil {
+				m.RollbackWindow = &RollbackWindowSpec{}
+			}
+			if err := m.RollbackWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigRefs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigRefs = append(m.ConfigRefs, ConfigRef{})
+			if err := m.ConfigRefs[len(m.ConfigRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = &RolloutHooks{}
+			}
+			if err := m.Hooks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RolloutRetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StrategyRef == nil {
+				m.StrategyRef = &StrategyRef{}
+			}
+			if err := m.StrategyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Abort = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseConditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseConditions = append(m.PauseConditions, PauseCondition{})
+			if err := m.PauseConditions[len(m.PauseConditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ControllerPause = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AbortedAt == nil {
+				m.AbortedAt = &v1.Time{}
+			}
+			if err := m.AbortedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPodHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentPodHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentStepHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			m.Replicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Replicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			}
+			m.UpdatedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			}
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
+			}
+			m.AvailableReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AvailableReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepIndex", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CurrentStepIndex = &v
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CollisionCount = &v
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservedGeneration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, RolloutCondition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HPAReplicas", wireType)
+			}
+			m.HPAReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HPAReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableRS", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StableRS = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartedAt == nil {
+				m.RestartedAt = &v1.Time{}
+			}
+			if err := m.RestartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromoteFull", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PromoteFull = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadObservedGeneration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29434,28 +40390,27 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkloadObservedGeneration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29482,13 +40437,16 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ALB == nil {
+				m.ALB = &ALBStatus{}
+			}
+			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ALBs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29515,63 +40473,14 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ALBs = append(m.ALBs, ALBStatus{})
+			if err := m.ALBs[len(m.ALBs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29598,14 +40507,14 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, AnalysisTemplateRef{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Hooks = append(m.Hooks, RolloutHookStatus{})
+			if err := m.Hooks[len(m.Hooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29632,14 +40541,16 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Retry == nil {
+				m.Retry = &RolloutRetryStatus{}
+			}
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29666,14 +40577,16 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CurrentStep == nil {
+				m.CurrentStep = &RolloutStepStatus{}
+			}
+			if err := m.CurrentStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29700,14 +40613,16 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
-			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Lifecycle == nil {
+				m.Lifecycle = &RolloutLifecycleStatus{}
+			}
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyTemplate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29734,7 +40649,10 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StrategyTemplate == nil {
+				m.StrategyTemplate = &RolloutStrategyTemplateStatus{}
+			}
+			if err := m.StrategyTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29759,7 +40677,7 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
+func (m *RolloutStepStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29782,17 +40700,17 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutStepStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutStepStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29802,98 +40720,27 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.StartingStep = &v
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29921,13 +40768,13 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29937,29 +40784,30 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29969,23 +40817,27 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.TimedOutAt == nil {
+				m.TimedOutAt = &v1.Time{}
+			}
+			if err := m.TimedOutAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30008,7 +40860,7 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
+func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30031,17 +40883,17 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutCondition: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30051,59 +40903,31 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = RolloutConditionType(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.BlueGreen == nil {
+				m.BlueGreen = &BlueGreenStrategy{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30130,48 +40954,68 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Canary == nil {
+				m.Canary = &CanaryStrategy{}
+			}
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStrategyTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStrategyTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStrategyTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30181,29 +41025,30 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30213,23 +41058,24 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30252,7 +41098,7 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
+func (m *RolloutStrategyTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30275,15 +41121,15 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutStrategyTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutStrategyTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30310,46 +41156,13 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, RolloutExperimentTemplate{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = DurationString(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30376,48 +41189,64 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyses = append(m.Analyses, RolloutExperimentStepAnalysisTemplateRef{})
-			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, RolloutStrategyTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutStrategyTemplateSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutStrategyTemplateSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutStrategyTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30444,7 +41273,7 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30469,7 +41298,7 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+func (m *RolloutStrategyTemplateStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30492,15 +41321,15 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutStrategyTemplateStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutStrategyTemplateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30528,11 +41357,11 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30560,7 +41389,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -30583,10 +41412,10 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 			}
 			m.ClusterScope = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
 			}
-			var msglen int
+			m.Generation = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30596,31 +41425,16 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Generation |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategyHash", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30630,12 +41444,24 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequiredForCompletion = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StrategyHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -30657,7 +41483,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
+func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30680,17 +41506,17 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Istio", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -30700,79 +41526,31 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecRef", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Istio == nil {
+				m.Istio = &IstioTrafficRouting{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Istio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SpecRef = ReplicaSetSpecRef(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nginx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30799,13 +41577,16 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Nginx == nil {
+				m.Nginx = &NginxTrafficRouting{}
+			}
+			if err := m.Nginx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30832,36 +41613,16 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
+			if m.ALB == nil {
+				m.ALB = &ALBTrafficRouting{}
 			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Weight = &v
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SMI", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30888,66 +41649,16 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &TemplateService{}
+			if m.SMI == nil {
+				m.SMI = &SMITrafficRouting{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SMI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ambassador", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30974,13 +41685,16 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Ambassador == nil {
+				m.Ambassador = &AmbassadorTrafficRouting{}
+			}
+			if err := m.Ambassador.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppMesh", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31007,64 +41721,16 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Rollout{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.AppMesh == nil {
+				m.AppMesh = &AppMeshTrafficRouting{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.AppMesh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutPause) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Traefik", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31091,86 +41757,16 @@ func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &intstr.IntOrString{}
+			if m.Traefik == nil {
+				m.Traefik = &TraefikTrafficRouting{}
 			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Traefik.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Replicas = &v
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedRoutes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31197,16 +41793,14 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ManagedRoutes = append(m.ManagedRoutes, MangedRoutes{})
+			if err := m.ManagedRoutes[len(m.ManagedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apisix", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31233,32 +41827,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Apisix == nil {
+				m.Apisix = &ApisixTrafficRouting{}
+			}
+			if err := m.Apisix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReadySeconds", wireType)
-			}
-			m.MinReadySeconds = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinReadySeconds |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31285,13 +41863,108 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Strategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Plugins == nil {
+				m.Plugins = make(map[string]encoding_json.RawMessage)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Plugins[mapkey] = ((encoding_json.RawMessage)(mapvalue))
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RevisionHistoryLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrafficWeight", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -31308,12 +41981,12 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.RevisionHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			m.MaxTrafficWeight = &v
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contour", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31323,35 +41996,31 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Paused = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.ProgressDeadlineSeconds = &v
-		case 9:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Contour == nil {
+				m.Contour = &ContourTrafficRouting{}
+			}
+			if err := m.Contour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kong", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31378,16 +42047,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RestartAt == nil {
-				m.RestartAt = &v1.Time{}
+			if m.Kong == nil {
+				m.Kong = &KongTrafficRouting{}
 			}
-			if err := m.RestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Linkerd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31414,16 +42083,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkloadRef == nil {
-				m.WorkloadRef = &ObjectRef{}
+			if m.Linkerd == nil {
+				m.Linkerd = &LinkerdTrafficRouting{}
 			}
-			if err := m.WorkloadRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Linkerd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DNS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31450,36 +42119,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &AnalysisRunStrategy{}
+			if m.DNS == nil {
+				m.DNS = &DNSTrafficRouting{}
 			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineAbort", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ProgressDeadlineAbort = bool(v != 0)
-		case 13:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollbackWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stickiness", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31506,10 +42155,10 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollbackWindow == nil {
-				m.RollbackWindow = &RollbackWindowSpec{}
+			if m.Stickiness == nil {
+				m.Stickiness = &TrafficStickiness{}
 			}
-			if err := m.RollbackWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stickiness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31534,7 +42183,7 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
+func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31557,35 +42206,15 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouteMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouteMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Abort = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauseConditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31612,34 +42241,16 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PauseConditions = append(m.PauseConditions, PauseCondition{})
-			if err := m.PauseConditions[len(m.PauseConditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Method == nil {
+				m.Method = &StringMatch{}
+			}
+			if err := m.Method.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControllerPause", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ControllerPause = bool(v != 0)
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbortedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31666,18 +42277,18 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AbortedAt == nil {
-				m.AbortedAt = &v1.Time{}
+			if m.Path == nil {
+				m.Path = &StringMatch{}
 			}
-			if err := m.AbortedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPodHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31687,243 +42298,176 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentPodHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepHash", wireType)
+			if m.Headers == nil {
+				m.Headers = make(map[string]StringMatch)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &StringMatch{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StringMatch{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentStepHash = string(dAtA[iNdEx:postIndex])
+			m.Headers[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
-			}
-			m.Replicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Replicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
-			}
-			m.UpdatedReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
-			}
-			m.ReadyReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadyReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
-			}
-			m.AvailableReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AvailableReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStepIndex", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CurrentStepIndex = &v
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CollisionCount = &v
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservedGeneration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunSummary) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, RolloutCondition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunSummary: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var msglen int
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31933,30 +42477,16 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 16:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
 			}
-			var msglen int
+			m.Successful = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31966,30 +42496,35 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Successful |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
-			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 17:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HPAReplicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
 			}
-			m.HPAReplicas = 0
+			m.Inconclusive = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31999,16 +42534,16 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HPAReplicas |= int32(b&0x7F) << shift
+				m.Inconclusive |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var stringLen uint64
+			m.Error = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32018,27 +42553,64 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Error |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SMITrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SMITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableRS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootService", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32066,13 +42638,13 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableRS = string(dAtA[iNdEx:postIndex])
+			m.RootService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficSplitName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32082,51 +42654,77 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RestartedAt == nil {
-				m.RestartedAt = &v1.Time{}
-			}
-			if err := m.RestartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TrafficSplitName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromoteFull", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PromoteFull = bool(v != 0)
-		case 22:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32154,11 +42752,11 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = RolloutPhase(dAtA[iNdEx:postIndex])
+			m.Scope = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 23:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32186,13 +42784,13 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadObservedGeneration", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
-			var stringLen uint64
+			m.Step = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32202,29 +42800,16 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Step |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkloadObservedGeneration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 25:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32234,33 +42819,29 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ALB == nil {
-				m.ALB = &ALBStatus{}
-			}
-			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Start = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 26:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALBs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32270,25 +42851,23 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ALBs = append(m.ALBs, ALBStatus{})
-			if err := m.ALBs[len(m.ALBs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.End = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32311,7 +42890,7 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
+func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32334,17 +42913,17 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretKeyRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlueGreen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32354,33 +42933,29 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlueGreen == nil {
-				m.BlueGreen = &BlueGreenStrategy{}
-			}
-			if err := m.BlueGreen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32390,27 +42965,23 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Canary == nil {
-				m.Canary = &CanaryStrategy{}
-			}
-			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32433,7 +43004,7 @@ func (m *RolloutStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *SecretRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32456,17 +43027,17 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Istio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32476,33 +43047,29 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Istio == nil {
-				m.Istio = &IstioTrafficRouting{}
-			}
-			if err := m.Istio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nginx", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaced", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32512,67 +43079,65 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.Namespaced = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Nginx == nil {
-				m.Nginx = &NginxTrafficRouting{}
-			}
-			if err := m.Nginx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ALB", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCanaryNodes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ALB == nil {
-				m.ALB = &ALBTrafficRouting{}
-			}
-			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCanaryNodes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCanaryNodes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SMI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32599,54 +43164,65 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SMI == nil {
-				m.SMI = &SMITrafficRouting{}
-			}
-			if err := m.SMI.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NodeSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ambassador", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Ambassador == nil {
-				m.Ambassador = &AmbassadorTrafficRouting{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Ambassador.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppMesh", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCanaryScale: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCanaryScale: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32656,33 +43232,17 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AppMesh == nil {
-				m.AppMesh = &AppMeshTrafficRouting{}
-			}
-			if err := m.AppMesh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Traefik", wireType)
+			m.Weight = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32692,33 +43252,17 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Traefik == nil {
-				m.Traefik = &TraefikTrafficRouting{}
-			}
-			if err := m.Traefik.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManagedRoutes", wireType)
+			m.Replicas = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchTrafficWeight", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32728,31 +43272,67 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.MatchTrafficWeight = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ManagedRoutes = append(m.ManagedRoutes, MangedRoutes{})
-			if err := m.ManagedRoutes[len(m.ManagedRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			iNdEx = postIndex
-		case 9:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetHeaderRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetHeaderRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apisix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32762,31 +43342,27 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apisix == nil {
-				m.Apisix = &ApisixTrafficRouting{}
-			}
-			if err := m.Apisix.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32813,125 +43389,11 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plugins == nil {
-				m.Plugins = make(map[string]encoding_json.RawMessage)
-			}
-			var mapkey string
-			mapvalue := []byte{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapbyteLen uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapbyteLen |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intMapbyteLen := int(mapbyteLen)
-					if intMapbyteLen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postbytesIndex := iNdEx + intMapbyteLen
-					if postbytesIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postbytesIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = make([]byte, mapbyteLen)
-					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
-					iNdEx = postbytesIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.Match = append(m.Match, HeaderRoutingMatch{})
+			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Plugins[mapkey] = ((encoding_json.RawMessage)(mapvalue))
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTrafficWeight", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.MaxTrafficWeight = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32953,7 +43415,7 @@ func (m *RolloutTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouteMatch) Unmarshal(dAtA []byte) error {
+func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32976,17 +43438,17 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RouteMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetMirrorRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouteMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetMirrorRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32996,31 +43458,27 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Method == nil {
-				m.Method = &StringMatch{}
-			}
-			if err := m.Method.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33047,18 +43505,16 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Path == nil {
-				m.Path = &StringMatch{}
-			}
-			if err := m.Path.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Match = append(m.Match, RouteMatch{})
+			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33068,121 +43524,12 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Headers == nil {
-				m.Headers = make(map[string]StringMatch)
-			}
-			var mapkey string
-			mapvalue := &StringMatch{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &StringMatch{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Headers[mapkey] = *mapvalue
-			iNdEx = postIndex
+			m.Percentage = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33204,7 +43551,7 @@ func (m *RouteMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RunSummary) Unmarshal(dAtA []byte) error {
+func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33227,17 +43574,17 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RunSummary: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sigv4Config: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RunSummary: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sigv4Config: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33247,35 +43594,29 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Successful = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Successful |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Failed = 0
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33285,35 +43626,29 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Failed |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inconclusive", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.Inconclusive = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Inconclusive |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Error = 0
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33323,11 +43658,24 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Error |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33349,7 +43697,7 @@ func (m *RunSummary) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33372,15 +43720,15 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SMITrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: SkyWalkingMetric: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SMITrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SkyWalkingMetric: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33408,11 +43756,11 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootService = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficSplitName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33440,7 +43788,39 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrafficSplitName = string(dAtA[iNdEx:postIndex])
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Interval = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33463,7 +43843,7 @@ func (m *SMITrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
+func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33486,15 +43866,34 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ScopeDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: StepPluginStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ScopeDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StepPluginStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33522,11 +43921,11 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33554,13 +43953,13 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Operation = StepPluginOperation(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			m.Step = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33570,14 +43969,27 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Step |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = StepPluginPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33605,11 +44017,119 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &v1.Time{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33637,7 +44157,80 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.End = string(dAtA[iNdEx:postIndex])
+			m.Backoff = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			m.Executions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Executions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = append(m.Status[:0], dAtA[iNdEx:postIndex]...)
+			if m.Status == nil {
+				m.Status = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33660,7 +44253,7 @@ func (m *ScopeDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
+func (m *StepTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33683,15 +44276,15 @@ func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretKeyRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: StepTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretKeyRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StepTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33719,11 +44312,11 @@ func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Timeout = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnTimeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33751,7 +44344,7 @@ func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.OnTimeout = StepTimeoutPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -33774,7 +44367,7 @@ func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SecretRef) Unmarshal(dAtA []byte) error {
+func (m *StickinessConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33797,17 +44390,17 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SecretRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: StickinessConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StickinessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33817,29 +44410,17 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespaced", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
 			}
-			var v int
+			m.DurationSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33849,12 +44430,11 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.DurationSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Namespaced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33876,7 +44456,7 @@ func (m *SecretRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
+func (m *StrategyRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33899,17 +44479,17 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetCanaryScale: wiretype end group for non-group")
+			return fmt.Errorf("proto: StrategyRef: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetCanaryScale: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StrategyRef: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33919,17 +44499,29 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Weight = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33939,17 +44531,17 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			m.ClusterScope = bool(v != 0)
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MatchTrafficWeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -33959,65 +44551,105 @@ func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.MatchTrafficWeight = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Weights = append(m.Weights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Weights) == 0 {
+					m.Weights = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Weights = append(m.Weights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetHeaderRoute: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetHeaderRoute: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34045,13 +44677,13 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CanaryService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34061,79 +44693,27 @@ func (m *SetHeaderRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Match = append(m.Match, HeaderRoutingMatch{})
-			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StableService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetMirrorRoute: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetMirrorRoute: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveService", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34161,13 +44741,13 @@ func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ActiveService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34177,46 +44757,24 @@ func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Match = append(m.Match, RouteMatch{})
-			if err := m.Match[len(m.Match)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PreviewService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Percentage = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34238,7 +44796,7 @@ func (m *SetMirrorRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
+func (m *StringMatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34261,15 +44819,15 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Sigv4Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: StringMatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Sigv4Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StringMatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34297,11 +44855,11 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
+			m.Exact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34329,11 +44887,11 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.Prefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleARN", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34361,7 +44919,7 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleARN = string(dAtA[iNdEx:postIndex])
+			m.Regex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34384,7 +44942,7 @@ func (m *Sigv4Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
+func (m *TCPRoute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34407,17 +44965,17 @@ func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SkyWalkingMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: TCPRoute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SkyWalkingMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TCPRoute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
 			}
-			var stringLen uint64
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34427,29 +44985,66 @@ func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Port |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TLSRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLSRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLSRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34459,27 +45054,14 @@ func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Port |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SNIHosts", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34507,7 +45089,7 @@ func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Interval = DurationString(dAtA[iNdEx:postIndex])
+			m.SNIHosts = append(m.SNIHosts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34530,7 +45112,7 @@ func (m *SkyWalkingMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
+func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34553,17 +45135,17 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StepPluginStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StepPluginStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
 			}
-			m.Index = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34573,16 +45155,17 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.SecondsAfterCompletion = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34592,29 +45175,17 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.SecondsAfterFailure = &v
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34624,27 +45195,65 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			m.SecondsAfterSuccess = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operation = StepPluginOperation(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateService) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateService: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateService: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34672,11 +45281,61 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = StepPluginPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TemplateSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -34704,13 +45363,13 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34720,33 +45379,17 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
-			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			m.Replicas = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReadySeconds", wireType)
 			}
-			var msglen int
+			m.MinReadySeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34756,31 +45399,14 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MinReadySeconds |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UpdatedAt == nil {
-				m.UpdatedAt = &v1.Time{}
-			}
-			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34807,18 +45433,18 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34828,68 +45454,30 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Backoff = DurationString(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
-			}
-			m.Executions = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Executions |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Disabled = bool(v != 0)
-		case 12:
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34899,24 +45487,26 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = append(m.Status[:0], dAtA[iNdEx:postIndex]...)
-			if m.Status == nil {
-				m.Status = []byte{}
+			if m.Service == nil {
+				m.Service = &TemplateService{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -34940,7 +45530,7 @@ func (m *StepPluginStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StickinessConfig) Unmarshal(dAtA []byte) error {
+func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34963,17 +45553,17 @@ func (m *StickinessConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StickinessConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: TemplateStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StickinessConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TemplateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34983,17 +45573,29 @@ func (m *StickinessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
 			}
-			m.DurationSeconds = 0
+			m.Replicas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35003,66 +45605,54 @@ func (m *StickinessConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DurationSeconds |= int64(b&0x7F) << shift
+				m.Replicas |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StringMatch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.UpdatedReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ReadyReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReadyReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StringMatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringMatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
 			}
-			var stringLen uint64
+			m.AvailableReplicas = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35072,27 +45662,34 @@ func (m *StringMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AvailableReplicas |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Exact = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.CollisionCount = &v
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35120,11 +45717,11 @@ func (m *StringMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prefix = string(dAtA[iNdEx:postIndex])
+			m.Status = TemplateStatusCode(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35152,63 +45749,13 @@ func (m *StringMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regex = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TCPRoute) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TCPRoute: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TCPRoute: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
-			m.Port = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35218,66 +45765,33 @@ func (m *TCPRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Port |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TLSRoute) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.LastTransitionTime == nil {
+				m.LastTransitionTime = &v1.Time{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TLSRoute: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TLSRoute: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
 			}
-			m.Port = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35287,14 +45801,27 @@ func (m *TLSRoute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Port |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SNIHosts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35322,7 +45849,7 @@ func (m *TLSRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SNIHosts = append(m.SNIHosts, string(dAtA[iNdEx:postIndex]))
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35345,7 +45872,7 @@ func (m *TLSRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
+func (m *TraefikTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35368,17 +45895,17 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TTLStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraefikTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TTLStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraefikTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterCompletion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedTraefikServiceName", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35388,52 +45915,24 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SecondsAfterCompletion = &v
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterFailure", wireType)
-			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.SecondsAfterFailure = &v
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondsAfterSuccess", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SecondsAfterSuccess = &v
+			m.WeightedTraefikServiceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35455,7 +45954,7 @@ func (m *TTLStrategy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateService) Unmarshal(dAtA []byte) error {
+func (m *TrafficStickiness) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35478,15 +45977,15 @@ func (m *TemplateService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateService: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrafficStickiness: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrafficStickiness: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CookieName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35514,8 +46013,27 @@ func (m *TemplateService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.CookieName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -35537,7 +46055,7 @@ func (m *TemplateService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
+func (m *TrafficWeights) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35560,17 +46078,17 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: TrafficWeights: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TrafficWeights: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35580,29 +46098,30 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stable", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35612,17 +46131,30 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReadySeconds", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Additional", wireType)
 			}
-			m.MinReadySeconds = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35632,16 +46164,31 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinReadySeconds |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Additional = append(m.Additional, WeightDestination{})
+			if err := m.Additional[len(m.Additional)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35651,31 +46198,66 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			b := bool(v != 0)
+			m.Verified = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueFrom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueFrom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35702,13 +46284,16 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &SecretKeyRef{}
+			}
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35735,10 +46320,10 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &TemplateService{}
+			if m.FieldRef == nil {
+				m.FieldRef = &FieldRef{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FieldRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35763,7 +46348,7 @@ func (m *TemplateSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
+func (m *WaitForStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35786,17 +46371,17 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: WaitForStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WaitForStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35806,29 +46391,16 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			m.Replicas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35838,54 +46410,30 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Replicas |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedReplicas", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.UpdatedReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdatedReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReadyReplicas", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ReadyReplicas = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReadyReplicas |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 5:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableReplicas", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Met", wireType)
 			}
-			m.AvailableReplicas = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35895,16 +46443,17 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AvailableReplicas |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.Met = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollisionCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
 			}
-			var v int32
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35914,15 +46463,15 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CollisionCount = &v
-		case 7:
+			m.TimedOut = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35950,45 +46499,63 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = TemplateStatusCode(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WaitForStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WaitForStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WaitForStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35998,31 +46565,27 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastTransitionTime == nil {
-				m.LastTransitionTime = &v1.Time{}
-			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.APIVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36050,11 +46613,11 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceName = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36082,61 +46645,11 @@ func (m *TemplateStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TraefikTrafficRouting) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TraefikTrafficRouting: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TraefikTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WeightedTraefikServiceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -36164,63 +46677,13 @@ func (m *TraefikTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WeightedTraefikServiceName = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TrafficWeights) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TrafficWeights: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TrafficWeights: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Canary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36230,30 +46693,29 @@ func (m *TrafficWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Canary.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Condition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JSONPath", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36263,30 +46725,29 @@ func (m *TrafficWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JSONPath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Additional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36296,102 +46757,29 @@ func (m *TrafficWeights) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Additional = append(m.Additional, WeightDestination{})
-			if err := m.Additional[len(m.Additional)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.Verified = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValueFrom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValueFrom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValueFrom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36401,33 +46789,29 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecretKeyRef == nil {
-				m.SecretKeyRef = &SecretKeyRef{}
-			}
-			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Timeout = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FieldRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnTimeout", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -36437,27 +46821,23 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FieldRef == nil {
-				m.FieldRef = &FieldRef{}
-			}
-			if err := m.FieldRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OnTimeout = WaitForTimeoutPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,88 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterRolloutPolicy holds the rules which the new revisions of the Rollouts of all the namespaces must satisfy
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusterrolloutpolicies,shortName=crp,scope=Cluster
+// +kubebuilder:printcolumn:name="Action",type="string",JSONPath=".spec.enforcementAction",description="Action taken on the violations of the rules"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type ClusterRolloutPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutPolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// ClusterRolloutPolicyList is a list of ClusterRolloutPolicy resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterRolloutPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ClusterRolloutPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutPolicy holds the rules which the new revisions of the Rollouts of its namespace must satisfy
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=rolloutpolicies,shortName=rp
+// +kubebuilder:printcolumn:name="Action",type="string",JSONPath=".spec.enforcementAction",description="Action taken on the violations of the rules"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type RolloutPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutPolicySpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// RolloutPolicyList is a list of RolloutPolicy resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RolloutPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RolloutPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutPolicySpec is the specification of a RolloutPolicy or ClusterRolloutPolicy
+type RolloutPolicySpec struct {
+	// Selector selects the Rollouts the policy applies to by their labels. Defaults to all the Rollouts
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,1,opt,name=selector"`
+	// EnforcementAction is the action taken when a new revision of a Rollout violates a rule of the policy. Deny
+	// blocks the new revision until the rule is satisfied, and Warn emits a warning event. Defaults to Deny
+	// +optional
+	EnforcementAction PolicyEnforcementAction `json:"enforcementAction,omitempty" protobuf:"bytes,2,opt,name=enforcementAction,casttype=PolicyEnforcementAction"`
+	// Rules are the rules of the policy
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Rules []RolloutPolicyRule `json:"rules" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=rules"`
+}
+
+// RolloutPolicyRule is a CEL expression which the Rollouts selected by a policy must satisfy
+type RolloutPolicyRule struct {
+	// Name is the name of the rule
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Expression is a CEL expression which evaluates to true when a Rollout satisfies the rule. The expression is
+	// evaluated with the variables `rollout`, the Rollout, and `plan`, the resolved step plan of the Rollout
+	Expression string `json:"expression" protobuf:"bytes,2,opt,name=expression"`
+	// Message is the message of the violations of the rule. Defaults to the expression
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// EnforcementAction overrides the enforcement action of the policy for the rule
+	// +optional
+	EnforcementAction PolicyEnforcementAction `json:"enforcementAction,omitempty" protobuf:"bytes,4,opt,name=enforcementAction,casttype=PolicyEnforcementAction"`
+}
+
+// PolicyEnforcementAction is the action taken on the violations of the rules of a policy
+type PolicyEnforcementAction string
+
+const (
+	// PolicyEnforcementActionDeny blocks the new revisions which violate a rule
+	PolicyEnforcementActionDeny PolicyEnforcementAction = "Deny"
+	// PolicyEnforcementActionWarn emits a warning event for the new revisions which violate a rule
+	PolicyEnforcementActionWarn PolicyEnforcementAction = "Warn"
+)
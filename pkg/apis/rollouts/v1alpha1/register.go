@@ -13,11 +13,15 @@ var SchemeGroupVersion = schema.GroupVersion{Group: rollouts.Group, Version: "v1
 
 var (
 	// GroupVersionResource for all rollout types
-	RolloutGVR                 = SchemeGroupVersion.WithResource("rollouts")
-	AnalysisRunGVR             = SchemeGroupVersion.WithResource("analysisruns")
-	AnalysisTemplateGVR        = SchemeGroupVersion.WithResource("analysistemplates")
-	ClusterAnalysisTemplateGVR = SchemeGroupVersion.WithResource("clusteranalysistemplates")
-	ExperimentGVR              = SchemeGroupVersion.WithResource("experiments")
+	RolloutGVR                        = SchemeGroupVersion.WithResource("rollouts")
+	AnalysisRunGVR                    = SchemeGroupVersion.WithResource("analysisruns")
+	AnalysisTemplateGVR               = SchemeGroupVersion.WithResource("analysistemplates")
+	ClusterAnalysisTemplateGVR        = SchemeGroupVersion.WithResource("clusteranalysistemplates")
+	ExperimentGVR                     = SchemeGroupVersion.WithResource("experiments")
+	RolloutPolicyGVR                  = SchemeGroupVersion.WithResource("rolloutpolicies")
+	ClusterRolloutPolicyGVR           = SchemeGroupVersion.WithResource("clusterrolloutpolicies")
+	RolloutStrategyTemplateGVR        = SchemeGroupVersion.WithResource("rolloutstrategytemplates")
+	ClusterRolloutStrategyTemplateGVR = SchemeGroupVersion.WithResource("clusterrolloutstrategytemplates")
 )
 
 // Kind takes an unqualified kind and returns back a Group qualified GroupKind
@@ -48,6 +52,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterAnalysisTemplateList{},
 		&AnalysisRun{},
 		&AnalysisRunList{},
+		&RolloutPolicy{},
+		&RolloutPolicyList{},
+		&ClusterRolloutPolicy{},
+		&ClusterRolloutPolicyList{},
+		&RolloutStrategyTemplate{},
+		&RolloutStrategyTemplateList{},
+		&ClusterRolloutStrategyTemplate{},
+		&ClusterRolloutStrategyTemplateList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
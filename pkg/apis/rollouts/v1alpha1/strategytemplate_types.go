@@ -0,0 +1,101 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterRolloutStrategyTemplate holds a strategy shared by the Rollouts of all the namespaces
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=clusterrolloutstrategytemplates,shortName=crst,scope=Cluster
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type ClusterRolloutStrategyTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutStrategyTemplateSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// ClusterRolloutStrategyTemplateList is a list of ClusterRolloutStrategyTemplate resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterRolloutStrategyTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []ClusterRolloutStrategyTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutStrategyTemplate holds a strategy shared by the Rollouts of its namespace
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=rolloutstrategytemplates,shortName=rst
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+type RolloutStrategyTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec RolloutStrategyTemplateSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// RolloutStrategyTemplateList is a list of RolloutStrategyTemplate resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RolloutStrategyTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []RolloutStrategyTemplate `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// RolloutStrategyTemplateSpec is the specification of a RolloutStrategyTemplate or ClusterRolloutStrategyTemplate
+type RolloutStrategyTemplateSpec struct {
+	// Strategy is the strategy of the Rollouts referencing the template
+	Strategy RolloutStrategy `json:"strategy" protobuf:"bytes,1,opt,name=strategy"`
+}
+
+// StrategyRef references the RolloutStrategyTemplate or ClusterRolloutStrategyTemplate which provides the strategy of
+// a Rollout, along with the values of the strategy which are overridden by the Rollout
+type StrategyRef struct {
+	// Name is the name of the template
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// ClusterScope indicates the template is a ClusterRolloutStrategyTemplate
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,2,opt,name=clusterScope"`
+	// Args override the arguments of the same name of every analysis of the strategy, and are added to the
+	// analyses which do not set them
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Args []AnalysisRunArgument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=args"`
+	// Weights override the weights of the setWeight steps of the canary strategy, in order. There must be one
+	// weight for each setWeight step
+	// +optional
+	Weights []int32 `json:"weights,omitempty" protobuf:"varint,4,rep,name=weights"`
+	// CanaryService overrides the canary service of the canary strategy
+	// +optional
+	CanaryService string `json:"canaryService,omitempty" protobuf:"bytes,5,opt,name=canaryService"`
+	// StableService overrides the stable service of the canary strategy
+	// +optional
+	StableService string `json:"stableService,omitempty" protobuf:"bytes,6,opt,name=stableService"`
+	// ActiveService overrides the active service of the blue-green strategy
+	// +optional
+	ActiveService string `json:"activeService,omitempty" protobuf:"bytes,7,opt,name=activeService"`
+	// PreviewService overrides the preview service of the blue-green strategy
+	// +optional
+	PreviewService string `json:"previewService,omitempty" protobuf:"bytes,8,opt,name=previewService"`
+}
+
+// RolloutStrategyTemplateStatus records the generation of the template referenced by the StrategyRef of a Rollout
+// when its current revision was created. The strategy of the template at that generation is kept in a
+// ControllerRevision owned by the Rollout, so that a change of the template only applies to the next revisions.
+type RolloutStrategyTemplateStatus struct {
+	// PodTemplateHash is the pod template hash of the revision the strategy was resolved for
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,1,opt,name=podTemplateHash"`
+	// Name is the name of the template
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// ClusterScope indicates the template is a ClusterRolloutStrategyTemplate
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty" protobuf:"varint,3,opt,name=clusterScope"`
+	// Generation is the generation of the template
+	Generation int64 `json:"generation" protobuf:"varint,4,opt,name=generation"`
+	// StrategyHash is the hash of the strategy of the template, which names the ControllerRevision holding it
+	StrategyHash string `json:"strategyHash" protobuf:"bytes,5,opt,name=strategyHash"`
+}
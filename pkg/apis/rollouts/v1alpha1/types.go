@@ -6,16 +6,19 @@ import (
 	"strconv"
 	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:path=rollouts,shortName=ro
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.HPAReplicas,selectorpath=.status.selector
 // +kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".spec.replicas",description="Number of desired pods"
 // +kubebuilder:printcolumn:name="Current",type="integer",JSONPath=".status.replicas",description="Total number of non-terminated pods targeted by this rollout"
@@ -37,6 +40,7 @@ type Rollout struct {
 type RolloutSpec struct {
 	TemplateResolvedFromRef bool `json:"-"`
 	SelectorResolvedFromRef bool `json:"-"`
+	StrategyResolvedFromRef bool `json:"-"`
 	// Number of desired pods. This is a pointer to distinguish between explicit
 	// zero and not specified. Defaults to 1.
 	// +optional
@@ -64,6 +68,10 @@ type RolloutSpec struct {
 	// The deployment strategy to use to replace existing pods with new ones.
 	// +optional
 	Strategy RolloutStrategy `json:"strategy" protobuf:"bytes,5,opt,name=strategy"`
+	// StrategyRef references a RolloutStrategyTemplate or ClusterRolloutStrategyTemplate which provides the
+	// strategy, instead of the strategy field. A change of the template only applies to the next revisions
+	// +optional
+	StrategyRef *StrategyRef `json:"strategyRef,omitempty" protobuf:"bytes,17,opt,name=strategyRef"`
 	// The number of old ReplicaSets to retain. If unspecified, will retain 10 old ReplicaSets
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" protobuf:"varint,6,opt,name=revisionHistoryLimit"`
 	// Paused pauses the rollout at its current step.
@@ -83,6 +91,157 @@ type RolloutSpec struct {
 	RestartAt *metav1.Time `json:"restartAt,omitempty" protobuf:"bytes,9,opt,name=restartAt"`
 	// Analysis configuration for the analysis runs to retain
 	Analysis *AnalysisRunStrategy `json:"analysis,omitempty" protobuf:"bytes,11,opt,name=analysis"`
+	// ConfigRefs are the ConfigMaps and Secrets referenced by the pod template which are versioned with the pod
+	// template. Each version of their data is copied into an immutable snapshot, and the pod template is rewritten
+	// to reference the snapshot, so that a change of the data creates a new revision of the Rollout.
+	// +optional
+	ConfigRefs []ConfigRef `json:"configRefs,omitempty" protobuf:"bytes,14,rep,name=configRefs"`
+	// Hooks are Jobs run by the controller at phases of an update: before the ReplicaSet of a new revision is
+	// created, after a revision is fully promoted and after an update is aborted
+	// +optional
+	Hooks *RolloutHooks `json:"hooks,omitempty" protobuf:"bytes,15,opt,name=hooks"`
+	// RetryPolicy retries the update of a revision automatically, with a backoff, after it was aborted
+	// +optional
+	RetryPolicy *RolloutRetryPolicy `json:"retryPolicy,omitempty" protobuf:"bytes,16,opt,name=retryPolicy"`
+	// UnresolvedConfigTemplate is the pod template before its ConfigRefs were rewritten to their snapshots
+	UnresolvedConfigTemplate *corev1.PodTemplateSpec `json:"-"`
+}
+
+// ConfigRefKind is the kind of a versioned config
+// +kubebuilder:validation:Enum=ConfigMap;Secret
+type ConfigRefKind string
+
+const (
+	ConfigRefKindConfigMap ConfigRefKind = "ConfigMap"
+	ConfigRefKindSecret    ConfigRefKind = "Secret"
+)
+
+// ConfigRef references a ConfigMap or Secret versioned with the pod template
+type ConfigRef struct {
+	// Kind of the config, ConfigMap or Secret
+	Kind ConfigRefKind `json:"kind" protobuf:"bytes,1,opt,name=kind,casttype=ConfigRefKind"`
+	// Name of the ConfigMap or Secret in the namespace of the Rollout
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+}
+
+// RolloutHooks are the Jobs run by the controller at phases of an update
+type RolloutHooks struct {
+	// PreRollout is run before the ReplicaSet of a new revision is created. The ReplicaSet is created once the Job
+	// succeeds, and the update is aborted if the Job fails.
+	// +optional
+	PreRollout *RolloutHook `json:"preRollout,omitempty" protobuf:"bytes,1,opt,name=preRollout"`
+	// PostPromotion is run once a revision is fully promoted. The Rollout is progressing until the Job succeeds, and
+	// degraded if the Job fails.
+	// +optional
+	PostPromotion *RolloutHook `json:"postPromotion,omitempty" protobuf:"bytes,2,opt,name=postPromotion"`
+	// OnAbort is run when an update is aborted
+	// +optional
+	OnAbort *RolloutHook `json:"onAbort,omitempty" protobuf:"bytes,3,opt,name=onAbort"`
+}
+
+// RolloutHook defines a Job run at a phase of an update
+type RolloutHook struct {
+	// Metadata of the Job
+	// +optional
+	Metadata PodTemplateMetadata `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec of the Job. Finished Jobs are deleted after spec.ttlSecondsAfterFinished, which defaults to one day.
+	Spec batchv1.JobSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// RolloutHookType is the phase of an update at which a hook is run
+type RolloutHookType string
+
+const (
+	RolloutHookTypePreRollout    RolloutHookType = "PreRollout"
+	RolloutHookTypePostPromotion RolloutHookType = "PostPromotion"
+	RolloutHookTypeOnAbort       RolloutHookType = "OnAbort"
+)
+
+// RolloutHookPhase is the phase of the Job of a hook
+type RolloutHookPhase string
+
+const (
+	RolloutHookPhaseRunning    RolloutHookPhase = "Running"
+	RolloutHookPhaseSuccessful RolloutHookPhase = "Successful"
+	RolloutHookPhaseFailed     RolloutHookPhase = "Failed"
+)
+
+// RolloutHookStatus is the status of the last Job of a hook
+type RolloutHookStatus struct {
+	// Type of the hook
+	Type RolloutHookType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=RolloutHookType"`
+	// PodTemplateHash is the pod template hash of the revision the Job was run for
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,2,opt,name=podTemplateHash"`
+	// JobName is the name of the Job
+	JobName string `json:"jobName" protobuf:"bytes,3,opt,name=jobName"`
+	// Phase of the Job
+	Phase RolloutHookPhase `json:"phase" protobuf:"bytes,4,opt,name=phase,casttype=RolloutHookPhase"`
+	// Message explains why the Job failed
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// StartedAt is the time the Job was created
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,6,opt,name=startedAt"`
+	// FinishedAt is the time the Job completed or failed
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,7,opt,name=finishedAt"`
+}
+
+// RolloutAbortReason is the reason of the abort of an update which can be retried automatically
+type RolloutAbortReason string
+
+const (
+	// RolloutAbortReasonAnalysisError is the abort of an update by an AnalysisRun in the Error phase
+	RolloutAbortReasonAnalysisError RolloutAbortReason = "AnalysisError"
+	// RolloutAbortReasonAnalysisFailed is the abort of an update by an AnalysisRun in the Failed phase
+	RolloutAbortReasonAnalysisFailed RolloutAbortReason = "AnalysisFailed"
+	// RolloutAbortReasonAnalysisInconclusive is the abort of an update by an AnalysisRun in the Inconclusive phase.
+	// Inconclusive AnalysisRuns pause the rollout, unless they are retried by the retryPolicy.
+	RolloutAbortReasonAnalysisInconclusive RolloutAbortReason = "AnalysisInconclusive"
+	// RolloutAbortReasonProgressDeadlineExceeded is the abort of an update which exceeded the progress deadline
+	// with progressDeadlineAbort
+	RolloutAbortReasonProgressDeadlineExceeded RolloutAbortReason = "ProgressDeadlineExceeded"
+	// RolloutAbortReasonStepTimeout is the abort of an update by a step which timed out with the Abort policy
+	RolloutAbortReasonStepTimeout RolloutAbortReason = "StepTimeout"
+)
+
+// RolloutRetryPolicy defines the automatic retries of the aborted updates of a revision
+type RolloutRetryPolicy struct {
+	// Limit is the maximum number of automatic retries of the update of a revision
+	Limit int32 `json:"limit" protobuf:"varint,1,opt,name=limit"`
+	// Backoff is the exponential backoff between an abort and the retry of the update
+	// +optional
+	Backoff *RolloutRetryBackoff `json:"backoff,omitempty" protobuf:"bytes,2,opt,name=backoff"`
+	// RetryOn are the reasons of the aborts which are retried. Defaults to AnalysisError and AnalysisInconclusive.
+	// +optional
+	RetryOn []RolloutAbortReason `json:"retryOn,omitempty" protobuf:"bytes,3,rep,name=retryOn,casttype=RolloutAbortReason"`
+}
+
+// RolloutRetryBackoff is the exponential backoff of the automatic retries of an update
+type RolloutRetryBackoff struct {
+	// Duration is the delay before the first retry (e.g. 30s, 5m). Defaults to 30s
+	// +optional
+	Duration DurationString `json:"duration,omitempty" protobuf:"bytes,1,opt,name=duration,casttype=DurationString"`
+	// Factor multiplies the delay after each retry. Defaults to 2
+	// +optional
+	Factor *int32 `json:"factor,omitempty" protobuf:"varint,2,opt,name=factor"`
+	// MaxDuration is the maximum delay before a retry
+	// +optional
+	MaxDuration DurationString `json:"maxDuration,omitempty" protobuf:"bytes,3,opt,name=maxDuration,casttype=DurationString"`
+}
+
+// RolloutRetryStatus is the status of the automatic retries of the update of a revision
+type RolloutRetryStatus struct {
+	// PodTemplateHash is the pod template hash of the revision whose update is retried
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,1,opt,name=podTemplateHash"`
+	// Attempts is the number of automatic retries of the update
+	Attempts int32 `json:"attempts" protobuf:"varint,2,opt,name=attempts"`
+	// LastAbortReason is the reason of the last abort of the update, if it can be retried
+	// +optional
+	LastAbortReason RolloutAbortReason `json:"lastAbortReason,omitempty" protobuf:"bytes,3,opt,name=lastAbortReason,casttype=RolloutAbortReason"`
+	// NextRetryAt is the time of the next retry of the aborted update
+	// +optional
+	NextRetryAt *metav1.Time `json:"nextRetryAt,omitempty" protobuf:"bytes,4,opt,name=nextRetryAt"`
 }
 
 func (s *RolloutSpec) SetResolvedSelector(selector *metav1.LabelSelector) {
@@ -95,6 +254,22 @@ func (s *RolloutSpec) SetResolvedTemplate(template corev1.PodTemplateSpec) {
 	s.Template = template
 }
 
+// SetResolvedStrategy sets the strategy resolved from the StrategyRef. The resolved strategy is not marshaled when the
+// Rollout is updated.
+func (s *RolloutSpec) SetResolvedStrategy(strategy RolloutStrategy) {
+	s.StrategyResolvedFromRef = true
+	s.Strategy = strategy
+}
+
+// SetResolvedConfigTemplate sets the pod template whose ConfigRefs were rewritten to their snapshots. The original
+// template is kept, and is the one marshaled when the Rollout is updated.
+func (s *RolloutSpec) SetResolvedConfigTemplate(template corev1.PodTemplateSpec) {
+	if s.UnresolvedConfigTemplate == nil {
+		s.UnresolvedConfigTemplate = s.Template.DeepCopy()
+	}
+	s.Template = template
+}
+
 func (s *RolloutSpec) EmptyTemplate() bool {
 	if len(s.Template.Labels) > 0 {
 		return false
@@ -105,10 +280,31 @@ func (s *RolloutSpec) EmptyTemplate() bool {
 	return true
 }
 
+// IsStatefulSetWorkload returns whether the Rollout progresses the StatefulSet referenced by the workloadRef
+// instead of managing its own ReplicaSets
+func (s *RolloutSpec) IsStatefulSetWorkload() bool {
+	if s.WorkloadRef == nil {
+		return false
+	}
+	gvk := schema.FromAPIVersionAndKind(s.WorkloadRef.APIVersion, s.WorkloadRef.Kind)
+	return gvk.Group == "apps" && gvk.Kind == "StatefulSet"
+}
+
+// IsDaemonSetWorkload returns whether the pods of the Rollout are run by DaemonSets instead of ReplicaSets
+func (s *RolloutSpec) IsDaemonSetWorkload() bool {
+	return s.Strategy.Canary != nil && s.Strategy.Canary.DaemonSet != nil && s.Strategy.Canary.DaemonSet.Enabled
+}
+
 func (s *RolloutSpec) MarshalJSON() ([]byte, error) {
 	type Alias RolloutSpec
 
-	if s.TemplateResolvedFromRef || s.SelectorResolvedFromRef {
+	if s.UnresolvedConfigTemplate != nil && !s.TemplateResolvedFromRef {
+		unresolved := *s
+		unresolved.Template = *s.UnresolvedConfigTemplate
+		unresolved.UnresolvedConfigTemplate = nil
+		return unresolved.MarshalJSON()
+	}
+	if s.TemplateResolvedFromRef || s.SelectorResolvedFromRef || s.StrategyResolvedFromRef {
 		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&struct {
 			Alias `json:",inline"`
 		}{
@@ -123,6 +319,9 @@ func (s *RolloutSpec) MarshalJSON() ([]byte, error) {
 		if s.SelectorResolvedFromRef {
 			unstructured.RemoveNestedField(obj, "selector")
 		}
+		if s.StrategyResolvedFromRef {
+			unstructured.RemoveNestedField(obj, "strategy")
+		}
 
 		return json.Marshal(obj)
 	}
@@ -221,6 +420,37 @@ type BlueGreenStrategy struct {
 	// Default is 30 second
 	// +optional
 	AbortScaleDownDelaySeconds *int32 `json:"abortScaleDownDelaySeconds,omitempty" protobuf:"varint,14,opt,name=abortScaleDownDelaySeconds"`
+	// TrafficRouting hosts all the supported service meshes supported to shift the traffic from the active
+	// service to the preview service in weighted steps, instead of switching the active service at once
+	// +optional
+	TrafficRouting *RolloutTrafficRouting `json:"trafficRouting,omitempty" protobuf:"bytes,15,opt,name=trafficRouting"`
+	// TrafficSteps define the steps used to shift the traffic to the preview service on promotion when
+	// TrafficRouting is used. The active service is switched to the new ReplicaSet after the last step.
+	// +optional
+	TrafficSteps []BlueGreenTrafficStep `json:"trafficSteps,omitempty" protobuf:"bytes,16,rep,name=trafficSteps"`
+	// PrePromotionTimeout bounds the pre-promotion phase, from the time the preview ReplicaSet is available
+	// until the active service is switched. Skip considers the pre-promotion analysis successful.
+	// +optional
+	PrePromotionTimeout *StepTimeout `json:"prePromotionTimeout,omitempty" protobuf:"bytes,17,opt,name=prePromotionTimeout"`
+	// PostPromotionTimeout bounds the post-promotion analysis, from the time the active service is switched
+	// until the new ReplicaSet is marked stable. Skip considers the post-promotion analysis successful.
+	// +optional
+	PostPromotionTimeout *StepTimeout `json:"postPromotionTimeout,omitempty" protobuf:"bytes,18,opt,name=postPromotionTimeout"`
+}
+
+// BlueGreenTrafficStep defines a step of the weighted switchover of a blue-green rollout. Only one field
+// should be set.
+type BlueGreenTrafficStep struct {
+	// SetWeight sets the percentage of the traffic shifted to the preview service
+	// +optional
+	SetWeight *int32 `json:"setWeight,omitempty" protobuf:"varint,1,opt,name=setWeight"`
+	// Pause freezes the switchover by setting spec.Paused to true.
+	// A Rollout will resume when spec.Paused is reset to false.
+	// +optional
+	Pause *RolloutPause `json:"pause,omitempty" protobuf:"bytes,2,opt,name=pause"`
+	// Analysis defines the AnalysisRun that will run for a step
+	// +optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty" protobuf:"bytes,3,opt,name=analysis"`
 }
 
 // AntiAffinity defines which inter-pod scheduling rule to use for anti-affinity injection
@@ -317,6 +547,38 @@ type CanaryStrategy struct {
 	// Assuming the desired number of pods in a stable or canary ReplicaSet is not zero, then make sure it is at least
 	// MinPodsPerReplicaSet for High Availability. Only applicable for TrafficRoutedCanary
 	MinPodsPerReplicaSet *int32 `json:"minPodsPerReplicaSet,omitempty" protobuf:"varint,16,opt,name=minPodsPerReplicaSet"`
+	// DaemonSet runs the pods of the Rollout with DaemonSets instead of ReplicaSets. The canary steps select the
+	// nodes running the canary DaemonSet, while the stable DaemonSet runs on all the other nodes.
+	// +optional
+	DaemonSet *DaemonSetCanaryStrategy `json:"daemonSet,omitempty" protobuf:"bytes,17,opt,name=daemonSet"`
+	// AbortPolicy defines what happens to the canary pods when an update is aborted
+	// +optional
+	AbortPolicy *CanaryAbortPolicy `json:"abortPolicy,omitempty" protobuf:"bytes,18,opt,name=abortPolicy"`
+}
+
+// CanaryAbortPolicy defines what happens to the canary pods when an update is aborted
+type CanaryAbortPolicy struct {
+	// Preserve keeps some canary pods of an aborted update running for debugging, after removing them from the
+	// Services and the traffic routers
+	// +optional
+	Preserve *PreserveAbortedPods `json:"preserve,omitempty" protobuf:"bytes,1,opt,name=preserve"`
+}
+
+// PreserveAbortedPods defines the canary pods which are preserved when an update is aborted
+type PreserveAbortedPods struct {
+	// Count is the number of canary pods preserved for an aborted revision. Defaults to 1
+	// +optional
+	Count *int32 `json:"count,omitempty" protobuf:"varint,1,opt,name=count"`
+	// TTL is how long the preserved pods are kept before they are deleted (e.g. 30m, 2h). Defaults to 1h
+	// +optional
+	TTL DurationString `json:"ttl,omitempty" protobuf:"bytes,2,opt,name=ttl,casttype=DurationString"`
+}
+
+// DaemonSetCanaryStrategy runs a canary of a node agent on a subset of the nodes. The controller labels the canary
+// nodes, and the stable and canary DaemonSets are given complementary node affinities on that label.
+type DaemonSetCanaryStrategy struct {
+	// Enabled runs the pods of the Rollout with DaemonSets instead of ReplicaSets
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
 }
 
 // PingPongSpec holds the ping and pong service name.
@@ -390,6 +652,28 @@ type RolloutTrafficRouting struct {
 
 	// MaxTrafficWeight The total weight of traffic. If unspecified, it defaults to 100
 	MaxTrafficWeight *int32 `json:"maxTrafficWeight,omitempty" protobuf:"varint,11,opt,name=maxTrafficWeight"`
+	// Contour holds specific configuration to use Contour HTTPProxy to route traffic
+	Contour *ContourTrafficRouting `json:"contour,omitempty" protobuf:"bytes,12,opt,name=contour"`
+	// Kong holds specific configuration to use Kong to route traffic
+	Kong *KongTrafficRouting `json:"kong,omitempty" protobuf:"bytes,13,opt,name=kong"`
+	// Linkerd holds specific configuration to use Linkerd HTTPRoutes to route traffic
+	Linkerd *LinkerdTrafficRouting `json:"linkerd,omitempty" protobuf:"bytes,14,opt,name=linkerd"`
+	// DNS holds specific configuration to shift traffic with weighted DNS records
+	DNS *DNSTrafficRouting `json:"dns,omitempty" protobuf:"bytes,15,opt,name=dns"`
+	// Stickiness keeps users on the version they were first routed to while the canary weight changes.
+	// Supported by Istio, Nginx and Traefik
+	// +optional
+	Stickiness *TrafficStickiness `json:"stickiness,omitempty" protobuf:"bytes,16,opt,name=stickiness"`
+}
+
+// TrafficStickiness defines the cookie used by the traffic router to pin users to the stable or canary version
+type TrafficStickiness struct {
+	// CookieName is the name of the cookie which pins users to a version. Defaults to argo-rollouts-sticky
+	// +optional
+	CookieName string `json:"cookieName,omitempty" protobuf:"bytes,1,opt,name=cookieName"`
+	// DurationSeconds is the lifetime of the cookie. Defaults to a session cookie
+	// +optional
+	DurationSeconds int64 `json:"durationSeconds,omitempty" protobuf:"varint,2,opt,name=durationSeconds"`
 }
 
 type MangedRoutes struct {
@@ -404,6 +688,44 @@ type TraefikTrafficRouting struct {
 	WeightedTraefikServiceName string `json:"weightedTraefikServiceName" protobuf:"bytes,1,name=weightedTraefikServiceName"`
 }
 
+// ContourTrafficRouting defines the configuration required to use Contour HTTPProxy as traffic router
+type ContourTrafficRouting struct {
+	// HTTPProxies refer to the names of the Contour HTTPProxies used to route traffic to the service
+	HTTPProxies []string `json:"httpProxies" protobuf:"bytes,1,rep,name=httpProxies"`
+}
+
+// KongTrafficRouting defines the configuration required to use Kong as traffic router. Kong splits
+// traffic between upstreams through the weighted backendRefs of Gateway API HTTPRoutes.
+type KongTrafficRouting struct {
+	// HTTPRoutes refer to the names of the HTTPRoutes attached to a Kong Gateway used to route traffic to the service
+	HTTPRoutes []string `json:"httpRoutes" protobuf:"bytes,1,rep,name=httpRoutes"`
+}
+
+// LinkerdTrafficRouting defines the configuration required to use Linkerd as traffic router. Linkerd splits
+// traffic through the weighted backendRefs of policy.linkerd.io HTTPRoutes.
+type LinkerdTrafficRouting struct {
+	// HTTPRoutes refer to the names of the Linkerd HTTPRoutes used to route traffic to the service
+	HTTPRoutes []string `json:"httpRoutes" protobuf:"bytes,1,rep,name=httpRoutes"`
+}
+
+// DNSTrafficRouting defines the configuration required to shift traffic with the weighted DNS records of an
+// external-dns DNSEndpoint
+type DNSTrafficRouting struct {
+	// DNSEndpoint refers to the name of the external-dns DNSEndpoint which holds the weighted records
+	DNSEndpoint string `json:"dnsEndpoint" protobuf:"bytes,1,opt,name=dnsEndpoint"`
+	// StableSetIdentifier is the set identifier of the records which resolve to the stable service
+	StableSetIdentifier string `json:"stableSetIdentifier" protobuf:"bytes,2,opt,name=stableSetIdentifier"`
+	// CanarySetIdentifier is the set identifier of the records which resolve to the canary service
+	CanarySetIdentifier string `json:"canarySetIdentifier" protobuf:"bytes,3,opt,name=canarySetIdentifier"`
+	// WeightProperty is the provider specific property which holds the weight of the records. Defaults to aws/weight
+	// +optional
+	WeightProperty string `json:"weightProperty,omitempty" protobuf:"bytes,4,opt,name=weightProperty"`
+	// TTLSeconds is the time to wait after a weight change before the weight is verified, so that resolvers expire the
+	// records cached with the previous weights. Defaults to the highest recordTTL of the weighted records
+	// +optional
+	TTLSeconds *int32 `json:"ttlSeconds,omitempty" protobuf:"varint,5,opt,name=ttlSeconds"`
+}
+
 // ApisixTrafficRouting defines the configuration required to use APISIX as traffic router
 type ApisixTrafficRouting struct {
 	// Route references an Apisix Route to modify to shape traffic
@@ -460,6 +782,10 @@ type IstioTrafficRouting struct {
 	DestinationRule *IstioDestinationRule `json:"destinationRule,omitempty" protobuf:"bytes,2,opt,name=destinationRule"`
 	// VirtualServices references a list of Istio VirtualService to modify to shape traffic
 	VirtualServices []IstioVirtualService `json:"virtualServices,omitempty" protobuf:"bytes,3,opt,name=virtualServices"`
+	// Localities restricts the canary traffic to the canary pods of the listed localities, which are enabled one after
+	// the other as the canary weight increases. Requires a DestinationRule
+	// +optional
+	Localities []IstioLocality `json:"localities,omitempty" protobuf:"bytes,4,rep,name=localities"`
 }
 
 // IstioVirtualService holds information on the virtual service the rollout needs to modify
@@ -498,6 +824,15 @@ type IstioDestinationRule struct {
 	StableSubsetName string `json:"stableSubsetName" protobuf:"bytes,3,opt,name=stableSubsetName"`
 }
 
+// IstioLocality holds a locality in which the canary pods receive traffic
+type IstioLocality struct {
+	// Name of the locality in the region/zone/sub-zone format used by Istio, e.g. us-east1/us-east1-b
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// MinWeight is the canary weight from which the canary pods of the locality receive traffic. Defaults to 0
+	// +optional
+	MinWeight int32 `json:"minWeight,omitempty" protobuf:"varint,2,opt,name=minWeight"`
+}
+
 // AppMeshTrafficRouting configuration for AWS AppMesh service mesh to enable fine grain configuration
 type AppMeshTrafficRouting struct {
 	// VirtualService references an AppMesh VirtualService and VirtualRouter to modify to shape traffic
@@ -642,6 +977,85 @@ type CanaryStep struct {
 	SetMirrorRoute *SetMirrorRoute `json:"setMirrorRoute,omitempty" protobuf:"bytes,8,opt,name=setMirrorRoute"`
 	// Plugin defines a plugin to execute for a step
 	Plugin *PluginStep `json:"plugin,omitempty" protobuf:"bytes,9,opt,name=plugin"`
+	// SetCanaryNodes selects by label the nodes running the canary DaemonSet of a DaemonSet canary
+	// +optional
+	SetCanaryNodes *SetCanaryNodes `json:"setCanaryNodes,omitempty" protobuf:"bytes,10,opt,name=setCanaryNodes"`
+	// WaitFor waits for a condition of a resource of the cluster
+	// +optional
+	WaitFor *WaitForStep `json:"waitFor,omitempty" protobuf:"bytes,11,opt,name=waitFor"`
+	// Timeout is the maximum duration of the step (e.g. 30s, 10m), measured from the time the step started.
+	// The step is not bounded if unset
+	// +optional
+	Timeout DurationString `json:"timeout,omitempty" protobuf:"bytes,12,opt,name=timeout,casttype=DurationString"`
+	// OnTimeout is the action taken when the timeout of the step expires: Abort (default), Pause or Skip
+	// +optional
+	OnTimeout StepTimeoutPolicy `json:"onTimeout,omitempty" protobuf:"bytes,13,opt,name=onTimeout,casttype=StepTimeoutPolicy"`
+}
+
+// StepTimeout bounds the duration of a phase of an update
+type StepTimeout struct {
+	// Timeout is the maximum duration of the phase (e.g. 30s, 10m)
+	Timeout DurationString `json:"timeout" protobuf:"bytes,1,opt,name=timeout,casttype=DurationString"`
+	// OnTimeout is the action taken when the timeout expires: Abort (default), Pause or Skip
+	// +optional
+	OnTimeout StepTimeoutPolicy `json:"onTimeout,omitempty" protobuf:"bytes,2,opt,name=onTimeout,casttype=StepTimeoutPolicy"`
+}
+
+// StepTimeoutPolicy is the action taken when a step exceeds its timeout
+type StepTimeoutPolicy string
+
+const (
+	// StepTimeoutPolicyAbort aborts the update when a step times out
+	StepTimeoutPolicyAbort StepTimeoutPolicy = "Abort"
+	// StepTimeoutPolicyPause pauses the update when a step times out. The step is completed once the rollout is promoted
+	StepTimeoutPolicyPause StepTimeoutPolicy = "Pause"
+	// StepTimeoutPolicySkip completes a step which times out, and continues the update
+	StepTimeoutPolicySkip StepTimeoutPolicy = "Skip"
+)
+
+// WaitForStep waits for a condition of a resource, expressed either as a CEL expression or as a JSONPath template
+// and its expected value
+type WaitForStep struct {
+	// APIVersion of the resource
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	// Kind of the resource
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Name of the resource
+	Name string `json:"name" protobuf:"bytes,3,opt,name=name"`
+	// Namespace of the resource. Defaults to the namespace of the Rollout, and is ignored for cluster-scoped resources
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,4,opt,name=namespace"`
+	// Condition is a CEL expression which must evaluate to true. The resource is available as `object`
+	// +optional
+	Condition string `json:"condition,omitempty" protobuf:"bytes,5,opt,name=condition"`
+	// JSONPath is a JSONPath template evaluated against the resource, whose result must be equal to Value
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty" protobuf:"bytes,6,opt,name=jsonPath"`
+	// Value is the expected result of JSONPath
+	// +optional
+	Value string `json:"value,omitempty" protobuf:"bytes,7,opt,name=value"`
+	// Timeout is the maximum duration to wait for the condition (e.g. 30s, 10m). Waits forever if unset
+	// +optional
+	Timeout DurationString `json:"timeout,omitempty" protobuf:"bytes,8,opt,name=timeout,casttype=DurationString"`
+	// OnTimeout is the action taken when the timeout expires: Abort (default) or Pause
+	// +optional
+	OnTimeout WaitForTimeoutPolicy `json:"onTimeout,omitempty" protobuf:"bytes,9,opt,name=onTimeout,casttype=WaitForTimeoutPolicy"`
+}
+
+// WaitForTimeoutPolicy is the action taken when a waitFor step times out
+type WaitForTimeoutPolicy string
+
+const (
+	// WaitForTimeoutPolicyAbort aborts the update when a waitFor step times out
+	WaitForTimeoutPolicyAbort WaitForTimeoutPolicy = "Abort"
+	// WaitForTimeoutPolicyPause pauses the update when a waitFor step times out, until it is promoted
+	WaitForTimeoutPolicyPause WaitForTimeoutPolicy = "Pause"
+)
+
+// SetCanaryNodes selects the nodes running the canary DaemonSet
+type SetCanaryNodes struct {
+	// NodeSelector is a label query over the nodes running the canary DaemonSet
+	NodeSelector metav1.LabelSelector `json:"nodeSelector" protobuf:"bytes,1,opt,name=nodeSelector"`
 }
 
 type PluginStep struct {
@@ -863,6 +1277,12 @@ const (
 	PauseReasonCanaryPauseStep PauseReason = "CanaryPauseStep"
 	// PauseReasonBlueGreenPause pause rollout before promoting rollout
 	PauseReasonBlueGreenPause PauseReason = "BlueGreenPause"
+	// PauseReasonBlueGreenTrafficStepPause pause rollout for a pause step of the weighted blue-green switchover
+	PauseReasonBlueGreenTrafficStepPause PauseReason = "BlueGreenTrafficStepPause"
+	// PauseReasonWaitForTimeout pauses rollout when a waitFor step with the Pause timeout policy times out
+	PauseReasonWaitForTimeout PauseReason = "WaitForTimeout"
+	// PauseReasonStepTimeout pauses rollout when a step with the Pause timeout policy times out
+	PauseReasonStepTimeout PauseReason = "StepTimeout"
 )
 
 // PauseCondition the reason for a pause and when it started
@@ -970,6 +1390,44 @@ type RolloutStatus struct {
 	ALB *ALBStatus `json:"alb,omitempty" protobuf:"bytes,25,opt,name=alb"`
 	/// ALBs keeps information regarding multiple ALBs and TargetGroups in a multi ingress scenario
 	ALBs []ALBStatus `json:"albs,omitempty" protobuf:"bytes,26,opt,name=albs"`
+	// Hooks are the statuses of the last Job of each hook
+	// +optional
+	Hooks []RolloutHookStatus `json:"hooks,omitempty" protobuf:"bytes,27,rep,name=hooks"`
+	// Retry is the status of the automatic retries of the update of the current revision
+	// +optional
+	Retry *RolloutRetryStatus `json:"retry,omitempty" protobuf:"bytes,28,opt,name=retry"`
+	// CurrentStep records when the current step of the update started, to enforce its timeout and measure its
+	// duration
+	// +optional
+	CurrentStep *RolloutStepStatus `json:"currentStep,omitempty" protobuf:"bytes,29,opt,name=currentStep"`
+	// Lifecycle records when the update of the current revision started, to measure its duration
+	// +optional
+	Lifecycle *RolloutLifecycleStatus `json:"lifecycle,omitempty" protobuf:"bytes,30,opt,name=lifecycle"`
+	// StrategyTemplate is the generation of the template referenced by the StrategyRef when the current revision was
+	// created
+	// +optional
+	StrategyTemplate *RolloutStrategyTemplateStatus `json:"strategyTemplate,omitempty" protobuf:"bytes,31,opt,name=strategyTemplate"`
+}
+
+// RolloutLifecycleStatus records the progress of the update of a revision
+type RolloutLifecycleStatus struct {
+	// PodTemplateHash is the pod template hash of the updated revision
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,1,opt,name=podTemplateHash"`
+	// StartedAt is the time at which the update started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,2,opt,name=startedAt"`
+}
+
+// RolloutStepStatus is the status of the current step of an update
+type RolloutStepStatus struct {
+	// Step identifies the step: steps[N] for a canary step, prePromotion or postPromotion for a blue-green update
+	Step string `json:"step" protobuf:"bytes,1,opt,name=step"`
+	// PodTemplateHash is the pod template hash of the updated revision
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,2,opt,name=podTemplateHash"`
+	// StartedAt is the time at which the step started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,3,opt,name=startedAt"`
+	// TimedOutAt is the time at which the step exceeded its timeout
+	// +optional
+	TimedOutAt *metav1.Time `json:"timedOutAt,omitempty" protobuf:"bytes,4,opt,name=timedOutAt"`
 }
 
 // BlueGreenStatus status fields that only pertain to the blueGreen rollout
@@ -987,6 +1445,14 @@ type BlueGreenStatus struct {
 	PrePromotionAnalysisRunStatus *RolloutAnalysisRunStatus `json:"prePromotionAnalysisRunStatus,omitempty" protobuf:"bytes,4,opt,name=prePromotionAnalysisRunStatus"`
 	// PostPromotionAnalysisRunStatus indicates the status of the current post promotion analysis run
 	PostPromotionAnalysisRunStatus *RolloutAnalysisRunStatus `json:"postPromotionAnalysisRunStatus,omitempty" protobuf:"bytes,5,opt,name=postPromotionAnalysisRunStatus"`
+	// TrafficStepIndex is the index of the current traffic step of the weighted switchover. It is
+	// only set while the traffic is being shifted to the preview service.
+	// +optional
+	TrafficStepIndex *int32 `json:"trafficStepIndex,omitempty" protobuf:"varint,6,opt,name=trafficStepIndex"`
+	// TrafficStepAnalysisRunStatus indicates the status of the analysis run of the current traffic step
+	TrafficStepAnalysisRunStatus *RolloutAnalysisRunStatus `json:"trafficStepAnalysisRunStatus,omitempty" protobuf:"bytes,7,opt,name=trafficStepAnalysisRunStatus"`
+	// Weights records the weights which have been set on traffic provider. Only valid when using traffic routing
+	Weights *TrafficWeights `json:"weights,omitempty" protobuf:"bytes,8,opt,name=weights"`
 }
 
 // CanaryStatus status fields that only pertain to the canary rollout
@@ -1003,6 +1469,22 @@ type CanaryStatus struct {
 	StablePingPong PingPongType `json:"stablePingPong,omitempty" protobuf:"bytes,5,opt,name=stablePingPong"`
 	// StepPluginStatuses holds the status of the step plugins executed
 	StepPluginStatuses []StepPluginStatus `json:"stepPluginStatuses,omitempty" protobuf:"bytes,6,rep,name=stepPluginStatuses"`
+	// WaitFor is the status of the current waitFor step
+	WaitFor *WaitForStatus `json:"waitFor,omitempty" protobuf:"bytes,7,opt,name=waitFor"`
+}
+
+// WaitForStatus is the status of a waitFor step
+type WaitForStatus struct {
+	// Index of the step
+	Index int32 `json:"index" protobuf:"varint,1,opt,name=index"`
+	// StartedAt is the time the step started to wait for the condition
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,2,opt,name=startedAt"`
+	// Met indicates that the condition has been met
+	Met bool `json:"met,omitempty" protobuf:"varint,3,opt,name=met"`
+	// TimedOut indicates that the condition was not met before the timeout
+	TimedOut bool `json:"timedOut,omitempty" protobuf:"varint,4,opt,name=timedOut"`
+	// Message explains why the condition is not met
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
 }
 
 type PingPongType string
@@ -1,9 +1,11 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 )
 
 func TestRolloutPauseDuration(t *testing.T) {
@@ -24,3 +26,35 @@ func TestRolloutPauseDuration(t *testing.T) {
 	rp.Duration = DurationFromString("20000000000") // out of int32
 	assert.Equal(t, int32(-1), rp.DurationSeconds())
 }
+
+func TestRolloutSpecMarshalJSONResolvedConfigTemplate(t *testing.T) {
+	spec := RolloutSpec{
+		Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{ServiceAccountName: "original"}},
+	}
+	spec.SetResolvedConfigTemplate(corev1.PodTemplateSpec{Spec: corev1.PodSpec{ServiceAccountName: "resolved"}})
+	spec.SetResolvedConfigTemplate(corev1.PodTemplateSpec{Spec: corev1.PodSpec{ServiceAccountName: "resolved-again"}})
+	assert.Equal(t, "resolved-again", spec.Template.Spec.ServiceAccountName)
+	assert.Equal(t, "original", spec.UnresolvedConfigTemplate.Spec.ServiceAccountName)
+
+	data, err := json.Marshal(&spec)
+	assert.NoError(t, err)
+	var unmarshaled RolloutSpec
+	assert.NoError(t, json.Unmarshal(data, &unmarshaled))
+	assert.Equal(t, "original", unmarshaled.Template.Spec.ServiceAccountName)
+	assert.Nil(t, unmarshaled.UnresolvedConfigTemplate)
+}
+
+func TestRolloutSpecMarshalJSONResolvedStrategy(t *testing.T) {
+	spec := RolloutSpec{
+		StrategyRef: &StrategyRef{Name: "canary"},
+	}
+	spec.SetResolvedStrategy(RolloutStrategy{Canary: &CanaryStrategy{CanaryService: "canary"}})
+	assert.True(t, spec.StrategyResolvedFromRef)
+
+	data, err := json.Marshal(&spec)
+	assert.NoError(t, err)
+	var unmarshaled RolloutSpec
+	assert.NoError(t, json.Unmarshal(data, &unmarshaled))
+	assert.Nil(t, unmarshaled.Strategy.Canary)
+	assert.Equal(t, "canary", unmarshaled.StrategyRef.Name)
+}
@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	json "encoding/json"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
@@ -692,6 +693,21 @@ func (in *BlueGreenStatus) DeepCopyInto(out *BlueGreenStatus) {
 		*out = new(RolloutAnalysisRunStatus)
 		**out = **in
 	}
+	if in.TrafficStepIndex != nil {
+		in, out := &in.TrafficStepIndex, &out.TrafficStepIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TrafficStepAnalysisRunStatus != nil {
+		in, out := &in.TrafficStepAnalysisRunStatus, &out.TrafficStepAnalysisRunStatus
+		*out = new(RolloutAnalysisRunStatus)
+		**out = **in
+	}
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = new(TrafficWeights)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -763,6 +779,28 @@ func (in *BlueGreenStrategy) DeepCopyInto(out *BlueGreenStrategy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TrafficRouting != nil {
+		in, out := &in.TrafficRouting, &out.TrafficRouting
+		*out = new(RolloutTrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficSteps != nil {
+		in, out := &in.TrafficSteps, &out.TrafficSteps
+		*out = make([]BlueGreenTrafficStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrePromotionTimeout != nil {
+		in, out := &in.PrePromotionTimeout, &out.PrePromotionTimeout
+		*out = new(StepTimeout)
+		**out = **in
+	}
+	if in.PostPromotionTimeout != nil {
+		in, out := &in.PostPromotionTimeout, &out.PostPromotionTimeout
+		*out = new(StepTimeout)
+		**out = **in
+	}
 	return
 }
 
@@ -776,6 +814,58 @@ func (in *BlueGreenStrategy) DeepCopy() *BlueGreenStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenTrafficStep) DeepCopyInto(out *BlueGreenTrafficStep) {
+	*out = *in
+	if in.SetWeight != nil {
+		in, out := &in.SetWeight, &out.SetWeight
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(RolloutPause)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(RolloutAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenTrafficStep.
+func (in *BlueGreenTrafficStep) DeepCopy() *BlueGreenTrafficStep {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenTrafficStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryAbortPolicy) DeepCopyInto(out *CanaryAbortPolicy) {
+	*out = *in
+	if in.Preserve != nil {
+		in, out := &in.Preserve, &out.Preserve
+		*out = new(PreserveAbortedPods)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryAbortPolicy.
+func (in *CanaryAbortPolicy) DeepCopy() *CanaryAbortPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryAbortPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 	*out = *in
@@ -801,6 +891,11 @@ func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WaitFor != nil {
+		in, out := &in.WaitFor, &out.WaitFor
+		*out = new(WaitForStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -857,6 +952,16 @@ func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 		*out = new(PluginStep)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SetCanaryNodes != nil {
+		in, out := &in.SetCanaryNodes, &out.SetCanaryNodes
+		*out = new(SetCanaryNodes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WaitFor != nil {
+		in, out := &in.WaitFor, &out.WaitFor
+		*out = new(WaitForStep)
+		**out = **in
+	}
 	return
 }
 
@@ -940,6 +1045,16 @@ func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.DaemonSet != nil {
+		in, out := &in.DaemonSet, &out.DaemonSet
+		*out = new(DaemonSetCanaryStrategy)
+		**out = **in
+	}
+	if in.AbortPolicy != nil {
+		in, out := &in.AbortPolicy, &out.AbortPolicy
+		*out = new(CanaryAbortPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1137,6 +1252,200 @@ func (in *ClusterAnalysisTemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutPolicy) DeepCopyInto(out *ClusterRolloutPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutPolicy.
+func (in *ClusterRolloutPolicy) DeepCopy() *ClusterRolloutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutPolicyList) DeepCopyInto(out *ClusterRolloutPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRolloutPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutPolicyList.
+func (in *ClusterRolloutPolicyList) DeepCopy() *ClusterRolloutPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutStrategyTemplate) DeepCopyInto(out *ClusterRolloutStrategyTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutStrategyTemplate.
+func (in *ClusterRolloutStrategyTemplate) DeepCopy() *ClusterRolloutStrategyTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutStrategyTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutStrategyTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutStrategyTemplateList) DeepCopyInto(out *ClusterRolloutStrategyTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRolloutStrategyTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutStrategyTemplateList.
+func (in *ClusterRolloutStrategyTemplateList) DeepCopy() *ClusterRolloutStrategyTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutStrategyTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutStrategyTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRef) DeepCopyInto(out *ConfigRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRef.
+func (in *ConfigRef) DeepCopy() *ConfigRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContourTrafficRouting) DeepCopyInto(out *ContourTrafficRouting) {
+	*out = *in
+	if in.HTTPProxies != nil {
+		in, out := &in.HTTPProxies, &out.HTTPProxies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContourTrafficRouting.
+func (in *ContourTrafficRouting) DeepCopy() *ContourTrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(ContourTrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSTrafficRouting) DeepCopyInto(out *DNSTrafficRouting) {
+	*out = *in
+	if in.TTLSeconds != nil {
+		in, out := &in.TTLSeconds, &out.TTLSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSTrafficRouting.
+func (in *DNSTrafficRouting) DeepCopy() *DNSTrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSTrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DaemonSetCanaryStrategy) DeepCopyInto(out *DaemonSetCanaryStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetCanaryStrategy.
+func (in *DaemonSetCanaryStrategy) DeepCopy() *DaemonSetCanaryStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(DaemonSetCanaryStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMetric) DeepCopyInto(out *DatadogMetric) {
 	*out = *in
@@ -1470,6 +1779,22 @@ func (in *IstioDestinationRule) DeepCopy() *IstioDestinationRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioLocality) DeepCopyInto(out *IstioLocality) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioLocality.
+func (in *IstioLocality) DeepCopy() *IstioLocality {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioLocality)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioTrafficRouting) DeepCopyInto(out *IstioTrafficRouting) {
 	*out = *in
@@ -1490,6 +1815,11 @@ func (in *IstioTrafficRouting) DeepCopyInto(out *IstioTrafficRouting) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Localities != nil {
+		in, out := &in.Localities, &out.Localities
+		*out = make([]IstioLocality, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1611,30 +1941,72 @@ func (in *KayentaThreshold) DeepCopy() *KayentaThreshold {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MangedRoutes) DeepCopyInto(out *MangedRoutes) {
+func (in *KongTrafficRouting) DeepCopyInto(out *KongTrafficRouting) {
 	*out = *in
+	if in.HTTPRoutes != nil {
+		in, out := &in.HTTPRoutes, &out.HTTPRoutes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MangedRoutes.
-func (in *MangedRoutes) DeepCopy() *MangedRoutes {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KongTrafficRouting.
+func (in *KongTrafficRouting) DeepCopy() *KongTrafficRouting {
 	if in == nil {
 		return nil
 	}
-	out := new(MangedRoutes)
+	out := new(KongTrafficRouting)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Measurement) DeepCopyInto(out *Measurement) {
+func (in *LinkerdTrafficRouting) DeepCopyInto(out *LinkerdTrafficRouting) {
 	*out = *in
-	if in.StartedAt != nil {
-		in, out := &in.StartedAt, &out.StartedAt
-		*out = (*in).DeepCopy()
+	if in.HTTPRoutes != nil {
+		in, out := &in.HTTPRoutes, &out.HTTPRoutes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.FinishedAt != nil {
-		in, out := &in.FinishedAt, &out.FinishedAt
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinkerdTrafficRouting.
+func (in *LinkerdTrafficRouting) DeepCopy() *LinkerdTrafficRouting {
+	if in == nil {
+		return nil
+	}
+	out := new(LinkerdTrafficRouting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MangedRoutes) DeepCopyInto(out *MangedRoutes) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MangedRoutes.
+func (in *MangedRoutes) DeepCopy() *MangedRoutes {
+	if in == nil {
+		return nil
+	}
+	out := new(MangedRoutes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Measurement) DeepCopyInto(out *Measurement) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
 		*out = (*in).DeepCopy()
 	}
 	if in.Metadata != nil {
@@ -2028,6 +2400,27 @@ func (in *PreferredDuringSchedulingIgnoredDuringExecution) DeepCopy() *Preferred
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreserveAbortedPods) DeepCopyInto(out *PreserveAbortedPods) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreserveAbortedPods.
+func (in *PreserveAbortedPods) DeepCopy() *PreserveAbortedPods {
+	if in == nil {
+		return nil
+	}
+	out := new(PreserveAbortedPods)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusMetric) DeepCopyInto(out *PrometheusMetric) {
 	*out = *in
@@ -2327,6 +2720,96 @@ func (in *RolloutExperimentTemplate) DeepCopy() *RolloutExperimentTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutHook) DeepCopyInto(out *RolloutHook) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutHook.
+func (in *RolloutHook) DeepCopy() *RolloutHook {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutHookStatus) DeepCopyInto(out *RolloutHookStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutHookStatus.
+func (in *RolloutHookStatus) DeepCopy() *RolloutHookStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutHookStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutHooks) DeepCopyInto(out *RolloutHooks) {
+	*out = *in
+	if in.PreRollout != nil {
+		in, out := &in.PreRollout, &out.PreRollout
+		*out = new(RolloutHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostPromotion != nil {
+		in, out := &in.PostPromotion, &out.PostPromotion
+		*out = new(RolloutHook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnAbort != nil {
+		in, out := &in.OnAbort, &out.OnAbort
+		*out = new(RolloutHook)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutHooks.
+func (in *RolloutHooks) DeepCopy() *RolloutHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutLifecycleStatus) DeepCopyInto(out *RolloutLifecycleStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutLifecycleStatus.
+func (in *RolloutLifecycleStatus) DeepCopy() *RolloutLifecycleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutLifecycleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutList) DeepCopyInto(out *RolloutList) {
 	*out = *in
@@ -2381,6 +2864,175 @@ func (in *RolloutPause) DeepCopy() *RolloutPause {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicy) DeepCopyInto(out *RolloutPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicy.
+func (in *RolloutPolicy) DeepCopy() *RolloutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicyList) DeepCopyInto(out *RolloutPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolloutPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicyList.
+func (in *RolloutPolicyList) DeepCopy() *RolloutPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicyRule) DeepCopyInto(out *RolloutPolicyRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicyRule.
+func (in *RolloutPolicyRule) DeepCopy() *RolloutPolicyRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicyRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutPolicySpec) DeepCopyInto(out *RolloutPolicySpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RolloutPolicyRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutPolicySpec.
+func (in *RolloutPolicySpec) DeepCopy() *RolloutPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutRetryBackoff) DeepCopyInto(out *RolloutRetryBackoff) {
+	*out = *in
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutRetryBackoff.
+func (in *RolloutRetryBackoff) DeepCopy() *RolloutRetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutRetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutRetryPolicy) DeepCopyInto(out *RolloutRetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RolloutRetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryOn != nil {
+		in, out := &in.RetryOn, &out.RetryOn
+		*out = make([]RolloutAbortReason, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutRetryPolicy.
+func (in *RolloutRetryPolicy) DeepCopy() *RolloutRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutRetryStatus) DeepCopyInto(out *RolloutRetryStatus) {
+	*out = *in
+	if in.NextRetryAt != nil {
+		in, out := &in.NextRetryAt, &out.NextRetryAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutRetryStatus.
+func (in *RolloutRetryStatus) DeepCopy() *RolloutRetryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutRetryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 	*out = *in
@@ -2406,6 +3058,11 @@ func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 		**out = **in
 	}
 	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.StrategyRef != nil {
+		in, out := &in.StrategyRef, &out.StrategyRef
+		*out = new(StrategyRef)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RevisionHistoryLimit != nil {
 		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
 		*out = new(int32)
@@ -2425,6 +3082,26 @@ func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 		*out = new(AnalysisRunStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ConfigRefs != nil {
+		in, out := &in.ConfigRefs, &out.ConfigRefs
+		*out = make([]ConfigRef, len(*in))
+		copy(*out, *in)
+	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = new(RolloutHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RolloutRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UnresolvedConfigTemplate != nil {
+		in, out := &in.UnresolvedConfigTemplate, &out.UnresolvedConfigTemplate
+		*out = new(corev1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2485,6 +3162,33 @@ func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
 		*out = make([]ALBStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Hooks != nil {
+		in, out := &in.Hooks, &out.Hooks
+		*out = make([]RolloutHookStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RolloutRetryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CurrentStep != nil {
+		in, out := &in.CurrentStep, &out.CurrentStep
+		*out = new(RolloutStepStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(RolloutLifecycleStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StrategyTemplate != nil {
+		in, out := &in.StrategyTemplate, &out.StrategyTemplate
+		*out = new(RolloutStrategyTemplateStatus)
+		**out = **in
+	}
 	return
 }
 
@@ -2498,6 +3202,27 @@ func (in *RolloutStatus) DeepCopy() *RolloutStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStepStatus) DeepCopyInto(out *RolloutStepStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.TimedOutAt != nil {
+		in, out := &in.TimedOutAt, &out.TimedOutAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStepStatus.
+func (in *RolloutStepStatus) DeepCopy() *RolloutStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 	*out = *in
@@ -2524,6 +3249,99 @@ func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategyTemplate) DeepCopyInto(out *RolloutStrategyTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategyTemplate.
+func (in *RolloutStrategyTemplate) DeepCopy() *RolloutStrategyTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategyTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutStrategyTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategyTemplateList) DeepCopyInto(out *RolloutStrategyTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RolloutStrategyTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategyTemplateList.
+func (in *RolloutStrategyTemplateList) DeepCopy() *RolloutStrategyTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategyTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RolloutStrategyTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategyTemplateSpec) DeepCopyInto(out *RolloutStrategyTemplateSpec) {
+	*out = *in
+	in.Strategy.DeepCopyInto(&out.Strategy)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategyTemplateSpec.
+func (in *RolloutStrategyTemplateSpec) DeepCopy() *RolloutStrategyTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategyTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategyTemplateStatus) DeepCopyInto(out *RolloutStrategyTemplateStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategyTemplateStatus.
+func (in *RolloutStrategyTemplateStatus) DeepCopy() *RolloutStrategyTemplateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategyTemplateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutTrafficRouting) DeepCopyInto(out *RolloutTrafficRouting) {
 	*out = *in
@@ -2592,6 +3410,31 @@ func (in *RolloutTrafficRouting) DeepCopyInto(out *RolloutTrafficRouting) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Contour != nil {
+		in, out := &in.Contour, &out.Contour
+		*out = new(ContourTrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Kong != nil {
+		in, out := &in.Kong, &out.Kong
+		*out = new(KongTrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Linkerd != nil {
+		in, out := &in.Linkerd, &out.Linkerd
+		*out = new(LinkerdTrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSTrafficRouting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stickiness != nil {
+		in, out := &in.Stickiness, &out.Stickiness
+		*out = new(TrafficStickiness)
+		**out = **in
+	}
 	return
 }
 
@@ -2718,6 +3561,23 @@ func (in *SecretRef) DeepCopy() *SecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetCanaryNodes) DeepCopyInto(out *SetCanaryNodes) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetCanaryNodes.
+func (in *SetCanaryNodes) DeepCopy() *SetCanaryNodes {
+	if in == nil {
+		return nil
+	}
+	out := new(SetCanaryNodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SetCanaryScale) DeepCopyInto(out *SetCanaryScale) {
 	*out = *in
@@ -2860,6 +3720,22 @@ func (in *StepPluginStatus) DeepCopy() *StepPluginStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StepTimeout) DeepCopyInto(out *StepTimeout) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StepTimeout.
+func (in *StepTimeout) DeepCopy() *StepTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(StepTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StickinessConfig) DeepCopyInto(out *StickinessConfig) {
 	*out = *in
@@ -2876,6 +3752,34 @@ func (in *StickinessConfig) DeepCopy() *StickinessConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StrategyRef) DeepCopyInto(out *StrategyRef) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]AnalysisRunArgument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Weights != nil {
+		in, out := &in.Weights, &out.Weights
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StrategyRef.
+func (in *StrategyRef) DeepCopy() *StrategyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(StrategyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StringMatch) DeepCopyInto(out *StringMatch) {
 	*out = *in
@@ -3049,6 +3953,22 @@ func (in *TraefikTrafficRouting) DeepCopy() *TraefikTrafficRouting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficStickiness) DeepCopyInto(out *TrafficStickiness) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficStickiness.
+func (in *TrafficStickiness) DeepCopy() *TrafficStickiness {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficStickiness)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficWeights) DeepCopyInto(out *TrafficWeights) {
 	*out = *in
@@ -3103,6 +4023,39 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WaitForStatus) DeepCopyInto(out *WaitForStatus) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitForStatus.
+func (in *WaitForStatus) DeepCopy() *WaitForStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WaitForStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WaitForStep) DeepCopyInto(out *WaitForStep) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitForStep.
+func (in *WaitForStep) DeepCopy() *WaitForStep {
+	if in == nil {
+		return nil
+	}
+	out := new(WaitForStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WavefrontMetric) DeepCopyInto(out *WavefrontMetric) {
 	*out = *in
@@ -0,0 +1,363 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// The v1beta1 API types only differ from the v1alpha1 ones by the durations of the Rollouts and Experiments, which
+// are metav1.Durations instead of strings, and by the ManagedRoute type, which has the same schema as the misspelled
+// v1alpha1.MangedRoutes. The durations of the AnalysisTemplates and AnalysisRuns remain strings since they may
+// reference arguments, and the step of Prometheus range queries is not a Go duration.
+
+// durationToV1beta1 parses an optional v1alpha1 duration string. An invalid duration is left unset rather than
+// failing the conversion, which would fail the lists of all the objects of the kind, and is restored from the
+// V1alpha1DurationsAnnotationKey annotation when converting back to v1alpha1.
+func durationToV1beta1(in v1alpha1.DurationString) *metav1.Duration {
+	d, err := in.Duration()
+	if in == "" || err != nil {
+		return nil
+	}
+	return &metav1.Duration{Duration: d}
+}
+
+// durationToV1alpha1 formats an optional v1beta1 duration as a v1alpha1 duration string
+func durationToV1alpha1(in *metav1.Duration) v1alpha1.DurationString {
+	if in == nil {
+		return ""
+	}
+	return v1alpha1.DurationString(in.Duration.String())
+}
+
+// Convert_v1alpha1_RolloutRetryBackoff_To_v1beta1_RolloutRetryBackoff converts a v1alpha1.RolloutRetryBackoff
+func Convert_v1alpha1_RolloutRetryBackoff_To_v1beta1_RolloutRetryBackoff(in *v1alpha1.RolloutRetryBackoff, out *RolloutRetryBackoff, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_RolloutRetryBackoff_To_v1beta1_RolloutRetryBackoff(in, out, s); err != nil {
+		return err
+	}
+	out.Duration = durationToV1beta1(in.Duration)
+	out.MaxDuration = durationToV1beta1(in.MaxDuration)
+	return nil
+}
+
+// Convert_v1beta1_RolloutRetryBackoff_To_v1alpha1_RolloutRetryBackoff converts a v1beta1.RolloutRetryBackoff
+func Convert_v1beta1_RolloutRetryBackoff_To_v1alpha1_RolloutRetryBackoff(in *RolloutRetryBackoff, out *v1alpha1.RolloutRetryBackoff, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_RolloutRetryBackoff_To_v1alpha1_RolloutRetryBackoff(in, out, s); err != nil {
+		return err
+	}
+	out.Duration = durationToV1alpha1(in.Duration)
+	out.MaxDuration = durationToV1alpha1(in.MaxDuration)
+	return nil
+}
+
+// Convert_v1alpha1_PreserveAbortedPods_To_v1beta1_PreserveAbortedPods converts a v1alpha1.PreserveAbortedPods
+func Convert_v1alpha1_PreserveAbortedPods_To_v1beta1_PreserveAbortedPods(in *v1alpha1.PreserveAbortedPods, out *PreserveAbortedPods, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_PreserveAbortedPods_To_v1beta1_PreserveAbortedPods(in, out, s); err != nil {
+		return err
+	}
+	out.TTL = durationToV1beta1(in.TTL)
+	return nil
+}
+
+// Convert_v1beta1_PreserveAbortedPods_To_v1alpha1_PreserveAbortedPods converts a v1beta1.PreserveAbortedPods
+func Convert_v1beta1_PreserveAbortedPods_To_v1alpha1_PreserveAbortedPods(in *PreserveAbortedPods, out *v1alpha1.PreserveAbortedPods, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_PreserveAbortedPods_To_v1alpha1_PreserveAbortedPods(in, out, s); err != nil {
+		return err
+	}
+	out.TTL = durationToV1alpha1(in.TTL)
+	return nil
+}
+
+// Convert_v1alpha1_RolloutExperimentStep_To_v1beta1_RolloutExperimentStep converts a v1alpha1.RolloutExperimentStep
+func Convert_v1alpha1_RolloutExperimentStep_To_v1beta1_RolloutExperimentStep(in *v1alpha1.RolloutExperimentStep, out *RolloutExperimentStep, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_RolloutExperimentStep_To_v1beta1_RolloutExperimentStep(in, out, s); err != nil {
+		return err
+	}
+	out.Duration = durationToV1beta1(in.Duration)
+	return nil
+}
+
+// Convert_v1beta1_RolloutExperimentStep_To_v1alpha1_RolloutExperimentStep converts a v1beta1.RolloutExperimentStep
+func Convert_v1beta1_RolloutExperimentStep_To_v1alpha1_RolloutExperimentStep(in *RolloutExperimentStep, out *v1alpha1.RolloutExperimentStep, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_RolloutExperimentStep_To_v1alpha1_RolloutExperimentStep(in, out, s); err != nil {
+		return err
+	}
+	out.Duration = durationToV1alpha1(in.Duration)
+	return nil
+}
+
+// Convert_v1alpha1_CanaryStep_To_v1beta1_CanaryStep converts a v1alpha1.CanaryStep
+func Convert_v1alpha1_CanaryStep_To_v1beta1_CanaryStep(in *v1alpha1.CanaryStep, out *CanaryStep, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_CanaryStep_To_v1beta1_CanaryStep(in, out, s); err != nil {
+		return err
+	}
+	out.Timeout = durationToV1beta1(in.Timeout)
+	return nil
+}
+
+// Convert_v1beta1_CanaryStep_To_v1alpha1_CanaryStep converts a v1beta1.CanaryStep
+func Convert_v1beta1_CanaryStep_To_v1alpha1_CanaryStep(in *CanaryStep, out *v1alpha1.CanaryStep, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_CanaryStep_To_v1alpha1_CanaryStep(in, out, s); err != nil {
+		return err
+	}
+	out.Timeout = durationToV1alpha1(in.Timeout)
+	return nil
+}
+
+// Convert_v1alpha1_StepTimeout_To_v1beta1_StepTimeout converts a v1alpha1.StepTimeout
+func Convert_v1alpha1_StepTimeout_To_v1beta1_StepTimeout(in *v1alpha1.StepTimeout, out *StepTimeout, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_StepTimeout_To_v1beta1_StepTimeout(in, out, s); err != nil {
+		return err
+	}
+	timeout := durationToV1beta1(in.Timeout)
+	out.Timeout = metav1.Duration{}
+	if timeout != nil {
+		out.Timeout = *timeout
+	}
+	return nil
+}
+
+// Convert_v1beta1_StepTimeout_To_v1alpha1_StepTimeout converts a v1beta1.StepTimeout
+func Convert_v1beta1_StepTimeout_To_v1alpha1_StepTimeout(in *StepTimeout, out *v1alpha1.StepTimeout, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_StepTimeout_To_v1alpha1_StepTimeout(in, out, s); err != nil {
+		return err
+	}
+	out.Timeout = durationToV1alpha1(&in.Timeout)
+	return nil
+}
+
+// Convert_v1alpha1_WaitForStep_To_v1beta1_WaitForStep converts a v1alpha1.WaitForStep
+func Convert_v1alpha1_WaitForStep_To_v1beta1_WaitForStep(in *v1alpha1.WaitForStep, out *WaitForStep, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_WaitForStep_To_v1beta1_WaitForStep(in, out, s); err != nil {
+		return err
+	}
+	out.Timeout = durationToV1beta1(in.Timeout)
+	return nil
+}
+
+// Convert_v1beta1_WaitForStep_To_v1alpha1_WaitForStep converts a v1beta1.WaitForStep
+func Convert_v1beta1_WaitForStep_To_v1alpha1_WaitForStep(in *WaitForStep, out *v1alpha1.WaitForStep, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_WaitForStep_To_v1alpha1_WaitForStep(in, out, s); err != nil {
+		return err
+	}
+	out.Timeout = durationToV1alpha1(in.Timeout)
+	return nil
+}
+
+// Convert_v1alpha1_StepPluginStatus_To_v1beta1_StepPluginStatus converts a v1alpha1.StepPluginStatus
+func Convert_v1alpha1_StepPluginStatus_To_v1beta1_StepPluginStatus(in *v1alpha1.StepPluginStatus, out *StepPluginStatus, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_StepPluginStatus_To_v1beta1_StepPluginStatus(in, out, s); err != nil {
+		return err
+	}
+	out.Backoff = durationToV1beta1(in.Backoff)
+	return nil
+}
+
+// Convert_v1beta1_StepPluginStatus_To_v1alpha1_StepPluginStatus converts a v1beta1.StepPluginStatus
+func Convert_v1beta1_StepPluginStatus_To_v1alpha1_StepPluginStatus(in *StepPluginStatus, out *v1alpha1.StepPluginStatus, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_StepPluginStatus_To_v1alpha1_StepPluginStatus(in, out, s); err != nil {
+		return err
+	}
+	out.Backoff = durationToV1alpha1(in.Backoff)
+	return nil
+}
+
+// Convert_v1alpha1_ExperimentSpec_To_v1beta1_ExperimentSpec converts a v1alpha1.ExperimentSpec
+func Convert_v1alpha1_ExperimentSpec_To_v1beta1_ExperimentSpec(in *v1alpha1.ExperimentSpec, out *ExperimentSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ExperimentSpec_To_v1beta1_ExperimentSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Duration = durationToV1beta1(in.Duration)
+	return nil
+}
+
+// Convert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec converts a v1beta1.ExperimentSpec
+func Convert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(in *ExperimentSpec, out *v1alpha1.ExperimentSpec, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Duration = durationToV1alpha1(in.Duration)
+	return nil
+}
+
+// V1alpha1DurationsAnnotationKey is the annotation of the v1beta1 Rollouts and Experiments which records the v1alpha1
+// durations that are not formatted as Go durations, such as `1h` instead of `1h0m0s`, or that are invalid, as a JSON
+// object of their original strings keyed by their JSON pointer. They are restored when converting back to v1alpha1,
+// unless the duration changed in the meantime, so that the v1alpha1 objects applied by GitOps tools do not appear out
+// of sync.
+const V1alpha1DurationsAnnotationKey = "argo-rollouts.argoproj.io/v1alpha1-durations"
+
+// Convert_v1alpha1_Rollout_To_v1beta1_Rollout converts a v1alpha1.Rollout
+func Convert_v1alpha1_Rollout_To_v1beta1_Rollout(in *v1alpha1.Rollout, out *Rollout, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Rollout_To_v1beta1_Rollout(in, out, s); err != nil {
+		return err
+	}
+	var roundTripped v1alpha1.Rollout
+	if err := autoConvert_v1beta1_Rollout_To_v1alpha1_Rollout(out, &roundTripped, s); err != nil {
+		return err
+	}
+	return recordDurations(in, &roundTripped, &out.ObjectMeta)
+}
+
+// Convert_v1beta1_Rollout_To_v1alpha1_Rollout converts a v1beta1.Rollout
+func Convert_v1beta1_Rollout_To_v1alpha1_Rollout(in *Rollout, out *v1alpha1.Rollout, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_Rollout_To_v1alpha1_Rollout(in, out, s); err != nil {
+		return err
+	}
+	return restoreDurations(out, &out.ObjectMeta)
+}
+
+// Convert_v1alpha1_Experiment_To_v1beta1_Experiment converts a v1alpha1.Experiment
+func Convert_v1alpha1_Experiment_To_v1beta1_Experiment(in *v1alpha1.Experiment, out *Experiment, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Experiment_To_v1beta1_Experiment(in, out, s); err != nil {
+		return err
+	}
+	var roundTripped v1alpha1.Experiment
+	if err := autoConvert_v1beta1_Experiment_To_v1alpha1_Experiment(out, &roundTripped, s); err != nil {
+		return err
+	}
+	return recordDurations(in, &roundTripped, &out.ObjectMeta)
+}
+
+// Convert_v1beta1_Experiment_To_v1alpha1_Experiment converts a v1beta1.Experiment
+func Convert_v1beta1_Experiment_To_v1alpha1_Experiment(in *Experiment, out *v1alpha1.Experiment, s conversion.Scope) error {
+	if err := autoConvert_v1beta1_Experiment_To_v1alpha1_Experiment(in, out, s); err != nil {
+		return err
+	}
+	return restoreDurations(out, &out.ObjectMeta)
+}
+
+// recordDurations records in the annotations of a converted object the v1alpha1 durations of the original object
+// which differ once converted back to v1alpha1, which are the durations that are not formatted as Go durations
+func recordDurations(original, roundTripped runtime.Object, meta *metav1.ObjectMeta) error {
+	roundTrippedDurations := map[string]string{}
+	visitDurations(reflect.ValueOf(roundTripped), "", func(pointer string, d reflect.Value) {
+		roundTrippedDurations[pointer] = d.String()
+	})
+	durations := map[string]string{}
+	visitDurations(reflect.ValueOf(original), "", func(pointer string, d reflect.Value) {
+		if roundTripped, ok := roundTrippedDurations[pointer]; ok && roundTripped != d.String() {
+			durations[pointer] = d.String()
+		}
+	})
+	_, recorded := meta.Annotations[V1alpha1DurationsAnnotationKey]
+	if len(durations) == 0 && !recorded {
+		return nil
+	}
+	// the annotations are shared with the original object
+	annotations := withoutAnnotation(meta.Annotations, V1alpha1DurationsAnnotationKey)
+	if len(durations) > 0 {
+		value, err := json.Marshal(durations)
+		if err != nil {
+			return err
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[V1alpha1DurationsAnnotationKey] = string(value)
+	}
+	meta.Annotations = annotations
+	return nil
+}
+
+// restoreDurations restores the original strings of the v1alpha1 durations recorded in the annotations of a
+// converted object, unless they changed, and removes the annotation
+func restoreDurations(obj runtime.Object, meta *metav1.ObjectMeta) error {
+	value, ok := meta.Annotations[V1alpha1DurationsAnnotationKey]
+	if !ok {
+		return nil
+	}
+	// the annotations are shared with the converted object
+	meta.Annotations = withoutAnnotation(meta.Annotations, V1alpha1DurationsAnnotationKey)
+	var durations map[string]string
+	if err := json.Unmarshal([]byte(value), &durations); err != nil {
+		// the annotation was edited, the durations remain formatted as Go durations
+		return nil
+	}
+	visitDurations(reflect.ValueOf(obj), "", func(pointer string, d reflect.Value) {
+		original, ok := durations[pointer]
+		if ok && d.CanSet() && restorable(v1alpha1.DurationString(d.String()), v1alpha1.DurationString(original)) {
+			d.SetString(original)
+		}
+	})
+	return nil
+}
+
+var durationStringType = reflect.TypeOf(v1alpha1.DurationString(""))
+
+// visitDurations calls visit with the JSON pointer of every v1alpha1 duration of a value
+func visitDurations(v reflect.Value, pointer string, visit func(pointer string, d reflect.Value)) {
+	if v.Type() == durationStringType {
+		visit(pointer, v)
+		return
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			visitDurations(v.Elem(), pointer, visit)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			visitDurations(v.Index(i), pointer+"/"+strconv.Itoa(i), visit)
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			visitDurations(iter.Value(), pointer+"/"+jsonPointerEscaper.Replace(iter.Key().String()), visit)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			switch {
+			case name == "-":
+			case name == "" && field.Anonymous:
+				visitDurations(v.Field(i), pointer, visit)
+			case name == "":
+				visitDurations(v.Field(i), pointer+"/"+jsonPointerEscaper.Replace(field.Name), visit)
+			default:
+				visitDurations(v.Field(i), pointer+"/"+jsonPointerEscaper.Replace(name), visit)
+			}
+		}
+	}
+}
+
+// restorable returns whether the original string of a v1alpha1 duration can be restored, which is when the duration
+// did not change, or remained unset if the original duration is invalid
+func restorable(current, original v1alpha1.DurationString) bool {
+	currentDuration, err := current.Duration()
+	originalDuration, originalErr := original.Duration()
+	if originalErr != nil {
+		return current == "" || err == nil && currentDuration == 0
+	}
+	return err == nil && currentDuration == originalDuration
+}
+
+func withoutAnnotation(annotations map[string]string, key string) map[string]string {
+	var result map[string]string
+	for k, v := range annotations {
+		if k == key {
+			continue
+		}
+		if result == nil {
+			result = map[string]string{}
+		}
+		result[k] = v
+	}
+	return result
+}
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
@@ -0,0 +1,172 @@
+package v1beta1
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.AddToScheme(scheme))
+	require.NoError(t, AddToScheme(scheme))
+	return scheme
+}
+
+func newFuzzer(seed int64) *fuzz.Fuzzer {
+	return fuzz.NewWithSeed(seed).NilChance(0.3).NumElements(0, 2).MaxDepth(12).Funcs(
+		// durations are formatted as Go durations, which parse back to the same duration
+		func(d *v1alpha1.DurationString, c fuzz.Continue) {
+			*d = v1alpha1.DurationString(time.Duration(c.Int63n(int64(100 * time.Hour))).String())
+		},
+		func(d *metav1.Duration, c fuzz.Continue) {
+			d.Duration = time.Duration(c.Int63n(int64(100 * time.Hour)))
+		},
+		// times are serialized with a precision of a second
+		func(t *metav1.Time, c fuzz.Continue) {
+			*t = metav1.Unix(c.Int63n(1<<32), 0)
+		},
+		func(t *metav1.MicroTime, c fuzz.Continue) {
+			*t = metav1.NewMicroTime(time.Unix(c.Int63n(1<<32), 0))
+		},
+	)
+}
+
+// TestRoundTrip converts random objects of both versions to the other version and back
+func TestRoundTrip(t *testing.T) {
+	scheme := newScheme(t)
+	pairs := []struct {
+		alpha runtime.Object
+		beta  runtime.Object
+	}{
+		{&v1alpha1.Rollout{}, &Rollout{}},
+		{&v1alpha1.Experiment{}, &Experiment{}},
+		{&v1alpha1.AnalysisTemplate{}, &AnalysisTemplate{}},
+		{&v1alpha1.ClusterAnalysisTemplate{}, &ClusterAnalysisTemplate{}},
+		{&v1alpha1.AnalysisRun{}, &AnalysisRun{}},
+	}
+	seed := rand.Int63()
+	t.Logf("seed: %d", seed)
+	fuzzer := newFuzzer(seed)
+	for _, pair := range pairs {
+		for i := 0; i < 20; i++ {
+			for _, objs := range [][2]runtime.Object{{pair.alpha, pair.beta}, {pair.beta, pair.alpha}} {
+				original := objs[0].DeepCopyObject()
+				fuzzer.Fuzz(original)
+				original.GetObjectKind().SetGroupVersionKind(schemaKind(t, scheme, objs[0]))
+
+				converted := objs[1].DeepCopyObject()
+				require.NoError(t, scheme.Convert(original, converted, nil))
+				roundTripped := objs[0].DeepCopyObject()
+				require.NoError(t, scheme.Convert(converted, roundTripped, nil))
+				roundTripped.GetObjectKind().SetGroupVersionKind(schemaKind(t, scheme, objs[0]))
+
+				if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+					t.Fatalf("%T did not round trip through %T", original, converted)
+				}
+			}
+		}
+	}
+}
+
+func schemaKind(t *testing.T, scheme *runtime.Scheme, obj runtime.Object) schema.GroupVersionKind {
+	gvks, _, err := scheme.ObjectKinds(obj)
+	require.NoError(t, err)
+	return gvks[0]
+}
+
+func TestConvertDurations(t *testing.T) {
+	alpha := v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{Experiment: &v1alpha1.RolloutExperimentStep{Duration: "1h"}},
+						{SetWeight: pointer.Int32Ptr(10), Timeout: "90s"},
+						{SetWeight: pointer.Int32Ptr(20)},
+					},
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "header"}},
+					},
+				},
+			},
+		},
+	}
+	var beta Rollout
+	require.NoError(t, Convert_v1alpha1_Rollout_To_v1beta1_Rollout(&alpha, &beta, nil))
+	steps := beta.Spec.Strategy.Canary.Steps
+	assert.Equal(t, &metav1.Duration{Duration: time.Hour}, steps[0].Experiment.Duration)
+	assert.Equal(t, &metav1.Duration{Duration: 90 * time.Second}, steps[1].Timeout)
+	assert.Nil(t, steps[2].Timeout)
+	assert.Equal(t, []ManagedRoute{{Name: "header"}}, beta.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes)
+
+	// the durations which are not formatted as Go durations are recorded, and restored in v1alpha1
+	assert.JSONEq(t, `{"/spec/strategy/canary/steps/0/experiment/duration":"1h","/spec/strategy/canary/steps/1/timeout":"90s"}`,
+		beta.Annotations[V1alpha1DurationsAnnotationKey])
+	assert.Nil(t, alpha.Annotations)
+	var converted v1alpha1.Rollout
+	require.NoError(t, Convert_v1beta1_Rollout_To_v1alpha1_Rollout(&beta, &converted, nil))
+	assert.Equal(t, alpha, converted)
+	assert.Contains(t, beta.Annotations, V1alpha1DurationsAnnotationKey)
+
+	// the durations which changed are formatted as Go durations
+	beta.Spec.Strategy.Canary.Steps[1].Timeout = &metav1.Duration{Duration: 2 * time.Minute}
+	converted = v1alpha1.Rollout{}
+	require.NoError(t, Convert_v1beta1_Rollout_To_v1alpha1_Rollout(&beta, &converted, nil))
+	convertedSteps := converted.Spec.Strategy.Canary.Steps
+	assert.Equal(t, v1alpha1.DurationString("1h"), convertedSteps[0].Experiment.Duration)
+	assert.Equal(t, v1alpha1.DurationString("2m0s"), convertedSteps[1].Timeout)
+	assert.Equal(t, v1alpha1.DurationString(""), convertedSteps[2].Timeout)
+	assert.Nil(t, converted.Annotations)
+}
+
+func TestConvertDurationsAnnotation(t *testing.T) {
+	// a stale annotation is removed from the Go durations
+	alpha := v1alpha1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			V1alpha1DurationsAnnotationKey: `{"/spec/duration":"60m"}`,
+			"foo":                          "bar",
+		}},
+		Spec: v1alpha1.ExperimentSpec{Duration: "1h0m0s"},
+	}
+	var beta Experiment
+	require.NoError(t, Convert_v1alpha1_Experiment_To_v1beta1_Experiment(&alpha, &beta, nil))
+	assert.Equal(t, map[string]string{"foo": "bar"}, beta.Annotations)
+	assert.Contains(t, alpha.Annotations, V1alpha1DurationsAnnotationKey)
+
+	// an invalid annotation is ignored
+	beta.Annotations[V1alpha1DurationsAnnotationKey] = "{"
+	var converted v1alpha1.Experiment
+	require.NoError(t, Convert_v1beta1_Experiment_To_v1alpha1_Experiment(&beta, &converted, nil))
+	assert.Equal(t, v1alpha1.DurationString("1h0m0s"), converted.Spec.Duration)
+	assert.Equal(t, map[string]string{"foo": "bar"}, converted.Annotations)
+}
+
+func TestConvertInvalidDuration(t *testing.T) {
+	// invalid durations are left unset rather than failing the conversion, and restored in v1alpha1
+	alpha := v1alpha1.Experiment{Spec: v1alpha1.ExperimentSpec{Duration: "forever"}}
+	var beta Experiment
+	require.NoError(t, Convert_v1alpha1_Experiment_To_v1beta1_Experiment(&alpha, &beta, nil))
+	assert.Nil(t, beta.Spec.Duration)
+	assert.Equal(t, `{"/spec/duration":"forever"}`, beta.Annotations[V1alpha1DurationsAnnotationKey])
+
+	var converted v1alpha1.Experiment
+	require.NoError(t, Convert_v1beta1_Experiment_To_v1alpha1_Experiment(&beta, &converted, nil))
+	assert.Equal(t, alpha, converted)
+
+	beta.Spec.Duration = &metav1.Duration{Duration: time.Hour}
+	converted = v1alpha1.Experiment{}
+	require.NoError(t, Convert_v1beta1_Experiment_To_v1alpha1_Experiment(&beta, &converted, nil))
+	assert.Equal(t, v1alpha1.DurationString("1h0m0s"), converted.Spec.Duration)
+}
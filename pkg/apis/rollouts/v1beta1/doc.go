@@ -0,0 +1,7 @@
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1
+// +groupName=argoproj.io
+// +k8s:openapi-gen=true
+
+// Package v1beta1 is the v1beta1 version of the API.
+package v1beta1
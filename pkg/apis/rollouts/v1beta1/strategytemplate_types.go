@@ -0,0 +1,50 @@
+package v1beta1
+
+// StrategyRef references the RolloutStrategyTemplate or ClusterRolloutStrategyTemplate which provides the strategy of
+// a Rollout, along with the values of the strategy which are overridden by the Rollout
+type StrategyRef struct {
+	// Name is the name of the template
+	Name string `json:"name"`
+	// ClusterScope indicates the template is a ClusterRolloutStrategyTemplate
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty"`
+	// Args override the arguments of the same name of every analysis of the strategy, and are added to the
+	// analyses which do not set them
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Args []AnalysisRunArgument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+	// Weights override the weights of the setWeight steps of the canary strategy, in order. There must be one
+	// weight for each setWeight step
+	// +optional
+	Weights []int32 `json:"weights,omitempty"`
+	// CanaryService overrides the canary service of the canary strategy
+	// +optional
+	CanaryService string `json:"canaryService,omitempty"`
+	// StableService overrides the stable service of the canary strategy
+	// +optional
+	StableService string `json:"stableService,omitempty"`
+	// ActiveService overrides the active service of the blue-green strategy
+	// +optional
+	ActiveService string `json:"activeService,omitempty"`
+	// PreviewService overrides the preview service of the blue-green strategy
+	// +optional
+	PreviewService string `json:"previewService,omitempty"`
+}
+
+// RolloutStrategyTemplateStatus records the generation of the template referenced by the StrategyRef of a Rollout
+// when its current revision was created. The strategy of the template at that generation is kept in a
+// ControllerRevision owned by the Rollout, so that a change of the template only applies to the next revisions.
+type RolloutStrategyTemplateStatus struct {
+	// PodTemplateHash is the pod template hash of the revision the strategy was resolved for
+	PodTemplateHash string `json:"podTemplateHash"`
+	// Name is the name of the template
+	Name string `json:"name"`
+	// ClusterScope indicates the template is a ClusterRolloutStrategyTemplate
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty"`
+	// Generation is the generation of the template
+	Generation int64 `json:"generation"`
+	// StrategyHash is the hash of the strategy of the template, which names the ControllerRevision holding it
+	StrategyHash string `json:"strategyHash"`
+}
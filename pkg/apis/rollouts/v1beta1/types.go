@@ -0,0 +1,1677 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	fmt "fmt"
+	"strconv"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:path=rollouts,shortName=ro
+// +kubebuilder:unservedversion
+// +kubebuilder:subresource:scale:specpath=.spec.replicas,statuspath=.status.HPAReplicas,selectorpath=.status.selector
+// +kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".spec.replicas",description="Number of desired pods"
+// +kubebuilder:printcolumn:name="Current",type="integer",JSONPath=".status.replicas",description="Total number of non-terminated pods targeted by this rollout"
+// +kubebuilder:printcolumn:name="Up-to-date",type="integer",JSONPath=".status.updatedReplicas",description="Total number of non-terminated pods targeted by this rollout that have the desired template spec"
+// +kubebuilder:printcolumn:name="Available",type="integer",JSONPath=".status.availableReplicas",description="Total number of available pods (ready for at least minReadySeconds) targeted by this rollout"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="Time since resource was created"
+// +kubebuilder:subresource:status
+
+// Rollout is a specification for a Rollout resource
+type Rollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RolloutSpec   `json:"spec"`
+	Status RolloutStatus `json:"status,omitempty"`
+}
+
+// RolloutSpec is the spec for a Rollout resource
+type RolloutSpec struct {
+	TemplateResolvedFromRef bool `json:"-"`
+	SelectorResolvedFromRef bool `json:"-"`
+	StrategyResolvedFromRef bool `json:"-"`
+	// Number of desired pods. This is a pointer to distinguish between explicit
+	// zero and not specified. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Label selector for pods. Existing ReplicaSets whose pods are
+	// selected by this will be the ones affected by this rollout.
+	// It must match the pod template's labels.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message=".spec.selector is immutable"
+	Selector *metav1.LabelSelector `json:"selector"`
+	// Template describes the pods that will be created.
+	// +optional
+	Template corev1.PodTemplateSpec `json:"template"`
+	// WorkloadRef holds a references to a workload that provides Pod template
+	// +optional
+	WorkloadRef *ObjectRef `json:"workloadRef,omitempty"`
+	// Minimum number of seconds for which a newly created pod should be ready
+	// without any of its container crashing, for it to be considered available.
+	// Defaults to 0 (pod will be considered available as soon as it is ready)
+	// +optional
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+	// The window in which a rollback will be fast tracked (fully promoted)
+	// +optional
+	RollbackWindow *RollbackWindowSpec `json:"rollbackWindow,omitempty"`
+	// The deployment strategy to use to replace existing pods with new ones.
+	// +optional
+	Strategy RolloutStrategy `json:"strategy"`
+	// StrategyRef references a RolloutStrategyTemplate or ClusterRolloutStrategyTemplate which provides the
+	// strategy, instead of the strategy field. A change of the template only applies to the next revisions
+	// +optional
+	StrategyRef *StrategyRef `json:"strategyRef,omitempty"`
+	// The number of old ReplicaSets to retain. If unspecified, will retain 10 old ReplicaSets
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+	// Paused pauses the rollout at its current step.
+	Paused bool `json:"paused,omitempty"`
+	// ProgressDeadlineSeconds The maximum time in seconds for a rollout to
+	// make progress before it is considered to be failed. Argo Rollouts will
+	// continue to process failed rollouts and a condition with a
+	// ProgressDeadlineExceeded reason will be surfaced in the rollout status.
+	// Note that progress will not be estimated during the time a rollout is paused.
+	// Defaults to 600s.
+	ProgressDeadlineSeconds *int32 `json:"progressDeadlineSeconds,omitempty"`
+	// ProgressDeadlineAbort is whether to abort the update when ProgressDeadlineSeconds
+	// is exceeded.
+	// +optional
+	ProgressDeadlineAbort bool `json:"progressDeadlineAbort,omitempty"`
+	// RestartAt indicates when all the pods of a Rollout should be restarted
+	RestartAt *metav1.Time `json:"restartAt,omitempty"`
+	// Analysis configuration for the analysis runs to retain
+	Analysis *AnalysisRunStrategy `json:"analysis,omitempty"`
+	// ConfigRefs are the ConfigMaps and Secrets referenced by the pod template which are versioned with the pod
+	// template. Each version of their data is copied into an immutable snapshot, and the pod template is rewritten
+	// to reference the snapshot, so that a change of the data creates a new revision of the Rollout.
+	// +optional
+	ConfigRefs []ConfigRef `json:"configRefs,omitempty"`
+	// Hooks are Jobs run by the controller at phases of an update: before the ReplicaSet of a new revision is
+	// created, after a revision is fully promoted and after an update is aborted
+	// +optional
+	Hooks *RolloutHooks `json:"hooks,omitempty"`
+	// RetryPolicy retries the update of a revision automatically, with a backoff, after it was aborted
+	// +optional
+	RetryPolicy *RolloutRetryPolicy `json:"retryPolicy,omitempty"`
+	// UnresolvedConfigTemplate is the pod template before its ConfigRefs were rewritten to their snapshots
+	UnresolvedConfigTemplate *corev1.PodTemplateSpec `json:"-"`
+}
+
+// ConfigRefKind is the kind of a versioned config
+// +kubebuilder:validation:Enum=ConfigMap;Secret
+type ConfigRefKind string
+
+const (
+	ConfigRefKindConfigMap ConfigRefKind = "ConfigMap"
+	ConfigRefKindSecret    ConfigRefKind = "Secret"
+)
+
+// ConfigRef references a ConfigMap or Secret versioned with the pod template
+type ConfigRef struct {
+	// Kind of the config, ConfigMap or Secret
+	Kind ConfigRefKind `json:"kind"`
+	// Name of the ConfigMap or Secret in the namespace of the Rollout
+	Name string `json:"name"`
+}
+
+// RolloutHooks are the Jobs run by the controller at phases of an update
+type RolloutHooks struct {
+	// PreRollout is run before the ReplicaSet of a new revision is created. The ReplicaSet is created once the Job
+	// succeeds, and the update is aborted if the Job fails.
+	// +optional
+	PreRollout *RolloutHook `json:"preRollout,omitempty"`
+	// PostPromotion is run once a revision is fully promoted. The Rollout is progressing until the Job succeeds, and
+	// degraded if the Job fails.
+	// +optional
+	PostPromotion *RolloutHook `json:"postPromotion,omitempty"`
+	// OnAbort is run when an update is aborted
+	// +optional
+	OnAbort *RolloutHook `json:"onAbort,omitempty"`
+}
+
+// RolloutHook defines a Job run at a phase of an update
+type RolloutHook struct {
+	// Metadata of the Job
+	// +optional
+	Metadata PodTemplateMetadata `json:"metadata,omitempty"`
+	// Spec of the Job. Finished Jobs are deleted after spec.ttlSecondsAfterFinished, which defaults to one day.
+	Spec batchv1.JobSpec `json:"spec"`
+}
+
+// RolloutHookType is the phase of an update at which a hook is run
+type RolloutHookType string
+
+const (
+	RolloutHookTypePreRollout    RolloutHookType = "PreRollout"
+	RolloutHookTypePostPromotion RolloutHookType = "PostPromotion"
+	RolloutHookTypeOnAbort       RolloutHookType = "OnAbort"
+)
+
+// RolloutHookPhase is the phase of the Job of a hook
+type RolloutHookPhase string
+
+const (
+	RolloutHookPhaseRunning    RolloutHookPhase = "Running"
+	RolloutHookPhaseSuccessful RolloutHookPhase = "Successful"
+	RolloutHookPhaseFailed     RolloutHookPhase = "Failed"
+)
+
+// RolloutHookStatus is the status of the last Job of a hook
+type RolloutHookStatus struct {
+	// Type of the hook
+	Type RolloutHookType `json:"type"`
+	// PodTemplateHash is the pod template hash of the revision the Job was run for
+	PodTemplateHash string `json:"podTemplateHash"`
+	// JobName is the name of the Job
+	JobName string `json:"jobName"`
+	// Phase of the Job
+	Phase RolloutHookPhase `json:"phase"`
+	// Message explains why the Job failed
+	// +optional
+	Message string `json:"message,omitempty"`
+	// StartedAt is the time the Job was created
+	// +optional
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// FinishedAt is the time the Job completed or failed
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+}
+
+// RolloutAbortReason is the reason of the abort of an update which can be retried automatically
+type RolloutAbortReason string
+
+const (
+	// RolloutAbortReasonAnalysisError is the abort of an update by an AnalysisRun in the Error phase
+	RolloutAbortReasonAnalysisError RolloutAbortReason = "AnalysisError"
+	// RolloutAbortReasonAnalysisFailed is the abort of an update by an AnalysisRun in the Failed phase
+	RolloutAbortReasonAnalysisFailed RolloutAbortReason = "AnalysisFailed"
+	// RolloutAbortReasonAnalysisInconclusive is the abort of an update by an AnalysisRun in the Inconclusive phase.
+	// Inconclusive AnalysisRuns pause the rollout, unless they are retried by the retryPolicy.
+	RolloutAbortReasonAnalysisInconclusive RolloutAbortReason = "AnalysisInconclusive"
+	// RolloutAbortReasonProgressDeadlineExceeded is the abort of an update which exceeded the progress deadline
+	// with progressDeadlineAbort
+	RolloutAbortReasonProgressDeadlineExceeded RolloutAbortReason = "ProgressDeadlineExceeded"
+	// RolloutAbortReasonStepTimeout is the abort of an update by a step which timed out with the Abort policy
+	RolloutAbortReasonStepTimeout RolloutAbortReason = "StepTimeout"
+)
+
+// RolloutRetryPolicy defines the automatic retries of the aborted updates of a revision
+type RolloutRetryPolicy struct {
+	// Limit is the maximum number of automatic retries of the update of a revision
+	Limit int32 `json:"limit"`
+	// Backoff is the exponential backoff between an abort and the retry of the update
+	// +optional
+	Backoff *RolloutRetryBackoff `json:"backoff,omitempty"`
+	// RetryOn are the reasons of the aborts which are retried. Defaults to AnalysisError and AnalysisInconclusive.
+	// +optional
+	RetryOn []RolloutAbortReason `json:"retryOn,omitempty"`
+}
+
+// RolloutRetryBackoff is the exponential backoff of the automatic retries of an update
+type RolloutRetryBackoff struct {
+	// Duration is the delay before the first retry (e.g. 30s, 5m). Defaults to 30s
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// Factor multiplies the delay after each retry. Defaults to 2
+	// +optional
+	Factor *int32 `json:"factor,omitempty"`
+	// MaxDuration is the maximum delay before a retry
+	// +optional
+	MaxDuration *metav1.Duration `json:"maxDuration,omitempty"`
+}
+
+// RolloutRetryStatus is the status of the automatic retries of the update of a revision
+type RolloutRetryStatus struct {
+	// PodTemplateHash is the pod template hash of the revision whose update is retried
+	PodTemplateHash string `json:"podTemplateHash"`
+	// Attempts is the number of automatic retries of the update
+	Attempts int32 `json:"attempts"`
+	// LastAbortReason is the reason of the last abort of the update, if it can be retried
+	// +optional
+	LastAbortReason RolloutAbortReason `json:"lastAbortReason,omitempty"`
+	// NextRetryAt is the time of the next retry of the aborted update
+	// +optional
+	NextRetryAt *metav1.Time `json:"nextRetryAt,omitempty"`
+}
+
+func (s *RolloutSpec) SetResolvedSelector(selector *metav1.LabelSelector) {
+	s.SelectorResolvedFromRef = true
+	s.Selector = selector
+}
+
+func (s *RolloutSpec) SetResolvedTemplate(template corev1.PodTemplateSpec) {
+	s.TemplateResolvedFromRef = true
+	s.Template = template
+}
+
+// SetResolvedStrategy sets the strategy resolved from the StrategyRef. The resolved strategy is not marshaled when the
+// Rollout is updated.
+func (s *RolloutSpec) SetResolvedStrategy(strategy RolloutStrategy) {
+	s.StrategyResolvedFromRef = true
+	s.Strategy = strategy
+}
+
+// SetResolvedConfigTemplate sets the pod template whose ConfigRefs were rewritten to their snapshots. The original
+// template is kept, and is the one marshaled when the Rollout is updated.
+func (s *RolloutSpec) SetResolvedConfigTemplate(template corev1.PodTemplateSpec) {
+	if s.UnresolvedConfigTemplate == nil {
+		s.UnresolvedConfigTemplate = s.Template.DeepCopy()
+	}
+	s.Template = template
+}
+
+func (s *RolloutSpec) EmptyTemplate() bool {
+	if len(s.Template.Labels) > 0 {
+		return false
+	}
+	if len(s.Template.Annotations) > 0 {
+		return false
+	}
+	return true
+}
+
+// IsStatefulSetWorkload returns whether the Rollout progresses the StatefulSet referenced by the workloadRef
+// instead of managing its own ReplicaSets
+func (s *RolloutSpec) IsStatefulSetWorkload() bool {
+	if s.WorkloadRef == nil {
+		return false
+	}
+	gvk := schema.FromAPIVersionAndKind(s.WorkloadRef.APIVersion, s.WorkloadRef.Kind)
+	return gvk.Group == "apps" && gvk.Kind == "StatefulSet"
+}
+
+// IsDaemonSetWorkload returns whether the pods of the Rollout are run by DaemonSets instead of ReplicaSets
+func (s *RolloutSpec) IsDaemonSetWorkload() bool {
+	return s.Strategy.Canary != nil && s.Strategy.Canary.DaemonSet != nil && s.Strategy.Canary.DaemonSet.Enabled
+}
+
+func (s *RolloutSpec) MarshalJSON() ([]byte, error) {
+	type Alias RolloutSpec
+
+	if s.UnresolvedConfigTemplate != nil && !s.TemplateResolvedFromRef {
+		unresolved := *s
+		unresolved.Template = *s.UnresolvedConfigTemplate
+		unresolved.UnresolvedConfigTemplate = nil
+		return unresolved.MarshalJSON()
+	}
+	if s.TemplateResolvedFromRef || s.SelectorResolvedFromRef || s.StrategyResolvedFromRef {
+		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&struct {
+			Alias `json:",inline"`
+		}{
+			Alias: (Alias)(*s),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if s.TemplateResolvedFromRef {
+			unstructured.RemoveNestedField(obj, "template")
+		}
+		if s.SelectorResolvedFromRef {
+			unstructured.RemoveNestedField(obj, "selector")
+		}
+		if s.StrategyResolvedFromRef {
+			unstructured.RemoveNestedField(obj, "strategy")
+		}
+
+		return json.Marshal(obj)
+	}
+	return json.Marshal(&struct{ *Alias }{
+		Alias: (*Alias)(s),
+	})
+}
+
+// ObjectRef holds a references to the Kubernetes object
+type ObjectRef struct {
+	// API Version of the referent
+	APIVersion string `json:"apiVersion,omitempty"`
+	// Kind of the referent
+	Kind string `json:"kind,omitempty"`
+	// Name of the referent
+	Name string `json:"name,omitempty"`
+	// Automatically scale down deployment
+	ScaleDown string `json:"scaleDown,omitempty"`
+}
+
+const (
+	// DefaultRolloutUniqueLabelKey is the default key of the selector that is added
+	// to existing ReplicaSets (and label key that is added to its pods) to prevent the existing ReplicaSets
+	// to select new pods (and old pods being select by new ReplicaSet).
+	DefaultRolloutUniqueLabelKey string = "rollouts-pod-template-hash"
+	// DefaultReplicaSetScaleDownDeadlineAnnotationKey is the default key attached to an old stable ReplicaSet after
+	// the rollout transitioned to a new version. It contains the time when the controller can scale down the RS.
+	DefaultReplicaSetScaleDownDeadlineAnnotationKey = "scale-down-deadline"
+	// ManagedByRolloutKey is the key used to indicate which rollout(s) manage a resource but doesn't own it.
+	ManagedByRolloutsKey = "argo-rollouts.argoproj.io/managed-by-rollouts"
+	// DefaultReplicaSetRestartAnnotationKey indicates that the ReplicaSet with this annotation was restarted at the
+	// time listed in the value
+	DefaultReplicaSetRestartAnnotationKey = "argo-rollouts.argoproj.io/restarted-after"
+	// LabelKeyControllerInstanceID is the label the controller uses for the rollout, experiment, analysis segregation
+	// between controllers. Controllers will only operate on objects with the same instanceID as the controller.
+	LabelKeyControllerInstanceID = "argo-rollouts.argoproj.io/controller-instance-id"
+)
+
+// RolloutStrategy defines strategy to apply during next rollout
+type RolloutStrategy struct {
+	// +optional
+	BlueGreen *BlueGreenStrategy `json:"blueGreen,omitempty"`
+	// +optional
+	Canary *CanaryStrategy `json:"canary,omitempty"`
+}
+
+// BlueGreenStrategy defines parameters for Blue Green deployment
+type BlueGreenStrategy struct {
+	// Name of the service that the rollout modifies as the active service.
+	ActiveService string `json:"activeService"`
+	// Name of the service that the rollout modifies as the preview service.
+	// +optional
+	PreviewService string `json:"previewService,omitempty"`
+	// PreviewReplicaCount is the number of replicas to run for the preview stack before the
+	// switchover. Once the rollout is resumed the desired replicaset will be full scaled up before the switch occurs
+	// +optional
+	PreviewReplicaCount *int32 `json:"previewReplicaCount,omitempty"`
+	// AutoPromotionEnabled indicates if the rollout should automatically promote the new ReplicaSet
+	// to the active service or enter a paused state. If not specified, the default value is true.
+	// +optional
+	AutoPromotionEnabled *bool `json:"autoPromotionEnabled,omitempty"`
+	// AutoPromotionSeconds is a duration in seconds in which to delay auto-promotion (default: 0).
+	// The countdown begins after the preview ReplicaSet have reached full availability.
+	// This option is ignored if autoPromotionEnabled is set to false.
+	// +optional
+	AutoPromotionSeconds int32 `json:"autoPromotionSeconds,omitempty"`
+	// MaxUnavailable The maximum number of pods that can be unavailable during a restart operation.
+	// Defaults to 25% of total replicas.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// ScaleDownDelaySeconds adds a delay before scaling down the previous replicaset.
+	// If omitted, the Rollout waits 30 seconds before scaling down the previous ReplicaSet.
+	// A minimum of 30 seconds is recommended to ensure IP table propagation across the nodes in
+	// a cluster. See https://github.com/argoproj/argo-rollouts/issues/19#issuecomment-476329960 for
+	// more information
+	// +optional
+	ScaleDownDelaySeconds *int32 `json:"scaleDownDelaySeconds,omitempty"`
+	// ScaleDownDelayRevisionLimit limits the number of old RS that can run at one time before getting scaled down
+	// +optional
+	ScaleDownDelayRevisionLimit *int32 `json:"scaleDownDelayRevisionLimit,omitempty"`
+	// PrePromotionAnalysis configuration to run analysis before a selector switch
+	PrePromotionAnalysis *RolloutAnalysis `json:"prePromotionAnalysis,omitempty"`
+	// AntiAffinity enables anti-affinity rules for Blue Green deployment
+	// +optional
+	AntiAffinity *AntiAffinity `json:"antiAffinity,omitempty"`
+	// PostPromotionAnalysis configuration to run analysis after a selector switch
+	PostPromotionAnalysis *RolloutAnalysis `json:"postPromotionAnalysis,omitempty"`
+	// PreviewMetadata specify labels and annotations which will be attached to the preview pods for
+	// the duration which they act as a preview pod, and will be removed after
+	PreviewMetadata *PodTemplateMetadata `json:"previewMetadata,omitempty"`
+	// ActiveMetadata specify labels and annotations which will be attached to the active pods for
+	// the duration which they act as a active pod, and will be removed after
+	ActiveMetadata *PodTemplateMetadata `json:"activeMetadata,omitempty"`
+	// AbortScaleDownDelaySeconds adds a delay in second before scaling down the preview replicaset
+	// if update is aborted. 0 means not to scale down.
+	// Default is 30 second
+	// +optional
+	AbortScaleDownDelaySeconds *int32 `json:"abortScaleDownDelaySeconds,omitempty"`
+	// TrafficRouting hosts all the supported service meshes supported to shift the traffic from the active
+	// service to the preview service in weighted steps, instead of switching the active service at once
+	// +optional
+	TrafficRouting *RolloutTrafficRouting `json:"trafficRouting,omitempty"`
+	// TrafficSteps define the steps used to shift the traffic to the preview service on promotion when
+	// TrafficRouting is used. The active service is switched to the new ReplicaSet after the last step.
+	// +optional
+	TrafficSteps []BlueGreenTrafficStep `json:"trafficSteps,omitempty"`
+	// PrePromotionTimeout bounds the pre-promotion phase, from the time the preview ReplicaSet is available
+	// until the active service is switched. Skip considers the pre-promotion analysis successful.
+	// +optional
+	PrePromotionTimeout *StepTimeout `json:"prePromotionTimeout,omitempty"`
+	// PostPromotionTimeout bounds the post-promotion analysis, from the time the active service is switched
+	// until the new ReplicaSet is marked stable. Skip considers the post-promotion analysis successful.
+	// +optional
+	PostPromotionTimeout *StepTimeout `json:"postPromotionTimeout,omitempty"`
+}
+
+// BlueGreenTrafficStep defines a step of the weighted switchover of a blue-green rollout. Only one field
+// should be set.
+type BlueGreenTrafficStep struct {
+	// SetWeight sets the percentage of the traffic shifted to the preview service
+	// +optional
+	SetWeight *int32 `json:"setWeight,omitempty"`
+	// Pause freezes the switchover by setting spec.Paused to true.
+	// A Rollout will resume when spec.Paused is reset to false.
+	// +optional
+	Pause *RolloutPause `json:"pause,omitempty"`
+	// Analysis defines the AnalysisRun that will run for a step
+	// +optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty"`
+}
+
+// AntiAffinity defines which inter-pod scheduling rule to use for anti-affinity injection
+type AntiAffinity struct {
+	// +optional
+	PreferredDuringSchedulingIgnoredDuringExecution *PreferredDuringSchedulingIgnoredDuringExecution `json:"preferredDuringSchedulingIgnoredDuringExecution,omitempty"`
+	// +optional
+	RequiredDuringSchedulingIgnoredDuringExecution *RequiredDuringSchedulingIgnoredDuringExecution `json:"requiredDuringSchedulingIgnoredDuringExecution,omitempty"`
+}
+
+// PreferredDuringSchedulingIgnoredDuringExecution defines the weight of the anti-affinity injection
+type PreferredDuringSchedulingIgnoredDuringExecution struct {
+	// Weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+	Weight int32 `json:"weight"`
+}
+
+// RequiredDuringSchedulingIgnoredDuringExecution defines inter-pod scheduling rule to be RequiredDuringSchedulingIgnoredDuringExecution
+type RequiredDuringSchedulingIgnoredDuringExecution struct{}
+
+// CanaryStrategy defines parameters for a Replica Based Canary
+type CanaryStrategy struct {
+	// CanaryService holds the name of a service which selects pods with canary version and don't select any pods with stable version.
+	// +optional
+	CanaryService string `json:"canaryService,omitempty"`
+	// StableService holds the name of a service which selects pods with stable version and don't select any pods with canary version.
+	// +optional
+	StableService string `json:"stableService,omitempty"`
+	// Steps define the order of phases to execute the canary deployment
+	// +optional
+	Steps []CanaryStep `json:"steps,omitempty"`
+	// TrafficRouting hosts all the supported service meshes supported to enable more fine-grained traffic routing
+	TrafficRouting *RolloutTrafficRouting `json:"trafficRouting,omitempty"`
+
+	// MaxUnavailable The maximum number of pods that can be unavailable during the update.
+	// Value can be an absolute number (ex: 5) or a percentage of total pods at the start of update (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// This can not be 0 if MaxSurge is 0.
+	// By default, a fixed value of 25% is used.
+	// Example: when this is set to 30%, the old RC can be scaled down by 30%
+	// immediately when the rolling update starts. Once new pods are ready, old RC
+	// can be scaled down further, followed by scaling up the new RC, ensuring
+	// that at least 70% of original number of pods are available at all times
+	// during the update.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge The maximum number of pods that can be scheduled above the original number of
+	// pods.
+	// Value can be an absolute number (ex: 5) or a percentage of total pods at
+	// the start of the update (ex: 10%). This can not be 0 if MaxUnavailable is 0.
+	// Absolute number is calculated from percentage by rounding up.
+	// By default, a value of 25% is used.
+	// Example: when this is set to 30%, the new RC can be scaled up by 30%
+	// immediately when the rolling update starts. Once old pods have been killed,
+	// new RC can be scaled up further, ensuring that total number of pods running
+	// at any time during the update is at most 130% of original pods.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// Analysis runs a separate analysisRun while all the steps execute. This is intended to be a continuous validation of the new ReplicaSet
+	Analysis *RolloutAnalysisBackground `json:"analysis,omitempty"`
+	// AntiAffinity enables anti-affinity rules for Canary deployment
+	// +optional
+	AntiAffinity *AntiAffinity `json:"antiAffinity,omitempty"`
+	// CanaryMetadata specify labels and annotations which will be attached to the canary pods for
+	// the duration which they act as a canary, and will be removed after
+	CanaryMetadata *PodTemplateMetadata `json:"canaryMetadata,omitempty"`
+	// StableMetadata specify labels and annotations which will be attached to the stable pods for
+	// the duration which they act as a canary, and will be removed after
+	StableMetadata *PodTemplateMetadata `json:"stableMetadata,omitempty"`
+
+	// ScaleDownDelaySeconds adds a delay before scaling down the previous ReplicaSet when the
+	// canary strategy is used with traffic routing (default 30 seconds). A delay in scaling down
+	// the previous ReplicaSet is needed after switching the stable service selector to point to
+	// the new ReplicaSet, in order to give time for traffic providers to re-target the new pods.
+	// This value is ignored with basic, replica-weighted canary without traffic routing.
+	// +optional
+	ScaleDownDelaySeconds *int32 `json:"scaleDownDelaySeconds,omitempty"`
+	// ScaleDownDelayRevisionLimit limits the number of old RS that can run at one time before getting scaled down
+	// +optional
+	ScaleDownDelayRevisionLimit *int32 `json:"scaleDownDelayRevisionLimit,omitempty"`
+	// AbortScaleDownDelaySeconds adds a delay in second before scaling down the canary pods when update
+	// is aborted for canary strategy with traffic routing (not applicable for basic canary).
+	// 0 means canary pods are not scaled down.
+	// Default is 30 seconds.
+	// +optional
+	AbortScaleDownDelaySeconds *int32 `json:"abortScaleDownDelaySeconds,omitempty"`
+	// DynamicStableScale is a traffic routing feature which dynamically scales the stable
+	// ReplicaSet to minimize total pods which are running during an update. This is calculated by
+	// scaling down the stable as traffic is increased to canary. When disabled (the default behavior)
+	// the stable ReplicaSet remains fully scaled to support instantaneous aborts.
+	DynamicStableScale bool `json:"dynamicStableScale,omitempty"`
+	// PingPongSpec holds the ping and pong services
+	PingPong *PingPongSpec `json:"pingPong,omitempty"`
+	// Assuming the desired number of pods in a stable or canary ReplicaSet is not zero, then make sure it is at least
+	// MinPodsPerReplicaSet for High Availability. Only applicable for TrafficRoutedCanary
+	MinPodsPerReplicaSet *int32 `json:"minPodsPerReplicaSet,omitempty"`
+	// DaemonSet runs the pods of the Rollout with DaemonSets instead of ReplicaSets. The canary steps select the
+	// nodes running the canary DaemonSet, while the stable DaemonSet runs on all the other nodes.
+	// +optional
+	DaemonSet *DaemonSetCanaryStrategy `json:"daemonSet,omitempty"`
+	// AbortPolicy defines what happens to the canary pods when an update is aborted
+	// +optional
+	AbortPolicy *CanaryAbortPolicy `json:"abortPolicy,omitempty"`
+}
+
+// CanaryAbortPolicy defines what happens to the canary pods when an update is aborted
+type CanaryAbortPolicy struct {
+	// Preserve keeps some canary pods of an aborted update running for debugging, after removing them from the
+	// Services and the traffic routers
+	// +optional
+	Preserve *PreserveAbortedPods `json:"preserve,omitempty"`
+}
+
+// PreserveAbortedPods defines the canary pods which are preserved when an update is aborted
+type PreserveAbortedPods struct {
+	// Count is the number of canary pods preserved for an aborted revision. Defaults to 1
+	// +optional
+	Count *int32 `json:"count,omitempty"`
+	// TTL is how long the preserved pods are kept before they are deleted (e.g. 30m, 2h). Defaults to 1h
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// DaemonSetCanaryStrategy runs a canary of a node agent on a subset of the nodes. The controller labels the canary
+// nodes, and the stable and canary DaemonSets are given complementary node affinities on that label.
+type DaemonSetCanaryStrategy struct {
+	// Enabled runs the pods of the Rollout with DaemonSets instead of ReplicaSets
+	Enabled bool `json:"enabled"`
+}
+
+// PingPongSpec holds the ping and pong service name.
+type PingPongSpec struct {
+	// name of the ping service
+	PingService string `json:"pingService"`
+	// name of the pong service
+	PongService string `json:"pongService"`
+}
+
+// AnalysisRunStrategy configuration for the analysis runs and experiments to retain
+type AnalysisRunStrategy struct {
+	// SuccessfulRunHistoryLimit limits the number of old successful analysis runs and experiments to be retained in a history
+	SuccessfulRunHistoryLimit *int32 `json:"successfulRunHistoryLimit,omitempty"`
+	// UnsuccessfulRunHistoryLimit limits the number of old unsuccessful analysis runs and experiments to be retained in a history.
+	// Stages for unsuccessful: "Error", "Failed", "Inconclusive"
+	UnsuccessfulRunHistoryLimit *int32 `json:"unsuccessfulRunHistoryLimit,omitempty"`
+}
+
+// ALBTrafficRouting configuration for ALB ingress controller to control traffic routing
+type ALBTrafficRouting struct {
+	// Ingress refers to the name of an `Ingress` resource in the same namespace as the `Rollout`
+	Ingress string `json:"ingress,omitempty"`
+	// ServicePort refers to the port that the Ingress action should route traffic to
+	ServicePort int32 `json:"servicePort"`
+	// RootService references the service in the ingress to the controller should add the action to
+	RootService string `json:"rootService,omitempty"`
+	// AnnotationPrefix has to match the configured annotation prefix on the alb ingress controller
+	// +optional
+	AnnotationPrefix string `json:"annotationPrefix,omitempty"`
+	// StickinessConfig refers to the duration-based stickiness of the target groups associated with an `Ingress`
+	// +optional
+	StickinessConfig *StickinessConfig `json:"stickinessConfig,omitempty"`
+	// Ingresses refers to the name of an `Ingress` resource in the same namespace as the `Rollout` in a multi ingress scenario
+	// +optional
+	Ingresses []string `json:"ingresses,omitempty"`
+}
+
+type StickinessConfig struct {
+	Enabled         bool  `json:"enabled"`
+	DurationSeconds int64 `json:"durationSeconds"`
+}
+
+// RolloutTrafficRouting hosts all the different configuration for supported service meshes to enable more fine-grained traffic routing
+type RolloutTrafficRouting struct {
+	// Istio holds Istio specific configuration to route traffic
+	Istio *IstioTrafficRouting `json:"istio,omitempty"`
+	// Nginx holds Nginx Ingress specific configuration to route traffic
+	Nginx *NginxTrafficRouting `json:"nginx,omitempty"`
+	// Nginx holds ALB Ingress specific configuration to route traffic
+	ALB *ALBTrafficRouting `json:"alb,omitempty"`
+	// SMI holds TrafficSplit specific configuration to route traffic
+	SMI *SMITrafficRouting `json:"smi,omitempty"`
+	// Ambassador holds specific configuration to use Ambassador to route traffic
+	Ambassador *AmbassadorTrafficRouting `json:"ambassador,omitempty"`
+	// AppMesh holds specific configuration to use AppMesh to route traffic
+	AppMesh *AppMeshTrafficRouting `json:"appMesh,omitempty"`
+	// Traefik holds specific configuration to use Traefik to route traffic
+	Traefik *TraefikTrafficRouting `json:"traefik,omitempty"`
+	// ManagedRoutes A list of HTTP routes that Argo Rollouts manages, the order of this array also becomes the precedence in the upstream
+	// traffic router.
+	ManagedRoutes []ManagedRoute `json:"managedRoutes,omitempty"`
+	// Apisix holds specific configuration to use Apisix to route traffic
+	Apisix *ApisixTrafficRouting `json:"apisix,omitempty"`
+
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// Plugins holds specific configuration that traffic router plugins can use for routing traffic
+	Plugins map[string]json.RawMessage `json:"plugins,omitempty"`
+
+	// MaxTrafficWeight The total weight of traffic. If unspecified, it defaults to 100
+	MaxTrafficWeight *int32 `json:"maxTrafficWeight,omitempty"`
+	// Contour holds specific configuration to use Contour HTTPProxy to route traffic
+	Contour *ContourTrafficRouting `json:"contour,omitempty"`
+	// Kong holds specific configuration to use Kong to route traffic
+	Kong *KongTrafficRouting `json:"kong,omitempty"`
+	// Linkerd holds specific configuration to use Linkerd HTTPRoutes to route traffic
+	Linkerd *LinkerdTrafficRouting `json:"linkerd,omitempty"`
+	// DNS holds specific configuration to shift traffic with weighted DNS records
+	DNS *DNSTrafficRouting `json:"dns,omitempty"`
+	// Stickiness keeps users on the version they were first routed to while the canary weight changes.
+	// Supported by Istio, Nginx and Traefik
+	// +optional
+	Stickiness *TrafficStickiness `json:"stickiness,omitempty"`
+}
+
+// TrafficStickiness defines the cookie used by the traffic router to pin users to the stable or canary version
+type TrafficStickiness struct {
+	// CookieName is the name of the cookie which pins users to a version. Defaults to argo-rollouts-sticky
+	// +optional
+	CookieName string `json:"cookieName,omitempty"`
+	// DurationSeconds is the lifetime of the cookie. Defaults to a session cookie
+	// +optional
+	DurationSeconds int64 `json:"durationSeconds,omitempty"`
+}
+
+// ManagedRoute is an HTTP route managed by Argo Rollouts, referenced by name by the steps of the canary
+type ManagedRoute struct {
+	Name string `json:"name"`
+	//Possibly name for future use
+	//canaryRoute bool
+}
+
+// TraefikTrafficRouting defines the configuration required to use Traefik as traffic router
+type TraefikTrafficRouting struct {
+	// TraefikServiceName refer to the name of the Traefik service used to route traffic to the service
+	WeightedTraefikServiceName string `json:"weightedTraefikServiceName"`
+}
+
+// ContourTrafficRouting defines the configuration required to use Contour HTTPProxy as traffic router
+type ContourTrafficRouting struct {
+	// HTTPProxies refer to the names of the Contour HTTPProxies used to route traffic to the service
+	HTTPProxies []string `json:"httpProxies"`
+}
+
+// KongTrafficRouting defines the configuration required to use Kong as traffic router. Kong splits
+// traffic between upstreams through the weighted backendRefs of Gateway API HTTPRoutes.
+type KongTrafficRouting struct {
+	// HTTPRoutes refer to the names of the HTTPRoutes attached to a Kong Gateway used to route traffic to the service
+	HTTPRoutes []string `json:"httpRoutes"`
+}
+
+// LinkerdTrafficRouting defines the configuration required to use Linkerd as traffic router. Linkerd splits
+// traffic through the weighted backendRefs of policy.linkerd.io HTTPRoutes.
+type LinkerdTrafficRouting struct {
+	// HTTPRoutes refer to the names of the Linkerd HTTPRoutes used to route traffic to the service
+	HTTPRoutes []string `json:"httpRoutes"`
+}
+
+// DNSTrafficRouting defines the configuration required to shift traffic with the weighted DNS records of an
+// external-dns DNSEndpoint
+type DNSTrafficRouting struct {
+	// DNSEndpoint refers to the name of the external-dns DNSEndpoint which holds the weighted records
+	DNSEndpoint string `json:"dnsEndpoint"`
+	// StableSetIdentifier is the set identifier of the records which resolve to the stable service
+	StableSetIdentifier string `json:"stableSetIdentifier"`
+	// CanarySetIdentifier is the set identifier of the records which resolve to the canary service
+	CanarySetIdentifier string `json:"canarySetIdentifier"`
+	// WeightProperty is the provider specific property which holds the weight of the records. Defaults to aws/weight
+	// +optional
+	WeightProperty string `json:"weightProperty,omitempty"`
+	// TTLSeconds is the time to wait after a weight change before the weight is verified, so that resolvers expire the
+	// records cached with the previous weights. Defaults to the highest recordTTL of the weighted records
+	// +optional
+	TTLSeconds *int32 `json:"ttlSeconds,omitempty"`
+}
+
+// ApisixTrafficRouting defines the configuration required to use APISIX as traffic router
+type ApisixTrafficRouting struct {
+	// Route references an Apisix Route to modify to shape traffic
+	Route *ApisixRoute `json:"route,omitempty"`
+}
+
+// ApisixRoute holds information on the APISIX Route the rollout needs to modify
+type ApisixRoute struct {
+	// Name refer to the name of the APISIX Route used to route traffic to the service
+	Name string `json:"name"`
+	// RuleRef a list of the APISIX Route HTTP Rules used to route traffic to the service
+	Rules []string `json:"rules,omitempty"`
+}
+
+// AmbassadorTrafficRouting defines the configuration required to use Ambassador as traffic
+// router
+type AmbassadorTrafficRouting struct {
+	// Mappings refer to the name of the Ambassador Mappings used to route traffic to the
+	// service
+	Mappings []string `json:"mappings"`
+}
+
+// SMITrafficRouting configuration for TrafficSplit Custom Resource to control traffic routing
+type SMITrafficRouting struct {
+	// RootService holds the name of that clients use to communicate.
+	// +optional
+	RootService string `json:"rootService,omitempty"`
+	// TrafficSplitName holds the name of the TrafficSplit.
+	// +optional
+	TrafficSplitName string `json:"trafficSplitName,omitempty"`
+}
+
+// NginxTrafficRouting configuration for Nginx ingress controller to control traffic routing
+type NginxTrafficRouting struct {
+	// AnnotationPrefix has to match the configured annotation prefix on the nginx ingress controller
+	// +optional
+	AnnotationPrefix string `json:"annotationPrefix,omitempty"`
+	// StableIngress refers to the name of an `Ingress` resource in the same namespace as the `Rollout`
+	StableIngress string `json:"stableIngress,omitempty"`
+	// +optional
+	AdditionalIngressAnnotations map[string]string `json:"additionalIngressAnnotations,omitempty"`
+	// StableIngresses refers to the names of `Ingress` resources in the same namespace as the `Rollout` in a multi ingress scenario
+	// +optional
+	StableIngresses []string `json:"stableIngresses,omitempty"`
+	// +optional
+	CanaryIngressAnnotations map[string]string `json:"canaryIngressAnnotations,omitempty"`
+}
+
+// IstioTrafficRouting configuration for Istio service mesh to enable fine grain configuration
+type IstioTrafficRouting struct {
+	// VirtualService references an Istio VirtualService to modify to shape traffic
+	VirtualService *IstioVirtualService `json:"virtualService,omitempty"`
+	// DestinationRule references an Istio DestinationRule to modify to shape traffic
+	DestinationRule *IstioDestinationRule `json:"destinationRule,omitempty"`
+	// VirtualServices references a list of Istio VirtualService to modify to shape traffic
+	VirtualServices []IstioVirtualService `json:"virtualServices,omitempty"`
+	// Localities restricts the canary traffic to the canary pods of the listed localities, which are enabled one after
+	// the other as the canary weight increases. Requires a DestinationRule
+	// +optional
+	Localities []IstioLocality `json:"localities,omitempty"`
+}
+
+// IstioVirtualService holds information on the virtual service the rollout needs to modify
+type IstioVirtualService struct {
+	// Name holds the name of the VirtualService
+	Name string `json:"name"`
+	// A list of HTTP routes within VirtualService to edit. If omitted, VirtualService must have a single route of this type.
+	Routes []string `json:"routes,omitempty"`
+	// A list of TLS/HTTPS routes within VirtualService to edit. If omitted, VirtualService must have a single route of this type.
+	TLSRoutes []TLSRoute `json:"tlsRoutes,omitempty"`
+	// A list of TCP routes within VirtualService to edit. If omitted, VirtualService must have a single route of this type.
+	TCPRoutes []TCPRoute `json:"tcpRoutes,omitempty"`
+}
+
+// TLSRoute holds the information on the virtual service's TLS/HTTPS routes that are desired to be matched for changing weights.
+type TLSRoute struct {
+	// Port number of the TLS Route desired to be matched in the given Istio VirtualService.
+	Port int64 `json:"port,omitempty"`
+	// A list of all the SNI Hosts of the TLS Route desired to be matched in the given Istio VirtualService.
+	SNIHosts []string `json:"sniHosts,omitempty"`
+}
+
+// TCPRoute holds the information on the virtual service's TCP routes that are desired to be matched for changing weights.
+type TCPRoute struct {
+	// Port number of the TCP Route desired to be matched in the given Istio VirtualService.
+	Port int64 `json:"port,omitempty"`
+}
+
+// IstioDestinationRule is a reference to an Istio DestinationRule to modify and shape traffic
+type IstioDestinationRule struct {
+	// Name holds the name of the DestinationRule
+	Name string `json:"name"`
+	// CanarySubsetName is the subset name to modify labels with canary ReplicaSet pod template hash value
+	CanarySubsetName string `json:"canarySubsetName"`
+	// StableSubsetName is the subset name to modify labels with stable ReplicaSet pod template hash value
+	StableSubsetName string `json:"stableSubsetName"`
+}
+
+// IstioLocality holds a locality in which the canary pods receive traffic
+type IstioLocality struct {
+	// Name of the locality in the region/zone/sub-zone format used by Istio, e.g. us-east1/us-east1-b
+	Name string `json:"name"`
+	// MinWeight is the canary weight from which the canary pods of the locality receive traffic. Defaults to 0
+	// +optional
+	MinWeight int32 `json:"minWeight,omitempty"`
+}
+
+// AppMeshTrafficRouting configuration for AWS AppMesh service mesh to enable fine grain configuration
+type AppMeshTrafficRouting struct {
+	// VirtualService references an AppMesh VirtualService and VirtualRouter to modify to shape traffic
+	VirtualService *AppMeshVirtualService `json:"virtualService,omitempty"`
+	// VirtualNodeGroup references an AppMesh Route targets that are formed by a set of VirtualNodes that are used to shape traffic
+	VirtualNodeGroup *AppMeshVirtualNodeGroup `json:"virtualNodeGroup,omitempty"`
+}
+
+// AppMeshVirtualService holds information on the virtual service the rollout needs to modify
+type AppMeshVirtualService struct {
+	// Name is the name of virtual service
+	Name string `json:"name"`
+	// Routes is list of HTTP routes within virtual router associated with virtual service to edit. If omitted, virtual service must have a single route of this type.
+	Routes []string `json:"routes,omitempty"`
+}
+
+// AppMeshVirtualNodeGroup holds information about targets used for routing traffic to a virtual service
+type AppMeshVirtualNodeGroup struct {
+	// CanaryVirtualNodeRef is the virtual node ref to modify labels with canary ReplicaSet pod template hash value
+	CanaryVirtualNodeRef *AppMeshVirtualNodeReference `json:"canaryVirtualNodeRef"`
+	// StableVirtualNodeRef is the virtual node name to modify labels with stable ReplicaSet pod template hash value
+	StableVirtualNodeRef *AppMeshVirtualNodeReference `json:"stableVirtualNodeRef"`
+}
+
+// AppMeshVirtualNodeReference holds a reference to VirtualNode.appmesh.k8s.aws
+type AppMeshVirtualNodeReference struct {
+	// Name is the name of VirtualNode CR
+	Name string `json:"name"`
+}
+
+// RolloutExperimentStep defines a template that is used to create a experiment for a step
+type RolloutExperimentStep struct {
+	// Templates what templates that should be added to the experiment. Should be non-nil
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Templates []RolloutExperimentTemplate `json:"templates" patchStrategy:"merge" patchMergeKey:"name"`
+	// Duration is a duration string (e.g. 30s, 5m, 1h) that the experiment should run for
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+	// Analyses reference which analysis templates to run with the experiment
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Analyses []RolloutExperimentStepAnalysisTemplateRef `json:"analyses,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+	// DryRun object contains the settings for running the analysis in Dry-Run mode
+	// +patchMergeKey=metricName
+	// +patchStrategy=merge
+	// +optional
+	DryRun []DryRun `json:"dryRun,omitempty" patchStrategy:"merge" patchMergeKey:"metricName"`
+	// AnalysisRunMetadata labels and annotations that will be added to the AnalysisRuns
+	// +optional
+	AnalysisRunMetadata AnalysisRunMetadata `json:"analysisRunMetadata,omitempty"`
+}
+
+type RolloutExperimentStepAnalysisTemplateRef struct {
+	// Name is a name for this analysis template invocation
+	Name string `json:"name"`
+	// TemplateName reference of the AnalysisTemplate name used by the Experiment to create the run
+	TemplateName string `json:"templateName"`
+	// Whether to look for the templateName at cluster scope or namespace scope
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty"`
+	// Args the arguments that will be added to the AnalysisRuns
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Args []AnalysisRunArgument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+	// RequiredForCompletion blocks the Experiment from completing until the analysis has completed
+	RequiredForCompletion bool `json:"requiredForCompletion,omitempty"`
+}
+
+// RolloutExperimentTemplate defines the template used to create experiments for the Rollout's experiment canary step
+type RolloutExperimentTemplate struct {
+	// Name description of template that passed to the template
+	Name string `json:"name"`
+	// SpecRef indicates where the rollout should get the RS template from
+	SpecRef ReplicaSetSpecRef `json:"specRef"`
+	// Replicas replica count for the template
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// Metadata sets labels and annotations to use for the RS created from the template
+	// +optional
+	Metadata PodTemplateMetadata `json:"metadata,omitempty"`
+	// Selector overrides the selector to be used for the template's ReplicaSet. If omitted, will
+	// use the same selector as the Rollout
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Weight sets the percentage of traffic the template's replicas should receive
+	Weight *int32 `json:"weight,omitempty"`
+	// Service controls the optionally generated service
+	Service *TemplateService `json:"service,omitempty"`
+}
+
+// PodTemplateMetadata extra labels to add to the template
+type PodTemplateMetadata struct {
+	// Labels Additional labels to add to the experiment
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations additional annotations to add to the experiment
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// AnalysisRunMetadata extra labels to add to the AnalysisRun
+type AnalysisRunMetadata struct {
+	// Labels Additional labels to add to the AnalysisRun
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations additional annotations to add to the AnalysisRun
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ReplicaSetSpecRef defines which RS that the experiment's template will use.
+type ReplicaSetSpecRef string
+
+const (
+	// CanarySpecRef indicates the RS template should be pulled from the newRS's template
+	CanarySpecRef ReplicaSetSpecRef = "canary"
+	// StableSpecRef indicates the RS template should be pulled from the stableRS's template
+	StableSpecRef ReplicaSetSpecRef = "stable"
+)
+
+// CanaryStep defines a step of a canary deployment.
+type CanaryStep struct {
+	// SetWeight sets what percentage of the newRS should receive
+	SetWeight *int32 `json:"setWeight,omitempty"`
+	// Pause freezes the rollout by setting spec.Paused to true.
+	// A Rollout will resume when spec.Paused is reset to false.
+	// +optional
+	Pause *RolloutPause `json:"pause,omitempty"`
+	// Experiment defines the experiment object that should be created
+	Experiment *RolloutExperimentStep `json:"experiment,omitempty"`
+	// Analysis defines the AnalysisRun that will run for a step
+	Analysis *RolloutAnalysis `json:"analysis,omitempty"`
+	// SetCanaryScale defines how to scale the newRS without changing traffic weight
+	// +optional
+	SetCanaryScale *SetCanaryScale `json:"setCanaryScale,omitempty"`
+	// SetHeaderRoute defines the route with specified header name to send 100% of traffic to the canary service
+	// +optional
+	SetHeaderRoute *SetHeaderRoute `json:"setHeaderRoute,omitempty"`
+	// SetMirrorRoutes Mirrors traffic that matches rules to a particular destination
+	// +optional
+	SetMirrorRoute *SetMirrorRoute `json:"setMirrorRoute,omitempty"`
+	// Plugin defines a plugin to execute for a step
+	Plugin *PluginStep `json:"plugin,omitempty"`
+	// SetCanaryNodes selects by label the nodes running the canary DaemonSet of a DaemonSet canary
+	// +optional
+	SetCanaryNodes *SetCanaryNodes `json:"setCanaryNodes,omitempty"`
+	// WaitFor waits for a condition of a resource of the cluster
+	// +optional
+	WaitFor *WaitForStep `json:"waitFor,omitempty"`
+	// Timeout is the maximum duration of the step (e.g. 30s, 10m), measured from the time the step started.
+	// The step is not bounded if unset
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// OnTimeout is the action taken when the timeout of the step expires: Abort (default), Pause or Skip
+	// +optional
+	OnTimeout StepTimeoutPolicy `json:"onTimeout,omitempty"`
+}
+
+// StepTimeout bounds the duration of a phase of an update
+type StepTimeout struct {
+	// Timeout is the maximum duration of the phase (e.g. 30s, 10m)
+	Timeout metav1.Duration `json:"timeout"`
+	// OnTimeout is the action taken when the timeout expires: Abort (default), Pause or Skip
+	// +optional
+	OnTimeout StepTimeoutPolicy `json:"onTimeout,omitempty"`
+}
+
+// StepTimeoutPolicy is the action taken when a step exceeds its timeout
+type StepTimeoutPolicy string
+
+const (
+	// StepTimeoutPolicyAbort aborts the update when a step times out
+	StepTimeoutPolicyAbort StepTimeoutPolicy = "Abort"
+	// StepTimeoutPolicyPause pauses the update when a step times out. The step is completed once the rollout is promoted
+	StepTimeoutPolicyPause StepTimeoutPolicy = "Pause"
+	// StepTimeoutPolicySkip completes a step which times out, and continues the update
+	StepTimeoutPolicySkip StepTimeoutPolicy = "Skip"
+)
+
+// WaitForStep waits for a condition of a resource, expressed either as a CEL expression or as a JSONPath template
+// and its expected value
+type WaitForStep struct {
+	// APIVersion of the resource
+	APIVersion string `json:"apiVersion"`
+	// Kind of the resource
+	Kind string `json:"kind"`
+	// Name of the resource
+	Name string `json:"name"`
+	// Namespace of the resource. Defaults to the namespace of the Rollout, and is ignored for cluster-scoped resources
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Condition is a CEL expression which must evaluate to true. The resource is available as `object`
+	// +optional
+	Condition string `json:"condition,omitempty"`
+	// JSONPath is a JSONPath template evaluated against the resource, whose result must be equal to Value
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty"`
+	// Value is the expected result of JSONPath
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Timeout is the maximum duration to wait for the condition (e.g. 30s, 10m). Waits forever if unset
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// OnTimeout is the action taken when the timeout expires: Abort (default) or Pause
+	// +optional
+	OnTimeout WaitForTimeoutPolicy `json:"onTimeout,omitempty"`
+}
+
+// WaitForTimeoutPolicy is the action taken when a waitFor step times out
+type WaitForTimeoutPolicy string
+
+const (
+	// WaitForTimeoutPolicyAbort aborts the update when a waitFor step times out
+	WaitForTimeoutPolicyAbort WaitForTimeoutPolicy = "Abort"
+	// WaitForTimeoutPolicyPause pauses the update when a waitFor step times out, until it is promoted
+	WaitForTimeoutPolicyPause WaitForTimeoutPolicy = "Pause"
+)
+
+// SetCanaryNodes selects the nodes running the canary DaemonSet
+type SetCanaryNodes struct {
+	// NodeSelector is a label query over the nodes running the canary DaemonSet
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+}
+
+type PluginStep struct {
+	// Name of the hashicorp go-plugin step to query
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// Config is the configuration object for the specified plugin
+	Config json.RawMessage `json:"config,omitempty"`
+}
+
+type SetMirrorRoute struct {
+	// Name this is the name of the route to use for the mirroring of traffic this also needs
+	// to be included in the `spec.strategy.canary.trafficRouting.managedRoutes` field
+	Name string `json:"name"`
+	// Match Contains a list of rules that if mated will mirror the traffic to the services
+	// +optional
+	Match []RouteMatch `json:"match,omitempty"`
+
+	// Services The list of services to mirror the traffic to if the method, path, headers match
+	//Service string `json:"service"`
+	// Percentage What percent of the traffic that matched the rules should be mirrored
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+type RouteMatch struct {
+	// Method What http methods should be mirrored
+	// +optional
+	Method *StringMatch `json:"method,omitempty"`
+	// Path What url paths should be mirrored
+	// +optional
+	Path *StringMatch `json:"path,omitempty"`
+	// Headers What request with matching headers should be mirrored
+	// +optional
+	Headers map[string]StringMatch `json:"headers,omitempty"`
+}
+
+// StringMatch Used to define what type of matching we will use exact, prefix, or regular expression
+type StringMatch struct {
+	// Exact The string must match exactly
+	Exact string `json:"exact,omitempty"`
+	// Prefix The string will be prefixed matched
+	Prefix string `json:"prefix,omitempty"`
+	// Regex The string will be regular expression matched
+	Regex string `json:"regex,omitempty"`
+}
+
+// SetHeaderRoute defines the route with specified header name to send 100% of traffic to the canary service
+type SetHeaderRoute struct {
+	// Name this is the name of the route to use for the mirroring of traffic this also needs
+	// to be included in the `spec.strategy.canary.trafficRouting.managedRoutes` field
+	Name  string               `json:"name,omitempty"`
+	Match []HeaderRoutingMatch `json:"match,omitempty"`
+}
+
+type HeaderRoutingMatch struct {
+	// HeaderName the name of the request header
+	HeaderName string `json:"headerName"`
+	// HeaderValue the value of the header
+	HeaderValue *StringMatch `json:"headerValue"`
+}
+
+// SetCanaryScale defines how to scale the newRS without changing traffic weight
+type SetCanaryScale struct {
+	// Weight sets the percentage of replicas the newRS should have
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+	// Replicas sets the number of replicas the newRS should have
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+	// MatchTrafficWeight cancels out previously set Replicas or Weight, effectively activating SetWeight
+	// +optional
+	MatchTrafficWeight bool `json:"matchTrafficWeight,omitempty"`
+}
+
+// RolloutAnalysisBackground defines a template that is used to create a background analysisRun
+type RolloutAnalysisBackground struct {
+	RolloutAnalysis `json:",inline"`
+	// StartingStep indicates which step the background analysis should start on
+	// If not listed, controller defaults to 0
+	StartingStep *int32 `json:"startingStep,omitempty"`
+}
+
+// RolloutAnalysis defines a template that is used to create a analysisRun
+type RolloutAnalysis struct {
+	// Templates reference to a list of analysis templates to combine for an AnalysisRun
+	// +patchMergeKey=templateName
+	// +patchStrategy=merge
+	Templates []AnalysisTemplateRef `json:"templates,omitempty" patchStrategy:"merge" patchMergeKey:"templateName"`
+	// Args the arguments that will be added to the AnalysisRuns
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	Args []AnalysisRunArgument `json:"args,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+	// DryRun object contains the settings for running the analysis in Dry-Run mode
+	// +patchMergeKey=metricName
+	// +patchStrategy=merge
+	// +optional
+	DryRun []DryRun `json:"dryRun,omitempty" patchStrategy:"merge" patchMergeKey:"metricName"`
+	// MeasurementRetention object contains the settings for retaining the number of measurements during the analysis
+	// +patchMergeKey=metricName
+	// +patchStrategy=merge
+	// +optional
+	MeasurementRetention []MeasurementRetention `json:"measurementRetention,omitempty" patchStrategy:"merge" patchMergeKey:"metricName"`
+	// AnalysisRunMetadata labels and annotations that will be added to the AnalysisRuns
+	// +optional
+	AnalysisRunMetadata AnalysisRunMetadata `json:"analysisRunMetadata,omitempty"`
+}
+
+type AnalysisTemplateRef struct {
+	//TemplateName name of template to use in AnalysisRun
+	// +optional
+	TemplateName string `json:"templateName"`
+	// Whether to look for the templateName at cluster scope or namespace scope
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty"`
+}
+
+// AnalysisRunArgument argument to add to analysisRun
+type AnalysisRunArgument struct {
+	// Name argument name
+	Name string `json:"name"`
+	// Value a hardcoded value for the argument. This field is a one of field with valueFrom
+	Value string `json:"value,omitempty"`
+	// ValueFrom A reference to where the value is stored. This field is a one of field with valueFrom
+	ValueFrom *ArgumentValueFrom `json:"valueFrom,omitempty"`
+}
+
+// ArgumentValueFrom defines references to fields within resources to grab for the value (i.e. Pod Template Hash)
+type ArgumentValueFrom struct {
+	// PodTemplateHashValue gets the value from one of the children ReplicaSet's Pod Template Hash
+	PodTemplateHashValue *ValueFromPodTemplateHash `json:"podTemplateHashValue,omitempty"`
+	//FieldRef
+	FieldRef *FieldRef `json:"fieldRef,omitempty"`
+}
+
+type FieldRef struct {
+	// Required: Path of the field to select in the specified API version
+	FieldPath string `json:"fieldPath"`
+}
+
+// ValueFromPodTemplateHash indicates which ReplicaSet pod template pod hash to use
+type ValueFromPodTemplateHash string
+
+const (
+	// Stable tells the Rollout to get the pod template hash from the stable ReplicaSet
+	Stable ValueFromPodTemplateHash = "Stable"
+	// Latest tells the Rollout to get the pod template hash from the latest ReplicaSet
+	Latest ValueFromPodTemplateHash = "Latest"
+)
+
+const (
+	// RolloutTypeLabel indicates how the rollout created the analysisRun
+	RolloutTypeLabel = "rollout-type"
+	// RolloutTypeStepLabel indicates that the analysisRun was created as a canary step
+	RolloutTypeStepLabel = "Step"
+	// RolloutTypeBackgroundRunLabel indicates that the analysisRun was created in Background to an execution
+	RolloutTypeBackgroundRunLabel = "Background"
+	// RolloutTypePrePromotionLabel indicates that the analysisRun was created before the active service promotion
+	RolloutTypePrePromotionLabel = "PrePromotion"
+	// RolloutTypePostPromotionLabel indicates that the analysisRun was created after the active service promotion
+	RolloutTypePostPromotionLabel = "PostPromotion"
+	// RolloutCanaryStepIndexLabel indicates which step created this analysisRun
+	RolloutCanaryStepIndexLabel = "step-index"
+)
+
+// RolloutPause defines a pause stage for a rollout
+type RolloutPause struct {
+	// Duration the amount of time to wait before moving to the next step.
+	// +optional
+	Duration *intstr.IntOrString `json:"duration,omitempty"`
+}
+
+// DurationSeconds converts the pause duration to seconds
+// If Duration is nil 0 is returned
+// if Duration values is string and does not contain a valid unit -1 is returned
+func (p RolloutPause) DurationSeconds() int32 {
+	if p.Duration != nil {
+		if p.Duration.Type == intstr.String {
+			s, err := strconv.ParseInt(p.Duration.StrVal, 10, 32)
+			if err != nil {
+				d, err := time.ParseDuration(p.Duration.StrVal)
+				if err != nil {
+					return -1
+				}
+				return int32(d.Seconds())
+			}
+			// special case where no unit was specified
+			return int32(s)
+		}
+		return p.Duration.IntVal
+	}
+	return 0
+}
+
+// DurationFromInt creates duration in seconds from int value
+func DurationFromInt(i int) *intstr.IntOrString {
+	d := intstr.FromInt(i)
+	return &d
+}
+
+// DurationFromString creates duration from string
+// value must be a string representation of an int with optional time unit (see time.ParseDuration)
+func DurationFromString(s string) *intstr.IntOrString {
+	d := intstr.FromString(s)
+	return &d
+}
+
+// PauseReason reasons that the rollout can pause
+type PauseReason string
+
+const (
+	// PauseReasonInconclusiveAnalysis pauses rollout when rollout has an inconclusive analysis run
+	PauseReasonInconclusiveAnalysis PauseReason = "InconclusiveAnalysisRun"
+	// PauseReasonInconclusiveExperiment pauses rollout when rollout has an inconclusive experiment
+	PauseReasonInconclusiveExperiment PauseReason = "InconclusiveExperiment"
+	// PauseReasonCanaryPauseStep pause rollout for canary pause step
+	PauseReasonCanaryPauseStep PauseReason = "CanaryPauseStep"
+	// PauseReasonBlueGreenPause pause rollout before promoting rollout
+	PauseReasonBlueGreenPause PauseReason = "BlueGreenPause"
+	// PauseReasonBlueGreenTrafficStepPause pause rollout for a pause step of the weighted blue-green switchover
+	PauseReasonBlueGreenTrafficStepPause PauseReason = "BlueGreenTrafficStepPause"
+	// PauseReasonWaitForTimeout pauses rollout when a waitFor step with the Pause timeout policy times out
+	PauseReasonWaitForTimeout PauseReason = "WaitForTimeout"
+	// PauseReasonStepTimeout pauses rollout when a step with the Pause timeout policy times out
+	PauseReasonStepTimeout PauseReason = "StepTimeout"
+)
+
+// PauseCondition the reason for a pause and when it started
+type PauseCondition struct {
+	Reason    PauseReason `json:"reason"`
+	StartTime metav1.Time `json:"startTime"`
+}
+
+// RolloutPhase are a set of phases that this rollout
+type RolloutPhase string
+
+const (
+	// RolloutPhaseHealthy indicates a rollout is healthy
+	RolloutPhaseHealthy RolloutPhase = "Healthy"
+	// RolloutPhaseDegraded indicates a rollout is degraded (e.g. pod unavailability, misconfiguration)
+	RolloutPhaseDegraded RolloutPhase = "Degraded"
+	// RolloutPhaseProgressing indicates a rollout is not yet healthy but still making progress towards a healthy state
+	RolloutPhaseProgressing RolloutPhase = "Progressing"
+	// RolloutPhasePaused indicates a rollout is not yet healthy and will not make progress until unpaused
+	RolloutPhasePaused RolloutPhase = "Paused"
+)
+
+// RolloutStatus is the status for a Rollout resource
+type RolloutStatus struct {
+	// Abort cancel the current rollout progression
+	Abort bool `json:"abort,omitempty"`
+	// PauseConditions is a list of reasons why rollout became automatically paused (e.g.
+	// CanaryPauseStep, BlueGreenPause, InconclusiveAnalysis). The items in this list are populated
+	// by the controller but are cleared by the user (e.g. plugin, argo-cd resume action) when they
+	// wish to unpause. If pause conditions is empty, but controllerPause is true, it indicates
+	// the user manually unpaused the Rollout
+	PauseConditions []PauseCondition `json:"pauseConditions,omitempty"`
+	// ControllerPause indicates the controller has paused the rollout. It is set to true when
+	// the controller adds a pause condition. This field helps to discern the scenario where a
+	// rollout was resumed after being paused by the controller (e.g. via the plugin). In that
+	// situation, the pauseConditions would have been cleared , but controllerPause would still be
+	// set to true.
+	ControllerPause bool `json:"controllerPause,omitempty"`
+	// AbortedAt indicates the controller reconciled an aborted rollout. The controller uses this to understand if
+	// the controller needs to do some specific work when a Rollout is aborted. For example, the reconcileAbort is used
+	// to indicate if the Rollout should enter an aborted state when the latest AnalysisRun is a failure, or the controller
+	// has already put the Rollout into an aborted and should create a new AnalysisRun.
+	AbortedAt *metav1.Time `json:"abortedAt,omitempty"`
+	// CurrentPodHash the hash of the current pod template
+	// +optional
+	CurrentPodHash string `json:"currentPodHash,omitempty"`
+	// CurrentStepHash the hash of the current list of steps for the current strategy. This is used to detect when the
+	// list of current steps change
+	// +optional
+	CurrentStepHash string `json:"currentStepHash,omitempty"`
+	// Total number of non-terminated pods targeted by this rollout (their labels match the selector).
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+	// Total number of non-terminated pods targeted by this rollout that have the desired template spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+	// Total number of ready pods targeted by this rollout.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+	// Total number of available pods (ready for at least minReadySeconds) targeted by this rollout.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas,omitempty"`
+	// CurrentStepIndex defines the current step of the rollout is on. If the current step index is null, the
+	// controller will execute the rollout.
+	// +optional
+	CurrentStepIndex *int32 `json:"currentStepIndex,omitempty"`
+	// Count of hash collisions for the Rollout. The Rollout controller uses this
+	// field as a collision avoidance mechanism when it needs to create the name for the
+	// newest ReplicaSet.
+	// +optional
+	CollisionCount *int32 `json:"collisionCount,omitempty"`
+	// The generation observed by the rollout controller from metadata.generation
+	// +optional
+	ObservedGeneration string `json:"observedGeneration,omitempty"`
+	// Conditions a list of conditions a rollout can have.
+	// +optional
+	Conditions []RolloutCondition `json:"conditions,omitempty"`
+	// Canary describes the state of the canary rollout
+	// +optional
+	Canary CanaryStatus `json:"canary,omitempty"`
+	// BlueGreen describes the state of the bluegreen rollout
+	// +optional
+	BlueGreen BlueGreenStatus `json:"blueGreen,omitempty"`
+	// HPAReplicas the number of non-terminated replicas that are receiving active traffic
+	// +optional
+	HPAReplicas int32 `json:"HPAReplicas,omitempty"`
+	// Selector that identifies the pods that are receiving active traffic
+	// +optional
+	Selector string `json:"selector,omitempty"`
+	// StableRS indicates the replicaset that has successfully rolled out
+	// +optional
+	StableRS string `json:"stableRS,omitempty"`
+	// RestartedAt indicates last time a Rollout was restarted
+	RestartedAt *metav1.Time `json:"restartedAt,omitempty"`
+	// PromoteFull indicates if the rollout should perform a full promotion, skipping analysis and pauses.
+	PromoteFull bool `json:"promoteFull,omitempty"`
+	// Phase is the rollout phase. Clients should only rely on the value if status.observedGeneration equals metadata.generation
+	Phase RolloutPhase `json:"phase,omitempty"`
+	// Message provides details on why the rollout is in its current phase
+	Message string `json:"message,omitempty"`
+	// The generation of referenced workload observed by the rollout controller
+	// +optional
+	WorkloadObservedGeneration string `json:"workloadObservedGeneration,omitempty"`
+	/// ALB keeps information regarding the ALB and TargetGroups
+	ALB *ALBStatus `json:"alb,omitempty"`
+	/// ALBs keeps information regarding multiple ALBs and TargetGroups in a multi ingress scenario
+	ALBs []ALBStatus `json:"albs,omitempty"`
+	// Hooks are the statuses of the last Job of each hook
+	// +optional
+	Hooks []RolloutHookStatus `json:"hooks,omitempty"`
+	// Retry is the status of the automatic retries of the update of the current revision
+	// +optional
+	Retry *RolloutRetryStatus `json:"retry,omitempty"`
+	// CurrentStep records when the current step of the update started, to enforce its timeout and measure its
+	// duration
+	// +optional
+	CurrentStep *RolloutStepStatus `json:"currentStep,omitempty"`
+	// Lifecycle records when the update of the current revision started, to measure its duration
+	// +optional
+	Lifecycle *RolloutLifecycleStatus `json:"lifecycle,omitempty"`
+	// StrategyTemplate is the generation of the template referenced by the StrategyRef when the current revision was
+	// created
+	// +optional
+	StrategyTemplate *RolloutStrategyTemplateStatus `json:"strategyTemplate,omitempty"`
+}
+
+// RolloutLifecycleStatus records the progress of the update of a revision
+type RolloutLifecycleStatus struct {
+	// PodTemplateHash is the pod template hash of the updated revision
+	PodTemplateHash string `json:"podTemplateHash"`
+	// StartedAt is the time at which the update started
+	StartedAt metav1.Time `json:"startedAt"`
+}
+
+// RolloutStepStatus is the status of the current step of an update
+type RolloutStepStatus struct {
+	// Step identifies the step: steps[N] for a canary step, prePromotion or postPromotion for a blue-green update
+	Step string `json:"step"`
+	// PodTemplateHash is the pod template hash of the updated revision
+	PodTemplateHash string `json:"podTemplateHash"`
+	// StartedAt is the time at which the step started
+	StartedAt metav1.Time `json:"startedAt"`
+	// TimedOutAt is the time at which the step exceeded its timeout
+	// +optional
+	TimedOutAt *metav1.Time `json:"timedOutAt,omitempty"`
+}
+
+// BlueGreenStatus status fields that only pertain to the blueGreen rollout
+type BlueGreenStatus struct {
+	// PreviewSelector indicates which replicas set the preview service is serving traffic to
+	// +optional
+	PreviewSelector string `json:"previewSelector,omitempty"`
+	// ActiveSelector indicates which replicas set the active service is serving traffic to
+	// +optional
+	ActiveSelector string `json:"activeSelector,omitempty"`
+	// ScaleUpPreviewCheckPoint indicates that the Replicaset receiving traffic from the preview service is ready to be scaled up after the rollout is unpaused
+	// +optional
+	ScaleUpPreviewCheckPoint bool `json:"scaleUpPreviewCheckPoint,omitempty"`
+	// PrePromotionAnalysisRunStatus indicates the status of the current prepromotion analysis run
+	PrePromotionAnalysisRunStatus *RolloutAnalysisRunStatus `json:"prePromotionAnalysisRunStatus,omitempty"`
+	// PostPromotionAnalysisRunStatus indicates the status of the current post promotion analysis run
+	PostPromotionAnalysisRunStatus *RolloutAnalysisRunStatus `json:"postPromotionAnalysisRunStatus,omitempty"`
+	// TrafficStepIndex is the index of the current traffic step of the weighted switchover. It is
+	// only set while the traffic is being shifted to the preview service.
+	// +optional
+	TrafficStepIndex *int32 `json:"trafficStepIndex,omitempty"`
+	// TrafficStepAnalysisRunStatus indicates the status of the analysis run of the current traffic step
+	TrafficStepAnalysisRunStatus *RolloutAnalysisRunStatus `json:"trafficStepAnalysisRunStatus,omitempty"`
+	// Weights records the weights which have been set on traffic provider. Only valid when using traffic routing
+	Weights *TrafficWeights `json:"weights,omitempty"`
+}
+
+// CanaryStatus status fields that only pertain to the canary rollout
+type CanaryStatus struct {
+	// CurrentStepAnalysisRunStatus indicates the status of the current step analysis run
+	CurrentStepAnalysisRunStatus *RolloutAnalysisRunStatus `json:"currentStepAnalysisRunStatus,omitempty"`
+	// CurrentBackgroundAnalysisRunStatus indicates the status of the current background analysis run
+	CurrentBackgroundAnalysisRunStatus *RolloutAnalysisRunStatus `json:"currentBackgroundAnalysisRunStatus,omitempty"`
+	// CurrentExperiment indicates the running experiment
+	CurrentExperiment string `json:"currentExperiment,omitempty"`
+	// Weights records the weights which have been set on traffic provider. Only valid when using traffic routing
+	Weights *TrafficWeights `json:"weights,omitempty"`
+	// StablePingPong For the ping-pong feature holds the current stable service, ping or pong
+	StablePingPong PingPongType `json:"stablePingPong,omitempty"`
+	// StepPluginStatuses holds the status of the step plugins executed
+	StepPluginStatuses []StepPluginStatus `json:"stepPluginStatuses,omitempty"`
+	// WaitFor is the status of the current waitFor step
+	WaitFor *WaitForStatus `json:"waitFor,omitempty"`
+}
+
+// WaitForStatus is the status of a waitFor step
+type WaitForStatus struct {
+	// Index of the step
+	Index int32 `json:"index"`
+	// StartedAt is the time the step started to wait for the condition
+	StartedAt metav1.Time `json:"startedAt"`
+	// Met indicates that the condition has been met
+	Met bool `json:"met,omitempty"`
+	// TimedOut indicates that the condition was not met before the timeout
+	TimedOut bool `json:"timedOut,omitempty"`
+	// Message explains why the condition is not met
+	Message string `json:"message,omitempty"`
+}
+
+type PingPongType string
+
+const (
+	PPPing PingPongType = "ping"
+	PPPong PingPongType = "pong"
+)
+
+// TrafficWeights describes the current status of how traffic has been split
+type TrafficWeights struct {
+	// Canary is the current traffic weight split to canary ReplicaSet
+	Canary WeightDestination `json:"canary"`
+	// Stable is the current traffic weight split to stable ReplicaSet
+	Stable WeightDestination `json:"stable"`
+	// Additional holds the weights split to additional ReplicaSets such as experiment ReplicaSets
+	Additional []WeightDestination `json:"additional,omitempty"`
+	// Verified is an optional indicator that the weight has been verified to have taken effect.
+	// This is currently only applicable to ALB traffic router
+	Verified *bool `json:"verified,omitempty"`
+}
+
+type WeightDestination struct {
+	// Weight is an percentage of traffic being sent to this destination
+	Weight int32 `json:"weight"`
+	// ServiceName is the Kubernetes service name traffic is being sent to
+	ServiceName string `json:"serviceName,omitempty"`
+	// PodTemplateHash is the pod template hash label for this destination
+	PodTemplateHash string `json:"podTemplateHash,omitempty"`
+}
+
+type RolloutAnalysisRunStatus struct {
+	Name    string        `json:"name"`
+	Status  AnalysisPhase `json:"status"`
+	Message string        `json:"message,omitempty"`
+}
+
+type StepPluginStatus struct {
+	// Index is the matching step index of the executed plugin
+	Index int32 `json:"index"`
+	// Name is the matching step name of the executed plugin
+	Name string `json:"name"`
+	// Operation is the name of the operation that produced this status
+	Operation StepPluginOperation `json:"operation"`
+	// Phase is the resulting phase of the operation
+	Phase StepPluginPhase `json:"phase,omitempty"`
+	// Message provides details on why the plugin is in its current phase
+	Message string `json:"message,omitempty"`
+	// StartedAt indicates when the plugin was first called for the operation
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// UpdatedAt indicates when the plugin was last called for the operation
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+	// FinishedAt indicates when the operation was completed
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty"`
+	// Backoff is a duration to wait before trying to execute the operation again if it was not completed
+	Backoff *metav1.Duration `json:"backoff,omitempty"`
+	// Executions is the number of time the operation was executed
+	Executions int32 `json:"executions,omitempty"`
+	// Disabled indicates if the plugin is globally disabled
+	Disabled bool `json:"disabled,omitempty"`
+
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Type=object
+	// Status holds the internal status of the plugin for this operation
+	Status json.RawMessage `json:"status,omitempty"`
+}
+
+// StepPluginPhase is the overall phase of a StepPlugin
+type StepPluginPhase string
+
+// Possible StepPluginPhase values
+const (
+	// StepPluginPhaseRunning is the phase of a step plugin when it has not completed its execution
+	StepPluginPhaseRunning StepPluginPhase = "Running"
+	// StepPluginPhaseSuccessful is the phase of a step plugin when the operation completed successfully
+	StepPluginPhaseSuccessful StepPluginPhase = "Successful"
+	// StepPluginPhaseFailed is the phase of a step plugin when the operation completed unsuccessfully
+	StepPluginPhaseFailed StepPluginPhase = "Failed"
+	// StepPluginPhaseError is the phase of a step plugin when an unexpected error prevented the completion of the operation
+	StepPluginPhaseError StepPluginPhase = "Error"
+)
+
+// Validate that the object is a valid phase
+func (p StepPluginPhase) Validate() error {
+	switch p {
+	case StepPluginPhaseRunning:
+	case StepPluginPhaseSuccessful:
+	case StepPluginPhaseFailed:
+	case StepPluginPhaseError:
+	default:
+		return fmt.Errorf("phase '%s' is not valid", p)
+	}
+	return nil
+}
+
+// StepPluginOperation is the operation executed by a step plugin
+type StepPluginOperation string
+
+// Possible StepPluginOperation values
+const (
+	// StepPluginOperationRun is the value for the Run operation
+	StepPluginOperationRun StepPluginOperation = "Run"
+	// StepPluginOperationRun is the value for the Terminate operation
+	StepPluginOperationTerminate StepPluginOperation = "Terminate"
+	// StepPluginOperationRun is the value for the Abort operation
+	StepPluginOperationAbort StepPluginOperation = "Abort"
+)
+
+type ALBStatus struct {
+	LoadBalancer      AwsResourceRef `json:"loadBalancer,omitempty"`
+	CanaryTargetGroup AwsResourceRef `json:"canaryTargetGroup,omitempty"`
+	StableTargetGroup AwsResourceRef `json:"stableTargetGroup,omitempty"`
+	Ingress           string         `json:"ingress,omitempty"`
+}
+
+type AwsResourceRef struct {
+	Name string `json:"name"`
+	ARN  string `json:"arn"`
+	// FullName is the full name of the resource
+	// +optional
+	FullName string `json:"fullName"`
+}
+
+// RolloutConditionType defines the conditions of Rollout
+type RolloutConditionType string
+
+// These are valid conditions of a rollout.
+const (
+	// InvalidSpec means the rollout has an invalid spec and will not progress until
+	// the spec is fixed.
+	InvalidSpec RolloutConditionType = "InvalidSpec"
+	// RolloutAvailable means the rollout is available, ie. the active service is pointing at a
+	// replicaset with the required replicas up and running for at least minReadySeconds.
+	RolloutAvailable RolloutConditionType = "Available"
+	// RolloutProgressing means the rollout is progressing. Progress for a rollout is
+	// considered when a new replica set is created or adopted, when pods scale
+	// up or old pods scale down, or when the services are updated. Progress is not estimated
+	// for paused rollouts.
+	RolloutProgressing RolloutConditionType = "Progressing"
+	// RolloutReplicaFailure ReplicaFailure is added in a deployment when one of its pods
+	// fails to be created or deleted.
+	RolloutReplicaFailure RolloutConditionType = "ReplicaFailure"
+	// RolloutPaused means that rollout is in a paused state. It is still progressing at this point.
+	RolloutPaused RolloutConditionType = "Paused"
+	// RolloutCompleted indicates that the rollout completed its update to the desired revision and is not in the middle
+	// of any update. Note that a Completed rollout could also be considered Progressing or Degraded, if its Pods become
+	// unavailable sometime after the update completes.
+	RolloutCompleted RolloutConditionType = "Completed"
+	// RolloutHealthy means that rollout is in a completed state and is healthy. Which means that all the pods have been updated
+	// and are passing their health checks and are ready to serve traffic.
+	RolloutHealthy RolloutConditionType = "Healthy"
+)
+
+// RolloutCondition describes the state of a rollout at a certain point.
+type RolloutCondition struct {
+	// Type of deployment condition.
+	Type RolloutConditionType `json:"type"`
+	// Phase of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// The last time this condition was updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason"`
+	// A human readable message indicating details about the transition.
+	Message string `json:"message"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RolloutList is a list of Rollout resources
+type RolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Rollout `json:"items"`
+}
+
+type RollbackWindowSpec struct {
+	Revisions int32 `json:"revisions,omitempty"`
+}
+
+const (
+	ScaleDownNever         string = "never"
+	ScaleDownOnSuccess     string = "onsuccess"
+	ScaleDownProgressively string = "progressively"
+)
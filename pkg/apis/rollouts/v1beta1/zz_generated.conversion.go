@@ -0,0 +1,5454 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	json "encoding/json"
+	unsafe "unsafe"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ALBStatus)(nil), (*v1alpha1.ALBStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ALBStatus_To_v1alpha1_ALBStatus(a.(*ALBStatus), b.(*v1alpha1.ALBStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ALBStatus)(nil), (*ALBStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ALBStatus_To_v1beta1_ALBStatus(a.(*v1alpha1.ALBStatus), b.(*ALBStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ALBTrafficRouting)(nil), (*v1alpha1.ALBTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ALBTrafficRouting_To_v1alpha1_ALBTrafficRouting(a.(*ALBTrafficRouting), b.(*v1alpha1.ALBTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ALBTrafficRouting)(nil), (*ALBTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ALBTrafficRouting_To_v1beta1_ALBTrafficRouting(a.(*v1alpha1.ALBTrafficRouting), b.(*ALBTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AmbassadorTrafficRouting)(nil), (*v1alpha1.AmbassadorTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AmbassadorTrafficRouting_To_v1alpha1_AmbassadorTrafficRouting(a.(*AmbassadorTrafficRouting), b.(*v1alpha1.AmbassadorTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AmbassadorTrafficRouting)(nil), (*AmbassadorTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AmbassadorTrafficRouting_To_v1beta1_AmbassadorTrafficRouting(a.(*v1alpha1.AmbassadorTrafficRouting), b.(*AmbassadorTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisRun)(nil), (*v1alpha1.AnalysisRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisRun_To_v1alpha1_AnalysisRun(a.(*AnalysisRun), b.(*v1alpha1.AnalysisRun), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisRun)(nil), (*AnalysisRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisRun_To_v1beta1_AnalysisRun(a.(*v1alpha1.AnalysisRun), b.(*AnalysisRun), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisRunArgument)(nil), (*v1alpha1.AnalysisRunArgument)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisRunArgument_To_v1alpha1_AnalysisRunArgument(a.(*AnalysisRunArgument), b.(*v1alpha1.AnalysisRunArgument), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisRunArgument)(nil), (*AnalysisRunArgument)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisRunArgument_To_v1beta1_AnalysisRunArgument(a.(*v1alpha1.AnalysisRunArgument), b.(*AnalysisRunArgument), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisRunList)(nil), (*v1alpha1.AnalysisRunList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisRunList_To_v1alpha1_AnalysisRunList(a.(*AnalysisRunList), b.(*v1alpha1.AnalysisRunList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisRunList)(nil), (*AnalysisRunList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisRunList_To_v1beta1_AnalysisRunList(a.(*v1alpha1.AnalysisRunList), b.(*AnalysisRunList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisRunMetadata)(nil), (*v1alpha1.AnalysisRunMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisRunMetadata_To_v1alpha1_AnalysisRunMetadata(a.(*AnalysisRunMetadata), b.(*v1alpha1.AnalysisRunMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisRunMetadata)(nil), (*AnalysisRunMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisRunMetadata_To_v1beta1_AnalysisRunMetadata(a.(*v1alpha1.AnalysisRunMetadata), b.(*AnalysisRunMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisRunSpec)(nil), (*v1alpha1.AnalysisRunSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisRunSpec_To_v1alpha1_AnalysisRunSpec(a.(*AnalysisRunSpec), b.(*v1alpha1.AnalysisRunSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisRunSpec)(nil), (*AnalysisRunSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisRunSpec_To_v1beta1_AnalysisRunSpec(a.(*v1alpha1.AnalysisRunSpec), b.(*AnalysisRunSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisRunStatus)(nil), (*v1alpha1.AnalysisRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisRunStatus_To_v1alpha1_AnalysisRunStatus(a.(*AnalysisRunStatus), b.(*v1alpha1.AnalysisRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisRunStatus)(nil), (*AnalysisRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisRunStatus_To_v1beta1_AnalysisRunStatus(a.(*v1alpha1.AnalysisRunStatus), b.(*AnalysisRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisRunStrategy)(nil), (*v1alpha1.AnalysisRunStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisRunStrategy_To_v1alpha1_AnalysisRunStrategy(a.(*AnalysisRunStrategy), b.(*v1alpha1.AnalysisRunStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisRunStrategy)(nil), (*AnalysisRunStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisRunStrategy_To_v1beta1_AnalysisRunStrategy(a.(*v1alpha1.AnalysisRunStrategy), b.(*AnalysisRunStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisTemplate)(nil), (*v1alpha1.AnalysisTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisTemplate_To_v1alpha1_AnalysisTemplate(a.(*AnalysisTemplate), b.(*v1alpha1.AnalysisTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisTemplate)(nil), (*AnalysisTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisTemplate_To_v1beta1_AnalysisTemplate(a.(*v1alpha1.AnalysisTemplate), b.(*AnalysisTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisTemplateList)(nil), (*v1alpha1.AnalysisTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisTemplateList_To_v1alpha1_AnalysisTemplateList(a.(*AnalysisTemplateList), b.(*v1alpha1.AnalysisTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisTemplateList)(nil), (*AnalysisTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisTemplateList_To_v1beta1_AnalysisTemplateList(a.(*v1alpha1.AnalysisTemplateList), b.(*AnalysisTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisTemplateRef)(nil), (*v1alpha1.AnalysisTemplateRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisTemplateRef_To_v1alpha1_AnalysisTemplateRef(a.(*AnalysisTemplateRef), b.(*v1alpha1.AnalysisTemplateRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisTemplateRef)(nil), (*AnalysisTemplateRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisTemplateRef_To_v1beta1_AnalysisTemplateRef(a.(*v1alpha1.AnalysisTemplateRef), b.(*AnalysisTemplateRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AnalysisTemplateSpec)(nil), (*v1alpha1.AnalysisTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AnalysisTemplateSpec_To_v1alpha1_AnalysisTemplateSpec(a.(*AnalysisTemplateSpec), b.(*v1alpha1.AnalysisTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AnalysisTemplateSpec)(nil), (*AnalysisTemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AnalysisTemplateSpec_To_v1beta1_AnalysisTemplateSpec(a.(*v1alpha1.AnalysisTemplateSpec), b.(*AnalysisTemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AntiAffinity)(nil), (*v1alpha1.AntiAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AntiAffinity_To_v1alpha1_AntiAffinity(a.(*AntiAffinity), b.(*v1alpha1.AntiAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AntiAffinity)(nil), (*AntiAffinity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AntiAffinity_To_v1beta1_AntiAffinity(a.(*v1alpha1.AntiAffinity), b.(*AntiAffinity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ApisixRoute)(nil), (*v1alpha1.ApisixRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ApisixRoute_To_v1alpha1_ApisixRoute(a.(*ApisixRoute), b.(*v1alpha1.ApisixRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ApisixRoute)(nil), (*ApisixRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApisixRoute_To_v1beta1_ApisixRoute(a.(*v1alpha1.ApisixRoute), b.(*ApisixRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ApisixTrafficRouting)(nil), (*v1alpha1.ApisixTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ApisixTrafficRouting_To_v1alpha1_ApisixTrafficRouting(a.(*ApisixTrafficRouting), b.(*v1alpha1.ApisixTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ApisixTrafficRouting)(nil), (*ApisixTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApisixTrafficRouting_To_v1beta1_ApisixTrafficRouting(a.(*v1alpha1.ApisixTrafficRouting), b.(*ApisixTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AppMeshTrafficRouting)(nil), (*v1alpha1.AppMeshTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AppMeshTrafficRouting_To_v1alpha1_AppMeshTrafficRouting(a.(*AppMeshTrafficRouting), b.(*v1alpha1.AppMeshTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AppMeshTrafficRouting)(nil), (*AppMeshTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AppMeshTrafficRouting_To_v1beta1_AppMeshTrafficRouting(a.(*v1alpha1.AppMeshTrafficRouting), b.(*AppMeshTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AppMeshVirtualNodeGroup)(nil), (*v1alpha1.AppMeshVirtualNodeGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AppMeshVirtualNodeGroup_To_v1alpha1_AppMeshVirtualNodeGroup(a.(*AppMeshVirtualNodeGroup), b.(*v1alpha1.AppMeshVirtualNodeGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AppMeshVirtualNodeGroup)(nil), (*AppMeshVirtualNodeGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AppMeshVirtualNodeGroup_To_v1beta1_AppMeshVirtualNodeGroup(a.(*v1alpha1.AppMeshVirtualNodeGroup), b.(*AppMeshVirtualNodeGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AppMeshVirtualNodeReference)(nil), (*v1alpha1.AppMeshVirtualNodeReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AppMeshVirtualNodeReference_To_v1alpha1_AppMeshVirtualNodeReference(a.(*AppMeshVirtualNodeReference), b.(*v1alpha1.AppMeshVirtualNodeReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AppMeshVirtualNodeReference)(nil), (*AppMeshVirtualNodeReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AppMeshVirtualNodeReference_To_v1beta1_AppMeshVirtualNodeReference(a.(*v1alpha1.AppMeshVirtualNodeReference), b.(*AppMeshVirtualNodeReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AppMeshVirtualService)(nil), (*v1alpha1.AppMeshVirtualService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AppMeshVirtualService_To_v1alpha1_AppMeshVirtualService(a.(*AppMeshVirtualService), b.(*v1alpha1.AppMeshVirtualService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AppMeshVirtualService)(nil), (*AppMeshVirtualService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AppMeshVirtualService_To_v1beta1_AppMeshVirtualService(a.(*v1alpha1.AppMeshVirtualService), b.(*AppMeshVirtualService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Argument)(nil), (*v1alpha1.Argument)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Argument_To_v1alpha1_Argument(a.(*Argument), b.(*v1alpha1.Argument), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Argument)(nil), (*Argument)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Argument_To_v1beta1_Argument(a.(*v1alpha1.Argument), b.(*Argument), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ArgumentValueFrom)(nil), (*v1alpha1.ArgumentValueFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ArgumentValueFrom_To_v1alpha1_ArgumentValueFrom(a.(*ArgumentValueFrom), b.(*v1alpha1.ArgumentValueFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ArgumentValueFrom)(nil), (*ArgumentValueFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ArgumentValueFrom_To_v1beta1_ArgumentValueFrom(a.(*v1alpha1.ArgumentValueFrom), b.(*ArgumentValueFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Authentication)(nil), (*v1alpha1.Authentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Authentication_To_v1alpha1_Authentication(a.(*Authentication), b.(*v1alpha1.Authentication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Authentication)(nil), (*Authentication)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Authentication_To_v1beta1_Authentication(a.(*v1alpha1.Authentication), b.(*Authentication), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AwsResourceRef)(nil), (*v1alpha1.AwsResourceRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AwsResourceRef_To_v1alpha1_AwsResourceRef(a.(*AwsResourceRef), b.(*v1alpha1.AwsResourceRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.AwsResourceRef)(nil), (*AwsResourceRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AwsResourceRef_To_v1beta1_AwsResourceRef(a.(*v1alpha1.AwsResourceRef), b.(*AwsResourceRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlueGreenStatus)(nil), (*v1alpha1.BlueGreenStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(a.(*BlueGreenStatus), b.(*v1alpha1.BlueGreenStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BlueGreenStatus)(nil), (*BlueGreenStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BlueGreenStatus_To_v1beta1_BlueGreenStatus(a.(*v1alpha1.BlueGreenStatus), b.(*BlueGreenStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlueGreenStrategy)(nil), (*v1alpha1.BlueGreenStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BlueGreenStrategy_To_v1alpha1_BlueGreenStrategy(a.(*BlueGreenStrategy), b.(*v1alpha1.BlueGreenStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BlueGreenStrategy)(nil), (*BlueGreenStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BlueGreenStrategy_To_v1beta1_BlueGreenStrategy(a.(*v1alpha1.BlueGreenStrategy), b.(*BlueGreenStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlueGreenTrafficStep)(nil), (*v1alpha1.BlueGreenTrafficStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BlueGreenTrafficStep_To_v1alpha1_BlueGreenTrafficStep(a.(*BlueGreenTrafficStep), b.(*v1alpha1.BlueGreenTrafficStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.BlueGreenTrafficStep)(nil), (*BlueGreenTrafficStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BlueGreenTrafficStep_To_v1beta1_BlueGreenTrafficStep(a.(*v1alpha1.BlueGreenTrafficStep), b.(*BlueGreenTrafficStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryAbortPolicy)(nil), (*v1alpha1.CanaryAbortPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CanaryAbortPolicy_To_v1alpha1_CanaryAbortPolicy(a.(*CanaryAbortPolicy), b.(*v1alpha1.CanaryAbortPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CanaryAbortPolicy)(nil), (*CanaryAbortPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryAbortPolicy_To_v1beta1_CanaryAbortPolicy(a.(*v1alpha1.CanaryAbortPolicy), b.(*CanaryAbortPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryStatus)(nil), (*v1alpha1.CanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CanaryStatus_To_v1alpha1_CanaryStatus(a.(*CanaryStatus), b.(*v1alpha1.CanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CanaryStatus)(nil), (*CanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryStatus_To_v1beta1_CanaryStatus(a.(*v1alpha1.CanaryStatus), b.(*CanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CanaryStrategy)(nil), (*v1alpha1.CanaryStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CanaryStrategy_To_v1alpha1_CanaryStrategy(a.(*CanaryStrategy), b.(*v1alpha1.CanaryStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CanaryStrategy)(nil), (*CanaryStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryStrategy_To_v1beta1_CanaryStrategy(a.(*v1alpha1.CanaryStrategy), b.(*CanaryStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudWatchMetric)(nil), (*v1alpha1.CloudWatchMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudWatchMetric_To_v1alpha1_CloudWatchMetric(a.(*CloudWatchMetric), b.(*v1alpha1.CloudWatchMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CloudWatchMetric)(nil), (*CloudWatchMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudWatchMetric_To_v1beta1_CloudWatchMetric(a.(*v1alpha1.CloudWatchMetric), b.(*CloudWatchMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudWatchMetricDataQuery)(nil), (*v1alpha1.CloudWatchMetricDataQuery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudWatchMetricDataQuery_To_v1alpha1_CloudWatchMetricDataQuery(a.(*CloudWatchMetricDataQuery), b.(*v1alpha1.CloudWatchMetricDataQuery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CloudWatchMetricDataQuery)(nil), (*CloudWatchMetricDataQuery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudWatchMetricDataQuery_To_v1beta1_CloudWatchMetricDataQuery(a.(*v1alpha1.CloudWatchMetricDataQuery), b.(*CloudWatchMetricDataQuery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudWatchMetricStat)(nil), (*v1alpha1.CloudWatchMetricStat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudWatchMetricStat_To_v1alpha1_CloudWatchMetricStat(a.(*CloudWatchMetricStat), b.(*v1alpha1.CloudWatchMetricStat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CloudWatchMetricStat)(nil), (*CloudWatchMetricStat)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudWatchMetricStat_To_v1beta1_CloudWatchMetricStat(a.(*v1alpha1.CloudWatchMetricStat), b.(*CloudWatchMetricStat), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudWatchMetricStatMetric)(nil), (*v1alpha1.CloudWatchMetricStatMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudWatchMetricStatMetric_To_v1alpha1_CloudWatchMetricStatMetric(a.(*CloudWatchMetricStatMetric), b.(*v1alpha1.CloudWatchMetricStatMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CloudWatchMetricStatMetric)(nil), (*CloudWatchMetricStatMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudWatchMetricStatMetric_To_v1beta1_CloudWatchMetricStatMetric(a.(*v1alpha1.CloudWatchMetricStatMetric), b.(*CloudWatchMetricStatMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudWatchMetricStatMetricDimension)(nil), (*v1alpha1.CloudWatchMetricStatMetricDimension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudWatchMetricStatMetricDimension_To_v1alpha1_CloudWatchMetricStatMetricDimension(a.(*CloudWatchMetricStatMetricDimension), b.(*v1alpha1.CloudWatchMetricStatMetricDimension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CloudWatchMetricStatMetricDimension)(nil), (*CloudWatchMetricStatMetricDimension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CloudWatchMetricStatMetricDimension_To_v1beta1_CloudWatchMetricStatMetricDimension(a.(*v1alpha1.CloudWatchMetricStatMetricDimension), b.(*CloudWatchMetricStatMetricDimension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAnalysisTemplate)(nil), (*v1alpha1.ClusterAnalysisTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterAnalysisTemplate_To_v1alpha1_ClusterAnalysisTemplate(a.(*ClusterAnalysisTemplate), b.(*v1alpha1.ClusterAnalysisTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ClusterAnalysisTemplate)(nil), (*ClusterAnalysisTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAnalysisTemplate_To_v1beta1_ClusterAnalysisTemplate(a.(*v1alpha1.ClusterAnalysisTemplate), b.(*ClusterAnalysisTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAnalysisTemplateList)(nil), (*v1alpha1.ClusterAnalysisTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ClusterAnalysisTemplateList_To_v1alpha1_ClusterAnalysisTemplateList(a.(*ClusterAnalysisTemplateList), b.(*v1alpha1.ClusterAnalysisTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ClusterAnalysisTemplateList)(nil), (*ClusterAnalysisTemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAnalysisTemplateList_To_v1beta1_ClusterAnalysisTemplateList(a.(*v1alpha1.ClusterAnalysisTemplateList), b.(*ClusterAnalysisTemplateList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ConfigRef)(nil), (*v1alpha1.ConfigRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ConfigRef_To_v1alpha1_ConfigRef(a.(*ConfigRef), b.(*v1alpha1.ConfigRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ConfigRef)(nil), (*ConfigRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConfigRef_To_v1beta1_ConfigRef(a.(*v1alpha1.ConfigRef), b.(*ConfigRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ContourTrafficRouting)(nil), (*v1alpha1.ContourTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContourTrafficRouting_To_v1alpha1_ContourTrafficRouting(a.(*ContourTrafficRouting), b.(*v1alpha1.ContourTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ContourTrafficRouting)(nil), (*ContourTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContourTrafficRouting_To_v1beta1_ContourTrafficRouting(a.(*v1alpha1.ContourTrafficRouting), b.(*ContourTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSTrafficRouting)(nil), (*v1alpha1.DNSTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSTrafficRouting_To_v1alpha1_DNSTrafficRouting(a.(*DNSTrafficRouting), b.(*v1alpha1.DNSTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DNSTrafficRouting)(nil), (*DNSTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSTrafficRouting_To_v1beta1_DNSTrafficRouting(a.(*v1alpha1.DNSTrafficRouting), b.(*DNSTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DaemonSetCanaryStrategy)(nil), (*v1alpha1.DaemonSetCanaryStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DaemonSetCanaryStrategy_To_v1alpha1_DaemonSetCanaryStrategy(a.(*DaemonSetCanaryStrategy), b.(*v1alpha1.DaemonSetCanaryStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DaemonSetCanaryStrategy)(nil), (*DaemonSetCanaryStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DaemonSetCanaryStrategy_To_v1beta1_DaemonSetCanaryStrategy(a.(*v1alpha1.DaemonSetCanaryStrategy), b.(*DaemonSetCanaryStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DatadogMetric)(nil), (*v1alpha1.DatadogMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DatadogMetric_To_v1alpha1_DatadogMetric(a.(*DatadogMetric), b.(*v1alpha1.DatadogMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DatadogMetric)(nil), (*DatadogMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DatadogMetric_To_v1beta1_DatadogMetric(a.(*v1alpha1.DatadogMetric), b.(*DatadogMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DryRun)(nil), (*v1alpha1.DryRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DryRun_To_v1alpha1_DryRun(a.(*DryRun), b.(*v1alpha1.DryRun), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DryRun)(nil), (*DryRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DryRun_To_v1beta1_DryRun(a.(*v1alpha1.DryRun), b.(*DryRun), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExperimentAnalysisRunStatus)(nil), (*v1alpha1.ExperimentAnalysisRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExperimentAnalysisRunStatus_To_v1alpha1_ExperimentAnalysisRunStatus(a.(*ExperimentAnalysisRunStatus), b.(*v1alpha1.ExperimentAnalysisRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ExperimentAnalysisRunStatus)(nil), (*ExperimentAnalysisRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExperimentAnalysisRunStatus_To_v1beta1_ExperimentAnalysisRunStatus(a.(*v1alpha1.ExperimentAnalysisRunStatus), b.(*ExperimentAnalysisRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExperimentAnalysisTemplateRef)(nil), (*v1alpha1.ExperimentAnalysisTemplateRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExperimentAnalysisTemplateRef_To_v1alpha1_ExperimentAnalysisTemplateRef(a.(*ExperimentAnalysisTemplateRef), b.(*v1alpha1.ExperimentAnalysisTemplateRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ExperimentAnalysisTemplateRef)(nil), (*ExperimentAnalysisTemplateRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExperimentAnalysisTemplateRef_To_v1beta1_ExperimentAnalysisTemplateRef(a.(*v1alpha1.ExperimentAnalysisTemplateRef), b.(*ExperimentAnalysisTemplateRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExperimentCondition)(nil), (*v1alpha1.ExperimentCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExperimentCondition_To_v1alpha1_ExperimentCondition(a.(*ExperimentCondition), b.(*v1alpha1.ExperimentCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ExperimentCondition)(nil), (*ExperimentCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExperimentCondition_To_v1beta1_ExperimentCondition(a.(*v1alpha1.ExperimentCondition), b.(*ExperimentCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExperimentList)(nil), (*v1alpha1.ExperimentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExperimentList_To_v1alpha1_ExperimentList(a.(*ExperimentList), b.(*v1alpha1.ExperimentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ExperimentList)(nil), (*ExperimentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExperimentList_To_v1beta1_ExperimentList(a.(*v1alpha1.ExperimentList), b.(*ExperimentList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExperimentStatus)(nil), (*v1alpha1.ExperimentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(a.(*ExperimentStatus), b.(*v1alpha1.ExperimentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ExperimentStatus)(nil), (*ExperimentStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExperimentStatus_To_v1beta1_ExperimentStatus(a.(*v1alpha1.ExperimentStatus), b.(*ExperimentStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FieldRef)(nil), (*v1alpha1.FieldRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FieldRef_To_v1alpha1_FieldRef(a.(*FieldRef), b.(*v1alpha1.FieldRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.FieldRef)(nil), (*FieldRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FieldRef_To_v1beta1_FieldRef(a.(*v1alpha1.FieldRef), b.(*FieldRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GraphiteMetric)(nil), (*v1alpha1.GraphiteMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GraphiteMetric_To_v1alpha1_GraphiteMetric(a.(*GraphiteMetric), b.(*v1alpha1.GraphiteMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.GraphiteMetric)(nil), (*GraphiteMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GraphiteMetric_To_v1beta1_GraphiteMetric(a.(*v1alpha1.GraphiteMetric), b.(*GraphiteMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HeaderRoutingMatch)(nil), (*v1alpha1.HeaderRoutingMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HeaderRoutingMatch_To_v1alpha1_HeaderRoutingMatch(a.(*HeaderRoutingMatch), b.(*v1alpha1.HeaderRoutingMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.HeaderRoutingMatch)(nil), (*HeaderRoutingMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HeaderRoutingMatch_To_v1beta1_HeaderRoutingMatch(a.(*v1alpha1.HeaderRoutingMatch), b.(*HeaderRoutingMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InfluxdbMetric)(nil), (*v1alpha1.InfluxdbMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_InfluxdbMetric_To_v1alpha1_InfluxdbMetric(a.(*InfluxdbMetric), b.(*v1alpha1.InfluxdbMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.InfluxdbMetric)(nil), (*InfluxdbMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InfluxdbMetric_To_v1beta1_InfluxdbMetric(a.(*v1alpha1.InfluxdbMetric), b.(*InfluxdbMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IstioDestinationRule)(nil), (*v1alpha1.IstioDestinationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IstioDestinationRule_To_v1alpha1_IstioDestinationRule(a.(*IstioDestinationRule), b.(*v1alpha1.IstioDestinationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.IstioDestinationRule)(nil), (*IstioDestinationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IstioDestinationRule_To_v1beta1_IstioDestinationRule(a.(*v1alpha1.IstioDestinationRule), b.(*IstioDestinationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IstioLocality)(nil), (*v1alpha1.IstioLocality)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IstioLocality_To_v1alpha1_IstioLocality(a.(*IstioLocality), b.(*v1alpha1.IstioLocality), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.IstioLocality)(nil), (*IstioLocality)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IstioLocality_To_v1beta1_IstioLocality(a.(*v1alpha1.IstioLocality), b.(*IstioLocality), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IstioTrafficRouting)(nil), (*v1alpha1.IstioTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IstioTrafficRouting_To_v1alpha1_IstioTrafficRouting(a.(*IstioTrafficRouting), b.(*v1alpha1.IstioTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.IstioTrafficRouting)(nil), (*IstioTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IstioTrafficRouting_To_v1beta1_IstioTrafficRouting(a.(*v1alpha1.IstioTrafficRouting), b.(*IstioTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IstioVirtualService)(nil), (*v1alpha1.IstioVirtualService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IstioVirtualService_To_v1alpha1_IstioVirtualService(a.(*IstioVirtualService), b.(*v1alpha1.IstioVirtualService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.IstioVirtualService)(nil), (*IstioVirtualService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IstioVirtualService_To_v1beta1_IstioVirtualService(a.(*v1alpha1.IstioVirtualService), b.(*IstioVirtualService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*JobMetric)(nil), (*v1alpha1.JobMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_JobMetric_To_v1alpha1_JobMetric(a.(*JobMetric), b.(*v1alpha1.JobMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.JobMetric)(nil), (*JobMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_JobMetric_To_v1beta1_JobMetric(a.(*v1alpha1.JobMetric), b.(*JobMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KayentaMetric)(nil), (*v1alpha1.KayentaMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KayentaMetric_To_v1alpha1_KayentaMetric(a.(*KayentaMetric), b.(*v1alpha1.KayentaMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.KayentaMetric)(nil), (*KayentaMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KayentaMetric_To_v1beta1_KayentaMetric(a.(*v1alpha1.KayentaMetric), b.(*KayentaMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KayentaScope)(nil), (*v1alpha1.KayentaScope)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KayentaScope_To_v1alpha1_KayentaScope(a.(*KayentaScope), b.(*v1alpha1.KayentaScope), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.KayentaScope)(nil), (*KayentaScope)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KayentaScope_To_v1beta1_KayentaScope(a.(*v1alpha1.KayentaScope), b.(*KayentaScope), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KayentaThreshold)(nil), (*v1alpha1.KayentaThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KayentaThreshold_To_v1alpha1_KayentaThreshold(a.(*KayentaThreshold), b.(*v1alpha1.KayentaThreshold), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.KayentaThreshold)(nil), (*KayentaThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KayentaThreshold_To_v1beta1_KayentaThreshold(a.(*v1alpha1.KayentaThreshold), b.(*KayentaThreshold), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KongTrafficRouting)(nil), (*v1alpha1.KongTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KongTrafficRouting_To_v1alpha1_KongTrafficRouting(a.(*KongTrafficRouting), b.(*v1alpha1.KongTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.KongTrafficRouting)(nil), (*KongTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KongTrafficRouting_To_v1beta1_KongTrafficRouting(a.(*v1alpha1.KongTrafficRouting), b.(*KongTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LinkerdTrafficRouting)(nil), (*v1alpha1.LinkerdTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LinkerdTrafficRouting_To_v1alpha1_LinkerdTrafficRouting(a.(*LinkerdTrafficRouting), b.(*v1alpha1.LinkerdTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.LinkerdTrafficRouting)(nil), (*LinkerdTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LinkerdTrafficRouting_To_v1beta1_LinkerdTrafficRouting(a.(*v1alpha1.LinkerdTrafficRouting), b.(*LinkerdTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Measurement)(nil), (*v1alpha1.Measurement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Measurement_To_v1alpha1_Measurement(a.(*Measurement), b.(*v1alpha1.Measurement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Measurement)(nil), (*Measurement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Measurement_To_v1beta1_Measurement(a.(*v1alpha1.Measurement), b.(*Measurement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MeasurementRetention)(nil), (*v1alpha1.MeasurementRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MeasurementRetention_To_v1alpha1_MeasurementRetention(a.(*MeasurementRetention), b.(*v1alpha1.MeasurementRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.MeasurementRetention)(nil), (*MeasurementRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MeasurementRetention_To_v1beta1_MeasurementRetention(a.(*v1alpha1.MeasurementRetention), b.(*MeasurementRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Metric)(nil), (*v1alpha1.Metric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Metric_To_v1alpha1_Metric(a.(*Metric), b.(*v1alpha1.Metric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Metric)(nil), (*Metric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Metric_To_v1beta1_Metric(a.(*v1alpha1.Metric), b.(*Metric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricProvider)(nil), (*v1alpha1.MetricProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricProvider_To_v1alpha1_MetricProvider(a.(*MetricProvider), b.(*v1alpha1.MetricProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.MetricProvider)(nil), (*MetricProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricProvider_To_v1beta1_MetricProvider(a.(*v1alpha1.MetricProvider), b.(*MetricProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetricResult)(nil), (*v1alpha1.MetricResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MetricResult_To_v1alpha1_MetricResult(a.(*MetricResult), b.(*v1alpha1.MetricResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.MetricResult)(nil), (*MetricResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MetricResult_To_v1beta1_MetricResult(a.(*v1alpha1.MetricResult), b.(*MetricResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NewRelicMetric)(nil), (*v1alpha1.NewRelicMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NewRelicMetric_To_v1alpha1_NewRelicMetric(a.(*NewRelicMetric), b.(*v1alpha1.NewRelicMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NewRelicMetric)(nil), (*NewRelicMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NewRelicMetric_To_v1beta1_NewRelicMetric(a.(*v1alpha1.NewRelicMetric), b.(*NewRelicMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NginxTrafficRouting)(nil), (*v1alpha1.NginxTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NginxTrafficRouting_To_v1alpha1_NginxTrafficRouting(a.(*NginxTrafficRouting), b.(*v1alpha1.NginxTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.NginxTrafficRouting)(nil), (*NginxTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NginxTrafficRouting_To_v1beta1_NginxTrafficRouting(a.(*v1alpha1.NginxTrafficRouting), b.(*NginxTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OAuth2Config)(nil), (*v1alpha1.OAuth2Config)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OAuth2Config_To_v1alpha1_OAuth2Config(a.(*OAuth2Config), b.(*v1alpha1.OAuth2Config), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.OAuth2Config)(nil), (*OAuth2Config)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OAuth2Config_To_v1beta1_OAuth2Config(a.(*v1alpha1.OAuth2Config), b.(*OAuth2Config), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ObjectRef)(nil), (*v1alpha1.ObjectRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ObjectRef_To_v1alpha1_ObjectRef(a.(*ObjectRef), b.(*v1alpha1.ObjectRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ObjectRef)(nil), (*ObjectRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ObjectRef_To_v1beta1_ObjectRef(a.(*v1alpha1.ObjectRef), b.(*ObjectRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PauseCondition)(nil), (*v1alpha1.PauseCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PauseCondition_To_v1alpha1_PauseCondition(a.(*PauseCondition), b.(*v1alpha1.PauseCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PauseCondition)(nil), (*PauseCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PauseCondition_To_v1beta1_PauseCondition(a.(*v1alpha1.PauseCondition), b.(*PauseCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PingPongSpec)(nil), (*v1alpha1.PingPongSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PingPongSpec_To_v1alpha1_PingPongSpec(a.(*PingPongSpec), b.(*v1alpha1.PingPongSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PingPongSpec)(nil), (*PingPongSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PingPongSpec_To_v1beta1_PingPongSpec(a.(*v1alpha1.PingPongSpec), b.(*PingPongSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PluginStep)(nil), (*v1alpha1.PluginStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PluginStep_To_v1alpha1_PluginStep(a.(*PluginStep), b.(*v1alpha1.PluginStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PluginStep)(nil), (*PluginStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PluginStep_To_v1beta1_PluginStep(a.(*v1alpha1.PluginStep), b.(*PluginStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodTemplateMetadata)(nil), (*v1alpha1.PodTemplateMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PodTemplateMetadata_To_v1alpha1_PodTemplateMetadata(a.(*PodTemplateMetadata), b.(*v1alpha1.PodTemplateMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PodTemplateMetadata)(nil), (*PodTemplateMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodTemplateMetadata_To_v1beta1_PodTemplateMetadata(a.(*v1alpha1.PodTemplateMetadata), b.(*PodTemplateMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PreferredDuringSchedulingIgnoredDuringExecution)(nil), (*v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution(a.(*PreferredDuringSchedulingIgnoredDuringExecution), b.(*v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution)(nil), (*PreferredDuringSchedulingIgnoredDuringExecution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution(a.(*v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution), b.(*PreferredDuringSchedulingIgnoredDuringExecution), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusMetric)(nil), (*v1alpha1.PrometheusMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PrometheusMetric_To_v1alpha1_PrometheusMetric(a.(*PrometheusMetric), b.(*v1alpha1.PrometheusMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PrometheusMetric)(nil), (*PrometheusMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrometheusMetric_To_v1beta1_PrometheusMetric(a.(*v1alpha1.PrometheusMetric), b.(*PrometheusMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusRangeQueryArgs)(nil), (*v1alpha1.PrometheusRangeQueryArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PrometheusRangeQueryArgs_To_v1alpha1_PrometheusRangeQueryArgs(a.(*PrometheusRangeQueryArgs), b.(*v1alpha1.PrometheusRangeQueryArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.PrometheusRangeQueryArgs)(nil), (*PrometheusRangeQueryArgs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PrometheusRangeQueryArgs_To_v1beta1_PrometheusRangeQueryArgs(a.(*v1alpha1.PrometheusRangeQueryArgs), b.(*PrometheusRangeQueryArgs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RequiredDuringSchedulingIgnoredDuringExecution)(nil), (*v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution(a.(*RequiredDuringSchedulingIgnoredDuringExecution), b.(*v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution)(nil), (*RequiredDuringSchedulingIgnoredDuringExecution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution(a.(*v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution), b.(*RequiredDuringSchedulingIgnoredDuringExecution), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RollbackWindowSpec)(nil), (*v1alpha1.RollbackWindowSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RollbackWindowSpec_To_v1alpha1_RollbackWindowSpec(a.(*RollbackWindowSpec), b.(*v1alpha1.RollbackWindowSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RollbackWindowSpec)(nil), (*RollbackWindowSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RollbackWindowSpec_To_v1beta1_RollbackWindowSpec(a.(*v1alpha1.RollbackWindowSpec), b.(*RollbackWindowSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutAnalysis)(nil), (*v1alpha1.RolloutAnalysis)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutAnalysis_To_v1alpha1_RolloutAnalysis(a.(*RolloutAnalysis), b.(*v1alpha1.RolloutAnalysis), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutAnalysis)(nil), (*RolloutAnalysis)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutAnalysis_To_v1beta1_RolloutAnalysis(a.(*v1alpha1.RolloutAnalysis), b.(*RolloutAnalysis), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutAnalysisBackground)(nil), (*v1alpha1.RolloutAnalysisBackground)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutAnalysisBackground_To_v1alpha1_RolloutAnalysisBackground(a.(*RolloutAnalysisBackground), b.(*v1alpha1.RolloutAnalysisBackground), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutAnalysisBackground)(nil), (*RolloutAnalysisBackground)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutAnalysisBackground_To_v1beta1_RolloutAnalysisBackground(a.(*v1alpha1.RolloutAnalysisBackground), b.(*RolloutAnalysisBackground), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutAnalysisRunStatus)(nil), (*v1alpha1.RolloutAnalysisRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutAnalysisRunStatus_To_v1alpha1_RolloutAnalysisRunStatus(a.(*RolloutAnalysisRunStatus), b.(*v1alpha1.RolloutAnalysisRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutAnalysisRunStatus)(nil), (*RolloutAnalysisRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutAnalysisRunStatus_To_v1beta1_RolloutAnalysisRunStatus(a.(*v1alpha1.RolloutAnalysisRunStatus), b.(*RolloutAnalysisRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutCondition)(nil), (*v1alpha1.RolloutCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutCondition_To_v1alpha1_RolloutCondition(a.(*RolloutCondition), b.(*v1alpha1.RolloutCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutCondition)(nil), (*RolloutCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutCondition_To_v1beta1_RolloutCondition(a.(*v1alpha1.RolloutCondition), b.(*RolloutCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutExperimentStepAnalysisTemplateRef)(nil), (*v1alpha1.RolloutExperimentStepAnalysisTemplateRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutExperimentStepAnalysisTemplateRef_To_v1alpha1_RolloutExperimentStepAnalysisTemplateRef(a.(*RolloutExperimentStepAnalysisTemplateRef), b.(*v1alpha1.RolloutExperimentStepAnalysisTemplateRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutExperimentStepAnalysisTemplateRef)(nil), (*RolloutExperimentStepAnalysisTemplateRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutExperimentStepAnalysisTemplateRef_To_v1beta1_RolloutExperimentStepAnalysisTemplateRef(a.(*v1alpha1.RolloutExperimentStepAnalysisTemplateRef), b.(*RolloutExperimentStepAnalysisTemplateRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutExperimentTemplate)(nil), (*v1alpha1.RolloutExperimentTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutExperimentTemplate_To_v1alpha1_RolloutExperimentTemplate(a.(*RolloutExperimentTemplate), b.(*v1alpha1.RolloutExperimentTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutExperimentTemplate)(nil), (*RolloutExperimentTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutExperimentTemplate_To_v1beta1_RolloutExperimentTemplate(a.(*v1alpha1.RolloutExperimentTemplate), b.(*RolloutExperimentTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutHook)(nil), (*v1alpha1.RolloutHook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutHook_To_v1alpha1_RolloutHook(a.(*RolloutHook), b.(*v1alpha1.RolloutHook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutHook)(nil), (*RolloutHook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutHook_To_v1beta1_RolloutHook(a.(*v1alpha1.RolloutHook), b.(*RolloutHook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutHookStatus)(nil), (*v1alpha1.RolloutHookStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutHookStatus_To_v1alpha1_RolloutHookStatus(a.(*RolloutHookStatus), b.(*v1alpha1.RolloutHookStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutHookStatus)(nil), (*RolloutHookStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutHookStatus_To_v1beta1_RolloutHookStatus(a.(*v1alpha1.RolloutHookStatus), b.(*RolloutHookStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutHooks)(nil), (*v1alpha1.RolloutHooks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutHooks_To_v1alpha1_RolloutHooks(a.(*RolloutHooks), b.(*v1alpha1.RolloutHooks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutHooks)(nil), (*RolloutHooks)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutHooks_To_v1beta1_RolloutHooks(a.(*v1alpha1.RolloutHooks), b.(*RolloutHooks), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutLifecycleStatus)(nil), (*v1alpha1.RolloutLifecycleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutLifecycleStatus_To_v1alpha1_RolloutLifecycleStatus(a.(*RolloutLifecycleStatus), b.(*v1alpha1.RolloutLifecycleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutLifecycleStatus)(nil), (*RolloutLifecycleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutLifecycleStatus_To_v1beta1_RolloutLifecycleStatus(a.(*v1alpha1.RolloutLifecycleStatus), b.(*RolloutLifecycleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutList)(nil), (*v1alpha1.RolloutList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutList_To_v1alpha1_RolloutList(a.(*RolloutList), b.(*v1alpha1.RolloutList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutList)(nil), (*RolloutList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutList_To_v1beta1_RolloutList(a.(*v1alpha1.RolloutList), b.(*RolloutList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutPause)(nil), (*v1alpha1.RolloutPause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutPause_To_v1alpha1_RolloutPause(a.(*RolloutPause), b.(*v1alpha1.RolloutPause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutPause)(nil), (*RolloutPause)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutPause_To_v1beta1_RolloutPause(a.(*v1alpha1.RolloutPause), b.(*RolloutPause), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutRetryPolicy)(nil), (*v1alpha1.RolloutRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutRetryPolicy_To_v1alpha1_RolloutRetryPolicy(a.(*RolloutRetryPolicy), b.(*v1alpha1.RolloutRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutRetryPolicy)(nil), (*RolloutRetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutRetryPolicy_To_v1beta1_RolloutRetryPolicy(a.(*v1alpha1.RolloutRetryPolicy), b.(*RolloutRetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutRetryStatus)(nil), (*v1alpha1.RolloutRetryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutRetryStatus_To_v1alpha1_RolloutRetryStatus(a.(*RolloutRetryStatus), b.(*v1alpha1.RolloutRetryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutRetryStatus)(nil), (*RolloutRetryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutRetryStatus_To_v1beta1_RolloutRetryStatus(a.(*v1alpha1.RolloutRetryStatus), b.(*RolloutRetryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutSpec)(nil), (*v1alpha1.RolloutSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutSpec_To_v1alpha1_RolloutSpec(a.(*RolloutSpec), b.(*v1alpha1.RolloutSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutSpec)(nil), (*RolloutSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutSpec_To_v1beta1_RolloutSpec(a.(*v1alpha1.RolloutSpec), b.(*RolloutSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStatus)(nil), (*v1alpha1.RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutStatus_To_v1alpha1_RolloutStatus(a.(*RolloutStatus), b.(*v1alpha1.RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutStatus)(nil), (*RolloutStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStatus_To_v1beta1_RolloutStatus(a.(*v1alpha1.RolloutStatus), b.(*RolloutStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStepStatus)(nil), (*v1alpha1.RolloutStepStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutStepStatus_To_v1alpha1_RolloutStepStatus(a.(*RolloutStepStatus), b.(*v1alpha1.RolloutStepStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutStepStatus)(nil), (*RolloutStepStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStepStatus_To_v1beta1_RolloutStepStatus(a.(*v1alpha1.RolloutStepStatus), b.(*RolloutStepStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStrategy)(nil), (*v1alpha1.RolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(a.(*RolloutStrategy), b.(*v1alpha1.RolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutStrategy)(nil), (*RolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(a.(*v1alpha1.RolloutStrategy), b.(*RolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutStrategyTemplateStatus)(nil), (*v1alpha1.RolloutStrategyTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutStrategyTemplateStatus_To_v1alpha1_RolloutStrategyTemplateStatus(a.(*RolloutStrategyTemplateStatus), b.(*v1alpha1.RolloutStrategyTemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutStrategyTemplateStatus)(nil), (*RolloutStrategyTemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutStrategyTemplateStatus_To_v1beta1_RolloutStrategyTemplateStatus(a.(*v1alpha1.RolloutStrategyTemplateStatus), b.(*RolloutStrategyTemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RolloutTrafficRouting)(nil), (*v1alpha1.RolloutTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutTrafficRouting_To_v1alpha1_RolloutTrafficRouting(a.(*RolloutTrafficRouting), b.(*v1alpha1.RolloutTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RolloutTrafficRouting)(nil), (*RolloutTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutTrafficRouting_To_v1beta1_RolloutTrafficRouting(a.(*v1alpha1.RolloutTrafficRouting), b.(*RolloutTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RouteMatch)(nil), (*v1alpha1.RouteMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RouteMatch_To_v1alpha1_RouteMatch(a.(*RouteMatch), b.(*v1alpha1.RouteMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RouteMatch)(nil), (*RouteMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RouteMatch_To_v1beta1_RouteMatch(a.(*v1alpha1.RouteMatch), b.(*RouteMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RunSummary)(nil), (*v1alpha1.RunSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RunSummary_To_v1alpha1_RunSummary(a.(*RunSummary), b.(*v1alpha1.RunSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.RunSummary)(nil), (*RunSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RunSummary_To_v1beta1_RunSummary(a.(*v1alpha1.RunSummary), b.(*RunSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SMITrafficRouting)(nil), (*v1alpha1.SMITrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SMITrafficRouting_To_v1alpha1_SMITrafficRouting(a.(*SMITrafficRouting), b.(*v1alpha1.SMITrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SMITrafficRouting)(nil), (*SMITrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SMITrafficRouting_To_v1beta1_SMITrafficRouting(a.(*v1alpha1.SMITrafficRouting), b.(*SMITrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ScopeDetail)(nil), (*v1alpha1.ScopeDetail)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ScopeDetail_To_v1alpha1_ScopeDetail(a.(*ScopeDetail), b.(*v1alpha1.ScopeDetail), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ScopeDetail)(nil), (*ScopeDetail)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ScopeDetail_To_v1beta1_ScopeDetail(a.(*v1alpha1.ScopeDetail), b.(*ScopeDetail), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretKeyRef)(nil), (*v1alpha1.SecretKeyRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretKeyRef_To_v1alpha1_SecretKeyRef(a.(*SecretKeyRef), b.(*v1alpha1.SecretKeyRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretKeyRef)(nil), (*SecretKeyRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretKeyRef_To_v1beta1_SecretKeyRef(a.(*v1alpha1.SecretKeyRef), b.(*SecretKeyRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretRef)(nil), (*v1alpha1.SecretRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SecretRef_To_v1alpha1_SecretRef(a.(*SecretRef), b.(*v1alpha1.SecretRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SecretRef)(nil), (*SecretRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SecretRef_To_v1beta1_SecretRef(a.(*v1alpha1.SecretRef), b.(*SecretRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SetCanaryNodes)(nil), (*v1alpha1.SetCanaryNodes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SetCanaryNodes_To_v1alpha1_SetCanaryNodes(a.(*SetCanaryNodes), b.(*v1alpha1.SetCanaryNodes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SetCanaryNodes)(nil), (*SetCanaryNodes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SetCanaryNodes_To_v1beta1_SetCanaryNodes(a.(*v1alpha1.SetCanaryNodes), b.(*SetCanaryNodes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SetCanaryScale)(nil), (*v1alpha1.SetCanaryScale)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SetCanaryScale_To_v1alpha1_SetCanaryScale(a.(*SetCanaryScale), b.(*v1alpha1.SetCanaryScale), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SetCanaryScale)(nil), (*SetCanaryScale)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SetCanaryScale_To_v1beta1_SetCanaryScale(a.(*v1alpha1.SetCanaryScale), b.(*SetCanaryScale), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SetHeaderRoute)(nil), (*v1alpha1.SetHeaderRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SetHeaderRoute_To_v1alpha1_SetHeaderRoute(a.(*SetHeaderRoute), b.(*v1alpha1.SetHeaderRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SetHeaderRoute)(nil), (*SetHeaderRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SetHeaderRoute_To_v1beta1_SetHeaderRoute(a.(*v1alpha1.SetHeaderRoute), b.(*SetHeaderRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SetMirrorRoute)(nil), (*v1alpha1.SetMirrorRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SetMirrorRoute_To_v1alpha1_SetMirrorRoute(a.(*SetMirrorRoute), b.(*v1alpha1.SetMirrorRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SetMirrorRoute)(nil), (*SetMirrorRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SetMirrorRoute_To_v1beta1_SetMirrorRoute(a.(*v1alpha1.SetMirrorRoute), b.(*SetMirrorRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Sigv4Config)(nil), (*v1alpha1.Sigv4Config)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Sigv4Config_To_v1alpha1_Sigv4Config(a.(*Sigv4Config), b.(*v1alpha1.Sigv4Config), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.Sigv4Config)(nil), (*Sigv4Config)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Sigv4Config_To_v1beta1_Sigv4Config(a.(*v1alpha1.Sigv4Config), b.(*Sigv4Config), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SkyWalkingMetric)(nil), (*v1alpha1.SkyWalkingMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SkyWalkingMetric_To_v1alpha1_SkyWalkingMetric(a.(*SkyWalkingMetric), b.(*v1alpha1.SkyWalkingMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.SkyWalkingMetric)(nil), (*SkyWalkingMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SkyWalkingMetric_To_v1beta1_SkyWalkingMetric(a.(*v1alpha1.SkyWalkingMetric), b.(*SkyWalkingMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StickinessConfig)(nil), (*v1alpha1.StickinessConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StickinessConfig_To_v1alpha1_StickinessConfig(a.(*StickinessConfig), b.(*v1alpha1.StickinessConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StickinessConfig)(nil), (*StickinessConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StickinessConfig_To_v1beta1_StickinessConfig(a.(*v1alpha1.StickinessConfig), b.(*StickinessConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StrategyRef)(nil), (*v1alpha1.StrategyRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StrategyRef_To_v1alpha1_StrategyRef(a.(*StrategyRef), b.(*v1alpha1.StrategyRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StrategyRef)(nil), (*StrategyRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StrategyRef_To_v1beta1_StrategyRef(a.(*v1alpha1.StrategyRef), b.(*StrategyRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StringMatch)(nil), (*v1alpha1.StringMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StringMatch_To_v1alpha1_StringMatch(a.(*StringMatch), b.(*v1alpha1.StringMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.StringMatch)(nil), (*StringMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StringMatch_To_v1beta1_StringMatch(a.(*v1alpha1.StringMatch), b.(*StringMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TCPRoute)(nil), (*v1alpha1.TCPRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TCPRoute_To_v1alpha1_TCPRoute(a.(*TCPRoute), b.(*v1alpha1.TCPRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TCPRoute)(nil), (*TCPRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TCPRoute_To_v1beta1_TCPRoute(a.(*v1alpha1.TCPRoute), b.(*TCPRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TLSRoute)(nil), (*v1alpha1.TLSRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TLSRoute_To_v1alpha1_TLSRoute(a.(*TLSRoute), b.(*v1alpha1.TLSRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TLSRoute)(nil), (*TLSRoute)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TLSRoute_To_v1beta1_TLSRoute(a.(*v1alpha1.TLSRoute), b.(*TLSRoute), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TTLStrategy)(nil), (*v1alpha1.TTLStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TTLStrategy_To_v1alpha1_TTLStrategy(a.(*TTLStrategy), b.(*v1alpha1.TTLStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TTLStrategy)(nil), (*TTLStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TTLStrategy_To_v1beta1_TTLStrategy(a.(*v1alpha1.TTLStrategy), b.(*TTLStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TemplateService)(nil), (*v1alpha1.TemplateService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TemplateService_To_v1alpha1_TemplateService(a.(*TemplateService), b.(*v1alpha1.TemplateService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TemplateService)(nil), (*TemplateService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplateService_To_v1beta1_TemplateService(a.(*v1alpha1.TemplateService), b.(*TemplateService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TemplateSpec)(nil), (*v1alpha1.TemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TemplateSpec_To_v1alpha1_TemplateSpec(a.(*TemplateSpec), b.(*v1alpha1.TemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TemplateSpec)(nil), (*TemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplateSpec_To_v1beta1_TemplateSpec(a.(*v1alpha1.TemplateSpec), b.(*TemplateSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TemplateStatus)(nil), (*v1alpha1.TemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TemplateStatus_To_v1alpha1_TemplateStatus(a.(*TemplateStatus), b.(*v1alpha1.TemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TemplateStatus)(nil), (*TemplateStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplateStatus_To_v1beta1_TemplateStatus(a.(*v1alpha1.TemplateStatus), b.(*TemplateStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TraefikTrafficRouting)(nil), (*v1alpha1.TraefikTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TraefikTrafficRouting_To_v1alpha1_TraefikTrafficRouting(a.(*TraefikTrafficRouting), b.(*v1alpha1.TraefikTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TraefikTrafficRouting)(nil), (*TraefikTrafficRouting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TraefikTrafficRouting_To_v1beta1_TraefikTrafficRouting(a.(*v1alpha1.TraefikTrafficRouting), b.(*TraefikTrafficRouting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficStickiness)(nil), (*v1alpha1.TrafficStickiness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrafficStickiness_To_v1alpha1_TrafficStickiness(a.(*TrafficStickiness), b.(*v1alpha1.TrafficStickiness), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TrafficStickiness)(nil), (*TrafficStickiness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficStickiness_To_v1beta1_TrafficStickiness(a.(*v1alpha1.TrafficStickiness), b.(*TrafficStickiness), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrafficWeights)(nil), (*v1alpha1.TrafficWeights)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrafficWeights_To_v1alpha1_TrafficWeights(a.(*TrafficWeights), b.(*v1alpha1.TrafficWeights), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.TrafficWeights)(nil), (*TrafficWeights)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TrafficWeights_To_v1beta1_TrafficWeights(a.(*v1alpha1.TrafficWeights), b.(*TrafficWeights), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ValueFrom)(nil), (*v1alpha1.ValueFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ValueFrom_To_v1alpha1_ValueFrom(a.(*ValueFrom), b.(*v1alpha1.ValueFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.ValueFrom)(nil), (*ValueFrom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ValueFrom_To_v1beta1_ValueFrom(a.(*v1alpha1.ValueFrom), b.(*ValueFrom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WaitForStatus)(nil), (*v1alpha1.WaitForStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WaitForStatus_To_v1alpha1_WaitForStatus(a.(*WaitForStatus), b.(*v1alpha1.WaitForStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.WaitForStatus)(nil), (*WaitForStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WaitForStatus_To_v1beta1_WaitForStatus(a.(*v1alpha1.WaitForStatus), b.(*WaitForStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WavefrontMetric)(nil), (*v1alpha1.WavefrontMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WavefrontMetric_To_v1alpha1_WavefrontMetric(a.(*WavefrontMetric), b.(*v1alpha1.WavefrontMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.WavefrontMetric)(nil), (*WavefrontMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WavefrontMetric_To_v1beta1_WavefrontMetric(a.(*v1alpha1.WavefrontMetric), b.(*WavefrontMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WebMetric)(nil), (*v1alpha1.WebMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WebMetric_To_v1alpha1_WebMetric(a.(*WebMetric), b.(*v1alpha1.WebMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.WebMetric)(nil), (*WebMetric)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WebMetric_To_v1beta1_WebMetric(a.(*v1alpha1.WebMetric), b.(*WebMetric), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WebMetricHeader)(nil), (*v1alpha1.WebMetricHeader)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WebMetricHeader_To_v1alpha1_WebMetricHeader(a.(*WebMetricHeader), b.(*v1alpha1.WebMetricHeader), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.WebMetricHeader)(nil), (*WebMetricHeader)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WebMetricHeader_To_v1beta1_WebMetricHeader(a.(*v1alpha1.WebMetricHeader), b.(*WebMetricHeader), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WeightDestination)(nil), (*v1alpha1.WeightDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WeightDestination_To_v1alpha1_WeightDestination(a.(*WeightDestination), b.(*v1alpha1.WeightDestination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.WeightDestination)(nil), (*WeightDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WeightDestination_To_v1beta1_WeightDestination(a.(*v1alpha1.WeightDestination), b.(*WeightDestination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.CanaryStep)(nil), (*CanaryStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CanaryStep_To_v1beta1_CanaryStep(a.(*v1alpha1.CanaryStep), b.(*CanaryStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.ExperimentSpec)(nil), (*ExperimentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExperimentSpec_To_v1beta1_ExperimentSpec(a.(*v1alpha1.ExperimentSpec), b.(*ExperimentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.Experiment)(nil), (*Experiment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Experiment_To_v1beta1_Experiment(a.(*v1alpha1.Experiment), b.(*Experiment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.PreserveAbortedPods)(nil), (*PreserveAbortedPods)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PreserveAbortedPods_To_v1beta1_PreserveAbortedPods(a.(*v1alpha1.PreserveAbortedPods), b.(*PreserveAbortedPods), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.RolloutExperimentStep)(nil), (*RolloutExperimentStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutExperimentStep_To_v1beta1_RolloutExperimentStep(a.(*v1alpha1.RolloutExperimentStep), b.(*RolloutExperimentStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.RolloutRetryBackoff)(nil), (*RolloutRetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RolloutRetryBackoff_To_v1beta1_RolloutRetryBackoff(a.(*v1alpha1.RolloutRetryBackoff), b.(*RolloutRetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.Rollout)(nil), (*Rollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Rollout_To_v1beta1_Rollout(a.(*v1alpha1.Rollout), b.(*Rollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.StepPluginStatus)(nil), (*StepPluginStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StepPluginStatus_To_v1beta1_StepPluginStatus(a.(*v1alpha1.StepPluginStatus), b.(*StepPluginStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.StepTimeout)(nil), (*StepTimeout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StepTimeout_To_v1beta1_StepTimeout(a.(*v1alpha1.StepTimeout), b.(*StepTimeout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha1.WaitForStep)(nil), (*WaitForStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WaitForStep_To_v1beta1_WaitForStep(a.(*v1alpha1.WaitForStep), b.(*WaitForStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*CanaryStep)(nil), (*v1alpha1.CanaryStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CanaryStep_To_v1alpha1_CanaryStep(a.(*CanaryStep), b.(*v1alpha1.CanaryStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*ExperimentSpec)(nil), (*v1alpha1.ExperimentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(a.(*ExperimentSpec), b.(*v1alpha1.ExperimentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Experiment)(nil), (*v1alpha1.Experiment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Experiment_To_v1alpha1_Experiment(a.(*Experiment), b.(*v1alpha1.Experiment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*PreserveAbortedPods)(nil), (*v1alpha1.PreserveAbortedPods)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PreserveAbortedPods_To_v1alpha1_PreserveAbortedPods(a.(*PreserveAbortedPods), b.(*v1alpha1.PreserveAbortedPods), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*RolloutExperimentStep)(nil), (*v1alpha1.RolloutExperimentStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutExperimentStep_To_v1alpha1_RolloutExperimentStep(a.(*RolloutExperimentStep), b.(*v1alpha1.RolloutExperimentStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*RolloutRetryBackoff)(nil), (*v1alpha1.RolloutRetryBackoff)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutRetryBackoff_To_v1alpha1_RolloutRetryBackoff(a.(*RolloutRetryBackoff), b.(*v1alpha1.RolloutRetryBackoff), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Rollout)(nil), (*v1alpha1.Rollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_Rollout_To_v1alpha1_Rollout(a.(*Rollout), b.(*v1alpha1.Rollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*StepPluginStatus)(nil), (*v1alpha1.StepPluginStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StepPluginStatus_To_v1alpha1_StepPluginStatus(a.(*StepPluginStatus), b.(*v1alpha1.StepPluginStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*StepTimeout)(nil), (*v1alpha1.StepTimeout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StepTimeout_To_v1alpha1_StepTimeout(a.(*StepTimeout), b.(*v1alpha1.StepTimeout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*WaitForStep)(nil), (*v1alpha1.WaitForStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WaitForStep_To_v1alpha1_WaitForStep(a.(*WaitForStep), b.(*v1alpha1.WaitForStep), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_ALBStatus_To_v1alpha1_ALBStatus(in *ALBStatus, out *v1alpha1.ALBStatus, s conversion.Scope) error {
+	if err := Convert_v1beta1_AwsResourceRef_To_v1alpha1_AwsResourceRef(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_AwsResourceRef_To_v1alpha1_AwsResourceRef(&in.CanaryTargetGroup, &out.CanaryTargetGroup, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_AwsResourceRef_To_v1alpha1_AwsResourceRef(&in.StableTargetGroup, &out.StableTargetGroup, s); err != nil {
+		return err
+	}
+	out.Ingress = in.Ingress
+	return nil
+}
+
+// Convert_v1beta1_ALBStatus_To_v1alpha1_ALBStatus is an autogenerated conversion function.
+func Convert_v1beta1_ALBStatus_To_v1alpha1_ALBStatus(in *ALBStatus, out *v1alpha1.ALBStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ALBStatus_To_v1alpha1_ALBStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ALBStatus_To_v1beta1_ALBStatus(in *v1alpha1.ALBStatus, out *ALBStatus, s conversion.Scope) error {
+	if err := Convert_v1alpha1_AwsResourceRef_To_v1beta1_AwsResourceRef(&in.LoadBalancer, &out.LoadBalancer, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AwsResourceRef_To_v1beta1_AwsResourceRef(&in.CanaryTargetGroup, &out.CanaryTargetGroup, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AwsResourceRef_To_v1beta1_AwsResourceRef(&in.StableTargetGroup, &out.StableTargetGroup, s); err != nil {
+		return err
+	}
+	out.Ingress = in.Ingress
+	return nil
+}
+
+// Convert_v1alpha1_ALBStatus_To_v1beta1_ALBStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ALBStatus_To_v1beta1_ALBStatus(in *v1alpha1.ALBStatus, out *ALBStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ALBStatus_To_v1beta1_ALBStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ALBTrafficRouting_To_v1alpha1_ALBTrafficRouting(in *ALBTrafficRouting, out *v1alpha1.ALBTrafficRouting, s conversion.Scope) error {
+	out.Ingress = in.Ingress
+	out.ServicePort = in.ServicePort
+	out.RootService = in.RootService
+	out.AnnotationPrefix = in.AnnotationPrefix
+	out.StickinessConfig = (*v1alpha1.StickinessConfig)(unsafe.Pointer(in.StickinessConfig))
+	out.Ingresses = *(*[]string)(unsafe.Pointer(&in.Ingresses))
+	return nil
+}
+
+// Convert_v1beta1_ALBTrafficRouting_To_v1alpha1_ALBTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_ALBTrafficRouting_To_v1alpha1_ALBTrafficRouting(in *ALBTrafficRouting, out *v1alpha1.ALBTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_ALBTrafficRouting_To_v1alpha1_ALBTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_ALBTrafficRouting_To_v1beta1_ALBTrafficRouting(in *v1alpha1.ALBTrafficRouting, out *ALBTrafficRouting, s conversion.Scope) error {
+	out.Ingress = in.Ingress
+	out.ServicePort = in.ServicePort
+	out.RootService = in.RootService
+	out.AnnotationPrefix = in.AnnotationPrefix
+	out.StickinessConfig = (*StickinessConfig)(unsafe.Pointer(in.StickinessConfig))
+	out.Ingresses = *(*[]string)(unsafe.Pointer(&in.Ingresses))
+	return nil
+}
+
+// Convert_v1alpha1_ALBTrafficRouting_To_v1beta1_ALBTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_ALBTrafficRouting_To_v1beta1_ALBTrafficRouting(in *v1alpha1.ALBTrafficRouting, out *ALBTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ALBTrafficRouting_To_v1beta1_ALBTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_AmbassadorTrafficRouting_To_v1alpha1_AmbassadorTrafficRouting(in *AmbassadorTrafficRouting, out *v1alpha1.AmbassadorTrafficRouting, s conversion.Scope) error {
+	out.Mappings = *(*[]string)(unsafe.Pointer(&in.Mappings))
+	return nil
+}
+
+// Convert_v1beta1_AmbassadorTrafficRouting_To_v1alpha1_AmbassadorTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_AmbassadorTrafficRouting_To_v1alpha1_AmbassadorTrafficRouting(in *AmbassadorTrafficRouting, out *v1alpha1.AmbassadorTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_AmbassadorTrafficRouting_To_v1alpha1_AmbassadorTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_AmbassadorTrafficRouting_To_v1beta1_AmbassadorTrafficRouting(in *v1alpha1.AmbassadorTrafficRouting, out *AmbassadorTrafficRouting, s conversion.Scope) error {
+	out.Mappings = *(*[]string)(unsafe.Pointer(&in.Mappings))
+	return nil
+}
+
+// Convert_v1alpha1_AmbassadorTrafficRouting_To_v1beta1_AmbassadorTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_AmbassadorTrafficRouting_To_v1beta1_AmbassadorTrafficRouting(in *v1alpha1.AmbassadorTrafficRouting, out *AmbassadorTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AmbassadorTrafficRouting_To_v1beta1_AmbassadorTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisRun_To_v1alpha1_AnalysisRun(in *AnalysisRun, out *v1alpha1.AnalysisRun, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_AnalysisRunSpec_To_v1alpha1_AnalysisRunSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_AnalysisRunStatus_To_v1alpha1_AnalysisRunStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_AnalysisRun_To_v1alpha1_AnalysisRun is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisRun_To_v1alpha1_AnalysisRun(in *AnalysisRun, out *v1alpha1.AnalysisRun, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisRun_To_v1alpha1_AnalysisRun(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisRun_To_v1beta1_AnalysisRun(in *v1alpha1.AnalysisRun, out *AnalysisRun, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_AnalysisRunSpec_To_v1beta1_AnalysisRunSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AnalysisRunStatus_To_v1beta1_AnalysisRunStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisRun_To_v1beta1_AnalysisRun is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisRun_To_v1beta1_AnalysisRun(in *v1alpha1.AnalysisRun, out *AnalysisRun, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisRun_To_v1beta1_AnalysisRun(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisRunArgument_To_v1alpha1_AnalysisRunArgument(in *AnalysisRunArgument, out *v1alpha1.AnalysisRunArgument, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.ValueFrom = (*v1alpha1.ArgumentValueFrom)(unsafe.Pointer(in.ValueFrom))
+	return nil
+}
+
+// Convert_v1beta1_AnalysisRunArgument_To_v1alpha1_AnalysisRunArgument is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisRunArgument_To_v1alpha1_AnalysisRunArgument(in *AnalysisRunArgument, out *v1alpha1.AnalysisRunArgument, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisRunArgument_To_v1alpha1_AnalysisRunArgument(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisRunArgument_To_v1beta1_AnalysisRunArgument(in *v1alpha1.AnalysisRunArgument, out *AnalysisRunArgument, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.ValueFrom = (*ArgumentValueFrom)(unsafe.Pointer(in.ValueFrom))
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisRunArgument_To_v1beta1_AnalysisRunArgument is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisRunArgument_To_v1beta1_AnalysisRunArgument(in *v1alpha1.AnalysisRunArgument, out *AnalysisRunArgument, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisRunArgument_To_v1beta1_AnalysisRunArgument(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisRunList_To_v1alpha1_AnalysisRunList(in *AnalysisRunList, out *v1alpha1.AnalysisRunList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.AnalysisRun)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_AnalysisRunList_To_v1alpha1_AnalysisRunList is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisRunList_To_v1alpha1_AnalysisRunList(in *AnalysisRunList, out *v1alpha1.AnalysisRunList, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisRunList_To_v1alpha1_AnalysisRunList(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisRunList_To_v1beta1_AnalysisRunList(in *v1alpha1.AnalysisRunList, out *AnalysisRunList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AnalysisRun)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisRunList_To_v1beta1_AnalysisRunList is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisRunList_To_v1beta1_AnalysisRunList(in *v1alpha1.AnalysisRunList, out *AnalysisRunList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisRunList_To_v1beta1_AnalysisRunList(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisRunMetadata_To_v1alpha1_AnalysisRunMetadata(in *AnalysisRunMetadata, out *v1alpha1.AnalysisRunMetadata, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1beta1_AnalysisRunMetadata_To_v1alpha1_AnalysisRunMetadata is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisRunMetadata_To_v1alpha1_AnalysisRunMetadata(in *AnalysisRunMetadata, out *v1alpha1.AnalysisRunMetadata, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisRunMetadata_To_v1alpha1_AnalysisRunMetadata(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisRunMetadata_To_v1beta1_AnalysisRunMetadata(in *v1alpha1.AnalysisRunMetadata, out *AnalysisRunMetadata, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisRunMetadata_To_v1beta1_AnalysisRunMetadata is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisRunMetadata_To_v1beta1_AnalysisRunMetadata(in *v1alpha1.AnalysisRunMetadata, out *AnalysisRunMetadata, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisRunMetadata_To_v1beta1_AnalysisRunMetadata(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisRunSpec_To_v1alpha1_AnalysisRunSpec(in *AnalysisRunSpec, out *v1alpha1.AnalysisRunSpec, s conversion.Scope) error {
+	out.Metrics = *(*[]v1alpha1.Metric)(unsafe.Pointer(&in.Metrics))
+	out.Args = *(*[]v1alpha1.Argument)(unsafe.Pointer(&in.Args))
+	out.Terminate = in.Terminate
+	out.DryRun = *(*[]v1alpha1.DryRun)(unsafe.Pointer(&in.DryRun))
+	out.MeasurementRetention = *(*[]v1alpha1.MeasurementRetention)(unsafe.Pointer(&in.MeasurementRetention))
+	out.TTLStrategy = (*v1alpha1.TTLStrategy)(unsafe.Pointer(in.TTLStrategy))
+	return nil
+}
+
+// Convert_v1beta1_AnalysisRunSpec_To_v1alpha1_AnalysisRunSpec is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisRunSpec_To_v1alpha1_AnalysisRunSpec(in *AnalysisRunSpec, out *v1alpha1.AnalysisRunSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisRunSpec_To_v1alpha1_AnalysisRunSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisRunSpec_To_v1beta1_AnalysisRunSpec(in *v1alpha1.AnalysisRunSpec, out *AnalysisRunSpec, s conversion.Scope) error {
+	out.Metrics = *(*[]Metric)(unsafe.Pointer(&in.Metrics))
+	out.Args = *(*[]Argument)(unsafe.Pointer(&in.Args))
+	out.Terminate = in.Terminate
+	out.DryRun = *(*[]DryRun)(unsafe.Pointer(&in.DryRun))
+	out.MeasurementRetention = *(*[]MeasurementRetention)(unsafe.Pointer(&in.MeasurementRetention))
+	out.TTLStrategy = (*TTLStrategy)(unsafe.Pointer(in.TTLStrategy))
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisRunSpec_To_v1beta1_AnalysisRunSpec is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisRunSpec_To_v1beta1_AnalysisRunSpec(in *v1alpha1.AnalysisRunSpec, out *AnalysisRunSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisRunSpec_To_v1beta1_AnalysisRunSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisRunStatus_To_v1alpha1_AnalysisRunStatus(in *AnalysisRunStatus, out *v1alpha1.AnalysisRunStatus, s conversion.Scope) error {
+	out.Phase = v1alpha1.AnalysisPhase(in.Phase)
+	out.Message = in.Message
+	out.MetricResults = *(*[]v1alpha1.MetricResult)(unsafe.Pointer(&in.MetricResults))
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	if err := Convert_v1beta1_RunSummary_To_v1alpha1_RunSummary(&in.RunSummary, &out.RunSummary, s); err != nil {
+		return err
+	}
+	out.DryRunSummary = (*v1alpha1.RunSummary)(unsafe.Pointer(in.DryRunSummary))
+	out.CompletedAt = (*v1.Time)(unsafe.Pointer(in.CompletedAt))
+	return nil
+}
+
+// Convert_v1beta1_AnalysisRunStatus_To_v1alpha1_AnalysisRunStatus is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisRunStatus_To_v1alpha1_AnalysisRunStatus(in *AnalysisRunStatus, out *v1alpha1.AnalysisRunStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisRunStatus_To_v1alpha1_AnalysisRunStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisRunStatus_To_v1beta1_AnalysisRunStatus(in *v1alpha1.AnalysisRunStatus, out *AnalysisRunStatus, s conversion.Scope) error {
+	out.Phase = AnalysisPhase(in.Phase)
+	out.Message = in.Message
+	out.MetricResults = *(*[]MetricResult)(unsafe.Pointer(&in.MetricResults))
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	if err := Convert_v1alpha1_RunSummary_To_v1beta1_RunSummary(&in.RunSummary, &out.RunSummary, s); err != nil {
+		return err
+	}
+	out.DryRunSummary = (*RunSummary)(unsafe.Pointer(in.DryRunSummary))
+	out.CompletedAt = (*v1.Time)(unsafe.Pointer(in.CompletedAt))
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisRunStatus_To_v1beta1_AnalysisRunStatus is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisRunStatus_To_v1beta1_AnalysisRunStatus(in *v1alpha1.AnalysisRunStatus, out *AnalysisRunStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisRunStatus_To_v1beta1_AnalysisRunStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisRunStrategy_To_v1alpha1_AnalysisRunStrategy(in *AnalysisRunStrategy, out *v1alpha1.AnalysisRunStrategy, s conversion.Scope) error {
+	out.SuccessfulRunHistoryLimit = (*int32)(unsafe.Pointer(in.SuccessfulRunHistoryLimit))
+	out.UnsuccessfulRunHistoryLimit = (*int32)(unsafe.Pointer(in.UnsuccessfulRunHistoryLimit))
+	return nil
+}
+
+// Convert_v1beta1_AnalysisRunStrategy_To_v1alpha1_AnalysisRunStrategy is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisRunStrategy_To_v1alpha1_AnalysisRunStrategy(in *AnalysisRunStrategy, out *v1alpha1.AnalysisRunStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisRunStrategy_To_v1alpha1_AnalysisRunStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisRunStrategy_To_v1beta1_AnalysisRunStrategy(in *v1alpha1.AnalysisRunStrategy, out *AnalysisRunStrategy, s conversion.Scope) error {
+	out.SuccessfulRunHistoryLimit = (*int32)(unsafe.Pointer(in.SuccessfulRunHistoryLimit))
+	out.UnsuccessfulRunHistoryLimit = (*int32)(unsafe.Pointer(in.UnsuccessfulRunHistoryLimit))
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisRunStrategy_To_v1beta1_AnalysisRunStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisRunStrategy_To_v1beta1_AnalysisRunStrategy(in *v1alpha1.AnalysisRunStrategy, out *AnalysisRunStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisRunStrategy_To_v1beta1_AnalysisRunStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisTemplate_To_v1alpha1_AnalysisTemplate(in *AnalysisTemplate, out *v1alpha1.AnalysisTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_AnalysisTemplateSpec_To_v1alpha1_AnalysisTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_AnalysisTemplate_To_v1alpha1_AnalysisTemplate is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisTemplate_To_v1alpha1_AnalysisTemplate(in *AnalysisTemplate, out *v1alpha1.AnalysisTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisTemplate_To_v1alpha1_AnalysisTemplate(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisTemplate_To_v1beta1_AnalysisTemplate(in *v1alpha1.AnalysisTemplate, out *AnalysisTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_AnalysisTemplateSpec_To_v1beta1_AnalysisTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisTemplate_To_v1beta1_AnalysisTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisTemplate_To_v1beta1_AnalysisTemplate(in *v1alpha1.AnalysisTemplate, out *AnalysisTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisTemplate_To_v1beta1_AnalysisTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisTemplateList_To_v1alpha1_AnalysisTemplateList(in *AnalysisTemplateList, out *v1alpha1.AnalysisTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.AnalysisTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_AnalysisTemplateList_To_v1alpha1_AnalysisTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisTemplateList_To_v1alpha1_AnalysisTemplateList(in *AnalysisTemplateList, out *v1alpha1.AnalysisTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisTemplateList_To_v1alpha1_AnalysisTemplateList(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisTemplateList_To_v1beta1_AnalysisTemplateList(in *v1alpha1.AnalysisTemplateList, out *AnalysisTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AnalysisTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisTemplateList_To_v1beta1_AnalysisTemplateList is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisTemplateList_To_v1beta1_AnalysisTemplateList(in *v1alpha1.AnalysisTemplateList, out *AnalysisTemplateList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisTemplateList_To_v1beta1_AnalysisTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisTemplateRef_To_v1alpha1_AnalysisTemplateRef(in *AnalysisTemplateRef, out *v1alpha1.AnalysisTemplateRef, s conversion.Scope) error {
+	out.TemplateName = in.TemplateName
+	out.ClusterScope = in.ClusterScope
+	return nil
+}
+
+// Convert_v1beta1_AnalysisTemplateRef_To_v1alpha1_AnalysisTemplateRef is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisTemplateRef_To_v1alpha1_AnalysisTemplateRef(in *AnalysisTemplateRef, out *v1alpha1.AnalysisTemplateRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisTemplateRef_To_v1alpha1_AnalysisTemplateRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisTemplateRef_To_v1beta1_AnalysisTemplateRef(in *v1alpha1.AnalysisTemplateRef, out *AnalysisTemplateRef, s conversion.Scope) error {
+	out.TemplateName = in.TemplateName
+	out.ClusterScope = in.ClusterScope
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisTemplateRef_To_v1beta1_AnalysisTemplateRef is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisTemplateRef_To_v1beta1_AnalysisTemplateRef(in *v1alpha1.AnalysisTemplateRef, out *AnalysisTemplateRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisTemplateRef_To_v1beta1_AnalysisTemplateRef(in, out, s)
+}
+
+func autoConvert_v1beta1_AnalysisTemplateSpec_To_v1alpha1_AnalysisTemplateSpec(in *AnalysisTemplateSpec, out *v1alpha1.AnalysisTemplateSpec, s conversion.Scope) error {
+	out.Metrics = *(*[]v1alpha1.Metric)(unsafe.Pointer(&in.Metrics))
+	out.Args = *(*[]v1alpha1.Argument)(unsafe.Pointer(&in.Args))
+	out.DryRun = *(*[]v1alpha1.DryRun)(unsafe.Pointer(&in.DryRun))
+	out.MeasurementRetention = *(*[]v1alpha1.MeasurementRetention)(unsafe.Pointer(&in.MeasurementRetention))
+	out.Templates = *(*[]v1alpha1.AnalysisTemplateRef)(unsafe.Pointer(&in.Templates))
+	return nil
+}
+
+// Convert_v1beta1_AnalysisTemplateSpec_To_v1alpha1_AnalysisTemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_AnalysisTemplateSpec_To_v1alpha1_AnalysisTemplateSpec(in *AnalysisTemplateSpec, out *v1alpha1.AnalysisTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_AnalysisTemplateSpec_To_v1alpha1_AnalysisTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_AnalysisTemplateSpec_To_v1beta1_AnalysisTemplateSpec(in *v1alpha1.AnalysisTemplateSpec, out *AnalysisTemplateSpec, s conversion.Scope) error {
+	out.Metrics = *(*[]Metric)(unsafe.Pointer(&in.Metrics))
+	out.Args = *(*[]Argument)(unsafe.Pointer(&in.Args))
+	out.DryRun = *(*[]DryRun)(unsafe.Pointer(&in.DryRun))
+	out.MeasurementRetention = *(*[]MeasurementRetention)(unsafe.Pointer(&in.MeasurementRetention))
+	out.Templates = *(*[]AnalysisTemplateRef)(unsafe.Pointer(&in.Templates))
+	return nil
+}
+
+// Convert_v1alpha1_AnalysisTemplateSpec_To_v1beta1_AnalysisTemplateSpec is an autogenerated conversion function.
+func Convert_v1alpha1_AnalysisTemplateSpec_To_v1beta1_AnalysisTemplateSpec(in *v1alpha1.AnalysisTemplateSpec, out *AnalysisTemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AnalysisTemplateSpec_To_v1beta1_AnalysisTemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_AntiAffinity_To_v1alpha1_AntiAffinity(in *AntiAffinity, out *v1alpha1.AntiAffinity, s conversion.Scope) error {
+	out.PreferredDuringSchedulingIgnoredDuringExecution = (*v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution)(unsafe.Pointer(in.PreferredDuringSchedulingIgnoredDuringExecution))
+	out.RequiredDuringSchedulingIgnoredDuringExecution = (*v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution)(unsafe.Pointer(in.RequiredDuringSchedulingIgnoredDuringExecution))
+	return nil
+}
+
+// Convert_v1beta1_AntiAffinity_To_v1alpha1_AntiAffinity is an autogenerated conversion function.
+func Convert_v1beta1_AntiAffinity_To_v1alpha1_AntiAffinity(in *AntiAffinity, out *v1alpha1.AntiAffinity, s conversion.Scope) error {
+	return autoConvert_v1beta1_AntiAffinity_To_v1alpha1_AntiAffinity(in, out, s)
+}
+
+func autoConvert_v1alpha1_AntiAffinity_To_v1beta1_AntiAffinity(in *v1alpha1.AntiAffinity, out *AntiAffinity, s conversion.Scope) error {
+	out.PreferredDuringSchedulingIgnoredDuringExecution = (*PreferredDuringSchedulingIgnoredDuringExecution)(unsafe.Pointer(in.PreferredDuringSchedulingIgnoredDuringExecution))
+	out.RequiredDuringSchedulingIgnoredDuringExecution = (*RequiredDuringSchedulingIgnoredDuringExecution)(unsafe.Pointer(in.RequiredDuringSchedulingIgnoredDuringExecution))
+	return nil
+}
+
+// Convert_v1alpha1_AntiAffinity_To_v1beta1_AntiAffinity is an autogenerated conversion function.
+func Convert_v1alpha1_AntiAffinity_To_v1beta1_AntiAffinity(in *v1alpha1.AntiAffinity, out *AntiAffinity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AntiAffinity_To_v1beta1_AntiAffinity(in, out, s)
+}
+
+func autoConvert_v1beta1_ApisixRoute_To_v1alpha1_ApisixRoute(in *ApisixRoute, out *v1alpha1.ApisixRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Rules = *(*[]string)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1beta1_ApisixRoute_To_v1alpha1_ApisixRoute is an autogenerated conversion function.
+func Convert_v1beta1_ApisixRoute_To_v1alpha1_ApisixRoute(in *ApisixRoute, out *v1alpha1.ApisixRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_ApisixRoute_To_v1alpha1_ApisixRoute(in, out, s)
+}
+
+func autoConvert_v1alpha1_ApisixRoute_To_v1beta1_ApisixRoute(in *v1alpha1.ApisixRoute, out *ApisixRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Rules = *(*[]string)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_ApisixRoute_To_v1beta1_ApisixRoute is an autogenerated conversion function.
+func Convert_v1alpha1_ApisixRoute_To_v1beta1_ApisixRoute(in *v1alpha1.ApisixRoute, out *ApisixRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApisixRoute_To_v1beta1_ApisixRoute(in, out, s)
+}
+
+func autoConvert_v1beta1_ApisixTrafficRouting_To_v1alpha1_ApisixTrafficRouting(in *ApisixTrafficRouting, out *v1alpha1.ApisixTrafficRouting, s conversion.Scope) error {
+	out.Route = (*v1alpha1.ApisixRoute)(unsafe.Pointer(in.Route))
+	return nil
+}
+
+// Convert_v1beta1_ApisixTrafficRouting_To_v1alpha1_ApisixTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_ApisixTrafficRouting_To_v1alpha1_ApisixTrafficRouting(in *ApisixTrafficRouting, out *v1alpha1.ApisixTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_ApisixTrafficRouting_To_v1alpha1_ApisixTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_ApisixTrafficRouting_To_v1beta1_ApisixTrafficRouting(in *v1alpha1.ApisixTrafficRouting, out *ApisixTrafficRouting, s conversion.Scope) error {
+	out.Route = (*ApisixRoute)(unsafe.Pointer(in.Route))
+	return nil
+}
+
+// Convert_v1alpha1_ApisixTrafficRouting_To_v1beta1_ApisixTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_ApisixTrafficRouting_To_v1beta1_ApisixTrafficRouting(in *v1alpha1.ApisixTrafficRouting, out *ApisixTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApisixTrafficRouting_To_v1beta1_ApisixTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_AppMeshTrafficRouting_To_v1alpha1_AppMeshTrafficRouting(in *AppMeshTrafficRouting, out *v1alpha1.AppMeshTrafficRouting, s conversion.Scope) error {
+	out.VirtualService = (*v1alpha1.AppMeshVirtualService)(unsafe.Pointer(in.VirtualService))
+	out.VirtualNodeGroup = (*v1alpha1.AppMeshVirtualNodeGroup)(unsafe.Pointer(in.VirtualNodeGroup))
+	return nil
+}
+
+// Convert_v1beta1_AppMeshTrafficRouting_To_v1alpha1_AppMeshTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_AppMeshTrafficRouting_To_v1alpha1_AppMeshTrafficRouting(in *AppMeshTrafficRouting, out *v1alpha1.AppMeshTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_AppMeshTrafficRouting_To_v1alpha1_AppMeshTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_AppMeshTrafficRouting_To_v1beta1_AppMeshTrafficRouting(in *v1alpha1.AppMeshTrafficRouting, out *AppMeshTrafficRouting, s conversion.Scope) error {
+	out.VirtualService = (*AppMeshVirtualService)(unsafe.Pointer(in.VirtualService))
+	out.VirtualNodeGroup = (*AppMeshVirtualNodeGroup)(unsafe.Pointer(in.VirtualNodeGroup))
+	return nil
+}
+
+// Convert_v1alpha1_AppMeshTrafficRouting_To_v1beta1_AppMeshTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_AppMeshTrafficRouting_To_v1beta1_AppMeshTrafficRouting(in *v1alpha1.AppMeshTrafficRouting, out *AppMeshTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AppMeshTrafficRouting_To_v1beta1_AppMeshTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_AppMeshVirtualNodeGroup_To_v1alpha1_AppMeshVirtualNodeGroup(in *AppMeshVirtualNodeGroup, out *v1alpha1.AppMeshVirtualNodeGroup, s conversion.Scope) error {
+	out.CanaryVirtualNodeRef = (*v1alpha1.AppMeshVirtualNodeReference)(unsafe.Pointer(in.CanaryVirtualNodeRef))
+	out.StableVirtualNodeRef = (*v1alpha1.AppMeshVirtualNodeReference)(unsafe.Pointer(in.StableVirtualNodeRef))
+	return nil
+}
+
+// Convert_v1beta1_AppMeshVirtualNodeGroup_To_v1alpha1_AppMeshVirtualNodeGroup is an autogenerated conversion function.
+func Convert_v1beta1_AppMeshVirtualNodeGroup_To_v1alpha1_AppMeshVirtualNodeGroup(in *AppMeshVirtualNodeGroup, out *v1alpha1.AppMeshVirtualNodeGroup, s conversion.Scope) error {
+	return autoConvert_v1beta1_AppMeshVirtualNodeGroup_To_v1alpha1_AppMeshVirtualNodeGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_AppMeshVirtualNodeGroup_To_v1beta1_AppMeshVirtualNodeGroup(in *v1alpha1.AppMeshVirtualNodeGroup, out *AppMeshVirtualNodeGroup, s conversion.Scope) error {
+	out.CanaryVirtualNodeRef = (*AppMeshVirtualNodeReference)(unsafe.Pointer(in.CanaryVirtualNodeRef))
+	out.StableVirtualNodeRef = (*AppMeshVirtualNodeReference)(unsafe.Pointer(in.StableVirtualNodeRef))
+	return nil
+}
+
+// Convert_v1alpha1_AppMeshVirtualNodeGroup_To_v1beta1_AppMeshVirtualNodeGroup is an autogenerated conversion function.
+func Convert_v1alpha1_AppMeshVirtualNodeGroup_To_v1beta1_AppMeshVirtualNodeGroup(in *v1alpha1.AppMeshVirtualNodeGroup, out *AppMeshVirtualNodeGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AppMeshVirtualNodeGroup_To_v1beta1_AppMeshVirtualNodeGroup(in, out, s)
+}
+
+func autoConvert_v1beta1_AppMeshVirtualNodeReference_To_v1alpha1_AppMeshVirtualNodeReference(in *AppMeshVirtualNodeReference, out *v1alpha1.AppMeshVirtualNodeReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_AppMeshVirtualNodeReference_To_v1alpha1_AppMeshVirtualNodeReference is an autogenerated conversion function.
+func Convert_v1beta1_AppMeshVirtualNodeReference_To_v1alpha1_AppMeshVirtualNodeReference(in *AppMeshVirtualNodeReference, out *v1alpha1.AppMeshVirtualNodeReference, s conversion.Scope) error {
+	return autoConvert_v1beta1_AppMeshVirtualNodeReference_To_v1alpha1_AppMeshVirtualNodeReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_AppMeshVirtualNodeReference_To_v1beta1_AppMeshVirtualNodeReference(in *v1alpha1.AppMeshVirtualNodeReference, out *AppMeshVirtualNodeReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_AppMeshVirtualNodeReference_To_v1beta1_AppMeshVirtualNodeReference is an autogenerated conversion function.
+func Convert_v1alpha1_AppMeshVirtualNodeReference_To_v1beta1_AppMeshVirtualNodeReference(in *v1alpha1.AppMeshVirtualNodeReference, out *AppMeshVirtualNodeReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AppMeshVirtualNodeReference_To_v1beta1_AppMeshVirtualNodeReference(in, out, s)
+}
+
+func autoConvert_v1beta1_AppMeshVirtualService_To_v1alpha1_AppMeshVirtualService(in *AppMeshVirtualService, out *v1alpha1.AppMeshVirtualService, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1beta1_AppMeshVirtualService_To_v1alpha1_AppMeshVirtualService is an autogenerated conversion function.
+func Convert_v1beta1_AppMeshVirtualService_To_v1alpha1_AppMeshVirtualService(in *AppMeshVirtualService, out *v1alpha1.AppMeshVirtualService, s conversion.Scope) error {
+	return autoConvert_v1beta1_AppMeshVirtualService_To_v1alpha1_AppMeshVirtualService(in, out, s)
+}
+
+func autoConvert_v1alpha1_AppMeshVirtualService_To_v1beta1_AppMeshVirtualService(in *v1alpha1.AppMeshVirtualService, out *AppMeshVirtualService, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1alpha1_AppMeshVirtualService_To_v1beta1_AppMeshVirtualService is an autogenerated conversion function.
+func Convert_v1alpha1_AppMeshVirtualService_To_v1beta1_AppMeshVirtualService(in *v1alpha1.AppMeshVirtualService, out *AppMeshVirtualService, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AppMeshVirtualService_To_v1beta1_AppMeshVirtualService(in, out, s)
+}
+
+func autoConvert_v1beta1_Argument_To_v1alpha1_Argument(in *Argument, out *v1alpha1.Argument, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = (*string)(unsafe.Pointer(in.Value))
+	out.ValueFrom = (*v1alpha1.ValueFrom)(unsafe.Pointer(in.ValueFrom))
+	return nil
+}
+
+// Convert_v1beta1_Argument_To_v1alpha1_Argument is an autogenerated conversion function.
+func Convert_v1beta1_Argument_To_v1alpha1_Argument(in *Argument, out *v1alpha1.Argument, s conversion.Scope) error {
+	return autoConvert_v1beta1_Argument_To_v1alpha1_Argument(in, out, s)
+}
+
+func autoConvert_v1alpha1_Argument_To_v1beta1_Argument(in *v1alpha1.Argument, out *Argument, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = (*string)(unsafe.Pointer(in.Value))
+	out.ValueFrom = (*ValueFrom)(unsafe.Pointer(in.ValueFrom))
+	return nil
+}
+
+// Convert_v1alpha1_Argument_To_v1beta1_Argument is an autogenerated conversion function.
+func Convert_v1alpha1_Argument_To_v1beta1_Argument(in *v1alpha1.Argument, out *Argument, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Argument_To_v1beta1_Argument(in, out, s)
+}
+
+func autoConvert_v1beta1_ArgumentValueFrom_To_v1alpha1_ArgumentValueFrom(in *ArgumentValueFrom, out *v1alpha1.ArgumentValueFrom, s conversion.Scope) error {
+	out.PodTemplateHashValue = (*v1alpha1.ValueFromPodTemplateHash)(unsafe.Pointer(in.PodTemplateHashValue))
+	out.FieldRef = (*v1alpha1.FieldRef)(unsafe.Pointer(in.FieldRef))
+	return nil
+}
+
+// Convert_v1beta1_ArgumentValueFrom_To_v1alpha1_ArgumentValueFrom is an autogenerated conversion function.
+func Convert_v1beta1_ArgumentValueFrom_To_v1alpha1_ArgumentValueFrom(in *ArgumentValueFrom, out *v1alpha1.ArgumentValueFrom, s conversion.Scope) error {
+	return autoConvert_v1beta1_ArgumentValueFrom_To_v1alpha1_ArgumentValueFrom(in, out, s)
+}
+
+func autoConvert_v1alpha1_ArgumentValueFrom_To_v1beta1_ArgumentValueFrom(in *v1alpha1.ArgumentValueFrom, out *ArgumentValueFrom, s conversion.Scope) error {
+	out.PodTemplateHashValue = (*ValueFromPodTemplateHash)(unsafe.Pointer(in.PodTemplateHashValue))
+	out.FieldRef = (*FieldRef)(unsafe.Pointer(in.FieldRef))
+	return nil
+}
+
+// Convert_v1alpha1_ArgumentValueFrom_To_v1beta1_ArgumentValueFrom is an autogenerated conversion function.
+func Convert_v1alpha1_ArgumentValueFrom_To_v1beta1_ArgumentValueFrom(in *v1alpha1.ArgumentValueFrom, out *ArgumentValueFrom, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ArgumentValueFrom_To_v1beta1_ArgumentValueFrom(in, out, s)
+}
+
+func autoConvert_v1beta1_Authentication_To_v1alpha1_Authentication(in *Authentication, out *v1alpha1.Authentication, s conversion.Scope) error {
+	if err := Convert_v1beta1_Sigv4Config_To_v1alpha1_Sigv4Config(&in.Sigv4, &out.Sigv4, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_OAuth2Config_To_v1alpha1_OAuth2Config(&in.OAuth2, &out.OAuth2, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Authentication_To_v1alpha1_Authentication is an autogenerated conversion function.
+func Convert_v1beta1_Authentication_To_v1alpha1_Authentication(in *Authentication, out *v1alpha1.Authentication, s conversion.Scope) error {
+	return autoConvert_v1beta1_Authentication_To_v1alpha1_Authentication(in, out, s)
+}
+
+func autoConvert_v1alpha1_Authentication_To_v1beta1_Authentication(in *v1alpha1.Authentication, out *Authentication, s conversion.Scope) error {
+	if err := Convert_v1alpha1_Sigv4Config_To_v1beta1_Sigv4Config(&in.Sigv4, &out.Sigv4, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_OAuth2Config_To_v1beta1_OAuth2Config(&in.OAuth2, &out.OAuth2, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Authentication_To_v1beta1_Authentication is an autogenerated conversion function.
+func Convert_v1alpha1_Authentication_To_v1beta1_Authentication(in *v1alpha1.Authentication, out *Authentication, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Authentication_To_v1beta1_Authentication(in, out, s)
+}
+
+func autoConvert_v1beta1_AwsResourceRef_To_v1alpha1_AwsResourceRef(in *AwsResourceRef, out *v1alpha1.AwsResourceRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ARN = in.ARN
+	out.FullName = in.FullName
+	return nil
+}
+
+// Convert_v1beta1_AwsResourceRef_To_v1alpha1_AwsResourceRef is an autogenerated conversion function.
+func Convert_v1beta1_AwsResourceRef_To_v1alpha1_AwsResourceRef(in *AwsResourceRef, out *v1alpha1.AwsResourceRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_AwsResourceRef_To_v1alpha1_AwsResourceRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_AwsResourceRef_To_v1beta1_AwsResourceRef(in *v1alpha1.AwsResourceRef, out *AwsResourceRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ARN = in.ARN
+	out.FullName = in.FullName
+	return nil
+}
+
+// Convert_v1alpha1_AwsResourceRef_To_v1beta1_AwsResourceRef is an autogenerated conversion function.
+func Convert_v1alpha1_AwsResourceRef_To_v1beta1_AwsResourceRef(in *v1alpha1.AwsResourceRef, out *AwsResourceRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AwsResourceRef_To_v1beta1_AwsResourceRef(in, out, s)
+}
+
+func autoConvert_v1beta1_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(in *BlueGreenStatus, out *v1alpha1.BlueGreenStatus, s conversion.Scope) error {
+	out.PreviewSelector = in.PreviewSelector
+	out.ActiveSelector = in.ActiveSelector
+	out.ScaleUpPreviewCheckPoint = in.ScaleUpPreviewCheckPoint
+	out.PrePromotionAnalysisRunStatus = (*v1alpha1.RolloutAnalysisRunStatus)(unsafe.Pointer(in.PrePromotionAnalysisRunStatus))
+	out.PostPromotionAnalysisRunStatus = (*v1alpha1.RolloutAnalysisRunStatus)(unsafe.Pointer(in.PostPromotionAnalysisRunStatus))
+	out.TrafficStepIndex = (*int32)(unsafe.Pointer(in.TrafficStepIndex))
+	out.TrafficStepAnalysisRunStatus = (*v1alpha1.RolloutAnalysisRunStatus)(unsafe.Pointer(in.TrafficStepAnalysisRunStatus))
+	out.Weights = (*v1alpha1.TrafficWeights)(unsafe.Pointer(in.Weights))
+	return nil
+}
+
+// Convert_v1beta1_BlueGreenStatus_To_v1alpha1_BlueGreenStatus is an autogenerated conversion function.
+func Convert_v1beta1_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(in *BlueGreenStatus, out *v1alpha1.BlueGreenStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_BlueGreenStatus_To_v1beta1_BlueGreenStatus(in *v1alpha1.BlueGreenStatus, out *BlueGreenStatus, s conversion.Scope) error {
+	out.PreviewSelector = in.PreviewSelector
+	out.ActiveSelector = in.ActiveSelector
+	out.ScaleUpPreviewCheckPoint = in.ScaleUpPreviewCheckPoint
+	out.PrePromotionAnalysisRunStatus = (*RolloutAnalysisRunStatus)(unsafe.Pointer(in.PrePromotionAnalysisRunStatus))
+	out.PostPromotionAnalysisRunStatus = (*RolloutAnalysisRunStatus)(unsafe.Pointer(in.PostPromotionAnalysisRunStatus))
+	out.TrafficStepIndex = (*int32)(unsafe.Pointer(in.TrafficStepIndex))
+	out.TrafficStepAnalysisRunStatus = (*RolloutAnalysisRunStatus)(unsafe.Pointer(in.TrafficStepAnalysisRunStatus))
+	out.Weights = (*TrafficWeights)(unsafe.Pointer(in.Weights))
+	return nil
+}
+
+// Convert_v1alpha1_BlueGreenStatus_To_v1beta1_BlueGreenStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BlueGreenStatus_To_v1beta1_BlueGreenStatus(in *v1alpha1.BlueGreenStatus, out *BlueGreenStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BlueGreenStatus_To_v1beta1_BlueGreenStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_BlueGreenStrategy_To_v1alpha1_BlueGreenStrategy(in *BlueGreenStrategy, out *v1alpha1.BlueGreenStrategy, s conversion.Scope) error {
+	out.ActiveService = in.ActiveService
+	out.PreviewService = in.PreviewService
+	out.PreviewReplicaCount = (*int32)(unsafe.Pointer(in.PreviewReplicaCount))
+	out.AutoPromotionEnabled = (*bool)(unsafe.Pointer(in.AutoPromotionEnabled))
+	out.AutoPromotionSeconds = in.AutoPromotionSeconds
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.ScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.ScaleDownDelaySeconds))
+	out.ScaleDownDelayRevisionLimit = (*int32)(unsafe.Pointer(in.ScaleDownDelayRevisionLimit))
+	out.PrePromotionAnalysis = (*v1alpha1.RolloutAnalysis)(unsafe.Pointer(in.PrePromotionAnalysis))
+	out.AntiAffinity = (*v1alpha1.AntiAffinity)(unsafe.Pointer(in.AntiAffinity))
+	out.PostPromotionAnalysis = (*v1alpha1.RolloutAnalysis)(unsafe.Pointer(in.PostPromotionAnalysis))
+	out.PreviewMetadata = (*v1alpha1.PodTemplateMetadata)(unsafe.Pointer(in.PreviewMetadata))
+	out.ActiveMetadata = (*v1alpha1.PodTemplateMetadata)(unsafe.Pointer(in.ActiveMetadata))
+	out.AbortScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.AbortScaleDownDelaySeconds))
+	out.TrafficRouting = (*v1alpha1.RolloutTrafficRouting)(unsafe.Pointer(in.TrafficRouting))
+	out.TrafficSteps = *(*[]v1alpha1.BlueGreenTrafficStep)(unsafe.Pointer(&in.TrafficSteps))
+	if in.PrePromotionTimeout != nil {
+		in, out := &in.PrePromotionTimeout, &out.PrePromotionTimeout
+		*out = new(v1alpha1.StepTimeout)
+		if err := Convert_v1beta1_StepTimeout_To_v1alpha1_StepTimeout(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrePromotionTimeout = nil
+	}
+	if in.PostPromotionTimeout != nil {
+		in, out := &in.PostPromotionTimeout, &out.PostPromotionTimeout
+		*out = new(v1alpha1.StepTimeout)
+		if err := Convert_v1beta1_StepTimeout_To_v1alpha1_StepTimeout(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostPromotionTimeout = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_BlueGreenStrategy_To_v1alpha1_BlueGreenStrategy is an autogenerated conversion function.
+func Convert_v1beta1_BlueGreenStrategy_To_v1alpha1_BlueGreenStrategy(in *BlueGreenStrategy, out *v1alpha1.BlueGreenStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_BlueGreenStrategy_To_v1alpha1_BlueGreenStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_BlueGreenStrategy_To_v1beta1_BlueGreenStrategy(in *v1alpha1.BlueGreenStrategy, out *BlueGreenStrategy, s conversion.Scope) error {
+	out.ActiveService = in.ActiveService
+	out.PreviewService = in.PreviewService
+	out.PreviewReplicaCount = (*int32)(unsafe.Pointer(in.PreviewReplicaCount))
+	out.AutoPromotionEnabled = (*bool)(unsafe.Pointer(in.AutoPromotionEnabled))
+	out.AutoPromotionSeconds = in.AutoPromotionSeconds
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.ScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.ScaleDownDelaySeconds))
+	out.ScaleDownDelayRevisionLimit = (*int32)(unsafe.Pointer(in.ScaleDownDelayRevisionLimit))
+	out.PrePromotionAnalysis = (*RolloutAnalysis)(unsafe.Pointer(in.PrePromotionAnalysis))
+	out.AntiAffinity = (*AntiAffinity)(unsafe.Pointer(in.AntiAffinity))
+	out.PostPromotionAnalysis = (*RolloutAnalysis)(unsafe.Pointer(in.PostPromotionAnalysis))
+	out.PreviewMetadata = (*PodTemplateMetadata)(unsafe.Pointer(in.PreviewMetadata))
+	out.ActiveMetadata = (*PodTemplateMetadata)(unsafe.Pointer(in.ActiveMetadata))
+	out.AbortScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.AbortScaleDownDelaySeconds))
+	out.TrafficRouting = (*RolloutTrafficRouting)(unsafe.Pointer(in.TrafficRouting))
+	out.TrafficSteps = *(*[]BlueGreenTrafficStep)(unsafe.Pointer(&in.TrafficSteps))
+	if in.PrePromotionTimeout != nil {
+		in, out := &in.PrePromotionTimeout, &out.PrePromotionTimeout
+		*out = new(StepTimeout)
+		if err := Convert_v1alpha1_StepTimeout_To_v1beta1_StepTimeout(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrePromotionTimeout = nil
+	}
+	if in.PostPromotionTimeout != nil {
+		in, out := &in.PostPromotionTimeout, &out.PostPromotionTimeout
+		*out = new(StepTimeout)
+		if err := Convert_v1alpha1_StepTimeout_To_v1beta1_StepTimeout(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PostPromotionTimeout = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BlueGreenStrategy_To_v1beta1_BlueGreenStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_BlueGreenStrategy_To_v1beta1_BlueGreenStrategy(in *v1alpha1.BlueGreenStrategy, out *BlueGreenStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BlueGreenStrategy_To_v1beta1_BlueGreenStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_BlueGreenTrafficStep_To_v1alpha1_BlueGreenTrafficStep(in *BlueGreenTrafficStep, out *v1alpha1.BlueGreenTrafficStep, s conversion.Scope) error {
+	out.SetWeight = (*int32)(unsafe.Pointer(in.SetWeight))
+	out.Pause = (*v1alpha1.RolloutPause)(unsafe.Pointer(in.Pause))
+	out.Analysis = (*v1alpha1.RolloutAnalysis)(unsafe.Pointer(in.Analysis))
+	return nil
+}
+
+// Convert_v1beta1_BlueGreenTrafficStep_To_v1alpha1_BlueGreenTrafficStep is an autogenerated conversion function.
+func Convert_v1beta1_BlueGreenTrafficStep_To_v1alpha1_BlueGreenTrafficStep(in *BlueGreenTrafficStep, out *v1alpha1.BlueGreenTrafficStep, s conversion.Scope) error {
+	return autoConvert_v1beta1_BlueGreenTrafficStep_To_v1alpha1_BlueGreenTrafficStep(in, out, s)
+}
+
+func autoConvert_v1alpha1_BlueGreenTrafficStep_To_v1beta1_BlueGreenTrafficStep(in *v1alpha1.BlueGreenTrafficStep, out *BlueGreenTrafficStep, s conversion.Scope) error {
+	out.SetWeight = (*int32)(unsafe.Pointer(in.SetWeight))
+	out.Pause = (*RolloutPause)(unsafe.Pointer(in.Pause))
+	out.Analysis = (*RolloutAnalysis)(unsafe.Pointer(in.Analysis))
+	return nil
+}
+
+// Convert_v1alpha1_BlueGreenTrafficStep_To_v1beta1_BlueGreenTrafficStep is an autogenerated conversion function.
+func Convert_v1alpha1_BlueGreenTrafficStep_To_v1beta1_BlueGreenTrafficStep(in *v1alpha1.BlueGreenTrafficStep, out *BlueGreenTrafficStep, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BlueGreenTrafficStep_To_v1beta1_BlueGreenTrafficStep(in, out, s)
+}
+
+func autoConvert_v1beta1_CanaryAbortPolicy_To_v1alpha1_CanaryAbortPolicy(in *CanaryAbortPolicy, out *v1alpha1.CanaryAbortPolicy, s conversion.Scope) error {
+	if in.Preserve != nil {
+		in, out := &in.Preserve, &out.Preserve
+		*out = new(v1alpha1.PreserveAbortedPods)
+		if err := Convert_v1beta1_PreserveAbortedPods_To_v1alpha1_PreserveAbortedPods(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Preserve = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_CanaryAbortPolicy_To_v1alpha1_CanaryAbortPolicy is an autogenerated conversion function.
+func Convert_v1beta1_CanaryAbortPolicy_To_v1alpha1_CanaryAbortPolicy(in *CanaryAbortPolicy, out *v1alpha1.CanaryAbortPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_CanaryAbortPolicy_To_v1alpha1_CanaryAbortPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryAbortPolicy_To_v1beta1_CanaryAbortPolicy(in *v1alpha1.CanaryAbortPolicy, out *CanaryAbortPolicy, s conversion.Scope) error {
+	if in.Preserve != nil {
+		in, out := &in.Preserve, &out.Preserve
+		*out = new(PreserveAbortedPods)
+		if err := Convert_v1alpha1_PreserveAbortedPods_To_v1beta1_PreserveAbortedPods(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Preserve = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CanaryAbortPolicy_To_v1beta1_CanaryAbortPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryAbortPolicy_To_v1beta1_CanaryAbortPolicy(in *v1alpha1.CanaryAbortPolicy, out *CanaryAbortPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryAbortPolicy_To_v1beta1_CanaryAbortPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_CanaryStatus_To_v1alpha1_CanaryStatus(in *CanaryStatus, out *v1alpha1.CanaryStatus, s conversion.Scope) error {
+	out.CurrentStepAnalysisRunStatus = (*v1alpha1.RolloutAnalysisRunStatus)(unsafe.Pointer(in.CurrentStepAnalysisRunStatus))
+	out.CurrentBackgroundAnalysisRunStatus = (*v1alpha1.RolloutAnalysisRunStatus)(unsafe.Pointer(in.CurrentBackgroundAnalysisRunStatus))
+	out.CurrentExperiment = in.CurrentExperiment
+	out.Weights = (*v1alpha1.TrafficWeights)(unsafe.Pointer(in.Weights))
+	out.StablePingPong = v1alpha1.PingPongType(in.StablePingPong)
+	if in.StepPluginStatuses != nil {
+		in, out := &in.StepPluginStatuses, &out.StepPluginStatuses
+		*out = make([]v1alpha1.StepPluginStatus, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_StepPluginStatus_To_v1alpha1_StepPluginStatus(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.StepPluginStatuses = nil
+	}
+	out.WaitFor = (*v1alpha1.WaitForStatus)(unsafe.Pointer(in.WaitFor))
+	return nil
+}
+
+// Convert_v1beta1_CanaryStatus_To_v1alpha1_CanaryStatus is an autogenerated conversion function.
+func Convert_v1beta1_CanaryStatus_To_v1alpha1_CanaryStatus(in *CanaryStatus, out *v1alpha1.CanaryStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CanaryStatus_To_v1alpha1_CanaryStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryStatus_To_v1beta1_CanaryStatus(in *v1alpha1.CanaryStatus, out *CanaryStatus, s conversion.Scope) error {
+	out.CurrentStepAnalysisRunStatus = (*RolloutAnalysisRunStatus)(unsafe.Pointer(in.CurrentStepAnalysisRunStatus))
+	out.CurrentBackgroundAnalysisRunStatus = (*RolloutAnalysisRunStatus)(unsafe.Pointer(in.CurrentBackgroundAnalysisRunStatus))
+	out.CurrentExperiment = in.CurrentExperiment
+	out.Weights = (*TrafficWeights)(unsafe.Pointer(in.Weights))
+	out.StablePingPong = PingPongType(in.StablePingPong)
+	if in.StepPluginStatuses != nil {
+		in, out := &in.StepPluginStatuses, &out.StepPluginStatuses
+		*out = make([]StepPluginStatus, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_StepPluginStatus_To_v1beta1_StepPluginStatus(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.StepPluginStatuses = nil
+	}
+	out.WaitFor = (*WaitForStatus)(unsafe.Pointer(in.WaitFor))
+	return nil
+}
+
+// Convert_v1alpha1_CanaryStatus_To_v1beta1_CanaryStatus is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryStatus_To_v1beta1_CanaryStatus(in *v1alpha1.CanaryStatus, out *CanaryStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryStatus_To_v1beta1_CanaryStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_CanaryStep_To_v1alpha1_CanaryStep(in *CanaryStep, out *v1alpha1.CanaryStep, s conversion.Scope) error {
+	out.SetWeight = (*int32)(unsafe.Pointer(in.SetWeight))
+	out.Pause = (*v1alpha1.RolloutPause)(unsafe.Pointer(in.Pause))
+	if in.Experiment != nil {
+		in, out := &in.Experiment, &out.Experiment
+		*out = new(v1alpha1.RolloutExperimentStep)
+		if err := Convert_v1beta1_RolloutExperimentStep_To_v1alpha1_RolloutExperimentStep(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Experiment = nil
+	}
+	out.Analysis = (*v1alpha1.RolloutAnalysis)(unsafe.Pointer(in.Analysis))
+	out.SetCanaryScale = (*v1alpha1.SetCanaryScale)(unsafe.Pointer(in.SetCanaryScale))
+	out.SetHeaderRoute = (*v1alpha1.SetHeaderRoute)(unsafe.Pointer(in.SetHeaderRoute))
+	out.SetMirrorRoute = (*v1alpha1.SetMirrorRoute)(unsafe.Pointer(in.SetMirrorRoute))
+	out.Plugin = (*v1alpha1.PluginStep)(unsafe.Pointer(in.Plugin))
+	out.SetCanaryNodes = (*v1alpha1.SetCanaryNodes)(unsafe.Pointer(in.SetCanaryNodes))
+	if in.WaitFor != nil {
+		in, out := &in.WaitFor, &out.WaitFor
+		*out = new(v1alpha1.WaitForStep)
+		if err := Convert_v1beta1_WaitForStep_To_v1alpha1_WaitForStep(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.WaitFor = nil
+	}
+	// WARNING: in.Timeout requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	out.OnTimeout = v1alpha1.StepTimeoutPolicy(in.OnTimeout)
+	return nil
+}
+
+func autoConvert_v1alpha1_CanaryStep_To_v1beta1_CanaryStep(in *v1alpha1.CanaryStep, out *CanaryStep, s conversion.Scope) error {
+	out.SetWeight = (*int32)(unsafe.Pointer(in.SetWeight))
+	out.Pause = (*RolloutPause)(unsafe.Pointer(in.Pause))
+	if in.Experiment != nil {
+		in, out := &in.Experiment, &out.Experiment
+		*out = new(RolloutExperimentStep)
+		if err := Convert_v1alpha1_RolloutExperimentStep_To_v1beta1_RolloutExperimentStep(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Experiment = nil
+	}
+	out.Analysis = (*RolloutAnalysis)(unsafe.Pointer(in.Analysis))
+	out.SetCanaryScale = (*SetCanaryScale)(unsafe.Pointer(in.SetCanaryScale))
+	out.SetHeaderRoute = (*SetHeaderRoute)(unsafe.Pointer(in.SetHeaderRoute))
+	out.SetMirrorRoute = (*SetMirrorRoute)(unsafe.Pointer(in.SetMirrorRoute))
+	out.Plugin = (*PluginStep)(unsafe.Pointer(in.Plugin))
+	out.SetCanaryNodes = (*SetCanaryNodes)(unsafe.Pointer(in.SetCanaryNodes))
+	if in.WaitFor != nil {
+		in, out := &in.WaitFor, &out.WaitFor
+		*out = new(WaitForStep)
+		if err := Convert_v1alpha1_WaitForStep_To_v1beta1_WaitForStep(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.WaitFor = nil
+	}
+	// WARNING: in.Timeout requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.OnTimeout = StepTimeoutPolicy(in.OnTimeout)
+	return nil
+}
+
+func autoConvert_v1beta1_CanaryStrategy_To_v1alpha1_CanaryStrategy(in *CanaryStrategy, out *v1alpha1.CanaryStrategy, s conversion.Scope) error {
+	out.CanaryService = in.CanaryService
+	out.StableService = in.StableService
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]v1alpha1.CanaryStep, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_CanaryStep_To_v1alpha1_CanaryStep(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	out.TrafficRouting = (*v1alpha1.RolloutTrafficRouting)(unsafe.Pointer(in.TrafficRouting))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.Analysis = (*v1alpha1.RolloutAnalysisBackground)(unsafe.Pointer(in.Analysis))
+	out.AntiAffinity = (*v1alpha1.AntiAffinity)(unsafe.Pointer(in.AntiAffinity))
+	out.CanaryMetadata = (*v1alpha1.PodTemplateMetadata)(unsafe.Pointer(in.CanaryMetadata))
+	out.StableMetadata = (*v1alpha1.PodTemplateMetadata)(unsafe.Pointer(in.StableMetadata))
+	out.ScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.ScaleDownDelaySeconds))
+	out.ScaleDownDelayRevisionLimit = (*int32)(unsafe.Pointer(in.ScaleDownDelayRevisionLimit))
+	out.AbortScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.AbortScaleDownDelaySeconds))
+	out.DynamicStableScale = in.DynamicStableScale
+	out.PingPong = (*v1alpha1.PingPongSpec)(unsafe.Pointer(in.PingPong))
+	out.MinPodsPerReplicaSet = (*int32)(unsafe.Pointer(in.MinPodsPerReplicaSet))
+	out.DaemonSet = (*v1alpha1.DaemonSetCanaryStrategy)(unsafe.Pointer(in.DaemonSet))
+	if in.AbortPolicy != nil {
+		in, out := &in.AbortPolicy, &out.AbortPolicy
+		*out = new(v1alpha1.CanaryAbortPolicy)
+		if err := Convert_v1beta1_CanaryAbortPolicy_To_v1alpha1_CanaryAbortPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AbortPolicy = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_CanaryStrategy_To_v1alpha1_CanaryStrategy is an autogenerated conversion function.
+func Convert_v1beta1_CanaryStrategy_To_v1alpha1_CanaryStrategy(in *CanaryStrategy, out *v1alpha1.CanaryStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_CanaryStrategy_To_v1alpha1_CanaryStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_CanaryStrategy_To_v1beta1_CanaryStrategy(in *v1alpha1.CanaryStrategy, out *CanaryStrategy, s conversion.Scope) error {
+	out.CanaryService = in.CanaryService
+	out.StableService = in.StableService
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]CanaryStep, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_CanaryStep_To_v1beta1_CanaryStep(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Steps = nil
+	}
+	out.TrafficRouting = (*RolloutTrafficRouting)(unsafe.Pointer(in.TrafficRouting))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.Analysis = (*RolloutAnalysisBackground)(unsafe.Pointer(in.Analysis))
+	out.AntiAffinity = (*AntiAffinity)(unsafe.Pointer(in.AntiAffinity))
+	out.CanaryMetadata = (*PodTemplateMetadata)(unsafe.Pointer(in.CanaryMetadata))
+	out.StableMetadata = (*PodTemplateMetadata)(unsafe.Pointer(in.StableMetadata))
+	out.ScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.ScaleDownDelaySeconds))
+	out.ScaleDownDelayRevisionLimit = (*int32)(unsafe.Pointer(in.ScaleDownDelayRevisionLimit))
+	out.AbortScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.AbortScaleDownDelaySeconds))
+	out.DynamicStableScale = in.DynamicStableScale
+	out.PingPong = (*PingPongSpec)(unsafe.Pointer(in.PingPong))
+	out.MinPodsPerReplicaSet = (*int32)(unsafe.Pointer(in.MinPodsPerReplicaSet))
+	out.DaemonSet = (*DaemonSetCanaryStrategy)(unsafe.Pointer(in.DaemonSet))
+	if in.AbortPolicy != nil {
+		in, out := &in.AbortPolicy, &out.AbortPolicy
+		*out = new(CanaryAbortPolicy)
+		if err := Convert_v1alpha1_CanaryAbortPolicy_To_v1beta1_CanaryAbortPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AbortPolicy = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CanaryStrategy_To_v1beta1_CanaryStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_CanaryStrategy_To_v1beta1_CanaryStrategy(in *v1alpha1.CanaryStrategy, out *CanaryStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CanaryStrategy_To_v1beta1_CanaryStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_CloudWatchMetric_To_v1alpha1_CloudWatchMetric(in *CloudWatchMetric, out *v1alpha1.CloudWatchMetric, s conversion.Scope) error {
+	out.Interval = v1alpha1.DurationString(in.Interval)
+	out.MetricDataQueries = *(*[]v1alpha1.CloudWatchMetricDataQuery)(unsafe.Pointer(&in.MetricDataQueries))
+	return nil
+}
+
+// Convert_v1beta1_CloudWatchMetric_To_v1alpha1_CloudWatchMetric is an autogenerated conversion function.
+func Convert_v1beta1_CloudWatchMetric_To_v1alpha1_CloudWatchMetric(in *CloudWatchMetric, out *v1alpha1.CloudWatchMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudWatchMetric_To_v1alpha1_CloudWatchMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudWatchMetric_To_v1beta1_CloudWatchMetric(in *v1alpha1.CloudWatchMetric, out *CloudWatchMetric, s conversion.Scope) error {
+	out.Interval = DurationString(in.Interval)
+	out.MetricDataQueries = *(*[]CloudWatchMetricDataQuery)(unsafe.Pointer(&in.MetricDataQueries))
+	return nil
+}
+
+// Convert_v1alpha1_CloudWatchMetric_To_v1beta1_CloudWatchMetric is an autogenerated conversion function.
+func Convert_v1alpha1_CloudWatchMetric_To_v1beta1_CloudWatchMetric(in *v1alpha1.CloudWatchMetric, out *CloudWatchMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudWatchMetric_To_v1beta1_CloudWatchMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_CloudWatchMetricDataQuery_To_v1alpha1_CloudWatchMetricDataQuery(in *CloudWatchMetricDataQuery, out *v1alpha1.CloudWatchMetricDataQuery, s conversion.Scope) error {
+	out.Id = in.Id
+	out.Expression = (*string)(unsafe.Pointer(in.Expression))
+	out.Label = (*string)(unsafe.Pointer(in.Label))
+	out.MetricStat = (*v1alpha1.CloudWatchMetricStat)(unsafe.Pointer(in.MetricStat))
+	out.Period = (*intstr.IntOrString)(unsafe.Pointer(in.Period))
+	out.ReturnData = (*bool)(unsafe.Pointer(in.ReturnData))
+	return nil
+}
+
+// Convert_v1beta1_CloudWatchMetricDataQuery_To_v1alpha1_CloudWatchMetricDataQuery is an autogenerated conversion function.
+func Convert_v1beta1_CloudWatchMetricDataQuery_To_v1alpha1_CloudWatchMetricDataQuery(in *CloudWatchMetricDataQuery, out *v1alpha1.CloudWatchMetricDataQuery, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudWatchMetricDataQuery_To_v1alpha1_CloudWatchMetricDataQuery(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudWatchMetricDataQuery_To_v1beta1_CloudWatchMetricDataQuery(in *v1alpha1.CloudWatchMetricDataQuery, out *CloudWatchMetricDataQuery, s conversion.Scope) error {
+	out.Id = in.Id
+	out.Expression = (*string)(unsafe.Pointer(in.Expression))
+	out.Label = (*string)(unsafe.Pointer(in.Label))
+	out.MetricStat = (*CloudWatchMetricStat)(unsafe.Pointer(in.MetricStat))
+	out.Period = (*intstr.IntOrString)(unsafe.Pointer(in.Period))
+	out.ReturnData = (*bool)(unsafe.Pointer(in.ReturnData))
+	return nil
+}
+
+// Convert_v1alpha1_CloudWatchMetricDataQuery_To_v1beta1_CloudWatchMetricDataQuery is an autogenerated conversion function.
+func Convert_v1alpha1_CloudWatchMetricDataQuery_To_v1beta1_CloudWatchMetricDataQuery(in *v1alpha1.CloudWatchMetricDataQuery, out *CloudWatchMetricDataQuery, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudWatchMetricDataQuery_To_v1beta1_CloudWatchMetricDataQuery(in, out, s)
+}
+
+func autoConvert_v1beta1_CloudWatchMetricStat_To_v1alpha1_CloudWatchMetricStat(in *CloudWatchMetricStat, out *v1alpha1.CloudWatchMetricStat, s conversion.Scope) error {
+	if err := Convert_v1beta1_CloudWatchMetricStatMetric_To_v1alpha1_CloudWatchMetricStatMetric(&in.Metric, &out.Metric, s); err != nil {
+		return err
+	}
+	out.Period = in.Period
+	out.Stat = in.Stat
+	out.Unit = in.Unit
+	return nil
+}
+
+// Convert_v1beta1_CloudWatchMetricStat_To_v1alpha1_CloudWatchMetricStat is an autogenerated conversion function.
+func Convert_v1beta1_CloudWatchMetricStat_To_v1alpha1_CloudWatchMetricStat(in *CloudWatchMetricStat, out *v1alpha1.CloudWatchMetricStat, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudWatchMetricStat_To_v1alpha1_CloudWatchMetricStat(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudWatchMetricStat_To_v1beta1_CloudWatchMetricStat(in *v1alpha1.CloudWatchMetricStat, out *CloudWatchMetricStat, s conversion.Scope) error {
+	if err := Convert_v1alpha1_CloudWatchMetricStatMetric_To_v1beta1_CloudWatchMetricStatMetric(&in.Metric, &out.Metric, s); err != nil {
+		return err
+	}
+	out.Period = in.Period
+	out.Stat = in.Stat
+	out.Unit = in.Unit
+	return nil
+}
+
+// Convert_v1alpha1_CloudWatchMetricStat_To_v1beta1_CloudWatchMetricStat is an autogenerated conversion function.
+func Convert_v1alpha1_CloudWatchMetricStat_To_v1beta1_CloudWatchMetricStat(in *v1alpha1.CloudWatchMetricStat, out *CloudWatchMetricStat, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudWatchMetricStat_To_v1beta1_CloudWatchMetricStat(in, out, s)
+}
+
+func autoConvert_v1beta1_CloudWatchMetricStatMetric_To_v1alpha1_CloudWatchMetricStatMetric(in *CloudWatchMetricStatMetric, out *v1alpha1.CloudWatchMetricStatMetric, s conversion.Scope) error {
+	out.Dimensions = *(*[]v1alpha1.CloudWatchMetricStatMetricDimension)(unsafe.Pointer(&in.Dimensions))
+	out.MetricName = in.MetricName
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	return nil
+}
+
+// Convert_v1beta1_CloudWatchMetricStatMetric_To_v1alpha1_CloudWatchMetricStatMetric is an autogenerated conversion function.
+func Convert_v1beta1_CloudWatchMetricStatMetric_To_v1alpha1_CloudWatchMetricStatMetric(in *CloudWatchMetricStatMetric, out *v1alpha1.CloudWatchMetricStatMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudWatchMetricStatMetric_To_v1alpha1_CloudWatchMetricStatMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudWatchMetricStatMetric_To_v1beta1_CloudWatchMetricStatMetric(in *v1alpha1.CloudWatchMetricStatMetric, out *CloudWatchMetricStatMetric, s conversion.Scope) error {
+	out.Dimensions = *(*[]CloudWatchMetricStatMetricDimension)(unsafe.Pointer(&in.Dimensions))
+	out.MetricName = in.MetricName
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	return nil
+}
+
+// Convert_v1alpha1_CloudWatchMetricStatMetric_To_v1beta1_CloudWatchMetricStatMetric is an autogenerated conversion function.
+func Convert_v1alpha1_CloudWatchMetricStatMetric_To_v1beta1_CloudWatchMetricStatMetric(in *v1alpha1.CloudWatchMetricStatMetric, out *CloudWatchMetricStatMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudWatchMetricStatMetric_To_v1beta1_CloudWatchMetricStatMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_CloudWatchMetricStatMetricDimension_To_v1alpha1_CloudWatchMetricStatMetricDimension(in *CloudWatchMetricStatMetricDimension, out *v1alpha1.CloudWatchMetricStatMetricDimension, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta1_CloudWatchMetricStatMetricDimension_To_v1alpha1_CloudWatchMetricStatMetricDimension is an autogenerated conversion function.
+func Convert_v1beta1_CloudWatchMetricStatMetricDimension_To_v1alpha1_CloudWatchMetricStatMetricDimension(in *CloudWatchMetricStatMetricDimension, out *v1alpha1.CloudWatchMetricStatMetricDimension, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudWatchMetricStatMetricDimension_To_v1alpha1_CloudWatchMetricStatMetricDimension(in, out, s)
+}
+
+func autoConvert_v1alpha1_CloudWatchMetricStatMetricDimension_To_v1beta1_CloudWatchMetricStatMetricDimension(in *v1alpha1.CloudWatchMetricStatMetricDimension, out *CloudWatchMetricStatMetricDimension, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1alpha1_CloudWatchMetricStatMetricDimension_To_v1beta1_CloudWatchMetricStatMetricDimension is an autogenerated conversion function.
+func Convert_v1alpha1_CloudWatchMetricStatMetricDimension_To_v1beta1_CloudWatchMetricStatMetricDimension(in *v1alpha1.CloudWatchMetricStatMetricDimension, out *CloudWatchMetricStatMetricDimension, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudWatchMetricStatMetricDimension_To_v1beta1_CloudWatchMetricStatMetricDimension(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterAnalysisTemplate_To_v1alpha1_ClusterAnalysisTemplate(in *ClusterAnalysisTemplate, out *v1alpha1.ClusterAnalysisTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_AnalysisTemplateSpec_To_v1alpha1_AnalysisTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ClusterAnalysisTemplate_To_v1alpha1_ClusterAnalysisTemplate is an autogenerated conversion function.
+func Convert_v1beta1_ClusterAnalysisTemplate_To_v1alpha1_ClusterAnalysisTemplate(in *ClusterAnalysisTemplate, out *v1alpha1.ClusterAnalysisTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterAnalysisTemplate_To_v1alpha1_ClusterAnalysisTemplate(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterAnalysisTemplate_To_v1beta1_ClusterAnalysisTemplate(in *v1alpha1.ClusterAnalysisTemplate, out *ClusterAnalysisTemplate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_AnalysisTemplateSpec_To_v1beta1_AnalysisTemplateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterAnalysisTemplate_To_v1beta1_ClusterAnalysisTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterAnalysisTemplate_To_v1beta1_ClusterAnalysisTemplate(in *v1alpha1.ClusterAnalysisTemplate, out *ClusterAnalysisTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterAnalysisTemplate_To_v1beta1_ClusterAnalysisTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_ClusterAnalysisTemplateList_To_v1alpha1_ClusterAnalysisTemplateList(in *ClusterAnalysisTemplateList, out *v1alpha1.ClusterAnalysisTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha1.ClusterAnalysisTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ClusterAnalysisTemplateList_To_v1alpha1_ClusterAnalysisTemplateList is an autogenerated conversion function.
+func Convert_v1beta1_ClusterAnalysisTemplateList_To_v1alpha1_ClusterAnalysisTemplateList(in *ClusterAnalysisTemplateList, out *v1alpha1.ClusterAnalysisTemplateList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ClusterAnalysisTemplateList_To_v1alpha1_ClusterAnalysisTemplateList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterAnalysisTemplateList_To_v1beta1_ClusterAnalysisTemplateList(in *v1alpha1.ClusterAnalysisTemplateList, out *ClusterAnalysisTemplateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterAnalysisTemplate)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterAnalysisTemplateList_To_v1beta1_ClusterAnalysisTemplateList is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterAnalysisTemplateList_To_v1beta1_ClusterAnalysisTemplateList(in *v1alpha1.ClusterAnalysisTemplateList, out *ClusterAnalysisTemplateList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterAnalysisTemplateList_To_v1beta1_ClusterAnalysisTemplateList(in, out, s)
+}
+
+func autoConvert_v1beta1_ConfigRef_To_v1alpha1_ConfigRef(in *ConfigRef, out *v1alpha1.ConfigRef, s conversion.Scope) error {
+	out.Kind = v1alpha1.ConfigRefKind(in.Kind)
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_ConfigRef_To_v1alpha1_ConfigRef is an autogenerated conversion function.
+func Convert_v1beta1_ConfigRef_To_v1alpha1_ConfigRef(in *ConfigRef, out *v1alpha1.ConfigRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_ConfigRef_To_v1alpha1_ConfigRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_ConfigRef_To_v1beta1_ConfigRef(in *v1alpha1.ConfigRef, out *ConfigRef, s conversion.Scope) error {
+	out.Kind = ConfigRefKind(in.Kind)
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ConfigRef_To_v1beta1_ConfigRef is an autogenerated conversion function.
+func Convert_v1alpha1_ConfigRef_To_v1beta1_ConfigRef(in *v1alpha1.ConfigRef, out *ConfigRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConfigRef_To_v1beta1_ConfigRef(in, out, s)
+}
+
+func autoConvert_v1beta1_ContourTrafficRouting_To_v1alpha1_ContourTrafficRouting(in *ContourTrafficRouting, out *v1alpha1.ContourTrafficRouting, s conversion.Scope) error {
+	out.HTTPProxies = *(*[]string)(unsafe.Pointer(&in.HTTPProxies))
+	return nil
+}
+
+// Convert_v1beta1_ContourTrafficRouting_To_v1alpha1_ContourTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_ContourTrafficRouting_To_v1alpha1_ContourTrafficRouting(in *ContourTrafficRouting, out *v1alpha1.ContourTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContourTrafficRouting_To_v1alpha1_ContourTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_ContourTrafficRouting_To_v1beta1_ContourTrafficRouting(in *v1alpha1.ContourTrafficRouting, out *ContourTrafficRouting, s conversion.Scope) error {
+	out.HTTPProxies = *(*[]string)(unsafe.Pointer(&in.HTTPProxies))
+	return nil
+}
+
+// Convert_v1alpha1_ContourTrafficRouting_To_v1beta1_ContourTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_ContourTrafficRouting_To_v1beta1_ContourTrafficRouting(in *v1alpha1.ContourTrafficRouting, out *ContourTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContourTrafficRouting_To_v1beta1_ContourTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_DNSTrafficRouting_To_v1alpha1_DNSTrafficRouting(in *DNSTrafficRouting, out *v1alpha1.DNSTrafficRouting, s conversion.Scope) error {
+	out.DNSEndpoint = in.DNSEndpoint
+	out.StableSetIdentifier = in.StableSetIdentifier
+	out.CanarySetIdentifier = in.CanarySetIdentifier
+	out.WeightProperty = in.WeightProperty
+	out.TTLSeconds = (*int32)(unsafe.Pointer(in.TTLSeconds))
+	return nil
+}
+
+// Convert_v1beta1_DNSTrafficRouting_To_v1alpha1_DNSTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_DNSTrafficRouting_To_v1alpha1_DNSTrafficRouting(in *DNSTrafficRouting, out *v1alpha1.DNSTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSTrafficRouting_To_v1alpha1_DNSTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSTrafficRouting_To_v1beta1_DNSTrafficRouting(in *v1alpha1.DNSTrafficRouting, out *DNSTrafficRouting, s conversion.Scope) error {
+	out.DNSEndpoint = in.DNSEndpoint
+	out.StableSetIdentifier = in.StableSetIdentifier
+	out.CanarySetIdentifier = in.CanarySetIdentifier
+	out.WeightProperty = in.WeightProperty
+	out.TTLSeconds = (*int32)(unsafe.Pointer(in.TTLSeconds))
+	return nil
+}
+
+// Convert_v1alpha1_DNSTrafficRouting_To_v1beta1_DNSTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_DNSTrafficRouting_To_v1beta1_DNSTrafficRouting(in *v1alpha1.DNSTrafficRouting, out *DNSTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSTrafficRouting_To_v1beta1_DNSTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_DaemonSetCanaryStrategy_To_v1alpha1_DaemonSetCanaryStrategy(in *DaemonSetCanaryStrategy, out *v1alpha1.DaemonSetCanaryStrategy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_DaemonSetCanaryStrategy_To_v1alpha1_DaemonSetCanaryStrategy is an autogenerated conversion function.
+func Convert_v1beta1_DaemonSetCanaryStrategy_To_v1alpha1_DaemonSetCanaryStrategy(in *DaemonSetCanaryStrategy, out *v1alpha1.DaemonSetCanaryStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_DaemonSetCanaryStrategy_To_v1alpha1_DaemonSetCanaryStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_DaemonSetCanaryStrategy_To_v1beta1_DaemonSetCanaryStrategy(in *v1alpha1.DaemonSetCanaryStrategy, out *DaemonSetCanaryStrategy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_DaemonSetCanaryStrategy_To_v1beta1_DaemonSetCanaryStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_DaemonSetCanaryStrategy_To_v1beta1_DaemonSetCanaryStrategy(in *v1alpha1.DaemonSetCanaryStrategy, out *DaemonSetCanaryStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DaemonSetCanaryStrategy_To_v1beta1_DaemonSetCanaryStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_DatadogMetric_To_v1alpha1_DatadogMetric(in *DatadogMetric, out *v1alpha1.DatadogMetric, s conversion.Scope) error {
+	out.Interval = v1alpha1.DurationString(in.Interval)
+	out.Query = in.Query
+	out.Queries = *(*map[string]string)(unsafe.Pointer(&in.Queries))
+	out.Formula = in.Formula
+	out.ApiVersion = in.ApiVersion
+	out.Aggregator = in.Aggregator
+	if err := Convert_v1beta1_SecretRef_To_v1alpha1_SecretRef(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_DatadogMetric_To_v1alpha1_DatadogMetric is an autogenerated conversion function.
+func Convert_v1beta1_DatadogMetric_To_v1alpha1_DatadogMetric(in *DatadogMetric, out *v1alpha1.DatadogMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_DatadogMetric_To_v1alpha1_DatadogMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_DatadogMetric_To_v1beta1_DatadogMetric(in *v1alpha1.DatadogMetric, out *DatadogMetric, s conversion.Scope) error {
+	out.Interval = DurationString(in.Interval)
+	out.Query = in.Query
+	out.Queries = *(*map[string]string)(unsafe.Pointer(&in.Queries))
+	out.Formula = in.Formula
+	out.ApiVersion = in.ApiVersion
+	out.Aggregator = in.Aggregator
+	if err := Convert_v1alpha1_SecretRef_To_v1beta1_SecretRef(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DatadogMetric_To_v1beta1_DatadogMetric is an autogenerated conversion function.
+func Convert_v1alpha1_DatadogMetric_To_v1beta1_DatadogMetric(in *v1alpha1.DatadogMetric, out *DatadogMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DatadogMetric_To_v1beta1_DatadogMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_DryRun_To_v1alpha1_DryRun(in *DryRun, out *v1alpha1.DryRun, s conversion.Scope) error {
+	out.MetricName = in.MetricName
+	return nil
+}
+
+// Convert_v1beta1_DryRun_To_v1alpha1_DryRun is an autogenerated conversion function.
+func Convert_v1beta1_DryRun_To_v1alpha1_DryRun(in *DryRun, out *v1alpha1.DryRun, s conversion.Scope) error {
+	return autoConvert_v1beta1_DryRun_To_v1alpha1_DryRun(in, out, s)
+}
+
+func autoConvert_v1alpha1_DryRun_To_v1beta1_DryRun(in *v1alpha1.DryRun, out *DryRun, s conversion.Scope) error {
+	out.MetricName = in.MetricName
+	return nil
+}
+
+// Convert_v1alpha1_DryRun_To_v1beta1_DryRun is an autogenerated conversion function.
+func Convert_v1alpha1_DryRun_To_v1beta1_DryRun(in *v1alpha1.DryRun, out *DryRun, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DryRun_To_v1beta1_DryRun(in, out, s)
+}
+
+func autoConvert_v1beta1_Experiment_To_v1alpha1_Experiment(in *Experiment, out *v1alpha1.Experiment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Experiment_To_v1beta1_Experiment(in *v1alpha1.Experiment, out *Experiment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ExperimentSpec_To_v1beta1_ExperimentSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ExperimentStatus_To_v1beta1_ExperimentStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_ExperimentAnalysisRunStatus_To_v1alpha1_ExperimentAnalysisRunStatus(in *ExperimentAnalysisRunStatus, out *v1alpha1.ExperimentAnalysisRunStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.AnalysisRun = in.AnalysisRun
+	out.Phase = v1alpha1.AnalysisPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_ExperimentAnalysisRunStatus_To_v1alpha1_ExperimentAnalysisRunStatus is an autogenerated conversion function.
+func Convert_v1beta1_ExperimentAnalysisRunStatus_To_v1alpha1_ExperimentAnalysisRunStatus(in *ExperimentAnalysisRunStatus, out *v1alpha1.ExperimentAnalysisRunStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExperimentAnalysisRunStatus_To_v1alpha1_ExperimentAnalysisRunStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExperimentAnalysisRunStatus_To_v1beta1_ExperimentAnalysisRunStatus(in *v1alpha1.ExperimentAnalysisRunStatus, out *ExperimentAnalysisRunStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.AnalysisRun = in.AnalysisRun
+	out.Phase = AnalysisPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_ExperimentAnalysisRunStatus_To_v1beta1_ExperimentAnalysisRunStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ExperimentAnalysisRunStatus_To_v1beta1_ExperimentAnalysisRunStatus(in *v1alpha1.ExperimentAnalysisRunStatus, out *ExperimentAnalysisRunStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExperimentAnalysisRunStatus_To_v1beta1_ExperimentAnalysisRunStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ExperimentAnalysisTemplateRef_To_v1alpha1_ExperimentAnalysisTemplateRef(in *ExperimentAnalysisTemplateRef, out *v1alpha1.ExperimentAnalysisTemplateRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.TemplateName = in.TemplateName
+	out.ClusterScope = in.ClusterScope
+	out.Args = *(*[]v1alpha1.Argument)(unsafe.Pointer(&in.Args))
+	out.RequiredForCompletion = in.RequiredForCompletion
+	return nil
+}
+
+// Convert_v1beta1_ExperimentAnalysisTemplateRef_To_v1alpha1_ExperimentAnalysisTemplateRef is an autogenerated conversion function.
+func Convert_v1beta1_ExperimentAnalysisTemplateRef_To_v1alpha1_ExperimentAnalysisTemplateRef(in *ExperimentAnalysisTemplateRef, out *v1alpha1.ExperimentAnalysisTemplateRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExperimentAnalysisTemplateRef_To_v1alpha1_ExperimentAnalysisTemplateRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExperimentAnalysisTemplateRef_To_v1beta1_ExperimentAnalysisTemplateRef(in *v1alpha1.ExperimentAnalysisTemplateRef, out *ExperimentAnalysisTemplateRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.TemplateName = in.TemplateName
+	out.ClusterScope = in.ClusterScope
+	out.Args = *(*[]Argument)(unsafe.Pointer(&in.Args))
+	out.RequiredForCompletion = in.RequiredForCompletion
+	return nil
+}
+
+// Convert_v1alpha1_ExperimentAnalysisTemplateRef_To_v1beta1_ExperimentAnalysisTemplateRef is an autogenerated conversion function.
+func Convert_v1alpha1_ExperimentAnalysisTemplateRef_To_v1beta1_ExperimentAnalysisTemplateRef(in *v1alpha1.ExperimentAnalysisTemplateRef, out *ExperimentAnalysisTemplateRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExperimentAnalysisTemplateRef_To_v1beta1_ExperimentAnalysisTemplateRef(in, out, s)
+}
+
+func autoConvert_v1beta1_ExperimentCondition_To_v1alpha1_ExperimentCondition(in *ExperimentCondition, out *v1alpha1.ExperimentCondition, s conversion.Scope) error {
+	out.Type = v1alpha1.ExperimentConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_ExperimentCondition_To_v1alpha1_ExperimentCondition is an autogenerated conversion function.
+func Convert_v1beta1_ExperimentCondition_To_v1alpha1_ExperimentCondition(in *ExperimentCondition, out *v1alpha1.ExperimentCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExperimentCondition_To_v1alpha1_ExperimentCondition(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExperimentCondition_To_v1beta1_ExperimentCondition(in *v1alpha1.ExperimentCondition, out *ExperimentCondition, s conversion.Scope) error {
+	out.Type = ExperimentConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_ExperimentCondition_To_v1beta1_ExperimentCondition is an autogenerated conversion function.
+func Convert_v1alpha1_ExperimentCondition_To_v1beta1_ExperimentCondition(in *v1alpha1.ExperimentCondition, out *ExperimentCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExperimentCondition_To_v1beta1_ExperimentCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_ExperimentList_To_v1alpha1_ExperimentList(in *ExperimentList, out *v1alpha1.ExperimentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.Experiment, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Experiment_To_v1alpha1_Experiment(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ExperimentList_To_v1alpha1_ExperimentList is an autogenerated conversion function.
+func Convert_v1beta1_ExperimentList_To_v1alpha1_ExperimentList(in *ExperimentList, out *v1alpha1.ExperimentList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExperimentList_To_v1alpha1_ExperimentList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExperimentList_To_v1beta1_ExperimentList(in *v1alpha1.ExperimentList, out *ExperimentList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Experiment, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Experiment_To_v1beta1_Experiment(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ExperimentList_To_v1beta1_ExperimentList is an autogenerated conversion function.
+func Convert_v1alpha1_ExperimentList_To_v1beta1_ExperimentList(in *v1alpha1.ExperimentList, out *ExperimentList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExperimentList_To_v1beta1_ExperimentList(in, out, s)
+}
+
+func autoConvert_v1beta1_ExperimentSpec_To_v1alpha1_ExperimentSpec(in *ExperimentSpec, out *v1alpha1.ExperimentSpec, s conversion.Scope) error {
+	out.Templates = *(*[]v1alpha1.TemplateSpec)(unsafe.Pointer(&in.Templates))
+	// WARNING: in.Duration requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	out.Terminate = in.Terminate
+	out.Analyses = *(*[]v1alpha1.ExperimentAnalysisTemplateRef)(unsafe.Pointer(&in.Analyses))
+	out.ScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.ScaleDownDelaySeconds))
+	out.DryRun = *(*[]v1alpha1.DryRun)(unsafe.Pointer(&in.DryRun))
+	out.MeasurementRetention = *(*[]v1alpha1.MeasurementRetention)(unsafe.Pointer(&in.MeasurementRetention))
+	if err := Convert_v1beta1_AnalysisRunMetadata_To_v1alpha1_AnalysisRunMetadata(&in.AnalysisRunMetadata, &out.AnalysisRunMetadata, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_ExperimentSpec_To_v1beta1_ExperimentSpec(in *v1alpha1.ExperimentSpec, out *ExperimentSpec, s conversion.Scope) error {
+	out.Templates = *(*[]TemplateSpec)(unsafe.Pointer(&in.Templates))
+	// WARNING: in.Duration requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	out.Terminate = in.Terminate
+	out.Analyses = *(*[]ExperimentAnalysisTemplateRef)(unsafe.Pointer(&in.Analyses))
+	out.ScaleDownDelaySeconds = (*int32)(unsafe.Pointer(in.ScaleDownDelaySeconds))
+	out.DryRun = *(*[]DryRun)(unsafe.Pointer(&in.DryRun))
+	out.MeasurementRetention = *(*[]MeasurementRetention)(unsafe.Pointer(&in.MeasurementRetention))
+	if err := Convert_v1alpha1_AnalysisRunMetadata_To_v1beta1_AnalysisRunMetadata(&in.AnalysisRunMetadata, &out.AnalysisRunMetadata, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(in *ExperimentStatus, out *v1alpha1.ExperimentStatus, s conversion.Scope) error {
+	out.Phase = v1alpha1.AnalysisPhase(in.Phase)
+	out.Message = in.Message
+	out.TemplateStatuses = *(*[]v1alpha1.TemplateStatus)(unsafe.Pointer(&in.TemplateStatuses))
+	out.AvailableAt = (*v1.Time)(unsafe.Pointer(in.AvailableAt))
+	out.Conditions = *(*[]v1alpha1.ExperimentCondition)(unsafe.Pointer(&in.Conditions))
+	out.AnalysisRuns = *(*[]v1alpha1.ExperimentAnalysisRunStatus)(unsafe.Pointer(&in.AnalysisRuns))
+	return nil
+}
+
+// Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus is an autogenerated conversion function.
+func Convert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(in *ExperimentStatus, out *v1alpha1.ExperimentStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExperimentStatus_To_v1alpha1_ExperimentStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExperimentStatus_To_v1beta1_ExperimentStatus(in *v1alpha1.ExperimentStatus, out *ExperimentStatus, s conversion.Scope) error {
+	out.Phase = AnalysisPhase(in.Phase)
+	out.Message = in.Message
+	out.TemplateStatuses = *(*[]TemplateStatus)(unsafe.Pointer(&in.TemplateStatuses))
+	out.AvailableAt = (*v1.Time)(unsafe.Pointer(in.AvailableAt))
+	out.Conditions = *(*[]ExperimentCondition)(unsafe.Pointer(&in.Conditions))
+	out.AnalysisRuns = *(*[]ExperimentAnalysisRunStatus)(unsafe.Pointer(&in.AnalysisRuns))
+	return nil
+}
+
+// Convert_v1alpha1_ExperimentStatus_To_v1beta1_ExperimentStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ExperimentStatus_To_v1beta1_ExperimentStatus(in *v1alpha1.ExperimentStatus, out *ExperimentStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExperimentStatus_To_v1beta1_ExperimentStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_FieldRef_To_v1alpha1_FieldRef(in *FieldRef, out *v1alpha1.FieldRef, s conversion.Scope) error {
+	out.FieldPath = in.FieldPath
+	return nil
+}
+
+// Convert_v1beta1_FieldRef_To_v1alpha1_FieldRef is an autogenerated conversion function.
+func Convert_v1beta1_FieldRef_To_v1alpha1_FieldRef(in *FieldRef, out *v1alpha1.FieldRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_FieldRef_To_v1alpha1_FieldRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_FieldRef_To_v1beta1_FieldRef(in *v1alpha1.FieldRef, out *FieldRef, s conversion.Scope) error {
+	out.FieldPath = in.FieldPath
+	return nil
+}
+
+// Convert_v1alpha1_FieldRef_To_v1beta1_FieldRef is an autogenerated conversion function.
+func Convert_v1alpha1_FieldRef_To_v1beta1_FieldRef(in *v1alpha1.FieldRef, out *FieldRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FieldRef_To_v1beta1_FieldRef(in, out, s)
+}
+
+func autoConvert_v1beta1_GraphiteMetric_To_v1alpha1_GraphiteMetric(in *GraphiteMetric, out *v1alpha1.GraphiteMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1beta1_GraphiteMetric_To_v1alpha1_GraphiteMetric is an autogenerated conversion function.
+func Convert_v1beta1_GraphiteMetric_To_v1alpha1_GraphiteMetric(in *GraphiteMetric, out *v1alpha1.GraphiteMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_GraphiteMetric_To_v1alpha1_GraphiteMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_GraphiteMetric_To_v1beta1_GraphiteMetric(in *v1alpha1.GraphiteMetric, out *GraphiteMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1alpha1_GraphiteMetric_To_v1beta1_GraphiteMetric is an autogenerated conversion function.
+func Convert_v1alpha1_GraphiteMetric_To_v1beta1_GraphiteMetric(in *v1alpha1.GraphiteMetric, out *GraphiteMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GraphiteMetric_To_v1beta1_GraphiteMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_HeaderRoutingMatch_To_v1alpha1_HeaderRoutingMatch(in *HeaderRoutingMatch, out *v1alpha1.HeaderRoutingMatch, s conversion.Scope) error {
+	out.HeaderName = in.HeaderName
+	out.HeaderValue = (*v1alpha1.StringMatch)(unsafe.Pointer(in.HeaderValue))
+	return nil
+}
+
+// Convert_v1beta1_HeaderRoutingMatch_To_v1alpha1_HeaderRoutingMatch is an autogenerated conversion function.
+func Convert_v1beta1_HeaderRoutingMatch_To_v1alpha1_HeaderRoutingMatch(in *HeaderRoutingMatch, out *v1alpha1.HeaderRoutingMatch, s conversion.Scope) error {
+	return autoConvert_v1beta1_HeaderRoutingMatch_To_v1alpha1_HeaderRoutingMatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_HeaderRoutingMatch_To_v1beta1_HeaderRoutingMatch(in *v1alpha1.HeaderRoutingMatch, out *HeaderRoutingMatch, s conversion.Scope) error {
+	out.HeaderName = in.HeaderName
+	out.HeaderValue = (*StringMatch)(unsafe.Pointer(in.HeaderValue))
+	return nil
+}
+
+// Convert_v1alpha1_HeaderRoutingMatch_To_v1beta1_HeaderRoutingMatch is an autogenerated conversion function.
+func Convert_v1alpha1_HeaderRoutingMatch_To_v1beta1_HeaderRoutingMatch(in *v1alpha1.HeaderRoutingMatch, out *HeaderRoutingMatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HeaderRoutingMatch_To_v1beta1_HeaderRoutingMatch(in, out, s)
+}
+
+func autoConvert_v1beta1_InfluxdbMetric_To_v1alpha1_InfluxdbMetric(in *InfluxdbMetric, out *v1alpha1.InfluxdbMetric, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1beta1_InfluxdbMetric_To_v1alpha1_InfluxdbMetric is an autogenerated conversion function.
+func Convert_v1beta1_InfluxdbMetric_To_v1alpha1_InfluxdbMetric(in *InfluxdbMetric, out *v1alpha1.InfluxdbMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_InfluxdbMetric_To_v1alpha1_InfluxdbMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_InfluxdbMetric_To_v1beta1_InfluxdbMetric(in *v1alpha1.InfluxdbMetric, out *InfluxdbMetric, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1alpha1_InfluxdbMetric_To_v1beta1_InfluxdbMetric is an autogenerated conversion function.
+func Convert_v1alpha1_InfluxdbMetric_To_v1beta1_InfluxdbMetric(in *v1alpha1.InfluxdbMetric, out *InfluxdbMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InfluxdbMetric_To_v1beta1_InfluxdbMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_IstioDestinationRule_To_v1alpha1_IstioDestinationRule(in *IstioDestinationRule, out *v1alpha1.IstioDestinationRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CanarySubsetName = in.CanarySubsetName
+	out.StableSubsetName = in.StableSubsetName
+	return nil
+}
+
+// Convert_v1beta1_IstioDestinationRule_To_v1alpha1_IstioDestinationRule is an autogenerated conversion function.
+func Convert_v1beta1_IstioDestinationRule_To_v1alpha1_IstioDestinationRule(in *IstioDestinationRule, out *v1alpha1.IstioDestinationRule, s conversion.Scope) error {
+	return autoConvert_v1beta1_IstioDestinationRule_To_v1alpha1_IstioDestinationRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_IstioDestinationRule_To_v1beta1_IstioDestinationRule(in *v1alpha1.IstioDestinationRule, out *IstioDestinationRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CanarySubsetName = in.CanarySubsetName
+	out.StableSubsetName = in.StableSubsetName
+	return nil
+}
+
+// Convert_v1alpha1_IstioDestinationRule_To_v1beta1_IstioDestinationRule is an autogenerated conversion function.
+func Convert_v1alpha1_IstioDestinationRule_To_v1beta1_IstioDestinationRule(in *v1alpha1.IstioDestinationRule, out *IstioDestinationRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IstioDestinationRule_To_v1beta1_IstioDestinationRule(in, out, s)
+}
+
+func autoConvert_v1beta1_IstioLocality_To_v1alpha1_IstioLocality(in *IstioLocality, out *v1alpha1.IstioLocality, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MinWeight = in.MinWeight
+	return nil
+}
+
+// Convert_v1beta1_IstioLocality_To_v1alpha1_IstioLocality is an autogenerated conversion function.
+func Convert_v1beta1_IstioLocality_To_v1alpha1_IstioLocality(in *IstioLocality, out *v1alpha1.IstioLocality, s conversion.Scope) error {
+	return autoConvert_v1beta1_IstioLocality_To_v1alpha1_IstioLocality(in, out, s)
+}
+
+func autoConvert_v1alpha1_IstioLocality_To_v1beta1_IstioLocality(in *v1alpha1.IstioLocality, out *IstioLocality, s conversion.Scope) error {
+	out.Name = in.Name
+	out.MinWeight = in.MinWeight
+	return nil
+}
+
+// Convert_v1alpha1_IstioLocality_To_v1beta1_IstioLocality is an autogenerated conversion function.
+func Convert_v1alpha1_IstioLocality_To_v1beta1_IstioLocality(in *v1alpha1.IstioLocality, out *IstioLocality, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IstioLocality_To_v1beta1_IstioLocality(in, out, s)
+}
+
+func autoConvert_v1beta1_IstioTrafficRouting_To_v1alpha1_IstioTrafficRouting(in *IstioTrafficRouting, out *v1alpha1.IstioTrafficRouting, s conversion.Scope) error {
+	out.VirtualService = (*v1alpha1.IstioVirtualService)(unsafe.Pointer(in.VirtualService))
+	out.DestinationRule = (*v1alpha1.IstioDestinationRule)(unsafe.Pointer(in.DestinationRule))
+	out.VirtualServices = *(*[]v1alpha1.IstioVirtualService)(unsafe.Pointer(&in.VirtualServices))
+	out.Localities = *(*[]v1alpha1.IstioLocality)(unsafe.Pointer(&in.Localities))
+	return nil
+}
+
+// Convert_v1beta1_IstioTrafficRouting_To_v1alpha1_IstioTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_IstioTrafficRouting_To_v1alpha1_IstioTrafficRouting(in *IstioTrafficRouting, out *v1alpha1.IstioTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_IstioTrafficRouting_To_v1alpha1_IstioTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_IstioTrafficRouting_To_v1beta1_IstioTrafficRouting(in *v1alpha1.IstioTrafficRouting, out *IstioTrafficRouting, s conversion.Scope) error {
+	out.VirtualService = (*IstioVirtualService)(unsafe.Pointer(in.VirtualService))
+	out.DestinationRule = (*IstioDestinationRule)(unsafe.Pointer(in.DestinationRule))
+	out.VirtualServices = *(*[]IstioVirtualService)(unsafe.Pointer(&in.VirtualServices))
+	out.Localities = *(*[]IstioLocality)(unsafe.Pointer(&in.Localities))
+	return nil
+}
+
+// Convert_v1alpha1_IstioTrafficRouting_To_v1beta1_IstioTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_IstioTrafficRouting_To_v1beta1_IstioTrafficRouting(in *v1alpha1.IstioTrafficRouting, out *IstioTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IstioTrafficRouting_To_v1beta1_IstioTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_IstioVirtualService_To_v1alpha1_IstioVirtualService(in *IstioVirtualService, out *v1alpha1.IstioVirtualService, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
+	out.TLSRoutes = *(*[]v1alpha1.TLSRoute)(unsafe.Pointer(&in.TLSRoutes))
+	out.TCPRoutes = *(*[]v1alpha1.TCPRoute)(unsafe.Pointer(&in.TCPRoutes))
+	return nil
+}
+
+// Convert_v1beta1_IstioVirtualService_To_v1alpha1_IstioVirtualService is an autogenerated conversion function.
+func Convert_v1beta1_IstioVirtualService_To_v1alpha1_IstioVirtualService(in *IstioVirtualService, out *v1alpha1.IstioVirtualService, s conversion.Scope) error {
+	return autoConvert_v1beta1_IstioVirtualService_To_v1alpha1_IstioVirtualService(in, out, s)
+}
+
+func autoConvert_v1alpha1_IstioVirtualService_To_v1beta1_IstioVirtualService(in *v1alpha1.IstioVirtualService, out *IstioVirtualService, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
+	out.TLSRoutes = *(*[]TLSRoute)(unsafe.Pointer(&in.TLSRoutes))
+	out.TCPRoutes = *(*[]TCPRoute)(unsafe.Pointer(&in.TCPRoutes))
+	return nil
+}
+
+// Convert_v1alpha1_IstioVirtualService_To_v1beta1_IstioVirtualService is an autogenerated conversion function.
+func Convert_v1alpha1_IstioVirtualService_To_v1beta1_IstioVirtualService(in *v1alpha1.IstioVirtualService, out *IstioVirtualService, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IstioVirtualService_To_v1beta1_IstioVirtualService(in, out, s)
+}
+
+func autoConvert_v1beta1_JobMetric_To_v1alpha1_JobMetric(in *JobMetric, out *v1alpha1.JobMetric, s conversion.Scope) error {
+	out.Metadata = in.Metadata
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1beta1_JobMetric_To_v1alpha1_JobMetric is an autogenerated conversion function.
+func Convert_v1beta1_JobMetric_To_v1alpha1_JobMetric(in *JobMetric, out *v1alpha1.JobMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_JobMetric_To_v1alpha1_JobMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_JobMetric_To_v1beta1_JobMetric(in *v1alpha1.JobMetric, out *JobMetric, s conversion.Scope) error {
+	out.Metadata = in.Metadata
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1alpha1_JobMetric_To_v1beta1_JobMetric is an autogenerated conversion function.
+func Convert_v1alpha1_JobMetric_To_v1beta1_JobMetric(in *v1alpha1.JobMetric, out *JobMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_JobMetric_To_v1beta1_JobMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_KayentaMetric_To_v1alpha1_KayentaMetric(in *KayentaMetric, out *v1alpha1.KayentaMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Application = in.Application
+	out.CanaryConfigName = in.CanaryConfigName
+	out.MetricsAccountName = in.MetricsAccountName
+	out.ConfigurationAccountName = in.ConfigurationAccountName
+	out.StorageAccountName = in.StorageAccountName
+	if err := Convert_v1beta1_KayentaThreshold_To_v1alpha1_KayentaThreshold(&in.Threshold, &out.Threshold, s); err != nil {
+		return err
+	}
+	out.Scopes = *(*[]v1alpha1.KayentaScope)(unsafe.Pointer(&in.Scopes))
+	return nil
+}
+
+// Convert_v1beta1_KayentaMetric_To_v1alpha1_KayentaMetric is an autogenerated conversion function.
+func Convert_v1beta1_KayentaMetric_To_v1alpha1_KayentaMetric(in *KayentaMetric, out *v1alpha1.KayentaMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_KayentaMetric_To_v1alpha1_KayentaMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_KayentaMetric_To_v1beta1_KayentaMetric(in *v1alpha1.KayentaMetric, out *KayentaMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Application = in.Application
+	out.CanaryConfigName = in.CanaryConfigName
+	out.MetricsAccountName = in.MetricsAccountName
+	out.ConfigurationAccountName = in.ConfigurationAccountName
+	out.StorageAccountName = in.StorageAccountName
+	if err := Convert_v1alpha1_KayentaThreshold_To_v1beta1_KayentaThreshold(&in.Threshold, &out.Threshold, s); err != nil {
+		return err
+	}
+	out.Scopes = *(*[]KayentaScope)(unsafe.Pointer(&in.Scopes))
+	return nil
+}
+
+// Convert_v1alpha1_KayentaMetric_To_v1beta1_KayentaMetric is an autogenerated conversion function.
+func Convert_v1alpha1_KayentaMetric_To_v1beta1_KayentaMetric(in *v1alpha1.KayentaMetric, out *KayentaMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KayentaMetric_To_v1beta1_KayentaMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_KayentaScope_To_v1alpha1_KayentaScope(in *KayentaScope, out *v1alpha1.KayentaScope, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_ScopeDetail_To_v1alpha1_ScopeDetail(&in.ControlScope, &out.ControlScope, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ScopeDetail_To_v1alpha1_ScopeDetail(&in.ExperimentScope, &out.ExperimentScope, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_KayentaScope_To_v1alpha1_KayentaScope is an autogenerated conversion function.
+func Convert_v1beta1_KayentaScope_To_v1alpha1_KayentaScope(in *KayentaScope, out *v1alpha1.KayentaScope, s conversion.Scope) error {
+	return autoConvert_v1beta1_KayentaScope_To_v1alpha1_KayentaScope(in, out, s)
+}
+
+func autoConvert_v1alpha1_KayentaScope_To_v1beta1_KayentaScope(in *v1alpha1.KayentaScope, out *KayentaScope, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_ScopeDetail_To_v1beta1_ScopeDetail(&in.ControlScope, &out.ControlScope, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ScopeDetail_To_v1beta1_ScopeDetail(&in.ExperimentScope, &out.ExperimentScope, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_KayentaScope_To_v1beta1_KayentaScope is an autogenerated conversion function.
+func Convert_v1alpha1_KayentaScope_To_v1beta1_KayentaScope(in *v1alpha1.KayentaScope, out *KayentaScope, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KayentaScope_To_v1beta1_KayentaScope(in, out, s)
+}
+
+func autoConvert_v1beta1_KayentaThreshold_To_v1alpha1_KayentaThreshold(in *KayentaThreshold, out *v1alpha1.KayentaThreshold, s conversion.Scope) error {
+	out.Pass = in.Pass
+	out.Marginal = in.Marginal
+	return nil
+}
+
+// Convert_v1beta1_KayentaThreshold_To_v1alpha1_KayentaThreshold is an autogenerated conversion function.
+func Convert_v1beta1_KayentaThreshold_To_v1alpha1_KayentaThreshold(in *KayentaThreshold, out *v1alpha1.KayentaThreshold, s conversion.Scope) error {
+	return autoConvert_v1beta1_KayentaThreshold_To_v1alpha1_KayentaThreshold(in, out, s)
+}
+
+func autoConvert_v1alpha1_KayentaThreshold_To_v1beta1_KayentaThreshold(in *v1alpha1.KayentaThreshold, out *KayentaThreshold, s conversion.Scope) error {
+	out.Pass = in.Pass
+	out.Marginal = in.Marginal
+	return nil
+}
+
+// Convert_v1alpha1_KayentaThreshold_To_v1beta1_KayentaThreshold is an autogenerated conversion function.
+func Convert_v1alpha1_KayentaThreshold_To_v1beta1_KayentaThreshold(in *v1alpha1.KayentaThreshold, out *KayentaThreshold, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KayentaThreshold_To_v1beta1_KayentaThreshold(in, out, s)
+}
+
+func autoConvert_v1beta1_KongTrafficRouting_To_v1alpha1_KongTrafficRouting(in *KongTrafficRouting, out *v1alpha1.KongTrafficRouting, s conversion.Scope) error {
+	out.HTTPRoutes = *(*[]string)(unsafe.Pointer(&in.HTTPRoutes))
+	return nil
+}
+
+// Convert_v1beta1_KongTrafficRouting_To_v1alpha1_KongTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_KongTrafficRouting_To_v1alpha1_KongTrafficRouting(in *KongTrafficRouting, out *v1alpha1.KongTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_KongTrafficRouting_To_v1alpha1_KongTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_KongTrafficRouting_To_v1beta1_KongTrafficRouting(in *v1alpha1.KongTrafficRouting, out *KongTrafficRouting, s conversion.Scope) error {
+	out.HTTPRoutes = *(*[]string)(unsafe.Pointer(&in.HTTPRoutes))
+	return nil
+}
+
+// Convert_v1alpha1_KongTrafficRouting_To_v1beta1_KongTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_KongTrafficRouting_To_v1beta1_KongTrafficRouting(in *v1alpha1.KongTrafficRouting, out *KongTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KongTrafficRouting_To_v1beta1_KongTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_LinkerdTrafficRouting_To_v1alpha1_LinkerdTrafficRouting(in *LinkerdTrafficRouting, out *v1alpha1.LinkerdTrafficRouting, s conversion.Scope) error {
+	out.HTTPRoutes = *(*[]string)(unsafe.Pointer(&in.HTTPRoutes))
+	return nil
+}
+
+// Convert_v1beta1_LinkerdTrafficRouting_To_v1alpha1_LinkerdTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_LinkerdTrafficRouting_To_v1alpha1_LinkerdTrafficRouting(in *LinkerdTrafficRouting, out *v1alpha1.LinkerdTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_LinkerdTrafficRouting_To_v1alpha1_LinkerdTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_LinkerdTrafficRouting_To_v1beta1_LinkerdTrafficRouting(in *v1alpha1.LinkerdTrafficRouting, out *LinkerdTrafficRouting, s conversion.Scope) error {
+	out.HTTPRoutes = *(*[]string)(unsafe.Pointer(&in.HTTPRoutes))
+	return nil
+}
+
+// Convert_v1alpha1_LinkerdTrafficRouting_To_v1beta1_LinkerdTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_LinkerdTrafficRouting_To_v1beta1_LinkerdTrafficRouting(in *v1alpha1.LinkerdTrafficRouting, out *LinkerdTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LinkerdTrafficRouting_To_v1beta1_LinkerdTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_Measurement_To_v1alpha1_Measurement(in *Measurement, out *v1alpha1.Measurement, s conversion.Scope) error {
+	out.Phase = v1alpha1.AnalysisPhase(in.Phase)
+	out.Message = in.Message
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.FinishedAt = (*v1.Time)(unsafe.Pointer(in.FinishedAt))
+	out.Value = in.Value
+	out.Metadata = *(*map[string]string)(unsafe.Pointer(&in.Metadata))
+	out.ResumeAt = (*v1.Time)(unsafe.Pointer(in.ResumeAt))
+	return nil
+}
+
+// Convert_v1beta1_Measurement_To_v1alpha1_Measurement is an autogenerated conversion function.
+func Convert_v1beta1_Measurement_To_v1alpha1_Measurement(in *Measurement, out *v1alpha1.Measurement, s conversion.Scope) error {
+	return autoConvert_v1beta1_Measurement_To_v1alpha1_Measurement(in, out, s)
+}
+
+func autoConvert_v1alpha1_Measurement_To_v1beta1_Measurement(in *v1alpha1.Measurement, out *Measurement, s conversion.Scope) error {
+	out.Phase = AnalysisPhase(in.Phase)
+	out.Message = in.Message
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.FinishedAt = (*v1.Time)(unsafe.Pointer(in.FinishedAt))
+	out.Value = in.Value
+	out.Metadata = *(*map[string]string)(unsafe.Pointer(&in.Metadata))
+	out.ResumeAt = (*v1.Time)(unsafe.Pointer(in.ResumeAt))
+	return nil
+}
+
+// Convert_v1alpha1_Measurement_To_v1beta1_Measurement is an autogenerated conversion function.
+func Convert_v1alpha1_Measurement_To_v1beta1_Measurement(in *v1alpha1.Measurement, out *Measurement, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Measurement_To_v1beta1_Measurement(in, out, s)
+}
+
+func autoConvert_v1beta1_MeasurementRetention_To_v1alpha1_MeasurementRetention(in *MeasurementRetention, out *v1alpha1.MeasurementRetention, s conversion.Scope) error {
+	out.MetricName = in.MetricName
+	out.Limit = in.Limit
+	return nil
+}
+
+// Convert_v1beta1_MeasurementRetention_To_v1alpha1_MeasurementRetention is an autogenerated conversion function.
+func Convert_v1beta1_MeasurementRetention_To_v1alpha1_MeasurementRetention(in *MeasurementRetention, out *v1alpha1.MeasurementRetention, s conversion.Scope) error {
+	return autoConvert_v1beta1_MeasurementRetention_To_v1alpha1_MeasurementRetention(in, out, s)
+}
+
+func autoConvert_v1alpha1_MeasurementRetention_To_v1beta1_MeasurementRetention(in *v1alpha1.MeasurementRetention, out *MeasurementRetention, s conversion.Scope) error {
+	out.MetricName = in.MetricName
+	out.Limit = in.Limit
+	return nil
+}
+
+// Convert_v1alpha1_MeasurementRetention_To_v1beta1_MeasurementRetention is an autogenerated conversion function.
+func Convert_v1alpha1_MeasurementRetention_To_v1beta1_MeasurementRetention(in *v1alpha1.MeasurementRetention, out *MeasurementRetention, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MeasurementRetention_To_v1beta1_MeasurementRetention(in, out, s)
+}
+
+func autoConvert_v1beta1_Metric_To_v1alpha1_Metric(in *Metric, out *v1alpha1.Metric, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Interval = v1alpha1.DurationString(in.Interval)
+	out.InitialDelay = v1alpha1.DurationString(in.InitialDelay)
+	out.Count = (*intstr.IntOrString)(unsafe.Pointer(in.Count))
+	out.SuccessCondition = in.SuccessCondition
+	out.FailureCondition = in.FailureCondition
+	out.FailureLimit = (*intstr.IntOrString)(unsafe.Pointer(in.FailureLimit))
+	out.InconclusiveLimit = (*intstr.IntOrString)(unsafe.Pointer(in.InconclusiveLimit))
+	out.ConsecutiveErrorLimit = (*intstr.IntOrString)(unsafe.Pointer(in.ConsecutiveErrorLimit))
+	if err := Convert_v1beta1_MetricProvider_To_v1alpha1_MetricProvider(&in.Provider, &out.Provider, s); err != nil {
+		return err
+	}
+	out.ConsecutiveSuccessLimit = (*intstr.IntOrString)(unsafe.Pointer(in.ConsecutiveSuccessLimit))
+	return nil
+}
+
+// Convert_v1beta1_Metric_To_v1alpha1_Metric is an autogenerated conversion function.
+func Convert_v1beta1_Metric_To_v1alpha1_Metric(in *Metric, out *v1alpha1.Metric, s conversion.Scope) error {
+	return autoConvert_v1beta1_Metric_To_v1alpha1_Metric(in, out, s)
+}
+
+func autoConvert_v1alpha1_Metric_To_v1beta1_Metric(in *v1alpha1.Metric, out *Metric, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Interval = DurationString(in.Interval)
+	out.InitialDelay = DurationString(in.InitialDelay)
+	out.Count = (*intstr.IntOrString)(unsafe.Pointer(in.Count))
+	out.SuccessCondition = in.SuccessCondition
+	out.FailureCondition = in.FailureCondition
+	out.FailureLimit = (*intstr.IntOrString)(unsafe.Pointer(in.FailureLimit))
+	out.InconclusiveLimit = (*intstr.IntOrString)(unsafe.Pointer(in.InconclusiveLimit))
+	out.ConsecutiveErrorLimit = (*intstr.IntOrString)(unsafe.Pointer(in.ConsecutiveErrorLimit))
+	if err := Convert_v1alpha1_MetricProvider_To_v1beta1_MetricProvider(&in.Provider, &out.Provider, s); err != nil {
+		return err
+	}
+	out.ConsecutiveSuccessLimit = (*intstr.IntOrString)(unsafe.Pointer(in.ConsecutiveSuccessLimit))
+	return nil
+}
+
+// Convert_v1alpha1_Metric_To_v1beta1_Metric is an autogenerated conversion function.
+func Convert_v1alpha1_Metric_To_v1beta1_Metric(in *v1alpha1.Metric, out *Metric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Metric_To_v1beta1_Metric(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricProvider_To_v1alpha1_MetricProvider(in *MetricProvider, out *v1alpha1.MetricProvider, s conversion.Scope) error {
+	out.Prometheus = (*v1alpha1.PrometheusMetric)(unsafe.Pointer(in.Prometheus))
+	out.Kayenta = (*v1alpha1.KayentaMetric)(unsafe.Pointer(in.Kayenta))
+	out.Web = (*v1alpha1.WebMetric)(unsafe.Pointer(in.Web))
+	out.Datadog = (*v1alpha1.DatadogMetric)(unsafe.Pointer(in.Datadog))
+	out.Wavefront = (*v1alpha1.WavefrontMetric)(unsafe.Pointer(in.Wavefront))
+	out.NewRelic = (*v1alpha1.NewRelicMetric)(unsafe.Pointer(in.NewRelic))
+	out.Job = (*v1alpha1.JobMetric)(unsafe.Pointer(in.Job))
+	out.CloudWatch = (*v1alpha1.CloudWatchMetric)(unsafe.Pointer(in.CloudWatch))
+	out.Graphite = (*v1alpha1.GraphiteMetric)(unsafe.Pointer(in.Graphite))
+	out.Influxdb = (*v1alpha1.InfluxdbMetric)(unsafe.Pointer(in.Influxdb))
+	out.SkyWalking = (*v1alpha1.SkyWalkingMetric)(unsafe.Pointer(in.SkyWalking))
+	out.Plugin = *(*map[string]json.RawMessage)(unsafe.Pointer(&in.Plugin))
+	return nil
+}
+
+// Convert_v1beta1_MetricProvider_To_v1alpha1_MetricProvider is an autogenerated conversion function.
+func Convert_v1beta1_MetricProvider_To_v1alpha1_MetricProvider(in *MetricProvider, out *v1alpha1.MetricProvider, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricProvider_To_v1alpha1_MetricProvider(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricProvider_To_v1beta1_MetricProvider(in *v1alpha1.MetricProvider, out *MetricProvider, s conversion.Scope) error {
+	out.Prometheus = (*PrometheusMetric)(unsafe.Pointer(in.Prometheus))
+	out.Kayenta = (*KayentaMetric)(unsafe.Pointer(in.Kayenta))
+	out.Web = (*WebMetric)(unsafe.Pointer(in.Web))
+	out.Datadog = (*DatadogMetric)(unsafe.Pointer(in.Datadog))
+	out.Wavefront = (*WavefrontMetric)(unsafe.Pointer(in.Wavefront))
+	out.NewRelic = (*NewRelicMetric)(unsafe.Pointer(in.NewRelic))
+	out.Job = (*JobMetric)(unsafe.Pointer(in.Job))
+	out.CloudWatch = (*CloudWatchMetric)(unsafe.Pointer(in.CloudWatch))
+	out.Graphite = (*GraphiteMetric)(unsafe.Pointer(in.Graphite))
+	out.Influxdb = (*InfluxdbMetric)(unsafe.Pointer(in.Influxdb))
+	out.SkyWalking = (*SkyWalkingMetric)(unsafe.Pointer(in.SkyWalking))
+	out.Plugin = *(*map[string]json.RawMessage)(unsafe.Pointer(&in.Plugin))
+	return nil
+}
+
+// Convert_v1alpha1_MetricProvider_To_v1beta1_MetricProvider is an autogenerated conversion function.
+func Convert_v1alpha1_MetricProvider_To_v1beta1_MetricProvider(in *v1alpha1.MetricProvider, out *MetricProvider, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricProvider_To_v1beta1_MetricProvider(in, out, s)
+}
+
+func autoConvert_v1beta1_MetricResult_To_v1alpha1_MetricResult(in *MetricResult, out *v1alpha1.MetricResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phase = v1alpha1.AnalysisPhase(in.Phase)
+	out.Measurements = *(*[]v1alpha1.Measurement)(unsafe.Pointer(&in.Measurements))
+	out.Message = in.Message
+	out.Count = in.Count
+	out.Successful = in.Successful
+	out.Failed = in.Failed
+	out.Inconclusive = in.Inconclusive
+	out.Error = in.Error
+	out.ConsecutiveError = in.ConsecutiveError
+	out.DryRun = in.DryRun
+	out.Metadata = *(*map[string]string)(unsafe.Pointer(&in.Metadata))
+	out.ConsecutiveSuccess = in.ConsecutiveSuccess
+	return nil
+}
+
+// Convert_v1beta1_MetricResult_To_v1alpha1_MetricResult is an autogenerated conversion function.
+func Convert_v1beta1_MetricResult_To_v1alpha1_MetricResult(in *MetricResult, out *v1alpha1.MetricResult, s conversion.Scope) error {
+	return autoConvert_v1beta1_MetricResult_To_v1alpha1_MetricResult(in, out, s)
+}
+
+func autoConvert_v1alpha1_MetricResult_To_v1beta1_MetricResult(in *v1alpha1.MetricResult, out *MetricResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phase = AnalysisPhase(in.Phase)
+	out.Measurements = *(*[]Measurement)(unsafe.Pointer(&in.Measurements))
+	out.Message = in.Message
+	out.Count = in.Count
+	out.Successful = in.Successful
+	out.Failed = in.Failed
+	out.Inconclusive = in.Inconclusive
+	out.Error = in.Error
+	out.ConsecutiveError = in.ConsecutiveError
+	out.DryRun = in.DryRun
+	out.Metadata = *(*map[string]string)(unsafe.Pointer(&in.Metadata))
+	out.ConsecutiveSuccess = in.ConsecutiveSuccess
+	return nil
+}
+
+// Convert_v1alpha1_MetricResult_To_v1beta1_MetricResult is an autogenerated conversion function.
+func Convert_v1alpha1_MetricResult_To_v1beta1_MetricResult(in *v1alpha1.MetricResult, out *MetricResult, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MetricResult_To_v1beta1_MetricResult(in, out, s)
+}
+
+func autoConvert_v1beta1_NewRelicMetric_To_v1alpha1_NewRelicMetric(in *NewRelicMetric, out *v1alpha1.NewRelicMetric, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.Query = in.Query
+	out.Timeout = (*int64)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1beta1_NewRelicMetric_To_v1alpha1_NewRelicMetric is an autogenerated conversion function.
+func Convert_v1beta1_NewRelicMetric_To_v1alpha1_NewRelicMetric(in *NewRelicMetric, out *v1alpha1.NewRelicMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_NewRelicMetric_To_v1alpha1_NewRelicMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_NewRelicMetric_To_v1beta1_NewRelicMetric(in *v1alpha1.NewRelicMetric, out *NewRelicMetric, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.Query = in.Query
+	out.Timeout = (*int64)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_NewRelicMetric_To_v1beta1_NewRelicMetric is an autogenerated conversion function.
+func Convert_v1alpha1_NewRelicMetric_To_v1beta1_NewRelicMetric(in *v1alpha1.NewRelicMetric, out *NewRelicMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NewRelicMetric_To_v1beta1_NewRelicMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_NginxTrafficRouting_To_v1alpha1_NginxTrafficRouting(in *NginxTrafficRouting, out *v1alpha1.NginxTrafficRouting, s conversion.Scope) error {
+	out.AnnotationPrefix = in.AnnotationPrefix
+	out.StableIngress = in.StableIngress
+	out.AdditionalIngressAnnotations = *(*map[string]string)(unsafe.Pointer(&in.AdditionalIngressAnnotations))
+	out.StableIngresses = *(*[]string)(unsafe.Pointer(&in.StableIngresses))
+	out.CanaryIngressAnnotations = *(*map[string]string)(unsafe.Pointer(&in.CanaryIngressAnnotations))
+	return nil
+}
+
+// Convert_v1beta1_NginxTrafficRouting_To_v1alpha1_NginxTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_NginxTrafficRouting_To_v1alpha1_NginxTrafficRouting(in *NginxTrafficRouting, out *v1alpha1.NginxTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_NginxTrafficRouting_To_v1alpha1_NginxTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_NginxTrafficRouting_To_v1beta1_NginxTrafficRouting(in *v1alpha1.NginxTrafficRouting, out *NginxTrafficRouting, s conversion.Scope) error {
+	out.AnnotationPrefix = in.AnnotationPrefix
+	out.StableIngress = in.StableIngress
+	out.AdditionalIngressAnnotations = *(*map[string]string)(unsafe.Pointer(&in.AdditionalIngressAnnotations))
+	out.StableIngresses = *(*[]string)(unsafe.Pointer(&in.StableIngresses))
+	out.CanaryIngressAnnotations = *(*map[string]string)(unsafe.Pointer(&in.CanaryIngressAnnotations))
+	return nil
+}
+
+// Convert_v1alpha1_NginxTrafficRouting_To_v1beta1_NginxTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_NginxTrafficRouting_To_v1beta1_NginxTrafficRouting(in *v1alpha1.NginxTrafficRouting, out *NginxTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NginxTrafficRouting_To_v1beta1_NginxTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_OAuth2Config_To_v1alpha1_OAuth2Config(in *OAuth2Config, out *v1alpha1.OAuth2Config, s conversion.Scope) error {
+	out.TokenURL = in.TokenURL
+	out.ClientID = in.ClientID
+	out.ClientSecret = in.ClientSecret
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	return nil
+}
+
+// Convert_v1beta1_OAuth2Config_To_v1alpha1_OAuth2Config is an autogenerated conversion function.
+func Convert_v1beta1_OAuth2Config_To_v1alpha1_OAuth2Config(in *OAuth2Config, out *v1alpha1.OAuth2Config, s conversion.Scope) error {
+	return autoConvert_v1beta1_OAuth2Config_To_v1alpha1_OAuth2Config(in, out, s)
+}
+
+func autoConvert_v1alpha1_OAuth2Config_To_v1beta1_OAuth2Config(in *v1alpha1.OAuth2Config, out *OAuth2Config, s conversion.Scope) error {
+	out.TokenURL = in.TokenURL
+	out.ClientID = in.ClientID
+	out.ClientSecret = in.ClientSecret
+	out.Scopes = *(*[]string)(unsafe.Pointer(&in.Scopes))
+	return nil
+}
+
+// Convert_v1alpha1_OAuth2Config_To_v1beta1_OAuth2Config is an autogenerated conversion function.
+func Convert_v1alpha1_OAuth2Config_To_v1beta1_OAuth2Config(in *v1alpha1.OAuth2Config, out *OAuth2Config, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OAuth2Config_To_v1beta1_OAuth2Config(in, out, s)
+}
+
+func autoConvert_v1beta1_ObjectRef_To_v1alpha1_ObjectRef(in *ObjectRef, out *v1alpha1.ObjectRef, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.ScaleDown = in.ScaleDown
+	return nil
+}
+
+// Convert_v1beta1_ObjectRef_To_v1alpha1_ObjectRef is an autogenerated conversion function.
+func Convert_v1beta1_ObjectRef_To_v1alpha1_ObjectRef(in *ObjectRef, out *v1alpha1.ObjectRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_ObjectRef_To_v1alpha1_ObjectRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_ObjectRef_To_v1beta1_ObjectRef(in *v1alpha1.ObjectRef, out *ObjectRef, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.ScaleDown = in.ScaleDown
+	return nil
+}
+
+// Convert_v1alpha1_ObjectRef_To_v1beta1_ObjectRef is an autogenerated conversion function.
+func Convert_v1alpha1_ObjectRef_To_v1beta1_ObjectRef(in *v1alpha1.ObjectRef, out *ObjectRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ObjectRef_To_v1beta1_ObjectRef(in, out, s)
+}
+
+func autoConvert_v1beta1_PauseCondition_To_v1alpha1_PauseCondition(in *PauseCondition, out *v1alpha1.PauseCondition, s conversion.Scope) error {
+	out.Reason = v1alpha1.PauseReason(in.Reason)
+	out.StartTime = in.StartTime
+	return nil
+}
+
+// Convert_v1beta1_PauseCondition_To_v1alpha1_PauseCondition is an autogenerated conversion function.
+func Convert_v1beta1_PauseCondition_To_v1alpha1_PauseCondition(in *PauseCondition, out *v1alpha1.PauseCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_PauseCondition_To_v1alpha1_PauseCondition(in, out, s)
+}
+
+func autoConvert_v1alpha1_PauseCondition_To_v1beta1_PauseCondition(in *v1alpha1.PauseCondition, out *PauseCondition, s conversion.Scope) error {
+	out.Reason = PauseReason(in.Reason)
+	out.StartTime = in.StartTime
+	return nil
+}
+
+// Convert_v1alpha1_PauseCondition_To_v1beta1_PauseCondition is an autogenerated conversion function.
+func Convert_v1alpha1_PauseCondition_To_v1beta1_PauseCondition(in *v1alpha1.PauseCondition, out *PauseCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PauseCondition_To_v1beta1_PauseCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_PingPongSpec_To_v1alpha1_PingPongSpec(in *PingPongSpec, out *v1alpha1.PingPongSpec, s conversion.Scope) error {
+	out.PingService = in.PingService
+	out.PongService = in.PongService
+	return nil
+}
+
+// Convert_v1beta1_PingPongSpec_To_v1alpha1_PingPongSpec is an autogenerated conversion function.
+func Convert_v1beta1_PingPongSpec_To_v1alpha1_PingPongSpec(in *PingPongSpec, out *v1alpha1.PingPongSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_PingPongSpec_To_v1alpha1_PingPongSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_PingPongSpec_To_v1beta1_PingPongSpec(in *v1alpha1.PingPongSpec, out *PingPongSpec, s conversion.Scope) error {
+	out.PingService = in.PingService
+	out.PongService = in.PongService
+	return nil
+}
+
+// Convert_v1alpha1_PingPongSpec_To_v1beta1_PingPongSpec is an autogenerated conversion function.
+func Convert_v1alpha1_PingPongSpec_To_v1beta1_PingPongSpec(in *v1alpha1.PingPongSpec, out *PingPongSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PingPongSpec_To_v1beta1_PingPongSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_PluginStep_To_v1alpha1_PluginStep(in *PluginStep, out *v1alpha1.PluginStep, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Config = *(*json.RawMessage)(unsafe.Pointer(&in.Config))
+	return nil
+}
+
+// Convert_v1beta1_PluginStep_To_v1alpha1_PluginStep is an autogenerated conversion function.
+func Convert_v1beta1_PluginStep_To_v1alpha1_PluginStep(in *PluginStep, out *v1alpha1.PluginStep, s conversion.Scope) error {
+	return autoConvert_v1beta1_PluginStep_To_v1alpha1_PluginStep(in, out, s)
+}
+
+func autoConvert_v1alpha1_PluginStep_To_v1beta1_PluginStep(in *v1alpha1.PluginStep, out *PluginStep, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Config = *(*json.RawMessage)(unsafe.Pointer(&in.Config))
+	return nil
+}
+
+// Convert_v1alpha1_PluginStep_To_v1beta1_PluginStep is an autogenerated conversion function.
+func Convert_v1alpha1_PluginStep_To_v1beta1_PluginStep(in *v1alpha1.PluginStep, out *PluginStep, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PluginStep_To_v1beta1_PluginStep(in, out, s)
+}
+
+func autoConvert_v1beta1_PodTemplateMetadata_To_v1alpha1_PodTemplateMetadata(in *PodTemplateMetadata, out *v1alpha1.PodTemplateMetadata, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1beta1_PodTemplateMetadata_To_v1alpha1_PodTemplateMetadata is an autogenerated conversion function.
+func Convert_v1beta1_PodTemplateMetadata_To_v1alpha1_PodTemplateMetadata(in *PodTemplateMetadata, out *v1alpha1.PodTemplateMetadata, s conversion.Scope) error {
+	return autoConvert_v1beta1_PodTemplateMetadata_To_v1alpha1_PodTemplateMetadata(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodTemplateMetadata_To_v1beta1_PodTemplateMetadata(in *v1alpha1.PodTemplateMetadata, out *PodTemplateMetadata, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1alpha1_PodTemplateMetadata_To_v1beta1_PodTemplateMetadata is an autogenerated conversion function.
+func Convert_v1alpha1_PodTemplateMetadata_To_v1beta1_PodTemplateMetadata(in *v1alpha1.PodTemplateMetadata, out *PodTemplateMetadata, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodTemplateMetadata_To_v1beta1_PodTemplateMetadata(in, out, s)
+}
+
+func autoConvert_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution(in *PreferredDuringSchedulingIgnoredDuringExecution, out *v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution, s conversion.Scope) error {
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution is an autogenerated conversion function.
+func Convert_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution(in *PreferredDuringSchedulingIgnoredDuringExecution, out *v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution, s conversion.Scope) error {
+	return autoConvert_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution(in, out, s)
+}
+
+func autoConvert_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution(in *v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution, out *PreferredDuringSchedulingIgnoredDuringExecution, s conversion.Scope) error {
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution is an autogenerated conversion function.
+func Convert_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution(in *v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution, out *PreferredDuringSchedulingIgnoredDuringExecution, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PreferredDuringSchedulingIgnoredDuringExecution_To_v1beta1_PreferredDuringSchedulingIgnoredDuringExecution(in, out, s)
+}
+
+func autoConvert_v1beta1_PreserveAbortedPods_To_v1alpha1_PreserveAbortedPods(in *PreserveAbortedPods, out *v1alpha1.PreserveAbortedPods, s conversion.Scope) error {
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	// WARNING: in.TTL requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	return nil
+}
+
+func autoConvert_v1alpha1_PreserveAbortedPods_To_v1beta1_PreserveAbortedPods(in *v1alpha1.PreserveAbortedPods, out *PreserveAbortedPods, s conversion.Scope) error {
+	out.Count = (*int32)(unsafe.Pointer(in.Count))
+	// WARNING: in.TTL requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	return nil
+}
+
+func autoConvert_v1beta1_PrometheusMetric_To_v1alpha1_PrometheusMetric(in *PrometheusMetric, out *v1alpha1.PrometheusMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Query = in.Query
+	if err := Convert_v1beta1_Authentication_To_v1alpha1_Authentication(&in.Authentication, &out.Authentication, s); err != nil {
+		return err
+	}
+	out.Timeout = (*int64)(unsafe.Pointer(in.Timeout))
+	out.Insecure = in.Insecure
+	out.Headers = *(*[]v1alpha1.WebMetricHeader)(unsafe.Pointer(&in.Headers))
+	out.RangeQuery = (*v1alpha1.PrometheusRangeQueryArgs)(unsafe.Pointer(in.RangeQuery))
+	return nil
+}
+
+// Convert_v1beta1_PrometheusMetric_To_v1alpha1_PrometheusMetric is an autogenerated conversion function.
+func Convert_v1beta1_PrometheusMetric_To_v1alpha1_PrometheusMetric(in *PrometheusMetric, out *v1alpha1.PrometheusMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_PrometheusMetric_To_v1alpha1_PrometheusMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrometheusMetric_To_v1beta1_PrometheusMetric(in *v1alpha1.PrometheusMetric, out *PrometheusMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Query = in.Query
+	if err := Convert_v1alpha1_Authentication_To_v1beta1_Authentication(&in.Authentication, &out.Authentication, s); err != nil {
+		return err
+	}
+	out.Timeout = (*int64)(unsafe.Pointer(in.Timeout))
+	out.Insecure = in.Insecure
+	out.Headers = *(*[]WebMetricHeader)(unsafe.Pointer(&in.Headers))
+	out.RangeQuery = (*PrometheusRangeQueryArgs)(unsafe.Pointer(in.RangeQuery))
+	return nil
+}
+
+// Convert_v1alpha1_PrometheusMetric_To_v1beta1_PrometheusMetric is an autogenerated conversion function.
+func Convert_v1alpha1_PrometheusMetric_To_v1beta1_PrometheusMetric(in *v1alpha1.PrometheusMetric, out *PrometheusMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrometheusMetric_To_v1beta1_PrometheusMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_PrometheusRangeQueryArgs_To_v1alpha1_PrometheusRangeQueryArgs(in *PrometheusRangeQueryArgs, out *v1alpha1.PrometheusRangeQueryArgs, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	out.Step = v1alpha1.DurationString(in.Step)
+	return nil
+}
+
+// Convert_v1beta1_PrometheusRangeQueryArgs_To_v1alpha1_PrometheusRangeQueryArgs is an autogenerated conversion function.
+func Convert_v1beta1_PrometheusRangeQueryArgs_To_v1alpha1_PrometheusRangeQueryArgs(in *PrometheusRangeQueryArgs, out *v1alpha1.PrometheusRangeQueryArgs, s conversion.Scope) error {
+	return autoConvert_v1beta1_PrometheusRangeQueryArgs_To_v1alpha1_PrometheusRangeQueryArgs(in, out, s)
+}
+
+func autoConvert_v1alpha1_PrometheusRangeQueryArgs_To_v1beta1_PrometheusRangeQueryArgs(in *v1alpha1.PrometheusRangeQueryArgs, out *PrometheusRangeQueryArgs, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	out.Step = DurationString(in.Step)
+	return nil
+}
+
+// Convert_v1alpha1_PrometheusRangeQueryArgs_To_v1beta1_PrometheusRangeQueryArgs is an autogenerated conversion function.
+func Convert_v1alpha1_PrometheusRangeQueryArgs_To_v1beta1_PrometheusRangeQueryArgs(in *v1alpha1.PrometheusRangeQueryArgs, out *PrometheusRangeQueryArgs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PrometheusRangeQueryArgs_To_v1beta1_PrometheusRangeQueryArgs(in, out, s)
+}
+
+func autoConvert_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution(in *RequiredDuringSchedulingIgnoredDuringExecution, out *v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution is an autogenerated conversion function.
+func Convert_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution(in *RequiredDuringSchedulingIgnoredDuringExecution, out *v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution, s conversion.Scope) error {
+	return autoConvert_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution(in, out, s)
+}
+
+func autoConvert_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution(in *v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution, out *RequiredDuringSchedulingIgnoredDuringExecution, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution is an autogenerated conversion function.
+func Convert_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution(in *v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution, out *RequiredDuringSchedulingIgnoredDuringExecution, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RequiredDuringSchedulingIgnoredDuringExecution_To_v1beta1_RequiredDuringSchedulingIgnoredDuringExecution(in, out, s)
+}
+
+func autoConvert_v1beta1_RollbackWindowSpec_To_v1alpha1_RollbackWindowSpec(in *RollbackWindowSpec, out *v1alpha1.RollbackWindowSpec, s conversion.Scope) error {
+	out.Revisions = in.Revisions
+	return nil
+}
+
+// Convert_v1beta1_RollbackWindowSpec_To_v1alpha1_RollbackWindowSpec is an autogenerated conversion function.
+func Convert_v1beta1_RollbackWindowSpec_To_v1alpha1_RollbackWindowSpec(in *RollbackWindowSpec, out *v1alpha1.RollbackWindowSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_RollbackWindowSpec_To_v1alpha1_RollbackWindowSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RollbackWindowSpec_To_v1beta1_RollbackWindowSpec(in *v1alpha1.RollbackWindowSpec, out *RollbackWindowSpec, s conversion.Scope) error {
+	out.Revisions = in.Revisions
+	return nil
+}
+
+// Convert_v1alpha1_RollbackWindowSpec_To_v1beta1_RollbackWindowSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RollbackWindowSpec_To_v1beta1_RollbackWindowSpec(in *v1alpha1.RollbackWindowSpec, out *RollbackWindowSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RollbackWindowSpec_To_v1beta1_RollbackWindowSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_Rollout_To_v1alpha1_Rollout(in *Rollout, out *v1alpha1.Rollout, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_RolloutSpec_To_v1alpha1_RolloutSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_RolloutStatus_To_v1alpha1_RolloutStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_Rollout_To_v1beta1_Rollout(in *v1alpha1.Rollout, out *Rollout, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_RolloutSpec_To_v1beta1_RolloutSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_RolloutStatus_To_v1beta1_RolloutStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_RolloutAnalysis_To_v1alpha1_RolloutAnalysis(in *RolloutAnalysis, out *v1alpha1.RolloutAnalysis, s conversion.Scope) error {
+	out.Templates = *(*[]v1alpha1.AnalysisTemplateRef)(unsafe.Pointer(&in.Templates))
+	out.Args = *(*[]v1alpha1.AnalysisRunArgument)(unsafe.Pointer(&in.Args))
+	out.DryRun = *(*[]v1alpha1.DryRun)(unsafe.Pointer(&in.DryRun))
+	out.MeasurementRetention = *(*[]v1alpha1.MeasurementRetention)(unsafe.Pointer(&in.MeasurementRetention))
+	if err := Convert_v1beta1_AnalysisRunMetadata_To_v1alpha1_AnalysisRunMetadata(&in.AnalysisRunMetadata, &out.AnalysisRunMetadata, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_RolloutAnalysis_To_v1alpha1_RolloutAnalysis is an autogenerated conversion function.
+func Convert_v1beta1_RolloutAnalysis_To_v1alpha1_RolloutAnalysis(in *RolloutAnalysis, out *v1alpha1.RolloutAnalysis, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutAnalysis_To_v1alpha1_RolloutAnalysis(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutAnalysis_To_v1beta1_RolloutAnalysis(in *v1alpha1.RolloutAnalysis, out *RolloutAnalysis, s conversion.Scope) error {
+	out.Templates = *(*[]AnalysisTemplateRef)(unsafe.Pointer(&in.Templates))
+	out.Args = *(*[]AnalysisRunArgument)(unsafe.Pointer(&in.Args))
+	out.DryRun = *(*[]DryRun)(unsafe.Pointer(&in.DryRun))
+	out.MeasurementRetention = *(*[]MeasurementRetention)(unsafe.Pointer(&in.MeasurementRetention))
+	if err := Convert_v1alpha1_AnalysisRunMetadata_To_v1beta1_AnalysisRunMetadata(&in.AnalysisRunMetadata, &out.AnalysisRunMetadata, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RolloutAnalysis_To_v1beta1_RolloutAnalysis is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutAnalysis_To_v1beta1_RolloutAnalysis(in *v1alpha1.RolloutAnalysis, out *RolloutAnalysis, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutAnalysis_To_v1beta1_RolloutAnalysis(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutAnalysisBackground_To_v1alpha1_RolloutAnalysisBackground(in *RolloutAnalysisBackground, out *v1alpha1.RolloutAnalysisBackground, s conversion.Scope) error {
+	if err := Convert_v1beta1_RolloutAnalysis_To_v1alpha1_RolloutAnalysis(&in.RolloutAnalysis, &out.RolloutAnalysis, s); err != nil {
+		return err
+	}
+	out.StartingStep = (*int32)(unsafe.Pointer(in.StartingStep))
+	return nil
+}
+
+// Convert_v1beta1_RolloutAnalysisBackground_To_v1alpha1_RolloutAnalysisBackground is an autogenerated conversion function.
+func Convert_v1beta1_RolloutAnalysisBackground_To_v1alpha1_RolloutAnalysisBackground(in *RolloutAnalysisBackground, out *v1alpha1.RolloutAnalysisBackground, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutAnalysisBackground_To_v1alpha1_RolloutAnalysisBackground(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutAnalysisBackground_To_v1beta1_RolloutAnalysisBackground(in *v1alpha1.RolloutAnalysisBackground, out *RolloutAnalysisBackground, s conversion.Scope) error {
+	if err := Convert_v1alpha1_RolloutAnalysis_To_v1beta1_RolloutAnalysis(&in.RolloutAnalysis, &out.RolloutAnalysis, s); err != nil {
+		return err
+	}
+	out.StartingStep = (*int32)(unsafe.Pointer(in.StartingStep))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutAnalysisBackground_To_v1beta1_RolloutAnalysisBackground is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutAnalysisBackground_To_v1beta1_RolloutAnalysisBackground(in *v1alpha1.RolloutAnalysisBackground, out *RolloutAnalysisBackground, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutAnalysisBackground_To_v1beta1_RolloutAnalysisBackground(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutAnalysisRunStatus_To_v1alpha1_RolloutAnalysisRunStatus(in *RolloutAnalysisRunStatus, out *v1alpha1.RolloutAnalysisRunStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Status = v1alpha1.AnalysisPhase(in.Status)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_RolloutAnalysisRunStatus_To_v1alpha1_RolloutAnalysisRunStatus is an autogenerated conversion function.
+func Convert_v1beta1_RolloutAnalysisRunStatus_To_v1alpha1_RolloutAnalysisRunStatus(in *RolloutAnalysisRunStatus, out *v1alpha1.RolloutAnalysisRunStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutAnalysisRunStatus_To_v1alpha1_RolloutAnalysisRunStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutAnalysisRunStatus_To_v1beta1_RolloutAnalysisRunStatus(in *v1alpha1.RolloutAnalysisRunStatus, out *RolloutAnalysisRunStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Status = AnalysisPhase(in.Status)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_RolloutAnalysisRunStatus_To_v1beta1_RolloutAnalysisRunStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutAnalysisRunStatus_To_v1beta1_RolloutAnalysisRunStatus(in *v1alpha1.RolloutAnalysisRunStatus, out *RolloutAnalysisRunStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutAnalysisRunStatus_To_v1beta1_RolloutAnalysisRunStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutCondition_To_v1alpha1_RolloutCondition(in *RolloutCondition, out *v1alpha1.RolloutCondition, s conversion.Scope) error {
+	out.Type = v1alpha1.RolloutConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_RolloutCondition_To_v1alpha1_RolloutCondition is an autogenerated conversion function.
+func Convert_v1beta1_RolloutCondition_To_v1alpha1_RolloutCondition(in *RolloutCondition, out *v1alpha1.RolloutCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutCondition_To_v1alpha1_RolloutCondition(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutCondition_To_v1beta1_RolloutCondition(in *v1alpha1.RolloutCondition, out *RolloutCondition, s conversion.Scope) error {
+	out.Type = RolloutConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_RolloutCondition_To_v1beta1_RolloutCondition is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutCondition_To_v1beta1_RolloutCondition(in *v1alpha1.RolloutCondition, out *RolloutCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutCondition_To_v1beta1_RolloutCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutExperimentStep_To_v1alpha1_RolloutExperimentStep(in *RolloutExperimentStep, out *v1alpha1.RolloutExperimentStep, s conversion.Scope) error {
+	out.Templates = *(*[]v1alpha1.RolloutExperimentTemplate)(unsafe.Pointer(&in.Templates))
+	// WARNING: in.Duration requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	out.Analyses = *(*[]v1alpha1.RolloutExperimentStepAnalysisTemplateRef)(unsafe.Pointer(&in.Analyses))
+	out.DryRun = *(*[]v1alpha1.DryRun)(unsafe.Pointer(&in.DryRun))
+	if err := Convert_v1beta1_AnalysisRunMetadata_To_v1alpha1_AnalysisRunMetadata(&in.AnalysisRunMetadata, &out.AnalysisRunMetadata, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_RolloutExperimentStep_To_v1beta1_RolloutExperimentStep(in *v1alpha1.RolloutExperimentStep, out *RolloutExperimentStep, s conversion.Scope) error {
+	out.Templates = *(*[]RolloutExperimentTemplate)(unsafe.Pointer(&in.Templates))
+	// WARNING: in.Duration requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.Analyses = *(*[]RolloutExperimentStepAnalysisTemplateRef)(unsafe.Pointer(&in.Analyses))
+	out.DryRun = *(*[]DryRun)(unsafe.Pointer(&in.DryRun))
+	if err := Convert_v1alpha1_AnalysisRunMetadata_To_v1beta1_AnalysisRunMetadata(&in.AnalysisRunMetadata, &out.AnalysisRunMetadata, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1beta1_RolloutExperimentStepAnalysisTemplateRef_To_v1alpha1_RolloutExperimentStepAnalysisTemplateRef(in *RolloutExperimentStepAnalysisTemplateRef, out *v1alpha1.RolloutExperimentStepAnalysisTemplateRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.TemplateName = in.TemplateName
+	out.ClusterScope = in.ClusterScope
+	out.Args = *(*[]v1alpha1.AnalysisRunArgument)(unsafe.Pointer(&in.Args))
+	out.RequiredForCompletion = in.RequiredForCompletion
+	return nil
+}
+
+// Convert_v1beta1_RolloutExperimentStepAnalysisTemplateRef_To_v1alpha1_RolloutExperimentStepAnalysisTemplateRef is an autogenerated conversion function.
+func Convert_v1beta1_RolloutExperimentStepAnalysisTemplateRef_To_v1alpha1_RolloutExperimentStepAnalysisTemplateRef(in *RolloutExperimentStepAnalysisTemplateRef, out *v1alpha1.RolloutExperimentStepAnalysisTemplateRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutExperimentStepAnalysisTemplateRef_To_v1alpha1_RolloutExperimentStepAnalysisTemplateRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutExperimentStepAnalysisTemplateRef_To_v1beta1_RolloutExperimentStepAnalysisTemplateRef(in *v1alpha1.RolloutExperimentStepAnalysisTemplateRef, out *RolloutExperimentStepAnalysisTemplateRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.TemplateName = in.TemplateName
+	out.ClusterScope = in.ClusterScope
+	out.Args = *(*[]AnalysisRunArgument)(unsafe.Pointer(&in.Args))
+	out.RequiredForCompletion = in.RequiredForCompletion
+	return nil
+}
+
+// Convert_v1alpha1_RolloutExperimentStepAnalysisTemplateRef_To_v1beta1_RolloutExperimentStepAnalysisTemplateRef is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutExperimentStepAnalysisTemplateRef_To_v1beta1_RolloutExperimentStepAnalysisTemplateRef(in *v1alpha1.RolloutExperimentStepAnalysisTemplateRef, out *RolloutExperimentStepAnalysisTemplateRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutExperimentStepAnalysisTemplateRef_To_v1beta1_RolloutExperimentStepAnalysisTemplateRef(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutExperimentTemplate_To_v1alpha1_RolloutExperimentTemplate(in *RolloutExperimentTemplate, out *v1alpha1.RolloutExperimentTemplate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SpecRef = v1alpha1.ReplicaSetSpecRef(in.SpecRef)
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	if err := Convert_v1beta1_PodTemplateMetadata_To_v1alpha1_PodTemplateMetadata(&in.Metadata, &out.Metadata, s); err != nil {
+		return err
+	}
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	out.Service = (*v1alpha1.TemplateService)(unsafe.Pointer(in.Service))
+	return nil
+}
+
+// Convert_v1beta1_RolloutExperimentTemplate_To_v1alpha1_RolloutExperimentTemplate is an autogenerated conversion function.
+func Convert_v1beta1_RolloutExperimentTemplate_To_v1alpha1_RolloutExperimentTemplate(in *RolloutExperimentTemplate, out *v1alpha1.RolloutExperimentTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutExperimentTemplate_To_v1alpha1_RolloutExperimentTemplate(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutExperimentTemplate_To_v1beta1_RolloutExperimentTemplate(in *v1alpha1.RolloutExperimentTemplate, out *RolloutExperimentTemplate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.SpecRef = ReplicaSetSpecRef(in.SpecRef)
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	if err := Convert_v1alpha1_PodTemplateMetadata_To_v1beta1_PodTemplateMetadata(&in.Metadata, &out.Metadata, s); err != nil {
+		return err
+	}
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	out.Service = (*TemplateService)(unsafe.Pointer(in.Service))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutExperimentTemplate_To_v1beta1_RolloutExperimentTemplate is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutExperimentTemplate_To_v1beta1_RolloutExperimentTemplate(in *v1alpha1.RolloutExperimentTemplate, out *RolloutExperimentTemplate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutExperimentTemplate_To_v1beta1_RolloutExperimentTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutHook_To_v1alpha1_RolloutHook(in *RolloutHook, out *v1alpha1.RolloutHook, s conversion.Scope) error {
+	if err := Convert_v1beta1_PodTemplateMetadata_To_v1alpha1_PodTemplateMetadata(&in.Metadata, &out.Metadata, s); err != nil {
+		return err
+	}
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1beta1_RolloutHook_To_v1alpha1_RolloutHook is an autogenerated conversion function.
+func Convert_v1beta1_RolloutHook_To_v1alpha1_RolloutHook(in *RolloutHook, out *v1alpha1.RolloutHook, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutHook_To_v1alpha1_RolloutHook(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutHook_To_v1beta1_RolloutHook(in *v1alpha1.RolloutHook, out *RolloutHook, s conversion.Scope) error {
+	if err := Convert_v1alpha1_PodTemplateMetadata_To_v1beta1_PodTemplateMetadata(&in.Metadata, &out.Metadata, s); err != nil {
+		return err
+	}
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1alpha1_RolloutHook_To_v1beta1_RolloutHook is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutHook_To_v1beta1_RolloutHook(in *v1alpha1.RolloutHook, out *RolloutHook, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutHook_To_v1beta1_RolloutHook(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutHookStatus_To_v1alpha1_RolloutHookStatus(in *RolloutHookStatus, out *v1alpha1.RolloutHookStatus, s conversion.Scope) error {
+	out.Type = v1alpha1.RolloutHookType(in.Type)
+	out.PodTemplateHash = in.PodTemplateHash
+	out.JobName = in.JobName
+	out.Phase = v1alpha1.RolloutHookPhase(in.Phase)
+	out.Message = in.Message
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.FinishedAt = (*v1.Time)(unsafe.Pointer(in.FinishedAt))
+	return nil
+}
+
+// Convert_v1beta1_RolloutHookStatus_To_v1alpha1_RolloutHookStatus is an autogenerated conversion function.
+func Convert_v1beta1_RolloutHookStatus_To_v1alpha1_RolloutHookStatus(in *RolloutHookStatus, out *v1alpha1.RolloutHookStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutHookStatus_To_v1alpha1_RolloutHookStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutHookStatus_To_v1beta1_RolloutHookStatus(in *v1alpha1.RolloutHookStatus, out *RolloutHookStatus, s conversion.Scope) error {
+	out.Type = RolloutHookType(in.Type)
+	out.PodTemplateHash = in.PodTemplateHash
+	out.JobName = in.JobName
+	out.Phase = RolloutHookPhase(in.Phase)
+	out.Message = in.Message
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.FinishedAt = (*v1.Time)(unsafe.Pointer(in.FinishedAt))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutHookStatus_To_v1beta1_RolloutHookStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutHookStatus_To_v1beta1_RolloutHookStatus(in *v1alpha1.RolloutHookStatus, out *RolloutHookStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutHookStatus_To_v1beta1_RolloutHookStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutHooks_To_v1alpha1_RolloutHooks(in *RolloutHooks, out *v1alpha1.RolloutHooks, s conversion.Scope) error {
+	out.PreRollout = (*v1alpha1.RolloutHook)(unsafe.Pointer(in.PreRollout))
+	out.PostPromotion = (*v1alpha1.RolloutHook)(unsafe.Pointer(in.PostPromotion))
+	out.OnAbort = (*v1alpha1.RolloutHook)(unsafe.Pointer(in.OnAbort))
+	return nil
+}
+
+// Convert_v1beta1_RolloutHooks_To_v1alpha1_RolloutHooks is an autogenerated conversion function.
+func Convert_v1beta1_RolloutHooks_To_v1alpha1_RolloutHooks(in *RolloutHooks, out *v1alpha1.RolloutHooks, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutHooks_To_v1alpha1_RolloutHooks(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutHooks_To_v1beta1_RolloutHooks(in *v1alpha1.RolloutHooks, out *RolloutHooks, s conversion.Scope) error {
+	out.PreRollout = (*RolloutHook)(unsafe.Pointer(in.PreRollout))
+	out.PostPromotion = (*RolloutHook)(unsafe.Pointer(in.PostPromotion))
+	out.OnAbort = (*RolloutHook)(unsafe.Pointer(in.OnAbort))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutHooks_To_v1beta1_RolloutHooks is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutHooks_To_v1beta1_RolloutHooks(in *v1alpha1.RolloutHooks, out *RolloutHooks, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutHooks_To_v1beta1_RolloutHooks(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutLifecycleStatus_To_v1alpha1_RolloutLifecycleStatus(in *RolloutLifecycleStatus, out *v1alpha1.RolloutLifecycleStatus, s conversion.Scope) error {
+	out.PodTemplateHash = in.PodTemplateHash
+	out.StartedAt = in.StartedAt
+	return nil
+}
+
+// Convert_v1beta1_RolloutLifecycleStatus_To_v1alpha1_RolloutLifecycleStatus is an autogenerated conversion function.
+func Convert_v1beta1_RolloutLifecycleStatus_To_v1alpha1_RolloutLifecycleStatus(in *RolloutLifecycleStatus, out *v1alpha1.RolloutLifecycleStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutLifecycleStatus_To_v1alpha1_RolloutLifecycleStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutLifecycleStatus_To_v1beta1_RolloutLifecycleStatus(in *v1alpha1.RolloutLifecycleStatus, out *RolloutLifecycleStatus, s conversion.Scope) error {
+	out.PodTemplateHash = in.PodTemplateHash
+	out.StartedAt = in.StartedAt
+	return nil
+}
+
+// Convert_v1alpha1_RolloutLifecycleStatus_To_v1beta1_RolloutLifecycleStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutLifecycleStatus_To_v1beta1_RolloutLifecycleStatus(in *v1alpha1.RolloutLifecycleStatus, out *RolloutLifecycleStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutLifecycleStatus_To_v1beta1_RolloutLifecycleStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutList_To_v1alpha1_RolloutList(in *RolloutList, out *v1alpha1.RolloutList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha1.Rollout, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Rollout_To_v1alpha1_Rollout(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_RolloutList_To_v1alpha1_RolloutList is an autogenerated conversion function.
+func Convert_v1beta1_RolloutList_To_v1alpha1_RolloutList(in *RolloutList, out *v1alpha1.RolloutList, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutList_To_v1alpha1_RolloutList(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutList_To_v1beta1_RolloutList(in *v1alpha1.RolloutList, out *RolloutList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Rollout, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Rollout_To_v1beta1_Rollout(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RolloutList_To_v1beta1_RolloutList is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutList_To_v1beta1_RolloutList(in *v1alpha1.RolloutList, out *RolloutList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutList_To_v1beta1_RolloutList(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutPause_To_v1alpha1_RolloutPause(in *RolloutPause, out *v1alpha1.RolloutPause, s conversion.Scope) error {
+	out.Duration = (*intstr.IntOrString)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1beta1_RolloutPause_To_v1alpha1_RolloutPause is an autogenerated conversion function.
+func Convert_v1beta1_RolloutPause_To_v1alpha1_RolloutPause(in *RolloutPause, out *v1alpha1.RolloutPause, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutPause_To_v1alpha1_RolloutPause(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutPause_To_v1beta1_RolloutPause(in *v1alpha1.RolloutPause, out *RolloutPause, s conversion.Scope) error {
+	out.Duration = (*intstr.IntOrString)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutPause_To_v1beta1_RolloutPause is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutPause_To_v1beta1_RolloutPause(in *v1alpha1.RolloutPause, out *RolloutPause, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutPause_To_v1beta1_RolloutPause(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutRetryBackoff_To_v1alpha1_RolloutRetryBackoff(in *RolloutRetryBackoff, out *v1alpha1.RolloutRetryBackoff, s conversion.Scope) error {
+	// WARNING: in.Duration requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	out.Factor = (*int32)(unsafe.Pointer(in.Factor))
+	// WARNING: in.MaxDuration requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	return nil
+}
+
+func autoConvert_v1alpha1_RolloutRetryBackoff_To_v1beta1_RolloutRetryBackoff(in *v1alpha1.RolloutRetryBackoff, out *RolloutRetryBackoff, s conversion.Scope) error {
+	// WARNING: in.Duration requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.Factor = (*int32)(unsafe.Pointer(in.Factor))
+	// WARNING: in.MaxDuration requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	return nil
+}
+
+func autoConvert_v1beta1_RolloutRetryPolicy_To_v1alpha1_RolloutRetryPolicy(in *RolloutRetryPolicy, out *v1alpha1.RolloutRetryPolicy, s conversion.Scope) error {
+	out.Limit = in.Limit
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(v1alpha1.RolloutRetryBackoff)
+		if err := Convert_v1beta1_RolloutRetryBackoff_To_v1alpha1_RolloutRetryBackoff(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Backoff = nil
+	}
+	out.RetryOn = *(*[]v1alpha1.RolloutAbortReason)(unsafe.Pointer(&in.RetryOn))
+	return nil
+}
+
+// Convert_v1beta1_RolloutRetryPolicy_To_v1alpha1_RolloutRetryPolicy is an autogenerated conversion function.
+func Convert_v1beta1_RolloutRetryPolicy_To_v1alpha1_RolloutRetryPolicy(in *RolloutRetryPolicy, out *v1alpha1.RolloutRetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutRetryPolicy_To_v1alpha1_RolloutRetryPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutRetryPolicy_To_v1beta1_RolloutRetryPolicy(in *v1alpha1.RolloutRetryPolicy, out *RolloutRetryPolicy, s conversion.Scope) error {
+	out.Limit = in.Limit
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RolloutRetryBackoff)
+		if err := Convert_v1alpha1_RolloutRetryBackoff_To_v1beta1_RolloutRetryBackoff(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Backoff = nil
+	}
+	out.RetryOn = *(*[]RolloutAbortReason)(unsafe.Pointer(&in.RetryOn))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutRetryPolicy_To_v1beta1_RolloutRetryPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutRetryPolicy_To_v1beta1_RolloutRetryPolicy(in *v1alpha1.RolloutRetryPolicy, out *RolloutRetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutRetryPolicy_To_v1beta1_RolloutRetryPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutRetryStatus_To_v1alpha1_RolloutRetryStatus(in *RolloutRetryStatus, out *v1alpha1.RolloutRetryStatus, s conversion.Scope) error {
+	out.PodTemplateHash = in.PodTemplateHash
+	out.Attempts = in.Attempts
+	out.LastAbortReason = v1alpha1.RolloutAbortReason(in.LastAbortReason)
+	out.NextRetryAt = (*v1.Time)(unsafe.Pointer(in.NextRetryAt))
+	return nil
+}
+
+// Convert_v1beta1_RolloutRetryStatus_To_v1alpha1_RolloutRetryStatus is an autogenerated conversion function.
+func Convert_v1beta1_RolloutRetryStatus_To_v1alpha1_RolloutRetryStatus(in *RolloutRetryStatus, out *v1alpha1.RolloutRetryStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutRetryStatus_To_v1alpha1_RolloutRetryStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutRetryStatus_To_v1beta1_RolloutRetryStatus(in *v1alpha1.RolloutRetryStatus, out *RolloutRetryStatus, s conversion.Scope) error {
+	out.PodTemplateHash = in.PodTemplateHash
+	out.Attempts = in.Attempts
+	out.LastAbortReason = RolloutAbortReason(in.LastAbortReason)
+	out.NextRetryAt = (*v1.Time)(unsafe.Pointer(in.NextRetryAt))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutRetryStatus_To_v1beta1_RolloutRetryStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutRetryStatus_To_v1beta1_RolloutRetryStatus(in *v1alpha1.RolloutRetryStatus, out *RolloutRetryStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutRetryStatus_To_v1beta1_RolloutRetryStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutSpec_To_v1alpha1_RolloutSpec(in *RolloutSpec, out *v1alpha1.RolloutSpec, s conversion.Scope) error {
+	out.TemplateResolvedFromRef = in.TemplateResolvedFromRef
+	out.SelectorResolvedFromRef = in.SelectorResolvedFromRef
+	out.StrategyResolvedFromRef = in.StrategyResolvedFromRef
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Template = in.Template
+	out.WorkloadRef = (*v1alpha1.ObjectRef)(unsafe.Pointer(in.WorkloadRef))
+	out.MinReadySeconds = in.MinReadySeconds
+	out.RollbackWindow = (*v1alpha1.RollbackWindowSpec)(unsafe.Pointer(in.RollbackWindow))
+	if err := Convert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	out.StrategyRef = (*v1alpha1.StrategyRef)(unsafe.Pointer(in.StrategyRef))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Paused = in.Paused
+	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	out.ProgressDeadlineAbort = in.ProgressDeadlineAbort
+	out.RestartAt = (*v1.Time)(unsafe.Pointer(in.RestartAt))
+	out.Analysis = (*v1alpha1.AnalysisRunStrategy)(unsafe.Pointer(in.Analysis))
+	out.ConfigRefs = *(*[]v1alpha1.ConfigRef)(unsafe.Pointer(&in.ConfigRefs))
+	out.Hooks = (*v1alpha1.RolloutHooks)(unsafe.Pointer(in.Hooks))
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(v1alpha1.RolloutRetryPolicy)
+		if err := Convert_v1beta1_RolloutRetryPolicy_To_v1alpha1_RolloutRetryPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RetryPolicy = nil
+	}
+	out.UnresolvedConfigTemplate = (*corev1.PodTemplateSpec)(unsafe.Pointer(in.UnresolvedConfigTemplate))
+	return nil
+}
+
+// Convert_v1beta1_RolloutSpec_To_v1alpha1_RolloutSpec is an autogenerated conversion function.
+func Convert_v1beta1_RolloutSpec_To_v1alpha1_RolloutSpec(in *RolloutSpec, out *v1alpha1.RolloutSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutSpec_To_v1alpha1_RolloutSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutSpec_To_v1beta1_RolloutSpec(in *v1alpha1.RolloutSpec, out *RolloutSpec, s conversion.Scope) error {
+	out.TemplateResolvedFromRef = in.TemplateResolvedFromRef
+	out.SelectorResolvedFromRef = in.SelectorResolvedFromRef
+	out.StrategyResolvedFromRef = in.StrategyResolvedFromRef
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Template = in.Template
+	out.WorkloadRef = (*ObjectRef)(unsafe.Pointer(in.WorkloadRef))
+	out.MinReadySeconds = in.MinReadySeconds
+	out.RollbackWindow = (*RollbackWindowSpec)(unsafe.Pointer(in.RollbackWindow))
+	if err := Convert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(&in.Strategy, &out.Strategy, s); err != nil {
+		return err
+	}
+	out.StrategyRef = (*StrategyRef)(unsafe.Pointer(in.StrategyRef))
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Paused = in.Paused
+	out.ProgressDeadlineSeconds = (*int32)(unsafe.Pointer(in.ProgressDeadlineSeconds))
+	out.ProgressDeadlineAbort = in.ProgressDeadlineAbort
+	out.RestartAt = (*v1.Time)(unsafe.Pointer(in.RestartAt))
+	out.Analysis = (*AnalysisRunStrategy)(unsafe.Pointer(in.Analysis))
+	out.ConfigRefs = *(*[]ConfigRef)(unsafe.Pointer(&in.ConfigRefs))
+	out.Hooks = (*RolloutHooks)(unsafe.Pointer(in.Hooks))
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RolloutRetryPolicy)
+		if err := Convert_v1alpha1_RolloutRetryPolicy_To_v1beta1_RolloutRetryPolicy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RetryPolicy = nil
+	}
+	out.UnresolvedConfigTemplate = (*corev1.PodTemplateSpec)(unsafe.Pointer(in.UnresolvedConfigTemplate))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutSpec_To_v1beta1_RolloutSpec is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutSpec_To_v1beta1_RolloutSpec(in *v1alpha1.RolloutSpec, out *RolloutSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutSpec_To_v1beta1_RolloutSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutStatus_To_v1alpha1_RolloutStatus(in *RolloutStatus, out *v1alpha1.RolloutStatus, s conversion.Scope) error {
+	out.Abort = in.Abort
+	out.PauseConditions = *(*[]v1alpha1.PauseCondition)(unsafe.Pointer(&in.PauseConditions))
+	out.ControllerPause = in.ControllerPause
+	out.AbortedAt = (*v1.Time)(unsafe.Pointer(in.AbortedAt))
+	out.CurrentPodHash = in.CurrentPodHash
+	out.CurrentStepHash = in.CurrentStepHash
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.CurrentStepIndex = (*int32)(unsafe.Pointer(in.CurrentStepIndex))
+	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]v1alpha1.RolloutCondition)(unsafe.Pointer(&in.Conditions))
+	if err := Convert_v1beta1_CanaryStatus_To_v1alpha1_CanaryStatus(&in.Canary, &out.Canary, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_BlueGreenStatus_To_v1alpha1_BlueGreenStatus(&in.BlueGreen, &out.BlueGreen, s); err != nil {
+		return err
+	}
+	out.HPAReplicas = in.HPAReplicas
+	out.Selector = in.Selector
+	out.StableRS = in.StableRS
+	out.RestartedAt = (*v1.Time)(unsafe.Pointer(in.RestartedAt))
+	out.PromoteFull = in.PromoteFull
+	out.Phase = v1alpha1.RolloutPhase(in.Phase)
+	out.Message = in.Message
+	out.WorkloadObservedGeneration = in.WorkloadObservedGeneration
+	out.ALB = (*v1alpha1.ALBStatus)(unsafe.Pointer(in.ALB))
+	out.ALBs = *(*[]v1alpha1.ALBStatus)(unsafe.Pointer(&in.ALBs))
+	out.Hooks = *(*[]v1alpha1.RolloutHookStatus)(unsafe.Pointer(&in.Hooks))
+	out.Retry = (*v1alpha1.RolloutRetryStatus)(unsafe.Pointer(in.Retry))
+	out.CurrentStep = (*v1alpha1.RolloutStepStatus)(unsafe.Pointer(in.CurrentStep))
+	out.Lifecycle = (*v1alpha1.RolloutLifecycleStatus)(unsafe.Pointer(in.Lifecycle))
+	out.StrategyTemplate = (*v1alpha1.RolloutStrategyTemplateStatus)(unsafe.Pointer(in.StrategyTemplate))
+	return nil
+}
+
+// Convert_v1beta1_RolloutStatus_To_v1alpha1_RolloutStatus is an autogenerated conversion function.
+func Convert_v1beta1_RolloutStatus_To_v1alpha1_RolloutStatus(in *RolloutStatus, out *v1alpha1.RolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutStatus_To_v1alpha1_RolloutStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStatus_To_v1beta1_RolloutStatus(in *v1alpha1.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	out.Abort = in.Abort
+	out.PauseConditions = *(*[]PauseCondition)(unsafe.Pointer(&in.PauseConditions))
+	out.ControllerPause = in.ControllerPause
+	out.AbortedAt = (*v1.Time)(unsafe.Pointer(in.AbortedAt))
+	out.CurrentPodHash = in.CurrentPodHash
+	out.CurrentStepHash = in.CurrentStepHash
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.CurrentStepIndex = (*int32)(unsafe.Pointer(in.CurrentStepIndex))
+	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]RolloutCondition)(unsafe.Pointer(&in.Conditions))
+	if err := Convert_v1alpha1_CanaryStatus_To_v1beta1_CanaryStatus(&in.Canary, &out.Canary, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_BlueGreenStatus_To_v1beta1_BlueGreenStatus(&in.BlueGreen, &out.BlueGreen, s); err != nil {
+		return err
+	}
+	out.HPAReplicas = in.HPAReplicas
+	out.Selector = in.Selector
+	out.StableRS = in.StableRS
+	out.RestartedAt = (*v1.Time)(unsafe.Pointer(in.RestartedAt))
+	out.PromoteFull = in.PromoteFull
+	out.Phase = RolloutPhase(in.Phase)
+	out.Message = in.Message
+	out.WorkloadObservedGeneration = in.WorkloadObservedGeneration
+	out.ALB = (*ALBStatus)(unsafe.Pointer(in.ALB))
+	out.ALBs = *(*[]ALBStatus)(unsafe.Pointer(&in.ALBs))
+	out.Hooks = *(*[]RolloutHookStatus)(unsafe.Pointer(&in.Hooks))
+	out.Retry = (*RolloutRetryStatus)(unsafe.Pointer(in.Retry))
+	out.CurrentStep = (*RolloutStepStatus)(unsafe.Pointer(in.CurrentStep))
+	out.Lifecycle = (*RolloutLifecycleStatus)(unsafe.Pointer(in.Lifecycle))
+	out.StrategyTemplate = (*RolloutStrategyTemplateStatus)(unsafe.Pointer(in.StrategyTemplate))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStatus_To_v1beta1_RolloutStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStatus_To_v1beta1_RolloutStatus(in *v1alpha1.RolloutStatus, out *RolloutStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStatus_To_v1beta1_RolloutStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutStepStatus_To_v1alpha1_RolloutStepStatus(in *RolloutStepStatus, out *v1alpha1.RolloutStepStatus, s conversion.Scope) error {
+	out.Step = in.Step
+	out.PodTemplateHash = in.PodTemplateHash
+	out.StartedAt = in.StartedAt
+	out.TimedOutAt = (*v1.Time)(unsafe.Pointer(in.TimedOutAt))
+	return nil
+}
+
+// Convert_v1beta1_RolloutStepStatus_To_v1alpha1_RolloutStepStatus is an autogenerated conversion function.
+func Convert_v1beta1_RolloutStepStatus_To_v1alpha1_RolloutStepStatus(in *RolloutStepStatus, out *v1alpha1.RolloutStepStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutStepStatus_To_v1alpha1_RolloutStepStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStepStatus_To_v1beta1_RolloutStepStatus(in *v1alpha1.RolloutStepStatus, out *RolloutStepStatus, s conversion.Scope) error {
+	out.Step = in.Step
+	out.PodTemplateHash = in.PodTemplateHash
+	out.StartedAt = in.StartedAt
+	out.TimedOutAt = (*v1.Time)(unsafe.Pointer(in.TimedOutAt))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStepStatus_To_v1beta1_RolloutStepStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStepStatus_To_v1beta1_RolloutStepStatus(in *v1alpha1.RolloutStepStatus, out *RolloutStepStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStepStatus_To_v1beta1_RolloutStepStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(in *RolloutStrategy, out *v1alpha1.RolloutStrategy, s conversion.Scope) error {
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(v1alpha1.BlueGreenStrategy)
+		if err := Convert_v1beta1_BlueGreenStrategy_To_v1alpha1_BlueGreenStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreen = nil
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(v1alpha1.CanaryStrategy)
+		if err := Convert_v1beta1_CanaryStrategy_To_v1alpha1_CanaryStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy is an autogenerated conversion function.
+func Convert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(in *RolloutStrategy, out *v1alpha1.RolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutStrategy_To_v1alpha1_RolloutStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(in *v1alpha1.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
+	if in.BlueGreen != nil {
+		in, out := &in.BlueGreen, &out.BlueGreen
+		*out = new(BlueGreenStrategy)
+		if err := Convert_v1alpha1_BlueGreenStrategy_To_v1beta1_BlueGreenStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.BlueGreen = nil
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStrategy)
+		if err := Convert_v1alpha1_CanaryStrategy_To_v1beta1_CanaryStrategy(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Canary = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(in *v1alpha1.RolloutStrategy, out *RolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStrategy_To_v1beta1_RolloutStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutStrategyTemplateStatus_To_v1alpha1_RolloutStrategyTemplateStatus(in *RolloutStrategyTemplateStatus, out *v1alpha1.RolloutStrategyTemplateStatus, s conversion.Scope) error {
+	out.PodTemplateHash = in.PodTemplateHash
+	out.Name = in.Name
+	out.ClusterScope = in.ClusterScope
+	out.Generation = in.Generation
+	out.StrategyHash = in.StrategyHash
+	return nil
+}
+
+// Convert_v1beta1_RolloutStrategyTemplateStatus_To_v1alpha1_RolloutStrategyTemplateStatus is an autogenerated conversion function.
+func Convert_v1beta1_RolloutStrategyTemplateStatus_To_v1alpha1_RolloutStrategyTemplateStatus(in *RolloutStrategyTemplateStatus, out *v1alpha1.RolloutStrategyTemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutStrategyTemplateStatus_To_v1alpha1_RolloutStrategyTemplateStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutStrategyTemplateStatus_To_v1beta1_RolloutStrategyTemplateStatus(in *v1alpha1.RolloutStrategyTemplateStatus, out *RolloutStrategyTemplateStatus, s conversion.Scope) error {
+	out.PodTemplateHash = in.PodTemplateHash
+	out.Name = in.Name
+	out.ClusterScope = in.ClusterScope
+	out.Generation = in.Generation
+	out.StrategyHash = in.StrategyHash
+	return nil
+}
+
+// Convert_v1alpha1_RolloutStrategyTemplateStatus_To_v1beta1_RolloutStrategyTemplateStatus is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutStrategyTemplateStatus_To_v1beta1_RolloutStrategyTemplateStatus(in *v1alpha1.RolloutStrategyTemplateStatus, out *RolloutStrategyTemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutStrategyTemplateStatus_To_v1beta1_RolloutStrategyTemplateStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_RolloutTrafficRouting_To_v1alpha1_RolloutTrafficRouting(in *RolloutTrafficRouting, out *v1alpha1.RolloutTrafficRouting, s conversion.Scope) error {
+	out.Istio = (*v1alpha1.IstioTrafficRouting)(unsafe.Pointer(in.Istio))
+	out.Nginx = (*v1alpha1.NginxTrafficRouting)(unsafe.Pointer(in.Nginx))
+	out.ALB = (*v1alpha1.ALBTrafficRouting)(unsafe.Pointer(in.ALB))
+	out.SMI = (*v1alpha1.SMITrafficRouting)(unsafe.Pointer(in.SMI))
+	out.Ambassador = (*v1alpha1.AmbassadorTrafficRouting)(unsafe.Pointer(in.Ambassador))
+	out.AppMesh = (*v1alpha1.AppMeshTrafficRouting)(unsafe.Pointer(in.AppMesh))
+	out.Traefik = (*v1alpha1.TraefikTrafficRouting)(unsafe.Pointer(in.Traefik))
+	out.ManagedRoutes = *(*[]v1alpha1.MangedRoutes)(unsafe.Pointer(&in.ManagedRoutes))
+	out.Apisix = (*v1alpha1.ApisixTrafficRouting)(unsafe.Pointer(in.Apisix))
+	out.Plugins = *(*map[string]json.RawMessage)(unsafe.Pointer(&in.Plugins))
+	out.MaxTrafficWeight = (*int32)(unsafe.Pointer(in.MaxTrafficWeight))
+	out.Contour = (*v1alpha1.ContourTrafficRouting)(unsafe.Pointer(in.Contour))
+	out.Kong = (*v1alpha1.KongTrafficRouting)(unsafe.Pointer(in.Kong))
+	out.Linkerd = (*v1alpha1.LinkerdTrafficRouting)(unsafe.Pointer(in.Linkerd))
+	out.DNS = (*v1alpha1.DNSTrafficRouting)(unsafe.Pointer(in.DNS))
+	out.Stickiness = (*v1alpha1.TrafficStickiness)(unsafe.Pointer(in.Stickiness))
+	return nil
+}
+
+// Convert_v1beta1_RolloutTrafficRouting_To_v1alpha1_RolloutTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_RolloutTrafficRouting_To_v1alpha1_RolloutTrafficRouting(in *RolloutTrafficRouting, out *v1alpha1.RolloutTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutTrafficRouting_To_v1alpha1_RolloutTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_RolloutTrafficRouting_To_v1beta1_RolloutTrafficRouting(in *v1alpha1.RolloutTrafficRouting, out *RolloutTrafficRouting, s conversion.Scope) error {
+	out.Istio = (*IstioTrafficRouting)(unsafe.Pointer(in.Istio))
+	out.Nginx = (*NginxTrafficRouting)(unsafe.Pointer(in.Nginx))
+	out.ALB = (*ALBTrafficRouting)(unsafe.Pointer(in.ALB))
+	out.SMI = (*SMITrafficRouting)(unsafe.Pointer(in.SMI))
+	out.Ambassador = (*AmbassadorTrafficRouting)(unsafe.Pointer(in.Ambassador))
+	out.AppMesh = (*AppMeshTrafficRouting)(unsafe.Pointer(in.AppMesh))
+	out.Traefik = (*TraefikTrafficRouting)(unsafe.Pointer(in.Traefik))
+	out.ManagedRoutes = *(*[]ManagedRoute)(unsafe.Pointer(&in.ManagedRoutes))
+	out.Apisix = (*ApisixTrafficRouting)(unsafe.Pointer(in.Apisix))
+	out.Plugins = *(*map[string]json.RawMessage)(unsafe.Pointer(&in.Plugins))
+	out.MaxTrafficWeight = (*int32)(unsafe.Pointer(in.MaxTrafficWeight))
+	out.Contour = (*ContourTrafficRouting)(unsafe.Pointer(in.Contour))
+	out.Kong = (*KongTrafficRouting)(unsafe.Pointer(in.Kong))
+	out.Linkerd = (*LinkerdTrafficRouting)(unsafe.Pointer(in.Linkerd))
+	out.DNS = (*DNSTrafficRouting)(unsafe.Pointer(in.DNS))
+	out.Stickiness = (*TrafficStickiness)(unsafe.Pointer(in.Stickiness))
+	return nil
+}
+
+// Convert_v1alpha1_RolloutTrafficRouting_To_v1beta1_RolloutTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_RolloutTrafficRouting_To_v1beta1_RolloutTrafficRouting(in *v1alpha1.RolloutTrafficRouting, out *RolloutTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RolloutTrafficRouting_To_v1beta1_RolloutTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_RouteMatch_To_v1alpha1_RouteMatch(in *RouteMatch, out *v1alpha1.RouteMatch, s conversion.Scope) error {
+	out.Method = (*v1alpha1.StringMatch)(unsafe.Pointer(in.Method))
+	out.Path = (*v1alpha1.StringMatch)(unsafe.Pointer(in.Path))
+	out.Headers = *(*map[string]v1alpha1.StringMatch)(unsafe.Pointer(&in.Headers))
+	return nil
+}
+
+// Convert_v1beta1_RouteMatch_To_v1alpha1_RouteMatch is an autogenerated conversion function.
+func Convert_v1beta1_RouteMatch_To_v1alpha1_RouteMatch(in *RouteMatch, out *v1alpha1.RouteMatch, s conversion.Scope) error {
+	return autoConvert_v1beta1_RouteMatch_To_v1alpha1_RouteMatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_RouteMatch_To_v1beta1_RouteMatch(in *v1alpha1.RouteMatch, out *RouteMatch, s conversion.Scope) error {
+	out.Method = (*StringMatch)(unsafe.Pointer(in.Method))
+	out.Path = (*StringMatch)(unsafe.Pointer(in.Path))
+	out.Headers = *(*map[string]StringMatch)(unsafe.Pointer(&in.Headers))
+	return nil
+}
+
+// Convert_v1alpha1_RouteMatch_To_v1beta1_RouteMatch is an autogenerated conversion function.
+func Convert_v1alpha1_RouteMatch_To_v1beta1_RouteMatch(in *v1alpha1.RouteMatch, out *RouteMatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RouteMatch_To_v1beta1_RouteMatch(in, out, s)
+}
+
+func autoConvert_v1beta1_RunSummary_To_v1alpha1_RunSummary(in *RunSummary, out *v1alpha1.RunSummary, s conversion.Scope) error {
+	out.Count = in.Count
+	out.Successful = in.Successful
+	out.Failed = in.Failed
+	out.Inconclusive = in.Inconclusive
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_v1beta1_RunSummary_To_v1alpha1_RunSummary is an autogenerated conversion function.
+func Convert_v1beta1_RunSummary_To_v1alpha1_RunSummary(in *RunSummary, out *v1alpha1.RunSummary, s conversion.Scope) error {
+	return autoConvert_v1beta1_RunSummary_To_v1alpha1_RunSummary(in, out, s)
+}
+
+func autoConvert_v1alpha1_RunSummary_To_v1beta1_RunSummary(in *v1alpha1.RunSummary, out *RunSummary, s conversion.Scope) error {
+	out.Count = in.Count
+	out.Successful = in.Successful
+	out.Failed = in.Failed
+	out.Inconclusive = in.Inconclusive
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_v1alpha1_RunSummary_To_v1beta1_RunSummary is an autogenerated conversion function.
+func Convert_v1alpha1_RunSummary_To_v1beta1_RunSummary(in *v1alpha1.RunSummary, out *RunSummary, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RunSummary_To_v1beta1_RunSummary(in, out, s)
+}
+
+func autoConvert_v1beta1_SMITrafficRouting_To_v1alpha1_SMITrafficRouting(in *SMITrafficRouting, out *v1alpha1.SMITrafficRouting, s conversion.Scope) error {
+	out.RootService = in.RootService
+	out.TrafficSplitName = in.TrafficSplitName
+	return nil
+}
+
+// Convert_v1beta1_SMITrafficRouting_To_v1alpha1_SMITrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_SMITrafficRouting_To_v1alpha1_SMITrafficRouting(in *SMITrafficRouting, out *v1alpha1.SMITrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_SMITrafficRouting_To_v1alpha1_SMITrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_SMITrafficRouting_To_v1beta1_SMITrafficRouting(in *v1alpha1.SMITrafficRouting, out *SMITrafficRouting, s conversion.Scope) error {
+	out.RootService = in.RootService
+	out.TrafficSplitName = in.TrafficSplitName
+	return nil
+}
+
+// Convert_v1alpha1_SMITrafficRouting_To_v1beta1_SMITrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_SMITrafficRouting_To_v1beta1_SMITrafficRouting(in *v1alpha1.SMITrafficRouting, out *SMITrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SMITrafficRouting_To_v1beta1_SMITrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_ScopeDetail_To_v1alpha1_ScopeDetail(in *ScopeDetail, out *v1alpha1.ScopeDetail, s conversion.Scope) error {
+	out.Scope = in.Scope
+	out.Region = in.Region
+	out.Step = in.Step
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1beta1_ScopeDetail_To_v1alpha1_ScopeDetail is an autogenerated conversion function.
+func Convert_v1beta1_ScopeDetail_To_v1alpha1_ScopeDetail(in *ScopeDetail, out *v1alpha1.ScopeDetail, s conversion.Scope) error {
+	return autoConvert_v1beta1_ScopeDetail_To_v1alpha1_ScopeDetail(in, out, s)
+}
+
+func autoConvert_v1alpha1_ScopeDetail_To_v1beta1_ScopeDetail(in *v1alpha1.ScopeDetail, out *ScopeDetail, s conversion.Scope) error {
+	out.Scope = in.Scope
+	out.Region = in.Region
+	out.Step = in.Step
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha1_ScopeDetail_To_v1beta1_ScopeDetail is an autogenerated conversion function.
+func Convert_v1alpha1_ScopeDetail_To_v1beta1_ScopeDetail(in *v1alpha1.ScopeDetail, out *ScopeDetail, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ScopeDetail_To_v1beta1_ScopeDetail(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretKeyRef_To_v1alpha1_SecretKeyRef(in *SecretKeyRef, out *v1alpha1.SecretKeyRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1beta1_SecretKeyRef_To_v1alpha1_SecretKeyRef is an autogenerated conversion function.
+func Convert_v1beta1_SecretKeyRef_To_v1alpha1_SecretKeyRef(in *SecretKeyRef, out *v1alpha1.SecretKeyRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretKeyRef_To_v1alpha1_SecretKeyRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretKeyRef_To_v1beta1_SecretKeyRef(in *v1alpha1.SecretKeyRef, out *SecretKeyRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1alpha1_SecretKeyRef_To_v1beta1_SecretKeyRef is an autogenerated conversion function.
+func Convert_v1alpha1_SecretKeyRef_To_v1beta1_SecretKeyRef(in *v1alpha1.SecretKeyRef, out *SecretKeyRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretKeyRef_To_v1beta1_SecretKeyRef(in, out, s)
+}
+
+func autoConvert_v1beta1_SecretRef_To_v1alpha1_SecretRef(in *SecretRef, out *v1alpha1.SecretRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaced = in.Namespaced
+	return nil
+}
+
+// Convert_v1beta1_SecretRef_To_v1alpha1_SecretRef is an autogenerated conversion function.
+func Convert_v1beta1_SecretRef_To_v1alpha1_SecretRef(in *SecretRef, out *v1alpha1.SecretRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_SecretRef_To_v1alpha1_SecretRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_SecretRef_To_v1beta1_SecretRef(in *v1alpha1.SecretRef, out *SecretRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespaced = in.Namespaced
+	return nil
+}
+
+// Convert_v1alpha1_SecretRef_To_v1beta1_SecretRef is an autogenerated conversion function.
+func Convert_v1alpha1_SecretRef_To_v1beta1_SecretRef(in *v1alpha1.SecretRef, out *SecretRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SecretRef_To_v1beta1_SecretRef(in, out, s)
+}
+
+func autoConvert_v1beta1_SetCanaryNodes_To_v1alpha1_SetCanaryNodes(in *SetCanaryNodes, out *v1alpha1.SetCanaryNodes, s conversion.Scope) error {
+	out.NodeSelector = in.NodeSelector
+	return nil
+}
+
+// Convert_v1beta1_SetCanaryNodes_To_v1alpha1_SetCanaryNodes is an autogenerated conversion function.
+func Convert_v1beta1_SetCanaryNodes_To_v1alpha1_SetCanaryNodes(in *SetCanaryNodes, out *v1alpha1.SetCanaryNodes, s conversion.Scope) error {
+	return autoConvert_v1beta1_SetCanaryNodes_To_v1alpha1_SetCanaryNodes(in, out, s)
+}
+
+func autoConvert_v1alpha1_SetCanaryNodes_To_v1beta1_SetCanaryNodes(in *v1alpha1.SetCanaryNodes, out *SetCanaryNodes, s conversion.Scope) error {
+	out.NodeSelector = in.NodeSelector
+	return nil
+}
+
+// Convert_v1alpha1_SetCanaryNodes_To_v1beta1_SetCanaryNodes is an autogenerated conversion function.
+func Convert_v1alpha1_SetCanaryNodes_To_v1beta1_SetCanaryNodes(in *v1alpha1.SetCanaryNodes, out *SetCanaryNodes, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SetCanaryNodes_To_v1beta1_SetCanaryNodes(in, out, s)
+}
+
+func autoConvert_v1beta1_SetCanaryScale_To_v1alpha1_SetCanaryScale(in *SetCanaryScale, out *v1alpha1.SetCanaryScale, s conversion.Scope) error {
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MatchTrafficWeight = in.MatchTrafficWeight
+	return nil
+}
+
+// Convert_v1beta1_SetCanaryScale_To_v1alpha1_SetCanaryScale is an autogenerated conversion function.
+func Convert_v1beta1_SetCanaryScale_To_v1alpha1_SetCanaryScale(in *SetCanaryScale, out *v1alpha1.SetCanaryScale, s conversion.Scope) error {
+	return autoConvert_v1beta1_SetCanaryScale_To_v1alpha1_SetCanaryScale(in, out, s)
+}
+
+func autoConvert_v1alpha1_SetCanaryScale_To_v1beta1_SetCanaryScale(in *v1alpha1.SetCanaryScale, out *SetCanaryScale, s conversion.Scope) error {
+	out.Weight = (*int32)(unsafe.Pointer(in.Weight))
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MatchTrafficWeight = in.MatchTrafficWeight
+	return nil
+}
+
+// Convert_v1alpha1_SetCanaryScale_To_v1beta1_SetCanaryScale is an autogenerated conversion function.
+func Convert_v1alpha1_SetCanaryScale_To_v1beta1_SetCanaryScale(in *v1alpha1.SetCanaryScale, out *SetCanaryScale, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SetCanaryScale_To_v1beta1_SetCanaryScale(in, out, s)
+}
+
+func autoConvert_v1beta1_SetHeaderRoute_To_v1alpha1_SetHeaderRoute(in *SetHeaderRoute, out *v1alpha1.SetHeaderRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Match = *(*[]v1alpha1.HeaderRoutingMatch)(unsafe.Pointer(&in.Match))
+	return nil
+}
+
+// Convert_v1beta1_SetHeaderRoute_To_v1alpha1_SetHeaderRoute is an autogenerated conversion function.
+func Convert_v1beta1_SetHeaderRoute_To_v1alpha1_SetHeaderRoute(in *SetHeaderRoute, out *v1alpha1.SetHeaderRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_SetHeaderRoute_To_v1alpha1_SetHeaderRoute(in, out, s)
+}
+
+func autoConvert_v1alpha1_SetHeaderRoute_To_v1beta1_SetHeaderRoute(in *v1alpha1.SetHeaderRoute, out *SetHeaderRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Match = *(*[]HeaderRoutingMatch)(unsafe.Pointer(&in.Match))
+	return nil
+}
+
+// Convert_v1alpha1_SetHeaderRoute_To_v1beta1_SetHeaderRoute is an autogenerated conversion function.
+func Convert_v1alpha1_SetHeaderRoute_To_v1beta1_SetHeaderRoute(in *v1alpha1.SetHeaderRoute, out *SetHeaderRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SetHeaderRoute_To_v1beta1_SetHeaderRoute(in, out, s)
+}
+
+func autoConvert_v1beta1_SetMirrorRoute_To_v1alpha1_SetMirrorRoute(in *SetMirrorRoute, out *v1alpha1.SetMirrorRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Match = *(*[]v1alpha1.RouteMatch)(unsafe.Pointer(&in.Match))
+	out.Percentage = (*int32)(unsafe.Pointer(in.Percentage))
+	return nil
+}
+
+// Convert_v1beta1_SetMirrorRoute_To_v1alpha1_SetMirrorRoute is an autogenerated conversion function.
+func Convert_v1beta1_SetMirrorRoute_To_v1alpha1_SetMirrorRoute(in *SetMirrorRoute, out *v1alpha1.SetMirrorRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_SetMirrorRoute_To_v1alpha1_SetMirrorRoute(in, out, s)
+}
+
+func autoConvert_v1alpha1_SetMirrorRoute_To_v1beta1_SetMirrorRoute(in *v1alpha1.SetMirrorRoute, out *SetMirrorRoute, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Match = *(*[]RouteMatch)(unsafe.Pointer(&in.Match))
+	out.Percentage = (*int32)(unsafe.Pointer(in.Percentage))
+	return nil
+}
+
+// Convert_v1alpha1_SetMirrorRoute_To_v1beta1_SetMirrorRoute is an autogenerated conversion function.
+func Convert_v1alpha1_SetMirrorRoute_To_v1beta1_SetMirrorRoute(in *v1alpha1.SetMirrorRoute, out *SetMirrorRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SetMirrorRoute_To_v1beta1_SetMirrorRoute(in, out, s)
+}
+
+func autoConvert_v1beta1_Sigv4Config_To_v1alpha1_Sigv4Config(in *Sigv4Config, out *v1alpha1.Sigv4Config, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Profile = in.Profile
+	out.RoleARN = in.RoleARN
+	return nil
+}
+
+// Convert_v1beta1_Sigv4Config_To_v1alpha1_Sigv4Config is an autogenerated conversion function.
+func Convert_v1beta1_Sigv4Config_To_v1alpha1_Sigv4Config(in *Sigv4Config, out *v1alpha1.Sigv4Config, s conversion.Scope) error {
+	return autoConvert_v1beta1_Sigv4Config_To_v1alpha1_Sigv4Config(in, out, s)
+}
+
+func autoConvert_v1alpha1_Sigv4Config_To_v1beta1_Sigv4Config(in *v1alpha1.Sigv4Config, out *Sigv4Config, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Profile = in.Profile
+	out.RoleARN = in.RoleARN
+	return nil
+}
+
+// Convert_v1alpha1_Sigv4Config_To_v1beta1_Sigv4Config is an autogenerated conversion function.
+func Convert_v1alpha1_Sigv4Config_To_v1beta1_Sigv4Config(in *v1alpha1.Sigv4Config, out *Sigv4Config, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Sigv4Config_To_v1beta1_Sigv4Config(in, out, s)
+}
+
+func autoConvert_v1beta1_SkyWalkingMetric_To_v1alpha1_SkyWalkingMetric(in *SkyWalkingMetric, out *v1alpha1.SkyWalkingMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Query = in.Query
+	out.Interval = v1alpha1.DurationString(in.Interval)
+	return nil
+}
+
+// Convert_v1beta1_SkyWalkingMetric_To_v1alpha1_SkyWalkingMetric is an autogenerated conversion function.
+func Convert_v1beta1_SkyWalkingMetric_To_v1alpha1_SkyWalkingMetric(in *SkyWalkingMetric, out *v1alpha1.SkyWalkingMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_SkyWalkingMetric_To_v1alpha1_SkyWalkingMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_SkyWalkingMetric_To_v1beta1_SkyWalkingMetric(in *v1alpha1.SkyWalkingMetric, out *SkyWalkingMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Query = in.Query
+	out.Interval = DurationString(in.Interval)
+	return nil
+}
+
+// Convert_v1alpha1_SkyWalkingMetric_To_v1beta1_SkyWalkingMetric is an autogenerated conversion function.
+func Convert_v1alpha1_SkyWalkingMetric_To_v1beta1_SkyWalkingMetric(in *v1alpha1.SkyWalkingMetric, out *SkyWalkingMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SkyWalkingMetric_To_v1beta1_SkyWalkingMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_StepPluginStatus_To_v1alpha1_StepPluginStatus(in *StepPluginStatus, out *v1alpha1.StepPluginStatus, s conversion.Scope) error {
+	out.Index = in.Index
+	out.Name = in.Name
+	out.Operation = v1alpha1.StepPluginOperation(in.Operation)
+	out.Phase = v1alpha1.StepPluginPhase(in.Phase)
+	out.Message = in.Message
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.UpdatedAt = (*v1.Time)(unsafe.Pointer(in.UpdatedAt))
+	out.FinishedAt = (*v1.Time)(unsafe.Pointer(in.FinishedAt))
+	// WARNING: in.Backoff requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	out.Executions = in.Executions
+	out.Disabled = in.Disabled
+	out.Status = *(*json.RawMessage)(unsafe.Pointer(&in.Status))
+	return nil
+}
+
+func autoConvert_v1alpha1_StepPluginStatus_To_v1beta1_StepPluginStatus(in *v1alpha1.StepPluginStatus, out *StepPluginStatus, s conversion.Scope) error {
+	out.Index = in.Index
+	out.Name = in.Name
+	out.Operation = StepPluginOperation(in.Operation)
+	out.Phase = StepPluginPhase(in.Phase)
+	out.Message = in.Message
+	out.StartedAt = (*v1.Time)(unsafe.Pointer(in.StartedAt))
+	out.UpdatedAt = (*v1.Time)(unsafe.Pointer(in.UpdatedAt))
+	out.FinishedAt = (*v1.Time)(unsafe.Pointer(in.FinishedAt))
+	// WARNING: in.Backoff requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.Executions = in.Executions
+	out.Disabled = in.Disabled
+	out.Status = *(*json.RawMessage)(unsafe.Pointer(&in.Status))
+	return nil
+}
+
+func autoConvert_v1beta1_StepTimeout_To_v1alpha1_StepTimeout(in *StepTimeout, out *v1alpha1.StepTimeout, s conversion.Scope) error {
+	// WARNING: in.Timeout requires manual conversion: inconvertible types (k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	out.OnTimeout = v1alpha1.StepTimeoutPolicy(in.OnTimeout)
+	return nil
+}
+
+func autoConvert_v1alpha1_StepTimeout_To_v1beta1_StepTimeout(in *v1alpha1.StepTimeout, out *StepTimeout, s conversion.Scope) error {
+	// WARNING: in.Timeout requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.OnTimeout = StepTimeoutPolicy(in.OnTimeout)
+	return nil
+}
+
+func autoConvert_v1beta1_StickinessConfig_To_v1alpha1_StickinessConfig(in *StickinessConfig, out *v1alpha1.StickinessConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.DurationSeconds = in.DurationSeconds
+	return nil
+}
+
+// Convert_v1beta1_StickinessConfig_To_v1alpha1_StickinessConfig is an autogenerated conversion function.
+func Convert_v1beta1_StickinessConfig_To_v1alpha1_StickinessConfig(in *StickinessConfig, out *v1alpha1.StickinessConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_StickinessConfig_To_v1alpha1_StickinessConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_StickinessConfig_To_v1beta1_StickinessConfig(in *v1alpha1.StickinessConfig, out *StickinessConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.DurationSeconds = in.DurationSeconds
+	return nil
+}
+
+// Convert_v1alpha1_StickinessConfig_To_v1beta1_StickinessConfig is an autogenerated conversion function.
+func Convert_v1alpha1_StickinessConfig_To_v1beta1_StickinessConfig(in *v1alpha1.StickinessConfig, out *StickinessConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StickinessConfig_To_v1beta1_StickinessConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_StrategyRef_To_v1alpha1_StrategyRef(in *StrategyRef, out *v1alpha1.StrategyRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterScope = in.ClusterScope
+	out.Args = *(*[]v1alpha1.AnalysisRunArgument)(unsafe.Pointer(&in.Args))
+	out.Weights = *(*[]int32)(unsafe.Pointer(&in.Weights))
+	out.CanaryService = in.CanaryService
+	out.StableService = in.StableService
+	out.ActiveService = in.ActiveService
+	out.PreviewService = in.PreviewService
+	return nil
+}
+
+// Convert_v1beta1_StrategyRef_To_v1alpha1_StrategyRef is an autogenerated conversion function.
+func Convert_v1beta1_StrategyRef_To_v1alpha1_StrategyRef(in *StrategyRef, out *v1alpha1.StrategyRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_StrategyRef_To_v1alpha1_StrategyRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_StrategyRef_To_v1beta1_StrategyRef(in *v1alpha1.StrategyRef, out *StrategyRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ClusterScope = in.ClusterScope
+	out.Args = *(*[]AnalysisRunArgument)(unsafe.Pointer(&in.Args))
+	out.Weights = *(*[]int32)(unsafe.Pointer(&in.Weights))
+	out.CanaryService = in.CanaryService
+	out.StableService = in.StableService
+	out.ActiveService = in.ActiveService
+	out.PreviewService = in.PreviewService
+	return nil
+}
+
+// Convert_v1alpha1_StrategyRef_To_v1beta1_StrategyRef is an autogenerated conversion function.
+func Convert_v1alpha1_StrategyRef_To_v1beta1_StrategyRef(in *v1alpha1.StrategyRef, out *StrategyRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StrategyRef_To_v1beta1_StrategyRef(in, out, s)
+}
+
+func autoConvert_v1beta1_StringMatch_To_v1alpha1_StringMatch(in *StringMatch, out *v1alpha1.StringMatch, s conversion.Scope) error {
+	out.Exact = in.Exact
+	out.Prefix = in.Prefix
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_v1beta1_StringMatch_To_v1alpha1_StringMatch is an autogenerated conversion function.
+func Convert_v1beta1_StringMatch_To_v1alpha1_StringMatch(in *StringMatch, out *v1alpha1.StringMatch, s conversion.Scope) error {
+	return autoConvert_v1beta1_StringMatch_To_v1alpha1_StringMatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_StringMatch_To_v1beta1_StringMatch(in *v1alpha1.StringMatch, out *StringMatch, s conversion.Scope) error {
+	out.Exact = in.Exact
+	out.Prefix = in.Prefix
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_v1alpha1_StringMatch_To_v1beta1_StringMatch is an autogenerated conversion function.
+func Convert_v1alpha1_StringMatch_To_v1beta1_StringMatch(in *v1alpha1.StringMatch, out *StringMatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StringMatch_To_v1beta1_StringMatch(in, out, s)
+}
+
+func autoConvert_v1beta1_TCPRoute_To_v1alpha1_TCPRoute(in *TCPRoute, out *v1alpha1.TCPRoute, s conversion.Scope) error {
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1beta1_TCPRoute_To_v1alpha1_TCPRoute is an autogenerated conversion function.
+func Convert_v1beta1_TCPRoute_To_v1alpha1_TCPRoute(in *TCPRoute, out *v1alpha1.TCPRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_TCPRoute_To_v1alpha1_TCPRoute(in, out, s)
+}
+
+func autoConvert_v1alpha1_TCPRoute_To_v1beta1_TCPRoute(in *v1alpha1.TCPRoute, out *TCPRoute, s conversion.Scope) error {
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1alpha1_TCPRoute_To_v1beta1_TCPRoute is an autogenerated conversion function.
+func Convert_v1alpha1_TCPRoute_To_v1beta1_TCPRoute(in *v1alpha1.TCPRoute, out *TCPRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TCPRoute_To_v1beta1_TCPRoute(in, out, s)
+}
+
+func autoConvert_v1beta1_TLSRoute_To_v1alpha1_TLSRoute(in *TLSRoute, out *v1alpha1.TLSRoute, s conversion.Scope) error {
+	out.Port = in.Port
+	out.SNIHosts = *(*[]string)(unsafe.Pointer(&in.SNIHosts))
+	return nil
+}
+
+// Convert_v1beta1_TLSRoute_To_v1alpha1_TLSRoute is an autogenerated conversion function.
+func Convert_v1beta1_TLSRoute_To_v1alpha1_TLSRoute(in *TLSRoute, out *v1alpha1.TLSRoute, s conversion.Scope) error {
+	return autoConvert_v1beta1_TLSRoute_To_v1alpha1_TLSRoute(in, out, s)
+}
+
+func autoConvert_v1alpha1_TLSRoute_To_v1beta1_TLSRoute(in *v1alpha1.TLSRoute, out *TLSRoute, s conversion.Scope) error {
+	out.Port = in.Port
+	out.SNIHosts = *(*[]string)(unsafe.Pointer(&in.SNIHosts))
+	return nil
+}
+
+// Convert_v1alpha1_TLSRoute_To_v1beta1_TLSRoute is an autogenerated conversion function.
+func Convert_v1alpha1_TLSRoute_To_v1beta1_TLSRoute(in *v1alpha1.TLSRoute, out *TLSRoute, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TLSRoute_To_v1beta1_TLSRoute(in, out, s)
+}
+
+func autoConvert_v1beta1_TTLStrategy_To_v1alpha1_TTLStrategy(in *TTLStrategy, out *v1alpha1.TTLStrategy, s conversion.Scope) error {
+	out.SecondsAfterCompletion = (*int32)(unsafe.Pointer(in.SecondsAfterCompletion))
+	out.SecondsAfterFailure = (*int32)(unsafe.Pointer(in.SecondsAfterFailure))
+	out.SecondsAfterSuccess = (*int32)(unsafe.Pointer(in.SecondsAfterSuccess))
+	return nil
+}
+
+// Convert_v1beta1_TTLStrategy_To_v1alpha1_TTLStrategy is an autogenerated conversion function.
+func Convert_v1beta1_TTLStrategy_To_v1alpha1_TTLStrategy(in *TTLStrategy, out *v1alpha1.TTLStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_TTLStrategy_To_v1alpha1_TTLStrategy(in, out, s)
+}
+
+func autoConvert_v1alpha1_TTLStrategy_To_v1beta1_TTLStrategy(in *v1alpha1.TTLStrategy, out *TTLStrategy, s conversion.Scope) error {
+	out.SecondsAfterCompletion = (*int32)(unsafe.Pointer(in.SecondsAfterCompletion))
+	out.SecondsAfterFailure = (*int32)(unsafe.Pointer(in.SecondsAfterFailure))
+	out.SecondsAfterSuccess = (*int32)(unsafe.Pointer(in.SecondsAfterSuccess))
+	return nil
+}
+
+// Convert_v1alpha1_TTLStrategy_To_v1beta1_TTLStrategy is an autogenerated conversion function.
+func Convert_v1alpha1_TTLStrategy_To_v1beta1_TTLStrategy(in *v1alpha1.TTLStrategy, out *TTLStrategy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TTLStrategy_To_v1beta1_TTLStrategy(in, out, s)
+}
+
+func autoConvert_v1beta1_TemplateService_To_v1alpha1_TemplateService(in *TemplateService, out *v1alpha1.TemplateService, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_TemplateService_To_v1alpha1_TemplateService is an autogenerated conversion function.
+func Convert_v1beta1_TemplateService_To_v1alpha1_TemplateService(in *TemplateService, out *v1alpha1.TemplateService, s conversion.Scope) error {
+	return autoConvert_v1beta1_TemplateService_To_v1alpha1_TemplateService(in, out, s)
+}
+
+func autoConvert_v1alpha1_TemplateService_To_v1beta1_TemplateService(in *v1alpha1.TemplateService, out *TemplateService, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_TemplateService_To_v1beta1_TemplateService is an autogenerated conversion function.
+func Convert_v1alpha1_TemplateService_To_v1beta1_TemplateService(in *v1alpha1.TemplateService, out *TemplateService, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TemplateService_To_v1beta1_TemplateService(in, out, s)
+}
+
+func autoConvert_v1beta1_TemplateSpec_To_v1alpha1_TemplateSpec(in *TemplateSpec, out *v1alpha1.TemplateSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MinReadySeconds = in.MinReadySeconds
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Template = in.Template
+	out.Service = (*v1alpha1.TemplateService)(unsafe.Pointer(in.Service))
+	return nil
+}
+
+// Convert_v1beta1_TemplateSpec_To_v1alpha1_TemplateSpec is an autogenerated conversion function.
+func Convert_v1beta1_TemplateSpec_To_v1alpha1_TemplateSpec(in *TemplateSpec, out *v1alpha1.TemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_TemplateSpec_To_v1alpha1_TemplateSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_TemplateSpec_To_v1beta1_TemplateSpec(in *v1alpha1.TemplateSpec, out *TemplateSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Replicas = (*int32)(unsafe.Pointer(in.Replicas))
+	out.MinReadySeconds = in.MinReadySeconds
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.Template = in.Template
+	out.Service = (*TemplateService)(unsafe.Pointer(in.Service))
+	return nil
+}
+
+// Convert_v1alpha1_TemplateSpec_To_v1beta1_TemplateSpec is an autogenerated conversion function.
+func Convert_v1alpha1_TemplateSpec_To_v1beta1_TemplateSpec(in *v1alpha1.TemplateSpec, out *TemplateSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TemplateSpec_To_v1beta1_TemplateSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_TemplateStatus_To_v1alpha1_TemplateStatus(in *TemplateStatus, out *v1alpha1.TemplateStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
+	out.Status = v1alpha1.TemplateStatusCode(in.Status)
+	out.Message = in.Message
+	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.ServiceName = in.ServiceName
+	out.PodTemplateHash = in.PodTemplateHash
+	return nil
+}
+
+// Convert_v1beta1_TemplateStatus_To_v1alpha1_TemplateStatus is an autogenerated conversion function.
+func Convert_v1beta1_TemplateStatus_To_v1alpha1_TemplateStatus(in *TemplateStatus, out *v1alpha1.TemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_TemplateStatus_To_v1alpha1_TemplateStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_TemplateStatus_To_v1beta1_TemplateStatus(in *v1alpha1.TemplateStatus, out *TemplateStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Replicas = in.Replicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
+	out.Status = TemplateStatusCode(in.Status)
+	out.Message = in.Message
+	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.ServiceName = in.ServiceName
+	out.PodTemplateHash = in.PodTemplateHash
+	return nil
+}
+
+// Convert_v1alpha1_TemplateStatus_To_v1beta1_TemplateStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TemplateStatus_To_v1beta1_TemplateStatus(in *v1alpha1.TemplateStatus, out *TemplateStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TemplateStatus_To_v1beta1_TemplateStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_TraefikTrafficRouting_To_v1alpha1_TraefikTrafficRouting(in *TraefikTrafficRouting, out *v1alpha1.TraefikTrafficRouting, s conversion.Scope) error {
+	out.WeightedTraefikServiceName = in.WeightedTraefikServiceName
+	return nil
+}
+
+// Convert_v1beta1_TraefikTrafficRouting_To_v1alpha1_TraefikTrafficRouting is an autogenerated conversion function.
+func Convert_v1beta1_TraefikTrafficRouting_To_v1alpha1_TraefikTrafficRouting(in *TraefikTrafficRouting, out *v1alpha1.TraefikTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1beta1_TraefikTrafficRouting_To_v1alpha1_TraefikTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1alpha1_TraefikTrafficRouting_To_v1beta1_TraefikTrafficRouting(in *v1alpha1.TraefikTrafficRouting, out *TraefikTrafficRouting, s conversion.Scope) error {
+	out.WeightedTraefikServiceName = in.WeightedTraefikServiceName
+	return nil
+}
+
+// Convert_v1alpha1_TraefikTrafficRouting_To_v1beta1_TraefikTrafficRouting is an autogenerated conversion function.
+func Convert_v1alpha1_TraefikTrafficRouting_To_v1beta1_TraefikTrafficRouting(in *v1alpha1.TraefikTrafficRouting, out *TraefikTrafficRouting, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TraefikTrafficRouting_To_v1beta1_TraefikTrafficRouting(in, out, s)
+}
+
+func autoConvert_v1beta1_TrafficStickiness_To_v1alpha1_TrafficStickiness(in *TrafficStickiness, out *v1alpha1.TrafficStickiness, s conversion.Scope) error {
+	out.CookieName = in.CookieName
+	out.DurationSeconds = in.DurationSeconds
+	return nil
+}
+
+// Convert_v1beta1_TrafficStickiness_To_v1alpha1_TrafficStickiness is an autogenerated conversion function.
+func Convert_v1beta1_TrafficStickiness_To_v1alpha1_TrafficStickiness(in *TrafficStickiness, out *v1alpha1.TrafficStickiness, s conversion.Scope) error {
+	return autoConvert_v1beta1_TrafficStickiness_To_v1alpha1_TrafficStickiness(in, out, s)
+}
+
+func autoConvert_v1alpha1_TrafficStickiness_To_v1beta1_TrafficStickiness(in *v1alpha1.TrafficStickiness, out *TrafficStickiness, s conversion.Scope) error {
+	out.CookieName = in.CookieName
+	out.DurationSeconds = in.DurationSeconds
+	return nil
+}
+
+// Convert_v1alpha1_TrafficStickiness_To_v1beta1_TrafficStickiness is an autogenerated conversion function.
+func Convert_v1alpha1_TrafficStickiness_To_v1beta1_TrafficStickiness(in *v1alpha1.TrafficStickiness, out *TrafficStickiness, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrafficStickiness_To_v1beta1_TrafficStickiness(in, out, s)
+}
+
+func autoConvert_v1beta1_TrafficWeights_To_v1alpha1_TrafficWeights(in *TrafficWeights, out *v1alpha1.TrafficWeights, s conversion.Scope) error {
+	if err := Convert_v1beta1_WeightDestination_To_v1alpha1_WeightDestination(&in.Canary, &out.Canary, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_WeightDestination_To_v1alpha1_WeightDestination(&in.Stable, &out.Stable, s); err != nil {
+		return err
+	}
+	out.Additional = *(*[]v1alpha1.WeightDestination)(unsafe.Pointer(&in.Additional))
+	out.Verified = (*bool)(unsafe.Pointer(in.Verified))
+	return nil
+}
+
+// Convert_v1beta1_TrafficWeights_To_v1alpha1_TrafficWeights is an autogenerated conversion function.
+func Convert_v1beta1_TrafficWeights_To_v1alpha1_TrafficWeights(in *TrafficWeights, out *v1alpha1.TrafficWeights, s conversion.Scope) error {
+	return autoConvert_v1beta1_TrafficWeights_To_v1alpha1_TrafficWeights(in, out, s)
+}
+
+func autoConvert_v1alpha1_TrafficWeights_To_v1beta1_TrafficWeights(in *v1alpha1.TrafficWeights, out *TrafficWeights, s conversion.Scope) error {
+	if err := Convert_v1alpha1_WeightDestination_To_v1beta1_WeightDestination(&in.Canary, &out.Canary, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_WeightDestination_To_v1beta1_WeightDestination(&in.Stable, &out.Stable, s); err != nil {
+		return err
+	}
+	out.Additional = *(*[]WeightDestination)(unsafe.Pointer(&in.Additional))
+	out.Verified = (*bool)(unsafe.Pointer(in.Verified))
+	return nil
+}
+
+// Convert_v1alpha1_TrafficWeights_To_v1beta1_TrafficWeights is an autogenerated conversion function.
+func Convert_v1alpha1_TrafficWeights_To_v1beta1_TrafficWeights(in *v1alpha1.TrafficWeights, out *TrafficWeights, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TrafficWeights_To_v1beta1_TrafficWeights(in, out, s)
+}
+
+func autoConvert_v1beta1_ValueFrom_To_v1alpha1_ValueFrom(in *ValueFrom, out *v1alpha1.ValueFrom, s conversion.Scope) error {
+	out.SecretKeyRef = (*v1alpha1.SecretKeyRef)(unsafe.Pointer(in.SecretKeyRef))
+	out.FieldRef = (*v1alpha1.FieldRef)(unsafe.Pointer(in.FieldRef))
+	return nil
+}
+
+// Convert_v1beta1_ValueFrom_To_v1alpha1_ValueFrom is an autogenerated conversion function.
+func Convert_v1beta1_ValueFrom_To_v1alpha1_ValueFrom(in *ValueFrom, out *v1alpha1.ValueFrom, s conversion.Scope) error {
+	return autoConvert_v1beta1_ValueFrom_To_v1alpha1_ValueFrom(in, out, s)
+}
+
+func autoConvert_v1alpha1_ValueFrom_To_v1beta1_ValueFrom(in *v1alpha1.ValueFrom, out *ValueFrom, s conversion.Scope) error {
+	out.SecretKeyRef = (*SecretKeyRef)(unsafe.Pointer(in.SecretKeyRef))
+	out.FieldRef = (*FieldRef)(unsafe.Pointer(in.FieldRef))
+	return nil
+}
+
+// Convert_v1alpha1_ValueFrom_To_v1beta1_ValueFrom is an autogenerated conversion function.
+func Convert_v1alpha1_ValueFrom_To_v1beta1_ValueFrom(in *v1alpha1.ValueFrom, out *ValueFrom, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValueFrom_To_v1beta1_ValueFrom(in, out, s)
+}
+
+func autoConvert_v1beta1_WaitForStatus_To_v1alpha1_WaitForStatus(in *WaitForStatus, out *v1alpha1.WaitForStatus, s conversion.Scope) error {
+	out.Index = in.Index
+	out.StartedAt = in.StartedAt
+	out.Met = in.Met
+	out.TimedOut = in.TimedOut
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_WaitForStatus_To_v1alpha1_WaitForStatus is an autogenerated conversion function.
+func Convert_v1beta1_WaitForStatus_To_v1alpha1_WaitForStatus(in *WaitForStatus, out *v1alpha1.WaitForStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_WaitForStatus_To_v1alpha1_WaitForStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_WaitForStatus_To_v1beta1_WaitForStatus(in *v1alpha1.WaitForStatus, out *WaitForStatus, s conversion.Scope) error {
+	out.Index = in.Index
+	out.StartedAt = in.StartedAt
+	out.Met = in.Met
+	out.TimedOut = in.TimedOut
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_WaitForStatus_To_v1beta1_WaitForStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WaitForStatus_To_v1beta1_WaitForStatus(in *v1alpha1.WaitForStatus, out *WaitForStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WaitForStatus_To_v1beta1_WaitForStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_WaitForStep_To_v1alpha1_WaitForStep(in *WaitForStep, out *v1alpha1.WaitForStep, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Condition = in.Condition
+	out.JSONPath = in.JSONPath
+	out.Value = in.Value
+	// WARNING: in.Timeout requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.Duration vs github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString)
+	out.OnTimeout = v1alpha1.WaitForTimeoutPolicy(in.OnTimeout)
+	return nil
+}
+
+func autoConvert_v1alpha1_WaitForStep_To_v1beta1_WaitForStep(in *v1alpha1.WaitForStep, out *WaitForStep, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Condition = in.Condition
+	out.JSONPath = in.JSONPath
+	out.Value = in.Value
+	// WARNING: in.Timeout requires manual conversion: inconvertible types (github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1.DurationString vs *k8s.io/apimachinery/pkg/apis/meta/v1.Duration)
+	out.OnTimeout = WaitForTimeoutPolicy(in.OnTimeout)
+	return nil
+}
+
+func autoConvert_v1beta1_WavefrontMetric_To_v1alpha1_WavefrontMetric(in *WavefrontMetric, out *v1alpha1.WavefrontMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1beta1_WavefrontMetric_To_v1alpha1_WavefrontMetric is an autogenerated conversion function.
+func Convert_v1beta1_WavefrontMetric_To_v1alpha1_WavefrontMetric(in *WavefrontMetric, out *v1alpha1.WavefrontMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_WavefrontMetric_To_v1alpha1_WavefrontMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_WavefrontMetric_To_v1beta1_WavefrontMetric(in *v1alpha1.WavefrontMetric, out *WavefrontMetric, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Query = in.Query
+	return nil
+}
+
+// Convert_v1alpha1_WavefrontMetric_To_v1beta1_WavefrontMetric is an autogenerated conversion function.
+func Convert_v1alpha1_WavefrontMetric_To_v1beta1_WavefrontMetric(in *v1alpha1.WavefrontMetric, out *WavefrontMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WavefrontMetric_To_v1beta1_WavefrontMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_WebMetric_To_v1alpha1_WebMetric(in *WebMetric, out *v1alpha1.WebMetric, s conversion.Scope) error {
+	out.Method = v1alpha1.WebMetricMethod(in.Method)
+	out.URL = in.URL
+	out.Headers = *(*[]v1alpha1.WebMetricHeader)(unsafe.Pointer(&in.Headers))
+	out.Body = in.Body
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.JSONPath = in.JSONPath
+	out.Insecure = in.Insecure
+	out.JSONBody = *(*json.RawMessage)(unsafe.Pointer(&in.JSONBody))
+	if err := Convert_v1beta1_Authentication_To_v1alpha1_Authentication(&in.Authentication, &out.Authentication, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_WebMetric_To_v1alpha1_WebMetric is an autogenerated conversion function.
+func Convert_v1beta1_WebMetric_To_v1alpha1_WebMetric(in *WebMetric, out *v1alpha1.WebMetric, s conversion.Scope) error {
+	return autoConvert_v1beta1_WebMetric_To_v1alpha1_WebMetric(in, out, s)
+}
+
+func autoConvert_v1alpha1_WebMetric_To_v1beta1_WebMetric(in *v1alpha1.WebMetric, out *WebMetric, s conversion.Scope) error {
+	out.Method = WebMetricMethod(in.Method)
+	out.URL = in.URL
+	out.Headers = *(*[]WebMetricHeader)(unsafe.Pointer(&in.Headers))
+	out.Body = in.Body
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.JSONPath = in.JSONPath
+	out.Insecure = in.Insecure
+	out.JSONBody = *(*json.RawMessage)(unsafe.Pointer(&in.JSONBody))
+	if err := Convert_v1alpha1_Authentication_To_v1beta1_Authentication(&in.Authentication, &out.Authentication, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_WebMetric_To_v1beta1_WebMetric is an autogenerated conversion function.
+func Convert_v1alpha1_WebMetric_To_v1beta1_WebMetric(in *v1alpha1.WebMetric, out *WebMetric, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WebMetric_To_v1beta1_WebMetric(in, out, s)
+}
+
+func autoConvert_v1beta1_WebMetricHeader_To_v1alpha1_WebMetricHeader(in *WebMetricHeader, out *v1alpha1.WebMetricHeader, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1beta1_WebMetricHeader_To_v1alpha1_WebMetricHeader is an autogenerated conversion function.
+func Convert_v1beta1_WebMetricHeader_To_v1alpha1_WebMetricHeader(in *WebMetricHeader, out *v1alpha1.WebMetricHeader, s conversion.Scope) error {
+	return autoConvert_v1beta1_WebMetricHeader_To_v1alpha1_WebMetricHeader(in, out, s)
+}
+
+func autoConvert_v1alpha1_WebMetricHeader_To_v1beta1_WebMetricHeader(in *v1alpha1.WebMetricHeader, out *WebMetricHeader, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1alpha1_WebMetricHeader_To_v1beta1_WebMetricHeader is an autogenerated conversion function.
+func Convert_v1alpha1_WebMetricHeader_To_v1beta1_WebMetricHeader(in *v1alpha1.WebMetricHeader, out *WebMetricHeader, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WebMetricHeader_To_v1beta1_WebMetricHeader(in, out, s)
+}
+
+func autoConvert_v1beta1_WeightDestination_To_v1alpha1_WeightDestination(in *WeightDestination, out *v1alpha1.WeightDestination, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.ServiceName = in.ServiceName
+	out.PodTemplateHash = in.PodTemplateHash
+	return nil
+}
+
+// Convert_v1beta1_WeightDestination_To_v1alpha1_WeightDestination is an autogenerated conversion function.
+func Convert_v1beta1_WeightDestination_To_v1alpha1_WeightDestination(in *WeightDestination, out *v1alpha1.WeightDestination, s conversion.Scope) error {
+	return autoConvert_v1beta1_WeightDestination_To_v1alpha1_WeightDestination(in, out, s)
+}
+
+func autoConvert_v1alpha1_WeightDestination_To_v1beta1_WeightDestination(in *v1alpha1.WeightDestination, out *WeightDestination, s conversion.Scope) error {
+	out.Weight = in.Weight
+	out.ServiceName = in.ServiceName
+	out.PodTemplateHash = in.PodTemplateHash
+	return nil
+}
+
+// Convert_v1alpha1_WeightDestination_To_v1beta1_WeightDestination is an autogenerated conversion function.
+func Convert_v1alpha1_WeightDestination_To_v1beta1_WeightDestination(in *v1alpha1.WeightDestination, out *WeightDestination, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WeightDestination_To_v1beta1_WeightDestination(in, out, s)
+}
@@ -3,6 +3,7 @@ package validation
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 
@@ -19,7 +20,10 @@ import (
 	"k8s.io/kubernetes/pkg/fieldpath"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	"github.com/argoproj/argo-rollouts/utils/weightutil"
 )
 
@@ -35,9 +39,17 @@ const (
 	// InvalidSetCanaryScaleTrafficPolicy indicates that TrafficRouting, required for SetCanaryScale, is missing
 	InvalidSetCanaryScaleTrafficPolicy = "SetCanaryScale requires TrafficRouting to be set"
 	// InvalidSetHeaderRouteTrafficPolicy indicates that TrafficRouting required for SetHeaderRoute is missing
-	InvalidSetHeaderRouteTrafficPolicy = "SetHeaderRoute requires TrafficRouting, supports Istio and ALB and Apisix"
+	InvalidSetHeaderRouteTrafficPolicy = "SetHeaderRoute requires TrafficRouting, supports Istio, ALB, Apisix, Contour, Kong and Linkerd"
 	// InvalidSetMirrorRouteTrafficPolicy indicates that TrafficRouting, required for SetCanaryScale, is missing
-	InvalidSetMirrorRouteTrafficPolicy = "SetMirrorRoute requires TrafficRouting, supports Istio only"
+	InvalidSetMirrorRouteTrafficPolicy = "SetMirrorRoute requires TrafficRouting, supports Istio, Contour and Kong"
+	// InvalidSetMirrorRouteContourMethodPolicy indicates that Contour does not match the method of the requests
+	InvalidSetMirrorRouteContourMethodPolicy = "SetMirrorRoute method match is not supported by Contour"
+	// InvalidStickinessTrafficPolicy indicates that the traffic router does not support stickiness
+	InvalidStickinessTrafficPolicy = "Stickiness requires TrafficRouting, supports Istio, Nginx and Traefik"
+	// InvalidIstioLocalitiesDestinationRuleMessage indicates that localities require an Istio DestinationRule
+	InvalidIstioLocalitiesDestinationRuleMessage = "Istio localities require a DestinationRule"
+	// InvalidIstioLocalitiesMinWeightMessage indicates that no locality receives the canary traffic from the first weight
+	InvalidIstioLocalitiesMinWeightMessage = "Istio localities must have at least one locality with a minWeight of 0"
 	// InvalidStringMatchMultipleValuePolicy indicates that SetCanaryScale, has multiple values set
 	InvalidStringMatchMultipleValuePolicy = "StringMatch match value must have exactly one of the following: exact, regex, prefix"
 	// InvalidStringMatchMissedValuePolicy indicates that SetCanaryScale, has multiple values set
@@ -79,10 +91,44 @@ const (
 	InvalidPingPongProvidedMessage = "Ping service and Pong service must to be set to use Ping-Pong feature"
 	// DuplicatedPingPongServicesMessage indicates that the rollout uses the same service for the ping and pong services
 	DuplicatedPingPongServicesMessage = "This rollout uses the same service for the ping and pong services, but two different services are required."
+	// DuplicatedDNSSetIdentifiersMessage indicates that the rollout uses the same set identifier for the stable and canary DNS records
+	DuplicatedDNSSetIdentifiersMessage = "This rollout uses the same set identifier for the stable and canary DNS records, but two different set identifiers are required."
 	// MissedAlbRootServiceMessage indicates that the rollout with ALB TrafficRouting and ping pong feature enabled must have root service provided
 	MissedAlbRootServiceMessage = "Root service field is required for the configuration with ALB and ping-pong feature enabled"
 	// PingPongWithRouterOnlyMessage At this moment ping-pong feature works with the ALB traffic routing only
 	PingPongWithRouterOnlyMessage = "Ping-pong feature works with the ALB and Istio traffic routers only"
+	// InvalidStatefulSetWorkloadStrategyMessage indicates that a StatefulSet workload uses a strategy feature which relies on ReplicaSets
+	InvalidStatefulSetWorkloadStrategyMessage = "StatefulSet workloads only support the canary strategy without services, traffic routing or a rollback window"
+	// InvalidStatefulSetWorkloadStepMessage indicates that a StatefulSet workload uses a step which can not be implemented with a partition
+	InvalidStatefulSetWorkloadStepMessage = "StatefulSet workloads only support setWeight, pause, analysis and waitFor steps"
+	// InvalidStatefulSetWorkloadScaleDownMessage indicates that scaleDown is set for a StatefulSet workload
+	InvalidStatefulSetWorkloadScaleDownMessage = "scaleDown is not supported for StatefulSet workloads"
+	// InvalidDaemonSetCanaryStrategyMessage indicates that a DaemonSet canary uses a strategy feature which relies on ReplicaSets
+	InvalidDaemonSetCanaryStrategyMessage = "DaemonSet canaries do not support services, traffic routing, a rollback window or a StatefulSet workloadRef"
+	// InvalidDaemonSetCanaryStepMessage indicates that a DaemonSet canary uses a step which can not be implemented with node affinities
+	InvalidDaemonSetCanaryStepMessage = "DaemonSet canaries only support setWeight, setCanaryNodes, pause, analysis and waitFor steps"
+	// InvalidSetCanaryNodesStepMessage indicates that a setCanaryNodes step is used without the DaemonSet canary strategy
+	InvalidSetCanaryNodesStepMessage = "setCanaryNodes steps require the DaemonSet canary strategy"
+	// InvalidWaitForConditionMessage indicates that a waitFor step does not set exactly one of condition and jsonPath
+	InvalidWaitForConditionMessage = "waitFor steps must set exactly one of condition and jsonPath"
+	// InvalidBlueGreenTrafficRoutingMessage indicates that blue-green traffic routing is used without a preview service
+	InvalidBlueGreenTrafficRoutingMessage = "Preview service must be set to use Traffic Routing with the blue-green strategy"
+	// InvalidBlueGreenTrafficStepsMessage indicates that blue-green traffic steps are used without traffic routing
+	InvalidBlueGreenTrafficStepsMessage = "trafficSteps require TrafficRouting to be set"
+	// InvalidBlueGreenTrafficStepMessage indicates that a blue-green traffic step does not set exactly one step type
+	InvalidBlueGreenTrafficStepMessage = "Traffic step must have exactly one of the following set: setWeight, pause or analysis"
+	// InvalidConfigRefsWorkloadMessage indicates that configRefs are used with a workload whose pod template is not created by the Rollout
+	InvalidConfigRefsWorkloadMessage = "configRefs are not supported for StatefulSet workloads and DaemonSet canaries"
+	// DuplicateConfigRefMessage indicates that a ConfigMap or Secret is listed twice in configRefs
+	DuplicateConfigRefMessage = "configRefs must be unique"
+	// InvalidHooksWorkloadMessage indicates that hooks are used with a workload whose revisions are not ReplicaSets
+	InvalidHooksWorkloadMessage = "hooks are not supported for StatefulSet workloads and DaemonSet canaries"
+	// InvalidAbortPolicyWorkloadMessage indicates that aborted pods are preserved with a workload whose pods are not owned by ReplicaSets
+	InvalidAbortPolicyWorkloadMessage = "abortPolicy is not supported for StatefulSet workloads and DaemonSet canaries"
+	// InvalidStrategyRefStrategyMessage indicates that the strategy is set along with a StrategyRef
+	InvalidStrategyRefStrategyMessage = "strategy must be empty for a Rollout with a strategyRef"
+	// InvalidStrategyRefOverridesMessage indicates that a StrategyRef overrides both the canary and blue-green strategies
+	InvalidStrategyRefOverridesMessage = "strategyRef cannot override both the canary and the blue-green strategies"
 	// InvalideStepRouteNameNotFoundInManagedRoutes A step has been configured that requires managedRoutes and the route name
 	// is missing from managedRoutes
 	InvalideStepRouteNameNotFoundInManagedRoutes = "Steps define a route that does not exist in spec.strategy.canary.trafficRouting.managedRoutes"
@@ -175,8 +221,280 @@ func ValidateRolloutSpec(rollout *v1alpha1.Rollout, fldPath *field.Path) field.E
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("progressDeadlineSeconds"), progressDeadlineSeconds, "must be greater than minReadySeconds"))
 	}
 
-	allErrs = append(allErrs, ValidateRolloutStrategy(rollout, fldPath.Child("strategy"))...)
+	if spec.StrategyRef != nil && !spec.StrategyResolvedFromRef {
+		// The strategy of the template is validated once it is resolved by the controller
+		allErrs = append(allErrs, ValidateStrategyRef(rollout, fldPath)...)
+	} else {
+		allErrs = append(allErrs, ValidateRolloutStrategy(rollout, fldPath.Child("strategy"))...)
+	}
+
+	if spec.IsStatefulSetWorkload() {
+		allErrs = append(allErrs, ValidateStatefulSetWorkload(rollout, fldPath)...)
+	}
+	if spec.IsDaemonSetWorkload() {
+		allErrs = append(allErrs, ValidateDaemonSetCanary(rollout, fldPath)...)
+	}
+	allErrs = append(allErrs, ValidateConfigRefs(rollout, fldPath.Child("configRefs"))...)
+	allErrs = append(allErrs, ValidateRolloutHooks(rollout, fldPath.Child("hooks"))...)
+	allErrs = append(allErrs, ValidateRetryPolicy(spec.RetryPolicy, fldPath.Child("retryPolicy"))...)
+	if spec.Strategy.Canary != nil {
+		allErrs = append(allErrs, ValidateCanaryAbortPolicy(rollout, fldPath.Child("strategy", "canary", "abortPolicy"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateStrategyRef checks the StrategyRef of a Rollout whose strategy is not resolved yet
+func ValidateStrategyRef(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	ref := rollout.Spec.StrategyRef
+	allErrs := field.ErrorList{}
+	if !reflect.DeepEqual(rollout.Spec.Strategy, v1alpha1.RolloutStrategy{}) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("strategy"), "strategy", InvalidStrategyRefStrategyMessage))
+	}
+	refPath := fldPath.Child("strategyRef")
+	if ref.Name == "" {
+		allErrs = append(allErrs, field.Required(refPath.Child("name"), ""))
+	}
+	if (ref.CanaryService != "" || ref.StableService != "" || len(ref.Weights) > 0) && (ref.ActiveService != "" || ref.PreviewService != "") {
+		allErrs = append(allErrs, field.Invalid(refPath, ref.Name, InvalidStrategyRefOverridesMessage))
+	}
+	return allErrs
+}
+
+// strategyTemplateOverriddenFields are the fields of a strategy which are either overridden by the Rollouts
+// referencing its template, or only valid depending on the Rollout
+var strategyTemplateOverriddenFields = []string{
+	"canaryService",
+	"stableService",
+	"activeService",
+	"previewService",
+	"scaleDownDelayRevisionLimit",
+	"setCanaryNodes",
+}
+
+// ValidateRolloutStrategyTemplateSpec checks the strategy of a RolloutStrategyTemplate or
+// ClusterRolloutStrategyTemplate on its own. The errors of the fields which depend on the Rollouts referencing the
+// template, such as the services, are left to the validation of the strategy once resolved for a Rollout.
+func ValidateRolloutStrategyTemplateSpec(spec v1alpha1.RolloutStrategyTemplateSpec, fldPath *field.Path) field.ErrorList {
+	rollout := &v1alpha1.Rollout{Spec: v1alpha1.RolloutSpec{Strategy: *spec.Strategy.DeepCopy()}}
+	errs := ValidateRolloutStrategy(rollout, fldPath.Child("strategy"))
+	if spec.Strategy.Canary != nil {
+		errs = append(errs, ValidateCanaryAbortPolicy(rollout, fldPath.Child("strategy", "canary", "abortPolicy"))...)
+	}
+	allErrs := field.ErrorList{}
+	for _, err := range errs {
+		overridden := false
+		for _, name := range strategyTemplateOverriddenFields {
+			if strings.HasSuffix(err.Field, "."+name) {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			allErrs = append(allErrs, err)
+		}
+	}
+	return allErrs
+}
 
+// ValidateStatefulSetWorkload checks that a Rollout referencing a StatefulSet only uses the features which can be
+// implemented by moving the partition of the StatefulSet
+func ValidateStatefulSetWorkload(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	spec := rollout.Spec
+	allErrs := field.ErrorList{}
+	if spec.WorkloadRef.ScaleDown != "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("workloadRef", "scaleDown"), spec.WorkloadRef.ScaleDown, InvalidStatefulSetWorkloadScaleDownMessage))
+	}
+	if spec.RollbackWindow != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rollbackWindow"), spec.RollbackWindow, InvalidStatefulSetWorkloadStrategyMessage))
+	}
+	if spec.Strategy.BlueGreen != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("strategy", "blueGreen"), "blueGreen", InvalidStatefulSetWorkloadStrategyMessage))
+	}
+	canary := spec.Strategy.Canary
+	if canary == nil {
+		return allErrs
+	}
+	canaryPath := fldPath.Child("strategy", "canary")
+	if canary.TrafficRouting != nil {
+		allErrs = append(allErrs, field.Invalid(canaryPath.Child("trafficRouting"), "trafficRouting", InvalidStatefulSetWorkloadStrategyMessage))
+	}
+	if canary.CanaryService != "" || canary.StableService != "" {
+		allErrs = append(allErrs, field.Invalid(canaryPath.Child("canaryService"), canary.CanaryService, InvalidStatefulSetWorkloadStrategyMessage))
+	}
+	for i, step := range canary.Steps {
+		if step.SetWeight == nil && step.Pause == nil && step.Analysis == nil && step.WaitFor == nil {
+			allErrs = append(allErrs, field.Invalid(canaryPath.Child("steps").Index(i), step, InvalidStatefulSetWorkloadStepMessage))
+		}
+	}
+	return allErrs
+}
+
+// ValidateConfigRefs checks the ConfigMaps and Secrets versioned with the pod template
+func ValidateConfigRefs(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(rollout.Spec.ConfigRefs) == 0 {
+		return allErrs
+	}
+	if rollout.Spec.IsStatefulSetWorkload() || rollout.Spec.IsDaemonSetWorkload() {
+		allErrs = append(allErrs, field.Invalid(fldPath, rollout.Spec.ConfigRefs, InvalidConfigRefsWorkloadMessage))
+	}
+	seen := map[v1alpha1.ConfigRef]bool{}
+	for i, ref := range rollout.Spec.ConfigRefs {
+		refPath := fldPath.Index(i)
+		if ref.Kind != v1alpha1.ConfigRefKindConfigMap && ref.Kind != v1alpha1.ConfigRefKindSecret {
+			allErrs = append(allErrs, field.NotSupported(refPath.Child("kind"), ref.Kind, []string{string(v1alpha1.ConfigRefKindConfigMap), string(v1alpha1.ConfigRefKindSecret)}))
+		}
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(refPath.Child("name"), ""))
+		}
+		if seen[ref] {
+			allErrs = append(allErrs, field.Invalid(refPath, ref, DuplicateConfigRefMessage))
+		}
+		seen[ref] = true
+	}
+	return allErrs
+}
+
+// ValidateRolloutHooks checks the Jobs run at the phases of an update
+func ValidateRolloutHooks(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	hooks := rollout.Spec.Hooks
+	if hooks == nil {
+		return allErrs
+	}
+	if rollout.Spec.IsStatefulSetWorkload() || rollout.Spec.IsDaemonSetWorkload() {
+		allErrs = append(allErrs, field.Invalid(fldPath, "hooks", InvalidHooksWorkloadMessage))
+	}
+	allErrs = append(allErrs, validateRolloutHook(hooks.PreRollout, fldPath.Child("preRollout"))...)
+	allErrs = append(allErrs, validateRolloutHook(hooks.PostPromotion, fldPath.Child("postPromotion"))...)
+	allErrs = append(allErrs, validateRolloutHook(hooks.OnAbort, fldPath.Child("onAbort"))...)
+	return allErrs
+}
+
+func validateRolloutHook(hook *v1alpha1.RolloutHook, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if hook == nil {
+		return allErrs
+	}
+	podSpecPath := fldPath.Child("spec", "template", "spec")
+	podSpec := hook.Spec.Template.Spec
+	if len(podSpec.Containers) == 0 {
+		allErrs = append(allErrs, field.Required(podSpecPath.Child("containers"), ""))
+	}
+	if podSpec.RestartPolicy != corev1.RestartPolicyNever && podSpec.RestartPolicy != corev1.RestartPolicyOnFailure {
+		allErrs = append(allErrs, field.NotSupported(podSpecPath.Child("restartPolicy"), podSpec.RestartPolicy, []string{string(corev1.RestartPolicyNever), string(corev1.RestartPolicyOnFailure)}))
+	}
+	return allErrs
+}
+
+// ValidateRetryPolicy checks the limit, the backoff and the reasons of the automatic retries of aborted updates
+func ValidateRetryPolicy(policy *v1alpha1.RolloutRetryPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(policy.Limit), fldPath.Child("limit"))...)
+	if backoff := policy.Backoff; backoff != nil {
+		backoffPath := fldPath.Child("backoff")
+		allErrs = append(allErrs, validatePositiveDuration(backoff.Duration, backoffPath.Child("duration"))...)
+		allErrs = append(allErrs, validatePositiveDuration(backoff.MaxDuration, backoffPath.Child("maxDuration"))...)
+		if backoff.Factor != nil && *backoff.Factor < 1 {
+			allErrs = append(allErrs, field.Invalid(backoffPath.Child("factor"), *backoff.Factor, "must be greater than or equal to 1"))
+		}
+	}
+	reasons := []string{
+		string(v1alpha1.RolloutAbortReasonAnalysisError),
+		string(v1alpha1.RolloutAbortReasonAnalysisFailed),
+		string(v1alpha1.RolloutAbortReasonAnalysisInconclusive),
+		string(v1alpha1.RolloutAbortReasonProgressDeadlineExceeded),
+		string(v1alpha1.RolloutAbortReasonStepTimeout),
+	}
+	for i, reason := range policy.RetryOn {
+		switch reason {
+		case v1alpha1.RolloutAbortReasonAnalysisError, v1alpha1.RolloutAbortReasonAnalysisFailed,
+			v1alpha1.RolloutAbortReasonAnalysisInconclusive, v1alpha1.RolloutAbortReasonProgressDeadlineExceeded,
+			v1alpha1.RolloutAbortReasonStepTimeout:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("retryOn").Index(i), reason, reasons))
+		}
+	}
+	return allErrs
+}
+
+// ValidateCanaryAbortPolicy checks the canary pods which are preserved when an update is aborted
+func ValidateCanaryAbortPolicy(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	abortPolicy := rollout.Spec.Strategy.Canary.AbortPolicy
+	if abortPolicy == nil || abortPolicy.Preserve == nil {
+		return allErrs
+	}
+	if rollout.Spec.IsStatefulSetWorkload() || rollout.Spec.IsDaemonSetWorkload() {
+		allErrs = append(allErrs, field.Invalid(fldPath, "abortPolicy", InvalidAbortPolicyWorkloadMessage))
+	}
+	preservePath := fldPath.Child("preserve")
+	if abortPolicy.Preserve.Count != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*abortPolicy.Preserve.Count), preservePath.Child("count"))...)
+	}
+	allErrs = append(allErrs, validatePositiveDuration(abortPolicy.Preserve.TTL, preservePath.Child("ttl"))...)
+	return allErrs
+}
+
+func validatePositiveDuration(duration v1alpha1.DurationString, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if duration == "" {
+		return allErrs
+	}
+	if d, err := duration.Duration(); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, err.Error()))
+	} else if d <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, InvalidDurationMessage))
+	}
+	return allErrs
+}
+
+// ValidateStepTimeout checks the timeout and the onTimeout policy of a step
+func ValidateStepTimeout(stepTimeout v1alpha1.StepTimeout, fldPath *field.Path) field.ErrorList {
+	allErrs := validatePositiveDuration(stepTimeout.Timeout, fldPath.Child("timeout"))
+	switch stepTimeout.OnTimeout {
+	case "", v1alpha1.StepTimeoutPolicyAbort, v1alpha1.StepTimeoutPolicyPause, v1alpha1.StepTimeoutPolicySkip:
+	default:
+		policies := []string{string(v1alpha1.StepTimeoutPolicyAbort), string(v1alpha1.StepTimeoutPolicyPause), string(v1alpha1.StepTimeoutPolicySkip)}
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("onTimeout"), stepTimeout.OnTimeout, policies))
+	}
+	return allErrs
+}
+
+// ValidateDaemonSetCanary checks that a Rollout with the DaemonSet canary strategy only uses the features which can be
+// implemented by moving nodes between the stable and canary DaemonSets
+func ValidateDaemonSetCanary(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	spec := rollout.Spec
+	canary := spec.Strategy.Canary
+	canaryPath := fldPath.Child("strategy", "canary")
+	allErrs := field.ErrorList{}
+	if spec.IsStatefulSetWorkload() {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("workloadRef"), spec.WorkloadRef.Kind, InvalidDaemonSetCanaryStrategyMessage))
+	}
+	if spec.RollbackWindow != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rollbackWindow"), spec.RollbackWindow, InvalidDaemonSetCanaryStrategyMessage))
+	}
+	if canary.TrafficRouting != nil {
+		allErrs = append(allErrs, field.Invalid(canaryPath.Child("trafficRouting"), "trafficRouting", InvalidDaemonSetCanaryStrategyMessage))
+	}
+	if canary.CanaryService != "" || canary.StableService != "" {
+		allErrs = append(allErrs, field.Invalid(canaryPath.Child("canaryService"), canary.CanaryService, InvalidDaemonSetCanaryStrategyMessage))
+	}
+	for i, step := range canary.Steps {
+		stepFldPath := canaryPath.Child("steps").Index(i)
+		if step.SetWeight == nil && step.SetCanaryNodes == nil && step.Pause == nil && step.Analysis == nil && step.WaitFor == nil {
+			allErrs = append(allErrs, field.Invalid(stepFldPath, step, InvalidDaemonSetCanaryStepMessage))
+		}
+		if step.SetCanaryNodes != nil {
+			if _, err := metav1.LabelSelectorAsSelector(&step.SetCanaryNodes.NodeSelector); err != nil {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setCanaryNodes", "nodeSelector"), step.SetCanaryNodes.NodeSelector, err.Error()))
+			}
+		}
+	}
 	return allErrs
 }
 
@@ -225,6 +543,38 @@ func ValidateRolloutStrategyBlueGreen(rollout *v1alpha1.Rollout, fldPath *field.
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownDelayRevisionLimit"), *blueGreen.ScaleDownDelayRevisionLimit, ScaleDownLimitLargerThanRevisionLimit))
 	}
 	allErrs = append(allErrs, ValidateRolloutStrategyAntiAffinity(blueGreen.AntiAffinity, fldPath.Child("antiAffinity"))...)
+	if blueGreen.TrafficRouting != nil && blueGreen.PreviewService == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("trafficRouting"), blueGreen.PreviewService, InvalidBlueGreenTrafficRoutingMessage))
+	}
+	if len(blueGreen.TrafficSteps) > 0 && blueGreen.TrafficRouting == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("trafficRouting"), InvalidBlueGreenTrafficStepsMessage))
+	}
+	maxTrafficWeight := weightutil.MaxTrafficWeight(rollout)
+	for i, step := range blueGreen.TrafficSteps {
+		stepFldPath := fldPath.Child("trafficSteps").Index(i)
+		stepTypes := 0
+		for _, set := range []bool{step.SetWeight != nil, step.Pause != nil, step.Analysis != nil} {
+			if set {
+				stepTypes++
+			}
+		}
+		if stepTypes != 1 {
+			errVal := fmt.Sprintf("step.SetWeight: %t step.Pause: %t step.Analysis: %t", step.SetWeight != nil, step.Pause != nil, step.Analysis != nil)
+			allErrs = append(allErrs, field.Invalid(stepFldPath, errVal, InvalidBlueGreenTrafficStepMessage))
+		}
+		if step.SetWeight != nil && (*step.SetWeight < 0 || *step.SetWeight > maxTrafficWeight) {
+			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setWeight"), *step.SetWeight, fmt.Sprintf(InvalidSetWeightMessage, maxTrafficWeight)))
+		}
+		if step.Pause != nil && step.Pause.DurationSeconds() < 0 {
+			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("pause").Child("duration"), step.Pause.DurationSeconds(), InvalidDurationMessage))
+		}
+	}
+	if blueGreen.PrePromotionTimeout != nil {
+		allErrs = append(allErrs, ValidateStepTimeout(*blueGreen.PrePromotionTimeout, fldPath.Child("prePromotionTimeout"))...)
+	}
+	if blueGreen.PostPromotionTimeout != nil {
+		allErrs = append(allErrs, ValidateStepTimeout(*blueGreen.PostPromotionTimeout, fldPath.Child("postPromotionTimeout"))...)
+	}
 	return allErrs
 }
 
@@ -245,7 +595,11 @@ func requireCanaryStableServices(rollout *v1alpha1.Rollout) bool {
 		canary.TrafficRouting.Ambassador != nil,
 		canary.TrafficRouting.Nginx != nil,
 		canary.TrafficRouting.AppMesh != nil,
-		canary.TrafficRouting.Traefik != nil:
+		canary.TrafficRouting.Traefik != nil,
+		canary.TrafficRouting.Contour != nil,
+		canary.TrafficRouting.Kong != nil,
+		canary.TrafficRouting.Linkerd != nil,
+		canary.TrafficRouting.DNS != nil:
 		return true
 	default:
 		return false
@@ -296,6 +650,21 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 		if canary.ScaleDownDelaySeconds != nil && canary.DynamicStableScale {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("dynamicStableScale"), canary.DynamicStableScale, InvalidCanaryDynamicStableScaleWithScaleDownDelay))
 		}
+		if canary.TrafficRouting.Istio != nil && len(canary.TrafficRouting.Istio.Localities) > 0 {
+			allErrs = append(allErrs, validateIstioLocalities(rollout, fldPath.Child("trafficRouting", "istio"))...)
+		}
+		if stickiness := canary.TrafficRouting.Stickiness; stickiness != nil {
+			stickinessFldPath := fldPath.Child("trafficRouting", "stickiness")
+			if canary.TrafficRouting.Istio == nil && canary.TrafficRouting.Nginx == nil && canary.TrafficRouting.Traefik == nil {
+				allErrs = append(allErrs, field.Invalid(stickinessFldPath, stickiness, InvalidStickinessTrafficPolicy))
+			}
+			if stickiness.DurationSeconds < 0 {
+				allErrs = append(allErrs, field.Invalid(stickinessFldPath.Child("durationSeconds"), stickiness.DurationSeconds, InvalidDurationMessage))
+			}
+		}
+		if dns := canary.TrafficRouting.DNS; dns != nil && dns.StableSetIdentifier == dns.CanarySetIdentifier {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("trafficRouting", "dns", "canarySetIdentifier"), dns.CanarySetIdentifier, DuplicatedDNSSetIdentifiersMessage))
+		}
 		// only the nginx and plugin have this support for now
 		if canary.TrafficRouting.MaxTrafficWeight != nil {
 			if canary.TrafficRouting.Nginx == nil && len(canary.TrafficRouting.Plugins) == 0 {
@@ -308,11 +677,18 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 		stepFldPath := fldPath.Child("steps").Index(i)
 		allErrs = append(allErrs, hasMultipleStepsType(step, stepFldPath)...)
 		if step.Experiment == nil && step.Pause == nil && step.SetWeight == nil && step.Analysis == nil && step.SetCanaryScale == nil &&
-			step.SetHeaderRoute == nil && step.SetMirrorRoute == nil && step.Plugin == nil {
+			step.SetHeaderRoute == nil && step.SetMirrorRoute == nil && step.Plugin == nil && step.SetCanaryNodes == nil && step.WaitFor == nil {
 			errVal := fmt.Sprintf("step.Experiment: %t step.Pause: %t step.SetWeight: %t step.Analysis: %t step.SetCanaryScale: %t step.SetHeaderRoute: %t step.SetMirrorRoute: %t step.Plugin: %t",
 				step.Experiment == nil, step.Pause == nil, step.SetWeight == nil, step.Analysis == nil, step.SetCanaryScale == nil, step.SetHeaderRoute == nil, step.SetMirrorRoute == nil, step.Plugin == nil)
 			allErrs = append(allErrs, field.Invalid(stepFldPath, errVal, InvalidStepMessage))
 		}
+		if step.SetCanaryNodes != nil && !rollout.Spec.IsDaemonSetWorkload() {
+			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setCanaryNodes"), step.SetCanaryNodes, InvalidSetCanaryNodesStepMessage))
+		}
+		if step.WaitFor != nil {
+			allErrs = append(allErrs, ValidateWaitForStep(*step.WaitFor, stepFldPath.Child("waitFor"))...)
+		}
+		allErrs = append(allErrs, ValidateStepTimeout(v1alpha1.StepTimeout{Timeout: step.Timeout, OnTimeout: step.OnTimeout}, stepFldPath)...)
 
 		maxTrafficWeight := weightutil.MaxTrafficWeight(rollout)
 
@@ -328,7 +704,7 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 
 		if step.SetHeaderRoute != nil {
 			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
-			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.ALB == nil && trafficRouting.Apisix == nil && len(trafficRouting.Plugins) == 0) {
+			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.ALB == nil && trafficRouting.Apisix == nil && trafficRouting.Contour == nil && trafficRouting.Kong == nil && trafficRouting.Linkerd == nil && len(trafficRouting.Plugins) == 0) {
 				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setHeaderRoute"), step.SetHeaderRoute, InvalidSetHeaderRouteTrafficPolicy))
 			} else if step.SetHeaderRoute.Match != nil && len(step.SetHeaderRoute.Match) > 0 {
 				for j, match := range step.SetHeaderRoute.Match {
@@ -345,12 +721,15 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 
 		if step.SetMirrorRoute != nil {
 			trafficRouting := rollout.Spec.Strategy.Canary.TrafficRouting
-			if trafficRouting == nil || trafficRouting.Istio == nil {
-				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setMirrorRoute"), step.SetMirrorRoute, "SetMirrorRoute requires TrafficRouting, supports Istio only"))
+			if trafficRouting == nil || (trafficRouting.Istio == nil && trafficRouting.Contour == nil && trafficRouting.Kong == nil) {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setMirrorRoute"), step.SetMirrorRoute, InvalidSetMirrorRouteTrafficPolicy))
 			}
 			if step.SetMirrorRoute.Match != nil && len(step.SetMirrorRoute.Match) > 0 {
 				for j, match := range step.SetMirrorRoute.Match {
 					matchFld := stepFldPath.Child("setMirrorRoute").Child("match").Index(j)
+					if match.Method != nil && trafficRouting != nil && trafficRouting.Contour != nil {
+						allErrs = append(allErrs, field.Invalid(matchFld.Child("method"), match.Method, InvalidSetMirrorRouteContourMethodPolicy))
+					}
 					if match.Method != nil {
 						allErrs = append(allErrs, hasMultipleMatchValues(match.Method, matchFld)...)
 					}
@@ -387,8 +766,8 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 				if template.Weight != nil {
 					if canary.TrafficRouting == nil {
 						allErrs = append(allErrs, field.Invalid(stepFldPath.Child("experiment").Child("templates").Index(tmplIndex).Child("weight"), *canary.Steps[i].Experiment.Templates[tmplIndex].Weight, InvalidCanaryExperimentTemplateWeightWithoutTrafficRouting))
-					} else if canary.TrafficRouting.ALB == nil && canary.TrafficRouting.SMI == nil && canary.TrafficRouting.Istio == nil && len(canary.TrafficRouting.Plugins) == 0 {
-						allErrs = append(allErrs, field.Invalid(stepFldPath.Child("experiment").Child("templates").Index(tmplIndex).Child("weight"), *canary.Steps[i].Experiment.Templates[tmplIndex].Weight, "Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio and Plugins at this time"))
+					} else if canary.TrafficRouting.ALB == nil && canary.TrafficRouting.SMI == nil && canary.TrafficRouting.Istio == nil && canary.TrafficRouting.Kong == nil && len(canary.TrafficRouting.Plugins) == 0 {
+						allErrs = append(allErrs, field.Invalid(stepFldPath.Child("experiment").Child("templates").Index(tmplIndex).Child("weight"), *canary.Steps[i].Experiment.Templates[tmplIndex].Weight, "Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio, Kong and Plugins at this time"))
 					}
 				}
 			}
@@ -420,6 +799,36 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 	return allErrs
 }
 
+func validateIstioLocalities(rollout *v1alpha1.Rollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	istio := rollout.Spec.Strategy.Canary.TrafficRouting.Istio
+	if istio.DestinationRule == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("destinationRule"), InvalidIstioLocalitiesDestinationRuleMessage))
+	}
+	maxTrafficWeight := weightutil.MaxTrafficWeight(rollout)
+	hasMinWeightZero := false
+	names := map[string]bool{}
+	for i, locality := range istio.Localities {
+		localityFldPath := fldPath.Child("localities").Index(i)
+		if locality.Name == "" {
+			allErrs = append(allErrs, field.Required(localityFldPath.Child("name"), fmt.Sprintf(MissingFieldMessage, "name")))
+		} else if names[locality.Name] {
+			allErrs = append(allErrs, field.Duplicate(localityFldPath.Child("name"), locality.Name))
+		}
+		names[locality.Name] = true
+		if locality.MinWeight < 0 || locality.MinWeight > maxTrafficWeight {
+			allErrs = append(allErrs, field.Invalid(localityFldPath.Child("minWeight"), locality.MinWeight, fmt.Sprintf(InvalidSetWeightMessage, maxTrafficWeight)))
+		}
+		if locality.MinWeight == 0 {
+			hasMinWeightZero = true
+		}
+	}
+	if !hasMinWeightZero {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("localities"), len(istio.Localities), InvalidIstioLocalitiesMinWeightMessage))
+	}
+	return allErrs
+}
+
 func ValidateStepRouteFoundInManagedRoute(stepFldPath *field.Path, stepRoutName string, roManagedRoutes []v1alpha1.MangedRoutes) field.ErrorList {
 	allErrs := field.ErrorList{}
 	found := false
@@ -480,6 +889,42 @@ func getIntOrPercentValue(intOrStringValue intstr.IntOrString) int {
 	return intOrStringValue.IntValue()
 }
 
+// ValidateWaitForStep validates the resource reference, condition and timeout of a waitFor step
+func ValidateWaitForStep(waitFor v1alpha1.WaitForStep, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if waitFor.APIVersion == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("apiVersion"), fmt.Sprintf(MissingFieldMessage, "apiVersion")))
+	}
+	if waitFor.Kind == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kind"), fmt.Sprintf(MissingFieldMessage, "kind")))
+	}
+	if waitFor.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), fmt.Sprintf(MissingFieldMessage, "name")))
+	}
+	if (waitFor.Condition == "") == (waitFor.JSONPath == "") {
+		allErrs = append(allErrs, field.Invalid(fldPath, waitFor.Condition, InvalidWaitForConditionMessage))
+	} else if err := evaluate.ValidateWaitFor(waitFor); err != nil {
+		conditionFld := fldPath.Child("condition")
+		if waitFor.JSONPath != "" {
+			conditionFld = fldPath.Child("jsonPath")
+		}
+		allErrs = append(allErrs, field.Invalid(conditionFld, waitFor.Condition+waitFor.JSONPath, err.Error()))
+	}
+	if waitFor.Timeout != "" {
+		if timeout, err := waitFor.Timeout.Duration(); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), waitFor.Timeout, err.Error()))
+		} else if timeout <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), waitFor.Timeout, InvalidDurationMessage))
+		}
+	}
+	switch waitFor.OnTimeout {
+	case "", v1alpha1.WaitForTimeoutPolicyAbort, v1alpha1.WaitForTimeoutPolicyPause:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("onTimeout"), waitFor.OnTimeout, []string{string(v1alpha1.WaitForTimeoutPolicyAbort), string(v1alpha1.WaitForTimeoutPolicyPause)}))
+	}
+	return allErrs
+}
+
 func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	oneOf := make([]bool, 3)
@@ -487,6 +932,8 @@ func hasMultipleStepsType(s v1alpha1.CanaryStep, fldPath *field.Path) field.Erro
 	oneOf = append(oneOf, s.Pause != nil)
 	oneOf = append(oneOf, s.Experiment != nil)
 	oneOf = append(oneOf, s.Analysis != nil)
+	oneOf = append(oneOf, s.SetCanaryNodes != nil)
+	oneOf = append(oneOf, s.WaitFor != nil)
 	hasMultipleStepTypes := false
 	for i := range oneOf {
 		if oneOf[i] {
@@ -544,3 +991,47 @@ func hasMultipleMatchValues(match *v1alpha1.StringMatch, fldPath *field.Path) fi
 	}
 	return allErrs
 }
+
+// ValidateAnalysisTemplateSpec checks the metrics and arguments of an AnalysisTemplate or ClusterAnalysisTemplate.
+// Metrics which reference arguments are only validated once resolved in an AnalysisRun.
+func ValidateAnalysisTemplateSpec(spec v1alpha1.AnalysisTemplateSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(spec.Metrics) == 0 && len(spec.Templates) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("metrics"), "no metrics specified"))
+	}
+	for i, arg := range spec.Args {
+		if arg.Value != nil && arg.ValueFrom != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("args").Index(i), arg.Name, "arg has both value and valueFrom fields"))
+		}
+	}
+	metricNames := make(map[string]bool)
+	for i, metric := range spec.Metrics {
+		metricFldPath := fldPath.Child("metrics").Index(i)
+		if metricNames[metric.Name] {
+			allErrs = append(allErrs, field.Duplicate(metricFldPath.Child("name"), metric.Name))
+		}
+		metricNames[metric.Name] = true
+		metricBytes, err := json.Marshal(metric)
+		if err != nil || strings.Contains(string(metricBytes), "{{") {
+			continue
+		}
+		if err := analysisutil.ValidateMetric(metric); err != nil {
+			allErrs = append(allErrs, field.Invalid(metricFldPath, metric.Name, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// ValidateExperiment checks the spec of an Experiment, as done at the start of each reconciliation
+func ValidateExperiment(experiment *v1alpha1.Experiment) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cond := conditions.VerifyExperimentSpec(experiment, nil); cond != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "templates"), experiment.Name, cond.Message))
+	}
+	for i, analysis := range experiment.Spec.Analyses {
+		if analysis.TemplateName == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "analyses").Index(i).Child("templateName"), "analysis templateName is required"))
+		}
+	}
+	return allErrs
+}
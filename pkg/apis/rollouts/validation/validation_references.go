@@ -66,6 +66,10 @@ type ReferencedResources struct {
 	VirtualServices           []unstructured.Unstructured
 	AmbassadorMappings        []unstructured.Unstructured
 	AppMeshResources          []unstructured.Unstructured
+	ContourHTTPProxies        []unstructured.Unstructured
+	KongHTTPRoutes            []unstructured.Unstructured
+	LinkerdHTTPRoutes         []unstructured.Unstructured
+	DNSEndpoints              []unstructured.Unstructured
 }
 
 func ValidateRolloutReferencedResources(rollout *v1alpha1.Rollout, referencedResources ReferencedResources) field.ErrorList {
@@ -88,6 +92,18 @@ func ValidateRolloutReferencedResources(rollout *v1alpha1.Rollout, referencedRes
 	for _, appmeshRes := range referencedResources.AppMeshResources {
 		allErrs = append(allErrs, ValidateAppMeshResource(appmeshRes)...)
 	}
+	for _, httpProxy := range referencedResources.ContourHTTPProxies {
+		allErrs = append(allErrs, ValidateContourHTTPProxy(rollout, httpProxy)...)
+	}
+	for _, httpRoute := range referencedResources.KongHTTPRoutes {
+		allErrs = append(allErrs, ValidateKongHTTPRoute(rollout, httpRoute)...)
+	}
+	for _, httpRoute := range referencedResources.LinkerdHTTPRoutes {
+		allErrs = append(allErrs, ValidateLinkerdHTTPRoute(rollout, httpRoute)...)
+	}
+	for _, dnsEndpoint := range referencedResources.DNSEndpoints {
+		allErrs = append(allErrs, ValidateDNSEndpoint(rollout, dnsEndpoint)...)
+	}
 	return allErrs
 }
 
@@ -452,6 +468,82 @@ func ValidateAmbassadorMapping(obj unstructured.Unstructured) field.ErrorList {
 	return allErrs
 }
 
+// ValidateContourHTTPProxy verifies that the HTTPProxy has a route which references both the stable and canary services
+func ValidateContourHTTPProxy(rollout *v1alpha1.Rollout, obj unstructured.Unstructured) field.ErrorList {
+	fldPath := field.NewPath("spec", "strategy", "canary", "trafficRouting", "contour", "httpProxies")
+	routes, _, _ := unstructured.NestedSlice(obj.Object, "spec", "routes")
+	if !hasRouteToServices(routes, "services", rollout.Spec.Strategy.Canary) {
+		msg := fmt.Sprintf("Contour HTTPProxy %q has no route with services %q and %q", obj.GetName(), rollout.Spec.Strategy.Canary.StableService, rollout.Spec.Strategy.Canary.CanaryService)
+		return field.ErrorList{field.Invalid(fldPath, obj.GetName(), msg)}
+	}
+	return field.ErrorList{}
+}
+
+// ValidateKongHTTPRoute verifies that the HTTPRoute has a rule which references both the stable and canary services
+func ValidateKongHTTPRoute(rollout *v1alpha1.Rollout, obj unstructured.Unstructured) field.ErrorList {
+	return validateHTTPRoute(rollout, obj, field.NewPath("spec", "strategy", "canary", "trafficRouting", "kong", "httpRoutes"))
+}
+
+// ValidateLinkerdHTTPRoute verifies that the HTTPRoute has a rule which references both the stable and canary services
+func ValidateLinkerdHTTPRoute(rollout *v1alpha1.Rollout, obj unstructured.Unstructured) field.ErrorList {
+	return validateHTTPRoute(rollout, obj, field.NewPath("spec", "strategy", "canary", "trafficRouting", "linkerd", "httpRoutes"))
+}
+
+// ValidateDNSEndpoint verifies that the DNSEndpoint has records with both the stable and canary set identifiers
+func ValidateDNSEndpoint(rollout *v1alpha1.Rollout, obj unstructured.Unstructured) field.ErrorList {
+	fldPath := field.NewPath("spec", "strategy", "canary", "trafficRouting", "dns", "dnsEndpoint")
+	dns := rollout.Spec.Strategy.Canary.TrafficRouting.DNS
+	setIdentifiers := map[string]bool{}
+	endpoints, _, _ := unstructured.NestedSlice(obj.Object, "spec", "endpoints")
+	for _, endpoint := range endpoints {
+		typedEndpoint, ok := endpoint.(map[string]any)
+		if !ok {
+			continue
+		}
+		setIdentifier, _, _ := unstructured.NestedString(typedEndpoint, "setIdentifier")
+		setIdentifiers[setIdentifier] = true
+	}
+	if !setIdentifiers[dns.StableSetIdentifier] || !setIdentifiers[dns.CanarySetIdentifier] {
+		msg := fmt.Sprintf("DNSEndpoint %q has no records with set identifiers %q and %q", obj.GetName(), dns.StableSetIdentifier, dns.CanarySetIdentifier)
+		return field.ErrorList{field.Invalid(fldPath, obj.GetName(), msg)}
+	}
+	return field.ErrorList{}
+}
+
+func validateHTTPRoute(rollout *v1alpha1.Rollout, obj unstructured.Unstructured, fldPath *field.Path) field.ErrorList {
+	rules, _, _ := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	if !hasRouteToServices(rules, "backendRefs", rollout.Spec.Strategy.Canary) {
+		msg := fmt.Sprintf("HTTPRoute %q has no rule with backendRefs %q and %q", obj.GetName(), rollout.Spec.Strategy.Canary.StableService, rollout.Spec.Strategy.Canary.CanaryService)
+		return field.ErrorList{field.Invalid(fldPath, obj.GetName(), msg)}
+	}
+	return field.ErrorList{}
+}
+
+// hasRouteToServices returns true if one of the routes lists both the stable and canary services under the given key
+func hasRouteToServices(routes []any, key string, canary *v1alpha1.CanaryStrategy) bool {
+	for _, route := range routes {
+		typedRoute, ok := route.(map[string]any)
+		if !ok {
+			continue
+		}
+		services, _, _ := unstructured.NestedSlice(typedRoute, key)
+		hasStable, hasCanary := false, false
+		for _, service := range services {
+			typedService, ok := service.(map[string]any)
+			if !ok {
+				continue
+			}
+			name, _, _ := unstructured.NestedString(typedService, "name")
+			hasStable = hasStable || name == canary.StableService
+			hasCanary = hasCanary || name == canary.CanaryService
+		}
+		if hasStable && hasCanary {
+			return true
+		}
+	}
+	return false
+}
+
 func ValidateAppMeshResource(obj unstructured.Unstructured) field.ErrorList {
 	if obj.GetKind() != "VirtualRouter" {
 		fldPath := field.NewPath("kind")
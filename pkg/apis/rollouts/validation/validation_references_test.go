@@ -1325,6 +1325,125 @@ spec:
 	})
 }
 
+func TestValidateContourHTTPProxy(t *testing.T) {
+	ro := getRolloutSingleIngress("ingress")
+	httpProxy := `
+apiVersion: projectcontour.io/v1
+kind: HTTPProxy
+metadata:
+  name: proxy
+  namespace: default
+spec:
+  routes:
+  - services:
+    - name: stable-service
+      port: 80
+    - name: %s
+      port: 80`
+
+	t.Run("will return no error if a route references the canary and stable services", func(t *testing.T) {
+		obj := unstructured.StrToUnstructuredUnsafe(fmt.Sprintf(httpProxy, "canary-service-name"))
+		errList := ValidateContourHTTPProxy(ro, *obj)
+		assert.Len(t, errList, 0)
+	})
+	t.Run("will return error if no route references the canary service", func(t *testing.T) {
+		obj := unstructured.StrToUnstructuredUnsafe(fmt.Sprintf(httpProxy, "other"))
+		errList := ValidateContourHTTPProxy(ro, *obj)
+		assert.Len(t, errList, 1)
+		assert.Equal(t, `Contour HTTPProxy "proxy" has no route with services "stable-service" and "canary-service-name"`, errList[0].Detail)
+		assert.Equal(t, "spec.strategy.canary.trafficRouting.contour.httpProxies", errList[0].Field)
+	})
+}
+
+func TestValidateKongHTTPRoute(t *testing.T) {
+	ro := getRolloutSingleIngress("ingress")
+	httpRoute := `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: default
+spec:
+  rules:
+  - backendRefs:
+    - name: stable-service
+      port: 80
+    - name: %s
+      port: 80`
+
+	t.Run("will return no error if a rule references the canary and stable services", func(t *testing.T) {
+		obj := unstructured.StrToUnstructuredUnsafe(fmt.Sprintf(httpRoute, "canary-service-name"))
+		errList := ValidateKongHTTPRoute(ro, *obj)
+		assert.Len(t, errList, 0)
+	})
+	t.Run("will return error if no rule references the canary service", func(t *testing.T) {
+		obj := unstructured.StrToUnstructuredUnsafe(fmt.Sprintf(httpRoute, "other"))
+		errList := ValidateKongHTTPRoute(ro, *obj)
+		assert.Len(t, errList, 1)
+		assert.Equal(t, `HTTPRoute "route" has no rule with backendRefs "stable-service" and "canary-service-name"`, errList[0].Detail)
+		assert.Equal(t, "spec.strategy.canary.trafficRouting.kong.httpRoutes", errList[0].Field)
+	})
+}
+
+func TestValidateLinkerdHTTPRoute(t *testing.T) {
+	ro := getRolloutSingleIngress("ingress")
+	obj := unstructured.StrToUnstructuredUnsafe(`
+apiVersion: policy.linkerd.io/v1beta3
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: default
+spec:
+  rules:
+  - backendRefs:
+    - name: stable-service
+      port: 80`)
+	errList := ValidateLinkerdHTTPRoute(ro, *obj)
+	assert.Len(t, errList, 1)
+	assert.Equal(t, "spec.strategy.canary.trafficRouting.linkerd.httpRoutes", errList[0].Field)
+}
+
+func TestValidateDNSEndpoint(t *testing.T) {
+	ro := getRolloutSingleIngress("ingress")
+	ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		DNS: &v1alpha1.DNSTrafficRouting{
+			DNSEndpoint:         "records",
+			StableSetIdentifier: "stable",
+			CanarySetIdentifier: "canary",
+		},
+	}
+	obj := unstructured.StrToUnstructuredUnsafe(`
+apiVersion: externaldns.k8s.io/v1alpha1
+kind: DNSEndpoint
+metadata:
+  name: records
+  namespace: default
+spec:
+  endpoints:
+  - dnsName: app.example.com
+    recordType: CNAME
+    setIdentifier: stable
+    targets:
+    - stable.example.com
+  - dnsName: app.example.com
+    recordType: CNAME
+    setIdentifier: canary
+    targets:
+    - canary.example.com`)
+
+	t.Run("will succeed with stable and canary records", func(t *testing.T) {
+		assert.Empty(t, ValidateDNSEndpoint(ro, *obj))
+	})
+	t.Run("will return error when canary record is missing", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.DNS.CanarySetIdentifier = "preview"
+		errList := ValidateDNSEndpoint(invalidRo, *obj)
+		assert.Len(t, errList, 1)
+		assert.Equal(t, "spec.strategy.canary.trafficRouting.dns.dnsEndpoint", errList[0].Field)
+		assert.Equal(t, `DNSEndpoint "records" has no records with set identifiers "stable" and "preview"`, errList[0].Detail)
+	})
+}
+
 func TestValidateAppMeshResource(t *testing.T) {
 	t.Run("will return error with appmesh virtual-service", func(t *testing.T) {
 		t.Parallel()
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -17,7 +18,7 @@ import (
 )
 
 const (
-	errTrafficRoutingWithExperimentSupport = "Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio and Plugins at this time"
+	errTrafficRoutingWithExperimentSupport = "Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio, Kong and Plugins at this time"
 )
 
 func TestValidateRollout(t *testing.T) {
@@ -129,6 +130,50 @@ func TestValidateRolloutStrategyBlueGreen(t *testing.T) {
 	assert.Equal(t, ScaleDownLimitLargerThanRevisionLimit, allErrs[1].Detail)
 }
 
+func TestValidateRolloutStrategyBlueGreenTrafficSteps(t *testing.T) {
+	newRollout := func() *v1alpha1.Rollout {
+		return &v1alpha1.Rollout{
+			Spec: v1alpha1.RolloutSpec{
+				Strategy: v1alpha1.RolloutStrategy{
+					BlueGreen: &v1alpha1.BlueGreenStrategy{
+						ActiveService:  "active",
+						PreviewService: "preview",
+						TrafficRouting: &v1alpha1.RolloutTrafficRouting{Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"}},
+						TrafficSteps: []v1alpha1.BlueGreenTrafficStep{
+							{SetWeight: pointer.Int32(10)},
+							{Pause: &v1alpha1.RolloutPause{}},
+							{Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "success-rate"}}}},
+							{SetWeight: pointer.Int32(50)},
+						},
+					},
+				},
+			},
+		}
+	}
+	fldPath := field.NewPath("spec", "strategy", "blueGreen")
+	assert.Empty(t, ValidateRolloutStrategyBlueGreen(newRollout(), fldPath))
+
+	ro := newRollout()
+	ro.Spec.Strategy.BlueGreen.PreviewService = ""
+	allErrs := ValidateRolloutStrategyBlueGreen(ro, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidBlueGreenTrafficRoutingMessage, allErrs[0].Detail)
+
+	ro = newRollout()
+	ro.Spec.Strategy.BlueGreen.TrafficRouting = nil
+	allErrs = ValidateRolloutStrategyBlueGreen(ro, fldPath)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidBlueGreenTrafficStepsMessage, allErrs[0].Detail)
+
+	ro = newRollout()
+	ro.Spec.Strategy.BlueGreen.TrafficSteps[0].Pause = &v1alpha1.RolloutPause{}
+	ro.Spec.Strategy.BlueGreen.TrafficSteps[3].SetWeight = pointer.Int32(110)
+	allErrs = ValidateRolloutStrategyBlueGreen(ro, fldPath)
+	assert.Len(t, allErrs, 2)
+	assert.Equal(t, InvalidBlueGreenTrafficStepMessage, allErrs[0].Detail)
+	assert.Equal(t, fmt.Sprintf(InvalidSetWeightMessage, 100), allErrs[1].Detail)
+}
+
 func TestValidateRolloutStrategyCanaryMissingServiceNames(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -567,6 +612,213 @@ func TestValidateRolloutStrategyCanarySetHeaderRoutePlugins(t *testing.T) {
 	})
 }
 
+func TestValidateRolloutStrategyCanaryManagedRoutesGatewayRouters(t *testing.T) {
+	percentage := int32(20)
+	steps := []v1alpha1.CanaryStep{
+		{
+			SetHeaderRoute: &v1alpha1.SetHeaderRoute{
+				Name:  "header-route",
+				Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "chrome"}}},
+			},
+		},
+		{
+			SetMirrorRoute: &v1alpha1.SetMirrorRoute{
+				Name:       "mirror-route",
+				Percentage: &percentage,
+				Match:      []v1alpha1.RouteMatch{{Path: &v1alpha1.StringMatch{Prefix: "/"}}},
+			},
+		},
+	}
+	managedRoutes := []v1alpha1.MangedRoutes{{Name: "header-route"}, {Name: "mirror-route"}}
+
+	for _, trafficRouting := range []*v1alpha1.RolloutTrafficRouting{
+		{Contour: &v1alpha1.ContourTrafficRouting{HTTPProxies: []string{"proxy"}}, ManagedRoutes: managedRoutes},
+		{Kong: &v1alpha1.KongTrafficRouting{HTTPRoutes: []string{"route"}}, ManagedRoutes: managedRoutes},
+	} {
+		ro := &v1alpha1.Rollout{}
+		ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+			CanaryService:  "canary",
+			StableService:  "stable",
+			TrafficRouting: trafficRouting,
+			Steps:          steps,
+		}
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Empty(t, allErrs)
+		assert.True(t, requireCanaryStableServices(ro))
+	}
+
+	// Contour does not match the method of the requests
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Contour:       &v1alpha1.ContourTrafficRouting{HTTPProxies: []string{"proxy"}},
+			ManagedRoutes: managedRoutes,
+		},
+		Steps: []v1alpha1.CanaryStep{{
+			SetMirrorRoute: &v1alpha1.SetMirrorRoute{
+				Name:  "mirror-route",
+				Match: []v1alpha1.RouteMatch{{Method: &v1alpha1.StringMatch{Exact: "GET"}}},
+			},
+		}},
+	}
+	allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, InvalidSetMirrorRouteContourMethodPolicy, allErrs[0].Detail)
+	ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Kong:          &v1alpha1.KongTrafficRouting{HTTPRoutes: []string{"route"}},
+		ManagedRoutes: managedRoutes,
+	}
+	assert.Empty(t, ValidateRolloutStrategyCanary(ro, field.NewPath("")))
+
+	ro = &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Nginx:         &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"},
+			ManagedRoutes: managedRoutes,
+		},
+		Steps: steps[1:],
+	}
+	allErrs = ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+	assert.Equal(t, InvalidSetMirrorRouteTrafficPolicy, allErrs[0].Detail)
+
+	// Linkerd HTTPRoutes support header routes but not mirror routes
+	ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Linkerd:       &v1alpha1.LinkerdTrafficRouting{HTTPRoutes: []string{"route"}},
+		ManagedRoutes: managedRoutes,
+	}
+	ro.Spec.Strategy.Canary.Steps = steps[:1]
+	assert.Empty(t, ValidateRolloutStrategyCanary(ro, field.NewPath("")))
+	assert.True(t, requireCanaryStableServices(ro))
+	ro.Spec.Strategy.Canary.Steps = steps
+	allErrs = ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+	assert.Equal(t, InvalidSetMirrorRouteTrafficPolicy, allErrs[0].Detail)
+}
+
+func TestValidateRolloutStrategyCanaryDNS(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			DNS: &v1alpha1.DNSTrafficRouting{
+				DNSEndpoint:         "records",
+				StableSetIdentifier: "stable",
+				CanarySetIdentifier: "canary",
+			},
+		},
+	}
+	assert.True(t, requireCanaryStableServices(ro))
+	allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+	assert.Len(t, allErrs, 2)
+	assert.Equal(t, InvalidTrafficRoutingMessage, allErrs[0].Detail)
+
+	ro.Spec.Strategy.Canary.StableService = "stable"
+	ro.Spec.Strategy.Canary.CanaryService = "canary"
+	assert.Empty(t, ValidateRolloutStrategyCanary(ro, field.NewPath("")))
+
+	ro.Spec.Strategy.Canary.TrafficRouting.DNS.CanarySetIdentifier = "stable"
+	allErrs = ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, DuplicatedDNSSetIdentifiersMessage, allErrs[0].Detail)
+	assert.Equal(t, "[].trafficRouting.dns.canarySetIdentifier", allErrs[0].Field)
+}
+
+func TestValidateRolloutStrategyCanaryStickiness(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Istio: &v1alpha1.IstioTrafficRouting{
+				VirtualService:  &v1alpha1.IstioVirtualService{Name: "virtual-service"},
+				DestinationRule: &v1alpha1.IstioDestinationRule{Name: "destination-rule", CanarySubsetName: "canary", StableSubsetName: "stable"},
+			},
+			Stickiness: &v1alpha1.TrafficStickiness{DurationSeconds: 3600},
+		},
+	}
+	assert.Empty(t, ValidateRolloutStrategyCanary(ro, field.NewPath("")))
+
+	t.Run("istio without destination rule", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule = nil
+		assert.Empty(t, ValidateRolloutStrategyCanary(validRo, field.NewPath("")))
+	})
+	t.Run("nginx and traefik", func(t *testing.T) {
+		validRo := ro.DeepCopy()
+		validRo.Spec.Strategy.Canary.TrafficRouting.Istio = nil
+		validRo.Spec.Strategy.Canary.TrafficRouting.Nginx = &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"}
+		assert.Empty(t, ValidateRolloutStrategyCanary(validRo, field.NewPath("")))
+		validRo.Spec.Strategy.Canary.TrafficRouting.Nginx = nil
+		validRo.Spec.Strategy.Canary.TrafficRouting.Traefik = &v1alpha1.TraefikTrafficRouting{WeightedTraefikServiceName: "traefik-service"}
+		assert.Empty(t, ValidateRolloutStrategyCanary(validRo, field.NewPath("")))
+	})
+	t.Run("unsupported traffic router", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Istio = nil
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.SMI = &v1alpha1.SMITrafficRouting{}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidStickinessTrafficPolicy, allErrs[0].Detail)
+	})
+	t.Run("negative duration", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Stickiness.DurationSeconds = -1
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidDurationMessage, allErrs[0].Detail)
+	})
+}
+
+func TestValidateRolloutStrategyCanaryIstioLocalities(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService: "canary",
+		StableService: "stable",
+		TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+			Istio: &v1alpha1.IstioTrafficRouting{
+				VirtualService:  &v1alpha1.IstioVirtualService{Name: "virtual-service"},
+				DestinationRule: &v1alpha1.IstioDestinationRule{Name: "destination-rule", CanarySubsetName: "canary", StableSubsetName: "stable"},
+				Localities: []v1alpha1.IstioLocality{
+					{Name: "us-east1/us-east1-b"},
+					{Name: "us-east1/us-east1-c", MinWeight: 50},
+				},
+			},
+		},
+	}
+	assert.Empty(t, ValidateRolloutStrategyCanary(ro, field.NewPath("")))
+
+	t.Run("without destination rule", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule = nil
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidIstioLocalitiesDestinationRuleMessage, allErrs[0].Detail)
+	})
+	t.Run("without locality enabled from the start", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Istio.Localities[0].MinWeight = 10
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidIstioLocalitiesMinWeightMessage, allErrs[0].Detail)
+	})
+	t.Run("invalid localities", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.TrafficRouting.Istio.Localities = []v1alpha1.IstioLocality{
+			{Name: "us-east1/us-east1-b"},
+			{Name: "us-east1/us-east1-b", MinWeight: 10},
+			{MinWeight: 101},
+		}
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Len(t, allErrs, 3)
+		assert.Equal(t, field.ErrorTypeDuplicate, allErrs[0].Type)
+		assert.Equal(t, "[].trafficRouting.istio.localities[1].name", allErrs[0].Field)
+		assert.Equal(t, field.ErrorTypeRequired, allErrs[1].Type)
+		assert.Equal(t, fmt.Sprintf(InvalidSetWeightMessage, 100), allErrs[2].Detail)
+	})
+}
+
 func TestValidateRolloutStrategyCanarySetHeaderRouteIstio(t *testing.T) {
 	ro := &v1alpha1.Rollout{}
 	ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
@@ -999,6 +1251,376 @@ func TestWorkloadRefWithTemplate(t *testing.T) {
 	})
 }
 
+func TestValidateStatefulSetWorkload(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			WorkloadRef: &v1alpha1.ObjectRef{
+				Name:       "my-statefulset",
+				Kind:       "StatefulSet",
+				APIVersion: "apps/v1",
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{SetWeight: pointer.Int32(20)},
+						{Pause: &v1alpha1.RolloutPause{}},
+						{Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "analysis"}}}},
+					},
+				},
+			},
+		},
+	}
+	t.Run("valid statefulset workload", func(t *testing.T) {
+		assert.Empty(t, ValidateRollout(ro.DeepCopy()))
+	})
+	t.Run("unsupported step", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.Steps = append(ro.Spec.Strategy.Canary.Steps, v1alpha1.CanaryStep{SetCanaryScale: &v1alpha1.SetCanaryScale{Replicas: pointer.Int32(1)}})
+		allErrs := ValidateStatefulSetWorkload(ro, field.NewPath("spec"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.canary.steps[3]", allErrs[0].Field)
+		assert.Equal(t, InvalidStatefulSetWorkloadStepMessage, allErrs[0].Detail)
+	})
+	t.Run("services and traffic routing", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.CanaryService = "canary"
+		ro.Spec.Strategy.Canary.StableService = "stable"
+		ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"}}
+		allErrs := ValidateStatefulSetWorkload(ro, field.NewPath("spec"))
+		assert.Len(t, allErrs, 2)
+		for _, err := range allErrs {
+			assert.Equal(t, InvalidStatefulSetWorkloadStrategyMessage, err.Detail)
+		}
+	})
+	t.Run("blue-green and scale down", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.WorkloadRef.ScaleDown = v1alpha1.ScaleDownOnSuccess
+		ro.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 1}
+		ro.Spec.Strategy.Canary = nil
+		ro.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{ActiveService: "active"}
+		allErrs := ValidateStatefulSetWorkload(ro, field.NewPath("spec"))
+		assert.Len(t, allErrs, 3)
+		assert.Equal(t, InvalidStatefulSetWorkloadScaleDownMessage, allErrs[0].Detail)
+		assert.Equal(t, "spec.rollbackWindow", allErrs[1].Field)
+		assert.Equal(t, "spec.strategy.blueGreen", allErrs[2].Field)
+	})
+}
+
+func TestValidateDaemonSetCanary(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					DaemonSet: &v1alpha1.DaemonSetCanaryStrategy{Enabled: true},
+					Steps: []v1alpha1.CanaryStep{
+						{SetCanaryNodes: &v1alpha1.SetCanaryNodes{NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "canary"}}}},
+						{Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "analysis"}}}},
+						{SetWeight: pointer.Int32(50)},
+						{Pause: &v1alpha1.RolloutPause{}},
+					},
+				},
+			},
+		},
+	}
+	t.Run("valid daemonset canary", func(t *testing.T) {
+		assert.Empty(t, ValidateDaemonSetCanary(ro.DeepCopy(), field.NewPath("spec")))
+		assert.Empty(t, ValidateRolloutStrategyCanary(ro.DeepCopy(), field.NewPath("")))
+	})
+	t.Run("unsupported step", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.Steps = append(ro.Spec.Strategy.Canary.Steps, v1alpha1.CanaryStep{Experiment: &v1alpha1.RolloutExperimentStep{}})
+		allErrs := ValidateDaemonSetCanary(ro, field.NewPath("spec"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.canary.steps[4]", allErrs[0].Field)
+		assert.Equal(t, InvalidDaemonSetCanaryStepMessage, allErrs[0].Detail)
+	})
+	t.Run("invalid node selector", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.Steps[0].SetCanaryNodes.NodeSelector = metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "pool", Operator: "Bad"}},
+		}
+		allErrs := ValidateDaemonSetCanary(ro, field.NewPath("spec"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.canary.steps[0].setCanaryNodes.nodeSelector", allErrs[0].Field)
+	})
+	t.Run("services, traffic routing and statefulset", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.WorkloadRef = &v1alpha1.ObjectRef{Name: "my-statefulset", Kind: "StatefulSet", APIVersion: "apps/v1"}
+		ro.Spec.RollbackWindow = &v1alpha1.RollbackWindowSpec{Revisions: 1}
+		ro.Spec.Strategy.Canary.CanaryService = "canary"
+		ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"}}
+		allErrs := ValidateDaemonSetCanary(ro, field.NewPath("spec"))
+		assert.Len(t, allErrs, 4)
+		for _, err := range allErrs {
+			assert.Equal(t, InvalidDaemonSetCanaryStrategyMessage, err.Detail)
+		}
+	})
+	t.Run("setCanaryNodes without daemonset", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.DaemonSet = nil
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "[].steps[0].setCanaryNodes", allErrs[0].Field)
+		assert.Equal(t, InvalidSetCanaryNodesStepMessage, allErrs[0].Detail)
+	})
+}
+
+func TestValidateConfigRefs(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			ConfigRefs: []v1alpha1.ConfigRef{
+				{Kind: v1alpha1.ConfigRefKindConfigMap, Name: "config"},
+				{Kind: v1alpha1.ConfigRefKindSecret, Name: "config"},
+			},
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}},
+		},
+	}
+	t.Run("valid configRefs", func(t *testing.T) {
+		assert.Empty(t, ValidateConfigRefs(ro.DeepCopy(), field.NewPath("spec", "configRefs")))
+	})
+	t.Run("invalid kind, missing name and duplicate", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.ConfigRefs = append(ro.Spec.ConfigRefs,
+			v1alpha1.ConfigRef{Kind: "Service", Name: "config"},
+			v1alpha1.ConfigRef{Kind: v1alpha1.ConfigRefKindSecret},
+			v1alpha1.ConfigRef{Kind: v1alpha1.ConfigRefKindConfigMap, Name: "config"},
+		)
+		allErrs := ValidateConfigRefs(ro, field.NewPath("spec", "configRefs"))
+		assert.Len(t, allErrs, 3)
+		assert.Equal(t, "spec.configRefs[2].kind", allErrs[0].Field)
+		assert.Equal(t, "spec.configRefs[3].name", allErrs[1].Field)
+		assert.Equal(t, "spec.configRefs[4]", allErrs[2].Field)
+		assert.Equal(t, DuplicateConfigRefMessage, allErrs[2].Detail)
+	})
+	t.Run("daemonset canary", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.DaemonSet = &v1alpha1.DaemonSetCanaryStrategy{Enabled: true}
+		allErrs := ValidateConfigRefs(ro, field.NewPath("spec", "configRefs"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidConfigRefsWorkloadMessage, allErrs[0].Detail)
+	})
+}
+
+func TestValidateStrategyRef(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			StrategyRef: &v1alpha1.StrategyRef{Name: "canary", CanaryService: "canary", Weights: []int32{10, 50}},
+		},
+	}
+	t.Run("valid strategyRef", func(t *testing.T) {
+		assert.Empty(t, ValidateStrategyRef(ro.DeepCopy(), field.NewPath("spec")))
+	})
+	t.Run("strategy, missing name and both overrides", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{}
+		ro.Spec.StrategyRef.Name = ""
+		ro.Spec.StrategyRef.ActiveService = "active"
+		allErrs := ValidateStrategyRef(ro, field.NewPath("spec"))
+		assert.Len(t, allErrs, 3)
+		assert.Equal(t, "spec.strategy", allErrs[0].Field)
+		assert.Equal(t, InvalidStrategyRefStrategyMessage, allErrs[0].Detail)
+		assert.Equal(t, "spec.strategyRef.name", allErrs[1].Field)
+		assert.Equal(t, InvalidStrategyRefOverridesMessage, allErrs[2].Detail)
+	})
+	t.Run("resolved strategy is validated", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.SetResolvedStrategy(v1alpha1.RolloutStrategy{})
+		allErrs := ValidateRolloutSpec(ro, field.NewPath("spec"))
+		var fields []string
+		for _, err := range allErrs {
+			fields = append(fields, err.Field)
+		}
+		assert.Contains(t, fields, "spec.strategy.strategy")
+	})
+}
+
+func TestValidateRolloutHooks(t *testing.T) {
+	hook := &v1alpha1.RolloutHook{
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers:    []corev1.Container{{Name: "migrate", Image: "migrate"}},
+					RestartPolicy: corev1.RestartPolicyNever,
+				},
+			},
+		},
+	}
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Hooks:    &v1alpha1.RolloutHooks{PreRollout: hook, PostPromotion: hook, OnAbort: hook},
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}},
+		},
+	}
+	t.Run("valid hooks", func(t *testing.T) {
+		assert.Empty(t, ValidateRolloutHooks(ro.DeepCopy(), field.NewPath("spec", "hooks")))
+	})
+	t.Run("missing containers and invalid restart policy", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Hooks.PostPromotion.Spec.Template.Spec.Containers = nil
+		ro.Spec.Hooks.OnAbort.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyAlways
+		allErrs := ValidateRolloutHooks(ro, field.NewPath("spec", "hooks"))
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, "spec.hooks.postPromotion.spec.template.spec.containers", allErrs[0].Field)
+		assert.Equal(t, "spec.hooks.onAbort.spec.template.spec.restartPolicy", allErrs[1].Field)
+	})
+	t.Run("daemonset canary", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.DaemonSet = &v1alpha1.DaemonSetCanaryStrategy{Enabled: true}
+		allErrs := ValidateRolloutHooks(ro, field.NewPath("spec", "hooks"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidHooksWorkloadMessage, allErrs[0].Detail)
+	})
+}
+
+func TestValidateRetryPolicy(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{
+		Limit: 3,
+		Backoff: &v1alpha1.RolloutRetryBackoff{
+			Duration:    "30s",
+			Factor:      pointer.Int32(2),
+			MaxDuration: "10m",
+		},
+		RetryOn: []v1alpha1.RolloutAbortReason{v1alpha1.RolloutAbortReasonAnalysisError, v1alpha1.RolloutAbortReasonProgressDeadlineExceeded},
+	}
+	t.Run("valid retryPolicy", func(t *testing.T) {
+		assert.Empty(t, ValidateRetryPolicy(policy.DeepCopy(), field.NewPath("spec", "retryPolicy")))
+		assert.Empty(t, ValidateRetryPolicy(nil, field.NewPath("spec", "retryPolicy")))
+	})
+	t.Run("invalid limit, backoff and reason", func(t *testing.T) {
+		policy := policy.DeepCopy()
+		policy.Limit = -1
+		policy.Backoff.Duration = "abc"
+		policy.Backoff.MaxDuration = "0s"
+		policy.Backoff.Factor = pointer.Int32(0)
+		policy.RetryOn = append(policy.RetryOn, "Manual")
+		allErrs := ValidateRetryPolicy(policy, field.NewPath("spec", "retryPolicy"))
+		assert.Len(t, allErrs, 5)
+		assert.Equal(t, "spec.retryPolicy.limit", allErrs[0].Field)
+		assert.Equal(t, "spec.retryPolicy.backoff.duration", allErrs[1].Field)
+		assert.Equal(t, "spec.retryPolicy.backoff.maxDuration", allErrs[2].Field)
+		assert.Equal(t, InvalidDurationMessage, allErrs[2].Detail)
+		assert.Equal(t, "spec.retryPolicy.backoff.factor", allErrs[3].Field)
+		assert.Equal(t, "spec.retryPolicy.retryOn[2]", allErrs[4].Field)
+	})
+}
+
+func TestValidateStepTimeout(t *testing.T) {
+	fldPath := field.NewPath("spec", "strategy", "canary", "steps").Index(0)
+	t.Run("valid timeouts", func(t *testing.T) {
+		assert.Empty(t, ValidateStepTimeout(v1alpha1.StepTimeout{}, fldPath))
+		for _, policy := range []v1alpha1.StepTimeoutPolicy{"", v1alpha1.StepTimeoutPolicyAbort, v1alpha1.StepTimeoutPolicyPause, v1alpha1.StepTimeoutPolicySkip} {
+			assert.Empty(t, ValidateStepTimeout(v1alpha1.StepTimeout{Timeout: "10m", OnTimeout: policy}, fldPath))
+		}
+	})
+	t.Run("invalid timeout and policy", func(t *testing.T) {
+		allErrs := ValidateStepTimeout(v1alpha1.StepTimeout{Timeout: "-1m", OnTimeout: "Retry"}, fldPath)
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, "spec.strategy.canary.steps[0].timeout", allErrs[0].Field)
+		assert.Equal(t, InvalidDurationMessage, allErrs[0].Detail)
+		assert.Equal(t, "spec.strategy.canary.steps[0].onTimeout", allErrs[1].Field)
+	})
+	t.Run("blue-green timeouts", func(t *testing.T) {
+		ro := &v1alpha1.Rollout{
+			Spec: v1alpha1.RolloutSpec{
+				Strategy: v1alpha1.RolloutStrategy{BlueGreen: &v1alpha1.BlueGreenStrategy{
+					ActiveService:        "active",
+					PrePromotionTimeout:  &v1alpha1.StepTimeout{Timeout: "abc"},
+					PostPromotionTimeout: &v1alpha1.StepTimeout{Timeout: "5m", OnTimeout: v1alpha1.StepTimeoutPolicySkip},
+				}},
+			},
+		}
+		allErrs := ValidateRolloutStrategyBlueGreen(ro, field.NewPath("spec", "strategy", "blueGreen"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.blueGreen.prePromotionTimeout.timeout", allErrs[0].Field)
+	})
+}
+
+func TestValidateCanaryAbortPolicy(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{
+				AbortPolicy: &v1alpha1.CanaryAbortPolicy{
+					Preserve: &v1alpha1.PreserveAbortedPods{Count: pointer.Int32(2), TTL: "2h"},
+				},
+			}},
+		},
+	}
+	fldPath := field.NewPath("spec", "strategy", "canary", "abortPolicy")
+	t.Run("valid abortPolicy", func(t *testing.T) {
+		assert.Empty(t, ValidateCanaryAbortPolicy(ro.DeepCopy(), fldPath))
+	})
+	t.Run("invalid count and ttl", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.AbortPolicy.Preserve.Count = pointer.Int32(-1)
+		ro.Spec.Strategy.Canary.AbortPolicy.Preserve.TTL = "-1m"
+		allErrs := ValidateCanaryAbortPolicy(ro, fldPath)
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, "spec.strategy.canary.abortPolicy.preserve.count", allErrs[0].Field)
+		assert.Equal(t, "spec.strategy.canary.abortPolicy.preserve.ttl", allErrs[1].Field)
+	})
+	t.Run("daemonset canary", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.DaemonSet = &v1alpha1.DaemonSetCanaryStrategy{Enabled: true}
+		allErrs := ValidateCanaryAbortPolicy(ro, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidAbortPolicyWorkloadMessage, allErrs[0].Detail)
+	})
+}
+
+func TestValidateWaitForStep(t *testing.T) {
+	waitFor := v1alpha1.WaitForStep{
+		APIVersion: "cert-manager.io/v1",
+		Kind:       "Certificate",
+		Name:       "guestbook-tls",
+		Condition:  `object.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`,
+		Timeout:    "10m",
+		OnTimeout:  v1alpha1.WaitForTimeoutPolicyPause,
+	}
+	fldPath := field.NewPath("spec", "strategy", "canary", "steps").Index(0).Child("waitFor")
+	t.Run("valid condition", func(t *testing.T) {
+		assert.Empty(t, ValidateWaitForStep(waitFor, fldPath))
+	})
+	t.Run("valid jsonPath", func(t *testing.T) {
+		waitFor := waitFor
+		waitFor.Condition = ""
+		waitFor.JSONPath = `{.status.conditions[?(@.type=="Ready")].status}`
+		waitFor.Value = "True"
+		assert.Empty(t, ValidateWaitForStep(waitFor, fldPath))
+	})
+	t.Run("missing resource", func(t *testing.T) {
+		allErrs := ValidateWaitForStep(v1alpha1.WaitForStep{Condition: "true"}, fldPath)
+		assert.Len(t, allErrs, 3)
+		assert.Equal(t, "spec.strategy.canary.steps[0].waitFor.apiVersion", allErrs[0].Field)
+	})
+	t.Run("condition and jsonPath", func(t *testing.T) {
+		waitFor := waitFor
+		waitFor.JSONPath = ".status.phase"
+		allErrs := ValidateWaitForStep(waitFor, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, InvalidWaitForConditionMessage, allErrs[0].Detail)
+	})
+	t.Run("invalid condition", func(t *testing.T) {
+		waitFor := waitFor
+		waitFor.Condition = "object.status =="
+		allErrs := ValidateWaitForStep(waitFor, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.strategy.canary.steps[0].waitFor.condition", allErrs[0].Field)
+	})
+	t.Run("invalid timeout and policy", func(t *testing.T) {
+		waitFor := waitFor
+		waitFor.Timeout = "ten minutes"
+		waitFor.OnTimeout = "Retry"
+		allErrs := ValidateWaitForStep(waitFor, fldPath)
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, "spec.strategy.canary.steps[0].waitFor.timeout", allErrs[0].Field)
+		assert.Equal(t, "spec.strategy.canary.steps[0].waitFor.onTimeout", allErrs[1].Field)
+	})
+	t.Run("multiple step types", func(t *testing.T) {
+		step := v1alpha1.CanaryStep{WaitFor: &waitFor, SetWeight: pointer.Int32(10)}
+		assert.Len(t, hasMultipleStepsType(step, field.NewPath("step")), 1)
+	})
+}
+
 func TestCanaryExperimentStepWithWeight(t *testing.T) {
 	canaryStrategy := &v1alpha1.CanaryStrategy{
 		CanaryService: "canary",
@@ -1106,3 +1728,106 @@ func TestCanaryExperimentStepWithWeight(t *testing.T) {
 		assert.Equal(t, 0, len(allErrs))
 	})
 }
+
+func TestValidateAnalysisTemplateSpec(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	webMetric := func(name, url string) v1alpha1.Metric {
+		return v1alpha1.Metric{Name: name, Provider: v1alpha1.MetricProvider{Web: &v1alpha1.WebMetric{URL: url}}}
+	}
+	t.Run("valid", func(t *testing.T) {
+		spec := v1alpha1.AnalysisTemplateSpec{Metrics: []v1alpha1.Metric{webMetric("success-rate", "http://metrics")}}
+		assert.Empty(t, ValidateAnalysisTemplateSpec(spec, fldPath))
+	})
+	t.Run("no metrics", func(t *testing.T) {
+		allErrs := ValidateAnalysisTemplateSpec(v1alpha1.AnalysisTemplateSpec{}, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.metrics", allErrs[0].Field)
+	})
+	t.Run("templates only", func(t *testing.T) {
+		spec := v1alpha1.AnalysisTemplateSpec{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "other"}}}
+		assert.Empty(t, ValidateAnalysisTemplateSpec(spec, fldPath))
+	})
+	t.Run("duplicate metrics and invalid args", func(t *testing.T) {
+		spec := v1alpha1.AnalysisTemplateSpec{
+			Args:    []v1alpha1.Argument{{Name: "host", Value: pointer.String("a"), ValueFrom: &v1alpha1.ValueFrom{FieldRef: &v1alpha1.FieldRef{FieldPath: "metadata.name"}}}},
+			Metrics: []v1alpha1.Metric{webMetric("success-rate", "http://metrics"), webMetric("success-rate", "http://metrics")},
+		}
+		allErrs := ValidateAnalysisTemplateSpec(spec, fldPath)
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, "spec.args[0]", allErrs[0].Field)
+		assert.Equal(t, field.ErrorTypeDuplicate, allErrs[1].Type)
+	})
+	t.Run("invalid metric", func(t *testing.T) {
+		metric := webMetric("success-rate", "http://metrics")
+		count, failureLimit := intstr.FromInt(1), intstr.FromInt(2)
+		metric.Count, metric.FailureLimit = &count, &failureLimit
+		metric.Provider = v1alpha1.MetricProvider{}
+		allErrs := ValidateAnalysisTemplateSpec(v1alpha1.AnalysisTemplateSpec{Metrics: []v1alpha1.Metric{metric}}, fldPath)
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, "spec.metrics[0]", allErrs[0].Field)
+	})
+	t.Run("metric with args is validated once resolved", func(t *testing.T) {
+		metric := webMetric("success-rate", "http://metrics")
+		count := intstr.FromString("{{args.count}}")
+		metric.Count = &count
+		metric.Provider = v1alpha1.MetricProvider{}
+		assert.Empty(t, ValidateAnalysisTemplateSpec(v1alpha1.AnalysisTemplateSpec{Metrics: []v1alpha1.Metric{metric}}, fldPath))
+	})
+}
+
+func TestValidateExperiment(t *testing.T) {
+	ex := &v1alpha1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: "ex"},
+		Spec: v1alpha1.ExperimentSpec{
+			Templates: []v1alpha1.TemplateSpec{{
+				Name:     "canary",
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "canary"}},
+				Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "canary"}}},
+			}},
+			Analyses: []v1alpha1.ExperimentAnalysisTemplateRef{{Name: "analysis", TemplateName: "success-rate"}},
+		},
+	}
+	assert.Empty(t, ValidateExperiment(ex))
+
+	ex.Spec.Analyses[0].TemplateName = ""
+	allErrs := ValidateExperiment(ex)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, "spec.analyses[0].templateName", allErrs[0].Field)
+
+	ex.Spec.Templates[0].Selector = nil
+	allErrs = ValidateExperiment(ex)
+	assert.Len(t, allErrs, 2)
+	assert.Equal(t, "spec.templates", allErrs[0].Field)
+}
+
+func TestValidateRolloutStrategyTemplateSpec(t *testing.T) {
+	t.Run("services are set by the Rollouts", func(t *testing.T) {
+		spec := v1alpha1.RolloutStrategyTemplateSpec{Strategy: v1alpha1.RolloutStrategy{
+			Canary: &v1alpha1.CanaryStrategy{
+				TrafficRouting: &v1alpha1.RolloutTrafficRouting{SMI: &v1alpha1.SMITrafficRouting{}},
+				Steps:          []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}, {Pause: &v1alpha1.RolloutPause{}}},
+			},
+		}}
+		assert.Empty(t, ValidateRolloutStrategyTemplateSpec(spec, field.NewPath("spec")))
+		spec.Strategy = v1alpha1.RolloutStrategy{BlueGreen: &v1alpha1.BlueGreenStrategy{ScaleDownDelayRevisionLimit: pointer.Int32(20)}}
+		assert.Empty(t, ValidateRolloutStrategyTemplateSpec(spec, field.NewPath("spec")))
+	})
+	t.Run("steps and traffic routing are validated", func(t *testing.T) {
+		spec := v1alpha1.RolloutStrategyTemplateSpec{Strategy: v1alpha1.RolloutStrategy{
+			Canary: &v1alpha1.CanaryStrategy{
+				TrafficRouting: &v1alpha1.RolloutTrafficRouting{SMI: &v1alpha1.SMITrafficRouting{}, MaxTrafficWeight: pointer.Int32(1000)},
+				Steps:          []v1alpha1.CanaryStep{{}},
+			},
+		}}
+		allErrs := ValidateRolloutStrategyTemplateSpec(spec, field.NewPath("spec"))
+		assert.Len(t, allErrs, 2)
+		assert.Equal(t, "spec.strategy.trafficRouting.maxTrafficWeight", allErrs[0].Field)
+		assert.Equal(t, "spec.strategy.steps[0]", allErrs[1].Field)
+		assert.Equal(t, InvalidStepMessage, allErrs[1].Detail)
+	})
+	t.Run("missing strategy", func(t *testing.T) {
+		allErrs := ValidateRolloutStrategyTemplateSpec(v1alpha1.RolloutStrategyTemplateSpec{}, field.NewPath("spec"))
+		assert.Len(t, allErrs, 1)
+		assert.Equal(t, fmt.Sprintf(MissingFieldMessage, ".spec.strategy.canary or .spec.strategy.blueGreen"), allErrs[0].Detail)
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"net/http"
 
 	argoprojv1alpha1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
+	argoprojv1beta1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -31,12 +32,14 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	ArgoprojV1alpha1() argoprojv1alpha1.ArgoprojV1alpha1Interface
+	ArgoprojV1beta1() argoprojv1beta1.ArgoprojV1beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
 	argoprojV1alpha1 *argoprojv1alpha1.ArgoprojV1alpha1Client
+	argoprojV1beta1  *argoprojv1beta1.ArgoprojV1beta1Client
 }
 
 // ArgoprojV1alpha1 retrieves the ArgoprojV1alpha1Client
@@ -44,6 +47,11 @@ func (c *Clientset) ArgoprojV1alpha1() argoprojv1alpha1.ArgoprojV1alpha1Interfac
 	return c.argoprojV1alpha1
 }
 
+// ArgoprojV1beta1 retrieves the ArgoprojV1beta1Client
+func (c *Clientset) ArgoprojV1beta1() argoprojv1beta1.ArgoprojV1beta1Interface {
+	return c.argoprojV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -92,6 +100,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.argoprojV1beta1, err = argoprojv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -114,6 +126,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.argoprojV1alpha1 = argoprojv1alpha1.New(c)
+	cs.argoprojV1beta1 = argoprojv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
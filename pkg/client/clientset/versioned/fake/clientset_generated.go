@@ -22,6 +22,8 @@ import (
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	argoprojv1alpha1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	fakeargoprojv1alpha1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1/fake"
+	argoprojv1beta1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1beta1"
+	fakeargoprojv1beta1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -83,3 +85,8 @@ var (
 func (c *Clientset) ArgoprojV1alpha1() argoprojv1alpha1.ArgoprojV1alpha1Interface {
 	return &fakeargoprojv1alpha1.FakeArgoprojV1alpha1{Fake: &c.Fake}
 }
+
+// ArgoprojV1beta1 retrieves the ArgoprojV1beta1Client
+func (c *Clientset) ArgoprojV1beta1() argoprojv1beta1.ArgoprojV1beta1Interface {
+	return &fakeargoprojv1beta1.FakeArgoprojV1beta1{Fake: &c.Fake}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	scheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterRolloutPoliciesGetter has a method to return a ClusterRolloutPolicyInterface.
+// A group's client should implement this interface.
+type ClusterRolloutPoliciesGetter interface {
+	ClusterRolloutPolicies() ClusterRolloutPolicyInterface
+}
+
+// ClusterRolloutPolicyInterface has methods to work with ClusterRolloutPolicy resources.
+type ClusterRolloutPolicyInterface interface {
+	Create(ctx context.Context, clusterRolloutPolicy *v1alpha1.ClusterRolloutPolicy, opts v1.CreateOptions) (*v1alpha1.ClusterRolloutPolicy, error)
+	Update(ctx context.Context, clusterRolloutPolicy *v1alpha1.ClusterRolloutPolicy, opts v1.UpdateOptions) (*v1alpha1.ClusterRolloutPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ClusterRolloutPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ClusterRolloutPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutPolicy, err error)
+	ClusterRolloutPolicyExpansion
+}
+
+// clusterRolloutPolicies implements ClusterRolloutPolicyInterface
+type clusterRolloutPolicies struct {
+	client rest.Interface
+}
+
+// newClusterRolloutPolicies returns a ClusterRolloutPolicies
+func newClusterRolloutPolicies(c *ArgoprojV1alpha1Client) *clusterRolloutPolicies {
+	return &clusterRolloutPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterRolloutPolicy, and returns the corresponding clusterRolloutPolicy object, and an error if there is any.
+func (c *clusterRolloutPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterRolloutPolicy, err error) {
+	result = &v1alpha1.ClusterRolloutPolicy{}
+	err = c.client.Get().
+		Resource("clusterrolloutpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterRolloutPolicies that match those selectors.
+func (c *clusterRolloutPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterRolloutPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterRolloutPolicyList{}
+	err = c.client.Get().
+		Resource("clusterrolloutpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterRolloutPolicies.
+func (c *clusterRolloutPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clusterrolloutpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a clusterRolloutPolicy and creates it.  Returns the server's representation of the clusterRolloutPolicy, and an error, if there is any.
+func (c *clusterRolloutPolicies) Create(ctx context.Context, clusterRolloutPolicy *v1alpha1.ClusterRolloutPolicy, opts v1.CreateOptions) (result *v1alpha1.ClusterRolloutPolicy, err error) {
+	result = &v1alpha1.ClusterRolloutPolicy{}
+	err = c.client.Post().
+		Resource("clusterrolloutpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRolloutPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterRolloutPolicy and updates it. Returns the server's representation of the clusterRolloutPolicy, and an error, if there is any.
+func (c *clusterRolloutPolicies) Update(ctx context.Context, clusterRolloutPolicy *v1alpha1.ClusterRolloutPolicy, opts v1.UpdateOptions) (result *v1alpha1.ClusterRolloutPolicy, err error) {
+	result = &v1alpha1.ClusterRolloutPolicy{}
+	err = c.client.Put().
+		Resource("clusterrolloutpolicies").
+		Name(clusterRolloutPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRolloutPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterRolloutPolicy and deletes it. Returns an error if one occurs.
+func (c *clusterRolloutPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clusterrolloutpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterRolloutPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clusterrolloutpolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterRolloutPolicy.
+func (c *clusterRolloutPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutPolicy, err error) {
+	result = &v1alpha1.ClusterRolloutPolicy{}
+	err = c.client.Patch(pt).
+		Resource("clusterrolloutpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
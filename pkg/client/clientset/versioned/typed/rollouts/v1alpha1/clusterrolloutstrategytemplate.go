@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	scheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterRolloutStrategyTemplatesGetter has a method to return a ClusterRolloutStrategyTemplateInterface.
+// A group's client should implement this interface.
+type ClusterRolloutStrategyTemplatesGetter interface {
+	ClusterRolloutStrategyTemplates() ClusterRolloutStrategyTemplateInterface
+}
+
+// ClusterRolloutStrategyTemplateInterface has methods to work with ClusterRolloutStrategyTemplate resources.
+type ClusterRolloutStrategyTemplateInterface interface {
+	Create(ctx context.Context, clusterRolloutStrategyTemplate *v1alpha1.ClusterRolloutStrategyTemplate, opts v1.CreateOptions) (*v1alpha1.ClusterRolloutStrategyTemplate, error)
+	Update(ctx context.Context, clusterRolloutStrategyTemplate *v1alpha1.ClusterRolloutStrategyTemplate, opts v1.UpdateOptions) (*v1alpha1.ClusterRolloutStrategyTemplate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ClusterRolloutStrategyTemplate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ClusterRolloutStrategyTemplateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error)
+	ClusterRolloutStrategyTemplateExpansion
+}
+
+// clusterRolloutStrategyTemplates implements ClusterRolloutStrategyTemplateInterface
+type clusterRolloutStrategyTemplates struct {
+	client rest.Interface
+}
+
+// newClusterRolloutStrategyTemplates returns a ClusterRolloutStrategyTemplates
+func newClusterRolloutStrategyTemplates(c *ArgoprojV1alpha1Client) *clusterRolloutStrategyTemplates {
+	return &clusterRolloutStrategyTemplates{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterRolloutStrategyTemplate, and returns the corresponding clusterRolloutStrategyTemplate object, and an error if there is any.
+func (c *clusterRolloutStrategyTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error) {
+	result = &v1alpha1.ClusterRolloutStrategyTemplate{}
+	err = c.client.Get().
+		Resource("clusterrolloutstrategytemplates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterRolloutStrategyTemplates that match those selectors.
+func (c *clusterRolloutStrategyTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterRolloutStrategyTemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ClusterRolloutStrategyTemplateList{}
+	err = c.client.Get().
+		Resource("clusterrolloutstrategytemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterRolloutStrategyTemplates.
+func (c *clusterRolloutStrategyTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clusterrolloutstrategytemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a clusterRolloutStrategyTemplate and creates it.  Returns the server's representation of the clusterRolloutStrategyTemplate, and an error, if there is any.
+func (c *clusterRolloutStrategyTemplates) Create(ctx context.Context, clusterRolloutStrategyTemplate *v1alpha1.ClusterRolloutStrategyTemplate, opts v1.CreateOptions) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error) {
+	result = &v1alpha1.ClusterRolloutStrategyTemplate{}
+	err = c.client.Post().
+		Resource("clusterrolloutstrategytemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRolloutStrategyTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterRolloutStrategyTemplate and updates it. Returns the server's representation of the clusterRolloutStrategyTemplate, and an error, if there is any.
+func (c *clusterRolloutStrategyTemplates) Update(ctx context.Context, clusterRolloutStrategyTemplate *v1alpha1.ClusterRolloutStrategyTemplate, opts v1.UpdateOptions) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error) {
+	result = &v1alpha1.ClusterRolloutStrategyTemplate{}
+	err = c.client.Put().
+		Resource("clusterrolloutstrategytemplates").
+		Name(clusterRolloutStrategyTemplate.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterRolloutStrategyTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterRolloutStrategyTemplate and deletes it. Returns an error if one occurs.
+func (c *clusterRolloutStrategyTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clusterrolloutstrategytemplates").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterRolloutStrategyTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clusterrolloutstrategytemplates").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterRolloutStrategyTemplate.
+func (c *clusterRolloutStrategyTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error) {
+	result = &v1alpha1.ClusterRolloutStrategyTemplate{}
+	err = c.client.Patch(pt).
+		Resource("clusterrolloutstrategytemplates").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,121 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterRolloutPolicies implements ClusterRolloutPolicyInterface
+type FakeClusterRolloutPolicies struct {
+	Fake *FakeArgoprojV1alpha1
+}
+
+var clusterrolloutpoliciesResource = v1alpha1.SchemeGroupVersion.WithResource("clusterrolloutpolicies")
+
+var clusterrolloutpoliciesKind = v1alpha1.SchemeGroupVersion.WithKind("ClusterRolloutPolicy")
+
+// Get takes name of the clusterRolloutPolicy, and returns the corresponding clusterRolloutPolicy object, and an error if there is any.
+func (c *FakeClusterRolloutPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterRolloutPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clusterrolloutpoliciesResource, name), &v1alpha1.ClusterRolloutPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterRolloutPolicies that match those selectors.
+func (c *FakeClusterRolloutPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterRolloutPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clusterrolloutpoliciesResource, clusterrolloutpoliciesKind, opts), &v1alpha1.ClusterRolloutPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ClusterRolloutPolicyList{ListMeta: obj.(*v1alpha1.ClusterRolloutPolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ClusterRolloutPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterRolloutPolicies.
+func (c *FakeClusterRolloutPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clusterrolloutpoliciesResource, opts))
+}
+
+// Create takes the representation of a clusterRolloutPolicy and creates it.  Returns the server's representation of the clusterRolloutPolicy, and an error, if there is any.
+func (c *FakeClusterRolloutPolicies) Create(ctx context.Context, clusterRolloutPolicy *v1alpha1.ClusterRolloutPolicy, opts v1.CreateOptions) (result *v1alpha1.ClusterRolloutPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clusterrolloutpoliciesResource, clusterRolloutPolicy), &v1alpha1.ClusterRolloutPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutPolicy), err
+}
+
+// Update takes the representation of a clusterRolloutPolicy and updates it. Returns the server's representation of the clusterRolloutPolicy, and an error, if there is any.
+func (c *FakeClusterRolloutPolicies) Update(ctx context.Context, clusterRolloutPolicy *v1alpha1.ClusterRolloutPolicy, opts v1.UpdateOptions) (result *v1alpha1.ClusterRolloutPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clusterrolloutpoliciesResource, clusterRolloutPolicy), &v1alpha1.ClusterRolloutPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutPolicy), err
+}
+
+// Delete takes name of the clusterRolloutPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeClusterRolloutPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(clusterrolloutpoliciesResource, name, opts), &v1alpha1.ClusterRolloutPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterRolloutPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clusterrolloutpoliciesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ClusterRolloutPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterRolloutPolicy.
+func (c *FakeClusterRolloutPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clusterrolloutpoliciesResource, name, pt, data, subresources...), &v1alpha1.ClusterRolloutPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutPolicy), err
+}
@@ -0,0 +1,121 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeClusterRolloutStrategyTemplates implements ClusterRolloutStrategyTemplateInterface
+type FakeClusterRolloutStrategyTemplates struct {
+	Fake *FakeArgoprojV1alpha1
+}
+
+var clusterrolloutstrategytemplatesResource = v1alpha1.SchemeGroupVersion.WithResource("clusterrolloutstrategytemplates")
+
+var clusterrolloutstrategytemplatesKind = v1alpha1.SchemeGroupVersion.WithKind("ClusterRolloutStrategyTemplate")
+
+// Get takes name of the clusterRolloutStrategyTemplate, and returns the corresponding clusterRolloutStrategyTemplate object, and an error if there is any.
+func (c *FakeClusterRolloutStrategyTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(clusterrolloutstrategytemplatesResource, name), &v1alpha1.ClusterRolloutStrategyTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutStrategyTemplate), err
+}
+
+// List takes label and field selectors, and returns the list of ClusterRolloutStrategyTemplates that match those selectors.
+func (c *FakeClusterRolloutStrategyTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ClusterRolloutStrategyTemplateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(clusterrolloutstrategytemplatesResource, clusterrolloutstrategytemplatesKind, opts), &v1alpha1.ClusterRolloutStrategyTemplateList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ClusterRolloutStrategyTemplateList{ListMeta: obj.(*v1alpha1.ClusterRolloutStrategyTemplateList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ClusterRolloutStrategyTemplateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested clusterRolloutStrategyTemplates.
+func (c *FakeClusterRolloutStrategyTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(clusterrolloutstrategytemplatesResource, opts))
+}
+
+// Create takes the representation of a clusterRolloutStrategyTemplate and creates it.  Returns the server's representation of the clusterRolloutStrategyTemplate, and an error, if there is any.
+func (c *FakeClusterRolloutStrategyTemplates) Create(ctx context.Context, clusterRolloutStrategyTemplate *v1alpha1.ClusterRolloutStrategyTemplate, opts v1.CreateOptions) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(clusterrolloutstrategytemplatesResource, clusterRolloutStrategyTemplate), &v1alpha1.ClusterRolloutStrategyTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutStrategyTemplate), err
+}
+
+// Update takes the representation of a clusterRolloutStrategyTemplate and updates it. Returns the server's representation of the clusterRolloutStrategyTemplate, and an error, if there is any.
+func (c *FakeClusterRolloutStrategyTemplates) Update(ctx context.Context, clusterRolloutStrategyTemplate *v1alpha1.ClusterRolloutStrategyTemplate, opts v1.UpdateOptions) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(clusterrolloutstrategytemplatesResource, clusterRolloutStrategyTemplate), &v1alpha1.ClusterRolloutStrategyTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutStrategyTemplate), err
+}
+
+// Delete takes name of the clusterRolloutStrategyTemplate and deletes it. Returns an error if one occurs.
+func (c *FakeClusterRolloutStrategyTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(clusterrolloutstrategytemplatesResource, name, opts), &v1alpha1.ClusterRolloutStrategyTemplate{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeClusterRolloutStrategyTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(clusterrolloutstrategytemplatesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ClusterRolloutStrategyTemplateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched clusterRolloutStrategyTemplate.
+func (c *FakeClusterRolloutStrategyTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ClusterRolloutStrategyTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(clusterrolloutstrategytemplatesResource, name, pt, data, subresources...), &v1alpha1.ClusterRolloutStrategyTemplate{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ClusterRolloutStrategyTemplate), err
+}
@@ -0,0 +1,129 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRolloutPolicies implements RolloutPolicyInterface
+type FakeRolloutPolicies struct {
+	Fake *FakeArgoprojV1alpha1
+	ns   string
+}
+
+var rolloutpoliciesResource = v1alpha1.SchemeGroupVersion.WithResource("rolloutpolicies")
+
+var rolloutpoliciesKind = v1alpha1.SchemeGroupVersion.WithKind("RolloutPolicy")
+
+// Get takes name of the rolloutPolicy, and returns the corresponding rolloutPolicy object, and an error if there is any.
+func (c *FakeRolloutPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RolloutPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(rolloutpoliciesResource, c.ns, name), &v1alpha1.RolloutPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of RolloutPolicies that match those selectors.
+func (c *FakeRolloutPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RolloutPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(rolloutpoliciesResource, rolloutpoliciesKind, c.ns, opts), &v1alpha1.RolloutPolicyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.RolloutPolicyList{ListMeta: obj.(*v1alpha1.RolloutPolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.RolloutPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested rolloutPolicies.
+func (c *FakeRolloutPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(rolloutpoliciesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a rolloutPolicy and creates it.  Returns the server's representation of the rolloutPolicy, and an error, if there is any.
+func (c *FakeRolloutPolicies) Create(ctx context.Context, rolloutPolicy *v1alpha1.RolloutPolicy, opts v1.CreateOptions) (result *v1alpha1.RolloutPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(rolloutpoliciesResource, c.ns, rolloutPolicy), &v1alpha1.RolloutPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutPolicy), err
+}
+
+// Update takes the representation of a rolloutPolicy and updates it. Returns the server's representation of the rolloutPolicy, and an error, if there is any.
+func (c *FakeRolloutPolicies) Update(ctx context.Context, rolloutPolicy *v1alpha1.RolloutPolicy, opts v1.UpdateOptions) (result *v1alpha1.RolloutPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(rolloutpoliciesResource, c.ns, rolloutPolicy), &v1alpha1.RolloutPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutPolicy), err
+}
+
+// Delete takes name of the rolloutPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeRolloutPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(rolloutpoliciesResource, c.ns, name, opts), &v1alpha1.RolloutPolicy{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRolloutPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(rolloutpoliciesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.RolloutPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched rolloutPolicy.
+func (c *FakeRolloutPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RolloutPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rolloutpoliciesResource, c.ns, name, pt, data, subresources...), &v1alpha1.RolloutPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutPolicy), err
+}
@@ -40,6 +40,14 @@ func (c *FakeArgoprojV1alpha1) ClusterAnalysisTemplates() v1alpha1.ClusterAnalys
 	return &FakeClusterAnalysisTemplates{c}
 }
 
+func (c *FakeArgoprojV1alpha1) ClusterRolloutPolicies() v1alpha1.ClusterRolloutPolicyInterface {
+	return &FakeClusterRolloutPolicies{c}
+}
+
+func (c *FakeArgoprojV1alpha1) ClusterRolloutStrategyTemplates() v1alpha1.ClusterRolloutStrategyTemplateInterface {
+	return &FakeClusterRolloutStrategyTemplates{c}
+}
+
 func (c *FakeArgoprojV1alpha1) Experiments(namespace string) v1alpha1.ExperimentInterface {
 	return &FakeExperiments{c, namespace}
 }
@@ -48,6 +56,14 @@ func (c *FakeArgoprojV1alpha1) Rollouts(namespace string) v1alpha1.RolloutInterf
 	return &FakeRollouts{c, namespace}
 }
 
+func (c *FakeArgoprojV1alpha1) RolloutPolicies(namespace string) v1alpha1.RolloutPolicyInterface {
+	return &FakeRolloutPolicies{c, namespace}
+}
+
+func (c *FakeArgoprojV1alpha1) RolloutStrategyTemplates(namespace string) v1alpha1.RolloutStrategyTemplateInterface {
+	return &FakeRolloutStrategyTemplates{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeArgoprojV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,129 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRolloutStrategyTemplates implements RolloutStrategyTemplateInterface
+type FakeRolloutStrategyTemplates struct {
+	Fake *FakeArgoprojV1alpha1
+	ns   string
+}
+
+var rolloutstrategytemplatesResource = v1alpha1.SchemeGroupVersion.WithResource("rolloutstrategytemplates")
+
+var rolloutstrategytemplatesKind = v1alpha1.SchemeGroupVersion.WithKind("RolloutStrategyTemplate")
+
+// Get takes name of the rolloutStrategyTemplate, and returns the corresponding rolloutStrategyTemplate object, and an error if there is any.
+func (c *FakeRolloutStrategyTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RolloutStrategyTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(rolloutstrategytemplatesResource, c.ns, name), &v1alpha1.RolloutStrategyTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutStrategyTemplate), err
+}
+
+// List takes label and field selectors, and returns the list of RolloutStrategyTemplates that match those selectors.
+func (c *FakeRolloutStrategyTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RolloutStrategyTemplateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(rolloutstrategytemplatesResource, rolloutstrategytemplatesKind, c.ns, opts), &v1alpha1.RolloutStrategyTemplateList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.RolloutStrategyTemplateList{ListMeta: obj.(*v1alpha1.RolloutStrategyTemplateList).ListMeta}
+	for _, item := range obj.(*v1alpha1.RolloutStrategyTemplateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested rolloutStrategyTemplates.
+func (c *FakeRolloutStrategyTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(rolloutstrategytemplatesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a rolloutStrategyTemplate and creates it.  Returns the server's representation of the rolloutStrategyTemplate, and an error, if there is any.
+func (c *FakeRolloutStrategyTemplates) Create(ctx context.Context, rolloutStrategyTemplate *v1alpha1.RolloutStrategyTemplate, opts v1.CreateOptions) (result *v1alpha1.RolloutStrategyTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(rolloutstrategytemplatesResource, c.ns, rolloutStrategyTemplate), &v1alpha1.RolloutStrategyTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutStrategyTemplate), err
+}
+
+// Update takes the representation of a rolloutStrategyTemplate and updates it. Returns the server's representation of the rolloutStrategyTemplate, and an error, if there is any.
+func (c *FakeRolloutStrategyTemplates) Update(ctx context.Context, rolloutStrategyTemplate *v1alpha1.RolloutStrategyTemplate, opts v1.UpdateOptions) (result *v1alpha1.RolloutStrategyTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(rolloutstrategytemplatesResource, c.ns, rolloutStrategyTemplate), &v1alpha1.RolloutStrategyTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutStrategyTemplate), err
+}
+
+// Delete takes name of the rolloutStrategyTemplate and deletes it. Returns an error if one occurs.
+func (c *FakeRolloutStrategyTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(rolloutstrategytemplatesResource, c.ns, name, opts), &v1alpha1.RolloutStrategyTemplate{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRolloutStrategyTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(rolloutstrategytemplatesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.RolloutStrategyTemplateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched rolloutStrategyTemplate.
+func (c *FakeRolloutStrategyTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RolloutStrategyTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(rolloutstrategytemplatesResource, c.ns, name, pt, data, subresources...), &v1alpha1.RolloutStrategyTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RolloutStrategyTemplate), err
+}
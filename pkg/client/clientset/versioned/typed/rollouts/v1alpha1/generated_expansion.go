@@ -24,6 +24,14 @@ type AnalysisTemplateExpansion interface{}
 
 type ClusterAnalysisTemplateExpansion interface{}
 
+type ClusterRolloutPolicyExpansion interface{}
+
+type ClusterRolloutStrategyTemplateExpansion interface{}
+
 type ExperimentExpansion interface{}
 
 type RolloutExpansion interface{}
+
+type RolloutPolicyExpansion interface{}
+
+type RolloutStrategyTemplateExpansion interface{}
@@ -0,0 +1,178 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	scheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RolloutPoliciesGetter has a method to return a RolloutPolicyInterface.
+// A group's client should implement this interface.
+type RolloutPoliciesGetter interface {
+	RolloutPolicies(namespace string) RolloutPolicyInterface
+}
+
+// RolloutPolicyInterface has methods to work with RolloutPolicy resources.
+type RolloutPolicyInterface interface {
+	Create(ctx context.Context, rolloutPolicy *v1alpha1.RolloutPolicy, opts v1.CreateOptions) (*v1alpha1.RolloutPolicy, error)
+	Update(ctx context.Context, rolloutPolicy *v1alpha1.RolloutPolicy, opts v1.UpdateOptions) (*v1alpha1.RolloutPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.RolloutPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.RolloutPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RolloutPolicy, err error)
+	RolloutPolicyExpansion
+}
+
+// rolloutPolicies implements RolloutPolicyInterface
+type rolloutPolicies struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRolloutPolicies returns a RolloutPolicies
+func newRolloutPolicies(c *ArgoprojV1alpha1Client, namespace string) *rolloutPolicies {
+	return &rolloutPolicies{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the rolloutPolicy, and returns the corresponding rolloutPolicy object, and an error if there is any.
+func (c *rolloutPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RolloutPolicy, err error) {
+	result = &v1alpha1.RolloutPolicy{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rolloutpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RolloutPolicies that match those selectors.
+func (c *rolloutPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RolloutPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.RolloutPolicyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rolloutpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested rolloutPolicies.
+func (c *rolloutPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("rolloutpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a rolloutPolicy and creates it.  Returns the server's representation of the rolloutPolicy, and an error, if there is any.
+func (c *rolloutPolicies) Create(ctx context.Context, rolloutPolicy *v1alpha1.RolloutPolicy, opts v1.CreateOptions) (result *v1alpha1.RolloutPolicy, err error) {
+	result = &v1alpha1.RolloutPolicy{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("rolloutpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rolloutPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a rolloutPolicy and updates it. Returns the server's representation of the rolloutPolicy, and an error, if there is any.
+func (c *rolloutPolicies) Update(ctx context.Context, rolloutPolicy *v1alpha1.RolloutPolicy, opts v1.UpdateOptions) (result *v1alpha1.RolloutPolicy, err error) {
+	result = &v1alpha1.RolloutPolicy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rolloutpolicies").
+		Name(rolloutPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rolloutPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the rolloutPolicy and deletes it. Returns an error if one occurs.
+func (c *rolloutPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rolloutpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *rolloutPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rolloutpolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched rolloutPolicy.
+func (c *rolloutPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RolloutPolicy, err error) {
+	result = &v1alpha1.RolloutPolicy{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("rolloutpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -31,8 +31,12 @@ type ArgoprojV1alpha1Interface interface {
 	AnalysisRunsGetter
 	AnalysisTemplatesGetter
 	ClusterAnalysisTemplatesGetter
+	ClusterRolloutPoliciesGetter
+	ClusterRolloutStrategyTemplatesGetter
 	ExperimentsGetter
 	RolloutsGetter
+	RolloutPoliciesGetter
+	RolloutStrategyTemplatesGetter
 }
 
 // ArgoprojV1alpha1Client is used to interact with features provided by the argoproj.io group.
@@ -52,6 +56,14 @@ func (c *ArgoprojV1alpha1Client) ClusterAnalysisTemplates() ClusterAnalysisTempl
 	return newClusterAnalysisTemplates(c)
 }
 
+func (c *ArgoprojV1alpha1Client) ClusterRolloutPolicies() ClusterRolloutPolicyInterface {
+	return newClusterRolloutPolicies(c)
+}
+
+func (c *ArgoprojV1alpha1Client) ClusterRolloutStrategyTemplates() ClusterRolloutStrategyTemplateInterface {
+	return newClusterRolloutStrategyTemplates(c)
+}
+
 func (c *ArgoprojV1alpha1Client) Experiments(namespace string) ExperimentInterface {
 	return newExperiments(c, namespace)
 }
@@ -60,6 +72,14 @@ func (c *ArgoprojV1alpha1Client) Rollouts(namespace string) RolloutInterface {
 	return newRollouts(c, namespace)
 }
 
+func (c *ArgoprojV1alpha1Client) RolloutPolicies(namespace string) RolloutPolicyInterface {
+	return newRolloutPolicies(c, namespace)
+}
+
+func (c *ArgoprojV1alpha1Client) RolloutStrategyTemplates(namespace string) RolloutStrategyTemplateInterface {
+	return newRolloutStrategyTemplates(c, namespace)
+}
+
 // NewForConfig creates a new ArgoprojV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
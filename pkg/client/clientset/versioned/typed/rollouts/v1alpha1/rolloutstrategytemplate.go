@@ -0,0 +1,178 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	scheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RolloutStrategyTemplatesGetter has a method to return a RolloutStrategyTemplateInterface.
+// A group's client should implement this interface.
+type RolloutStrategyTemplatesGetter interface {
+	RolloutStrategyTemplates(namespace string) RolloutStrategyTemplateInterface
+}
+
+// RolloutStrategyTemplateInterface has methods to work with RolloutStrategyTemplate resources.
+type RolloutStrategyTemplateInterface interface {
+	Create(ctx context.Context, rolloutStrategyTemplate *v1alpha1.RolloutStrategyTemplate, opts v1.CreateOptions) (*v1alpha1.RolloutStrategyTemplate, error)
+	Update(ctx context.Context, rolloutStrategyTemplate *v1alpha1.RolloutStrategyTemplate, opts v1.UpdateOptions) (*v1alpha1.RolloutStrategyTemplate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.RolloutStrategyTemplate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.RolloutStrategyTemplateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RolloutStrategyTemplate, err error)
+	RolloutStrategyTemplateExpansion
+}
+
+// rolloutStrategyTemplates implements RolloutStrategyTemplateInterface
+type rolloutStrategyTemplates struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRolloutStrategyTemplates returns a RolloutStrategyTemplates
+func newRolloutStrategyTemplates(c *ArgoprojV1alpha1Client, namespace string) *rolloutStrategyTemplates {
+	return &rolloutStrategyTemplates{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the rolloutStrategyTemplate, and returns the corresponding rolloutStrategyTemplate object, and an error if there is any.
+func (c *rolloutStrategyTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RolloutStrategyTemplate, err error) {
+	result = &v1alpha1.RolloutStrategyTemplate{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rolloutstrategytemplates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RolloutStrategyTemplates that match those selectors.
+func (c *rolloutStrategyTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RolloutStrategyTemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.RolloutStrategyTemplateList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rolloutstrategytemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested rolloutStrategyTemplates.
+func (c *rolloutStrategyTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("rolloutstrategytemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a rolloutStrategyTemplate and creates it.  Returns the server's representation of the rolloutStrategyTemplate, and an error, if there is any.
+func (c *rolloutStrategyTemplates) Create(ctx context.Context, rolloutStrategyTemplate *v1alpha1.RolloutStrategyTemplate, opts v1.CreateOptions) (result *v1alpha1.RolloutStrategyTemplate, err error) {
+	result = &v1alpha1.RolloutStrategyTemplate{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("rolloutstrategytemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rolloutStrategyTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a rolloutStrategyTemplate and updates it. Returns the server's representation of the rolloutStrategyTemplate, and an error, if there is any.
+func (c *rolloutStrategyTemplates) Update(ctx context.Context, rolloutStrategyTemplate *v1alpha1.RolloutStrategyTemplate, opts v1.UpdateOptions) (result *v1alpha1.RolloutStrategyTemplate, err error) {
+	result = &v1alpha1.RolloutStrategyTemplate{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rolloutstrategytemplates").
+		Name(rolloutStrategyTemplate.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rolloutStrategyTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the rolloutStrategyTemplate and deletes it. Returns an error if one occurs.
+func (c *rolloutStrategyTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rolloutstrategytemplates").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *rolloutStrategyTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rolloutstrategytemplates").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched rolloutStrategyTemplate.
+func (c *rolloutStrategyTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RolloutStrategyTemplate, err error) {
+	result = &v1alpha1.RolloutStrategyTemplate{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("rolloutstrategytemplates").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,168 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
+	scheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ClusterAnalysisTemplatesGetter has a method to return a ClusterAnalysisTemplateInterface.
+// A group's client should implement this interface.
+type ClusterAnalysisTemplatesGetter interface {
+	ClusterAnalysisTemplates() ClusterAnalysisTemplateInterface
+}
+
+// ClusterAnalysisTemplateInterface has methods to work with ClusterAnalysisTemplate resources.
+type ClusterAnalysisTemplateInterface interface {
+	Create(ctx context.Context, clusterAnalysisTemplate *v1beta1.ClusterAnalysisTemplate, opts v1.CreateOptions) (*v1beta1.ClusterAnalysisTemplate, error)
+	Update(ctx context.Context, clusterAnalysisTemplate *v1beta1.ClusterAnalysisTemplate, opts v1.UpdateOptions) (*v1beta1.ClusterAnalysisTemplate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.ClusterAnalysisTemplate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.ClusterAnalysisTemplateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ClusterAnalysisTemplate, err error)
+	ClusterAnalysisTemplateExpansion
+}
+
+// clusterAnalysisTemplates implements ClusterAnalysisTemplateInterface
+type clusterAnalysisTemplates struct {
+	client rest.Interface
+}
+
+// newClusterAnalysisTemplates returns a ClusterAnalysisTemplates
+func newClusterAnalysisTemplates(c *ArgoprojV1beta1Client) *clusterAnalysisTemplates {
+	return &clusterAnalysisTemplates{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the clusterAnalysisTemplate, and returns the corresponding clusterAnalysisTemplate object, and an error if there is any.
+func (c *clusterAnalysisTemplates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ClusterAnalysisTemplate, err error) {
+	result = &v1beta1.ClusterAnalysisTemplate{}
+	err = c.client.Get().
+		Resource("clusteranalysistemplates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ClusterAnalysisTemplates that match those selectors.
+func (c *clusterAnalysisTemplates) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ClusterAnalysisTemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.ClusterAnalysisTemplateList{}
+	err = c.client.Get().
+		Resource("clusteranalysistemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested clusterAnalysisTemplates.
+func (c *clusterAnalysisTemplates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("clusteranalysistemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a clusterAnalysisTemplate and creates it.  Returns the server's representation of the clusterAnalysisTemplate, and an error, if there is any.
+func (c *clusterAnalysisTemplates) Create(ctx context.Context, clusterAnalysisTemplate *v1beta1.ClusterAnalysisTemplate, opts v1.CreateOptions) (result *v1beta1.ClusterAnalysisTemplate, err error) {
+	result = &v1beta1.ClusterAnalysisTemplate{}
+	err = c.client.Post().
+		Resource("clusteranalysistemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterAnalysisTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a clusterAnalysisTemplate and updates it. Returns the server's representation of the clusterAnalysisTemplate, and an error, if there is any.
+func (c *clusterAnalysisTemplates) Update(ctx context.Context, clusterAnalysisTemplate *v1beta1.ClusterAnalysisTemplate, opts v1.UpdateOptions) (result *v1beta1.ClusterAnalysisTemplate, err error) {
+	result = &v1beta1.ClusterAnalysisTemplate{}
+	err = c.client.Put().
+		Resource("clusteranalysistemplates").
+		Name(clusterAnalysisTemplate.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(clusterAnalysisTemplate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the clusterAnalysisTemplate and deletes it. Returns an error if one occurs.
+func (c *clusterAnalysisTemplates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("clusteranalysistemplates").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *clusterAnalysisTemplates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("clusteranalysistemplates").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched clusterAnalysisTemplate.
+func (c *clusterAnalysisTemplates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ClusterAnalysisTemplate, err error) {
+	result = &v1beta1.ClusterAnalysisTemplate{}
+	err = c.client.Patch(pt).
+		Resource("clusteranalysistemplates").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
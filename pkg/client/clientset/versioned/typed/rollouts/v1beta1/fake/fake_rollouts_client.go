@@ -0,0 +1,56 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1beta1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeArgoprojV1beta1 struct {
+	*testing.Fake
+}
+
+func (c *FakeArgoprojV1beta1) AnalysisRuns(namespace string) v1beta1.AnalysisRunInterface {
+	return &FakeAnalysisRuns{c, namespace}
+}
+
+func (c *FakeArgoprojV1beta1) AnalysisTemplates(namespace string) v1beta1.AnalysisTemplateInterface {
+	return &FakeAnalysisTemplates{c, namespace}
+}
+
+func (c *FakeArgoprojV1beta1) ClusterAnalysisTemplates() v1beta1.ClusterAnalysisTemplateInterface {
+	return &FakeClusterAnalysisTemplates{c}
+}
+
+func (c *FakeArgoprojV1beta1) Experiments(namespace string) v1beta1.ExperimentInterface {
+	return &FakeExperiments{c, namespace}
+}
+
+func (c *FakeArgoprojV1beta1) Rollouts(namespace string) v1beta1.RolloutInterface {
+	return &FakeRollouts{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeArgoprojV1beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,195 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
+	scheme "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RolloutsGetter has a method to return a RolloutInterface.
+// A group's client should implement this interface.
+type RolloutsGetter interface {
+	Rollouts(namespace string) RolloutInterface
+}
+
+// RolloutInterface has methods to work with Rollout resources.
+type RolloutInterface interface {
+	Create(ctx context.Context, rollout *v1beta1.Rollout, opts v1.CreateOptions) (*v1beta1.Rollout, error)
+	Update(ctx context.Context, rollout *v1beta1.Rollout, opts v1.UpdateOptions) (*v1beta1.Rollout, error)
+	UpdateStatus(ctx context.Context, rollout *v1beta1.Rollout, opts v1.UpdateOptions) (*v1beta1.Rollout, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.Rollout, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.RolloutList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Rollout, err error)
+	RolloutExpansion
+}
+
+// rollouts implements RolloutInterface
+type rollouts struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRollouts returns a Rollouts
+func newRollouts(c *ArgoprojV1beta1Client, namespace string) *rollouts {
+	return &rollouts{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the rollout, and returns the corresponding rollout object, and an error if there is any.
+func (c *rollouts) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Rollout, err error) {
+	result = &v1beta1.Rollout{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rollouts").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Rollouts that match those selectors.
+func (c *rollouts) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.RolloutList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.RolloutList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("rollouts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested rollouts.
+func (c *rollouts) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("rollouts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a rollout and creates it.  Returns the server's representation of the rollout, and an error, if there is any.
+func (c *rollouts) Create(ctx context.Context, rollout *v1beta1.Rollout, opts v1.CreateOptions) (result *v1beta1.Rollout, err error) {
+	result = &v1beta1.Rollout{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("rollouts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rollout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a rollout and updates it. Returns the server's representation of the rollout, and an error, if there is any.
+func (c *rollouts) Update(ctx context.Context, rollout *v1beta1.Rollout, opts v1.UpdateOptions) (result *v1beta1.Rollout, err error) {
+	result = &v1beta1.Rollout{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rollouts").
+		Name(rollout.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rollout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *rollouts) UpdateStatus(ctx context.Context, rollout *v1beta1.Rollout, opts v1.UpdateOptions) (result *v1beta1.Rollout, err error) {
+	result = &v1beta1.Rollout{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("rollouts").
+		Name(rollout.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(rollout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the rollout and deletes it. Returns an error if one occurs.
+func (c *rollouts) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rollouts").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *rollouts) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("rollouts").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched rollout.
+func (c *rollouts) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Rollout, err error) {
+	result = &v1beta1.Rollout{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("rollouts").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,127 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"net/http"
+
+	v1beta1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+type ArgoprojV1beta1Interface interface {
+	RESTClient() rest.Interface
+	AnalysisRunsGetter
+	AnalysisTemplatesGetter
+	ClusterAnalysisTemplatesGetter
+	ExperimentsGetter
+	RolloutsGetter
+}
+
+// ArgoprojV1beta1Client is used to interact with features provided by the argoproj.io group.
+type ArgoprojV1beta1Client struct {
+	restClient rest.Interface
+}
+
+func (c *ArgoprojV1beta1Client) AnalysisRuns(namespace string) AnalysisRunInterface {
+	return newAnalysisRuns(c, namespace)
+}
+
+func (c *ArgoprojV1beta1Client) AnalysisTemplates(namespace string) AnalysisTemplateInterface {
+	return newAnalysisTemplates(c, namespace)
+}
+
+func (c *ArgoprojV1beta1Client) ClusterAnalysisTemplates() ClusterAnalysisTemplateInterface {
+	return newClusterAnalysisTemplates(c)
+}
+
+func (c *ArgoprojV1beta1Client) Experiments(namespace string) ExperimentInterface {
+	return newExperiments(c, namespace)
+}
+
+func (c *ArgoprojV1beta1Client) Rollouts(namespace string) RolloutInterface {
+	return newRollouts(c, namespace)
+}
+
+// NewForConfig creates a new ArgoprojV1beta1Client for the given config.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewForConfig(c *rest.Config) (*ArgoprojV1beta1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewForConfigAndClient(&config, httpClient)
+}
+
+// NewForConfigAndClient creates a new ArgoprojV1beta1Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewForConfigAndClient(c *rest.Config, h *http.Client) (*ArgoprojV1beta1Client, error) {
+	config := *c
+	if err := setConfigDefaults(&config); err != nil {
+		return nil, err
+	}
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &ArgoprojV1beta1Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new ArgoprojV1beta1Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *ArgoprojV1beta1Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new ArgoprojV1beta1Client for the given RESTClient.
+func New(c rest.Interface) *ArgoprojV1beta1Client {
+	return &ArgoprojV1beta1Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) error {
+	gv := v1beta1.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	return nil
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *ArgoprojV1beta1Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	v1beta1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -59,10 +60,30 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1alpha1().AnalysisTemplates().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("clusteranalysistemplates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1alpha1().ClusterAnalysisTemplates().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("clusterrolloutpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1alpha1().ClusterRolloutPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("clusterrolloutstrategytemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("experiments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1alpha1().Experiments().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("rollouts"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1alpha1().Rollouts().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("rolloutpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1alpha1().RolloutPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("rolloutstrategytemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1alpha1().RolloutStrategyTemplates().Informer()}, nil
+
+		// Group=argoproj.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("analysisruns"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1beta1().AnalysisRuns().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("analysistemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1beta1().AnalysisTemplates().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("clusteranalysistemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1beta1().ClusterAnalysisTemplates().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("experiments"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1beta1().Experiments().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("rollouts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Argoproj().V1beta1().Rollouts().Informer()}, nil
 
 	}
 
@@ -30,10 +30,18 @@ type Interface interface {
 	AnalysisTemplates() AnalysisTemplateInformer
 	// ClusterAnalysisTemplates returns a ClusterAnalysisTemplateInformer.
 	ClusterAnalysisTemplates() ClusterAnalysisTemplateInformer
+	// ClusterRolloutPolicies returns a ClusterRolloutPolicyInformer.
+	ClusterRolloutPolicies() ClusterRolloutPolicyInformer
+	// ClusterRolloutStrategyTemplates returns a ClusterRolloutStrategyTemplateInformer.
+	ClusterRolloutStrategyTemplates() ClusterRolloutStrategyTemplateInformer
 	// Experiments returns a ExperimentInformer.
 	Experiments() ExperimentInformer
 	// Rollouts returns a RolloutInformer.
 	Rollouts() RolloutInformer
+	// RolloutPolicies returns a RolloutPolicyInformer.
+	RolloutPolicies() RolloutPolicyInformer
+	// RolloutStrategyTemplates returns a RolloutStrategyTemplateInformer.
+	RolloutStrategyTemplates() RolloutStrategyTemplateInformer
 }
 
 type version struct {
@@ -62,6 +70,16 @@ func (v *version) ClusterAnalysisTemplates() ClusterAnalysisTemplateInformer {
 	return &clusterAnalysisTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterRolloutPolicies returns a ClusterRolloutPolicyInformer.
+func (v *version) ClusterRolloutPolicies() ClusterRolloutPolicyInformer {
+	return &clusterRolloutPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ClusterRolloutStrategyTemplates returns a ClusterRolloutStrategyTemplateInformer.
+func (v *version) ClusterRolloutStrategyTemplates() ClusterRolloutStrategyTemplateInformer {
+	return &clusterRolloutStrategyTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Experiments returns a ExperimentInformer.
 func (v *version) Experiments() ExperimentInformer {
 	return &experimentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -71,3 +89,13 @@ func (v *version) Experiments() ExperimentInformer {
 func (v *version) Rollouts() RolloutInformer {
 	return &rolloutInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// RolloutPolicies returns a RolloutPolicyInformer.
+func (v *version) RolloutPolicies() RolloutPolicyInformer {
+	return &rolloutPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RolloutStrategyTemplates returns a RolloutStrategyTemplateInformer.
+func (v *version) RolloutStrategyTemplates() RolloutStrategyTemplateInformer {
+	return &rolloutStrategyTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	rolloutsv1beta1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
+	versioned "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AnalysisRunInformer provides access to a shared informer and lister for
+// AnalysisRuns.
+type AnalysisRunInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.AnalysisRunLister
+}
+
+type analysisRunInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewAnalysisRunInformer constructs a new informer for AnalysisRun type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewAnalysisRunInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredAnalysisRunInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredAnalysisRunInformer constructs a new informer for AnalysisRun type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredAnalysisRunInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1beta1().AnalysisRuns(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1beta1().AnalysisRuns(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&rolloutsv1beta1.AnalysisRun{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *analysisRunInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredAnalysisRunInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *analysisRunInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&rolloutsv1beta1.AnalysisRun{}, f.defaultInformer)
+}
+
+func (f *analysisRunInformer) Lister() v1beta1.AnalysisRunLister {
+	return v1beta1.NewAnalysisRunLister(f.Informer().GetIndexer())
+}
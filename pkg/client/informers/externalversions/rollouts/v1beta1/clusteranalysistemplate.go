@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	rolloutsv1beta1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
+	versioned "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ClusterAnalysisTemplateInformer provides access to a shared informer and lister for
+// ClusterAnalysisTemplates.
+type ClusterAnalysisTemplateInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.ClusterAnalysisTemplateLister
+}
+
+type clusterAnalysisTemplateInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewClusterAnalysisTemplateInformer constructs a new informer for ClusterAnalysisTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewClusterAnalysisTemplateInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredClusterAnalysisTemplateInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredClusterAnalysisTemplateInformer constructs a new informer for ClusterAnalysisTemplate type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredClusterAnalysisTemplateInformer(client versioned.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1beta1().ClusterAnalysisTemplates().List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.ArgoprojV1beta1().ClusterAnalysisTemplates().Watch(context.TODO(), options)
+			},
+		},
+		&rolloutsv1beta1.ClusterAnalysisTemplate{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *clusterAnalysisTemplateInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredClusterAnalysisTemplateInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *clusterAnalysisTemplateInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&rolloutsv1beta1.ClusterAnalysisTemplate{}, f.defaultInformer)
+}
+
+func (f *clusterAnalysisTemplateInformer) Lister() v1beta1.ClusterAnalysisTemplateLister {
+	return v1beta1.NewClusterAnalysisTemplateLister(f.Informer().GetIndexer())
+}
@@ -38,6 +38,14 @@ type AnalysisTemplateNamespaceListerExpansion interface{}
 // ClusterAnalysisTemplateLister.
 type ClusterAnalysisTemplateListerExpansion interface{}
 
+// ClusterRolloutPolicyListerExpansion allows custom methods to be added to
+// ClusterRolloutPolicyLister.
+type ClusterRolloutPolicyListerExpansion interface{}
+
+// ClusterRolloutStrategyTemplateListerExpansion allows custom methods to be added to
+// ClusterRolloutStrategyTemplateLister.
+type ClusterRolloutStrategyTemplateListerExpansion interface{}
+
 // ExperimentListerExpansion allows custom methods to be added to
 // ExperimentLister.
 type ExperimentListerExpansion interface{}
@@ -53,3 +61,19 @@ type RolloutListerExpansion interface{}
 // RolloutNamespaceListerExpansion allows custom methods to be added to
 // RolloutNamespaceLister.
 type RolloutNamespaceListerExpansion interface{}
+
+// RolloutPolicyListerExpansion allows custom methods to be added to
+// RolloutPolicyLister.
+type RolloutPolicyListerExpansion interface{}
+
+// RolloutPolicyNamespaceListerExpansion allows custom methods to be added to
+// RolloutPolicyNamespaceLister.
+type RolloutPolicyNamespaceListerExpansion interface{}
+
+// RolloutStrategyTemplateListerExpansion allows custom methods to be added to
+// RolloutStrategyTemplateLister.
+type RolloutStrategyTemplateListerExpansion interface{}
+
+// RolloutStrategyTemplateNamespaceListerExpansion allows custom methods to be added to
+// RolloutStrategyTemplateNamespaceLister.
+type RolloutStrategyTemplateNamespaceListerExpansion interface{}
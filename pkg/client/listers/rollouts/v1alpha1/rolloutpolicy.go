@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RolloutPolicyLister helps list RolloutPolicies.
+// All objects returned here must be treated as read-only.
+type RolloutPolicyLister interface {
+	// List lists all RolloutPolicies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RolloutPolicy, err error)
+	// RolloutPolicies returns an object that can list and get RolloutPolicies.
+	RolloutPolicies(namespace string) RolloutPolicyNamespaceLister
+	RolloutPolicyListerExpansion
+}
+
+// rolloutPolicyLister implements the RolloutPolicyLister interface.
+type rolloutPolicyLister struct {
+	indexer cache.Indexer
+}
+
+// NewRolloutPolicyLister returns a new RolloutPolicyLister.
+func NewRolloutPolicyLister(indexer cache.Indexer) RolloutPolicyLister {
+	return &rolloutPolicyLister{indexer: indexer}
+}
+
+// List lists all RolloutPolicies in the indexer.
+func (s *rolloutPolicyLister) List(selector labels.Selector) (ret []*v1alpha1.RolloutPolicy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RolloutPolicy))
+	})
+	return ret, err
+}
+
+// RolloutPolicies returns an object that can list and get RolloutPolicies.
+func (s *rolloutPolicyLister) RolloutPolicies(namespace string) RolloutPolicyNamespaceLister {
+	return rolloutPolicyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RolloutPolicyNamespaceLister helps list and get RolloutPolicies.
+// All objects returned here must be treated as read-only.
+type RolloutPolicyNamespaceLister interface {
+	// List lists all RolloutPolicies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RolloutPolicy, err error)
+	// Get retrieves the RolloutPolicy from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.RolloutPolicy, error)
+	RolloutPolicyNamespaceListerExpansion
+}
+
+// rolloutPolicyNamespaceLister implements the RolloutPolicyNamespaceLister
+// interface.
+type rolloutPolicyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RolloutPolicies in the indexer for a given namespace.
+func (s rolloutPolicyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.RolloutPolicy, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RolloutPolicy))
+	})
+	return ret, err
+}
+
+// Get retrieves the RolloutPolicy from the indexer for a given namespace and name.
+func (s rolloutPolicyNamespaceLister) Get(name string) (*v1alpha1.RolloutPolicy, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("rolloutpolicy"), name)
+	}
+	return obj.(*v1alpha1.RolloutPolicy), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RolloutStrategyTemplateLister helps list RolloutStrategyTemplates.
+// All objects returned here must be treated as read-only.
+type RolloutStrategyTemplateLister interface {
+	// List lists all RolloutStrategyTemplates in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RolloutStrategyTemplate, err error)
+	// RolloutStrategyTemplates returns an object that can list and get RolloutStrategyTemplates.
+	RolloutStrategyTemplates(namespace string) RolloutStrategyTemplateNamespaceLister
+	RolloutStrategyTemplateListerExpansion
+}
+
+// rolloutStrategyTemplateLister implements the RolloutStrategyTemplateLister interface.
+type rolloutStrategyTemplateLister struct {
+	indexer cache.Indexer
+}
+
+// NewRolloutStrategyTemplateLister returns a new RolloutStrategyTemplateLister.
+func NewRolloutStrategyTemplateLister(indexer cache.Indexer) RolloutStrategyTemplateLister {
+	return &rolloutStrategyTemplateLister{indexer: indexer}
+}
+
+// List lists all RolloutStrategyTemplates in the indexer.
+func (s *rolloutStrategyTemplateLister) List(selector labels.Selector) (ret []*v1alpha1.RolloutStrategyTemplate, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RolloutStrategyTemplate))
+	})
+	return ret, err
+}
+
+// RolloutStrategyTemplates returns an object that can list and get RolloutStrategyTemplates.
+func (s *rolloutStrategyTemplateLister) RolloutStrategyTemplates(namespace string) RolloutStrategyTemplateNamespaceLister {
+	return rolloutStrategyTemplateNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RolloutStrategyTemplateNamespaceLister helps list and get RolloutStrategyTemplates.
+// All objects returned here must be treated as read-only.
+type RolloutStrategyTemplateNamespaceLister interface {
+	// List lists all RolloutStrategyTemplates in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RolloutStrategyTemplate, err error)
+	// Get retrieves the RolloutStrategyTemplate from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.RolloutStrategyTemplate, error)
+	RolloutStrategyTemplateNamespaceListerExpansion
+}
+
+// rolloutStrategyTemplateNamespaceLister implements the RolloutStrategyTemplateNamespaceLister
+// interface.
+type rolloutStrategyTemplateNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RolloutStrategyTemplates in the indexer for a given namespace.
+func (s rolloutStrategyTemplateNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.RolloutStrategyTemplate, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RolloutStrategyTemplate))
+	})
+	return ret, err
+}
+
+// Get retrieves the RolloutStrategyTemplate from the indexer for a given namespace and name.
+func (s rolloutStrategyTemplateNamespaceLister) Get(name string) (*v1alpha1.RolloutStrategyTemplate, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("rolloutstrategytemplate"), name)
+	}
+	return obj.(*v1alpha1.RolloutStrategyTemplate), nil
+}
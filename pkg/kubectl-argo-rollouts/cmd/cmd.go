@@ -11,11 +11,13 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/get"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/lint"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/list"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/migrate"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/pause"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/promote"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/restart"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/retry"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/set"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/simulate"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/status"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/terminate"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/undo"
@@ -63,6 +65,8 @@ func NewCmdArgoRollouts(o *options.ArgoRolloutsOptions) *cobra.Command {
 	cmd.AddCommand(get.NewCmdGet(o))
 	cmd.AddCommand(lint.NewCmdLint(o))
 	cmd.AddCommand(list.NewCmdList(o))
+	cmd.AddCommand(migrate.NewCmdMigrateStorage(o))
+	cmd.AddCommand(simulate.NewCmdSimulate(o))
 	cmd.AddCommand(pause.NewCmdPause(o))
 	cmd.AddCommand(promote.NewCmdPromote(o))
 	cmd.AddCommand(restart.NewCmdRestart(o))
@@ -14,11 +14,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/get"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
@@ -137,6 +139,20 @@ func unmarshal(fileBytes []byte, obj any) error {
 	}
 }
 
+// unmarshalVersioned strictly unmarshals a manifest into the type of the version of its kind
+func unmarshalVersioned(fileBytes []byte, gvk schema.GroupVersionKind) error {
+	obj, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		return err
+	}
+	return unmarshal(fileBytes, obj)
+}
+
+// versionedGVR returns the resource of the version of a manifest
+func versionedGVR(gvr schema.GroupVersionResource, gvk schema.GroupVersionKind) schema.GroupVersionResource {
+	return gvr.GroupResource().WithVersion(gvk.Version)
+}
+
 func (c *CreateOptions) getNamespace(un unstructured.Unstructured) string {
 	ns := c.ArgoRolloutsOptions.Namespace()
 	if md, ok := un.Object["metadata"]; ok {
@@ -166,60 +182,55 @@ func (c *CreateOptions) createResource(path string) (runtime.Object, error) {
 	ns := c.getNamespace(un)
 	switch {
 	case gvk.Group == rollouts.Group && gvk.Kind == rollouts.ExperimentKind:
-		var exp v1alpha1.Experiment
-		err = unmarshal(fileBytes, &exp)
+		err = unmarshalVersioned(fileBytes, gvk)
 		if err != nil {
 			return nil, err
 		}
-		obj, err := c.DynamicClient.Resource(v1alpha1.ExperimentGVR).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
+		obj, err := c.DynamicClient.Resource(versionedGVR(v1alpha1.ExperimentGVR, gvk)).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
 		if err != nil {
 			return nil, err
 		}
 		fmt.Fprintf(c.Out, "%s.%s/%s created\n", rollouts.ExperimentSingular, rollouts.Group, obj.GetName())
 		return obj, nil
 	case gvk.Group == rollouts.Group && gvk.Kind == rollouts.RolloutKind:
-		var ro v1alpha1.Rollout
-		err = unmarshal(fileBytes, &ro)
+		err = unmarshalVersioned(fileBytes, gvk)
 		if err != nil {
 			return nil, err
 		}
-		obj, err := c.DynamicClient.Resource(v1alpha1.RolloutGVR).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
+		obj, err := c.DynamicClient.Resource(versionedGVR(v1alpha1.RolloutGVR, gvk)).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
 		if err != nil {
 			return nil, err
 		}
 		fmt.Fprintf(c.Out, "%s.%s/%s created\n", rollouts.RolloutSingular, rollouts.Group, obj.GetName())
 		return obj, nil
 	case gvk.Group == rollouts.Group && gvk.Kind == rollouts.AnalysisTemplateKind:
-		var template v1alpha1.AnalysisTemplate
-		err = unmarshal(fileBytes, &template)
+		err = unmarshalVersioned(fileBytes, gvk)
 		if err != nil {
 			return nil, err
 		}
-		obj, err := c.DynamicClient.Resource(v1alpha1.AnalysisTemplateGVR).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
+		obj, err := c.DynamicClient.Resource(versionedGVR(v1alpha1.AnalysisTemplateGVR, gvk)).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
 		if err != nil {
 			return nil, err
 		}
 		fmt.Fprintf(c.Out, "%s.%s/%s created\n", rollouts.AnalysisTemplateSingular, rollouts.Group, obj.GetName())
 		return obj, nil
 	case gvk.Group == rollouts.Group && gvk.Kind == rollouts.ClusterAnalysisTemplateKind:
-		var template v1alpha1.ClusterAnalysisTemplate
-		err = unmarshal(fileBytes, &template)
+		err = unmarshalVersioned(fileBytes, gvk)
 		if err != nil {
 			return nil, err
 		}
-		obj, err := c.DynamicClient.Resource(v1alpha1.ClusterAnalysisTemplateGVR).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
+		obj, err := c.DynamicClient.Resource(versionedGVR(v1alpha1.ClusterAnalysisTemplateGVR, gvk)).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
 		if err != nil {
 			return nil, err
 		}
 		fmt.Fprintf(c.Out, "%s.%s/%s created\n", rollouts.AnalysisTemplateSingular, rollouts.Group, obj.GetName())
 		return obj, nil
 	case gvk.Group == rollouts.Group && gvk.Kind == rollouts.AnalysisRunKind:
-		var run v1alpha1.AnalysisRun
-		err = unmarshal(fileBytes, &run)
+		err = unmarshalVersioned(fileBytes, gvk)
 		if err != nil {
 			return nil, err
 		}
-		obj, err := c.DynamicClient.Resource(v1alpha1.AnalysisRunGVR).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
+		obj, err := c.DynamicClient.Resource(versionedGVR(v1alpha1.AnalysisRunGVR, gvk)).Namespace(ns).Create(ctx, &un, metav1.CreateOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -163,6 +163,16 @@ func (o *GetOptions) PrintRollout(roInfo *rollout.RolloutInfo) {
 	fmt.Fprintf(o.Out, tableFormat, "  Updated:", roInfo.Updated)
 	fmt.Fprintf(o.Out, tableFormat, "  Ready:", roInfo.Ready)
 	fmt.Fprintf(o.Out, tableFormat, "  Available:", roInfo.Available)
+	if len(roInfo.Hooks) > 0 {
+		fmt.Fprint(o.Out, "Hooks:\n")
+		for _, hook := range roInfo.Hooks {
+			hookStr := fmt.Sprintf("%s %s (%s)", o.colorize(hook.Icon), hook.Status, hook.JobName)
+			if hook.Message != "" {
+				hookStr = fmt.Sprintf("%s: %s", hookStr, hook.Message)
+			}
+			fmt.Fprintf(o.Out, tableFormat, "  "+hook.Type+":", hookStr)
+		}
+	}
 
 	fmt.Fprintf(o.Out, "\n")
 	o.PrintRolloutTree(roInfo)
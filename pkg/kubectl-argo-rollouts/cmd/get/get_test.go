@@ -561,3 +561,51 @@ NAME                                                     KIND         STATUS
 `, "\n")
 	assertStdout(t, expectedOut, o.IOStreams)
 }
+
+func TestGetAbortedRolloutWithHooks(t *testing.T) {
+	rolloutObjs := testdata.NewAbortedRollout()
+	rolloutObjs.Rollouts[0].Status.Hooks = []v1alpha1.RolloutHookStatus{
+		{Type: v1alpha1.RolloutHookTypePostPromotion, PodTemplateHash: "7d84d44bb8", JobName: "rollout-background-analysis-7d84d44bb8-post-promotion", Phase: v1alpha1.RolloutHookPhaseSuccessful},
+		{Type: v1alpha1.RolloutHookTypePreRollout, PodTemplateHash: "db976bc44", JobName: "rollout-background-analysis-db976bc44-pre-rollout", Phase: v1alpha1.RolloutHookPhaseSuccessful},
+		{Type: v1alpha1.RolloutHookTypeOnAbort, PodTemplateHash: "db976bc44", JobName: "rollout-background-analysis-db976bc44-on-abort", Phase: v1alpha1.RolloutHookPhaseFailed, Message: "Job has reached the specified backoff limit"},
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--no-color"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := strings.TrimPrefix(`
+Name:            rollout-background-analysis
+Namespace:       default
+Status:          ✖ Degraded
+Message:         RolloutAborted: metric "web" assessed Failed due to failed (1) > failureLimit (0)
+Strategy:        Canary
+  Step:          0/2
+  SetWeight:     0
+  ActualWeight:  0
+Images:          argoproj/rollouts-demo:blue (stable)
+Replicas:
+  Desired:       1
+  Current:       1
+  Updated:       0
+  Ready:         1
+  Available:     1
+Hooks:
+  PreRollout:    ✔ Successful (rollout-background-analysis-db976bc44-pre-rollout)
+  OnAbort:       ✖ Failed (rollout-background-analysis-db976bc44-on-abort): Job has reached the specified backoff limit
+
+NAME                                                     KIND         STATUS        AGE  INFO
+⟳ rollout-background-analysis                            Rollout      ✖ Degraded    7d
+├──# revision:2
+│  ├──⧉ rollout-background-analysis-db976bc44            ReplicaSet   • ScaledDown  7d   canary
+│  └──α rollout-background-analysis-db976bc44-2          AnalysisRun  ✖ Failed      7d   ✖ 1
+└──# revision:1
+   └──⧉ rollout-background-analysis-7d84d44bb8           ReplicaSet   ✔ Healthy     7d   stable
+      └──□ rollout-background-analysis-7d84d44bb8-z5wps  Pod          ✔ Running     7d   ready:1/1
+`, "\n")
+	assertStdout(t, expectedOut, o.IOStreams)
+}
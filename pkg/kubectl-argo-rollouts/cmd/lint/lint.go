@@ -17,14 +17,17 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+	"github.com/argoproj/argo-rollouts/utils/policy"
 )
 
 type LintOptions struct {
 	options.ArgoRolloutsOptions
-	File string
+	File     string
+	Policies []string
 }
 
 type roAndReferences struct {
@@ -35,7 +38,10 @@ type roAndReferences struct {
 const (
 	lintExample = `
 	# Lint a rollout
-	%[1]s lint -f my-rollout.yaml`
+	%[1]s lint -f my-rollout.yaml
+
+	# Lint a rollout and evaluate the rules of the RolloutPolicies and ClusterRolloutPolicies of a file
+	%[1]s lint -f my-rollout.yaml --policy my-policies.yaml`
 )
 
 // NewCmdLint returns a new instance of a `rollouts lint` command
@@ -58,6 +64,7 @@ func NewCmdLint(o *options.ArgoRolloutsOptions) *cobra.Command {
 		},
 	}
 	cmd.Flags().StringVarP(&lintOptions.File, "filename", "f", "", "File to lint")
+	cmd.Flags().StringArrayVar(&lintOptions.Policies, "policy", nil, "File of RolloutPolicies and ClusterRolloutPolicies whose rules the Rollouts must satisfy (can be repeated)")
 	return cmd
 }
 
@@ -65,6 +72,23 @@ func unmarshal(fileBytes []byte, obj any) error {
 	return yaml.UnmarshalStrict(fileBytes, &obj, yaml.DisallowUnknownFields)
 }
 
+// unmarshalRollout unmarshals a Rollout of any version, and converts it to v1alpha1 for its validation
+func unmarshalRollout(valueBytes []byte, version string) (*v1alpha1.Rollout, error) {
+	var ro v1alpha1.Rollout
+	if version != v1beta1.SchemeGroupVersion.Version {
+		err := unmarshal(valueBytes, &ro)
+		return &ro, err
+	}
+	var betaRollout v1beta1.Rollout
+	if err := unmarshal(valueBytes, &betaRollout); err != nil {
+		return nil, err
+	}
+	if err := v1beta1.Convert_v1beta1_Rollout_To_v1alpha1_Rollout(&betaRollout, &ro, nil); err != nil {
+		return nil, err
+	}
+	return &ro, nil
+}
+
 func (l *LintOptions) lintResource(path string) error {
 	fileBytes, err := os.ReadFile(path)
 	if err != nil {
@@ -98,12 +122,11 @@ func (l *LintOptions) lintResource(path string) error {
 
 		gvk := un.GroupVersionKind()
 		if gvk.Group == rollouts.Group && gvk.Kind == rollouts.RolloutKind {
-			var ro v1alpha1.Rollout
-			err := unmarshal(valueBytes, &ro)
+			ro, err := unmarshalRollout(valueBytes, gvk.Version)
 			if err != nil {
 				return err
 			}
-			fileRollouts = append(fileRollouts, ro)
+			fileRollouts = append(fileRollouts, *ro)
 		}
 		err = buildAllReferencedResources(un, &refResource)
 		if err != nil {
@@ -128,9 +151,108 @@ func (l *LintOptions) lintResource(path string) error {
 	}
 	if len(errList) > 0 {
 		return errList[0]
-	} else {
-		return nil
 	}
+	return l.evaluatePolicies(fileRollouts)
+}
+
+// evaluatePolicies evaluates the rules of the policies of the policy files against the Rollouts. The violations of
+// the rules enforced with Warn are printed as warnings, and the violations of the rules enforced with Deny fail the
+// lint as they would block the new revisions of the Rollouts.
+func (l *LintOptions) evaluatePolicies(fileRollouts []v1alpha1.Rollout) error {
+	var rps []*v1alpha1.RolloutPolicy
+	var crps []*v1alpha1.ClusterRolloutPolicy
+	for _, path := range l.Policies {
+		filePolicies, fileClusterPolicies, err := readPolicies(path)
+		if err != nil {
+			return err
+		}
+		rps = append(rps, filePolicies...)
+		crps = append(crps, fileClusterPolicies...)
+	}
+
+	var denied []error
+	for i := range fileRollouts {
+		ro := &fileRollouts[i]
+		// the RolloutPolicies only apply to the Rollouts of their namespace, unless either namespace is unset
+		var namespacePolicies []*v1alpha1.RolloutPolicy
+		for _, rp := range rps {
+			if rp.Namespace == "" || ro.Namespace == "" || rp.Namespace == ro.Namespace {
+				namespacePolicies = append(namespacePolicies, rp)
+			}
+		}
+		policies := append(policy.FromRolloutPolicies(namespacePolicies), policy.FromClusterRolloutPolicies(crps)...)
+		violations, err := policy.Evaluate(ro, policies)
+		if err != nil {
+			return err
+		}
+		for _, v := range violations {
+			if v.EnforcementAction != v1alpha1.PolicyEnforcementActionDeny {
+				fmt.Fprintf(l.ErrOut, "Warning: Rollout %q violates %s\n", ro.Name, v)
+				continue
+			}
+			denied = append(denied, fmt.Errorf("Rollout %q violates %s", ro.Name, v))
+		}
+	}
+
+	for _, err := range denied {
+		fmt.Println(err)
+	}
+	if len(denied) > 0 {
+		return denied[0]
+	}
+	return nil
+}
+
+// readPolicies reads the RolloutPolicies and ClusterRolloutPolicies of a file, ignoring the other objects
+func readPolicies(path string) ([]*v1alpha1.RolloutPolicy, []*v1alpha1.ClusterRolloutPolicy, error) {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rps []*v1alpha1.RolloutPolicy
+	var crps []*v1alpha1.ClusterRolloutPolicy
+	decoder := goyaml.NewDecoder(bytes.NewReader(fileBytes))
+	for {
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			if err != io.EOF {
+				return nil, nil, err
+			}
+			break
+		}
+		if value == nil {
+			continue
+		}
+		valueBytes, err := goyaml.Marshal(value)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var un unstructured.Unstructured
+		if err = yaml.UnmarshalStrict(valueBytes, &un, yaml.DisallowUnknownFields); err != nil {
+			return nil, nil, err
+		}
+		gvk := un.GroupVersionKind()
+		if gvk.Group != rollouts.Group {
+			continue
+		}
+		switch gvk.Kind {
+		case rollouts.RolloutPolicyKind:
+			var rp v1alpha1.RolloutPolicy
+			if err := unmarshal(valueBytes, &rp); err != nil {
+				return nil, nil, err
+			}
+			rps = append(rps, &rp)
+		case rollouts.ClusterRolloutPolicyKind:
+			var crp v1alpha1.ClusterRolloutPolicy
+			if err := unmarshal(valueBytes, &crp); err != nil {
+				return nil, nil, err
+			}
+			crps = append(crps, &crp)
+		}
+	}
+	return rps, crps, nil
 }
 
 // buildAllReferencedResources This builds a ReferencedResources object that has all the external resources for every
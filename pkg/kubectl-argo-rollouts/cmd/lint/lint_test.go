@@ -86,7 +86,7 @@ func TestLintInvalidRollout(t *testing.T) {
 		},
 		{
 			filename: "testdata/invalid-nginx-canary.yml",
-			errmsg:   "Error: spec.strategy.steps[1].experiment.templates[0].weight: Invalid value: 20: Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio and Plugins at this time\n",
+			errmsg:   "Error: spec.strategy.steps[1].experiment.templates[0].weight: Invalid value: 20: Experiment template weight is only available for TrafficRouting with SMI, ALB, Istio, Kong and Plugins at this time\n",
 		},
 	}
 
@@ -112,3 +112,48 @@ func TestLintInvalidRollout(t *testing.T) {
 		runCmd(t.filename, t.errmsg)
 	}
 }
+
+func TestLintPolicies(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+
+	cmd := NewCmdLint(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "testdata/valid.yml", "--policy", "testdata/policies.yml"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, `Rollout "valid-rollout" violates ClusterRolloutPolicy/production rule 'analysis-before-50': an analysis step is required before 50% of the traffic`)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Equal(t, `Warning: Rollout "valid-rollout" violates RolloutPolicy/analysis-templates rule 'allowed-templates': plan.analysisTemplates.all(t, t.templateName in ["smoke", "latency"])
+Error: Rollout "valid-rollout" violates ClusterRolloutPolicy/production rule 'analysis-before-50': an analysis step is required before 50% of the traffic
+`, stderr)
+}
+
+func TestLintPoliciesSatisfied(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+
+	cmd := NewCmdLint(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "testdata/valid.yml", "--policy", "testdata/policies-satisfied.yml"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestLintPoliciesMissingFile(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+
+	cmd := NewCmdLint(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "testdata/valid.yml", "--policy", "testdata/missing.yml"})
+	err := cmd.Execute()
+	assert.ErrorContains(t, err, "testdata/missing.yml")
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	argoprojv1alpha1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 )
 
 const (
@@ -95,13 +96,25 @@ func (o *ListOptions) PrintRolloutTable(roList *v1alpha1.RolloutList) error {
 	}
 	fmt.Fprint(w, headerStr)
 	for _, ro := range roList.Items {
-		roLine := newRolloutInfo(ro)
+		roLine, err := o.rolloutLine(ro)
+		if err != nil {
+			return err
+		}
 		fmt.Fprintln(w, roLine.String(o.timestamps, o.allNamespaces))
 	}
 	_ = w.Flush()
 	return nil
 }
 
+// rolloutLine returns the columns of a rollout, with the strategy pinned for its StrategyRef read from the cluster
+func (o *ListOptions) rolloutLine(ro v1alpha1.Rollout) (rolloutInfo, error) {
+	strategyRevision, err := rolloututil.GetStrategyRevision(context.TODO(), o.KubeClientset(), &ro)
+	if err != nil {
+		return rolloutInfo{}, err
+	}
+	return newRolloutInfo(ro, strategyRevision), nil
+}
+
 // PrintRolloutUpdates watches for changes to rollouts and prints the updates
 func (o *ListOptions) PrintRolloutUpdates(ctx context.Context, rolloutIf argoprojv1alpha1.RolloutInterface, roList *v1alpha1.RolloutList) error {
 	w := tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
@@ -120,7 +133,10 @@ func (o *ListOptions) PrintRolloutUpdates(ctx context.Context, rolloutIf argopro
 	// when they have have changed in a meaningful way
 	prevLines := make(map[infoKey]rolloutInfo)
 	for _, ro := range roList.Items {
-		roLine := newRolloutInfo(ro)
+		roLine, err := o.rolloutLine(ro)
+		if err != nil {
+			return err
+		}
 		prevLines[roLine.key()] = roLine
 	}
 
@@ -156,7 +172,10 @@ L:
 			continue
 		}
 		opts.ResourceVersion = ro.ObjectMeta.ResourceVersion
-		roLine := newRolloutInfo(*ro)
+		roLine, err := o.rolloutLine(*ro)
+		if err != nil {
+			return err
+		}
 		if prevLine, ok := prevLines[roLine.key()]; !ok || prevLine != roLine {
 			fmt.Fprintln(w, roLine.String(o.timestamps, o.allNamespaces))
 			prevLines[roLine.key()] = roLine
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
+
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
@@ -39,7 +41,10 @@ type infoKey struct {
 	n  string
 }
 
-func newRolloutInfo(ro v1alpha1.Rollout) rolloutInfo {
+// newRolloutInfo returns the columns of a rollout. The strategy of a rollout with a StrategyRef is read from the
+// ControllerRevision holding the strategy pinned for its current revision.
+func newRolloutInfo(ro v1alpha1.Rollout, strategyRevision *appsv1.ControllerRevision) rolloutInfo {
+	rolloututil.SetStrategyFromRevisions(&ro, []*appsv1.ControllerRevision{strategyRevision})
 	ri := rolloutInfo{}
 	ri.name = ro.Name
 	ri.namespace = ro.Namespace
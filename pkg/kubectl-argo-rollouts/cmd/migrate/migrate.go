@@ -0,0 +1,201 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+)
+
+const (
+	migrateStorageExample = `
+	# Rewrite all the Rollouts, Experiments, AnalysisTemplates, ClusterAnalysisTemplates and AnalysisRuns
+	# in the storage version of their CRD
+	%[1]s migrate-storage
+
+	# Only migrate the Rollouts
+	%[1]s migrate-storage --resource rollouts`
+
+	listPageSize = 500
+)
+
+// crdGVR is the resource of the CustomResourceDefinitions
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// resources are the plural names of the resources of the CRDs of Argo Rollouts
+var resources = []string{
+	rollouts.RolloutPlural,
+	rollouts.ExperimentPlural,
+	rollouts.AnalysisTemplatePlural,
+	rollouts.ClusterAnalysisTemplatePlural,
+	rollouts.AnalysisRunPlural,
+}
+
+type MigrateStorageOptions struct {
+	Resources []string
+
+	options.ArgoRolloutsOptions
+}
+
+// NewCmdMigrateStorage returns a new instance of an `rollouts migrate-storage` command
+func NewCmdMigrateStorage(o *options.ArgoRolloutsOptions) *cobra.Command {
+	migrateOptions := MigrateStorageOptions{
+		ArgoRolloutsOptions: *o,
+	}
+	var cmd = &cobra.Command{
+		Use:   "migrate-storage",
+		Short: "Rewrite the objects of the CRDs in their storage version",
+		Long: `Rewrite the objects of the CRDs in their storage version.
+
+After the storage version of a CRD is changed, e.g. from v1alpha1 to v1beta1, the objects written before the change
+remain stored in the previous version. This command rewrites every object of the CRDs so that they are stored in the
+current storage version, and then removes the previous versions from the stored versions of the CRDs, after which
+the previous versions can be removed from the CRDs.`,
+		Example:      o.Example(migrateStorageExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return o.UsageErr(c)
+			}
+			var errs []error
+			for _, resource := range migrateOptions.Resources {
+				if err := migrateOptions.migrate(context.TODO(), resource); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			return errors.Join(errs...)
+		},
+	}
+	cmd.Flags().StringSliceVar(&migrateOptions.Resources, "resource", resources, "Resources whose objects are migrated")
+	return cmd
+}
+
+// migrate rewrites the objects of the resource in the storage version of its CRD, and sets the stored versions of
+// the CRD to the storage version
+func (o *MigrateStorageOptions) migrate(ctx context.Context, resource string) error {
+	crdClient := o.DynamicClientset().Resource(crdGVR)
+	crdName := resource + "." + rollouts.Group
+	crd, err := crdClient.Get(ctx, crdName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	version, err := storageVersion(crd)
+	if err != nil {
+		return err
+	}
+	storedVersions, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	if len(storedVersions) == 1 && storedVersions[0] == version {
+		fmt.Fprintf(o.Out, "%s is stored in %s\n", crdName, version)
+		return nil
+	}
+
+	gvr := schema.GroupVersionResource{Group: rollouts.Group, Version: version, Resource: resource}
+	migrated, failed, err := o.rewriteAll(ctx, o.DynamicClientset().Resource(gvr))
+	if err != nil {
+		return fmt.Errorf("failed to migrate %s: %w", crdName, err)
+	}
+	if failed > 0 {
+		// the previous versions remain stored until every object is rewritten
+		return fmt.Errorf("failed to migrate %s: %d objects could not be rewritten (%d were)", crdName, failed, migrated)
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		crd, err := crdClient.Get(ctx, crdName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedStringSlice(crd.Object, []string{version}, "status", "storedVersions"); err != nil {
+			return err
+		}
+		_, err = crdClient.UpdateStatus(ctx, crd, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update the stored versions of %s: %w", crdName, err)
+	}
+	fmt.Fprintf(o.Out, "%s migrated to %s (%d objects)\n", crdName, version, migrated)
+	return nil
+}
+
+// rewriteAll updates every object of the resource without changes, which makes the API server store them in the
+// storage version. An object which cannot be rewritten is reported and skipped, so that the others are still migrated.
+// It returns the number of rewritten objects and of objects which could not be rewritten.
+func (o *MigrateStorageOptions) rewriteAll(ctx context.Context, client dynamic.NamespaceableResourceInterface) (int, int, error) {
+	migrated, failed := 0, 0
+	opts := metav1.ListOptions{Limit: listPageSize}
+	for {
+		list, err := client.List(ctx, opts)
+		if err != nil {
+			return migrated, failed, err
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if err := rewrite(ctx, client, obj); err != nil {
+				fmt.Fprintf(o.ErrOut, "failed to rewrite %s %s: %v\n", obj.GetKind(), objectName(obj), err)
+				failed++
+				continue
+			}
+			migrated++
+		}
+		if list.GetContinue() == "" {
+			return migrated, failed, nil
+		}
+		opts.Continue = list.GetContinue()
+	}
+}
+
+func objectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() != "" {
+		return obj.GetNamespace() + "/" + obj.GetName()
+	}
+	return obj.GetName()
+}
+
+// rewrite updates an object without changes, getting it again when it was modified since it was listed
+func rewrite(ctx context.Context, client dynamic.NamespaceableResourceInterface, obj *unstructured.Unstructured) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := client.Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{})
+		if k8serrors.IsConflict(err) {
+			latest, getErr := client.Namespace(obj.GetNamespace()).Get(ctx, obj.GetName(), metav1.GetOptions{})
+			if getErr != nil {
+				err = getErr
+			} else {
+				obj = latest
+			}
+		}
+		if k8serrors.IsNotFound(err) {
+			// deleted since it was listed
+			return nil
+		}
+		return err
+	})
+}
+
+// storageVersion returns the storage version of a CRD
+func storageVersion(crd *unstructured.Unstructured) (string, error) {
+	versions, _, err := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	if err != nil {
+		return "", err
+	}
+	for _, v := range versions {
+		version, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+		if storage, _, _ := unstructured.NestedBool(version, "storage"); storage {
+			name, _, _ := unstructured.NestedString(version, "name")
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("CRD %s has no storage version", crd.GetName())
+}
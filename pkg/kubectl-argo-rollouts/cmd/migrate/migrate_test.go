@@ -0,0 +1,156 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+func newCRD(name string, storedVersions ...string) *unstructured.Unstructured {
+	var stored []any
+	for _, v := range storedVersions {
+		stored = append(stored, v)
+	}
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]any{"name": name},
+		"spec": map[string]any{
+			"versions": []any{
+				map[string]any{"name": "v1alpha1", "served": true, "storage": false},
+				map[string]any{"name": "v1beta1", "served": true, "storage": true},
+			},
+		},
+		"status": map[string]any{"storedVersions": stored},
+	}}
+}
+
+func newRollout(namespace, name string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "argoproj.io/v1beta1",
+		"kind":       "Rollout",
+		"metadata":   map[string]any{"name": name, "namespace": namespace},
+	}}
+}
+
+func newDynamicClient(objs ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		crdGVR:             "CustomResourceDefinitionList",
+		v1beta1.RolloutGVR: "RolloutList",
+	}, objs...)
+}
+
+func TestMigrateStorage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	client := newDynamicClient(
+		newCRD("rollouts.argoproj.io", "v1alpha1", "v1beta1"),
+		newRollout("default", "guestbook"),
+		newRollout("other", "guestbook"),
+	)
+	o.DynamicClient = client
+	cmd := NewCmdMigrateStorage(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--resource", "rollouts"})
+	require.NoError(t, cmd.Execute())
+
+	var updated []string
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "update" && action.GetResource() == v1beta1.RolloutGVR {
+			updated = append(updated, action.GetNamespace())
+		}
+	}
+	assert.ElementsMatch(t, []string{"default", "other"}, updated)
+
+	crd, err := client.Resource(crdGVR).Get(context.TODO(), "rollouts.argoproj.io", metav1.GetOptions{})
+	require.NoError(t, err)
+	storedVersions, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	assert.Equal(t, []string{"v1beta1"}, storedVersions)
+	assert.Equal(t, "rollouts.argoproj.io migrated to v1beta1 (2 objects)\n", o.Out.(*bytes.Buffer).String())
+}
+
+func TestMigrateStorageSkipsFailedObjects(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	client := newDynamicClient(
+		newCRD("rollouts.argoproj.io", "v1alpha1", "v1beta1"),
+		newRollout("default", "guestbook"),
+		newRollout("other", "guestbook"),
+	)
+	client.PrependReactor("update", "rollouts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "default" {
+			return true, nil, errors.New("invalid")
+		}
+		return false, nil, nil
+	})
+	o.DynamicClient = client
+	cmd := NewCmdMigrateStorage(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--resource", "rollouts"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "failed to migrate rollouts.argoproj.io: 1 objects could not be rewritten (1 were)")
+	assert.Contains(t, o.ErrOut.(*bytes.Buffer).String(), "failed to rewrite Rollout default/guestbook: invalid\n")
+
+	var updated []string
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "update" && action.GetResource() == v1beta1.RolloutGVR {
+			updated = append(updated, action.GetNamespace())
+		}
+	}
+	assert.ElementsMatch(t, []string{"default", "other"}, updated)
+
+	// the previous version remains stored
+	crd, err := client.Resource(crdGVR).Get(context.TODO(), "rollouts.argoproj.io", metav1.GetOptions{})
+	require.NoError(t, err)
+	storedVersions, _, _ := unstructured.NestedStringSlice(crd.Object, "status", "storedVersions")
+	assert.Equal(t, []string{"v1alpha1", "v1beta1"}, storedVersions)
+}
+
+func TestMigrateStorageMigrated(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	client := newDynamicClient(newCRD("rollouts.argoproj.io", "v1beta1"), newRollout("default", "guestbook"))
+	o.DynamicClient = client
+	cmd := NewCmdMigrateStorage(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--resource", "rollouts"})
+	require.NoError(t, cmd.Execute())
+
+	for _, action := range client.Actions() {
+		assert.Equal(t, "get", action.GetVerb())
+	}
+	assert.Equal(t, "rollouts.argoproj.io is stored in v1beta1\n", o.Out.(*bytes.Buffer).String())
+}
+
+func TestMigrateStorageMissingCRD(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	o.DynamicClient = newDynamicClient()
+	cmd := NewCmdMigrateStorage(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--resource", "rollouts"})
+	assert.Error(t, cmd.Execute())
+}
+
+func TestMigrateStorageUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdMigrateStorage(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"rollouts"})
+	assert.Error(t, cmd.Execute())
+	assert.Contains(t, o.ErrOut.(*bytes.Buffer).String(), "Usage:")
+}
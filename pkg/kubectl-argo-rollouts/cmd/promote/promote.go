@@ -5,15 +5,18 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	completionutil "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/util/completion"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 )
 
 const (
@@ -67,7 +70,7 @@ func NewCmdPromote(o *options.ArgoRolloutsOptions) *cobra.Command {
 			}
 			name := args[0]
 			rolloutIf := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(o.Namespace())
-			ro, err := PromoteRollout(rolloutIf, name, skipCurrentStep, skipAllSteps, full)
+			ro, err := PromoteRollout(rolloutIf, o.KubeClientset(), name, skipCurrentStep, skipAllSteps, full)
 			if err != nil {
 				return err
 			}
@@ -92,12 +95,17 @@ func NewCmdPromote(o *options.ArgoRolloutsOptions) *cobra.Command {
 }
 
 // PromoteRollout promotes a rollout to the next step, or to end of all steps
-func PromoteRollout(rolloutIf clientset.RolloutInterface, name string, skipCurrentStep, skipAllSteps, full bool) (*v1alpha1.Rollout, error) {
+func PromoteRollout(rolloutIf clientset.RolloutInterface, kubeClient kubernetes.Interface, name string, skipCurrentStep, skipAllSteps, full bool) (*v1alpha1.Rollout, error) {
 	ctx := context.TODO()
 	ro, err := rolloutIf.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
+	strategyRevision, err := rolloututil.GetStrategyRevision(ctx, kubeClient, ro)
+	if err != nil {
+		return nil, err
+	}
+	rolloututil.SetStrategyFromRevisions(ro, []*appsv1.ControllerRevision{strategyRevision})
 	if skipCurrentStep || skipAllSteps {
 		if ro.Spec.Strategy.BlueGreen != nil {
 			return nil, fmt.Errorf(skipFlagsWithBlueGreenError)
@@ -0,0 +1,494 @@
+package simulate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	goyaml "gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	weightutil "github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+type SimulateOptions struct {
+	options.ArgoRolloutsOptions
+	File        string
+	Replicas    int32
+	HPAReplicas []int32
+}
+
+const (
+	simulateExample = `
+	# Simulate the canary steps of a rollout
+	%[1]s simulate -f my-rollout.yaml
+
+	# Simulate the canary steps with 10 replicas
+	%[1]s simulate -f my-rollout.yaml --replicas 10
+
+	# Simulate the canary steps for each replica count the HPA may scale the rollout to
+	%[1]s simulate -f my-rollout.yaml --hpa-replicas 2,5,20`
+
+	simulateUsage = `This command walks through the canary steps of a Rollout from a file, and prints the replica counts
+of the canary and stable ReplicaSets, the traffic weights and the peak number of pods of each step, as computed by the
+controller. The pods are assumed to become available as soon as they are created. The strategy of a Rollout with a
+strategyRef is read from the template of the same file, or else from the cluster.`
+
+	// maxSimulatedReconciliations is the maximum number of reconciliations simulated for a step, after which the
+	// replica counts are assumed to never converge
+	maxSimulatedReconciliations = 100
+
+	simulatedStableHash = "stable"
+	simulatedCanaryHash = "canary"
+)
+
+// NewCmdSimulate returns a new instance of a `rollouts simulate` command
+func NewCmdSimulate(o *options.ArgoRolloutsOptions) *cobra.Command {
+	simulateOptions := SimulateOptions{
+		ArgoRolloutsOptions: *o,
+	}
+	var cmd = &cobra.Command{
+		Use:          "simulate",
+		Short:        "Simulate the replica counts and traffic weights of the canary steps of a Rollout",
+		Long:         simulateUsage,
+		Example:      o.Example(simulateExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if simulateOptions.File == "" {
+				return o.UsageErr(c)
+			}
+			return simulateOptions.simulateResource(simulateOptions.File)
+		},
+	}
+	cmd.Flags().StringVarP(&simulateOptions.File, "filename", "f", "", "File of the Rollout to simulate")
+	cmd.Flags().Int32Var(&simulateOptions.Replicas, "replicas", 0, "Number of replicas, instead of spec.replicas")
+	cmd.Flags().Int32SliceVar(&simulateOptions.HPAReplicas, "hpa-replicas", nil, "Replica counts the HPA may scale the rollout to, each simulated separately")
+	return cmd
+}
+
+func (s *SimulateOptions) simulateResource(path string) error {
+	objs, err := readObjects(path)
+	if err != nil {
+		return err
+	}
+	if len(objs.rollouts) == 0 {
+		return fmt.Errorf("no Rollout found in %s", path)
+	}
+	for i := range objs.rollouts {
+		ro := &objs.rollouts[i]
+		if err := s.resolveStrategyRef(ro, objs); err != nil {
+			return fmt.Errorf("rollout %s: %w", ro.Name, err)
+		}
+		if ro.Spec.Strategy.Canary == nil {
+			return fmt.Errorf("rollout %s: only the canary strategy can be simulated", ro.Name)
+		}
+		replicaCounts := s.HPAReplicas
+		if len(replicaCounts) == 0 {
+			replicas := defaults.GetReplicasOrDefault(ro.Spec.Replicas)
+			if s.Replicas > 0 {
+				replicas = s.Replicas
+			}
+			replicaCounts = []int32{replicas}
+		}
+		for _, replicas := range replicaCounts {
+			if replicas < 0 {
+				return fmt.Errorf("invalid replica count %d", replicas)
+			}
+			result := Simulate(ro, replicas)
+			printSimulation(s.Out, ro, result)
+		}
+	}
+	return nil
+}
+
+// fileObjects are the objects of a file which are simulated, or referenced by the simulated Rollouts
+type fileObjects struct {
+	rollouts                        []v1alpha1.Rollout
+	rolloutStrategyTemplates        []v1alpha1.RolloutStrategyTemplate
+	clusterRolloutStrategyTemplates []v1alpha1.ClusterRolloutStrategyTemplate
+}
+
+// readObjects returns the Rollouts and strategy templates of a file, ignoring the other objects
+func readObjects(path string) (*fileObjects, error) {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	objs := &fileObjects{}
+	decoder := goyaml.NewDecoder(bytes.NewReader(fileBytes))
+	for {
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			if !errors.Is(err, io.EOF) {
+				return nil, err
+			}
+			break
+		}
+		if value == nil {
+			continue
+		}
+		valueBytes, err := goyaml.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		var un unstructured.Unstructured
+		if err := yaml.Unmarshal(valueBytes, &un); err != nil {
+			return nil, err
+		}
+		gvk := un.GroupVersionKind()
+		if gvk.Group != rollouts.Group {
+			continue
+		}
+		switch gvk.Kind {
+		case rollouts.RolloutKind:
+			var ro v1alpha1.Rollout
+			if err := yaml.Unmarshal(valueBytes, &ro); err != nil {
+				return nil, err
+			}
+			objs.rollouts = append(objs.rollouts, ro)
+		case rollouts.RolloutStrategyTemplateKind:
+			var template v1alpha1.RolloutStrategyTemplate
+			if err := yaml.Unmarshal(valueBytes, &template); err != nil {
+				return nil, err
+			}
+			objs.rolloutStrategyTemplates = append(objs.rolloutStrategyTemplates, template)
+		case rollouts.ClusterRolloutStrategyTemplateKind:
+			var template v1alpha1.ClusterRolloutStrategyTemplate
+			if err := yaml.Unmarshal(valueBytes, &template); err != nil {
+				return nil, err
+			}
+			objs.clusterRolloutStrategyTemplates = append(objs.clusterRolloutStrategyTemplates, template)
+		}
+	}
+	return objs, nil
+}
+
+// resolveStrategyRef sets the strategy of a Rollout with a StrategyRef to the strategy of the referenced template,
+// with the overrides of the StrategyRef. The template is read from the file, or else from the cluster.
+func (s *SimulateOptions) resolveStrategyRef(ro *v1alpha1.Rollout, objs *fileObjects) error {
+	ref := ro.Spec.StrategyRef
+	if ref == nil {
+		return nil
+	}
+	templateSpec, err := s.getStrategyTemplateSpec(ro, *ref, objs)
+	if err != nil {
+		return err
+	}
+	strategy, err := rolloututil.ApplyStrategyOverrides(templateSpec.Strategy, *ref)
+	if err != nil {
+		return err
+	}
+	ro.Spec.SetResolvedStrategy(strategy)
+	return nil
+}
+
+// getStrategyTemplateSpec returns the spec of the template referenced by a StrategyRef, from the file or else from
+// the cluster
+func (s *SimulateOptions) getStrategyTemplateSpec(ro *v1alpha1.Rollout, ref v1alpha1.StrategyRef, objs *fileObjects) (*v1alpha1.RolloutStrategyTemplateSpec, error) {
+	ctx := context.TODO()
+	if ref.ClusterScope {
+		for i := range objs.clusterRolloutStrategyTemplates {
+			if template := &objs.clusterRolloutStrategyTemplates[i]; template.Name == ref.Name {
+				return &template.Spec, nil
+			}
+		}
+		template, err := s.RolloutsClientset().ArgoprojV1alpha1().ClusterRolloutStrategyTemplates().Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &template.Spec, nil
+	}
+	namespace := ro.Namespace
+	if namespace == "" {
+		namespace = s.Namespace()
+	}
+	for i := range objs.rolloutStrategyTemplates {
+		template := &objs.rolloutStrategyTemplates[i]
+		if template.Name == ref.Name && (template.Namespace == "" || template.Namespace == namespace) {
+			return &template.Spec, nil
+		}
+	}
+	template, err := s.RolloutsClientset().ArgoprojV1alpha1().RolloutStrategyTemplates(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &template.Spec, nil
+}
+
+// SimulatedStep is the state of the canary and stable ReplicaSets once a step completed
+type SimulatedStep struct {
+	// Step is the index of the step, or nil for the rows before and after the steps
+	Step *int32
+	// Action describes the step
+	Action string
+	// Canary and Stable are the replica counts of the canary and stable ReplicaSets
+	Canary int32
+	Stable int32
+	// Weight is the traffic weight of the canary, or the share of the canary pods without traffic routing
+	Weight int32
+	// Peak is the maximum number of pods while the step is reconciled
+	Peak int32
+}
+
+// SimulationResult is the result of the simulation of the canary steps of a Rollout
+type SimulationResult struct {
+	Replicas  int32
+	MaxSurge  int32
+	MaxWeight int32
+	Steps     []SimulatedStep
+	// Peak is the maximum number of pods during the update
+	Peak int32
+	// Converged is false if the replica counts did not converge for a step
+	Converged bool
+}
+
+// simulator replays the reconciliations of the canary and stable ReplicaSets of an update, assuming that the pods
+// become available as soon as they are created
+type simulator struct {
+	rollout  *v1alpha1.Rollout
+	newRS    *appsv1.ReplicaSet
+	stableRS *appsv1.ReplicaSet
+	weights  *v1alpha1.TrafficWeights
+	peak     int32
+}
+
+func newSimulatedReplicaSet(hash string, replicas int32) *appsv1.ReplicaSet {
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: hash},
+		Spec:       appsv1.ReplicaSetSpec{Replicas: pointer.Int32(replicas)},
+		Status:     appsv1.ReplicaSetStatus{Replicas: replicas, ReadyReplicas: replicas, AvailableReplicas: replicas},
+	}
+}
+
+// Simulate walks through the canary steps of a Rollout with the given number of replicas, with the replica count
+// calculations of the controller
+func Simulate(rollout *v1alpha1.Rollout, replicas int32) SimulationResult {
+	ro := rollout.DeepCopy()
+	ro.Spec.Replicas = pointer.Int32(replicas)
+	ro.Status = v1alpha1.RolloutStatus{
+		StableRS:         simulatedStableHash,
+		CurrentPodHash:   simulatedCanaryHash,
+		CurrentStepIndex: pointer.Int32(0),
+	}
+	maxWeight := weightutil.MaxTrafficWeight(ro)
+	sim := &simulator{
+		rollout:  ro,
+		newRS:    newSimulatedReplicaSet(simulatedCanaryHash, 0),
+		stableRS: newSimulatedReplicaSet(simulatedStableHash, replicas),
+		weights: &v1alpha1.TrafficWeights{
+			Canary: v1alpha1.WeightDestination{Weight: 0},
+			Stable: v1alpha1.WeightDestination{Weight: maxWeight},
+		},
+		peak: replicas,
+	}
+	result := SimulationResult{
+		Replicas:  replicas,
+		MaxSurge:  replicasetutil.MaxSurge(ro),
+		MaxWeight: maxWeight,
+		Converged: true,
+	}
+	result.Steps = append(result.Steps, SimulatedStep{Action: "initial", Stable: replicas, Peak: replicas})
+
+	steps := ro.Spec.Strategy.Canary.Steps
+	for i := range steps {
+		index := int32(i)
+		ro.Status.CurrentStepIndex = &index
+		sim.peak = sim.total()
+		result.Converged = sim.converge() && result.Converged
+		result.Steps = append(result.Steps, sim.step(&index, describeStep(ro, index)))
+	}
+
+	// the new revision is fully promoted after the last step
+	index := int32(len(steps))
+	ro.Status.CurrentStepIndex = &index
+	sim.peak = sim.total()
+	result.Converged = sim.converge() && result.Converged
+	result.Steps = append(result.Steps, sim.step(nil, "promote"))
+
+	// the canary ReplicaSet becomes the stable one, and the previous stable ReplicaSet is scaled down
+	sim.peak = sim.total()
+	sim.scale(replicas, 0)
+	sim.weights.Canary.Weight = maxWeight
+	sim.weights.Stable.Weight = 0
+	result.Steps = append(result.Steps, sim.step(nil, "scale down previous stable"))
+
+	for _, step := range result.Steps {
+		if step.Peak > result.Peak {
+			result.Peak = step.Peak
+		}
+	}
+	return result
+}
+
+func (s *simulator) total() int32 {
+	return *s.newRS.Spec.Replicas + *s.stableRS.Spec.Replicas
+}
+
+// scale sets the replica counts of the ReplicaSets, whose pods are available at once
+func (s *simulator) scale(canary, stable int32) {
+	for rs, replicas := range map[*appsv1.ReplicaSet]int32{s.newRS: canary, s.stableRS: stable} {
+		rs.Spec.Replicas = pointer.Int32(replicas)
+		rs.Status.Replicas = replicas
+		rs.Status.ReadyReplicas = replicas
+		rs.Status.AvailableReplicas = replicas
+	}
+	if total := s.total(); total > s.peak {
+		s.peak = total
+	}
+}
+
+// converge reconciles the ReplicaSets and the traffic weights of the current step until they do not change, and
+// returns false if they never converge
+func (s *simulator) converge() bool {
+	trafficRouting := s.rollout.Spec.Strategy.Canary.TrafficRouting != nil
+	for i := 0; i < maxSimulatedReconciliations; i++ {
+		var canary, stable int32
+		if trafficRouting {
+			// the ReplicaSets are scaled for the current weights before the weights are changed
+			canary, stable = replicasetutil.CalculateReplicaCountsForTrafficRoutedCanary(s.rollout, s.weights)
+		} else {
+			canary, stable = replicasetutil.CalculateReplicaCountsForBasicCanary(s.rollout, s.newRS, s.stableRS, nil)
+		}
+		changed := canary != *s.newRS.Spec.Replicas || stable != *s.stableRS.Spec.Replicas
+		s.scale(canary, stable)
+		if trafficRouting {
+			desiredWeight := replicasetutil.GetCurrentSetWeight(s.rollout)
+			if desiredWeight != s.weights.Canary.Weight {
+				s.weights.Canary.Weight = desiredWeight
+				s.weights.Stable.Weight = weightutil.MaxTrafficWeight(s.rollout) - desiredWeight
+				changed = true
+			}
+		}
+		if !changed {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *simulator) step(index *int32, action string) SimulatedStep {
+	step := SimulatedStep{
+		Step:   index,
+		Action: action,
+		Canary: *s.newRS.Spec.Replicas,
+		Stable: *s.stableRS.Spec.Replicas,
+		Weight: s.weights.Canary.Weight,
+		Peak:   s.peak,
+	}
+	if s.rollout.Spec.Strategy.Canary.TrafficRouting == nil {
+		step.Weight = 0
+		if total := step.Canary + step.Stable; total > 0 {
+			step.Weight = step.Canary * weightutil.MaxTrafficWeight(s.rollout) / total
+		}
+	}
+	return step
+}
+
+// describeStep returns a description of a canary step
+func describeStep(ro *v1alpha1.Rollout, index int32) string {
+	step := ro.Spec.Strategy.Canary.Steps[index]
+	var desc string
+	switch {
+	case step.SetWeight != nil:
+		desc = fmt.Sprintf("setWeight: %d", *step.SetWeight)
+	case step.Pause != nil:
+		if step.Pause.Duration == nil {
+			desc = "pause (until promoted)"
+		} else {
+			desc = fmt.Sprintf("pause: %s", step.Pause.Duration.String())
+		}
+	case step.Analysis != nil:
+		desc = fmt.Sprintf("analysis: %s", analysisTemplateNames(step.Analysis.Templates))
+	case step.Experiment != nil:
+		desc = fmt.Sprintf("experiment: %d templates", len(step.Experiment.Templates))
+	case step.SetCanaryScale != nil:
+		switch {
+		case step.SetCanaryScale.Replicas != nil:
+			desc = fmt.Sprintf("setCanaryScale: %d replicas", *step.SetCanaryScale.Replicas)
+		case step.SetCanaryScale.Weight != nil:
+			desc = fmt.Sprintf("setCanaryScale: weight %d", *step.SetCanaryScale.Weight)
+		default:
+			desc = "setCanaryScale: matchTrafficWeight"
+		}
+	case step.SetHeaderRoute != nil:
+		desc = fmt.Sprintf("setHeaderRoute: %s", step.SetHeaderRoute.Name)
+	case step.SetMirrorRoute != nil:
+		desc = fmt.Sprintf("setMirrorRoute: %s", step.SetMirrorRoute.Name)
+	case step.Plugin != nil:
+		desc = fmt.Sprintf("plugin: %s", step.Plugin.Name)
+	case step.WaitFor != nil:
+		desc = fmt.Sprintf("waitFor: %s/%s", step.WaitFor.Kind, step.WaitFor.Name)
+	case step.SetCanaryNodes != nil:
+		desc = "setCanaryNodes"
+	}
+	if background := ro.Spec.Strategy.Canary.Analysis; background != nil {
+		startingStep := int32(0)
+		if background.StartingStep != nil {
+			startingStep = *background.StartingStep
+		}
+		if startingStep == index {
+			desc += fmt.Sprintf(" (background analysis: %s)", analysisTemplateNames(background.Templates))
+		}
+	}
+	return desc
+}
+
+func analysisTemplateNames(templates []v1alpha1.AnalysisTemplateRef) string {
+	names := make([]string, 0, len(templates))
+	for _, t := range templates {
+		names = append(names, t.TemplateName)
+	}
+	return strings.Join(names, ",")
+}
+
+func printSimulation(out io.Writer, ro *v1alpha1.Rollout, result SimulationResult) {
+	mode := "basic canary"
+	if ro.Spec.Strategy.Canary.TrafficRouting != nil {
+		mode = "traffic routed canary"
+		if ro.Spec.Strategy.Canary.DynamicStableScale {
+			mode += ", dynamic stable scale"
+		}
+	}
+	fmt.Fprintf(out, "Rollout: %s (%s)\n", ro.Name, mode)
+	fmt.Fprintf(out, "Replicas: %d, maxSurge: %d, maxUnavailable: %d\n\n", result.Replicas, result.MaxSurge, replicasetutil.MaxUnavailable(ro))
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "STEP\tACTION\tCANARY\tSTABLE\tWEIGHT\tPEAK\n")
+	for _, step := range result.Steps {
+		index := "-"
+		if step.Step != nil {
+			index = fmt.Sprintf("%d", *step.Step)
+		}
+		weight := fmt.Sprintf("%d%%", step.Weight)
+		if result.MaxWeight != 100 {
+			weight = fmt.Sprintf("%d/%d", step.Weight, result.MaxWeight)
+		}
+		if ro.Spec.Strategy.Canary.TrafficRouting == nil {
+			weight = "~" + weight
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%d\n", index, step.Action, step.Canary, step.Stable, weight, step.Peak)
+	}
+	_ = w.Flush()
+
+	fmt.Fprintf(out, "\nPeak pods: %d (%d replicas + %d)\n", result.Peak, result.Replicas, result.Peak-result.Replicas)
+	if !result.Converged {
+		fmt.Fprintf(out, "Warning: the replica counts did not converge for some steps\n")
+	}
+	fmt.Fprintln(out)
+}
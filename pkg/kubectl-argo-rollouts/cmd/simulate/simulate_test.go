@@ -0,0 +1,145 @@
+package simulate
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+func newSimulatedRollout(steps ...v1alpha1.CanaryStep) *v1alpha1.Rollout {
+	maxSurge := intstr.FromInt(1)
+	maxUnavailable := intstr.FromInt(0)
+	return &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: pointer.Int32(4),
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+				Steps:          steps,
+			}},
+		},
+	}
+}
+
+func TestSimulateBasicCanary(t *testing.T) {
+	ro := newSimulatedRollout(
+		v1alpha1.CanaryStep{SetWeight: pointer.Int32(25)},
+		v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{}},
+		v1alpha1.CanaryStep{SetWeight: pointer.Int32(50)},
+	)
+	result := Simulate(ro, 4)
+
+	assert.True(t, result.Converged)
+	assert.Len(t, result.Steps, 6)
+	assert.Equal(t, SimulatedStep{Action: "initial", Stable: 4, Peak: 4}, result.Steps[0])
+	assert.Equal(t, SimulatedStep{Step: pointer.Int32(0), Action: "setWeight: 25", Canary: 1, Stable: 3, Weight: 25, Peak: 5}, result.Steps[1])
+	assert.Equal(t, "pause (until promoted)", result.Steps[2].Action)
+	assert.Equal(t, int32(2), result.Steps[3].Canary)
+	assert.Equal(t, int32(2), result.Steps[3].Stable)
+	assert.Equal(t, int32(4), result.Steps[4].Canary)
+	assert.Equal(t, int32(0), result.Steps[4].Stable)
+	// maxSurge bounds the number of pods without traffic routing
+	assert.Equal(t, int32(5), result.Peak)
+	// the simulated rollout is not modified
+	assert.Equal(t, int32(4), *ro.Spec.Replicas)
+	assert.Nil(t, ro.Status.CurrentStepIndex)
+}
+
+func TestSimulateTrafficRoutedCanary(t *testing.T) {
+	ro := newSimulatedRollout(
+		v1alpha1.CanaryStep{SetWeight: pointer.Int32(25)},
+		v1alpha1.CanaryStep{SetWeight: pointer.Int32(75)},
+	)
+	ro.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"},
+	}
+	result := Simulate(ro, 4)
+
+	// the stable ReplicaSet stays fully scaled, so that the number of pods doubles at the promotion
+	assert.Equal(t, int32(1), result.Steps[1].Canary)
+	assert.Equal(t, int32(4), result.Steps[1].Stable)
+	assert.Equal(t, int32(3), result.Steps[2].Canary)
+	assert.Equal(t, int32(75), result.Steps[2].Weight)
+	assert.Equal(t, int32(8), result.Peak)
+
+	ro.Spec.Strategy.Canary.DynamicStableScale = true
+	result = Simulate(ro, 4)
+	assert.Equal(t, int32(3), result.Steps[2].Canary)
+	assert.Equal(t, int32(1), result.Steps[2].Stable)
+	// the stable ReplicaSet is scaled down after the traffic is shifted to the canary
+	assert.Equal(t, int32(6), result.Steps[2].Peak)
+}
+
+func TestSimulateCommand(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+
+	cmd := NewCmdSimulate(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "testdata/canary.yaml", "--hpa-replicas", "4,10"})
+	assert.NoError(t, cmd.Execute())
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "Rollout: guestbook (basic canary)")
+	assert.Contains(t, stdout, "Replicas: 4, maxSurge: 2, maxUnavailable: 1")
+	assert.Contains(t, stdout, "Replicas: 10, maxSurge: 2, maxUnavailable: 1")
+	assert.Contains(t, stdout, "Rollout: routed (traffic routed canary, dynamic stable scale)")
+	assert.Contains(t, stdout, "analysis: success-rate")
+	assert.Contains(t, stdout, "Peak pods: 15 (10 replicas + 5)")
+}
+
+func TestSimulateCommandStrategyRef(t *testing.T) {
+	orgCanary := &v1alpha1.ClusterRolloutStrategyTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "org-canary"},
+		Spec: v1alpha1.RolloutStrategyTemplateSpec{
+			Strategy: newSimulatedRollout(v1alpha1.CanaryStep{SetWeight: pointer.Int32(10)}).Spec.Strategy,
+		},
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(orgCanary)
+	defer tf.Cleanup()
+
+	cmd := NewCmdSimulate(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-f", "testdata/strategyref.yaml"})
+	assert.NoError(t, cmd.Execute())
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	// the weights of the template of the file are overridden by the StrategyRef
+	assert.Contains(t, stdout, "Rollout: from-file (basic canary)")
+	assert.Contains(t, stdout, "setWeight: 40")
+	assert.Contains(t, stdout, "setWeight: 80")
+	assert.NotContains(t, stdout, "setWeight: 20")
+	// the template which is not in the file is read from the cluster
+	assert.Contains(t, stdout, "Rollout: from-cluster (basic canary)")
+	assert.Contains(t, stdout, "setWeight: 10")
+}
+
+func TestSimulateCommandErrors(t *testing.T) {
+	tests := []struct {
+		args   []string
+		errmsg string
+	}{
+		{[]string{"-f", "testdata/bluegreen.yaml"}, "Error: rollout bluegreen: only the canary strategy can be simulated\n"},
+		{[]string{"-f", "testdata/missing.yaml"}, "Error: open testdata/missing.yaml: no such file or directory\n"},
+		{[]string{"-f", "testdata/strategyref.yaml"}, "Error: rollout from-cluster: clusterrolloutstrategytemplates.argoproj.io \"org-canary\" not found\n"},
+	}
+	for _, test := range tests {
+		t.Run(test.args[1], func(t *testing.T) {
+			tf, o := options.NewFakeArgoRolloutsOptions()
+			defer tf.Cleanup()
+
+			cmd := NewCmdSimulate(o)
+			cmd.PersistentPreRunE = o.PersistentPreRunE
+			cmd.SetArgs(test.args)
+			assert.Error(t, cmd.Execute())
+			assert.Equal(t, test.errmsg, o.ErrOut.(*bytes.Buffer).String())
+		})
+	}
+}
@@ -6,8 +6,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info/testdata"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -22,7 +28,7 @@ func TestAge(t *testing.T) {
 
 func TestCanaryRolloutInfo(t *testing.T) {
 	rolloutObjs := testdata.NewCanaryRollout()
-	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 	assert.Equal(t, roInfo.ObjectMeta.Name, rolloutObjs.Rollouts[0].Name)
 	assert.Len(t, Revisions(roInfo), 3)
 
@@ -43,7 +49,7 @@ func TestCanaryRolloutInfoWeights(t *testing.T) {
 
 	t.Run("TestActualWeightWithExistingWeight", func(t *testing.T) {
 		t.Run("will test that actual weight for info object is set from rollout status", func(t *testing.T) {
-			roInfo := NewRolloutInfo(rolloutObjs.Rollouts[4], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+			roInfo := NewRolloutInfo(rolloutObjs.Rollouts[4], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 			actualWeightString := roInfo.ActualWeight
 			actualWeightStringInt32, err := strconv.ParseInt(actualWeightString, 10, 32)
 			if err != nil {
@@ -56,7 +62,7 @@ func TestCanaryRolloutInfoWeights(t *testing.T) {
 	t.Run("TestActualWeightWithoutExistingWeight", func(t *testing.T) {
 		t.Run("will test that actual weight is set to SetWeight when status field does not exist", func(t *testing.T) {
 			//This test has a no canary weight object in the status field so we fall back to using SetWeight value
-			roInfo := NewRolloutInfo(rolloutObjs.Rollouts[5], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+			roInfo := NewRolloutInfo(rolloutObjs.Rollouts[5], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 			assert.Equal(t, roInfo.SetWeight, roInfo.ActualWeight)
 		})
 	})
@@ -64,7 +70,7 @@ func TestCanaryRolloutInfoWeights(t *testing.T) {
 
 func TestPingPongCanaryRolloutInfo(t *testing.T) {
 	rolloutObjs := testdata.NewCanaryRollout()
-	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[3], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[3], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 	assert.Equal(t, roInfo.ObjectMeta.Name, rolloutObjs.Rollouts[3].Name)
 	assert.Len(t, Revisions(roInfo), 3)
 
@@ -83,7 +89,7 @@ func TestPingPongCanaryRolloutInfo(t *testing.T) {
 func TestBlueGreenRolloutInfo(t *testing.T) {
 	{
 		rolloutObjs := testdata.NewBlueGreenRollout()
-		roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+		roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 		assert.Equal(t, roInfo.ObjectMeta.Name, rolloutObjs.Rollouts[0].Name)
 		assert.Len(t, Revisions(roInfo), 3)
 
@@ -110,7 +116,7 @@ func TestBlueGreenRolloutInfo(t *testing.T) {
 		inFourHours := timeutil.Now().Add(4 * time.Hour).Truncate(time.Second).UTC().Format(time.RFC3339)
 		rolloutObjs.ReplicaSets[0].Annotations[v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey] = inFourHours
 		delayedRs := rolloutObjs.ReplicaSets[0].ObjectMeta.UID
-		roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+		roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 
 		assert.Equal(t, roInfo.ReplicaSets[1].ObjectMeta.UID, delayedRs)
 		assert.Equal(t, roInfo.ReplicaSets[1].ScaleDownDeadline, inFourHours)
@@ -120,7 +126,7 @@ func TestBlueGreenRolloutInfo(t *testing.T) {
 
 func TestExperimentAnalysisRolloutInfo(t *testing.T) {
 	rolloutObjs := testdata.NewExperimentAnalysisRollout()
-	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 	assert.Equal(t, roInfo.ObjectMeta.Name, rolloutObjs.Rollouts[0].Name)
 	assert.Len(t, Revisions(roInfo), 2)
 
@@ -158,22 +164,89 @@ func TestExperimentInfo(t *testing.T) {
 
 func TestRolloutStatusInvalidSpec(t *testing.T) {
 	rolloutObjs := testdata.NewInvalidRollout()
-	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 	assert.Equal(t, "Degraded", roInfo.Status)
 	assert.Equal(t, "InvalidSpec: The Rollout \"rollout-invalid\" is invalid: spec.template.metadata.labels: Invalid value: map[string]string{\"app\":\"doesnt-match\"}: `selector` does not match template `labels`", roInfo.Message)
 }
 
 func TestRolloutAborted(t *testing.T) {
 	rolloutObjs := testdata.NewAbortedRollout()
-	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 	assert.Equal(t, "Degraded", roInfo.Status)
 	assert.Equal(t, `RolloutAborted: metric "web" assessed Failed due to failed (1) > failureLimit (0)`, roInfo.Message)
 }
 
 func TestRolloutInfoMetadata(t *testing.T) {
 	rolloutObjs := testdata.NewCanaryRollout()
-	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil)
+	roInfo := NewRolloutInfo(rolloutObjs.Rollouts[0], rolloutObjs.ReplicaSets, rolloutObjs.Pods, rolloutObjs.Experiments, rolloutObjs.AnalysisRuns, nil, nil)
 	assert.Equal(t, roInfo.ObjectMeta.Name, rolloutObjs.Rollouts[0].Name)
 	assert.Equal(t, roInfo.ObjectMeta.Annotations, rolloutObjs.Rollouts[0].Annotations)
 	assert.Equal(t, roInfo.ObjectMeta.Labels, rolloutObjs.Rollouts[0].Labels)
 }
+
+func TestStatefulSetRolloutInfo(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "ro-uid"},
+		Spec: v1alpha1.RolloutSpec{
+			WorkloadRef: &v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "web"},
+			Strategy:    v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}},
+		},
+		Status: v1alpha1.RolloutStatus{StableRS: "5d4f8c7b9", CurrentPodHash: "6b8d7f6c5"},
+	}
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "sts-uid"},
+		Spec: appsv1.StatefulSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "web", Image: "nginx:1.25"}}},
+			},
+		},
+	}
+	stsOwner := []metav1.OwnerReference{{Kind: "StatefulSet", Name: "web", UID: "sts-uid", Controller: ptr.To(true)}}
+	newRevision := func(hash string, revision int64, image string) *appsv1.ControllerRevision {
+		return &appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-" + hash, Namespace: "default", UID: types.UID(hash), OwnerReferences: stsOwner},
+			Data:       runtime.RawExtension{Raw: []byte(`{"spec":{"template":{"spec":{"containers":[{"name":"web","image":"` + image + `"}]}}}}`)},
+			Revision:   revision,
+		}
+	}
+	newPod := func(name, hash string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       "default",
+				Labels:          map[string]string{appsv1.ControllerRevisionHashLabelKey: "web-" + hash},
+				OwnerReferences: stsOwner,
+			},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+	revisions := []*appsv1.ControllerRevision{
+		newRevision("5d4f8c7b9", 1, "nginx:1.24"),
+		newRevision("6b8d7f6c5", 2, "nginx:1.25"),
+	}
+	pods := []*corev1.Pod{newPod("web-0", "5d4f8c7b9"), newPod("web-1", "5d4f8c7b9"), newPod("web-2", "6b8d7f6c5")}
+
+	roInfo := NewRolloutInfo(ro, nil, pods, nil, nil, sts, revisions)
+	assert.Len(t, roInfo.ReplicaSets, 2)
+
+	canary := roInfo.ReplicaSets[0]
+	assert.Equal(t, "web-6b8d7f6c5", canary.ObjectMeta.Name)
+	assert.Equal(t, int64(2), canary.Revision)
+	assert.True(t, canary.Canary)
+	assert.Equal(t, int32(1), canary.Replicas)
+	assert.Equal(t, "Healthy", canary.Status)
+	assert.Equal(t, []string{"nginx:1.25"}, canary.Images)
+	assert.Len(t, canary.Pods, 1)
+
+	stable := roInfo.ReplicaSets[1]
+	assert.Equal(t, "web-5d4f8c7b9", stable.ObjectMeta.Name)
+	assert.True(t, stable.Stable)
+	assert.Equal(t, int32(2), stable.Replicas)
+	assert.Equal(t, []string{"nginx:1.24"}, stable.Images)
+	assert.Len(t, stable.Pods, 2)
+
+	assert.Equal(t, []*rollout.ContainerInfo{{Name: "web", Image: "nginx:1.25"}}, roInfo.Containers)
+}
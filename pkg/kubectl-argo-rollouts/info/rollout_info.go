@@ -8,6 +8,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -23,8 +24,13 @@ func NewRolloutInfo(
 	allPods []*corev1.Pod,
 	allExperiments []*v1alpha1.Experiment,
 	allARs []*v1alpha1.AnalysisRun,
-	workloadRef *appsv1.Deployment,
+	workloadRef runtime.Object,
+	allControllerRevisions []*appsv1.ControllerRevision,
 ) *rollout.RolloutInfo {
+	if ro.Spec.StrategyRef != nil {
+		ro = ro.DeepCopy()
+		rolloututil.SetStrategyFromRevisions(ro, allControllerRevisions)
+	}
 
 	roInfo := rollout.RolloutInfo{
 		ObjectMeta: &v1.ObjectMeta{
@@ -38,7 +44,11 @@ func NewRolloutInfo(
 		},
 	}
 
-	roInfo.ReplicaSets = GetReplicaSetInfo(ro.UID, ro, allReplicaSets, allPods)
+	if sts, ok := workloadRef.(*appsv1.StatefulSet); ok {
+		roInfo.ReplicaSets = GetStatefulSetRevisionInfo(ro, sts, allControllerRevisions, allPods)
+	} else {
+		roInfo.ReplicaSets = GetReplicaSetInfo(ro.UID, ro, allReplicaSets, allPods)
+	}
 	roInfo.Experiments = getExperimentInfo(ro, allExperiments, allReplicaSets, allARs, allPods)
 	roInfo.AnalysisRuns = getAnalysisRunInfo(ro.UID, allARs)
 
@@ -86,10 +96,14 @@ func NewRolloutInfo(
 
 	var containerList []corev1.Container
 	var initContainerList []corev1.Container
-	if workloadRef != nil {
-		containerList = workloadRef.Spec.Template.Spec.Containers
-		initContainerList = workloadRef.Spec.Template.Spec.InitContainers
-	} else {
+	switch w := workloadRef.(type) {
+	case *appsv1.Deployment:
+		containerList = w.Spec.Template.Spec.Containers
+		initContainerList = w.Spec.Template.Spec.InitContainers
+	case *appsv1.StatefulSet:
+		containerList = w.Spec.Template.Spec.Containers
+		initContainerList = w.Spec.Template.Spec.InitContainers
+	default:
 		containerList = ro.Spec.Template.Spec.Containers
 		initContainerList = ro.Spec.Template.Spec.InitContainers
 	}
@@ -108,6 +122,19 @@ func NewRolloutInfo(
 		roInfo.RestartedAt = "Never"
 	}
 
+	for _, hook := range ro.Status.Hooks {
+		if hook.PodTemplateHash != ro.Status.CurrentPodHash {
+			continue
+		}
+		roInfo.Hooks = append(roInfo.Hooks, &rollout.HookInfo{
+			Type:    string(hook.Type),
+			JobName: hook.JobName,
+			Status:  string(hook.Phase),
+			Icon:    hookIcon(hook.Phase),
+			Message: hook.Message,
+		})
+	}
+
 	roInfo.Generation = ro.Status.ObservedGeneration
 
 	roInfo.Desired = defaults.GetReplicasOrDefault(ro.Spec.Replicas)
@@ -118,6 +145,18 @@ func NewRolloutInfo(
 	return &roInfo
 }
 
+func hookIcon(phase v1alpha1.RolloutHookPhase) string {
+	switch phase {
+	case v1alpha1.RolloutHookPhaseSuccessful:
+		return IconOK
+	case v1alpha1.RolloutHookPhaseFailed:
+		return IconBad
+	case v1alpha1.RolloutHookPhaseRunning:
+		return IconProgressing
+	}
+	return " "
+}
+
 func rolloutIcon(status string) string {
 	switch status {
 	case "Progressing":
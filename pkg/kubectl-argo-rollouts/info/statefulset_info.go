@@ -0,0 +1,98 @@
+package info
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// GetStatefulSetRevisionInfo returns the revisions of a StatefulSet referenced by the workloadRef of a Rollout. The
+// ControllerRevisions of the StatefulSet are presented as ReplicaSets, with the pods labeled with their revision.
+func GetStatefulSetRevisionInfo(ro *v1alpha1.Rollout, sts *appsv1.StatefulSet, allControllerRevisions []*appsv1.ControllerRevision, allPods []*corev1.Pod) []*rollout.ReplicaSetInfo {
+	var stsPods []*corev1.Pod
+	for _, pod := range allPods {
+		if ownerRef(pod.OwnerReferences, []types.UID{sts.UID}) != nil {
+			stsPods = append(stsPods, pod)
+		}
+	}
+
+	var rsInfos []*rollout.ReplicaSetInfo
+	for _, cr := range allControllerRevisions {
+		if ownerRef(cr.OwnerReferences, []types.UID{sts.UID}) == nil {
+			continue
+		}
+		rsInfo := &rollout.ReplicaSetInfo{
+			ObjectMeta: &v1.ObjectMeta{
+				Name:              cr.Name,
+				Namespace:         cr.Namespace,
+				CreationTimestamp: cr.CreationTimestamp,
+				UID:               cr.UID,
+			},
+			Revision: cr.Revision,
+		}
+		for _, pod := range stsPods {
+			if pod.Labels[appsv1.ControllerRevisionHashLabelKey] != cr.Name {
+				continue
+			}
+			podInfo := newPodInfo(pod)
+			rsInfo.Pods = append(rsInfo.Pods, &podInfo)
+			rsInfo.Replicas++
+			if podutil.IsPodAvailable(pod, sts.Spec.MinReadySeconds, timeutil.MetaNow()) {
+				rsInfo.Available++
+			}
+		}
+		rsInfo.Status = getStatefulSetRevisionHealth(rsInfo)
+		rsInfo.Icon = replicaSetIcon(rsInfo.Status)
+
+		if ro != nil && ro.Spec.Strategy.Canary != nil {
+			hash := strings.TrimPrefix(cr.Name, sts.Name+"-")
+			if ro.Status.StableRS == hash {
+				rsInfo.Stable = true
+			} else if ro.Status.CurrentPodHash == hash {
+				rsInfo.Canary = true
+			}
+		}
+
+		var revision appsv1.StatefulSet
+		if err := json.Unmarshal(cr.Data.Raw, &revision); err == nil {
+			for _, ctr := range revision.Spec.Template.Spec.Containers {
+				rsInfo.Images = append(rsInfo.Images, ctr.Image)
+			}
+			for _, ctr := range revision.Spec.Template.Spec.InitContainers {
+				rsInfo.InitContainerImages = append(rsInfo.InitContainerImages, ctr.Image)
+			}
+		}
+
+		sort.Slice(rsInfo.Pods[:], func(i, j int) bool {
+			return rsInfo.Pods[i].ObjectMeta.Name < rsInfo.Pods[j].ObjectMeta.Name
+		})
+		rsInfos = append(rsInfos, rsInfo)
+	}
+	sort.Slice(rsInfos[:], func(i, j int) bool {
+		if rsInfos[i].Revision != rsInfos[j].Revision {
+			return rsInfos[i].Revision > rsInfos[j].Revision
+		}
+		return rsInfos[i].ObjectMeta.Name < rsInfos[j].ObjectMeta.Name
+	})
+	return rsInfos
+}
+
+func getStatefulSetRevisionHealth(rsInfo *rollout.ReplicaSetInfo) string {
+	if rsInfo.Replicas == 0 {
+		return "ScaledDown"
+	}
+	if rsInfo.Available < rsInfo.Replicas {
+		return "Progressing"
+	}
+	return "Healthy"
+}
@@ -0,0 +1,87 @@
+package options
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
+	roclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/scheme"
+	argoprojv1alpha1 "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/typed/rollouts/v1alpha1"
+)
+
+// APIVersions are the versions of the Argo Rollouts API which the commands can read and write the objects with
+var APIVersions = []string{v1alpha1.SchemeGroupVersion.Version, v1beta1.SchemeGroupVersion.Version}
+
+// newV1beta1Clientset wraps a Rollouts clientset so that the Rollouts, Experiments, AnalysisTemplates,
+// ClusterAnalysisTemplates and AnalysisRuns of its v1alpha1 client are read and written through the v1beta1 API, and
+// converted from and to v1alpha1 by the client. The commands then work the same when only v1beta1 is served, and
+// the original strings of the durations are preserved by the conversion. The other kinds only have a v1alpha1 API.
+func newV1beta1Clientset(config *rest.Config, clientset roclientset.Interface) (roclientset.Interface, error) {
+	config = rest.CopyConfig(config)
+	config.GroupVersion = &v1beta1.SchemeGroupVersion
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = v1alpha1Serializer{CodecFactory: scheme.Codecs}
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+	restClient, err := rest.RESTClientFor(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the v1beta1 client: %w", err)
+	}
+	return &v1beta1Clientset{
+		Interface: clientset,
+		v1alpha1: &v1beta1Client{
+			ArgoprojV1alpha1Interface: clientset.ArgoprojV1alpha1(),
+			converting:                argoprojv1alpha1.New(restClient),
+		},
+	}, nil
+}
+
+// v1alpha1Serializer encodes the objects in the version of the client, and decodes them to v1alpha1, including the
+// objects of the watch events, which are not decoded into a typed object
+type v1alpha1Serializer struct {
+	serializer.CodecFactory
+}
+
+func (s v1alpha1Serializer) DecoderToVersion(decoder runtime.Decoder, _ runtime.GroupVersioner) runtime.Decoder {
+	return s.CodecFactory.DecoderToVersion(decoder, v1alpha1.SchemeGroupVersion)
+}
+
+type v1beta1Clientset struct {
+	roclientset.Interface
+	v1alpha1 argoprojv1alpha1.ArgoprojV1alpha1Interface
+}
+
+func (c *v1beta1Clientset) ArgoprojV1alpha1() argoprojv1alpha1.ArgoprojV1alpha1Interface {
+	return c.v1alpha1
+}
+
+type v1beta1Client struct {
+	argoprojv1alpha1.ArgoprojV1alpha1Interface
+	converting argoprojv1alpha1.ArgoprojV1alpha1Interface
+}
+
+func (c *v1beta1Client) Rollouts(namespace string) argoprojv1alpha1.RolloutInterface {
+	return c.converting.Rollouts(namespace)
+}
+
+func (c *v1beta1Client) Experiments(namespace string) argoprojv1alpha1.ExperimentInterface {
+	return c.converting.Experiments(namespace)
+}
+
+func (c *v1beta1Client) AnalysisTemplates(namespace string) argoprojv1alpha1.AnalysisTemplateInterface {
+	return c.converting.AnalysisTemplates(namespace)
+}
+
+func (c *v1beta1Client) ClusterAnalysisTemplates() argoprojv1alpha1.ClusterAnalysisTemplateInterface {
+	return c.converting.ClusterAnalysisTemplates()
+}
+
+func (c *v1beta1Client) AnalysisRuns(namespace string) argoprojv1alpha1.AnalysisRunInterface {
+	return c.converting.AnalysisRuns(namespace)
+}
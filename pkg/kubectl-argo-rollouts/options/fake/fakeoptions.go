@@ -61,6 +61,18 @@ func NewFakeArgoRolloutsOptions(obj ...runtime.Object) (*cmdtesting.TestFactory,
 				APIVersion: "argoproj.io/v1alpha1",
 			}
 			rolloutObjs = append(rolloutObjs, o)
+		case *v1alpha1.RolloutStrategyTemplate:
+			typedO.TypeMeta = metav1.TypeMeta{
+				Kind:       "RolloutStrategyTemplate",
+				APIVersion: "argoproj.io/v1alpha1",
+			}
+			rolloutObjs = append(rolloutObjs, o)
+		case *v1alpha1.ClusterRolloutStrategyTemplate:
+			typedO.TypeMeta = metav1.TypeMeta{
+				Kind:       "ClusterRolloutStrategyTemplate",
+				APIVersion: "argoproj.io/v1alpha1",
+			}
+			rolloutObjs = append(rolloutObjs, o)
 		default:
 			kubeObjs = append(kubeObjs, o)
 		}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -15,6 +16,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1beta1"
 	roclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 )
 
@@ -30,6 +33,7 @@ type ArgoRolloutsOptions struct {
 	ConfigFlags      *genericclioptions.ConfigFlags
 	KlogLevel        int
 	LogLevel         string
+	APIVersion       string
 	RolloutsClient   roclientset.Interface
 	KubeClient       kubernetes.Interface
 	DynamicClient    dynamic.Interface
@@ -54,6 +58,7 @@ func NewArgoRolloutsOptions(streams genericclioptions.IOStreams) *ArgoRolloutsOp
 		IOStreams:        streams,
 		Log:              logCtx,
 		LogLevel:         log.InfoLevel.String(),
+		APIVersion:       v1alpha1.SchemeGroupVersion.Version,
 		Now:              metav1.Now,
 	}
 }
@@ -81,6 +86,9 @@ func (o *ArgoRolloutsOptions) PersistentPreRunE(c *cobra.Command, args []string)
 		return err
 	}
 	o.Log.SetLevel(level)
+	if !slices.Contains(APIVersions, o.APIVersion) {
+		return fmt.Errorf("unsupported API version %q, must be one of %s", o.APIVersion, strings.Join(APIVersions, ", "))
+	}
 	if flag.Lookup("v") != nil {
 		// the '-v' flag is set by klog.Init(), which we only call in main.go
 		err := flag.Set("v", strconv.Itoa(o.KlogLevel))
@@ -97,6 +105,7 @@ func (o *ArgoRolloutsOptions) AddKubectlFlags(cmd *cobra.Command) {
 	o.ConfigFlags.AddFlags(flags)
 	flags.IntVarP(&o.KlogLevel, "kloglevel", "v", 0, "Log level for kubernetes client library")
 	flags.StringVar(&o.LogLevel, "loglevel", log.InfoLevel.String(), "Log level for kubectl argo rollouts")
+	flags.StringVar(&o.APIVersion, "api-version", v1alpha1.SchemeGroupVersion.Version, fmt.Sprintf("Version of the Argo Rollouts API to read and write the objects with (%s)", strings.Join(APIVersions, ", ")))
 }
 
 // RolloutsClientset returns a Rollout client interface based on client flags
@@ -108,10 +117,17 @@ func (o *ArgoRolloutsOptions) RolloutsClientset() roclientset.Interface {
 	if err != nil {
 		panic(err)
 	}
-	rolloutsClient, err := roclientset.NewForConfig(config)
+	var rolloutsClient roclientset.Interface
+	rolloutsClient, err = roclientset.NewForConfig(config)
 	if err != nil {
 		panic(err)
 	}
+	if o.APIVersion == v1beta1.SchemeGroupVersion.Version {
+		rolloutsClient, err = newV1beta1Clientset(config, rolloutsClient)
+		if err != nil {
+			panic(err)
+		}
+	}
 	o.RolloutsClient = rolloutsClient
 	return o.RolloutsClient
 }
@@ -2,13 +2,21 @@ package options_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
 
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	fakeoptions "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
 )
@@ -93,3 +101,79 @@ func TestClientsets(t *testing.T) {
 	err := cmd.Execute()
 	assert.NoError(t, err)
 }
+
+func TestInvalidAPIVersion(t *testing.T) {
+	tf, o := fakeoptions.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+
+	var cmd = &cobra.Command{
+		Use:               "foo",
+		SilenceUsage:      true,
+		PersistentPreRunE: o.PersistentPreRunE,
+		RunE: func(c *cobra.Command, args []string) error {
+			return nil
+		},
+	}
+	o.AddKubectlFlags(cmd)
+	cmd.SetArgs([]string{"--api-version", "v2"})
+	assert.EqualError(t, cmd.Execute(), `unsupported API version "v2", must be one of v1alpha1, v1beta1`)
+}
+
+func TestV1beta1Clientset(t *testing.T) {
+	const betaRollout = `{
+  "apiVersion": "argoproj.io/v1beta1",
+  "kind": "Rollout",
+  "metadata": {
+    "name": "guestbook",
+    "namespace": "default",
+    "annotations": {"argo-rollouts.argoproj.io/v1alpha1-durations": "{\"/spec/strategy/canary/steps/0/timeout\":\"1h\"}"}
+  },
+  "spec": {"strategy": {"canary": {"steps": [{"setWeight": 10, "timeout": "1h0m0s"}]}}}
+}`
+	var requests []string
+	var updated map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPut:
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			_, _ = w.Write([]byte(betaRollout))
+		case r.URL.Query().Get("watch") == "true":
+			_, _ = fmt.Fprintf(w, `{"type": "ADDED", "object": %s}`, betaRollout)
+		default:
+			_, _ = w.Write([]byte(betaRollout))
+		}
+	}))
+	defer server.Close()
+
+	iostreams, _, _, _ := genericclioptions.NewTestIOStreams()
+	o := options.NewArgoRolloutsOptions(iostreams)
+	configFlags := genericclioptions.NewConfigFlags(false)
+	configFlags.APIServer = &server.URL
+	o.RESTClientGetter = configFlags
+	o.APIVersion = "v1beta1"
+	client := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts("default")
+
+	// the objects are converted to v1alpha1, with their original durations
+	ro, err := client.Get(context.TODO(), "guestbook", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.DurationString("1h"), ro.Spec.Strategy.Canary.Steps[0].Timeout)
+	assert.Empty(t, ro.Annotations)
+
+	w, err := client.Watch(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	event := <-w.ResultChan()
+	w.Stop()
+	assert.Equal(t, v1alpha1.DurationString("1h"), event.Object.(*v1alpha1.Rollout).Spec.Strategy.Canary.Steps[0].Timeout)
+
+	// and converted to v1beta1 when they are written
+	_, err = client.Update(context.TODO(), ro, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "argoproj.io/v1beta1", updated["apiVersion"])
+	assert.Equal(t, []string{
+		"GET /apis/argoproj.io/v1beta1/namespaces/default/rollouts/guestbook",
+		"GET /apis/argoproj.io/v1beta1/namespaces/default/rollouts",
+		"PUT /apis/argoproj.io/v1beta1/namespaces/default/rollouts/guestbook",
+	}, requests)
+}
@@ -11,6 +11,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
@@ -41,6 +42,8 @@ type viewController struct {
 	experimentLister  rolloutlisters.ExperimentNamespaceLister
 	analysisRunLister rolloutlisters.AnalysisRunNamespaceLister
 	deploymentLister  appslisters.DeploymentNamespaceLister
+	statefulSetLister appslisters.StatefulSetNamespaceLister
+	revisionLister    appslisters.ControllerRevisionNamespaceLister
 
 	cacheSyncs []cache.InformerSynced
 
@@ -105,12 +108,16 @@ func newViewController(namespace string, name string, kubeClient kubernetes.Inte
 		experimentLister:        rolloutsInformerFactory.Argoproj().V1alpha1().Experiments().Lister().Experiments(namespace),
 		analysisRunLister:       rolloutsInformerFactory.Argoproj().V1alpha1().AnalysisRuns().Lister().AnalysisRuns(namespace),
 		deploymentLister:        kubeInformerFactory.Apps().V1().Deployments().Lister().Deployments(namespace),
+		statefulSetLister:       kubeInformerFactory.Apps().V1().StatefulSets().Lister().StatefulSets(namespace),
+		revisionLister:          kubeInformerFactory.Apps().V1().ControllerRevisions().Lister().ControllerRevisions(namespace),
 		workqueue:               workqueue.NewRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter()),
 	}
 
 	controller.cacheSyncs = append(controller.cacheSyncs,
 		kubeInformerFactory.Apps().V1().ReplicaSets().Informer().HasSynced,
 		kubeInformerFactory.Core().V1().Pods().Informer().HasSynced,
+		kubeInformerFactory.Apps().V1().StatefulSets().Informer().HasSynced,
+		kubeInformerFactory.Apps().V1().ControllerRevisions().Informer().HasSynced,
 		rolloutsInformerFactory.Argoproj().V1alpha1().Experiments().Informer().HasSynced,
 		rolloutsInformerFactory.Argoproj().V1alpha1().AnalysisRuns().Informer().HasSynced,
 	)
@@ -130,6 +137,7 @@ func newViewController(namespace string, name string, kubeClient kubernetes.Inte
 	// changes to any of these resources will enqueue the rollout for refreshing
 	kubeInformerFactory.Apps().V1().ReplicaSets().Informer().AddEventHandler(enqueueRolloutHandlerFuncs)
 	kubeInformerFactory.Core().V1().Pods().Informer().AddEventHandler(enqueueRolloutHandlerFuncs)
+	kubeInformerFactory.Apps().V1().ControllerRevisions().Informer().AddEventHandler(enqueueRolloutHandlerFuncs)
 	rolloutsInformerFactory.Argoproj().V1alpha1().Rollouts().Informer().AddEventHandler(enqueueRolloutHandlerFuncs)
 	rolloutsInformerFactory.Argoproj().V1alpha1().Experiments().Informer().AddEventHandler(enqueueRolloutHandlerFuncs)
 	rolloutsInformerFactory.Argoproj().V1alpha1().AnalysisRuns().Informer().AddEventHandler(enqueueRolloutHandlerFuncs)
@@ -213,15 +221,32 @@ func (c *RolloutViewController) GetRolloutInfo() (*rollout.RolloutInfo, error) {
 		return nil, err
 	}
 
-	var workloadRef *v1.Deployment
+	var workloadRef runtime.Object
+	var allRevisions []*v1.ControllerRevision
 	if ro.Spec.WorkloadRef != nil {
-		workloadRef, err = c.deploymentLister.Get(ro.Spec.WorkloadRef.Name)
+		switch ro.Spec.WorkloadRef.Kind {
+		case "StatefulSet":
+			workloadRef, err = c.statefulSetLister.Get(ro.Spec.WorkloadRef.Name)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			workloadRef, err = c.deploymentLister.Get(ro.Spec.WorkloadRef.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// the ControllerRevisions hold the revisions of a StatefulSet, and the strategy pinned for a StrategyRef
+	if ro.Spec.WorkloadRef != nil && ro.Spec.WorkloadRef.Kind == "StatefulSet" || ro.Spec.StrategyRef != nil {
+		allRevisions, err = c.revisionLister.List(labels.Everything())
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	roInfo := info.NewRolloutInfo(ro, allReplicaSets, allPods, allExps, allAnalysisRuns, workloadRef)
+	roInfo := info.NewRolloutInfo(ro, allReplicaSets, allPods, allExps, allAnalysisRuns, workloadRef, allRevisions)
 	return roInfo, nil
 }
 
@@ -0,0 +1,224 @@
+package rollout
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// defaultPreservedPodCount is the number of canary pods preserved by a preserve policy without count
+	defaultPreservedPodCount = int32(1)
+	// defaultPreservedPodTTL is how long the preserved pods are kept by a preserve policy without TTL
+	defaultPreservedPodTTL = time.Hour
+
+	// preservedForLabelKey is the label of the preserved pods, set to the name of the Rollout
+	preservedForLabelKey = annotations.RolloutLabel + "/preserved-for"
+	// preservedPodTemplateHashLabelKey is the label of the preserved pods, set to the pod template hash of the
+	// aborted revision. It replaces the pod template hash label which is selected by the ReplicaSet and the Services.
+	preservedPodTemplateHashLabelKey = annotations.RolloutLabel + "/preserved-pod-template-hash"
+	// preservedAnalysisRunAnnotationKey is the annotation of the preserved pods, set to the name of the unsuccessful
+	// AnalysisRun which aborted the update
+	preservedAnalysisRunAnnotationKey = annotations.RolloutLabel + "/preserved-analysis-run"
+	// preservedUntilAnnotationKey is the annotation of the preserved pods, set to the time at which they are deleted
+	preservedUntilAnnotationKey = annotations.RolloutLabel + "/preserved-until"
+
+	abortedPodPreservedReason = "AbortedPodPreserved"
+	abortedPodDeletedReason   = "AbortedPodDeleted"
+)
+
+// getPreservePolicy returns the preserve policy of the canary pods of the aborted updates, if any
+func getPreservePolicy(rollout *v1alpha1.Rollout) *v1alpha1.PreserveAbortedPods {
+	canary := rollout.Spec.Strategy.Canary
+	if canary == nil || canary.AbortPolicy == nil {
+		return nil
+	}
+	return canary.AbortPolicy.Preserve
+}
+
+// preservedPodCountAndTTL returns the number of canary pods preserved for an aborted revision, and how long they are kept
+func preservedPodCountAndTTL(policy *v1alpha1.PreserveAbortedPods) (int32, time.Duration) {
+	count := defaultPreservedPodCount
+	if policy.Count != nil {
+		count = *policy.Count
+	}
+	ttl := defaultPreservedPodTTL
+	if policy.TTL != "" {
+		if d, err := policy.TTL.Duration(); err == nil {
+			ttl = d
+		}
+	}
+	return count, ttl
+}
+
+// unsuccessfulAnalysisRunName returns the name of the canary AnalysisRun which aborted the update, if any
+func (c *rolloutContext) unsuccessfulAnalysisRunName() string {
+	for _, status := range []*v1alpha1.RolloutAnalysisRunStatus{
+		c.newStatus.Canary.CurrentStepAnalysisRunStatus,
+		c.newStatus.Canary.CurrentBackgroundAnalysisRunStatus,
+		c.rollout.Status.Canary.CurrentStepAnalysisRunStatus,
+		c.rollout.Status.Canary.CurrentBackgroundAnalysisRunStatus,
+	} {
+		if status == nil {
+			continue
+		}
+		switch status.Status {
+		case v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseInconclusive:
+			return status.Name
+		}
+	}
+	return ""
+}
+
+// reconcileAbortedPodPreservation preserves canary pods of an aborted update before the canary ReplicaSet is scaled
+// down, and deletes the preserved pods whose TTL expired. A pod is preserved by relabeling it out of the selectors of
+// the ReplicaSet and of the Services, which removes it from the traffic routers, and by transferring its ownership to
+// the Rollout.
+func (c *rolloutContext) reconcileAbortedPodPreservation() error {
+	policy := getPreservePolicy(c.rollout)
+	if policy == nil {
+		return nil
+	}
+	ctx := c.context()
+	count, ttl := preservedPodCountAndTTL(policy)
+
+	// the pods are listed by label rather than watched, since only the Rollouts with a preserve policy read them
+	preservedPods, err := c.kubeclientset.CoreV1().Pods(c.rollout.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{preservedForLabelKey: c.rollout.Name}).String(),
+	})
+	if err != nil {
+		return err
+	}
+	now := timeutil.Now()
+	var nextExpiry time.Duration
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	preservedForRevision := int32(0)
+	for i := range preservedPods.Items {
+		pod := &preservedPods.Items[i]
+		until, err := time.Parse(time.RFC3339, pod.Annotations[preservedUntilAnnotationKey])
+		if err == nil && until.After(now) {
+			if nextExpiry == 0 || until.Sub(now) < nextExpiry {
+				nextExpiry = until.Sub(now)
+			}
+			if pod.Labels[preservedPodTemplateHashLabelKey] == podHash {
+				preservedForRevision++
+			}
+			continue
+		}
+		err = c.kubeclientset.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: abortedPodDeletedReason, EventAction: record.ActionUpdateWorkload, RelatedObject: pod}, "Deleted preserved pod %s", pod.Name)
+	}
+	if nextExpiry > 0 {
+		c.enqueueRolloutAfter(c.rollout, nextExpiry)
+	}
+
+	if !c.pauseContext.IsAborted() || c.newRS == nil || c.rollout.Status.StableRS == "" || c.rollout.Status.StableRS == podHash {
+		return nil
+	}
+	if preservedForRevision >= count {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(c.newRS.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	pods, err := c.kubeclientset.CoreV1().Pods(c.newRS.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return err
+	}
+	candidates := []*corev1.Pod{}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if metav1.IsControlledBy(pod, c.newRS) && pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning {
+			candidates = append(candidates, pod)
+		}
+	}
+	// the ready pods are preserved first, since they ran the new version for the longest
+	sort.SliceStable(candidates, func(i, j int) bool {
+		iReady, jReady := podutil.IsPodReady(candidates[i]), podutil.IsPodReady(candidates[j])
+		if iReady != jReady {
+			return iReady
+		}
+		return candidates[i].Name < candidates[j].Name
+	})
+	analysisRunName := c.unsuccessfulAnalysisRunName()
+	preservedUntil := now.Add(ttl).UTC().Format(time.RFC3339)
+	for _, pod := range candidates {
+		if preservedForRevision >= count {
+			break
+		}
+		pod = pod.DeepCopy()
+		if err := c.preservePod(pod, podHash, analysisRunName, preservedUntil); err != nil {
+			return err
+		}
+		if _, err := c.kubeclientset.CoreV1().Pods(pod.Namespace).Update(ctx, pod, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to preserve pod %s: %w", pod.Name, err)
+		}
+		preservedForRevision++
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: abortedPodPreservedReason, EventAction: record.ActionUpdateWorkload, RelatedObject: pod}, "Preserved aborted canary pod %s until %s", pod.Name, preservedUntil)
+	}
+	c.enqueueRolloutAfter(c.rollout, ttl)
+	return nil
+}
+
+// preservePod relabels a canary pod out of the selectors of its ReplicaSet, of the Rollout and of the Services. The
+// labels of the pod template are all removed, since a Service may select the canary pods on any of them.
+func (c *rolloutContext) preservePod(pod *corev1.Pod, podHash, analysisRunName, preservedUntil string) error {
+	delete(pod.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+	for k := range c.newRS.Spec.Template.Labels {
+		delete(pod.Labels, k)
+	}
+	for _, selector := range []*metav1.LabelSelector{c.rollout.Spec.Selector, c.newRS.Spec.Selector} {
+		if selector == nil {
+			continue
+		}
+		for k := range selector.MatchLabels {
+			delete(pod.Labels, k)
+		}
+		for _, requirement := range selector.MatchExpressions {
+			delete(pod.Labels, requirement.Key)
+		}
+	}
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	// a selector made only of NotIn and DoesNotExist requirements still matches the relabeled pod
+	for _, selector := range []*metav1.LabelSelector{c.rollout.Spec.Selector, c.newRS.Spec.Selector} {
+		if selector == nil {
+			continue
+		}
+		s, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return err
+		}
+		if s.Matches(labels.Set(pod.Labels)) {
+			return fmt.Errorf("failed to preserve pod %s: the selector %s still matches its labels", pod.Name, s.String())
+		}
+	}
+	pod.Labels[preservedForLabelKey] = c.rollout.Name
+	pod.Labels[preservedPodTemplateHashLabelKey] = podHash
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[preservedUntilAnnotationKey] = preservedUntil
+	if analysisRunName != "" {
+		pod.Annotations[preservedAnalysisRunAnnotationKey] = analysisRunName
+	}
+	pod.OwnerReferences = []metav1.OwnerReference{rolloutOwnerReference(c.rollout)}
+	return nil
+}
@@ -0,0 +1,205 @@
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func newAbortPreserveRolloutContext(ro *v1alpha1.Rollout, newRS *appsv1.ReplicaSet, objs ...runtime.Object) (*rolloutContext, *k8sfake.Clientset) {
+	kubeclient := k8sfake.NewSimpleClientset(objs...)
+	roCtx := &rolloutContext{
+		rollout: ro,
+		newRS:   newRS,
+		pauseContext: &pauseContext{
+			rollout: ro,
+		},
+		reconcilerBase: reconcilerBase{
+			kubeclientset:       kubeclient,
+			recorder:            record.NewFakeEventRecorder(),
+			enqueueRolloutAfter: func(obj any, duration time.Duration) {},
+		},
+	}
+	roCtx.log = logutil.WithRollout(ro)
+	return roCtx, kubeclient
+}
+
+func newAbortPreserveRollout() (*v1alpha1.Rollout, *appsv1.ReplicaSet) {
+	ro := newCanaryRollout("foo", 3, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))
+	ro.Spec.Strategy.Canary.AbortPolicy = &v1alpha1.CanaryAbortPolicy{
+		Preserve: &v1alpha1.PreserveAbortedPods{Count: pointer.Int32(2), TTL: "1h"},
+	}
+	ro.Status.StableRS = "stable"
+	ro.Status.Abort = true
+	ro.Status.Canary.CurrentStepAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   "foo-canary-1",
+		Status: v1alpha1.AnalysisPhaseFailed,
+	}
+	// a Service may select the canary pods on any label of the pod template
+	ro.Spec.Template.Labels["team"] = "checkout"
+	rs := newReplicaSetWithStatus(ro, 3, 3)
+	return ro, rs
+}
+
+func newAbortPreservePod(rs *appsv1.ReplicaSet, name string, ready bool) *corev1.Pod {
+	labels := map[string]string{"injected": "true"}
+	for k, v := range rs.Spec.Template.Labels {
+		labels[k] = v
+	}
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       rs.Namespace,
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rs, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+		},
+	}
+}
+
+func TestPreserveAbortedCanaryPods(t *testing.T) {
+	ro, rs := newAbortPreserveRollout()
+	roCtx, kubeclient := newAbortPreserveRolloutContext(ro, rs,
+		newAbortPreservePod(rs, "pod-a", false),
+		newAbortPreservePod(rs, "pod-b", true),
+		newAbortPreservePod(rs, "pod-c", true),
+	)
+
+	assert.NoError(t, roCtx.reconcileAbortedPodPreservation())
+
+	pods, err := kubeclient.CoreV1().Pods(ro.Namespace).List(context.TODO(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	preserved := map[string]corev1.Pod{}
+	for _, pod := range pods.Items {
+		if pod.Labels[preservedForLabelKey] == ro.Name {
+			preserved[pod.Name] = pod
+		}
+	}
+	// the ready pods are preserved first
+	assert.Len(t, preserved, 2)
+	assert.Contains(t, preserved, "pod-b")
+	assert.Contains(t, preserved, "pod-c")
+	pod := preserved["pod-b"]
+	assert.NotContains(t, pod.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+	for k := range ro.Spec.Selector.MatchLabels {
+		assert.NotContains(t, pod.Labels, k)
+	}
+	assert.NotContains(t, pod.Labels, "team")
+	assert.Equal(t, "true", pod.Labels["injected"])
+	assert.Equal(t, rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], pod.Labels[preservedPodTemplateHashLabelKey])
+	assert.Equal(t, "foo-canary-1", pod.Annotations[preservedAnalysisRunAnnotationKey])
+	assert.NotEmpty(t, pod.Annotations[preservedUntilAnnotationKey])
+	assert.Len(t, pod.OwnerReferences, 1)
+	assert.Equal(t, "Rollout", pod.OwnerReferences[0].Kind)
+	assert.Nil(t, pod.OwnerReferences[0].Controller)
+
+	// the pods are preserved once per revision
+	roCtx, kubeclient = newAbortPreserveRolloutContext(ro, rs, podObjects(pods.Items)...)
+	assert.NoError(t, roCtx.reconcileAbortedPodPreservation())
+	pods, err = kubeclient.CoreV1().Pods(ro.Namespace).List(context.TODO(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	count := 0
+	for _, pod := range pods.Items {
+		if pod.Labels[preservedForLabelKey] == ro.Name {
+			count++
+		}
+	}
+	assert.Equal(t, 2, count)
+}
+
+func podObjects(pods []corev1.Pod) []runtime.Object {
+	var objs []runtime.Object
+	for i := range pods {
+		objs = append(objs, &pods[i])
+	}
+	return objs
+}
+
+func TestPreserveAbortedCanaryPodsSelector(t *testing.T) {
+	ro, rs := newAbortPreserveRollout()
+	rs.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{{Key: "track", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"baseline"}}}
+	pod := newAbortPreservePod(rs, "pod-a", true)
+	pod.Labels["track"] = "canary"
+	roCtx, _ := newAbortPreserveRolloutContext(ro, rs)
+
+	assert.NoError(t, roCtx.preservePod(pod, "abc", "", "later"))
+	assert.NotContains(t, pod.Labels, "track")
+	assert.NotContains(t, pod.Labels, "team")
+
+	// a selector which still matches the pod once relabeled fails the preservation
+	rs.Spec.Selector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "track", Operator: metav1.LabelSelectorOpDoesNotExist}}}
+	assert.Error(t, roCtx.preservePod(newAbortPreservePod(rs, "pod-b", true), "abc", "", "later"))
+}
+
+func TestPreserveAbortedCanaryPodsNotAborted(t *testing.T) {
+	ro, rs := newAbortPreserveRollout()
+	ro.Status.Abort = false
+	roCtx, kubeclient := newAbortPreserveRolloutContext(ro, rs, newAbortPreservePod(rs, "pod-a", true))
+
+	assert.NoError(t, roCtx.reconcileAbortedPodPreservation())
+
+	pod, err := kubeclient.CoreV1().Pods(ro.Namespace).Get(context.TODO(), "pod-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, pod.Labels, preservedForLabelKey)
+}
+
+func TestDeleteExpiredPreservedPods(t *testing.T) {
+	ro, rs := newAbortPreserveRollout()
+	ro.Status.Abort = false
+	preservedPod := func(name string, until time.Time) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   ro.Namespace,
+			Labels:      map[string]string{preservedForLabelKey: ro.Name},
+			Annotations: map[string]string{preservedUntilAnnotationKey: until.UTC().Format(time.RFC3339)},
+		}}
+	}
+	now := timeutil.Now()
+	roCtx, kubeclient := newAbortPreserveRolloutContext(ro, rs,
+		preservedPod("expired", now.Add(-time.Minute)),
+		preservedPod("preserved", now.Add(time.Minute)),
+	)
+	var requeueAfter time.Duration
+	roCtx.enqueueRolloutAfter = func(obj any, duration time.Duration) {
+		requeueAfter = duration
+	}
+
+	assert.NoError(t, roCtx.reconcileAbortedPodPreservation())
+
+	_, err := kubeclient.CoreV1().Pods(ro.Namespace).Get(context.TODO(), "expired", metav1.GetOptions{})
+	assert.Error(t, err)
+	_, err = kubeclient.CoreV1().Pods(ro.Namespace).Get(context.TODO(), "preserved", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, requeueAfter > 0 && requeueAfter <= time.Minute)
+}
+
+func TestPreservedPodCountAndTTL(t *testing.T) {
+	count, ttl := preservedPodCountAndTTL(&v1alpha1.PreserveAbortedPods{})
+	assert.Equal(t, defaultPreservedPodCount, count)
+	assert.Equal(t, defaultPreservedPodTTL, ttl)
+
+	count, ttl = preservedPodCountAndTTL(&v1alpha1.PreserveAbortedPods{Count: pointer.Int32(3), TTL: "30m"})
+	assert.Equal(t, int32(3), count)
+	assert.Equal(t, 30*time.Minute, ttl)
+}
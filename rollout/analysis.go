@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	patchtypes "k8s.io/apimachinery/pkg/types"
 
+	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
@@ -54,6 +55,7 @@ func (c *Controller) getAnalysisRunsForRollout(rollout *v1alpha1.Rollout) ([]*v1
 		rollout.Status.Canary.CurrentStepAnalysisRunStatus,
 		rollout.Status.BlueGreen.PrePromotionAnalysisRunStatus,
 		rollout.Status.BlueGreen.PostPromotionAnalysisRunStatus,
+		rollout.Status.BlueGreen.TrafficStepAnalysisRunStatus,
 	}
 	for _, arStatus := range arStatuses {
 		if arStatus == nil || seen[arStatus.Name] {
@@ -111,6 +113,13 @@ func (c *rolloutContext) reconcileAnalysisRuns() error {
 		}
 		c.setPauseOrAbort(postPromotionAr)
 		newCurrentAnalysisRuns.BlueGreenPostPromotion = postPromotionAr
+
+		trafficStepAr, err := c.reconcileBlueGreenTrafficStepAnalysisRun()
+		if err != nil {
+			return err
+		}
+		c.setPauseOrAbort(trafficStepAr)
+		newCurrentAnalysisRuns.BlueGreenTrafficStep = trafficStepAr
 	}
 	c.SetCurrentAnalysisRuns(newCurrentAnalysisRuns)
 
@@ -152,9 +161,16 @@ func (c *rolloutContext) setPauseOrAbort(ar *v1alpha1.AnalysisRun) {
 	}
 	switch ar.Status.Phase {
 	case v1alpha1.AnalysisPhaseInconclusive:
+		// an inconclusive analysis aborts the update instead of pausing it when the abort is retried
+		if c.retriesAbort(v1alpha1.RolloutAbortReasonAnalysisInconclusive) {
+			c.pauseContext.AddAbortWithReason(v1alpha1.RolloutAbortReasonAnalysisInconclusive, ar.Status.Message)
+			return
+		}
 		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
-	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
-		c.pauseContext.AddAbort(ar.Status.Message)
+	case v1alpha1.AnalysisPhaseError:
+		c.pauseContext.AddAbortWithReason(v1alpha1.RolloutAbortReasonAnalysisError, ar.Status.Message)
+	case v1alpha1.AnalysisPhaseFailed:
+		c.pauseContext.AddAbortWithReason(v1alpha1.RolloutAbortReasonAnalysisFailed, ar.Status.Message)
 	}
 }
 
@@ -196,11 +212,30 @@ func (c *rolloutContext) emitAnalysisRunStatusChanges(prevStatus *v1alpha1.Rollo
 				eventType = corev1.EventTypeWarning
 			}
 			msg := fmt.Sprintf("%s Analysis Run '%s' Status New: '%s' Previous: '%s'", arType, ar.Name, ar.Status.Phase, prevStatusStr)
-			c.recorder.Eventf(c.rollout, record.EventOptions{EventType: eventType, EventReason: "AnalysisRun" + string(ar.Status.Phase)}, msg)
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventType: eventType, EventReason: "AnalysisRun" + string(ar.Status.Phase), RelatedObject: ar}, msg)
+			recordAnalysisRunFailure(c.rollout, ar)
 		}
 	}
 }
 
+// recordAnalysisRunFailure counts an unsuccessful analysis run of the rollout for each of its templates
+func recordAnalysisRunFailure(rollout *v1alpha1.Rollout, ar *v1alpha1.AnalysisRun) {
+	switch ar.Status.Phase {
+	case v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseInconclusive:
+	default:
+		return
+	}
+	templates := ar.Annotations[annotations.AnalysisTemplatesAnnotation]
+	if templates == "" {
+		// runs created before the templates were recorded
+		metrics.IncRolloutAnalysisFailure(rollout, "", ar.Status.Phase)
+		return
+	}
+	for _, template := range strings.Split(templates, ",") {
+		metrics.IncRolloutAnalysisFailure(rollout, template, ar.Status.Phase)
+	}
+}
+
 // reconcileAnalysisRunStatusChanges for each analysisRun type, the controller checks if the analysis run status has changed
 // for that type
 func (c *rolloutContext) reconcileAnalysisRunStatusChanges(currARs analysisutil.CurrentAnalysisRuns) {
@@ -216,6 +251,12 @@ func (c *rolloutContext) reconcileAnalysisRunStatusChanges(currARs analysisutil.
 		v1alpha1.RolloutTypePrePromotionLabel,
 	)
 
+	c.emitAnalysisRunStatusChanges(
+		c.rollout.Status.BlueGreen.TrafficStepAnalysisRunStatus,
+		currARs.BlueGreenTrafficStep,
+		v1alpha1.RolloutTypeStepLabel,
+	)
+
 	c.emitAnalysisRunStatusChanges(
 		c.rollout.Status.Canary.CurrentStepAnalysisRunStatus,
 		currARs.CanaryStep,
@@ -344,12 +385,7 @@ func (c *rolloutContext) reconcileBackgroundAnalysisRun() (*v1alpha1.AnalysisRun
 		}
 		return currentAr, err
 	}
-	switch currentAr.Status.Phase {
-	case v1alpha1.AnalysisPhaseInconclusive:
-		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
-	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
-		c.pauseContext.AddAbort(currentAr.Status.Message)
-	}
+	c.setPauseOrAbort(currentAr)
 	return currentAr, nil
 }
 
@@ -398,18 +434,13 @@ func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun,
 		return currentAr, err
 	}
 
-	switch currentAr.Status.Phase {
-	case v1alpha1.AnalysisPhaseInconclusive:
-		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
-	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
-		c.pauseContext.AddAbort(currentAr.Status.Message)
-	}
+	c.setPauseOrAbort(currentAr)
 
 	return currentAr, nil
 }
 
 func (c *rolloutContext) cancelAnalysisRuns(analysisRuns []*v1alpha1.AnalysisRun) error {
-	ctx := context.TODO()
+	ctx := c.context()
 	for i := range analysisRuns {
 		ar := analysisRuns[i]
 		isNotCompleted := ar == nil || !ar.Status.Phase.Completed()
@@ -451,8 +482,13 @@ func (c *rolloutContext) newAnalysisRunFromRollout(rolloutAnalysis *v1alpha1.Rol
 		runLabels[k] = v
 	}
 
+	templateNames := make([]string, len(rolloutAnalysis.Templates))
+	for i, ref := range rolloutAnalysis.Templates {
+		templateNames[i] = ref.TemplateName
+	}
 	runAnnotations := map[string]string{
-		annotations.RevisionAnnotation: revision,
+		annotations.RevisionAnnotation:          revision,
+		annotations.AnalysisTemplatesAnnotation: strings.Join(templateNames, ","),
 	}
 	for k, v := range rolloutAnalysis.AnalysisRunMetadata.Annotations {
 		runAnnotations[k] = v
@@ -514,7 +550,7 @@ func (c *rolloutContext) getAnalysisTemplatesFromRefs(templateRefs *[]v1alpha1.A
 }
 
 func (c *rolloutContext) deleteAnalysisRuns(ars []*v1alpha1.AnalysisRun) error {
-	ctx := context.TODO()
+	ctx := c.context()
 	for i := range ars {
 		ar := ars[i]
 		if ar.DeletionTimestamp != nil {
@@ -930,6 +930,7 @@ func TestCreateAnalysisRunOnPromotedAnalysisStepIfPreviousStepWasAnalysisToo(t *
 	patch := f.getPatchedRollout(index)
 	expectedPatch := `{
 		"status": {
+			"currentStep": {"step": "steps[1]"},
 			"canary": {
 				"currentStepAnalysisRunStatus":null
 			}
@@ -1599,6 +1600,7 @@ func TestErrorConditionAfterErrorAnalysisRunStep(t *testing.T) {
 			},
 			"conditions": %s,
 			"abort": true,
+			"currentStep": null,
 			"abortedAt": "%s",
 			"phase": "Degraded",
 			"message": "RolloutAborted: %s"
@@ -1676,6 +1678,7 @@ func TestErrorConditionAfterErrorAnalysisRunBackground(t *testing.T) {
 			"conditions": %s,
 			"abortedAt": "%s",
 			"abort": true,
+			"currentStep": null,
 			"phase": "Degraded",
 			"message": "RolloutAborted: %s"
 		}
@@ -1739,6 +1742,7 @@ func TestCancelAnalysisRunsWhenAborted(t *testing.T) {
 		"status": {
 			"conditions": %s,
 			"abortedAt": "%s",
+			"currentStep": null,
 			"phase": "Degraded",
 			"message": "RolloutAborted: %s"
 		}
@@ -2119,6 +2123,8 @@ func TestDoNotCreatePrePromotionAnalysisRunOnNotReadyReplicaSet(t *testing.T) {
 	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 
 	r2 = updateBlueGreenRolloutStatus(r2, rs2PodHash, rs1PodHash, rs1PodHash, 2, 2, 4, 2, false, true, false)
+	// the pre-promotion phase starts once the preview ReplicaSet is available
+	r2.Status.CurrentStep = nil
 
 	activeSelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}
 	activeSvc := newService("active", 80, activeSelector, r2)
@@ -2265,6 +2271,8 @@ func TestRolloutPrePromotionAnalysisSwitchServiceAfterSuccess(t *testing.T) {
 				"prePromotionAnalysisRunStatus":{"status":"Successful"}
 			},
 			"stableRS": "%s",
+			"lifecycle": null,
+			"currentStep": null,
 			"pauseConditions": null,
 			"controllerPause": null,
 			"selector":"foo=bar,rollouts-pod-template-hash=%s",
@@ -2331,6 +2339,8 @@ func TestRolloutPrePromotionAnalysisHonorAutoPromotionSeconds(t *testing.T) {
 				"activeSelector": "%s"
 			},
 			"stableRS": "%s",
+			"lifecycle": null,
+			"currentStep": null,
 			"pauseConditions": null,
 			"controllerPause": null,
 			"selector":"foo=bar,rollouts-pod-template-hash=%s",
@@ -2560,6 +2570,8 @@ func TestRolloutPostPromotionAnalysisSuccess(t *testing.T) {
 		"status": {
 			"replicas":2,
 			"stableRS": "%s",
+			"lifecycle": null,
+			"currentStep": null,
 			"blueGreen": {
 				"postPromotionAnalysisRunStatus":{"status":"Successful"}
 			},
@@ -26,6 +26,15 @@ func (c *rolloutContext) rolloutBlueGreen() error {
 		return fmt.Errorf("failed to getAllReplicaSetsAndSyncRevision in rolloutBlueGreen create true: %w", err)
 	}
 
+	if replicasetutil.CheckPodSpecChange(c.rollout, c.newRS) && c.rollout.Spec.Strategy.BlueGreen.TrafficRouting != nil {
+		// shift the traffic back to the active service before the preview service selects the new ReplicaSet
+		c.newStatus.BlueGreen.TrafficStepIndex = nil
+		err = c.reconcileBlueGreenTrafficRouting(activeSvc)
+		if err != nil {
+			return err
+		}
+	}
+
 	// This must happen right after the new replicaset is created
 	err = c.reconcilePreviewService(previewSvc)
 	if err != nil {
@@ -47,12 +56,19 @@ func (c *rolloutContext) rolloutBlueGreen() error {
 	}
 
 	c.reconcileBlueGreenPause(activeSvc, previewSvc)
+	c.reconcileBlueGreenTrafficSteps(activeSvc)
+	c.reconcileBlueGreenStepTimeout()
 
 	err = c.reconcileActiveService(activeSvc)
 	if err != nil {
 		return err
 	}
 
+	err = c.reconcileBlueGreenTrafficRouting(activeSvc)
+	if err != nil {
+		return err
+	}
+
 	err = c.awsVerifyTargetGroups(activeSvc)
 	if err != nil {
 		return err
@@ -106,6 +122,15 @@ func (c *rolloutContext) reconcileBlueGreenReplicaSets(activeSvc *corev1.Service
 	if err := c.reconcileRevisionHistoryLimit(c.otherRSs); err != nil {
 		return err
 	}
+	if err := c.reconcileConfigSnapshots(); err != nil {
+		return err
+	}
+	if err := c.reconcileStrategySnapshots(); err != nil {
+		return err
+	}
+	if err := c.reconcileHooks(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -158,6 +183,11 @@ func (c *rolloutContext) reconcileBlueGreenPause(activeSvc, previewSvc *corev1.S
 		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonBlueGreenPause)
 		return
 	}
+	if c.rollout.Status.BlueGreen.TrafficStepIndex != nil {
+		c.log.Debug("skipping pause: weighted switchover in progress")
+		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonBlueGreenPause)
+		return
+	}
 	if !needsBlueGreenControllerPause(c.rollout) {
 		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonBlueGreenPause)
 		return
@@ -208,8 +238,7 @@ func (c *rolloutContext) scaleDownOldReplicaSetsForBlueGreen(oldRSs []*appsv1.Re
 		return false, nil
 	}
 	if c.rollout.Spec.Strategy.BlueGreen != nil && c.rollout.Spec.Strategy.BlueGreen.PostPromotionAnalysis != nil && c.rollout.Spec.Strategy.BlueGreen.ScaleDownDelaySeconds == nil && !skipPostPromotionAnalysisRun(c.rollout, c.newRS) {
-		currentPostAr := c.currentArs.BlueGreenPostPromotion
-		if currentPostAr == nil || currentPostAr.Status.Phase != v1alpha1.AnalysisPhaseSuccessful {
+		if !c.completedPostPromotionAnalysis() {
 			c.log.Infof("Cannot scale down old ReplicaSets while Analysis is running and no ScaleDownDelaySeconds")
 			return false, nil
 		}
@@ -277,6 +277,8 @@ func TestBlueGreenHandlePause(t *testing.T) {
 		rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 
 		r2 = updateBlueGreenRolloutStatus(r2, rs2PodHash, rs1PodHash, rs1PodHash, 2, 2, 4, 2, false, true, false)
+		// the pre-promotion phase starts once the preview ReplicaSet is available
+		r2.Status.CurrentStep = nil
 
 		activeSelector := map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}
 		activeSvc := newService("active", 80, activeSelector, r2)
@@ -557,6 +559,8 @@ func TestBlueGreenHandlePause(t *testing.T) {
 					"activeSelector": "%s"
 				},
 				"stableRS": "%s",
+				"lifecycle": null,
+				"currentStep": null,
 				"pauseConditions": null,
 				"controllerPause": null,
 				"selector": "foo=bar,rollouts-pod-template-hash=%s",
@@ -657,6 +661,8 @@ func TestBlueGreenHandlePause(t *testing.T) {
 					"activeSelector": "%s"
 				},
 				"stableRS": "%s",
+				"lifecycle": null,
+				"currentStep": null,
 				"conditions": %s,
 				"selector": "%s",
 				"phase": "Healthy",
@@ -749,6 +755,7 @@ func TestBlueGreenHandlePause(t *testing.T) {
 					"activeSelector": "%s"
 				},
 				"stableRS": "%s",
+				"lifecycle": null,
 				"conditions": %s,
 				"selector": "%s",
 				"phase": "Healthy",
@@ -825,6 +832,8 @@ func TestBlueGreenHandlePause(t *testing.T) {
 					"activeSelector": "%s"
 				},
 				"stableRS": "%s",
+				"lifecycle": null,
+				"currentStep": null,
 				"controllerPause":null,
 				"conditions": %s,
 				"selector": "%s",
@@ -873,6 +882,8 @@ func TestBlueGreenAddScaleDownDelayToPreviousActiveReplicaSet(t *testing.T) {
 				"activeSelector": "%s"
 			},
 			"stableRS": "%s",
+			"lifecycle": null,
+			"currentStep": null,
 			"conditions": %s,
 			"selector": "%s",
 			"phase": "Healthy",
@@ -1596,6 +1607,8 @@ func TestBlueGreenHandlePauseAutoPromoteWithConditions(t *testing.T) {
 				},
 				"conditions": [%s, %s, %s, %s],
 				"stableRS": "%s",
+				"lifecycle": null,
+				"currentStep": null,
 				"pauseConditions": null,
 				"controllerPause": null,
 				"selector": "foo=bar,rollouts-pod-template-hash=%s",
@@ -0,0 +1,246 @@
+package rollout
+
+import (
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// blueGreenTrafficRoutingRollout returns a copy of a blue-green rollout which uses traffic routing, as a canary
+// rollout whose stable and canary services are the active and preview services. The traffic routing reconcilers
+// are built from this copy, so that they shift the traffic from the active service to the preview service.
+func blueGreenTrafficRoutingRollout(ro *v1alpha1.Rollout) *v1alpha1.Rollout {
+	blueGreen := ro.Spec.Strategy.BlueGreen
+	canaryRollout := ro.DeepCopy()
+	canaryRollout.Spec.Strategy.BlueGreen = nil
+	canaryRollout.Spec.Strategy.Canary = &v1alpha1.CanaryStrategy{
+		CanaryService:  blueGreen.PreviewService,
+		StableService:  blueGreen.ActiveService,
+		TrafficRouting: blueGreen.TrafficRouting.DeepCopy(),
+	}
+	canaryRollout.Status.Canary.Weights = ro.Status.BlueGreen.Weights.DeepCopy()
+	return canaryRollout
+}
+
+// usesBlueGreenTrafficSteps returns whether the active service of a blue-green rollout is switched after a
+// weighted switchover
+func usesBlueGreenTrafficSteps(ro *v1alpha1.Rollout) bool {
+	blueGreen := ro.Spec.Strategy.BlueGreen
+	return blueGreen != nil && blueGreen.TrafficRouting != nil && len(blueGreen.TrafficSteps) > 0
+}
+
+// currentBlueGreenTrafficStep returns the current step of the weighted switchover and its index, or nil if the
+// switchover is not in progress
+func (c *rolloutContext) currentBlueGreenTrafficStep() (*v1alpha1.BlueGreenTrafficStep, *int32) {
+	index := c.newStatus.BlueGreen.TrafficStepIndex
+	if !usesBlueGreenTrafficSteps(c.rollout) || index == nil || int(*index) >= len(c.rollout.Spec.Strategy.BlueGreen.TrafficSteps) {
+		return nil, nil
+	}
+	return &c.rollout.Spec.Strategy.BlueGreen.TrafficSteps[*index], index
+}
+
+// completedBlueGreenTrafficSteps returns whether the weighted switchover of a blue-green rollout using traffic
+// steps completed, after which the active service is switched to the new ReplicaSet
+func (c *rolloutContext) completedBlueGreenTrafficSteps() bool {
+	index := c.newStatus.BlueGreen.TrafficStepIndex
+	return index != nil && int(*index) >= len(c.rollout.Spec.Strategy.BlueGreen.TrafficSteps)
+}
+
+// reconcileBlueGreenTrafficSteps progresses the weighted switchover of a blue-green rollout using traffic steps.
+// The switchover starts instead of the switch of the active service, once the pause and the pre-promotion analysis
+// completed, and advances one step per reconciliation. It is reset when the update is aborted, so that the traffic
+// goes back to the active service at once, and starts over from the first step when the update is retried.
+func (c *rolloutContext) reconcileBlueGreenTrafficSteps(activeSvc *corev1.Service) {
+	if !usesBlueGreenTrafficSteps(c.rollout) {
+		c.newStatus.BlueGreen.TrafficStepIndex = nil
+		return
+	}
+	if c.pauseContext.IsAborted() || c.isBlueGreenFastTracked(activeSvc) {
+		c.newStatus.BlueGreen.TrafficStepIndex = nil
+		return
+	}
+	if reason := c.haltProgress(); reason != "" {
+		c.log.Infof("Skipping traffic steps reconciliation: %s", reason)
+		return
+	}
+	if !replicasetutil.ReadyForPause(c.rollout, c.newRS, c.allRSs) || !annotations.IsSaturated(c.rollout, c.newRS) {
+		c.log.Infof("Skipping traffic steps reconciliation: New RS '%s' is not fully saturated", c.newRS.Name)
+		return
+	}
+	if c.newStatus.BlueGreen.TrafficStepIndex == nil {
+		if !c.pauseContext.CompletedBlueGreenPause() || !c.completedPrePromotionAnalysis() {
+			return
+		}
+		c.log.Info("Starting weighted switchover to the preview service")
+		c.newStatus.BlueGreen.TrafficStepIndex = pointer.Int32(0)
+		return
+	}
+	step, index := c.currentBlueGreenTrafficStep()
+	if step == nil || !c.completedBlueGreenTrafficStep(*step, *index) {
+		return
+	}
+	c.log.Infof("Completed traffic step %d", *index)
+	c.newStatus.BlueGreen.TrafficStepIndex = pointer.Int32(*index + 1)
+}
+
+// completedBlueGreenTrafficStep returns whether the current step of the weighted switchover completed
+func (c *rolloutContext) completedBlueGreenTrafficStep(step v1alpha1.BlueGreenTrafficStep, index int32) bool {
+	switch {
+	case step.SetWeight != nil:
+		weights := c.rollout.Status.BlueGreen.Weights
+		return weights != nil &&
+			weights.Canary.Weight == *step.SetWeight &&
+			weights.Canary.PodTemplateHash == replicasetutil.GetPodTemplateHash(c.newRS) &&
+			(weights.Verified == nil || *weights.Verified)
+	case step.Pause != nil:
+		return c.completedBlueGreenTrafficPauseStep(*step.Pause)
+	case step.Analysis != nil:
+		currentAr := c.currentArs.BlueGreenTrafficStep
+		return currentAr != nil &&
+			currentAr.GetLabels()[v1alpha1.RolloutCanaryStepIndexLabel] == strconv.Itoa(int(index)) &&
+			currentAr.Status.Phase == v1alpha1.AnalysisPhaseSuccessful
+	}
+	return true
+}
+
+// completedBlueGreenTrafficPauseStep pauses the rollout for a pause step of the weighted switchover, and returns
+// whether the pause completed because the rollout was promoted or the pause duration elapsed
+func (c *rolloutContext) completedBlueGreenTrafficPauseStep(pause v1alpha1.RolloutPause) bool {
+	pauseCond := getPauseCondition(c.rollout, v1alpha1.PauseReasonBlueGreenTrafficStepPause)
+	if pauseCond == nil {
+		if c.rollout.Status.ControllerPause {
+			c.log.Info("Rollout has been unpaused")
+			return true
+		}
+		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonBlueGreenTrafficStepPause)
+		return false
+	}
+	if pause.Duration != nil {
+		expiredTime := pauseCond.StartTime.Add(time.Duration(pause.DurationSeconds()) * time.Second)
+		if timeutil.MetaNow().After(expiredTime) {
+			c.log.Info("Rollout has waited the duration of the pause step")
+			c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonBlueGreenTrafficStepPause)
+			return true
+		}
+		c.checkEnqueueRolloutDuringWait(pauseCond.StartTime, pause.DurationSeconds())
+	}
+	return false
+}
+
+// desiredBlueGreenTrafficWeight returns the weight of the traffic to shift to the preview service. The traffic
+// goes to the active service alone outside of the weighted switchover, including once the active service was
+// switched to the new ReplicaSet.
+func (c *rolloutContext) desiredBlueGreenTrafficWeight(previewHash, activeHash string) int32 {
+	index := c.newStatus.BlueGreen.TrafficStepIndex
+	if index == nil || c.pauseContext.IsAborted() || previewHash == "" || previewHash == activeHash {
+		return 0
+	}
+	steps := c.rollout.Spec.Strategy.BlueGreen.TrafficSteps
+	if int(*index) >= len(steps) {
+		return weightutil.MaxTrafficWeight(c.rollout)
+	}
+	for i := *index; i >= 0; i-- {
+		if steps[i].SetWeight != nil {
+			return *steps[i].SetWeight
+		}
+	}
+	return 0
+}
+
+// reconcileBlueGreenTrafficRouting sets the weight of the traffic shifted from the active service to the preview
+// service on the traffic routers of a blue-green rollout, and records it in the status
+func (c *rolloutContext) reconcileBlueGreenTrafficRouting(activeSvc *corev1.Service) error {
+	if c.rollout.Spec.Strategy.BlueGreen.TrafficRouting == nil {
+		c.newStatus.BlueGreen.Weights = nil
+		return nil
+	}
+	reconcilers, err := c.getTrafficRoutingReconcilers()
+	if err != nil {
+		return err
+	}
+	if len(reconcilers) == 0 {
+		c.log.Info("No TrafficRouting Reconcilers found")
+		c.newStatus.BlueGreen.Weights = nil
+		return nil
+	}
+
+	previewHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	activeHash := serviceutil.GetRolloutSelectorLabel(activeSvc)
+	desiredWeight := c.desiredBlueGreenTrafficWeight(previewHash, activeHash)
+	var weightVerified *bool
+	for _, reconciler := range reconcilers {
+		c.log.Infof("Reconciling TrafficRouting with type '%s'", reconciler.Type())
+		if err := reconciler.UpdateHash(previewHash, activeHash); err != nil {
+			return err
+		}
+		if err := reconciler.SetWeight(desiredWeight); err != nil {
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: "TrafficRoutingError"}, err.Error())
+			return err
+		}
+		verified, err := reconciler.VerifyWeight(desiredWeight)
+		if err != nil {
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.WeightVerifyErrorReason}, conditions.WeightVerifyErrorMessage, err)
+			continue
+		}
+		if verified != nil && (weightVerified == nil || *weightVerified) {
+			weightVerified = verified
+		}
+	}
+
+	canaryRollout := blueGreenTrafficRoutingRollout(c.rollout)
+	if modified, newWeights := calculateWeightStatus(canaryRollout, previewHash, activeHash, desiredWeight); modified {
+		c.log.Infof("Previous weights: %v", c.rollout.Status.BlueGreen.Weights)
+		c.log.Infof("New weights: %v", newWeights)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.TrafficWeightUpdatedReason}, trafficWeightUpdatedMessage(c.rollout.Status.BlueGreen.Weights, newWeights))
+		c.newStatus.BlueGreen.Weights = newWeights
+	} else {
+		c.newStatus.BlueGreen.Weights = c.rollout.Status.BlueGreen.Weights.DeepCopy()
+	}
+	c.newStatus.BlueGreen.Weights.Verified = weightVerified
+	if weightVerified != nil && !*weightVerified {
+		c.log.Infof("Desired weight %d not yet verified", desiredWeight)
+		c.enqueueRolloutAfter(c.rollout, defaults.GetRolloutVerifyRetryInterval())
+	}
+	return nil
+}
+
+// reconcileBlueGreenTrafficStepAnalysisRun runs the analysis of the current step of the weighted switchover
+func (c *rolloutContext) reconcileBlueGreenTrafficStepAnalysisRun() (*v1alpha1.AnalysisRun, error) {
+	currentAr := c.currentArs.BlueGreenTrafficStep
+	if len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Status.Abort {
+		return currentAr, nil
+	}
+
+	step, index := c.currentBlueGreenTrafficStep()
+	analysisRunFromPreviousStep := step != nil && step.Analysis != nil && currentAr != nil && currentAr.GetLabels()[v1alpha1.RolloutCanaryStepIndexLabel] != strconv.Itoa(int(*index))
+	if step == nil || step.Analysis == nil || analysisRunFromPreviousStep {
+		err := c.cancelAnalysisRuns([]*v1alpha1.AnalysisRun{currentAr})
+		return nil, err
+	}
+	c.log.Infof("Reconciling analysis of traffic step (stepIndex: %d)", *index)
+	if needsNewAnalysisRun(currentAr, c.rollout) {
+		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+		instanceID := analysisutil.GetInstanceID(c.rollout)
+		stepLabels := analysisutil.StepLabels(*index, podHash, instanceID)
+		currentAr, err := c.createAnalysisRun(step.Analysis, "traffic-"+strconv.Itoa(int(*index)), stepLabels)
+		if err == nil {
+			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Infof("Created AnalysisRun for traffic step '%d'", *index)
+		}
+		return currentAr, err
+	}
+	return currentAr, nil
+}
@@ -0,0 +1,172 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// newBlueGreenTrafficRolloutFixture returns a fixture for an update of a blue-green rollout which shifts the traffic
+// to the preview service with the given traffic steps, whose new ReplicaSet is fully available
+func newBlueGreenTrafficRolloutFixture(t *testing.T, steps []v1alpha1.BlueGreenTrafficStep, updateStatus func(*v1alpha1.Rollout, string, string)) (*fixture, *v1alpha1.Rollout, string, string) {
+	f := newFixture(t)
+
+	r1 := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	r1.Spec.Strategy.BlueGreen.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"},
+	}
+	r1.Spec.Strategy.BlueGreen.TrafficSteps = steps
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	r2 = updateBlueGreenRolloutStatus(r2, rs2PodHash, rs1PodHash, rs1PodHash, 1, 1, 2, 1, false, true, false)
+	if updateStatus != nil {
+		updateStatus(r2, rs1PodHash, rs2PodHash)
+	}
+	previewSvc := newService("preview", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}, r2)
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}, r2)
+
+	f.objects = append(f.objects, r2)
+	f.kubeobjects = append(f.kubeobjects, previewSvc, activeSvc, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.serviceLister = append(f.serviceLister, activeSvc, previewSvc)
+	return f, r2, rs1PodHash, rs2PodHash
+}
+
+func newBlueGreenTrafficWeights(previewHash, activeHash string, previewWeight int32) *v1alpha1.TrafficWeights {
+	return &v1alpha1.TrafficWeights{
+		Canary:   v1alpha1.WeightDestination{Weight: previewWeight, PodTemplateHash: previewHash, ServiceName: "preview"},
+		Stable:   v1alpha1.WeightDestination{Weight: 100 - previewWeight, PodTemplateHash: activeHash, ServiceName: "active"},
+		Verified: pointer.Bool(true),
+	}
+}
+
+var blueGreenTrafficSteps = []v1alpha1.BlueGreenTrafficStep{
+	{SetWeight: pointer.Int32(10)},
+	{Pause: &v1alpha1.RolloutPause{}},
+	{SetWeight: pointer.Int32(100)},
+}
+
+func TestBlueGreenTrafficStepsStartSwitchover(t *testing.T) {
+	f, r2, rs1PodHash, rs2PodHash := newBlueGreenTrafficRolloutFixture(t, blueGreenTrafficSteps, nil)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// the weight of the first step is set as the switchover starts
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, pointer.Int32(0), patched.Status.BlueGreen.TrafficStepIndex)
+	assert.Equal(t, newBlueGreenTrafficWeights(rs2PodHash, rs1PodHash, 10), patched.Status.BlueGreen.Weights)
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(10))
+}
+
+func TestBlueGreenTrafficStepsSetWeight(t *testing.T) {
+	f, r2, rs1PodHash, rs2PodHash := newBlueGreenTrafficRolloutFixture(t, blueGreenTrafficSteps, func(ro *v1alpha1.Rollout, rs1PodHash, rs2PodHash string) {
+		ro.Status.BlueGreen.TrafficStepIndex = pointer.Int32(0)
+	})
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.BlueGreen.TrafficStepIndex)
+	assert.Equal(t, newBlueGreenTrafficWeights(rs2PodHash, rs1PodHash, 10), patched.Status.BlueGreen.Weights)
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(10))
+}
+
+func TestBlueGreenTrafficStepsPause(t *testing.T) {
+	f, r2, _, _ := newBlueGreenTrafficRolloutFixture(t, blueGreenTrafficSteps, func(ro *v1alpha1.Rollout, rs1PodHash, rs2PodHash string) {
+		ro.Status.BlueGreen.TrafficStepIndex = pointer.Int32(0)
+		ro.Status.BlueGreen.Weights = newBlueGreenTrafficWeights(rs2PodHash, rs1PodHash, 10)
+	})
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// the setWeight step completed, and the rollout pauses on the pause step at the next reconciliation
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, pointer.Int32(1), patched.Status.BlueGreen.TrafficStepIndex)
+	assert.Empty(t, patched.Status.PauseConditions)
+
+	f, r2, _, _ = newBlueGreenTrafficRolloutFixture(t, blueGreenTrafficSteps, func(ro *v1alpha1.Rollout, rs1PodHash, rs2PodHash string) {
+		ro.Status.BlueGreen.TrafficStepIndex = pointer.Int32(1)
+		ro.Status.BlueGreen.Weights = newBlueGreenTrafficWeights(rs2PodHash, rs1PodHash, 10)
+	})
+	defer f.Close()
+
+	patchIndex = f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched = f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.ControllerPause)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonBlueGreenTrafficStepPause, patched.Status.PauseConditions[0].Reason)
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(10))
+}
+
+func TestBlueGreenTrafficStepsSwitchActiveService(t *testing.T) {
+	f, r2, _, rs2PodHash := newBlueGreenTrafficRolloutFixture(t, blueGreenTrafficSteps, func(ro *v1alpha1.Rollout, rs1PodHash, rs2PodHash string) {
+		ro.Status.BlueGreen.TrafficStepIndex = pointer.Int32(2)
+		ro.Status.BlueGreen.Weights = newBlueGreenTrafficWeights(rs2PodHash, rs1PodHash, 100)
+	})
+	defer f.Close()
+
+	activeSvc := f.serviceLister[0]
+	f.expectPatchServiceAction(activeSvc, rs2PodHash)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// the traffic goes back to the active service once it selects the new ReplicaSet
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.BlueGreen.TrafficStepIndex)
+	assert.Equal(t, rs2PodHash, patched.Status.BlueGreen.ActiveSelector)
+	assert.Equal(t, int32(0), patched.Status.BlueGreen.Weights.Canary.Weight)
+	assert.Equal(t, int32(100), patched.Status.BlueGreen.Weights.Stable.Weight)
+	assert.Equal(t, rs2PodHash, patched.Status.BlueGreen.Weights.Stable.PodTemplateHash)
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(0))
+}
+
+func TestBlueGreenTrafficStepsAbort(t *testing.T) {
+	f, r2, _, _ := newBlueGreenTrafficRolloutFixture(t, blueGreenTrafficSteps, func(ro *v1alpha1.Rollout, rs1PodHash, rs2PodHash string) {
+		ro.Status.Abort = true
+		ro.Status.BlueGreen.TrafficStepIndex = pointer.Int32(2)
+		ro.Status.BlueGreen.Weights = newBlueGreenTrafficWeights(rs2PodHash, rs1PodHash, 100)
+	})
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// the traffic is shifted back to the active service at once, which still selects the stable ReplicaSet
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(0), patched.Status.BlueGreen.Weights.Canary.Weight)
+	assert.Equal(t, int32(100), patched.Status.BlueGreen.Weights.Stable.Weight)
+	f.fakeTrafficRouting.AssertCalled(t, "SetWeight", int32(0))
+	f.fakeTrafficRouting.AssertNotCalled(t, "SetWeight", int32(100))
+}
+
+func TestBlueGreenTrafficRoutingRollout(t *testing.T) {
+	ro := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	ro.Spec.Strategy.BlueGreen.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: "ingress"},
+	}
+	ro.Status.BlueGreen.Weights = newBlueGreenTrafficWeights("new", "old", 10)
+
+	canaryRollout := blueGreenTrafficRoutingRollout(ro)
+	assert.Nil(t, canaryRollout.Spec.Strategy.BlueGreen)
+	assert.Equal(t, "preview", canaryRollout.Spec.Strategy.Canary.CanaryService)
+	assert.Equal(t, "active", canaryRollout.Spec.Strategy.Canary.StableService)
+	assert.Equal(t, ro.Spec.Strategy.BlueGreen.TrafficRouting, canaryRollout.Spec.Strategy.Canary.TrafficRouting)
+	assert.Equal(t, ro.Status.BlueGreen.Weights, canaryRollout.Status.Canary.Weights)
+	assert.NotNil(t, ro.Spec.Strategy.BlueGreen)
+}
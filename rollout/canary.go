@@ -45,6 +45,15 @@ func (c *rolloutContext) rolloutCanary() error {
 	if err := c.reconcileRevisionHistoryLimit(c.otherRSs); err != nil {
 		return err
 	}
+	if err := c.reconcileConfigSnapshots(); err != nil {
+		return err
+	}
+	if err := c.reconcileStrategySnapshots(); err != nil {
+		return err
+	}
+	if err := c.reconcileHooks(); err != nil {
+		return err
+	}
 
 	if err := c.reconcilePingAndPongService(); err != nil {
 		return err
@@ -72,6 +81,10 @@ func (c *rolloutContext) rolloutCanary() error {
 		return err
 	}
 
+	if err := c.reconcileAbortedPodPreservation(); err != nil {
+		return err
+	}
+
 	noScalingOccurred, err := c.reconcileCanaryReplicaSets()
 	if err != nil {
 		return err
@@ -324,11 +337,18 @@ func (c *rolloutContext) completedCurrentCanaryStep() bool {
 	if currentStep == nil {
 		return false
 	}
+	if c.completedTimedOutStep(canaryStepName(*currentStepIndex), canaryStepTimeout(currentStep)) {
+		return true
+	}
 	switch {
 	case currentStep.Pause != nil:
 		return c.pauseContext.CompletedCanaryPauseStep(*currentStep.Pause)
 	case currentStep.SetCanaryScale != nil:
 		return replicasetutil.AtDesiredReplicaCountsForCanary(c.rollout, c.newRS, c.stableRS, c.otherRSs, c.newStatus.Canary.Weights)
+	case currentStep.SetWeight != nil && c.rollout.Spec.IsStatefulSetWorkload():
+		return c.atDesiredStatefulSetPartition()
+	case (currentStep.SetWeight != nil || currentStep.SetCanaryNodes != nil) && c.rollout.Spec.IsDaemonSetWorkload():
+		return c.atDesiredDaemonSetNodes()
 	case currentStep.SetWeight != nil:
 		if !replicasetutil.AtDesiredReplicaCountsForCanary(c.rollout, c.newRS, c.stableRS, c.otherRSs, c.newStatus.Canary.Weights) {
 			return false
@@ -351,10 +371,23 @@ func (c *rolloutContext) completedCurrentCanaryStep() bool {
 		return true
 	case currentStep.Plugin != nil:
 		return c.stepPluginContext.isStepPluginCompleted(*currentStepIndex, currentStep.Plugin)
+	case currentStep.WaitFor != nil:
+		return c.completedWaitForStep()
 	}
 	return false
 }
 
+// reconcileCanaryStepTimeout records when the current canary step started, and applies its onTimeout policy once it
+// exceeds its timeout
+func (c *rolloutContext) reconcileCanaryStepTimeout() {
+	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	if currentStep == nil {
+		c.reconcileStepTimeout("", nil)
+		return
+	}
+	c.reconcileStepTimeout(canaryStepName(*currentStepIndex), canaryStepTimeout(currentStep))
+}
+
 func (c *rolloutContext) syncRolloutStatusCanary() error {
 	newStatus := c.calculateBaseStatus()
 	newStatus.AvailableReplicas = replicasetutil.GetAvailableReplicaCountForReplicaSets(c.allRSs)
@@ -422,14 +455,20 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 				newStatus.CurrentStepIndex = pointer.Int32Ptr(0)
 			}
 		}
+		newStatus.CurrentStep = nil
 		newStatus = c.calculateRolloutConditions(newStatus)
 		return c.persistRolloutStatus(&newStatus)
 	}
 
+	c.reconcileWaitForStep()
+	newStatus.Canary.WaitFor = c.newStatus.Canary.WaitFor
+	c.reconcileCanaryStepTimeout()
+	newStatus.CurrentStep = c.newStatus.CurrentStep
 	if c.completedCurrentCanaryStep() {
 		stepStr := rolloututil.CanaryStepString(*currentStep)
 		*currentStepIndex++
 		newStatus.Canary.CurrentStepAnalysisRunStatus = nil
+		newStatus.Canary.WaitFor = nil
 
 		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepCompletedReason}, conditions.RolloutStepCompletedMessage, int(*currentStepIndex), stepCount, stepStr)
 		c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonCanaryPauseStep)
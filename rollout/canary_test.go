@@ -380,6 +380,7 @@ func TestCanaryRolloutUpdateStatusWhenAtEndOfSteps(t *testing.T) {
 	expectedPatchWithoutStableRS := `{
 		"status": {
 			"stableRS": "%s",
+			"lifecycle": null,
 			"conditions": %s,
 			"phase": "Healthy",
 			"message": null
@@ -428,6 +429,7 @@ func TestResetCurrentStepIndexOnStepChange(t *testing.T) {
 			"currentStepIndex":0,
 			"currentPodHash": "%s",
 			"currentStepHash": "%s",
+			"currentStep": null,
 			"conditions": %s
 		}
 	}`
@@ -473,12 +475,13 @@ func TestResetCurrentStepIndexOnPodSpecChange(t *testing.T) {
 		"status": {
 			"currentStepIndex":0,
 			"currentPodHash": "%s",
+			"lifecycle": %s,
 			"conditions": %s
 		}
 	}`
 	newConditions := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, updatedRS, false, "", false)
 
-	expectedPatch := fmt.Sprintf(expectedPatchWithoutPodHash, expectedCurrentPodHash, newConditions)
+	expectedPatch := fmt.Sprintf(expectedPatchWithoutPodHash, expectedCurrentPodHash, generateLifecyclePatch(expectedCurrentPodHash), newConditions)
 	assert.JSONEq(t, calculatePatch(r2, expectedPatch), patch)
 }
 
@@ -935,6 +938,8 @@ func TestRollBackToStable(t *testing.T) {
 		"status":{
 			"currentPodHash": "%s",
 			"currentStepIndex":1,
+			"currentStep": null,
+			"lifecycle": null,
 			"conditions": %s
 		}
 	}`
@@ -1073,6 +1078,8 @@ func TestRollBackToStableAndStepChange(t *testing.T) {
 			"currentPodHash": "%s",
 			"currentStepHash": "%s",
 			"currentStepIndex":1,
+			"currentStep": null,
+			"lifecycle": null,
 			"conditions": %s
 		}
 	}`
@@ -1156,6 +1163,7 @@ func TestSyncRolloutWaitAddToQueue(t *testing.T) {
 	conditions.SetRolloutCondition(&r2.Status, completedCondition)
 
 	r2.Status.ObservedGeneration = strconv.Itoa(int(r2.Generation))
+	r2.Status.Lifecycle = newLifecycleStatus(r2)
 	f.rolloutLister = append(f.rolloutLister, r2)
 	f.objects = append(f.objects, r2)
 
@@ -1206,6 +1214,7 @@ func TestSyncRolloutIgnoreWaitOutsideOfReconciliationPeriod(t *testing.T) {
 
 	completedCondition, _ := newCompletedCondition(false)
 	conditions.SetRolloutCondition(&r2.Status, completedCondition)
+	r2.Status.Lifecycle = newLifecycleStatus(r2)
 
 	f.rolloutLister = append(f.rolloutLister, r2)
 	f.objects = append(f.objects, r2)
@@ -1949,6 +1958,7 @@ func TestHandleCanaryAbort(t *testing.T) {
 		expectedPatch := `{
 			"status":{
 				"currentStepIndex": 0,
+				"currentStep": null,
 				"conditions": %s,
 				"phase": "Degraded",
 				"message": "%s: %s"
@@ -0,0 +1,314 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const (
+	// configSnapshotOfLabelKey is the label of the snapshots of ConfigRefs, set to the name of the Rollout
+	configSnapshotOfLabelKey = annotations.RolloutLabel + "/config-snapshot-of"
+	// configSourceAnnotationKey is the annotation of the snapshots of ConfigRefs, set to the name of the ConfigRef
+	configSourceAnnotationKey = annotations.RolloutLabel + "/config-source"
+
+	configSnapshotCreatedReason = "ConfigSnapshotCreated"
+	configSnapshotDeletedReason = "ConfigSnapshotDeleted"
+)
+
+// ConfigSnapshotsTweakListOptions restricts the ConfigMap and Secret informers of the rollout controller to the
+// snapshots of the ConfigRefs. The ConfigMaps and Secrets referenced by the ConfigRefs are read with a GET when a
+// Rollout is reconciled, so that the controller does not cache all the ConfigMaps and Secrets of the cluster.
+func ConfigSnapshotsTweakListOptions(options *metav1.ListOptions) {
+	options.LabelSelector = configSnapshotOfLabelKey
+}
+
+// resolveConfigRefs snapshots the current data of the ConfigRefs of the Rollout, and rewrites the references of the
+// pod template to the snapshots. The snapshots are named <config>-<config hash>, so the pod template hash changes
+// with the data of the ConfigRefs. The pod template is only rewritten in memory (see SetResolvedConfigTemplate).
+// The ConfigRefs are not watched: a change of their data is picked up by the next reconciliation of the Rollout.
+func (c *reconcilerBase) resolveConfigRefs(rollout *v1alpha1.Rollout) error {
+	if len(rollout.Spec.ConfigRefs) == 0 {
+		return nil
+	}
+	ctx := context.TODO()
+	template := rollout.Spec.Template.DeepCopy()
+	if rollout.Spec.UnresolvedConfigTemplate != nil {
+		template = rollout.Spec.UnresolvedConfigTemplate.DeepCopy()
+	}
+	snapshots := map[v1alpha1.ConfigRef]string{}
+	for _, ref := range rollout.Spec.ConfigRefs {
+		var snapshotName string
+		var err error
+		switch ref.Kind {
+		case v1alpha1.ConfigRefKindConfigMap:
+			snapshotName, err = c.snapshotConfigMap(ctx, rollout, ref.Name)
+		case v1alpha1.ConfigRefKindSecret:
+			snapshotName, err = c.snapshotSecret(ctx, rollout, ref.Name)
+		default:
+			err = fmt.Errorf("configRef %s has unsupported kind %s", ref.Name, ref.Kind)
+		}
+		if err != nil {
+			return err
+		}
+		snapshots[ref] = snapshotName
+	}
+	visitPodSpecConfigRefs(&template.Spec, func(kind v1alpha1.ConfigRefKind, name *string) {
+		if snapshotName, ok := snapshots[v1alpha1.ConfigRef{Kind: kind, Name: *name}]; ok {
+			*name = snapshotName
+		}
+	})
+	rollout.Spec.SetResolvedConfigTemplate(*template)
+	return nil
+}
+
+// configSnapshotMeta returns the metadata of the snapshot of a ConfigRef
+func configSnapshotMeta(rollout *v1alpha1.Rollout, name string, configHash string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            name + "-" + configHash,
+		Namespace:       rollout.Namespace,
+		Labels:          map[string]string{configSnapshotOfLabelKey: rollout.Name},
+		Annotations:     map[string]string{configSourceAnnotationKey: name},
+		OwnerReferences: []metav1.OwnerReference{rolloutOwnerReference(rollout)},
+	}
+}
+
+// rolloutOwnerReference returns a non-controller owner reference to the Rollout. The snapshots are owned by the
+// Rollout until the ReplicaSets referencing them are created.
+func rolloutOwnerReference(rollout *v1alpha1.Rollout) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: controllerKind.GroupVersion().String(),
+		Kind:       controllerKind.Kind,
+		Name:       rollout.Name,
+		UID:        rollout.UID,
+	}
+}
+
+func (c *reconcilerBase) snapshotConfigMap(ctx context.Context, rollout *v1alpha1.Rollout, name string) (string, error) {
+	cm, err := c.kubeclientset.CoreV1().ConfigMaps(rollout.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	configHash := hash.ComputeConfigHash(struct {
+		Data       map[string]string `json:"data,omitempty"`
+		BinaryData map[string][]byte `json:"binaryData,omitempty"`
+	}{cm.Data, cm.BinaryData})
+	snapshot := &corev1.ConfigMap{
+		ObjectMeta: configSnapshotMeta(rollout, name, configHash),
+		Immutable:  pointer.Bool(true),
+		Data:       cm.Data,
+		BinaryData: cm.BinaryData,
+	}
+	if _, err := c.configMapSnapshotLister.ConfigMaps(rollout.Namespace).Get(snapshot.Name); err == nil || !k8serrors.IsNotFound(err) {
+		return snapshot.Name, err
+	}
+	if _, err := c.kubeclientset.CoreV1().ConfigMaps(rollout.Namespace).Create(ctx, snapshot, metav1.CreateOptions{}); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			// the snapshot was created by a previous reconciliation and is not in the cache yet
+			return snapshot.Name, nil
+		}
+		return "", err
+	}
+	msg := fmt.Sprintf("Created ConfigMap %s from ConfigMap %s", snapshot.Name, name)
+	c.recorder.Eventf(rollout, record.EventOptions{EventReason: configSnapshotCreatedReason}, msg)
+	return snapshot.Name, nil
+}
+
+func (c *reconcilerBase) snapshotSecret(ctx context.Context, rollout *v1alpha1.Rollout, name string) (string, error) {
+	secret, err := c.kubeclientset.CoreV1().Secrets(rollout.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	configHash := hash.ComputeConfigHash(struct {
+		Type corev1.SecretType `json:"type,omitempty"`
+		Data map[string][]byte `json:"data,omitempty"`
+	}{secret.Type, secret.Data})
+	snapshot := &corev1.Secret{
+		ObjectMeta: configSnapshotMeta(rollout, name, configHash),
+		Immutable:  pointer.Bool(true),
+		Type:       secret.Type,
+		Data:       secret.Data,
+	}
+	if _, err := c.secretSnapshotLister.Secrets(rollout.Namespace).Get(snapshot.Name); err == nil || !k8serrors.IsNotFound(err) {
+		return snapshot.Name, err
+	}
+	if _, err := c.kubeclientset.CoreV1().Secrets(rollout.Namespace).Create(ctx, snapshot, metav1.CreateOptions{}); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			// the snapshot was created by a previous reconciliation and is not in the cache yet
+			return snapshot.Name, nil
+		}
+		return "", err
+	}
+	msg := fmt.Sprintf("Created Secret %s from Secret %s", snapshot.Name, name)
+	c.recorder.Eventf(rollout, record.EventOptions{EventReason: configSnapshotCreatedReason}, msg)
+	return snapshot.Name, nil
+}
+
+// visitPodSpecConfigRefs calls the visitor with the name of every ConfigMap and Secret referenced by the volumes and
+// the environment of the containers of the pod spec. The visitor may modify the name.
+func visitPodSpecConfigRefs(spec *corev1.PodSpec, visitor func(kind v1alpha1.ConfigRefKind, name *string)) {
+	for i := range spec.Volumes {
+		volume := &spec.Volumes[i]
+		if volume.ConfigMap != nil {
+			visitor(v1alpha1.ConfigRefKindConfigMap, &volume.ConfigMap.Name)
+		}
+		if volume.Secret != nil {
+			visitor(v1alpha1.ConfigRefKindSecret, &volume.Secret.SecretName)
+		}
+		if volume.Projected != nil {
+			for j := range volume.Projected.Sources {
+				source := &volume.Projected.Sources[j]
+				if source.ConfigMap != nil {
+					visitor(v1alpha1.ConfigRefKindConfigMap, &source.ConfigMap.Name)
+				}
+				if source.Secret != nil {
+					visitor(v1alpha1.ConfigRefKindSecret, &source.Secret.Name)
+				}
+			}
+		}
+	}
+	visitContainer := func(env []corev1.EnvVar, envFrom []corev1.EnvFromSource) {
+		for i := range env {
+			if env[i].ValueFrom == nil {
+				continue
+			}
+			if env[i].ValueFrom.ConfigMapKeyRef != nil {
+				visitor(v1alpha1.ConfigRefKindConfigMap, &env[i].ValueFrom.ConfigMapKeyRef.Name)
+			}
+			if env[i].ValueFrom.SecretKeyRef != nil {
+				visitor(v1alpha1.ConfigRefKindSecret, &env[i].ValueFrom.SecretKeyRef.Name)
+			}
+		}
+		for i := range envFrom {
+			if envFrom[i].ConfigMapRef != nil {
+				visitor(v1alpha1.ConfigRefKindConfigMap, &envFrom[i].ConfigMapRef.Name)
+			}
+			if envFrom[i].SecretRef != nil {
+				visitor(v1alpha1.ConfigRefKindSecret, &envFrom[i].SecretRef.Name)
+			}
+		}
+	}
+	for i := range spec.InitContainers {
+		visitContainer(spec.InitContainers[i].Env, spec.InitContainers[i].EnvFrom)
+	}
+	for i := range spec.Containers {
+		visitContainer(spec.Containers[i].Env, spec.Containers[i].EnvFrom)
+	}
+	for i := range spec.EphemeralContainers {
+		visitContainer(spec.EphemeralContainers[i].Env, spec.EphemeralContainers[i].EnvFrom)
+	}
+}
+
+// reconcileConfigSnapshots sets the owners of the snapshots of the ConfigRefs to the ReplicaSets referencing them,
+// so that they are garbage collected with the ReplicaSets deleted by the RevisionHistoryLimit, and deletes the
+// snapshots which are referenced neither by a ReplicaSet nor by the pod template of the Rollout.
+func (c *rolloutContext) reconcileConfigSnapshots() error {
+	if len(c.rollout.Spec.ConfigRefs) == 0 {
+		return nil
+	}
+	ctx := c.context()
+
+	referencedBy := map[v1alpha1.ConfigRef][]metav1.OwnerReference{}
+	templateRefs := map[v1alpha1.ConfigRef]bool{}
+	visitPodSpecConfigRefs(c.rollout.Spec.Template.Spec.DeepCopy(), func(kind v1alpha1.ConfigRefKind, name *string) {
+		templateRefs[v1alpha1.ConfigRef{Kind: kind, Name: *name}] = true
+	})
+	for _, rs := range c.allRSs {
+		if rs == nil || rs.DeletionTimestamp != nil {
+			continue
+		}
+		ownerRef := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: rs.Name, UID: rs.UID}
+		seen := map[v1alpha1.ConfigRef]bool{}
+		visitPodSpecConfigRefs(rs.Spec.Template.Spec.DeepCopy(), func(kind v1alpha1.ConfigRefKind, name *string) {
+			ref := v1alpha1.ConfigRef{Kind: kind, Name: *name}
+			if !seen[ref] {
+				seen[ref] = true
+				referencedBy[ref] = append(referencedBy[ref], ownerRef)
+			}
+		})
+	}
+	desiredOwners := func(ref v1alpha1.ConfigRef) ([]metav1.OwnerReference, bool) {
+		if owners := referencedBy[ref]; len(owners) > 0 {
+			return owners, true
+		}
+		if templateRefs[ref] {
+			return []metav1.OwnerReference{rolloutOwnerReference(c.rollout)}, true
+		}
+		return nil, false
+	}
+	selector := labels.SelectorFromSet(labels.Set{configSnapshotOfLabelKey: c.rollout.Name})
+
+	configMaps, err := c.configMapSnapshotLister.ConfigMaps(c.rollout.Namespace).List(selector)
+	if err != nil {
+		return err
+	}
+	for _, cm := range configMaps {
+		owners, referenced := desiredOwners(v1alpha1.ConfigRef{Kind: v1alpha1.ConfigRefKindConfigMap, Name: cm.Name})
+		if !referenced {
+			if err := c.kubeclientset.CoreV1().ConfigMaps(cm.Namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: configSnapshotDeletedReason}, "Deleted ConfigMap %s", cm.Name)
+			continue
+		}
+		if ownerReferencesEqual(cm.OwnerReferences, owners) {
+			continue
+		}
+		cmCopy := cm.DeepCopy()
+		cmCopy.OwnerReferences = owners
+		if _, err := c.kubeclientset.CoreV1().ConfigMaps(cm.Namespace).Update(ctx, cmCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	secrets, err := c.secretSnapshotLister.Secrets(c.rollout.Namespace).List(selector)
+	if err != nil {
+		return err
+	}
+	for _, secret := range secrets {
+		owners, referenced := desiredOwners(v1alpha1.ConfigRef{Kind: v1alpha1.ConfigRefKindSecret, Name: secret.Name})
+		if !referenced {
+			if err := c.kubeclientset.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: configSnapshotDeletedReason}, "Deleted Secret %s", secret.Name)
+			continue
+		}
+		if ownerReferencesEqual(secret.OwnerReferences, owners) {
+			continue
+		}
+		secretCopy := secret.DeepCopy()
+		secretCopy.OwnerReferences = owners
+		if _, err := c.kubeclientset.CoreV1().Secrets(secret.Namespace).Update(ctx, secretCopy, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func ownerReferencesEqual(a, b []metav1.OwnerReference) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	uids := map[types.UID]bool{}
+	for _, ref := range a {
+		uids[ref.UID] = true
+	}
+	for _, ref := range b {
+		if !uids[ref.UID] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,251 @@
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+func newConfigRefsRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: metav1.NamespaceDefault, UID: "rollout-uid"},
+		Spec: v1alpha1.RolloutSpec{
+			ConfigRefs: []v1alpha1.ConfigRef{
+				{Kind: v1alpha1.ConfigRefKindConfigMap, Name: "app-config"},
+				{Kind: v1alpha1.ConfigRefKindSecret, Name: "app-secret"},
+			},
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "app",
+						EnvFrom: []corev1.EnvFromSource{
+							{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "app-config"}}},
+							{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "shared-config"}}},
+						},
+					}},
+					Volumes: []corev1.Volume{{
+						Name:         "secret",
+						VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "app-secret"}},
+					}},
+				},
+			},
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{}},
+		},
+	}
+}
+
+func newConfigRefsRolloutContext(ro *v1alpha1.Rollout, objs ...runtime.Object) (*rolloutContext, *k8sfake.Clientset, kubeinformers.SharedInformerFactory) {
+	kubeclient := k8sfake.NewSimpleClientset(objs...)
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+	for _, obj := range objs {
+		if _, ok := obj.(metav1.Object).GetLabels()[configSnapshotOfLabelKey]; !ok {
+			continue
+		}
+		switch obj.(type) {
+		case *corev1.ConfigMap:
+			k8sI.Core().V1().ConfigMaps().Informer().GetIndexer().Add(obj)
+		case *corev1.Secret:
+			k8sI.Core().V1().Secrets().Informer().GetIndexer().Add(obj)
+		}
+	}
+	roCtx := &rolloutContext{
+		rollout: ro,
+		reconcilerBase: reconcilerBase{
+			argoprojclientset:       fake.NewSimpleClientset(ro),
+			kubeclientset:           kubeclient,
+			configMapSnapshotLister: k8sI.Core().V1().ConfigMaps().Lister(),
+			secretSnapshotLister:    k8sI.Core().V1().Secrets().Lister(),
+			recorder:                record.NewFakeEventRecorder(),
+			enqueueRolloutAfter:     func(obj any, duration time.Duration) {},
+		},
+	}
+	roCtx.log = logutil.WithRollout(ro)
+	return roCtx, kubeclient, k8sI
+}
+
+func newConfigRefsObjects(value string) []runtime.Object {
+	return []runtime.Object{
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-config", Namespace: metav1.NamespaceDefault},
+			Data:       map[string]string{"key": value},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-secret", Namespace: metav1.NamespaceDefault},
+			Data:       map[string][]byte{"password": []byte("secret")},
+		},
+	}
+}
+
+func TestResolveConfigRefs(t *testing.T) {
+	ro := newConfigRefsRollout()
+	roCtx, kubeclient, k8sI := newConfigRefsRolloutContext(ro, newConfigRefsObjects("v1")...)
+
+	assert.NoError(t, roCtx.resolveConfigRefs(ro))
+
+	cmSnapshot := ro.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name
+	assert.Regexp(t, "^app-config-", cmSnapshot)
+	assert.Equal(t, "shared-config", ro.Spec.Template.Spec.Containers[0].EnvFrom[1].ConfigMapRef.Name)
+	secretSnapshot := ro.Spec.Template.Spec.Volumes[0].Secret.SecretName
+	assert.Regexp(t, "^app-secret-", secretSnapshot)
+	assert.Equal(t, "app-config", ro.Spec.UnresolvedConfigTemplate.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name)
+
+	cm, err := kubeclient.CoreV1().ConfigMaps(ro.Namespace).Get(context.TODO(), cmSnapshot, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "v1", cm.Data["key"])
+	assert.True(t, *cm.Immutable)
+	assert.Equal(t, "app", cm.Labels[configSnapshotOfLabelKey])
+	assert.Equal(t, "app-config", cm.Annotations[configSourceAnnotationKey])
+	assert.Equal(t, ro.UID, cm.OwnerReferences[0].UID)
+	secret, err := kubeclient.CoreV1().Secrets(ro.Namespace).Get(context.TODO(), secretSnapshot, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), secret.Data["password"])
+
+	// resolving again is idempotent, whether the snapshots are in the cache or not
+	podHash := hash.ComputePodTemplateHash(&ro.Spec.Template, nil)
+	assert.NoError(t, roCtx.resolveConfigRefs(ro))
+	assert.Equal(t, podHash, hash.ComputePodTemplateHash(&ro.Spec.Template, nil))
+	k8sI.Core().V1().ConfigMaps().Informer().GetIndexer().Add(cm)
+	k8sI.Core().V1().Secrets().Informer().GetIndexer().Add(secret)
+	kubeclient.ClearActions()
+	assert.NoError(t, roCtx.resolveConfigRefs(ro))
+	assert.Equal(t, podHash, hash.ComputePodTemplateHash(&ro.Spec.Template, nil))
+	for _, action := range kubeclient.Actions() {
+		assert.Equal(t, "get", action.GetVerb())
+	}
+
+	// a change of the data creates a new snapshot and a new pod template hash
+	cm, err = kubeclient.CoreV1().ConfigMaps(ro.Namespace).Get(context.TODO(), "app-config", metav1.GetOptions{})
+	assert.NoError(t, err)
+	cm.Data["key"] = "v2"
+	_, err = kubeclient.CoreV1().ConfigMaps(ro.Namespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, roCtx.resolveConfigRefs(ro))
+	assert.NotEqual(t, cmSnapshot, ro.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name)
+	assert.NotEqual(t, podHash, hash.ComputePodTemplateHash(&ro.Spec.Template, nil))
+}
+
+func TestResolveConfigRefsMissingConfig(t *testing.T) {
+	ro := newConfigRefsRollout()
+	roCtx, _, _ := newConfigRefsRolloutContext(ro)
+	assert.Error(t, roCtx.resolveConfigRefs(ro))
+}
+
+func TestVisitPodSpecConfigRefs(t *testing.T) {
+	spec := corev1.PodSpec{
+		InitContainers: []corev1.Container{{
+			Env: []corev1.EnvVar{
+				{Name: "A", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "cm-env"}}}},
+				{Name: "B", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "secret-env"}}}},
+				{Name: "C", Value: "literal"},
+			},
+		}},
+		Containers: []corev1.Container{{
+			EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "secret-env-from"}}}},
+		}},
+		Volumes: []corev1.Volume{
+			{VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "cm-volume"}}}},
+			{VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{
+				{ConfigMap: &corev1.ConfigMapProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "cm-projected"}}},
+				{Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "secret-projected"}}},
+			}}}},
+		},
+	}
+	var refs []v1alpha1.ConfigRef
+	visitPodSpecConfigRefs(&spec, func(kind v1alpha1.ConfigRefKind, name *string) {
+		refs = append(refs, v1alpha1.ConfigRef{Kind: kind, Name: *name})
+		*name = *name + "-snapshot"
+	})
+	assert.ElementsMatch(t, []v1alpha1.ConfigRef{
+		{Kind: v1alpha1.ConfigRefKindConfigMap, Name: "cm-env"},
+		{Kind: v1alpha1.ConfigRefKindSecret, Name: "secret-env"},
+		{Kind: v1alpha1.ConfigRefKindSecret, Name: "secret-env-from"},
+		{Kind: v1alpha1.ConfigRefKindConfigMap, Name: "cm-volume"},
+		{Kind: v1alpha1.ConfigRefKindConfigMap, Name: "cm-projected"},
+		{Kind: v1alpha1.ConfigRefKindSecret, Name: "secret-projected"},
+	}, refs)
+	assert.Equal(t, "cm-projected-snapshot", spec.Volumes[1].Projected.Sources[0].ConfigMap.Name)
+	assert.Equal(t, "secret-env-snapshot", spec.InitContainers[0].Env[1].ValueFrom.SecretKeyRef.Name)
+}
+
+func TestReconcileConfigSnapshots(t *testing.T) {
+	ro := newConfigRefsRollout()
+	snapshot := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       ro.Namespace,
+			Labels:          map[string]string{configSnapshotOfLabelKey: ro.Name},
+			OwnerReferences: []metav1.OwnerReference{rolloutOwnerReference(ro)},
+		}}
+	}
+	ro.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name = "app-config-new"
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-abc", Namespace: ro.Namespace, UID: "rs-uid"},
+		Spec:       appsv1.ReplicaSetSpec{Template: *ro.Spec.Template.DeepCopy()},
+	}
+	rs.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name = "app-config-old"
+	roCtx, kubeclient, _ := newConfigRefsRolloutContext(ro, snapshot("app-config-old"), snapshot("app-config-new"), snapshot("app-config-unused"))
+	roCtx.allRSs = []*appsv1.ReplicaSet{rs}
+
+	assert.NoError(t, roCtx.reconcileConfigSnapshots())
+
+	oldSnapshot, err := kubeclient.CoreV1().ConfigMaps(ro.Namespace).Get(context.TODO(), "app-config-old", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, oldSnapshot.OwnerReferences, 1)
+	assert.Equal(t, "ReplicaSet", oldSnapshot.OwnerReferences[0].Kind)
+	assert.Equal(t, rs.UID, oldSnapshot.OwnerReferences[0].UID)
+	newSnapshot, err := kubeclient.CoreV1().ConfigMaps(ro.Namespace).Get(context.TODO(), "app-config-new", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, ro.UID, newSnapshot.OwnerReferences[0].UID)
+	_, err = kubeclient.CoreV1().ConfigMaps(ro.Namespace).Get(context.TODO(), "app-config-unused", metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestConfigSnapshotEventHandler(t *testing.T) {
+	ro := newConfigRefsRollout()
+	rolloutsIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.NoError(t, rolloutsIndexer.Add(ro))
+
+	var enqueued []string
+	c := &Controller{reconcilerBase: reconcilerBase{
+		rolloutsLister: listers.NewRolloutLister(rolloutsIndexer),
+		enqueueRollout: func(obj any) {
+			enqueued = append(enqueued, obj.(*v1alpha1.Rollout).Name)
+		},
+	}}
+	handler := c.configSnapshotEventHandler()
+	snapshot := &corev1.ConfigMap{ObjectMeta: configSnapshotMeta(ro, "app-config", "abc")}
+	snapshot.ResourceVersion = "1"
+
+	handler.OnAdd(snapshot, false)
+	assert.Equal(t, []string{"app"}, enqueued)
+
+	// a resync does not enqueue the Rollout
+	handler.OnUpdate(snapshot, snapshot)
+	assert.Len(t, enqueued, 1)
+
+	// the deletion of a snapshot enqueues its Rollout
+	handler.OnDelete(cache.DeletedFinalStateUnknown{Key: "default/app-config-abc", Obj: snapshot})
+	assert.Equal(t, []string{"app", "app"}, enqueued)
+
+	// the snapshots of unknown Rollouts and the objects without the snapshot label are ignored
+	other := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: metav1.NamespaceDefault}}
+	handler.OnAdd(&corev1.ConfigMap{ObjectMeta: configSnapshotMeta(other, "other-config", "abc")}, false)
+	handler.OnAdd(newConfigRefsObjects("v1")[0], false)
+	assert.Len(t, enqueued, 2)
+}
@@ -1,16 +1,21 @@
 package rollout
 
 import (
+	"context"
+
 	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 )
 
 type rolloutContext struct {
 	reconcilerBase
 
+	// ctx is the context of the reconciliation, which carries its tracing span
+	ctx context.Context
 	log *log.Entry
 	// rollout is the rollout being reconciled
 	rollout *v1alpha1.Rollout
@@ -47,6 +52,30 @@ type rolloutContext struct {
 	// (e.g. a setWeight step, after a blue-green active switch, after stable service switch),
 	// since we do not want to continually verify weight in case it could incur rate-limiting or other expenses.
 	targetsVerified *bool
+
+	// waitingForPreRolloutHook indicates the ReplicaSet of a new revision is not created until its preRollout hook
+	// succeeds
+	waitingForPreRolloutHook bool
+
+	// workloadReplicas is the number of desired pods when it is not the replicas of the spec: the number of nodes
+	// running a DaemonSet canary
+	workloadReplicas *int32
+}
+
+// desiredReplicas returns the number of desired pods of the Rollout
+func (c *rolloutContext) desiredReplicas() int32 {
+	if c.workloadReplicas != nil {
+		return *c.workloadReplicas
+	}
+	return defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+}
+
+// context returns the context of the requests made during the reconciliation
+func (c *rolloutContext) context() context.Context {
+	if c.ctx == nil {
+		return context.TODO()
+	}
+	return c.ctx
 }
 
 func (c *rolloutContext) reconcile() error {
@@ -55,6 +84,16 @@ func (c *rolloutContext) reconcile() error {
 		return err
 	}
 
+	if c.waitingForPreRolloutHook {
+		return c.syncRolloutStatusPreRolloutHook()
+	}
+	if c.rollout.Spec.IsStatefulSetWorkload() {
+		return c.rolloutStatefulSet()
+	}
+	if c.rollout.Spec.IsDaemonSetWorkload() {
+		return c.rolloutDaemonSet()
+	}
+
 	isScalingEvent, err := c.isScalingEvent()
 	if err != nil {
 		return err
@@ -125,6 +164,14 @@ func (c *rolloutContext) SetCurrentAnalysisRuns(currARs analysisutil.CurrentAnal
 				Message: currPostPromoAr.Status.Message,
 			}
 		}
+		currTrafficStepAr := currARs.BlueGreenTrafficStep
+		if currTrafficStepAr != nil {
+			c.newStatus.BlueGreen.TrafficStepAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+				Name:    currTrafficStepAr.Name,
+				Status:  currTrafficStepAr.Status.Phase,
+				Message: currTrafficStepAr.Status.Message,
+			}
+		}
 	}
 }
 
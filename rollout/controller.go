@@ -13,11 +13,14 @@ import (
 
 	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -47,8 +50,13 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/contour"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/dns"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/kong"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/linkerd"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
@@ -61,11 +69,14 @@ import (
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 )
 
 type TemplateRefResolver interface {
 	Resolve(r *v1alpha1.Rollout) error
+	// GetResource returns a resource referenced by a Rollout, such as the resource of a waitFor step
+	GetResource(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error)
 }
 
 // Controller is the controller implementation for Rollout resources
@@ -87,34 +98,53 @@ type Controller struct {
 	rolloutWorkqueue workqueue.RateLimitingInterface
 	serviceWorkqueue workqueue.RateLimitingInterface
 	ingressWorkqueue workqueue.RateLimitingInterface
+
+	// workloadInformers are started the first time a Rollout progresses a StatefulSet or uses DaemonSet canaries
+	workloadInformers *controllerutil.LazyInformerFactory
 }
 
 // ControllerConfig describes the data required to instantiate a new rollout controller
 type ControllerConfig struct {
-	Namespace                       string
-	KubeClientSet                   kubernetes.Interface
-	ArgoProjClientset               clientset.Interface
-	DynamicClientSet                dynamic.Interface
-	RefResolver                     TemplateRefResolver
-	SmiClientSet                    smiclientset.Interface
-	ExperimentInformer              informers.ExperimentInformer
-	AnalysisRunInformer             informers.AnalysisRunInformer
-	AnalysisTemplateInformer        informers.AnalysisTemplateInformer
-	ClusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer
-	ReplicaSetInformer              appsinformers.ReplicaSetInformer
-	ServicesInformer                coreinformers.ServiceInformer
-	IngressWrapper                  IngressWrapper
-	RolloutsInformer                informers.RolloutInformer
-	IstioPrimaryDynamicClient       dynamic.Interface
-	IstioVirtualServiceInformer     cache.SharedIndexInformer
-	IstioDestinationRuleInformer    cache.SharedIndexInformer
-	ResyncPeriod                    time.Duration
-	RolloutWorkQueue                workqueue.RateLimitingInterface
-	ServiceWorkQueue                workqueue.RateLimitingInterface
-	IngressWorkQueue                workqueue.RateLimitingInterface
-	MetricsServer                   *metrics.MetricsServer
-	Recorder                        record.EventRecorder
-	EphemeralMetadataThreads        int
+	Namespace                              string
+	KubeClientSet                          kubernetes.Interface
+	ArgoProjClientset                      clientset.Interface
+	DynamicClientSet                       dynamic.Interface
+	RefResolver                            TemplateRefResolver
+	SmiClientSet                           smiclientset.Interface
+	ExperimentInformer                     informers.ExperimentInformer
+	AnalysisRunInformer                    informers.AnalysisRunInformer
+	AnalysisTemplateInformer               informers.AnalysisTemplateInformer
+	ClusterAnalysisTemplateInformer        informers.ClusterAnalysisTemplateInformer
+	RolloutPolicyInformer                  informers.RolloutPolicyInformer
+	ClusterRolloutPolicyInformer           informers.ClusterRolloutPolicyInformer
+	RolloutStrategyTemplateInformer        informers.RolloutStrategyTemplateInformer
+	ClusterRolloutStrategyTemplateInformer informers.ClusterRolloutStrategyTemplateInformer
+	ReplicaSetInformer                     appsinformers.ReplicaSetInformer
+	ServicesInformer                       coreinformers.ServiceInformer
+	PodInformer                            coreinformers.PodInformer
+	StatefulSetInformer                    appsinformers.StatefulSetInformer
+	ControllerRevisionInformer             appsinformers.ControllerRevisionInformer
+	DaemonSetInformer                      appsinformers.DaemonSetInformer
+	NodeInformer                           coreinformers.NodeInformer
+	// ConfigMapSnapshotInformer and SecretSnapshotInformer must be restricted to the snapshots of the ConfigRefs
+	// (see ConfigSnapshotsTweakListOptions)
+	ConfigMapSnapshotInformer coreinformers.ConfigMapInformer
+	SecretSnapshotInformer    coreinformers.SecretInformer
+	// WorkloadInformerFactory starts the pod, StatefulSet, ControllerRevision, DaemonSet and node informers once a
+	// Rollout needs them
+	WorkloadInformerFactory      *controllerutil.LazyInformerFactory
+	IngressWrapper               IngressWrapper
+	RolloutsInformer             informers.RolloutInformer
+	IstioPrimaryDynamicClient    dynamic.Interface
+	IstioVirtualServiceInformer  cache.SharedIndexInformer
+	IstioDestinationRuleInformer cache.SharedIndexInformer
+	ResyncPeriod                 time.Duration
+	RolloutWorkQueue             workqueue.RateLimitingInterface
+	ServiceWorkQueue             workqueue.RateLimitingInterface
+	IngressWorkQueue             workqueue.RateLimitingInterface
+	MetricsServer                *metrics.MetricsServer
+	Recorder                     record.EventRecorder
+	EphemeralMetadataThreads     int
 }
 
 // reconcilerBase is a shared datastructure containing all clients and configuration necessary to
@@ -131,20 +161,31 @@ type reconcilerBase struct {
 
 	refResolver TemplateRefResolver
 
-	replicaSetLister              appslisters.ReplicaSetLister
-	replicaSetSynced              cache.InformerSynced
-	rolloutsInformer              cache.SharedIndexInformer
-	rolloutsLister                listers.RolloutLister
-	replicaSetInformer            cache.SharedIndexInformer
-	rolloutsSynced                cache.InformerSynced
-	rolloutsIndexer               cache.Indexer
-	servicesLister                v1.ServiceLister
-	ingressWrapper                IngressWrapper
-	experimentsLister             listers.ExperimentLister
-	analysisRunLister             listers.AnalysisRunLister
-	analysisTemplateLister        listers.AnalysisTemplateLister
-	clusterAnalysisTemplateLister listers.ClusterAnalysisTemplateLister
-	IstioController               *istio.IstioController
+	replicaSetLister                     appslisters.ReplicaSetLister
+	replicaSetSynced                     cache.InformerSynced
+	rolloutsInformer                     cache.SharedIndexInformer
+	rolloutsLister                       listers.RolloutLister
+	replicaSetInformer                   cache.SharedIndexInformer
+	rolloutsSynced                       cache.InformerSynced
+	rolloutsIndexer                      cache.Indexer
+	servicesLister                       v1.ServiceLister
+	podLister                            v1.PodLister
+	statefulSetLister                    appslisters.StatefulSetLister
+	controllerRevisionLister             appslisters.ControllerRevisionLister
+	daemonSetLister                      appslisters.DaemonSetLister
+	nodeLister                           v1.NodeLister
+	configMapSnapshotLister              v1.ConfigMapLister
+	secretSnapshotLister                 v1.SecretLister
+	ingressWrapper                       IngressWrapper
+	experimentsLister                    listers.ExperimentLister
+	analysisRunLister                    listers.AnalysisRunLister
+	analysisTemplateLister               listers.AnalysisTemplateLister
+	clusterAnalysisTemplateLister        listers.ClusterAnalysisTemplateLister
+	rolloutPolicyLister                  listers.RolloutPolicyLister
+	clusterRolloutPolicyLister           listers.ClusterRolloutPolicyLister
+	rolloutStrategyTemplateLister        listers.RolloutStrategyTemplateLister
+	clusterRolloutStrategyTemplateLister listers.ClusterRolloutStrategyTemplateLister
+	IstioController                      *istio.IstioController
 
 	podRestarter RolloutPodRestarter
 
@@ -181,28 +222,42 @@ func NewController(cfg ControllerConfig) *Controller {
 		},
 	}
 	base := reconcilerBase{
-		kubeclientset:                 cfg.KubeClientSet,
-		argoprojclientset:             cfg.ArgoProjClientset,
-		dynamicclientset:              cfg.DynamicClientSet,
-		smiclientset:                  cfg.SmiClientSet,
-		replicaSetLister:              cfg.ReplicaSetInformer.Lister(),
-		replicaSetSynced:              cfg.ReplicaSetInformer.Informer().HasSynced,
-		rolloutsInformer:              cfg.RolloutsInformer.Informer(),
-		replicaSetInformer:            cfg.ReplicaSetInformer.Informer(),
-		rolloutsIndexer:               cfg.RolloutsInformer.Informer().GetIndexer(),
-		rolloutsLister:                cfg.RolloutsInformer.Lister(),
-		rolloutsSynced:                cfg.RolloutsInformer.Informer().HasSynced,
-		servicesLister:                cfg.ServicesInformer.Lister(),
-		ingressWrapper:                cfg.IngressWrapper,
-		experimentsLister:             cfg.ExperimentInformer.Lister(),
-		analysisRunLister:             cfg.AnalysisRunInformer.Lister(),
-		analysisTemplateLister:        cfg.AnalysisTemplateInformer.Lister(),
-		clusterAnalysisTemplateLister: cfg.ClusterAnalysisTemplateInformer.Lister(),
-		recorder:                      cfg.Recorder,
-		resyncPeriod:                  cfg.ResyncPeriod,
-		podRestarter:                  podRestarter,
-		refResolver:                   cfg.RefResolver,
-		ephemeralMetadataThreads:      cfg.EphemeralMetadataThreads,
+		kubeclientset:                        cfg.KubeClientSet,
+		argoprojclientset:                    cfg.ArgoProjClientset,
+		dynamicclientset:                     cfg.DynamicClientSet,
+		smiclientset:                         cfg.SmiClientSet,
+		replicaSetLister:                     cfg.ReplicaSetInformer.Lister(),
+		replicaSetSynced:                     cfg.ReplicaSetInformer.Informer().HasSynced,
+		rolloutsInformer:                     cfg.RolloutsInformer.Informer(),
+		replicaSetInformer:                   cfg.ReplicaSetInformer.Informer(),
+		rolloutsIndexer:                      cfg.RolloutsInformer.Informer().GetIndexer(),
+		rolloutsLister:                       cfg.RolloutsInformer.Lister(),
+		rolloutsSynced:                       cfg.RolloutsInformer.Informer().HasSynced,
+		servicesLister:                       cfg.ServicesInformer.Lister(),
+		podLister:                            cfg.PodInformer.Lister(),
+		statefulSetLister:                    cfg.StatefulSetInformer.Lister(),
+		controllerRevisionLister:             cfg.ControllerRevisionInformer.Lister(),
+		daemonSetLister:                      cfg.DaemonSetInformer.Lister(),
+		configMapSnapshotLister:              cfg.ConfigMapSnapshotInformer.Lister(),
+		secretSnapshotLister:                 cfg.SecretSnapshotInformer.Lister(),
+		ingressWrapper:                       cfg.IngressWrapper,
+		experimentsLister:                    cfg.ExperimentInformer.Lister(),
+		analysisRunLister:                    cfg.AnalysisRunInformer.Lister(),
+		analysisTemplateLister:               cfg.AnalysisTemplateInformer.Lister(),
+		clusterAnalysisTemplateLister:        cfg.ClusterAnalysisTemplateInformer.Lister(),
+		rolloutPolicyLister:                  cfg.RolloutPolicyInformer.Lister(),
+		clusterRolloutPolicyLister:           cfg.ClusterRolloutPolicyInformer.Lister(),
+		rolloutStrategyTemplateLister:        cfg.RolloutStrategyTemplateInformer.Lister(),
+		clusterRolloutStrategyTemplateLister: cfg.ClusterRolloutStrategyTemplateInformer.Lister(),
+		recorder:                             cfg.Recorder,
+		resyncPeriod:                         cfg.ResyncPeriod,
+		podRestarter:                         podRestarter,
+		refResolver:                          cfg.RefResolver,
+		ephemeralMetadataThreads:             cfg.EphemeralMetadataThreads,
+	}
+
+	if cfg.NodeInformer != nil {
+		base.nodeLister = cfg.NodeInformer.Lister()
 	}
 
 	controller := &Controller{
@@ -213,6 +268,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		serviceWorkqueue:  cfg.ServiceWorkQueue,
 		ingressWorkqueue:  cfg.IngressWorkQueue,
 		metricsServer:     cfg.MetricsServer,
+		workloadInformers: cfg.WorkloadInformerFactory,
 	}
 	controller.enqueueRollout = func(obj any) {
 		controllerutil.EnqueueRateLimited(obj, cfg.RolloutWorkQueue)
@@ -301,6 +357,45 @@ func NewController(cfg ControllerConfig) *Controller {
 		},
 	})
 
+	cfg.DaemonSetInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
+		},
+		UpdateFunc: func(old, new any) {
+			if new.(*appsv1.DaemonSet).ResourceVersion == old.(*appsv1.DaemonSet).ResourceVersion {
+				return
+			}
+			controllerutil.EnqueueParentObject(new, register.RolloutKind, controller.enqueueRollout)
+		},
+		DeleteFunc: func(obj any) {
+			controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
+		},
+	})
+
+	if cfg.NodeInformer != nil {
+		cfg.NodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj any) {
+				controller.enqueueDaemonSetRollouts()
+			},
+			UpdateFunc: func(old, new any) {
+				oldNode := old.(*corev1.Node)
+				newNode := new.(*corev1.Node)
+				// The status of the nodes is updated periodically, while only their labels and taints select the
+				// nodes of the DaemonSet canaries
+				if reflect.DeepEqual(oldNode.Labels, newNode.Labels) && reflect.DeepEqual(oldNode.Spec.Taints, newNode.Spec.Taints) {
+					return
+				}
+				controller.enqueueDaemonSetRollouts()
+			},
+			DeleteFunc: func(obj any) {
+				controller.enqueueDaemonSetRollouts()
+			},
+		})
+	}
+
+	cfg.ConfigMapSnapshotInformer.Informer().AddEventHandler(controller.configSnapshotEventHandler())
+	cfg.SecretSnapshotInformer.Informer().AddEventHandler(controller.configSnapshotEventHandler())
+
 	cfg.AnalysisRunInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj any) {
 			controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
@@ -325,6 +420,52 @@ func NewController(cfg ControllerConfig) *Controller {
 	return controller
 }
 
+// enqueueDaemonSetRollouts enqueues the Rollouts with the DaemonSet canary strategy, whose nodes may have changed
+func (c *Controller) enqueueDaemonSetRollouts() {
+	rollouts, err := c.rolloutsLister.List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list the Rollouts with a DaemonSet canary: %v", err)
+		return
+	}
+	for _, ro := range rollouts {
+		if ro.Spec.IsDaemonSetWorkload() {
+			c.enqueueRollout(ro)
+		}
+	}
+}
+
+// configSnapshotEventHandler enqueues the Rollout of a snapshot of a ConfigRef when the snapshot changes
+func (c *Controller) configSnapshotEventHandler() cache.ResourceEventHandlerFuncs {
+	enqueue := func(obj any) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		meta, err := apimeta.Accessor(obj)
+		if err != nil {
+			return
+		}
+		rolloutName, ok := meta.GetLabels()[configSnapshotOfLabelKey]
+		if !ok {
+			return
+		}
+		if ro, err := c.rolloutsLister.Rollouts(meta.GetNamespace()).Get(rolloutName); err == nil {
+			c.enqueueRollout(ro)
+		}
+	}
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(old, new any) {
+			oldMeta, oldErr := apimeta.Accessor(old)
+			newMeta, newErr := apimeta.Accessor(new)
+			if oldErr != nil || newErr != nil || oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				return
+			}
+			enqueue(new)
+		},
+		DeleteFunc: enqueue,
+	}
+}
+
 // removedKeys returns list of indexer keys which have been removed from the old rollout
 func removedKeys(name string, old, new *v1alpha1.Rollout, keyFunc func(ro *v1alpha1.Rollout) []string) []string {
 	oldKeys := keyFunc(old)
@@ -375,12 +516,14 @@ func (c *Controller) Run(ctx context.Context, threadiness int) error {
 // syncHandler compares the actual state with the desired, and attempts to
 // converge the two. It then updates the Phase block of the Rollout resource
 // with the current status of the resource.
-func (c *Controller) syncHandler(ctx context.Context, key string) error {
+func (c *Controller) syncHandler(ctx context.Context, key string) (err error) {
 	startTime := timeutil.Now()
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
 		return err
 	}
+	ctx, span := tracing.StartSpan(ctx, "rollout.reconcile", attribute.String("rollout.namespace", namespace), attribute.String("rollout.name", name))
+	defer func() { tracing.EndSpan(span, err) }()
 	rollout, err := c.rolloutsLister.Rollouts(namespace).Get(name)
 	if k8serrors.IsNotFound(err) {
 		return nil
@@ -395,6 +538,7 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 	r := remarshalRollout(rollout)
 	logCtx := logutil.WithRollout(r)
 	logCtx = logutil.WithVersionFields(logCtx, r)
+	logCtx = logutil.WithTrace(ctx, logCtx)
 	logCtx.Info("Started syncing rollout")
 
 	if r.ObjectMeta.DeletionTimestamp != nil {
@@ -409,6 +553,24 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 	}()
 
 	resolveErr := c.refResolver.Resolve(r)
+	if resolveErr == nil {
+		resolveErr = c.resolveConfigRefs(r)
+	}
+	var strategyTemplate *v1alpha1.RolloutStrategyTemplateStatus
+	if resolveErr == nil {
+		strategyTemplate, resolveErr = c.resolveStrategyRef(r)
+	}
+	if resolveErr != nil && r.Spec.StrategyRef != nil && !r.Spec.StrategyResolvedFromRef {
+		// The ReplicaSet of a new revision cannot be created without the strategy, so the rollout is not reconciled
+		roCtx := &rolloutContext{rollout: r, log: logCtx, reconcilerBase: c.reconcilerBase}
+		if err := roCtx.createInvalidRolloutCondition(resolveErr, r, conditions.InvalidSpecReason); err != nil {
+			return fmt.Errorf("failed to create invalid rollout condition during resolving the rollout: %w", err)
+		}
+		return fmt.Errorf("failed to resolve rollout: %w", resolveErr)
+	}
+	if err := c.startWorkloadInformers(ctx, r); err != nil {
+		return err
+	}
 	// We could maybe lose setting the error condition from the below if resolveErr != nil {}, and just log the error to clean up the logic
 	roCtx, err := c.newRolloutContext(r)
 	if roCtx == nil {
@@ -425,10 +587,14 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 		logCtx.Errorf("newRolloutContext err %v", err)
 		return err
 	}
+	// the requests of the reconciliation are not cancelled on shutdown, only traced as part of its span
+	roCtx.ctx = context.WithoutCancel(ctx)
+	roCtx.log = logutil.WithTrace(ctx, roCtx.log)
+	roCtx.newStatus.StrategyTemplate = strategyTemplate
 	// We should probably delete this if block and just log the error to clean up the logic, a bigger change would be to add a new
 	// field to the status maybe (reconcileErrMsg) and store the errors there from the processNextWorkItem function in controller/controller.go
 	if resolveErr != nil {
-		err := roCtx.createInvalidRolloutCondition(resolveErr, r)
+		err := roCtx.createInvalidRolloutCondition(resolveErr, r, conditions.InvalidSpecReason)
 		if err != nil {
 			return fmt.Errorf("failed to create invalid rollout condition during resolving the rollout: %w", err)
 		}
@@ -462,6 +628,16 @@ func (c *Controller) syncHandler(ctx context.Context, key string) error {
 	return nil
 }
 
+// startWorkloadInformers starts the pod, StatefulSet, ControllerRevision, DaemonSet and node informers the first time
+// a Rollout progresses a StatefulSet or uses DaemonSet canaries, so that the controllers without such Rollouts do not
+// watch all the pods and nodes of the cluster
+func (c *Controller) startWorkloadInformers(ctx context.Context, r *v1alpha1.Rollout) error {
+	if !r.Spec.IsStatefulSetWorkload() && !r.Spec.IsDaemonSetWorkload() {
+		return nil
+	}
+	return c.workloadInformers.Start(ctx)
+}
+
 // writeBackToInformer writes a just recently updated Rollout back into the informer cache.
 // This prevents the situation where the controller operates on a stale rollout and repeats work
 func (c *Controller) writeBackToInformer(ro *v1alpha1.Rollout) {
@@ -535,6 +711,8 @@ func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutConte
 			RestartedAt: rollout.Status.RestartedAt,
 			ALB:         rollout.Status.ALB,
 			ALBs:        rollout.Status.ALBs,
+			Hooks:       append([]v1alpha1.RolloutHookStatus(nil), rollout.Status.Hooks...),
+			CurrentStep: rollout.Status.CurrentStep.DeepCopy(),
 		},
 		pauseContext: &pauseContext{
 			rollout: rollout,
@@ -551,7 +729,19 @@ func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutConte
 	err = roCtx.getRolloutValidationErrors()
 	if err != nil {
 		if vErr, ok := err.(*field.Error); ok {
-			err := roCtx.createInvalidRolloutCondition(vErr, roCtx.rollout)
+			err := roCtx.createInvalidRolloutCondition(vErr, roCtx.rollout, conditions.InvalidSpecReason)
+			if err != nil {
+				return nil, err
+			}
+			return nil, vErr
+		}
+		return nil, err
+	}
+
+	err = roCtx.enforcePolicies()
+	if err != nil {
+		if vErr, ok := err.(*field.Error); ok {
+			err := roCtx.createInvalidRolloutCondition(vErr, roCtx.rollout, conditions.RolloutPolicyViolationReason)
 			if err != nil {
 				return nil, err
 			}
@@ -560,7 +750,14 @@ func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutConte
 		return nil, err
 	}
 
-	if roCtx.newRS == nil {
+	if roCtx.newRS == nil && rollout.Spec.Hooks != nil && rollout.Spec.Hooks.PreRollout != nil {
+		roCtx.waitingForPreRolloutHook, err = roCtx.reconcilePreRolloutHook()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if roCtx.newRS == nil && !roCtx.waitingForPreRolloutHook && !rollout.Spec.IsStatefulSetWorkload() && !rollout.Spec.IsDaemonSetWorkload() {
 		roCtx.newRS, err = roCtx.createDesiredReplicaSet()
 		if err != nil {
 			return nil, err
@@ -577,6 +774,8 @@ func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutConte
 	}
 	// carry over existing recorded weights
 	roCtx.newStatus.Canary.Weights = rollout.Status.Canary.Weights
+	roCtx.newStatus.BlueGreen.Weights = rollout.Status.BlueGreen.Weights
+	roCtx.newStatus.BlueGreen.TrafficStepIndex = rollout.Status.BlueGreen.TrafficStepIndex
 	return &roCtx, nil
 }
 
@@ -598,19 +797,48 @@ func (c *rolloutContext) getRolloutValidationErrors() error {
 	return nil
 }
 
-func (c *rolloutContext) createInvalidRolloutCondition(validationError error, r *v1alpha1.Rollout) error {
+// ValidateRolloutReferences validates the resources referenced by a Rollout against the informer caches, as done at
+// the start of each reconciliation. It is used by the admission webhook to reject Rollouts with invalid references.
+func (c *Controller) ValidateRolloutReferences(rollout *v1alpha1.Rollout) error {
+	r := remarshalRollout(rollout)
+	if err := c.refResolver.Resolve(r); err != nil {
+		return err
+	}
+	if ref := r.Spec.StrategyRef; ref != nil {
+		// the strategy is not pinned, since the Rollout may not be admitted
+		_, strategy, err := c.getStrategyTemplate(r.Namespace, *ref)
+		if err != nil {
+			return err
+		}
+		if err := setStrategyFromTemplate(r, strategy); err != nil {
+			return err
+		}
+	}
+	roCtx := &rolloutContext{
+		rollout:        r,
+		log:            logutil.WithRollout(r),
+		reconcilerBase: c.reconcilerBase,
+	}
+	refResources, err := roCtx.getRolloutReferencedResources()
+	if err != nil {
+		return err
+	}
+	return validation.ValidateRolloutReferencedResources(r, *refResources).ToAggregate()
+}
+
+func (c *rolloutContext) createInvalidRolloutCondition(validationError error, r *v1alpha1.Rollout, reason string) error {
 	prevCond := conditions.GetRolloutCondition(r.Status, v1alpha1.InvalidSpec)
 	invalidSpecCond := prevCond
 	errorMessage := fmt.Sprintf("The Rollout \"%s\" is invalid: %s", r.Name, validationError.Error())
-	if prevCond == nil || prevCond.Message != errorMessage {
-		invalidSpecCond = conditions.NewRolloutCondition(v1alpha1.InvalidSpec, corev1.ConditionTrue, conditions.InvalidSpecReason, errorMessage)
+	if prevCond == nil || prevCond.Message != errorMessage || prevCond.Reason != reason {
+		invalidSpecCond = conditions.NewRolloutCondition(v1alpha1.InvalidSpec, corev1.ConditionTrue, reason, errorMessage)
 	}
 	c.log.Error(errorMessage)
 	if r.Status.ObservedGeneration != strconv.Itoa(int(r.Generation)) || !reflect.DeepEqual(invalidSpecCond, prevCond) {
 		newStatus := r.Status.DeepCopy()
 		// SetRolloutCondition only updates the condition when the status and/or reason changes, but
 		// the controller should update the invalidSpec if there is a change in why the spec is invalid
-		if prevCond != nil && prevCond.Message != invalidSpecCond.Message {
+		if prevCond != nil && (prevCond.Message != invalidSpecCond.Message || prevCond.Reason != invalidSpecCond.Reason) {
 			conditions.RemoveRolloutCondition(newStatus, v1alpha1.InvalidSpec)
 		}
 		err := c.patchCondition(r, newStatus, invalidSpecCond)
@@ -677,11 +905,35 @@ func (c *rolloutContext) getRolloutReferencedResources() (*validation.Referenced
 	}
 	refResources.AppMeshResources = appmeshResources
 
+	contourHTTPProxies, err := c.getContourHTTPProxies()
+	if err != nil {
+		return nil, err
+	}
+	refResources.ContourHTTPProxies = contourHTTPProxies
+
+	kongHTTPRoutes, err := c.getKongHTTPRoutes()
+	if err != nil {
+		return nil, err
+	}
+	refResources.KongHTTPRoutes = kongHTTPRoutes
+
+	linkerdHTTPRoutes, err := c.getLinkerdHTTPRoutes()
+	if err != nil {
+		return nil, err
+	}
+	refResources.LinkerdHTTPRoutes = linkerdHTTPRoutes
+
+	dnsEndpoints, err := c.getDNSEndpoints()
+	if err != nil {
+		return nil, err
+	}
+	refResources.DNSEndpoints = dnsEndpoints
+
 	return &refResources, nil
 }
 
 func (c *rolloutContext) getReferencedAppMeshResources() ([]unstructured.Unstructured, error) {
-	ctx := context.TODO()
+	ctx := c.context()
 	appmeshClient := appmesh.NewResourceClient(c.dynamicclientset)
 	rollout := c.rollout
 	refResources := []unstructured.Unstructured{}
@@ -741,6 +993,105 @@ func (c *rolloutContext) getAmbassadorMappings() ([]unstructured.Unstructured, e
 	return mappings, nil
 }
 
+func (c *rolloutContext) getContourHTTPProxies() ([]unstructured.Unstructured, error) {
+	httpProxies := []unstructured.Unstructured{}
+	if c.rollout.Spec.Strategy.Canary != nil {
+		canary := c.rollout.Spec.Strategy.Canary
+		if canary.TrafficRouting != nil && canary.TrafficRouting.Contour != nil {
+			fldPath := field.NewPath("spec", "strategy", "canary", "trafficRouting", "contour", "httpProxies")
+			if len(canary.TrafficRouting.Contour.HTTPProxies) == 0 {
+				return nil, field.Invalid(fldPath, nil, "must provide at least one httpProxy")
+			}
+			client := contour.NewDynamicClient(c.dynamicclientset, c.rollout.Namespace)
+			for _, name := range canary.TrafficRouting.Contour.HTTPProxies {
+				httpProxy, err := client.Get(context.Background(), name, metav1.GetOptions{})
+				if err != nil {
+					if k8serrors.IsNotFound(err) {
+						return nil, field.Invalid(fldPath, name, err.Error())
+					}
+					return nil, err
+				}
+				httpProxies = append(httpProxies, *httpProxy)
+			}
+		}
+	}
+	return httpProxies, nil
+}
+
+func (c *rolloutContext) getKongHTTPRoutes() ([]unstructured.Unstructured, error) {
+	httpRoutes := []unstructured.Unstructured{}
+	if c.rollout.Spec.Strategy.Canary != nil {
+		canary := c.rollout.Spec.Strategy.Canary
+		if canary.TrafficRouting != nil && canary.TrafficRouting.Kong != nil {
+			fldPath := field.NewPath("spec", "strategy", "canary", "trafficRouting", "kong", "httpRoutes")
+			if len(canary.TrafficRouting.Kong.HTTPRoutes) == 0 {
+				return nil, field.Invalid(fldPath, nil, "must provide at least one httpRoute")
+			}
+			client := kong.NewDynamicClient(c.dynamicclientset, c.rollout.Namespace)
+			for _, name := range canary.TrafficRouting.Kong.HTTPRoutes {
+				httpRoute, err := client.Get(context.Background(), name, metav1.GetOptions{})
+				if err != nil {
+					if k8serrors.IsNotFound(err) {
+						return nil, field.Invalid(fldPath, name, err.Error())
+					}
+					return nil, err
+				}
+				httpRoutes = append(httpRoutes, *httpRoute)
+			}
+		}
+	}
+	return httpRoutes, nil
+}
+
+func (c *rolloutContext) getLinkerdHTTPRoutes() ([]unstructured.Unstructured, error) {
+	httpRoutes := []unstructured.Unstructured{}
+	if c.rollout.Spec.Strategy.Canary != nil {
+		canary := c.rollout.Spec.Strategy.Canary
+		if canary.TrafficRouting != nil && canary.TrafficRouting.Linkerd != nil {
+			fldPath := field.NewPath("spec", "strategy", "canary", "trafficRouting", "linkerd", "httpRoutes")
+			if len(canary.TrafficRouting.Linkerd.HTTPRoutes) == 0 {
+				return nil, field.Invalid(fldPath, nil, "must provide at least one httpRoute")
+			}
+			client := linkerd.NewDynamicClient(c.dynamicclientset, c.rollout.Namespace)
+			for _, name := range canary.TrafficRouting.Linkerd.HTTPRoutes {
+				httpRoute, err := client.Get(context.Background(), name, metav1.GetOptions{})
+				if err != nil {
+					if k8serrors.IsNotFound(err) {
+						return nil, field.Invalid(fldPath, name, err.Error())
+					}
+					return nil, err
+				}
+				httpRoutes = append(httpRoutes, *httpRoute)
+			}
+		}
+	}
+	return httpRoutes, nil
+}
+
+func (c *rolloutContext) getDNSEndpoints() ([]unstructured.Unstructured, error) {
+	dnsEndpoints := []unstructured.Unstructured{}
+	if c.rollout.Spec.Strategy.Canary != nil {
+		canary := c.rollout.Spec.Strategy.Canary
+		if canary.TrafficRouting != nil && canary.TrafficRouting.DNS != nil {
+			fldPath := field.NewPath("spec", "strategy", "canary", "trafficRouting", "dns", "dnsEndpoint")
+			name := canary.TrafficRouting.DNS.DNSEndpoint
+			if name == "" {
+				return nil, field.Invalid(fldPath, nil, "must provide a dnsEndpoint")
+			}
+			client := dns.NewDynamicClient(c.dynamicclientset, c.rollout.Namespace)
+			dnsEndpoint, err := client.Get(context.Background(), name, metav1.GetOptions{})
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					return nil, field.Invalid(fldPath, name, err.Error())
+				}
+				return nil, err
+			}
+			dnsEndpoints = append(dnsEndpoints, *dnsEndpoint)
+		}
+	}
+	return dnsEndpoints, nil
+}
+
 func (c *rolloutContext) getReferencedServices() (*[]validation.ServiceWithType, error) {
 	var services []validation.ServiceWithType
 	if bluegreenSpec := c.rollout.Spec.Strategy.BlueGreen; bluegreenSpec != nil {
@@ -990,7 +1341,13 @@ func remarshalRollout(r *v1alpha1.Rollout) *v1alpha1.Rollout {
 // updateReplicaSet updates the replicaset using kubeclient update. It returns the updated replicaset and copies the updated replicaset
 // into the passed in pointer as well.
 func (c *rolloutContext) updateReplicaSet(ctx context.Context, rs *appsv1.ReplicaSet) (*appsv1.ReplicaSet, error) {
-	updatedRS, err := c.kubeclientset.AppsV1().ReplicaSets(rs.Namespace).Update(ctx, rs, metav1.UpdateOptions{})
+	var updatedRS *appsv1.ReplicaSet
+	var err error
+	if apply.Enabled() {
+		updatedRS, err = c.applyReplicaSet(ctx, rs)
+	} else {
+		updatedRS, err = c.kubeclientset.AppsV1().ReplicaSets(rs.Namespace).Update(ctx, rs, metav1.UpdateOptions{})
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error updating replicaset in updateReplicaSet %s: %w", rs.Name, err)
 	}
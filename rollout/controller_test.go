@@ -17,6 +17,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -44,6 +45,7 @@ import (
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	"github.com/argoproj/argo-rollouts/rollout/mocks"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
@@ -71,12 +73,22 @@ const (
 )
 
 type FakeWorkloadRefResolver struct {
+	resources []*unstructured.Unstructured
 }
 
 func (f *FakeWorkloadRefResolver) Resolve(_ *v1alpha1.Rollout) error {
 	return nil
 }
 
+func (f *FakeWorkloadRefResolver) GetResource(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	for _, un := range f.resources {
+		if un.GroupVersionKind() == gvk && un.GetNamespace() == namespace && un.GetName() == name {
+			return un, nil
+		}
+	}
+	return nil, k8serrors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, name)
+}
+
 func (f *FakeWorkloadRefResolver) Init() error {
 	return nil
 }
@@ -87,15 +99,21 @@ type fixture struct {
 	client     *fake.Clientset
 	kubeclient *k8sfake.Clientset
 	// Objects to put in the store.
-	rolloutLister                 []*v1alpha1.Rollout
-	experimentLister              []*v1alpha1.Experiment
-	analysisRunLister             []*v1alpha1.AnalysisRun
-	clusterAnalysisTemplateLister []*v1alpha1.ClusterAnalysisTemplate
-	analysisTemplateLister        []*v1alpha1.AnalysisTemplate
-	replicaSetLister              []*appsv1.ReplicaSet
-	serviceLister                 []*corev1.Service
-	ingressLister                 []*ingressutil.Ingress
-	virtualServiceLister          []*unstructured.Unstructured
+	rolloutLister                        []*v1alpha1.Rollout
+	experimentLister                     []*v1alpha1.Experiment
+	analysisRunLister                    []*v1alpha1.AnalysisRun
+	clusterAnalysisTemplateLister        []*v1alpha1.ClusterAnalysisTemplate
+	analysisTemplateLister               []*v1alpha1.AnalysisTemplate
+	rolloutPolicyLister                  []*v1alpha1.RolloutPolicy
+	clusterRolloutPolicyLister           []*v1alpha1.ClusterRolloutPolicy
+	rolloutStrategyTemplateLister        []*v1alpha1.RolloutStrategyTemplate
+	clusterRolloutStrategyTemplateLister []*v1alpha1.ClusterRolloutStrategyTemplate
+	replicaSetLister                     []*appsv1.ReplicaSet
+	serviceLister                        []*corev1.Service
+	ingressLister                        []*ingressutil.Ingress
+	virtualServiceLister                 []*unstructured.Unstructured
+	// refResources are the resources returned by the workload ref resolver, such as the resources of waitFor steps
+	refResources []*unstructured.Unstructured
 	// Actions expected to happen on the client.
 	kubeactions []core.Action
 	actions     []core.Action
@@ -427,6 +445,20 @@ func updateBlueGreenRolloutStatus(r *v1alpha1.Rollout, preview, active, stable s
 		newRollout.Status.ControllerPause = true
 		newRollout.Status.PauseConditions = append(newRollout.Status.PauseConditions, cond)
 	}
+	newRollout.Status.Lifecycle = newUpdateLifecycleStatus(newRollout)
+	if podHash := newRollout.Status.CurrentPodHash; newRollout.Status.Lifecycle != nil && active != "" {
+		step := prePromotionStep
+		if active == podHash {
+			step = postPromotionStep
+		}
+		if step == prePromotionStep || newRollout.Spec.Strategy.BlueGreen.PostPromotionAnalysis != nil {
+			newRollout.Status.CurrentStep = &v1alpha1.RolloutStepStatus{
+				Step:            step,
+				PodTemplateHash: podHash,
+				StartedAt:       timeutil.MetaNow(),
+			}
+		}
+	}
 	newRollout.Status.Phase, newRollout.Status.Message = rolloututil.CalculateRolloutPhase(r.Spec, newRollout.Status)
 	return newRollout
 }
@@ -442,10 +474,42 @@ func updateCanaryRolloutStatus(r *v1alpha1.Rollout, stableRS string, availableRe
 		newRollout.Status.ControllerPause = true
 		newRollout.Status.PauseConditions = append(newRollout.Status.PauseConditions, cond)
 	}
+	newRollout.Status.Lifecycle = newUpdateLifecycleStatus(newRollout)
+	if index := newRollout.Status.CurrentStepIndex; newRollout.Status.Lifecycle != nil && index != nil && int(*index) < len(newRollout.Spec.Strategy.Canary.Steps) {
+		newRollout.Status.CurrentStep = &v1alpha1.RolloutStepStatus{
+			Step:            canaryStepName(*index),
+			PodTemplateHash: newRollout.Status.CurrentPodHash,
+			StartedAt:       timeutil.MetaNow(),
+		}
+	}
 	newRollout.Status.Phase, newRollout.Status.Message = rolloututil.CalculateRolloutPhase(r.Spec, newRollout.Status)
 	return newRollout
 }
 
+// generateCurrentStepPatch returns the patch of the status of the current step of an update, which started at the time
+// frozen by the fixture
+func generateCurrentStepPatch(step, podHash string) string {
+	return fmt.Sprintf(`{"step":"%s","podTemplateHash":"%s","startedAt":"%s"}`, step, podHash, timeutil.MetaNow().UTC().Format(time.RFC3339))
+}
+
+// generateLifecyclePatch returns the patch of the lifecycle status of an update, which started at the time frozen by the
+// fixture
+func generateLifecyclePatch(podHash string) string {
+	return fmt.Sprintf(`{"podTemplateHash":"%s","startedAt":"%s"}`, podHash, timeutil.MetaNow().UTC().Format(time.RFC3339))
+}
+
+// newUpdateLifecycleStatus returns the lifecycle status of a rollout whose update is in progress, started at the time
+// frozen by the fixture
+func newUpdateLifecycleStatus(r *v1alpha1.Rollout) *v1alpha1.RolloutLifecycleStatus {
+	if r.Status.CurrentPodHash == "" || r.Status.CurrentPodHash == r.Status.StableRS {
+		return nil
+	}
+	return &v1alpha1.RolloutLifecycleStatus{
+		PodTemplateHash: r.Status.CurrentPodHash,
+		StartedAt:       timeutil.MetaNow(),
+	}
+}
+
 func updateBaseRolloutStatus(r *v1alpha1.Rollout, availableReplicas, updatedReplicas, totalReplicas, hpaReplicas int32) *v1alpha1.Rollout {
 	newRollout := r.DeepCopy()
 	newRollout.Status.Replicas = totalReplicas
@@ -580,29 +644,40 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	}
 
 	c := NewController(ControllerConfig{
-		Namespace:                       metav1.NamespaceAll,
-		KubeClientSet:                   f.kubeclient,
-		ArgoProjClientset:               f.client,
-		DynamicClientSet:                dynamicClient,
-		ExperimentInformer:              i.Argoproj().V1alpha1().Experiments(),
-		AnalysisRunInformer:             i.Argoproj().V1alpha1().AnalysisRuns(),
-		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
-		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
-		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
-		ServicesInformer:                k8sI.Core().V1().Services(),
-		IngressWrapper:                  ingressWrapper,
-		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
-		IstioPrimaryDynamicClient:       dynamicClient,
-		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
-		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
-		ResyncPeriod:                    resync(),
-		RolloutWorkQueue:                rolloutWorkqueue,
-		ServiceWorkQueue:                serviceWorkqueue,
-		IngressWorkQueue:                ingressWorkqueue,
-		MetricsServer:                   metricsServer,
-		Recorder:                        record.NewFakeEventRecorder(),
-		RefResolver:                     &FakeWorkloadRefResolver{},
-		EphemeralMetadataThreads:        DefaultEphemeralMetadataThreads,
+		Namespace:                              metav1.NamespaceAll,
+		KubeClientSet:                          f.kubeclient,
+		ArgoProjClientset:                      f.client,
+		DynamicClientSet:                       dynamicClient,
+		ExperimentInformer:                     i.Argoproj().V1alpha1().Experiments(),
+		AnalysisRunInformer:                    i.Argoproj().V1alpha1().AnalysisRuns(),
+		AnalysisTemplateInformer:               i.Argoproj().V1alpha1().AnalysisTemplates(),
+		ClusterAnalysisTemplateInformer:        i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
+		RolloutPolicyInformer:                  i.Argoproj().V1alpha1().RolloutPolicies(),
+		ClusterRolloutPolicyInformer:           i.Argoproj().V1alpha1().ClusterRolloutPolicies(),
+		RolloutStrategyTemplateInformer:        i.Argoproj().V1alpha1().RolloutStrategyTemplates(),
+		ClusterRolloutStrategyTemplateInformer: i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates(),
+		ReplicaSetInformer:                     k8sI.Apps().V1().ReplicaSets(),
+		ServicesInformer:                       k8sI.Core().V1().Services(),
+		PodInformer:                            k8sI.Core().V1().Pods(),
+		StatefulSetInformer:                    k8sI.Apps().V1().StatefulSets(),
+		ControllerRevisionInformer:             k8sI.Apps().V1().ControllerRevisions(),
+		DaemonSetInformer:                      k8sI.Apps().V1().DaemonSets(),
+		NodeInformer:                           k8sI.Core().V1().Nodes(),
+		ConfigMapSnapshotInformer:              k8sI.Core().V1().ConfigMaps(),
+		SecretSnapshotInformer:                 k8sI.Core().V1().Secrets(),
+		IngressWrapper:                         ingressWrapper,
+		RolloutsInformer:                       i.Argoproj().V1alpha1().Rollouts(),
+		IstioPrimaryDynamicClient:              dynamicClient,
+		IstioVirtualServiceInformer:            istioVirtualServiceInformer,
+		IstioDestinationRuleInformer:           istioDestinationRuleInformer,
+		ResyncPeriod:                           resync(),
+		RolloutWorkQueue:                       rolloutWorkqueue,
+		ServiceWorkQueue:                       serviceWorkqueue,
+		IngressWorkQueue:                       ingressWorkqueue,
+		MetricsServer:                          metricsServer,
+		Recorder:                               record.NewFakeEventRecorder(),
+		RefResolver:                            &FakeWorkloadRefResolver{resources: f.refResources},
+		EphemeralMetadataThreads:               DefaultEphemeralMetadataThreads,
 	})
 
 	c.enqueueRollout = func(obj any) {
@@ -626,6 +701,9 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		c.enqueueRollout(obj)
 	}
 	c.newTrafficRoutingReconciler = func(roCtx *rolloutContext) ([]trafficrouting.TrafficRoutingReconciler, error) {
+		if roCtx.rollout.Spec.Strategy.BlueGreen != nil && roCtx.rollout.Spec.Strategy.BlueGreen.TrafficRouting != nil {
+			return []trafficrouting.TrafficRoutingReconciler{f.fakeTrafficRouting}, nil
+		}
 		if roCtx.rollout.Spec.Strategy.Canary == nil || roCtx.rollout.Spec.Strategy.Canary.TrafficRouting == nil {
 			return nil, nil
 		}
@@ -664,6 +742,18 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	for _, ar := range f.analysisRunLister {
 		i.Argoproj().V1alpha1().AnalysisRuns().Informer().GetIndexer().Add(ar)
 	}
+	for _, rp := range f.rolloutPolicyLister {
+		i.Argoproj().V1alpha1().RolloutPolicies().Informer().GetIndexer().Add(rp)
+	}
+	for _, crp := range f.clusterRolloutPolicyLister {
+		i.Argoproj().V1alpha1().ClusterRolloutPolicies().Informer().GetIndexer().Add(crp)
+	}
+	for _, rst := range f.rolloutStrategyTemplateLister {
+		i.Argoproj().V1alpha1().RolloutStrategyTemplates().Informer().GetIndexer().Add(rst)
+	}
+	for _, crst := range f.clusterRolloutStrategyTemplateLister {
+		i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates().Informer().GetIndexer().Add(crst)
+	}
 	for _, vs := range f.virtualServiceLister {
 		c.IstioController.VirtualServiceInformer.GetIndexer().Add(vs)
 	}
@@ -770,6 +860,14 @@ func filterInformerActions(actions []core.Action) []core.Action {
 			action.Matches("watch", "analysistemplates") ||
 			action.Matches("list", "clusteranalysistemplates") ||
 			action.Matches("watch", "clusteranalysistemplates") ||
+			action.Matches("list", "rolloutpolicies") ||
+			action.Matches("watch", "rolloutpolicies") ||
+			action.Matches("list", "clusterrolloutpolicies") ||
+			action.Matches("watch", "clusterrolloutpolicies") ||
+			action.Matches("list", "rolloutstrategytemplates") ||
+			action.Matches("watch", "rolloutstrategytemplates") ||
+			action.Matches("list", "clusterrolloutstrategytemplates") ||
+			action.Matches("watch", "clusterrolloutstrategytemplates") ||
 			action.Matches("list", "rollouts") ||
 			action.Matches("watch", "rollouts") ||
 			action.Matches("list", "replicaSets") ||
@@ -777,7 +875,21 @@ func filterInformerActions(actions []core.Action) []core.Action {
 			action.Matches("list", "services") ||
 			action.Matches("watch", "services") ||
 			action.Matches("list", "ingresses") ||
-			action.Matches("watch", "ingresses") {
+			action.Matches("watch", "ingresses") ||
+			(action.Matches("list", "pods") && action.GetNamespace() == metav1.NamespaceAll) ||
+			action.Matches("watch", "pods") ||
+			action.Matches("list", "statefulsets") ||
+			action.Matches("watch", "statefulsets") ||
+			action.Matches("list", "controllerrevisions") ||
+			action.Matches("watch", "controllerrevisions") ||
+			action.Matches("list", "daemonsets") ||
+			action.Matches("watch", "daemonsets") ||
+			action.Matches("list", "nodes") ||
+			action.Matches("watch", "nodes") ||
+			action.Matches("list", "configmaps") ||
+			action.Matches("watch", "configmaps") ||
+			action.Matches("list", "secrets") ||
+			action.Matches("watch", "secrets") {
 			continue
 		}
 		ret = append(ret, action)
@@ -809,6 +921,12 @@ func (f *fixture) expectCreateReplicaSetAction(r *appsv1.ReplicaSet) int {
 	return len
 }
 
+func (f *fixture) expectCreateControllerRevisionAction() int {
+	len := len(f.kubeactions)
+	f.kubeactions = append(f.kubeactions, core.NewCreateAction(schema.GroupVersionResource{Resource: "controllerrevisions"}, metav1.NamespaceDefault, nil))
+	return len
+}
+
 func (f *fixture) expectUpdateReplicaSetAction(r *appsv1.ReplicaSet) int {
 	len := len(f.kubeactions)
 	f.kubeactions = append(f.kubeactions, core.NewUpdateAction(schema.GroupVersionResource{Resource: "replicasets"}, r.Namespace, r))
@@ -2413,6 +2531,144 @@ func TestGetAmbassadorMappings(t *testing.T) {
 	})
 }
 
+func TestGetContourHTTPProxies(t *testing.T) {
+	r := newCanaryRollout("rollout", 1, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))
+	r.Namespace = metav1.NamespaceDefault
+	r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Contour: &v1alpha1.ContourTrafficRouting{
+			HTTPProxies: []string{"proxy"},
+		},
+	}
+	httpProxy := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: projectcontour.io/v1
+kind: HTTPProxy
+metadata:
+  name: proxy
+  namespace: default
+`)
+
+	t.Run("will get httpProxies successfully", func(t *testing.T) {
+		roCtx := &rolloutContext{rollout: r}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient(httpProxy)
+		httpProxies, err := roCtx.getContourHTTPProxies()
+		assert.NoError(t, err)
+		assert.Len(t, httpProxies, 1)
+		assert.Equal(t, "proxy", httpProxies[0].GetName())
+	})
+	t.Run("will return error when httpProxy is not found", func(t *testing.T) {
+		roCtx := &rolloutContext{rollout: r}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient()
+		_, err := roCtx.getContourHTTPProxies()
+		assert.Error(t, err)
+	})
+	t.Run("will return error when no httpProxies are defined", func(t *testing.T) {
+		rCopy := r.DeepCopy()
+		rCopy.Spec.Strategy.Canary.TrafficRouting.Contour.HTTPProxies = nil
+		roCtx := &rolloutContext{rollout: rCopy}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient()
+		_, err := roCtx.getContourHTTPProxies()
+		assert.EqualError(t, err, "spec.strategy.canary.trafficRouting.contour.httpProxies: Invalid value: \"null\": must provide at least one httpProxy")
+	})
+}
+
+func TestGetKongHTTPRoutes(t *testing.T) {
+	r := newCanaryRollout("rollout", 1, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))
+	r.Namespace = metav1.NamespaceDefault
+	r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Kong: &v1alpha1.KongTrafficRouting{
+			HTTPRoutes: []string{"route"},
+		},
+	}
+	httpRoute := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: default
+`)
+
+	t.Run("will get httpRoutes successfully", func(t *testing.T) {
+		roCtx := &rolloutContext{rollout: r}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient(httpRoute)
+		httpRoutes, err := roCtx.getKongHTTPRoutes()
+		assert.NoError(t, err)
+		assert.Len(t, httpRoutes, 1)
+		assert.Equal(t, "route", httpRoutes[0].GetName())
+	})
+	t.Run("will return error when httpRoute is not found", func(t *testing.T) {
+		roCtx := &rolloutContext{rollout: r}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient()
+		_, err := roCtx.getKongHTTPRoutes()
+		assert.Error(t, err)
+	})
+}
+
+func TestGetLinkerdHTTPRoutes(t *testing.T) {
+	r := newCanaryRollout("rollout", 1, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))
+	r.Namespace = metav1.NamespaceDefault
+	r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		Linkerd: &v1alpha1.LinkerdTrafficRouting{
+			HTTPRoutes: []string{"route"},
+		},
+	}
+	httpRoute := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: policy.linkerd.io/v1beta3
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: default
+`)
+
+	t.Run("will get httpRoutes successfully", func(t *testing.T) {
+		roCtx := &rolloutContext{rollout: r}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient(httpRoute)
+		httpRoutes, err := roCtx.getLinkerdHTTPRoutes()
+		assert.NoError(t, err)
+		assert.Len(t, httpRoutes, 1)
+		assert.Equal(t, "route", httpRoutes[0].GetName())
+	})
+	t.Run("will return error when httpRoute is not found", func(t *testing.T) {
+		roCtx := &rolloutContext{rollout: r}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient()
+		_, err := roCtx.getLinkerdHTTPRoutes()
+		assert.Error(t, err)
+	})
+}
+
+func TestGetDNSEndpoints(t *testing.T) {
+	r := newCanaryRollout("rollout", 1, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))
+	r.Namespace = metav1.NamespaceDefault
+	r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+		DNS: &v1alpha1.DNSTrafficRouting{
+			DNSEndpoint:         "records",
+			StableSetIdentifier: "stable",
+			CanarySetIdentifier: "canary",
+		},
+	}
+	dnsEndpoint := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: externaldns.k8s.io/v1alpha1
+kind: DNSEndpoint
+metadata:
+  name: records
+  namespace: default
+`)
+
+	t.Run("will get dnsEndpoint successfully", func(t *testing.T) {
+		roCtx := &rolloutContext{rollout: r}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient(dnsEndpoint)
+		dnsEndpoints, err := roCtx.getDNSEndpoints()
+		assert.NoError(t, err)
+		assert.Len(t, dnsEndpoints, 1)
+		assert.Equal(t, "records", dnsEndpoints[0].GetName())
+	})
+	t.Run("will return error when dnsEndpoint is not found", func(t *testing.T) {
+		roCtx := &rolloutContext{rollout: r}
+		roCtx.dynamicclientset = testutil.NewFakeDynamicClient()
+		_, err := roCtx.getDNSEndpoints()
+		assert.Error(t, err)
+	})
+}
+
 func TestRolloutStrategyNotSet(t *testing.T) {
 	f := newFixture(t)
 	defer f.Close()
@@ -2488,3 +2744,20 @@ func TestRun(t *testing.T) {
 	}()
 	c.Run(ctx, 1)
 }
+
+func TestValidateRolloutReferences(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	activeSvc := newService("active", 80, nil, r)
+	f.kubeobjects = append(f.kubeobjects, activeSvc)
+	f.serviceLister = append(f.serviceLister, activeSvc)
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	err := c.ValidateRolloutReferences(r)
+	assert.EqualError(t, err, `spec.strategy.blueGreen.previewService: Invalid value: "preview": service "preview" not found`)
+
+	r.Spec.Strategy.BlueGreen.PreviewService = ""
+	assert.NoError(t, c.ValidateRolloutReferences(r))
+}
@@ -0,0 +1,539 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	patchtypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	labelsutil "k8s.io/kubernetes/pkg/util/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+const (
+	daemonSetCreatedReason      = "DaemonSetCreated"
+	daemonSetUpdatedReason      = "DaemonSetUpdated"
+	daemonSetDeletedReason      = "DaemonSetDeleted"
+	daemonSetNodesLabeledReason = "DaemonSetCanaryNodesLabeled"
+
+	// daemonSetTemplateAffinityAnnotation records the affinity of the pod template of a DaemonSet, to which the
+	// controller adds the requirement on the canary node label
+	daemonSetTemplateAffinityAnnotation = annotations.RolloutLabel + "/template-affinity"
+	// daemonSetCanaryNodeLabelPrefix is the prefix of the label set on the canary nodes of a Rollout, followed by the
+	// UID of the Rollout. Its value is the pod template hash of the canary DaemonSet.
+	daemonSetCanaryNodeLabelPrefix = annotations.RolloutLabel + "/canary-"
+)
+
+// canaryNodeSet is the set of nodes running the canary DaemonSet
+type canaryNodeSet struct {
+	// all indicates the canary runs on all the nodes, including the nodes added during the update
+	all bool
+	// names are the names of the canary nodes when all is false
+	names []string
+}
+
+// rolloutDaemonSet progresses a Rollout with the DaemonSet canary strategy. Each version of the pod template runs in
+// its own DaemonSet named <rollout>-<pod template hash>. The canary steps move nodes from the stable DaemonSet to the
+// canary DaemonSet by labeling them: the canary DaemonSet requires the canary node label, the stable DaemonSet runs
+// on the nodes without it. Like for StatefulSets, the DaemonSets are presented to the rest of the controller as
+// ReplicaSets (see newDaemonSetReplicaSet) so that analysis, pauses and the status of the Rollout are reconciled the
+// same way as for canary Rollouts. The DaemonSets and the nodes are watched, so the Rollout is reconciled again once
+// the DaemonSet controller moved the pods.
+func (c *rolloutContext) rolloutDaemonSet() error {
+	ctx := c.context()
+	nodes, err := c.getDaemonSetEligibleNodes()
+	if err != nil {
+		return err
+	}
+	daemonSets, err := c.getDaemonSetsForRollout()
+	if err != nil {
+		return err
+	}
+	podHash := hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+	newDS := findDaemonSetByHash(daemonSets, podHash)
+	if newDS == nil {
+		newDS, err = c.createDaemonSet(ctx, podHash, daemonSets)
+		if err != nil {
+			return err
+		}
+		daemonSets = append(daemonSets, newDS)
+	}
+	if err := c.setRolloutRevision(newDS.Annotations[annotations.RevisionAnnotation]); err != nil {
+		return err
+	}
+
+	// The nodes are the replicas of a DaemonSet canary: the replica counts of the status and the full promotion are
+	// computed from them rather than from the replicas of the spec
+	replicas := int32(len(nodes))
+	c.workloadReplicas = &replicas
+	if err := c.setDaemonSetReplicaSets(newDS, daemonSets, nodes); err != nil {
+		return err
+	}
+
+	if replicasetutil.PodTemplateOrStepsChanged(c.rollout, c.newRS) {
+		return c.syncRolloutStatusCanary()
+	}
+
+	err = c.reconcileAnalysisRuns()
+	if c.pauseContext.HasAddPause() {
+		c.log.Info("Detected pause due to inconclusive AnalysisRun")
+		return c.syncRolloutStatusCanary()
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := c.reconcileDaemonSets(ctx, newDS, daemonSets, nodes); err != nil {
+		return err
+	}
+
+	if stillReconciling := c.reconcileCanaryPause(); stillReconciling {
+		c.log.Infof("Not finished reconciling Canary Pause")
+	}
+
+	return c.syncRolloutStatusCanary()
+}
+
+// getDaemonSetEligibleNodes returns the nodes which run the pods of the Rollout, sorted by name: the nodes matching
+// the node selector and required node affinity of the pod template, whose taints are tolerated by the pod template.
+// Like the DaemonSet controller, the taints of the node lifecycle controller (node.kubernetes.io/*) are ignored.
+func (c *rolloutContext) getDaemonSetEligibleNodes() ([]*corev1.Node, error) {
+	if c.nodeLister == nil {
+		return nil, fmt.Errorf("DaemonSet canaries require the controller to run in cluster-wide mode to read the nodes")
+	}
+	nodeList, err := c.nodeLister.List(labels.SelectorFromSet(c.rollout.Spec.Template.Spec.NodeSelector))
+	if err != nil {
+		return nil, err
+	}
+	pod := &corev1.Pod{Spec: c.rollout.Spec.Template.Spec}
+	requiredAffinity := nodeaffinity.GetRequiredNodeAffinity(pod)
+	var nodes []*corev1.Node
+	for _, node := range nodeList {
+		if match, _ := requiredAffinity.Match(node); !match {
+			continue
+		}
+		_, untolerated := corev1helpers.FindMatchingUntoleratedTaint(node.Spec.Taints, pod.Spec.Tolerations, func(t *corev1.Taint) bool {
+			return (t.Effect == corev1.TaintEffectNoSchedule || t.Effect == corev1.TaintEffectNoExecute) && !strings.HasPrefix(t.Key, "node.kubernetes.io/")
+		})
+		if untolerated {
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	return nodes, nil
+}
+
+// getDaemonSetsForRollout returns the DaemonSets controlled by the Rollout
+func (c *rolloutContext) getDaemonSetsForRollout() ([]*appsv1.DaemonSet, error) {
+	dsList, err := c.daemonSetLister.DaemonSets(c.rollout.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var daemonSets []*appsv1.DaemonSet
+	for _, ds := range dsList {
+		if controllerRef := metav1.GetControllerOf(ds); controllerRef != nil && controllerRef.UID == c.rollout.UID {
+			daemonSets = append(daemonSets, ds)
+		}
+	}
+	return daemonSets, nil
+}
+
+func findDaemonSetByHash(daemonSets []*appsv1.DaemonSet, podHash string) *appsv1.DaemonSet {
+	for _, ds := range daemonSets {
+		if ds.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] == podHash {
+			return ds
+		}
+	}
+	return nil
+}
+
+// daemonSetCanaryNodeLabel returns the key of the label set on the canary nodes of the Rollout
+func daemonSetCanaryNodeLabel(ro *v1alpha1.Rollout) string {
+	return daemonSetCanaryNodeLabelPrefix + string(ro.UID)
+}
+
+// createDaemonSet creates the DaemonSet of the pod template of the Rollout. The DaemonSet is created without any pod
+// when the Rollout has a stable version, the canary steps then label the nodes running it.
+func (c *rolloutContext) createDaemonSet(ctx context.Context, podHash string, daemonSets []*appsv1.DaemonSet) (*appsv1.DaemonSet, error) {
+	maxRevision := int64(0)
+	for _, ds := range daemonSets {
+		if revision, err := strconv.ParseInt(ds.Annotations[annotations.RevisionAnnotation], 10, 64); err == nil && revision > maxRevision {
+			maxRevision = revision
+		}
+	}
+	template := *c.rollout.Spec.Template.DeepCopy()
+	template.Labels = labelsutil.CloneAndAddLabel(c.rollout.Spec.Template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+	dsAnnotations := map[string]string{
+		annotations.RevisionAnnotation: strconv.FormatInt(maxRevision+1, 10),
+	}
+	if template.Spec.Affinity != nil {
+		data, err := json.Marshal(template.Spec.Affinity)
+		if err != nil {
+			return nil, err
+		}
+		dsAnnotations[daemonSetTemplateAffinityAnnotation] = string(data)
+	}
+	if c.rollout.Status.StableRS != "" {
+		template.Spec.Affinity = affinityWithRequirement(template.Spec.Affinity, c.daemonSetNodeRequirement(true, podHash, canaryNodeSet{}))
+	}
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            c.rollout.Name + "-" + podHash,
+			Namespace:       c.rollout.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(c.rollout, controllerKind)},
+			Labels:          template.Labels,
+			Annotations:     dsAnnotations,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector:        labelsutil.CloneSelectorAndAddLabel(c.rollout.Spec.Selector, v1alpha1.DefaultRolloutUniqueLabelKey, podHash),
+			Template:        template,
+			MinReadySeconds: c.rollout.Spec.MinReadySeconds,
+			// The canary nodes change at every step. The DaemonSet controller schedules and deletes the pods of the
+			// nodes entering and leaving the affinity, but must not replace the others.
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.OnDeleteDaemonSetStrategyType,
+			},
+		},
+	}
+	createdDS, err := c.kubeclientset.AppsV1().DaemonSets(ds.Namespace).Create(ctx, ds, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	msg := fmt.Sprintf("Created DaemonSet %s (revision %d)", createdDS.Name, maxRevision+1)
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: daemonSetCreatedReason, EventAction: record.ActionUpdateWorkload, RelatedObject: createdDS}, msg)
+	return createdDS, nil
+}
+
+// setDaemonSetReplicaSets sets the new, stable and older ReplicaSets of the context from the DaemonSets of the Rollout
+func (c *rolloutContext) setDaemonSetReplicaSets(newDS *appsv1.DaemonSet, daemonSets []*appsv1.DaemonSet, nodes []*corev1.Node) error {
+	canaryNodes := c.desiredCanaryNodes(nodes)
+	canaryNames := sets.New(canaryNodes.names...)
+	if canaryNodes.all {
+		canaryNames = sets.New(nodeNames(nodes)...)
+	}
+	stableNames := sets.New(nodeNames(nodes)...).Difference(canaryNames)
+
+	var err error
+	c.newRS, err = c.newDaemonSetReplicaSet(newDS, canaryNames)
+	if err != nil {
+		return err
+	}
+	c.stableRS = nil
+	c.olderRSs = nil
+	c.allRSs = []*appsv1.ReplicaSet{c.newRS}
+	sort.Slice(daemonSets, func(i, j int) bool {
+		return daemonSets[i].Name < daemonSets[j].Name
+	})
+	for _, ds := range daemonSets {
+		if ds.Name == newDS.Name {
+			continue
+		}
+		desiredNames := sets.New[string]()
+		if ds.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] == c.rollout.Status.StableRS {
+			desiredNames = stableNames
+		}
+		rs, err := c.newDaemonSetReplicaSet(ds, desiredNames)
+		if err != nil {
+			return err
+		}
+		c.olderRSs = append(c.olderRSs, rs)
+		c.allRSs = append(c.allRSs, rs)
+	}
+	if c.rollout.Status.StableRS != "" {
+		c.stableRS = replicasetutil.GetStableRS(c.rollout, c.newRS, c.olderRSs)
+	}
+	c.otherRSs = replicasetutil.GetOtherRSs(c.rollout, c.newRS, c.stableRS, c.allRSs)
+	return nil
+}
+
+// newDaemonSetReplicaSet returns a ReplicaSet describing the pods of a DaemonSet, which are desired on the given
+// nodes. The ReplicaSet only exists in memory and is never created in the cluster. Its replicas are the pods of the
+// DaemonSet, but only the pods running on the desired nodes are counted as ready and available, so that the status
+// does not depend on how fast the DaemonSet controller observes the canary node labels.
+func (c *rolloutContext) newDaemonSetReplicaSet(ds *appsv1.DaemonSet, desiredNodes sets.Set[string]) (*appsv1.ReplicaSet, error) {
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := c.podLister.Pods(ds.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	var replicas, ready, available int32
+	now := timeutil.MetaNow()
+	for _, pod := range pods {
+		if controllerRef := metav1.GetControllerOf(pod); controllerRef == nil || controllerRef.UID != ds.UID || pod.DeletionTimestamp != nil {
+			continue
+		}
+		replicas++
+		if !desiredNodes.Has(pod.Spec.NodeName) {
+			continue
+		}
+		if podutil.IsPodReady(pod) {
+			ready++
+		}
+		if podutil.IsPodAvailable(pod, ds.Spec.MinReadySeconds, now) {
+			available++
+		}
+	}
+	desired := int32(desiredNodes.Len())
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ds.Name,
+			Namespace:   ds.Namespace,
+			Generation:  ds.Generation,
+			Labels:      map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: ds.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]},
+			Annotations: map[string]string{annotations.RevisionAnnotation: ds.Annotations[annotations.RevisionAnnotation]},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas:        &desired,
+			MinReadySeconds: ds.Spec.MinReadySeconds,
+			Selector:        ds.Spec.Selector,
+			Template:        ds.Spec.Template,
+		},
+		Status: appsv1.ReplicaSetStatus{
+			Replicas:             replicas,
+			FullyLabeledReplicas: replicas,
+			ReadyReplicas:        ready,
+			AvailableReplicas:    available,
+			ObservedGeneration:   ds.Status.ObservedGeneration,
+		},
+	}
+	return rs, nil
+}
+
+// desiredCanaryNodes returns the nodes running the canary DaemonSet for the current state of the Rollout:
+//   - all the nodes on the initial deploy, when the canary is fully promoted, or once all the steps are completed
+//   - no node when the Rollout is aborted
+//   - during the canary steps, the nodes selected by the last setWeight or setCanaryNodes step. A setWeight step
+//     selects the first nodes by name, rounded up, so that the canary nodes of a higher weight include those of a
+//     lower weight.
+func (c *rolloutContext) desiredCanaryNodes(nodes []*corev1.Node) canaryNodeSet {
+	if c.rollout.Status.StableRS == "" || c.rollout.Status.PromoteFull || c.rollout.Status.StableRS == c.rollout.Status.CurrentPodHash {
+		return canaryNodeSet{all: true}
+	}
+	if c.rollout.Status.Abort {
+		return canaryNodeSet{}
+	}
+	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	if currentStep == nil {
+		return canaryNodeSet{all: true}
+	}
+	for i := *currentStepIndex; i >= 0; i-- {
+		step := c.rollout.Spec.Strategy.Canary.Steps[i]
+		switch {
+		case step.SetWeight != nil:
+			count := int(math.Ceil(float64(len(nodes)) * float64(*step.SetWeight) / float64(weightutil.MaxTrafficWeight(c.rollout))))
+			if count > len(nodes) {
+				count = len(nodes)
+			}
+			return canaryNodeSet{names: nodeNames(nodes[:count])}
+		case step.SetCanaryNodes != nil:
+			selector, err := metav1.LabelSelectorAsSelector(&step.SetCanaryNodes.NodeSelector)
+			if err != nil {
+				c.log.Warnf("Invalid nodeSelector of setCanaryNodes step %d: %v", i, err)
+				return canaryNodeSet{}
+			}
+			var selected []*corev1.Node
+			for _, node := range nodes {
+				if selector.Matches(labels.Set(node.Labels)) {
+					selected = append(selected, node)
+				}
+			}
+			return canaryNodeSet{names: nodeNames(selected)}
+		}
+	}
+	return canaryNodeSet{}
+}
+
+func nodeNames(nodes []*corev1.Node) []string {
+	names := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+	return names
+}
+
+// reconcileDaemonSets deletes the DaemonSets which are neither canary nor stable, sets the node affinities of the
+// canary and stable DaemonSets, and labels the canary nodes
+func (c *rolloutContext) reconcileDaemonSets(ctx context.Context, newDS *appsv1.DaemonSet, daemonSets []*appsv1.DaemonSet, nodes []*corev1.Node) error {
+	canaryNodes := c.desiredCanaryNodes(nodes)
+	canaryHash := newDS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	for _, ds := range daemonSets {
+		podHash := ds.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+		switch {
+		case ds.Name == newDS.Name:
+			if err := c.updateDaemonSetAffinity(ctx, ds, c.daemonSetNodeRequirement(true, canaryHash, canaryNodes)); err != nil {
+				return err
+			}
+		case podHash == c.rollout.Status.StableRS:
+			if err := c.updateDaemonSetAffinity(ctx, ds, c.daemonSetNodeRequirement(false, canaryHash, canaryNodes)); err != nil {
+				return err
+			}
+		case ds.DeletionTimestamp == nil:
+			err := c.kubeclientset.AppsV1().DaemonSets(ds.Namespace).Delete(ctx, ds.Name, metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return err
+			}
+			msg := fmt.Sprintf("Deleted DaemonSet %s", ds.Name)
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: daemonSetDeletedReason, EventAction: record.ActionUpdateWorkload, RelatedObject: ds}, msg)
+		}
+	}
+	return c.reconcileCanaryNodeLabels(ctx, canaryHash, canaryNodes)
+}
+
+// daemonSetNodeRequirement returns the requirement added to the node affinity of the canary or stable DaemonSet.
+// The canary DaemonSet runs on the nodes labeled with its pod template hash, the stable DaemonSet on the other
+// nodes. Once the canary runs on all the nodes, the canary DaemonSet has no requirement and the stable DaemonSet
+// runs on no node.
+func (c *rolloutContext) daemonSetNodeRequirement(canary bool, canaryHash string, canaryNodes canaryNodeSet) *corev1.NodeSelectorRequirement {
+	key := daemonSetCanaryNodeLabel(c.rollout)
+	switch {
+	case canary && canaryNodes.all:
+		return nil
+	case canary:
+		return &corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpIn, Values: []string{canaryHash}}
+	case canaryNodes.all:
+		return &corev1.NodeSelectorRequirement{Key: corev1.LabelHostname, Operator: corev1.NodeSelectorOpDoesNotExist}
+	default:
+		return &corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpNotIn, Values: []string{canaryHash}}
+	}
+}
+
+// updateDaemonSetAffinity sets the node affinity of a DaemonSet to the affinity of its pod template with the given
+// requirement
+func (c *rolloutContext) updateDaemonSetAffinity(ctx context.Context, ds *appsv1.DaemonSet, requirement *corev1.NodeSelectorRequirement) error {
+	affinity, err := daemonSetTemplateAffinity(ds)
+	if err != nil {
+		return err
+	}
+	desiredAffinity := affinityWithRequirement(affinity, requirement)
+	if equality.Semantic.DeepEqual(ds.Spec.Template.Spec.Affinity, desiredAffinity) {
+		return nil
+	}
+	dsCopy := ds.DeepCopy()
+	dsCopy.Spec.Template.Spec.Affinity = desiredAffinity
+	if _, err := c.kubeclientset.AppsV1().DaemonSets(ds.Namespace).Update(ctx, dsCopy, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	msg := fmt.Sprintf("Updated DaemonSet %s node affinity", ds.Name)
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: daemonSetUpdatedReason, EventAction: record.ActionUpdateWorkload, RelatedObject: ds}, msg)
+	return nil
+}
+
+// reconcileCanaryNodeLabels sets the canary node label of the Rollout to the pod template hash of the canary on the
+// canary nodes, and removes it from the other nodes. The label is removed from all the nodes once the canary runs on
+// all of them.
+func (c *rolloutContext) reconcileCanaryNodeLabels(ctx context.Context, canaryHash string, canaryNodes canaryNodeSet) error {
+	key := daemonSetCanaryNodeLabel(c.rollout)
+	desired := sets.New[string]()
+	if !canaryNodes.all {
+		desired.Insert(canaryNodes.names...)
+	}
+	nodes, err := c.nodeLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	labeled, unlabeled := 0, 0
+	for _, node := range nodes {
+		value, ok := node.Labels[key]
+		var patch string
+		switch {
+		case desired.Has(node.Name) && value != canaryHash:
+			patch = fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, key, canaryHash)
+			labeled++
+		case !desired.Has(node.Name) && ok:
+			patch = fmt.Sprintf(`{"metadata":{"labels":{%q:null}}}`, key)
+			unlabeled++
+		default:
+			continue
+		}
+		_, err := c.kubeclientset.CoreV1().Nodes().Patch(ctx, node.Name, patchtypes.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+	}
+	if labeled > 0 || unlabeled > 0 {
+		msg := fmt.Sprintf("Labeled %d canary nodes and unlabeled %d nodes with %s", labeled, unlabeled, key)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: daemonSetNodesLabeledReason, EventAction: record.ActionUpdateWorkload}, msg)
+	}
+	return nil
+}
+
+// daemonSetTemplateAffinity returns the affinity of the pod template the DaemonSet was created from, before the
+// canary node requirement was added to it
+func daemonSetTemplateAffinity(ds *appsv1.DaemonSet) (*corev1.Affinity, error) {
+	data := ds.Annotations[daemonSetTemplateAffinityAnnotation]
+	if data == "" {
+		return nil, nil
+	}
+	var affinity corev1.Affinity
+	if err := json.Unmarshal([]byte(data), &affinity); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation of DaemonSet %s: %w", daemonSetTemplateAffinityAnnotation, ds.Name, err)
+	}
+	return &affinity, nil
+}
+
+// affinityWithRequirement returns a copy of the affinity whose required node affinity has the given requirement. The
+// requirement is added to every node selector term, since the terms are ORed.
+func affinityWithRequirement(affinity *corev1.Affinity, requirement *corev1.NodeSelectorRequirement) *corev1.Affinity {
+	if requirement == nil {
+		return affinity.DeepCopy()
+	}
+	result := affinity.DeepCopy()
+	if result == nil {
+		result = &corev1.Affinity{}
+	}
+	if result.NodeAffinity == nil {
+		result.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	if result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	required := result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(required.NodeSelectorTerms) == 0 {
+		required.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range required.NodeSelectorTerms {
+		required.NodeSelectorTerms[i].MatchExpressions = append(required.NodeSelectorTerms[i].MatchExpressions, *requirement)
+	}
+	return result
+}
+
+// atDesiredDaemonSetNodes returns whether the DaemonSets observed their node affinity and run available pods on all
+// their desired nodes, and on no other node
+func (c *rolloutContext) atDesiredDaemonSetNodes() bool {
+	for _, rs := range c.allRSs {
+		if rs.Status.ObservedGeneration < rs.Generation {
+			return false
+		}
+		if rs.Status.Replicas != *rs.Spec.Replicas || rs.Status.AvailableReplicas != *rs.Spec.Replicas {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,516 @@
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+func newDaemonSetRollout(stableRS string, stepIndex int32) *v1alpha1.Rollout {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: metav1.NamespaceDefault, UID: "rollout-uid"},
+		Spec: v1alpha1.RolloutSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "agent"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "agent"}},
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+					Containers:   []corev1.Container{{Name: "agent", Image: "agent:2.0"}},
+				},
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					DaemonSet: &v1alpha1.DaemonSetCanaryStrategy{Enabled: true},
+					Steps: []v1alpha1.CanaryStep{
+						{SetCanaryNodes: &v1alpha1.SetCanaryNodes{NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "canary"}}}},
+						{Pause: &v1alpha1.RolloutPause{}},
+						{SetWeight: pointer.Int32(50)},
+						{Pause: &v1alpha1.RolloutPause{}},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			StableRS:         stableRS,
+			CurrentStepIndex: &stepIndex,
+		},
+	}
+	return ro
+}
+
+func newDaemonSetNode(name string, pool string, taints ...corev1.Taint) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"kubernetes.io/os":   "linux",
+				corev1.LabelHostname: name,
+				"pool":               pool,
+			},
+		},
+		Spec: corev1.NodeSpec{Taints: taints},
+	}
+}
+
+func newRolloutDaemonSet(ro *v1alpha1.Rollout, podHash string, revision string) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ro.Name + "-" + podHash,
+			Namespace:       ro.Namespace,
+			UID:             types.UID(ro.Name + "-" + podHash),
+			Labels:          map[string]string{"app": "agent", v1alpha1.DefaultRolloutUniqueLabelKey: podHash},
+			Annotations:     map[string]string{annotations.RevisionAnnotation: revision},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, controllerKind)},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "agent", v1alpha1.DefaultRolloutUniqueLabelKey: podHash}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "agent", v1alpha1.DefaultRolloutUniqueLabelKey: podHash}},
+			},
+		},
+	}
+}
+
+func newDaemonSetPod(ds *appsv1.DaemonSet, node string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            ds.Name + "-" + node,
+			Namespace:       ds.Namespace,
+			Labels:          ds.Spec.Template.Labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ds, appsv1.SchemeGroupVersion.WithKind("DaemonSet"))},
+		},
+		Spec: corev1.PodSpec{NodeName: node},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func newDaemonSetNodes() []runtime.Object {
+	return []runtime.Object{
+		newDaemonSetNode("node-a", "canary"),
+		newDaemonSetNode("node-b", "default"),
+		newDaemonSetNode("node-c", "default"),
+		newDaemonSetNode("node-d", "default"),
+	}
+}
+
+func newDaemonSetRolloutContext(ro *v1alpha1.Rollout, objs ...runtime.Object) (*rolloutContext, *k8sfake.Clientset) {
+	kubeclient := k8sfake.NewSimpleClientset(objs...)
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+	for _, obj := range objs {
+		switch obj.(type) {
+		case *corev1.Node:
+			k8sI.Core().V1().Nodes().Informer().GetIndexer().Add(obj)
+		case *corev1.Pod:
+			k8sI.Core().V1().Pods().Informer().GetIndexer().Add(obj)
+		case *appsv1.DaemonSet:
+			k8sI.Apps().V1().DaemonSets().Informer().GetIndexer().Add(obj)
+		}
+	}
+	roCtx := &rolloutContext{
+		rollout:      ro,
+		pauseContext: &pauseContext{rollout: ro},
+		reconcilerBase: reconcilerBase{
+			argoprojclientset:   fake.NewSimpleClientset(ro),
+			kubeclientset:       kubeclient,
+			nodeLister:          k8sI.Core().V1().Nodes().Lister(),
+			podLister:           k8sI.Core().V1().Pods().Lister(),
+			daemonSetLister:     k8sI.Apps().V1().DaemonSets().Lister(),
+			recorder:            record.NewFakeEventRecorder(),
+			refResolver:         &FakeWorkloadRefResolver{},
+			enqueueRolloutAfter: func(obj any, duration time.Duration) {},
+		},
+		stepPluginContext: &stepPluginContext{},
+	}
+	roCtx.log = logutil.WithRollout(ro)
+	return roCtx, kubeclient
+}
+
+func getDaemonSetNodes(t *testing.T, roCtx *rolloutContext) []*corev1.Node {
+	nodes, err := roCtx.getDaemonSetEligibleNodes()
+	assert.NoError(t, err)
+	return nodes
+}
+
+func requiredMatchExpressions(affinity *corev1.Affinity) []corev1.NodeSelectorRequirement {
+	return affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions
+}
+
+func TestGetDaemonSetEligibleNodes(t *testing.T) {
+	ro := newDaemonSetRollout("abc", 0)
+	ro.Spec.Template.Spec.Tolerations = []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}}
+	windows := newDaemonSetNode("node-windows", "default")
+	windows.Labels["kubernetes.io/os"] = "windows"
+	roCtx, _ := newDaemonSetRolloutContext(ro,
+		newDaemonSetNode("node-c", "default"),
+		newDaemonSetNode("node-a", "default"),
+		windows,
+		newDaemonSetNode("node-gpu", "gpu", corev1.Taint{Key: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+		newDaemonSetNode("node-dedicated", "default", corev1.Taint{Key: "dedicated", Effect: corev1.TaintEffectNoSchedule}),
+		newDaemonSetNode("node-not-ready", "default", corev1.Taint{Key: corev1.TaintNodeNotReady, Effect: corev1.TaintEffectNoExecute}),
+		newDaemonSetNode("node-prefer", "default", corev1.Taint{Key: "gpu", Effect: corev1.TaintEffectPreferNoSchedule}),
+	)
+
+	nodes := getDaemonSetNodes(t, roCtx)
+	assert.Equal(t, []string{"node-a", "node-c", "node-dedicated", "node-not-ready", "node-prefer"}, nodeNames(nodes))
+
+	// the nodes are not read in namespaced mode
+	roCtx.nodeLister = nil
+	_, err := roCtx.getDaemonSetEligibleNodes()
+	assert.Error(t, err)
+}
+
+func TestDesiredCanaryNodes(t *testing.T) {
+	var nodes []*corev1.Node
+	for _, obj := range newDaemonSetNodes() {
+		nodes = append(nodes, obj.(*corev1.Node))
+	}
+	tests := []struct {
+		name     string
+		rollout  func() *v1alpha1.Rollout
+		expected canaryNodeSet
+	}{
+		{
+			name:     "initial deploy",
+			rollout:  func() *v1alpha1.Rollout { return newDaemonSetRollout("", 0) },
+			expected: canaryNodeSet{all: true},
+		},
+		{
+			name: "setCanaryNodes step",
+			rollout: func() *v1alpha1.Rollout {
+				ro := newDaemonSetRollout("abc", 1)
+				ro.Status.CurrentPodHash = "def"
+				return ro
+			},
+			expected: canaryNodeSet{names: []string{"node-a"}},
+		},
+		{
+			name: "setWeight step rounds up",
+			rollout: func() *v1alpha1.Rollout {
+				ro := newDaemonSetRollout("abc", 2)
+				ro.Status.CurrentPodHash = "def"
+				ro.Spec.Strategy.Canary.Steps[2].SetWeight = pointer.Int32(30)
+				return ro
+			},
+			expected: canaryNodeSet{names: []string{"node-a", "node-b"}},
+		},
+		{
+			name: "completed steps",
+			rollout: func() *v1alpha1.Rollout {
+				ro := newDaemonSetRollout("abc", 4)
+				ro.Status.CurrentPodHash = "def"
+				return ro
+			},
+			expected: canaryNodeSet{all: true},
+		},
+		{
+			name: "full promotion",
+			rollout: func() *v1alpha1.Rollout {
+				ro := newDaemonSetRollout("abc", 1)
+				ro.Status.CurrentPodHash = "def"
+				ro.Status.PromoteFull = true
+				return ro
+			},
+			expected: canaryNodeSet{all: true},
+		},
+		{
+			name: "aborted",
+			rollout: func() *v1alpha1.Rollout {
+				ro := newDaemonSetRollout("abc", 3)
+				ro.Status.CurrentPodHash = "def"
+				ro.Status.Abort = true
+				return ro
+			},
+			expected: canaryNodeSet{},
+		},
+		{
+			name: "before the first canary step",
+			rollout: func() *v1alpha1.Rollout {
+				ro := newDaemonSetRollout("abc", 0)
+				ro.Status.CurrentPodHash = "def"
+				ro.Spec.Strategy.Canary.Steps = append([]v1alpha1.CanaryStep{{Pause: &v1alpha1.RolloutPause{}}}, ro.Spec.Strategy.Canary.Steps...)
+				return ro
+			},
+			expected: canaryNodeSet{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			roCtx, _ := newDaemonSetRolloutContext(test.rollout())
+			canaryNodes := roCtx.desiredCanaryNodes(nodes)
+			assert.Equal(t, test.expected.all, canaryNodes.all)
+			assert.ElementsMatch(t, test.expected.names, canaryNodes.names)
+		})
+	}
+}
+
+func TestDaemonSetNodeRequirement(t *testing.T) {
+	ro := newDaemonSetRollout("abc", 0)
+	roCtx, _ := newDaemonSetRolloutContext(ro)
+	key := daemonSetCanaryNodeLabelPrefix + "rollout-uid"
+
+	canaryNodes := canaryNodeSet{names: []string{"node-a"}}
+	assert.Equal(t, &corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpIn, Values: []string{"def"}}, roCtx.daemonSetNodeRequirement(true, "def", canaryNodes))
+	assert.Equal(t, &corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpNotIn, Values: []string{"def"}}, roCtx.daemonSetNodeRequirement(false, "def", canaryNodes))
+
+	// the requirements do not depend on the canary nodes
+	assert.Equal(t, roCtx.daemonSetNodeRequirement(true, "def", canaryNodes), roCtx.daemonSetNodeRequirement(true, "def", canaryNodeSet{}))
+
+	allNodes := canaryNodeSet{all: true}
+	assert.Nil(t, roCtx.daemonSetNodeRequirement(true, "def", allNodes))
+	assert.Equal(t, &corev1.NodeSelectorRequirement{Key: corev1.LabelHostname, Operator: corev1.NodeSelectorOpDoesNotExist}, roCtx.daemonSetNodeRequirement(false, "def", allNodes))
+}
+
+func TestAffinityWithRequirement(t *testing.T) {
+	requirement := &corev1.NodeSelectorRequirement{Key: "canary", Operator: corev1.NodeSelectorOpIn, Values: []string{"def"}}
+	t.Run("no affinity", func(t *testing.T) {
+		affinity := affinityWithRequirement(nil, requirement)
+		terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		assert.Len(t, terms, 1)
+		assert.Equal(t, []corev1.NodeSelectorRequirement{*requirement}, terms[0].MatchExpressions)
+	})
+	t.Run("adds the requirement to every term", func(t *testing.T) {
+		zone := corev1.NodeSelectorRequirement{Key: corev1.LabelTopologyZone, Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}}
+		affinity := &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{zone}}, {}},
+			},
+		}}
+		result := affinityWithRequirement(affinity, requirement)
+		terms := result.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		assert.Len(t, terms, 2)
+		assert.Equal(t, []corev1.NodeSelectorRequirement{zone, *requirement}, terms[0].MatchExpressions)
+		assert.Equal(t, []corev1.NodeSelectorRequirement{*requirement}, terms[1].MatchExpressions)
+		// the original affinity is not modified
+		assert.Len(t, affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions, 1)
+	})
+	t.Run("no requirement", func(t *testing.T) {
+		assert.Nil(t, affinityWithRequirement(nil, nil))
+	})
+}
+
+func TestReconcileDaemonSets(t *testing.T) {
+	ro := newDaemonSetRollout("abc", 0)
+	ro.Status.CurrentPodHash = "def"
+	stableDS := newRolloutDaemonSet(ro, "abc", "1")
+	newDS := newRolloutDaemonSet(ro, "def", "3")
+	oldDS := newRolloutDaemonSet(ro, "xyz", "2")
+	objs := append(newDaemonSetNodes(), stableDS, newDS, oldDS)
+	roCtx, kubeclient := newDaemonSetRolloutContext(ro, objs...)
+	nodes := getDaemonSetNodes(t, roCtx)
+	key := daemonSetCanaryNodeLabel(ro)
+
+	assert.NoError(t, roCtx.reconcileDaemonSets(context.TODO(), newDS, []*appsv1.DaemonSet{stableDS, newDS, oldDS}, nodes))
+
+	updatedNewDS, err := kubeclient.AppsV1().DaemonSets(ro.Namespace).Get(context.TODO(), newDS.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.NodeSelectorRequirement{{Key: key, Operator: corev1.NodeSelectorOpIn, Values: []string{"def"}}},
+		requiredMatchExpressions(updatedNewDS.Spec.Template.Spec.Affinity))
+	updatedStableDS, err := kubeclient.AppsV1().DaemonSets(ro.Namespace).Get(context.TODO(), stableDS.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.NodeSelectorRequirement{{Key: key, Operator: corev1.NodeSelectorOpNotIn, Values: []string{"def"}}},
+		requiredMatchExpressions(updatedStableDS.Spec.Template.Spec.Affinity))
+	_, err = kubeclient.AppsV1().DaemonSets(ro.Namespace).Get(context.TODO(), oldDS.Name, metav1.GetOptions{})
+	assert.Error(t, err)
+
+	canaryNode, err := kubeclient.CoreV1().Nodes().Get(context.TODO(), "node-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "def", canaryNode.Labels[key])
+	stableNode, err := kubeclient.CoreV1().Nodes().Get(context.TODO(), "node-b", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, stableNode.Labels, key)
+
+	// a second reconciliation has nothing to do
+	nodeList, err := kubeclient.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	var updatedObjs []runtime.Object
+	for i := range nodeList.Items {
+		updatedObjs = append(updatedObjs, &nodeList.Items[i])
+	}
+	roCtx, kubeclient = newDaemonSetRolloutContext(ro, append(updatedObjs, updatedStableDS, updatedNewDS)...)
+	assert.NoError(t, roCtx.reconcileDaemonSets(context.TODO(), updatedNewDS, []*appsv1.DaemonSet{updatedStableDS, updatedNewDS}, getDaemonSetNodes(t, roCtx)))
+	assert.Empty(t, filterDaemonSetActions(kubeclient.Actions()))
+}
+
+// filterDaemonSetActions returns the actions of the controller, without the reads of the test
+func filterDaemonSetActions(actions []k8stesting.Action) []k8stesting.Action {
+	var result []k8stesting.Action
+	for _, action := range actions {
+		if action.GetVerb() != "get" && action.GetVerb() != "list" {
+			result = append(result, action)
+		}
+	}
+	return result
+}
+
+func TestReconcileDaemonSetsAborted(t *testing.T) {
+	ro := newDaemonSetRollout("abc", 0)
+	ro.Status.CurrentPodHash = "def"
+	ro.Status.Abort = true
+	key := daemonSetCanaryNodeLabel(ro)
+	stableDS := newRolloutDaemonSet(ro, "abc", "1")
+	stableDS.Spec.Template.Spec.Affinity = affinityWithRequirement(nil, &corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpNotIn, Values: []string{"def"}})
+	newDS := newRolloutDaemonSet(ro, "def", "2")
+	newDS.Spec.Template.Spec.Affinity = affinityWithRequirement(nil, &corev1.NodeSelectorRequirement{Key: key, Operator: corev1.NodeSelectorOpIn, Values: []string{"def"}})
+	nodes := newDaemonSetNodes()
+	nodes[0].(*corev1.Node).Labels[key] = "def"
+	roCtx, kubeclient := newDaemonSetRolloutContext(ro, append(nodes, stableDS, newDS)...)
+
+	assert.NoError(t, roCtx.reconcileDaemonSets(context.TODO(), newDS, []*appsv1.DaemonSet{stableDS, newDS}, getDaemonSetNodes(t, roCtx)))
+
+	// the affinities do not change, the canary node is unlabeled
+	actions := filterDaemonSetActions(kubeclient.Actions())
+	assert.Len(t, actions, 1)
+	assert.True(t, actions[0].Matches("patch", "nodes"))
+	canaryNode, err := kubeclient.CoreV1().Nodes().Get(context.TODO(), "node-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, canaryNode.Labels, key)
+}
+
+func TestSetDaemonSetReplicaSets(t *testing.T) {
+	ro := newDaemonSetRollout("abc", 2)
+	ro.Status.CurrentPodHash = "def"
+	stableDS := newRolloutDaemonSet(ro, "abc", "1")
+	newDS := newRolloutDaemonSet(ro, "def", "2")
+	objs := append(newDaemonSetNodes(),
+		newDaemonSetPod(stableDS, "node-b", true),
+		newDaemonSetPod(stableDS, "node-c", true),
+		newDaemonSetPod(stableDS, "node-d", true),
+		newDaemonSetPod(newDS, "node-a", true),
+		newDaemonSetPod(newDS, "node-b", false),
+	)
+	roCtx, _ := newDaemonSetRolloutContext(ro, objs...)
+	nodes := getDaemonSetNodes(t, roCtx)
+
+	// the canary runs on node-a and node-b, the stable pod of node-b is not counted as available
+	assert.NoError(t, roCtx.setDaemonSetReplicaSets(newDS, []*appsv1.DaemonSet{stableDS, newDS}, nodes))
+	assert.Equal(t, "agent-def", roCtx.newRS.Name)
+	assert.Equal(t, int32(2), *roCtx.newRS.Spec.Replicas)
+	assert.Equal(t, int32(2), roCtx.newRS.Status.Replicas)
+	assert.Equal(t, int32(1), roCtx.newRS.Status.AvailableReplicas)
+	assert.Equal(t, "agent-abc", roCtx.stableRS.Name)
+	assert.Equal(t, int32(2), *roCtx.stableRS.Spec.Replicas)
+	assert.Equal(t, int32(3), roCtx.stableRS.Status.Replicas)
+	assert.Equal(t, int32(2), roCtx.stableRS.Status.AvailableReplicas)
+	assert.Len(t, roCtx.allRSs, 2)
+	assert.Empty(t, roCtx.otherRSs)
+	assert.False(t, roCtx.atDesiredDaemonSetNodes())
+
+	objs = append(newDaemonSetNodes(),
+		newDaemonSetPod(stableDS, "node-c", true),
+		newDaemonSetPod(stableDS, "node-d", true),
+		newDaemonSetPod(newDS, "node-a", true),
+		newDaemonSetPod(newDS, "node-b", true),
+	)
+	roCtx, _ = newDaemonSetRolloutContext(ro, objs...)
+	assert.NoError(t, roCtx.setDaemonSetReplicaSets(newDS, []*appsv1.DaemonSet{stableDS, newDS}, nodes))
+	assert.True(t, roCtx.atDesiredDaemonSetNodes())
+
+	newDS.Generation = 2
+	newDS.Status.ObservedGeneration = 1
+	assert.NoError(t, roCtx.setDaemonSetReplicaSets(newDS, []*appsv1.DaemonSet{stableDS, newDS}, nodes))
+	assert.False(t, roCtx.atDesiredDaemonSetNodes())
+}
+
+func TestRolloutDaemonSetCreatesDaemonSet(t *testing.T) {
+	ro := newDaemonSetRollout("abc", 0)
+	ro.Annotations = map[string]string{annotations.RevisionAnnotation: "1"}
+	stableDS := newRolloutDaemonSet(ro, "abc", "1")
+	roCtx, kubeclient := newDaemonSetRolloutContext(ro, append(newDaemonSetNodes(), stableDS)...)
+
+	assert.NoError(t, roCtx.rolloutDaemonSet())
+
+	podHash := hash.ComputePodTemplateHash(&ro.Spec.Template, nil)
+	newDS, err := kubeclient.AppsV1().DaemonSets(ro.Namespace).Get(context.TODO(), "agent-"+podHash, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", newDS.Annotations[annotations.RevisionAnnotation])
+	assert.Equal(t, appsv1.OnDeleteDaemonSetStrategyType, newDS.Spec.UpdateStrategy.Type)
+	assert.Equal(t, podHash, newDS.Spec.Selector.MatchLabels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, []corev1.NodeSelectorRequirement{{Key: daemonSetCanaryNodeLabel(ro), Operator: corev1.NodeSelectorOpIn, Values: []string{podHash}}},
+		requiredMatchExpressions(newDS.Spec.Template.Spec.Affinity))
+	assert.Equal(t, "2", roCtx.rollout.Annotations[annotations.RevisionAnnotation])
+
+	// the pod template changed, the status is reset before the DaemonSets are updated
+	status := roCtx.newRollout.Status
+	assert.Equal(t, podHash, status.CurrentPodHash)
+	assert.Equal(t, "abc", status.StableRS)
+	assert.Equal(t, int32(0), *status.CurrentStepIndex)
+}
+
+func TestRolloutDaemonSetSetCanaryNodes(t *testing.T) {
+	ro := newDaemonSetRollout("abc", 0)
+	podHash := hash.ComputePodTemplateHash(&ro.Spec.Template, nil)
+	ro.Annotations = map[string]string{annotations.RevisionAnnotation: "2"}
+	ro.Status.CurrentPodHash = podHash
+	ro.Status.CurrentStepHash = conditions.ComputeStepHash(ro)
+	stableDS := newRolloutDaemonSet(ro, "abc", "1")
+	newDS := newRolloutDaemonSet(ro, podHash, "2")
+	objs := append(newDaemonSetNodes(), stableDS, newDS)
+	for _, node := range []string{"node-a", "node-b", "node-c", "node-d"} {
+		objs = append(objs, newDaemonSetPod(stableDS, node, true))
+	}
+	roCtx, kubeclient := newDaemonSetRolloutContext(ro, objs...)
+
+	assert.NoError(t, roCtx.rolloutDaemonSet())
+
+	key := daemonSetCanaryNodeLabel(ro)
+	updatedStableDS, err := kubeclient.AppsV1().DaemonSets(ro.Namespace).Get(context.TODO(), stableDS.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.NodeSelectorRequirement{{Key: key, Operator: corev1.NodeSelectorOpNotIn, Values: []string{podHash}}},
+		requiredMatchExpressions(updatedStableDS.Spec.Template.Spec.Affinity))
+	canaryNode, err := kubeclient.CoreV1().Nodes().Get(context.TODO(), "node-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, podHash, canaryNode.Labels[key])
+
+	// the replicas are the nodes, the spec of the Rollout is not modified
+	assert.Nil(t, ro.Spec.Replicas)
+	assert.Equal(t, int32(4), roCtx.desiredReplicas())
+
+	status := roCtx.newRollout.Status
+	assert.Equal(t, "abc", status.StableRS)
+	assert.Equal(t, int32(0), *status.CurrentStepIndex)
+	assert.Equal(t, int32(4), status.Replicas)
+	assert.Equal(t, int32(0), status.UpdatedReplicas)
+	assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status.Phase)
+}
+
+func TestStartWorkloadInformersDaemonSet(t *testing.T) {
+	kubeclient := k8sfake.NewSimpleClientset(newDaemonSetNodes()...)
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+	nodeLister := k8sI.Core().V1().Nodes().Lister()
+	c := &Controller{workloadInformers: controllerutil.NewLazyInformerFactory(k8sI)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, c.startWorkloadInformers(ctx, newDaemonSetRollout("", 0)))
+	nodes, err := nodeLister.List(labels.Everything())
+	assert.NoError(t, err)
+	assert.Len(t, nodes, len(newDaemonSetNodes()))
+}
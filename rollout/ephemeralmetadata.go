@@ -6,9 +6,12 @@ import (
 
 	"golang.org/x/sync/errgroup"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	patchtypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 )
 
@@ -17,7 +20,7 @@ const DefaultEphemeralMetadataThreads = 10
 
 // reconcileEphemeralMetadata syncs canary/stable ephemeral metadata to ReplicaSets and pods
 func (c *rolloutContext) reconcileEphemeralMetadata() error {
-	ctx := context.TODO()
+	ctx := c.context()
 	var newMetadata, stableMetadata *v1alpha1.PodTemplateMetadata
 	if c.rollout.Spec.Strategy.Canary != nil {
 		newMetadata = c.rollout.Spec.Strategy.Canary.CanaryMetadata
@@ -97,9 +100,16 @@ func (c *rolloutContext) syncEphemeralMetadata(ctx context.Context, rs *appsv1.R
 			newPodObjectMeta, podModified := replicasetutil.SyncEphemeralPodMetadata(&pod.ObjectMeta, existingPodMetadata, podMetadata)
 			if podModified {
 				pod.ObjectMeta = *newPodObjectMeta
-				_, err = c.kubeclientset.CoreV1().Pods(pod.Namespace).Update(ctx, pod, metav1.UpdateOptions{})
-				if err != nil {
-					return err
+				if apply.Enabled() {
+					err := c.applyEphemeralPodMetadata(ctx, pod, existingPodMetadata, podMetadata)
+					if err != nil {
+						return err
+					}
+				} else {
+					_, err := c.kubeclientset.CoreV1().Pods(pod.Namespace).Update(ctx, pod, metav1.UpdateOptions{})
+					if err != nil {
+						return err
+					}
 				}
 				c.log.Infof("synced ephemeral metadata %v to Pod %s", podMetadata, pod.Name)
 			}
@@ -113,3 +123,31 @@ func (c *rolloutContext) syncEphemeralMetadata(ctx context.Context, rs *appsv1.R
 
 	return nil
 }
+
+// applyEphemeralPodMetadata applies the desired ephemeral labels and annotations of a pod, so that the previously
+// applied ones which are no longer desired are removed. The ones which are not owned by the field manager of the
+// controller, e.g. because they were injected before server-side apply was enabled, are removed with an update.
+func (c *rolloutContext) applyEphemeralPodMetadata(ctx context.Context, pod *corev1.Pod, existingPodMetadata, podMetadata *v1alpha1.PodTemplateMetadata) error {
+	var fields [][]string
+	if podMetadata != nil {
+		for key := range podMetadata.Labels {
+			fields = append(fields, []string{"metadata", "labels", key})
+		}
+		for key := range podMetadata.Annotations {
+			fields = append(fields, []string{"metadata", "annotations", key})
+		}
+	}
+	patch, err := apply.Patch(pod, corev1.SchemeGroupVersion.WithKind("Pod"), fields...)
+	if err != nil {
+		return err
+	}
+	appliedPod, err := c.kubeclientset.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, patchtypes.ApplyPatchType, patch, apply.PatchOptions())
+	if err != nil {
+		return err
+	}
+	if newPodObjectMeta, podModified := replicasetutil.SyncEphemeralPodMetadata(&appliedPod.ObjectMeta, existingPodMetadata, podMetadata); podModified {
+		appliedPod.ObjectMeta = *newPodObjectMeta
+		_, err = c.kubeclientset.CoreV1().Pods(pod.Namespace).Update(ctx, appliedPod, metav1.UpdateOptions{})
+	}
+	return err
+}
@@ -1,6 +1,7 @@
 package rollout
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -8,11 +9,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 )
 
 // TestSyncCanaryEphemeralMetadataInitialRevision verifies when we create a revision 1 ReplicaSet
@@ -283,3 +288,41 @@ func TestReconcileEphemeralMetadata(t *testing.T) {
 	err = mockContext.reconcileEphemeralMetadata()
 	assert.NoError(t, err)
 }
+
+func TestApplyEphemeralPodMetadata(t *testing.T) {
+	defaults.SetServerSideApplyEnabled(true)
+	defer defaults.SetServerSideApplyEnabled(false)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo-abc123",
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				"foo":  "bar",
+				"role": "canary",
+			},
+		},
+	}
+	client := k8sfake.NewSimpleClientset(pod)
+	roCtx := &rolloutContext{reconcilerBase: reconcilerBase{kubeclientset: client}}
+
+	existing := &v1alpha1.PodTemplateMetadata{Labels: map[string]string{"role": "canary"}}
+	desired := &v1alpha1.PodTemplateMetadata{Labels: map[string]string{"tier": "stable"}}
+	desiredPod := pod.DeepCopy()
+	desiredPod.Labels = map[string]string{"foo": "bar", "tier": "stable"}
+	err := roCtx.applyEphemeralPodMetadata(context.Background(), desiredPod, existing, desired)
+	assert.NoError(t, err)
+
+	actions := client.Actions()
+	assert.Len(t, actions, 2)
+	patchAction, ok := actions[0].(k8stesting.PatchAction)
+	assert.True(t, ok)
+	assert.Equal(t, types.ApplyPatchType, patchAction.GetPatchType())
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"Pod","metadata":{"name":"foo-abc123","namespace":"default","labels":{"tier":"stable"}}}`, string(patchAction.GetPatch()))
+	// the label injected before server-side apply was enabled is not owned by the field manager, so it is removed
+	// with an update
+	assert.Equal(t, "update", actions[1].GetVerb())
+	updatedPod, err := client.CoreV1().Pods(metav1.NamespaceDefault).Get(context.Background(), pod.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"foo": "bar", "tier": "stable"}, updatedPod.Labels)
+}
@@ -1,7 +1,6 @@
 package rollout
 
 import (
-	"context"
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -191,7 +190,7 @@ func (c *rolloutContext) reconcileExperiments() error {
 		case v1alpha1.AnalysisPhaseInconclusive:
 			c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveExperiment)
 		case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
-			c.pauseContext.AddAbort(currentEx.Status.Message)
+			c.pauseContext.AddAbortWithCause(abortCauseExperimentFailed, currentEx.Status.Message)
 		case v1alpha1.AnalysisPhaseSuccessful:
 			// Do not set current Experiment after successful experiment
 		default:
@@ -222,7 +221,7 @@ func (c *rolloutContext) reconcileExperiments() error {
 // createExperimentWithCollisionHandling creates the given experiment, but with a new name
 // in the event that an experiment with the same name already exists
 func (c *rolloutContext) createExperimentWithCollisionHandling(newEx *v1alpha1.Experiment) (*v1alpha1.Experiment, error) {
-	ctx := context.TODO()
+	ctx := c.context()
 	collisionCount := 1
 	baseName := newEx.Name
 	for {
@@ -269,7 +268,7 @@ func (c *rolloutContext) cancelExperiments(exs []*v1alpha1.Experiment) error {
 }
 
 func (c *rolloutContext) deleteExperiments(exs []*v1alpha1.Experiment) error {
-	ctx := context.TODO()
+	ctx := c.context()
 	for i := range exs {
 		ex := exs[i]
 		if ex.DeletionTimestamp != nil {
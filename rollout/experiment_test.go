@@ -305,6 +305,7 @@ func TestAbortRolloutAfterFailedExperiment(t *testing.T) {
 		"status": {
 			"abort": true,
 			"abortedAt": "%s",
+			"currentStep": null,
 			"conditions": %s,
 			"canary": {
 				"currentExperiment": null
@@ -0,0 +1,234 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// hookJobCheckTime is how often a Rollout is requeued while the Job of a hook is running. The controller does not
+	// watch Jobs, so it polls the running Jobs.
+	hookJobCheckTime = 10 * time.Second
+	// defaultHookJobTTLSecondsAfterFinished is the TTL of the finished Jobs of hooks which do not set one
+	defaultHookJobTTLSecondsAfterFinished = int32(24 * 60 * 60)
+
+	// hookTypeLabelKey is the label of the Jobs of hooks, set to the type of the hook
+	hookTypeLabelKey = annotations.RolloutLabel + "/hook"
+
+	hookJobCreatedReason   = "HookJobCreated"
+	hookJobSucceededReason = "HookJobSucceeded"
+	hookJobFailedReason    = "HookJobFailed"
+)
+
+// hookJobSuffixes are the suffixes of the names of the Jobs of each hook type
+var hookJobSuffixes = map[v1alpha1.RolloutHookType]string{
+	v1alpha1.RolloutHookTypePreRollout:    "pre-rollout",
+	v1alpha1.RolloutHookTypePostPromotion: "post-promotion",
+	v1alpha1.RolloutHookTypeOnAbort:       "on-abort",
+}
+
+// getHookStatus returns the status of the last Job of a hook type
+func getHookStatus(statuses []v1alpha1.RolloutHookStatus, hookType v1alpha1.RolloutHookType) *v1alpha1.RolloutHookStatus {
+	for i := range statuses {
+		if statuses[i].Type == hookType {
+			return &statuses[i]
+		}
+	}
+	return nil
+}
+
+// setHookStatus replaces the status of the last Job of a hook type
+func setHookStatus(statuses []v1alpha1.RolloutHookStatus, status v1alpha1.RolloutHookStatus) []v1alpha1.RolloutHookStatus {
+	for i := range statuses {
+		if statuses[i].Type == status.Type {
+			statuses[i] = status
+			return statuses
+		}
+	}
+	return append(statuses, status)
+}
+
+// newHookJob returns the Job of a hook for the revision with the given pod template hash
+func newHookJob(rollout *v1alpha1.Rollout, hookType v1alpha1.RolloutHookType, hook *v1alpha1.RolloutHook, podHash string) *batchv1.Job {
+	labels := map[string]string{}
+	for k, v := range hook.Metadata.Labels {
+		labels[k] = v
+	}
+	labels[hookTypeLabelKey] = hookJobSuffixes[hookType]
+	labels[v1alpha1.DefaultRolloutUniqueLabelKey] = podHash
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-%s-%s", rollout.Name, podHash, hookJobSuffixes[hookType]),
+			Namespace:       rollout.Namespace,
+			Labels:          labels,
+			Annotations:     hook.Metadata.Annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rollout, controllerKind)},
+		},
+		Spec: *hook.Spec.DeepCopy(),
+	}
+	if job.Spec.TTLSecondsAfterFinished == nil {
+		job.Spec.TTLSecondsAfterFinished = pointer.Int32(defaultHookJobTTLSecondsAfterFinished)
+	}
+	return job
+}
+
+// runHook runs the Job of a hook for the revision with the given pod template hash, and returns the status of the
+// Job. The Job is created if the hook was not run for the revision yet, or if rerun is true.
+func (c *rolloutContext) runHook(hookType v1alpha1.RolloutHookType, hook *v1alpha1.RolloutHook, podHash string, rerun bool) (*v1alpha1.RolloutHookStatus, error) {
+	ctx := c.context()
+	status := getHookStatus(c.newStatus.Hooks, hookType)
+	if status == nil || status.PodTemplateHash != podHash || rerun {
+		job := newHookJob(c.rollout, hookType, hook, podHash)
+		if rerun {
+			propagation := metav1.DeletePropagationBackground
+			err := c.kubeclientset.BatchV1().Jobs(job.Namespace).Delete(ctx, job.Name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				return nil, err
+			}
+		}
+		_, err := c.kubeclientset.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create %s hook Job %s: %w", hookType, job.Name, err)
+		}
+		if err == nil {
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: hookJobCreatedReason, EventAction: record.ActionRunHook, RelatedObject: job}, "Created %s hook Job %s", hookType, job.Name)
+		}
+		now := timeutil.MetaNow()
+		c.newStatus.Hooks = setHookStatus(c.newStatus.Hooks, v1alpha1.RolloutHookStatus{
+			Type:            hookType,
+			PodTemplateHash: podHash,
+			JobName:         job.Name,
+			Phase:           v1alpha1.RolloutHookPhaseRunning,
+			StartedAt:       &now,
+		})
+		c.enqueueRolloutAfter(c.rollout, hookJobCheckTime)
+		return getHookStatus(c.newStatus.Hooks, hookType), nil
+	}
+	if status.Phase != v1alpha1.RolloutHookPhaseRunning {
+		return status, nil
+	}
+
+	job, err := c.kubeclientset.BatchV1().Jobs(c.rollout.Namespace).Get(ctx, status.JobName, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		c.finishHook(status, v1alpha1.RolloutHookPhaseFailed, fmt.Sprintf("Job %s not found", status.JobName))
+		return status, nil
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			c.finishHook(status, v1alpha1.RolloutHookPhaseSuccessful, "")
+			return status, nil
+		case batchv1.JobFailed:
+			c.finishHook(status, v1alpha1.RolloutHookPhaseFailed, cond.Message)
+			return status, nil
+		}
+	}
+	c.enqueueRolloutAfter(c.rollout, hookJobCheckTime)
+	return status, nil
+}
+
+// finishHook records the completion of the Job of a hook
+func (c *rolloutContext) finishHook(status *v1alpha1.RolloutHookStatus, phase v1alpha1.RolloutHookPhase, message string) {
+	status.Phase = phase
+	status.Message = message
+	now := timeutil.MetaNow()
+	status.FinishedAt = &now
+	if phase == v1alpha1.RolloutHookPhaseSuccessful {
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: hookJobSucceededReason, EventAction: record.ActionRunHook}, "%s hook Job %s succeeded", status.Type, status.JobName)
+		return
+	}
+	c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: hookJobFailedReason, EventAction: record.ActionRunHook}, "%s hook Job %s failed: %s", status.Type, status.JobName, message)
+}
+
+// reconcilePreRolloutHook runs the preRollout hook of a revision whose ReplicaSet does not exist yet, and returns
+// whether the creation of the ReplicaSet must wait for the hook. The update is aborted if the Job fails, and the Job
+// is run again when the update is retried.
+func (c *rolloutContext) reconcilePreRolloutHook() (bool, error) {
+	podHash := hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+	prevStatus := getHookStatus(c.newStatus.Hooks, v1alpha1.RolloutHookTypePreRollout)
+	rerun := prevStatus != nil && prevStatus.PodTemplateHash == podHash && prevStatus.Phase == v1alpha1.RolloutHookPhaseFailed && !c.pauseContext.IsAborted()
+	status, err := c.runHook(v1alpha1.RolloutHookTypePreRollout, c.rollout.Spec.Hooks.PreRollout, podHash, rerun)
+	if err != nil {
+		return true, err
+	}
+	switch status.Phase {
+	case v1alpha1.RolloutHookPhaseSuccessful:
+		return false, nil
+	case v1alpha1.RolloutHookPhaseFailed:
+		if !c.pauseContext.IsAborted() {
+			c.pauseContext.AddAbortWithCause(abortCauseHookFailed, fmt.Sprintf("%s hook Job %s failed", status.Type, status.JobName))
+		}
+	}
+	return true, nil
+}
+
+// reconcileHooks runs the postPromotion hook of the stable revision, and the onAbort hook of an aborted update
+func (c *rolloutContext) reconcileHooks() error {
+	hooks := c.rollout.Spec.Hooks
+	if hooks == nil {
+		return nil
+	}
+	if hooks.PostPromotion != nil && c.rollout.Status.StableRS != "" && rolloututil.IsFullyPromoted(c.rollout) && !c.rollout.Status.Abort {
+		if _, err := c.runHook(v1alpha1.RolloutHookTypePostPromotion, hooks.PostPromotion, c.rollout.Status.StableRS, false); err != nil {
+			return err
+		}
+	}
+	// the onAbort hook is run once the abort is recorded, and again for each later abort of the same revision
+	if hooks.OnAbort != nil && c.rollout.Status.Abort && c.rollout.Status.AbortedAt != nil {
+		podHash := c.rollout.Status.CurrentPodHash
+		prevStatus := getHookStatus(c.newStatus.Hooks, v1alpha1.RolloutHookTypeOnAbort)
+		rerun := prevStatus != nil && prevStatus.PodTemplateHash == podHash && prevStatus.StartedAt != nil && prevStatus.StartedAt.Before(c.rollout.Status.AbortedAt)
+		if _, err := c.runHook(v1alpha1.RolloutHookTypeOnAbort, hooks.OnAbort, podHash, rerun); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncRolloutStatusPreRolloutHook updates the status of a Rollout whose new ReplicaSet waits for the preRollout hook.
+// The status is reset when the new revision is first seen, as for any new revision, so that the steps start over
+// once the ReplicaSet is created.
+func (c *rolloutContext) syncRolloutStatusPreRolloutHook() error {
+	if err := c.reconcileHooks(); err != nil {
+		return err
+	}
+	newStatus := c.calculateBaseStatus()
+	newStatus.AvailableReplicas = replicasetutil.GetAvailableReplicaCountForReplicaSets(c.allRSs)
+	newStatus.HPAReplicas = c.rollout.Status.HPAReplicas
+	newStatus.Selector = c.rollout.Status.Selector
+	newStatus.StableRS = c.rollout.Status.StableRS
+	newStatus.CurrentStepIndex = c.rollout.Status.CurrentStepIndex
+	newStatus.BlueGreen = c.rollout.Status.BlueGreen
+	weights := newStatus.Canary.Weights
+	newStatus.Canary = c.rollout.Status.Canary
+	newStatus.Canary.Weights = weights
+	if c.rollout.Spec.Strategy.Canary != nil {
+		newStatus.CurrentStepHash = conditions.ComputeStepHash(c.rollout)
+	}
+	if newStatus.CurrentPodHash != c.rollout.Status.CurrentPodHash {
+		c.resetRolloutStatus(&newStatus)
+	}
+	newStatus = c.calculateRolloutConditions(newStatus)
+	return c.persistRolloutStatus(&newStatus)
+}
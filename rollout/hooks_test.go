@@ -0,0 +1,272 @@
+package rollout
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func newRolloutHook() *v1alpha1.RolloutHook {
+	return &v1alpha1.RolloutHook{
+		Metadata: v1alpha1.PodTemplateMetadata{Labels: map[string]string{"team": "payments"}},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers:    []corev1.Container{{Name: "hook", Image: "hook"}},
+					RestartPolicy: corev1.RestartPolicyNever,
+				},
+			},
+		},
+	}
+}
+
+func newHooksRolloutContext(ro *v1alpha1.Rollout, objs ...runtime.Object) (*rolloutContext, *k8sfake.Clientset) {
+	kubeclient := k8sfake.NewSimpleClientset(objs...)
+	logCtx := logutil.WithRollout(ro)
+	roCtx := &rolloutContext{
+		rollout: ro,
+		log:     logCtx,
+		newStatus: v1alpha1.RolloutStatus{
+			Hooks: append([]v1alpha1.RolloutHookStatus(nil), ro.Status.Hooks...),
+		},
+		pauseContext: &pauseContext{
+			rollout: ro,
+			log:     logCtx,
+		},
+		reconcilerBase: reconcilerBase{
+			argoprojclientset:   fake.NewSimpleClientset(ro),
+			kubeclientset:       kubeclient,
+			recorder:            record.NewFakeEventRecorder(),
+			enqueueRolloutAfter: func(obj any, duration time.Duration) {},
+		},
+	}
+	return roCtx, kubeclient
+}
+
+func newHookJobWithCondition(name string, condType batchv1.JobConditionType) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{Type: condType, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}},
+		},
+	}
+}
+
+func TestRunHook(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	hook := newRolloutHook()
+	roCtx, kubeclient := newHooksRolloutContext(ro)
+
+	status, err := roCtx.runHook(v1alpha1.RolloutHookTypePostPromotion, hook, "abc123", false)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutHookPhaseRunning, status.Phase)
+	assert.Equal(t, "foo-abc123-post-promotion", status.JobName)
+	assert.NotNil(t, status.StartedAt)
+
+	job, err := kubeclient.BatchV1().Jobs(ro.Namespace).Get(context.TODO(), "foo-abc123-post-promotion", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "payments", job.Labels["team"])
+	assert.Equal(t, "post-promotion", job.Labels[hookTypeLabelKey])
+	assert.Equal(t, "abc123", job.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, defaultHookJobTTLSecondsAfterFinished, *job.Spec.TTLSecondsAfterFinished)
+	assert.Equal(t, ro.Name, job.OwnerReferences[0].Name)
+
+	// the Job is still running
+	status, err = roCtx.runHook(v1alpha1.RolloutHookTypePostPromotion, hook, "abc123", false)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutHookPhaseRunning, status.Phase)
+
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	_, err = kubeclient.BatchV1().Jobs(ro.Namespace).Update(context.TODO(), job, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	status, err = roCtx.runHook(v1alpha1.RolloutHookTypePostPromotion, hook, "abc123", false)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutHookPhaseSuccessful, status.Phase)
+	assert.NotNil(t, status.FinishedAt)
+	assert.Len(t, roCtx.newStatus.Hooks, 1)
+	assert.Equal(t, []string{hookJobCreatedReason, hookJobSucceededReason}, roCtx.recorder.(*record.FakeEventRecorder).Events())
+
+	// a new revision runs a new Job
+	status, err = roCtx.runHook(v1alpha1.RolloutHookTypePostPromotion, hook, "def456", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo-def456-post-promotion", status.JobName)
+	assert.Len(t, roCtx.newStatus.Hooks, 1)
+}
+
+func TestRunHookJobNotFound(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	ro.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+		Type:            v1alpha1.RolloutHookTypeOnAbort,
+		PodTemplateHash: "abc123",
+		JobName:         "foo-abc123-on-abort",
+		Phase:           v1alpha1.RolloutHookPhaseRunning,
+	}}
+	roCtx, _ := newHooksRolloutContext(ro)
+
+	status, err := roCtx.runHook(v1alpha1.RolloutHookTypeOnAbort, newRolloutHook(), "abc123", false)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.RolloutHookPhaseFailed, status.Phase)
+	assert.Equal(t, "Job foo-abc123-on-abort not found", status.Message)
+}
+
+func TestReconcilePreRolloutHook(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	ro.Spec.Hooks = &v1alpha1.RolloutHooks{PreRollout: newRolloutHook()}
+	podHash := hash.ComputePodTemplateHash(&ro.Spec.Template, ro.Status.CollisionCount)
+	jobName := "foo-" + podHash + "-pre-rollout"
+	ro.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+		Type:            v1alpha1.RolloutHookTypePreRollout,
+		PodTemplateHash: podHash,
+		JobName:         jobName,
+		Phase:           v1alpha1.RolloutHookPhaseRunning,
+	}}
+
+	t.Run("succeeded", func(t *testing.T) {
+		roCtx, _ := newHooksRolloutContext(ro.DeepCopy(), newHookJobWithCondition(jobName, batchv1.JobComplete))
+		waiting, err := roCtx.reconcilePreRolloutHook()
+		assert.NoError(t, err)
+		assert.False(t, waiting)
+		assert.False(t, roCtx.pauseContext.IsAborted())
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		roCtx, _ := newHooksRolloutContext(ro.DeepCopy(), newHookJobWithCondition(jobName, batchv1.JobFailed))
+		waiting, err := roCtx.reconcilePreRolloutHook()
+		assert.NoError(t, err)
+		assert.True(t, waiting)
+		assert.True(t, roCtx.pauseContext.IsAborted())
+		assert.Equal(t, "PreRollout hook Job "+jobName+" failed", roCtx.pauseContext.abortMessage)
+		assert.Equal(t, "BackoffLimitExceeded", roCtx.newStatus.Hooks[0].Message)
+	})
+
+	t.Run("failed and aborted", func(t *testing.T) {
+		failed := ro.DeepCopy()
+		failed.Status.Abort = true
+		failed.Status.Hooks[0].Phase = v1alpha1.RolloutHookPhaseFailed
+		roCtx, kubeclient := newHooksRolloutContext(failed, newHookJobWithCondition(jobName, batchv1.JobFailed))
+		waiting, err := roCtx.reconcilePreRolloutHook()
+		assert.NoError(t, err)
+		assert.True(t, waiting)
+		assert.Len(t, filterInformerActions(kubeclient.Actions()), 0)
+	})
+
+	t.Run("failed and retried", func(t *testing.T) {
+		retried := ro.DeepCopy()
+		retried.Status.Hooks[0].Phase = v1alpha1.RolloutHookPhaseFailed
+		roCtx, kubeclient := newHooksRolloutContext(retried, newHookJobWithCondition(jobName, batchv1.JobFailed))
+		waiting, err := roCtx.reconcilePreRolloutHook()
+		assert.NoError(t, err)
+		assert.True(t, waiting)
+		assert.Equal(t, v1alpha1.RolloutHookPhaseRunning, roCtx.newStatus.Hooks[0].Phase)
+		actions := filterInformerActions(kubeclient.Actions())
+		assert.Len(t, actions, 2)
+		assert.True(t, actions[0].Matches("delete", "jobs"))
+		assert.True(t, actions[1].Matches("create", "jobs"))
+	})
+}
+
+func TestReconcileHooks(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	ro.Spec.Hooks = &v1alpha1.RolloutHooks{PostPromotion: newRolloutHook(), OnAbort: newRolloutHook()}
+
+	t.Run("postPromotion of the stable revision", func(t *testing.T) {
+		promoted := ro.DeepCopy()
+		promoted.Status.CurrentPodHash = "abc123"
+		promoted.Status.StableRS = "abc123"
+		roCtx, _ := newHooksRolloutContext(promoted)
+		assert.NoError(t, roCtx.reconcileHooks())
+		assert.Len(t, roCtx.newStatus.Hooks, 1)
+		assert.Equal(t, v1alpha1.RolloutHookTypePostPromotion, roCtx.newStatus.Hooks[0].Type)
+		assert.Equal(t, "foo-abc123-post-promotion", roCtx.newStatus.Hooks[0].JobName)
+	})
+
+	t.Run("no hook while updating", func(t *testing.T) {
+		updating := ro.DeepCopy()
+		updating.Status.CurrentPodHash = "def456"
+		updating.Status.StableRS = "abc123"
+		roCtx, kubeclient := newHooksRolloutContext(updating)
+		assert.NoError(t, roCtx.reconcileHooks())
+		assert.Empty(t, roCtx.newStatus.Hooks)
+		assert.Empty(t, filterInformerActions(kubeclient.Actions()))
+	})
+
+	t.Run("onAbort is run again for a later abort", func(t *testing.T) {
+		aborted := ro.DeepCopy()
+		aborted.Status.CurrentPodHash = "def456"
+		aborted.Status.StableRS = "abc123"
+		aborted.Status.Abort = true
+		abortedAt := timeutil.MetaNow()
+		aborted.Status.AbortedAt = &abortedAt
+		roCtx, _ := newHooksRolloutContext(aborted)
+		assert.NoError(t, roCtx.reconcileHooks())
+		assert.Len(t, roCtx.newStatus.Hooks, 1)
+		assert.Equal(t, "foo-def456-on-abort", roCtx.newStatus.Hooks[0].JobName)
+
+		startedAt := metav1.NewTime(abortedAt.Add(-time.Minute))
+		aborted.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+			Type:            v1alpha1.RolloutHookTypeOnAbort,
+			PodTemplateHash: "def456",
+			JobName:         "foo-def456-on-abort",
+			Phase:           v1alpha1.RolloutHookPhaseSuccessful,
+			StartedAt:       &startedAt,
+		}}
+		roCtx, kubeclient := newHooksRolloutContext(aborted)
+		assert.NoError(t, roCtx.reconcileHooks())
+		assert.Equal(t, v1alpha1.RolloutHookPhaseRunning, roCtx.newStatus.Hooks[0].Phase)
+		actions := filterInformerActions(kubeclient.Actions())
+		assert.Len(t, actions, 2)
+		assert.True(t, actions[1].Matches("create", "jobs"))
+	})
+}
+
+// TestCanaryRolloutWaitsForPreRolloutHook verifies the ReplicaSet of a new revision is not created until the
+// preRollout hook succeeds
+func TestCanaryRolloutWaitsForPreRolloutHook(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 10, 10)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	r2.Spec.Hooks = &v1alpha1.RolloutHooks{PreRollout: newRolloutHook()}
+	newPodHash := r2.Status.CurrentPodHash
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 0, 10, false)
+	r2.Status.CurrentPodHash = rs1PodHash
+
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	f.kubeactions = append(f.kubeactions, core.NewCreateAction(schema.GroupVersionResource{Resource: "jobs"}, r2.Namespace, nil))
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, newPodHash, patched.Status.CurrentPodHash)
+	assert.Equal(t, int32(0), *patched.Status.CurrentStepIndex)
+	assert.Len(t, patched.Status.Hooks, 1)
+	assert.Equal(t, v1alpha1.RolloutHookTypePreRollout, patched.Status.Hooks[0].Type)
+	assert.Equal(t, v1alpha1.RolloutHookPhaseRunning, patched.Status.Hooks[0].Phase)
+	assert.Equal(t, "foo-"+newPodHash+"-pre-rollout", patched.Status.Hooks[0].JobName)
+	assert.Equal(t, "waiting for preRollout hook to complete", patched.Status.Message)
+}
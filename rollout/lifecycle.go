@@ -0,0 +1,119 @@
+package rollout
+
+import (
+	"time"
+
+	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// The causes of the aborts which are not retried by a retryPolicy, reported by the rollout_aborts_total metric
+const (
+	abortCauseManual           = "Manual"
+	abortCauseExperimentFailed = "ExperimentFailed"
+	abortCauseHookFailed       = "HookFailed"
+	abortCauseStepPluginFailed = "StepPluginFailed"
+	abortCauseWaitForTimedOut  = "WaitForTimedOut"
+	abortCauseUnknown          = "Unknown"
+)
+
+// canaryStepType returns the type of a canary step, reported by the rollout_step_duration_seconds metric
+func canaryStepType(step v1alpha1.CanaryStep) string {
+	switch {
+	case step.SetWeight != nil:
+		return "setWeight"
+	case step.Pause != nil:
+		return "pause"
+	case step.Experiment != nil:
+		return "experiment"
+	case step.Analysis != nil:
+		return "analysis"
+	case step.SetCanaryScale != nil:
+		return "setCanaryScale"
+	case step.SetHeaderRoute != nil:
+		return "setHeaderRoute"
+	case step.SetMirrorRoute != nil:
+		return "setMirrorRoute"
+	case step.Plugin != nil:
+		return "plugin"
+	case step.SetCanaryNodes != nil:
+		return "setCanaryNodes"
+	case step.WaitFor != nil:
+		return "waitFor"
+	}
+	return "unknown"
+}
+
+// calculateLifecycleStatus records in newStatus.Lifecycle when the update of the current revision started. The status
+// is cleared once the revision is fully promoted.
+func (c *rolloutContext) calculateLifecycleStatus(newStatus *v1alpha1.RolloutStatus) {
+	podHash := newStatus.CurrentPodHash
+	if podHash == "" || newStatus.StableRS == podHash {
+		newStatus.Lifecycle = nil
+		return
+	}
+	now := timeutil.MetaNow()
+	lifecycle := c.rollout.Status.Lifecycle.DeepCopy()
+	if lifecycle == nil || lifecycle.PodTemplateHash != podHash {
+		startedAt := now
+		// the update started when its ReplicaSet was created, unless it is the ReplicaSet of an older revision
+		if c.newRS != nil && c.stableRS != nil && c.newRS.CreationTimestamp.After(c.stableRS.CreationTimestamp.Time) {
+			startedAt = c.newRS.CreationTimestamp
+		}
+		lifecycle = &v1alpha1.RolloutLifecycleStatus{
+			PodTemplateHash: podHash,
+			StartedAt:       startedAt,
+		}
+	}
+	newStatus.Lifecycle = lifecycle
+}
+
+// recordLifecycleMetrics records the lifecycle metrics of the transitions of a persisted status: the full promotion
+// of the revision, the abort of its update, and the completion of its canary steps
+func (c *rolloutContext) recordLifecycleMetrics(prevStatus, newStatus *v1alpha1.RolloutStatus) {
+	now := timeutil.Now()
+	prevLifecycle := prevStatus.Lifecycle
+	if prevLifecycle != nil && prevLifecycle.PodTemplateHash != newStatus.CurrentPodHash {
+		prevLifecycle = nil
+	}
+
+	if newStatus.StableRS != "" && newStatus.StableRS == newStatus.CurrentPodHash && prevStatus.StableRS != newStatus.StableRS {
+		var duration *time.Duration
+		if prevLifecycle != nil {
+			d := now.Sub(prevLifecycle.StartedAt.Time)
+			duration = &d
+		}
+		metrics.ObserveRolloutCompleted(c.rollout, duration)
+	} else if prevStatus.AbortedAt == nil && newStatus.AbortedAt != nil {
+		cause := abortCauseUnknown
+		if c.pauseContext.addAbort && c.pauseContext.abortCause != "" {
+			cause = c.pauseContext.abortCause
+		} else if prevStatus.Abort {
+			cause = abortCauseManual
+		}
+		var timeToAbort *time.Duration
+		if prevLifecycle != nil {
+			d := now.Sub(prevLifecycle.StartedAt.Time)
+			timeToAbort = &d
+		}
+		metrics.ObserveRolloutAborted(c.rollout, cause, timeToAbort)
+	}
+
+	// the start of the completed step is recorded in status.currentStep
+	prevStep := prevStatus.CurrentStep
+	if prevStep == nil || prevStep.PodTemplateHash != newStatus.CurrentPodHash || c.rollout.Spec.Strategy.Canary == nil {
+		return
+	}
+	if prevStatus.Abort || newStatus.Abort || prevStatus.CurrentStepHash != newStatus.CurrentStepHash {
+		return
+	}
+	index, ok := canaryStepIndex(prevStep.Step)
+	if !ok || (newStatus.CurrentStepIndex != nil && *newStatus.CurrentStepIndex == index) {
+		return
+	}
+	steps := c.rollout.Spec.Strategy.Canary.Steps
+	if int(index) < len(steps) {
+		metrics.ObserveRolloutStep(c.rollout, canaryStepType(steps[index]), now.Sub(prevStep.StartedAt.Time))
+	}
+}
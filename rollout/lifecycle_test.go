@@ -0,0 +1,221 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/controller/metrics"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newLifecycleStatus returns the lifecycle status of a rollout whose update started a minute ago
+func newLifecycleStatus(ro *v1alpha1.Rollout) *v1alpha1.RolloutLifecycleStatus {
+	return &v1alpha1.RolloutLifecycleStatus{
+		PodTemplateHash: ro.Status.CurrentPodHash,
+		StartedAt:       timeutil.MetaTime(time.Now().Add(-time.Minute)),
+	}
+}
+
+// newCurrentStepStatus returns the status of the current canary step of a rollout, which started a minute ago
+func newCurrentStepStatus(ro *v1alpha1.Rollout) *v1alpha1.RolloutStepStatus {
+	return &v1alpha1.RolloutStepStatus{
+		Step:            canaryStepName(*ro.Status.CurrentStepIndex),
+		PodTemplateHash: ro.Status.CurrentPodHash,
+		StartedAt:       timeutil.MetaTime(time.Now().Add(-time.Minute)),
+	}
+}
+
+func newLifecycleRolloutContext(name string) *rolloutContext {
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(10)},
+		{Pause: &v1alpha1.RolloutPause{}},
+		{Analysis: &v1alpha1.RolloutAnalysis{}},
+	}
+	ro := newCanaryRollout(name, 10, nil, steps, pointer.Int32(1), intstr.FromInt(1), intstr.FromInt(0))
+	ro.Labels["team"] = "payments"
+	ro.Status.StableRS = "stable"
+	logCtx := logutil.WithRollout(ro)
+	return &rolloutContext{
+		rollout:      ro,
+		log:          logCtx,
+		pauseContext: &pauseContext{rollout: ro, log: logCtx},
+	}
+}
+
+func histogramSampleCount(t *testing.T, histogram *prometheus.HistogramVec, lvs ...string) uint64 {
+	m := &dto.Metric{}
+	require.NoError(t, histogram.WithLabelValues(lvs...).(prometheus.Metric).Write(m))
+	return m.GetHistogram().GetSampleCount()
+}
+
+func TestCalculateLifecycleStatus(t *testing.T) {
+	roCtx := newLifecycleRolloutContext("lifecycle-status")
+	ro := roCtx.rollout
+
+	// the update of the revision starts to be tracked
+	newStatus := ro.Status.DeepCopy()
+	roCtx.calculateLifecycleStatus(newStatus)
+	require.NotNil(t, newStatus.Lifecycle)
+	assert.Equal(t, ro.Status.CurrentPodHash, newStatus.Lifecycle.PodTemplateHash)
+
+	// the update of a new ReplicaSet started when it was created
+	roCtx.stableRS = newReplicaSetWithStatus(ro, 10, 10)
+	roCtx.stableRS.CreationTimestamp = timeutil.MetaTime(time.Now().Add(-time.Hour))
+	roCtx.newRS = roCtx.stableRS.DeepCopy()
+	roCtx.newRS.CreationTimestamp = timeutil.MetaTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	newStatus = ro.Status.DeepCopy()
+	roCtx.calculateLifecycleStatus(newStatus)
+	assert.Equal(t, roCtx.newRS.CreationTimestamp, newStatus.Lifecycle.StartedAt)
+
+	// the start time is preserved across steps
+	ro.Status.Lifecycle = newLifecycleStatus(ro)
+	ro.Status.CurrentStep = newCurrentStepStatus(ro)
+	newStatus = ro.Status.DeepCopy()
+	newStatus.CurrentStepIndex = pointer.Int32(2)
+	roCtx.calculateLifecycleStatus(newStatus)
+	assert.Equal(t, ro.Status.Lifecycle, newStatus.Lifecycle)
+
+	// the status is cleared once the revision is fully promoted
+	newStatus = ro.Status.DeepCopy()
+	newStatus.StableRS = newStatus.CurrentPodHash
+	roCtx.calculateLifecycleStatus(newStatus)
+	assert.Nil(t, newStatus.Lifecycle)
+}
+
+func TestRecordLifecycleMetricsCompleted(t *testing.T) {
+	defaults.SetMetricsTeamLabelKey("team")
+	defer defaults.SetMetricsTeamLabelKey("")
+	roCtx := newLifecycleRolloutContext("lifecycle-completed")
+	ro := roCtx.rollout
+	ro.Status.Lifecycle = newLifecycleStatus(ro)
+	ro.Status.CurrentStep = newCurrentStepStatus(ro)
+
+	newStatus := ro.Status.DeepCopy()
+	newStatus.StableRS = newStatus.CurrentPodHash
+	newStatus.CurrentStepIndex = pointer.Int32(3)
+	roCtx.recordLifecycleMetrics(&ro.Status, newStatus)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutDeploymentsTotal.WithLabelValues("default", ro.Name, "payments")))
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.MetricRolloutDuration, "default", ro.Name, "payments"))
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.MetricRolloutStepDuration, "default", ro.Name, "payments", "pause"))
+}
+
+func TestRecordLifecycleMetricsStep(t *testing.T) {
+	roCtx := newLifecycleRolloutContext("lifecycle-step")
+	ro := roCtx.rollout
+	ro.Status.Lifecycle = newLifecycleStatus(ro)
+	ro.Status.CurrentStep = newCurrentStepStatus(ro)
+
+	newStatus := ro.Status.DeepCopy()
+	roCtx.recordLifecycleMetrics(&ro.Status, newStatus)
+	assert.Equal(t, uint64(0), histogramSampleCount(t, metrics.MetricRolloutStepDuration, "default", ro.Name, "", "pause"))
+
+	// the step of another revision is not observed
+	prevStatus := ro.Status.DeepCopy()
+	prevStatus.CurrentStep.PodTemplateHash = "other"
+	newStatus.CurrentStepIndex = pointer.Int32(2)
+	roCtx.recordLifecycleMetrics(prevStatus, newStatus)
+	assert.Equal(t, uint64(0), histogramSampleCount(t, metrics.MetricRolloutStepDuration, "default", ro.Name, "", "pause"))
+
+	roCtx.recordLifecycleMetrics(&ro.Status, newStatus)
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.MetricRolloutStepDuration, "default", ro.Name, "", "pause"))
+	assert.Equal(t, float64(0), testutil.ToFloat64(metrics.MetricRolloutDeploymentsTotal.WithLabelValues("default", ro.Name, "")))
+}
+
+func TestRecordLifecycleMetricsAborted(t *testing.T) {
+	roCtx := newLifecycleRolloutContext("lifecycle-aborted")
+	ro := roCtx.rollout
+	ro.Status.Lifecycle = newLifecycleStatus(ro)
+	ro.Status.CurrentStep = newCurrentStepStatus(ro)
+	roCtx.pauseContext.AddAbortWithReason(v1alpha1.RolloutAbortReasonAnalysisFailed, "analysis failed")
+
+	newStatus := ro.Status.DeepCopy()
+	roCtx.pauseContext.CalculatePauseStatus(newStatus)
+	newStatus.CurrentStepIndex = pointer.Int32(0)
+	roCtx.recordLifecycleMetrics(&ro.Status, newStatus)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutAbortsTotal.WithLabelValues("default", ro.Name, "", "AnalysisFailed")))
+	assert.Equal(t, uint64(1), histogramSampleCount(t, metrics.MetricRolloutTimeToAbort, "default", ro.Name, ""))
+	assert.Equal(t, uint64(0), histogramSampleCount(t, metrics.MetricRolloutStepDuration, "default", ro.Name, "", "pause"))
+
+	// an abort is recorded once
+	ro.Status = *newStatus
+	roCtx.recordLifecycleMetrics(&ro.Status, newStatus.DeepCopy())
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutAbortsTotal.WithLabelValues("default", ro.Name, "", "AnalysisFailed")))
+}
+
+func TestRecordLifecycleMetricsManualAbort(t *testing.T) {
+	roCtx := newLifecycleRolloutContext("lifecycle-manual-abort")
+	ro := roCtx.rollout
+	ro.Status.Abort = true
+
+	newStatus := ro.Status.DeepCopy()
+	roCtx.pauseContext.CalculatePauseStatus(newStatus)
+	roCtx.recordLifecycleMetrics(&ro.Status, newStatus)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutAbortsTotal.WithLabelValues("default", ro.Name, "", abortCauseManual)))
+	// the start of updates which were not tracked is unknown
+	assert.Equal(t, uint64(0), histogramSampleCount(t, metrics.MetricRolloutTimeToAbort, "default", ro.Name, ""))
+}
+
+func TestSyncReplicaSetRevisionRecordsRollback(t *testing.T) {
+	roCtx := newLifecycleRolloutContext("lifecycle-rollback")
+	ro := roCtx.rollout
+	ro.Annotations[annotations.RevisionAnnotation] = "3"
+	oldRS := newReplicaSetWithStatus(ro, 10, 10)
+	oldRS.Annotations[annotations.RevisionAnnotation] = "1"
+	newerRS := oldRS.DeepCopy()
+	newerRS.Name = "lifecycle-rollback-newer"
+	newerRS.Annotations[annotations.RevisionAnnotation] = "2"
+	roCtx.kubeclientset = k8sfake.NewSimpleClientset(oldRS, newerRS)
+	roCtx.newRS = oldRS
+	roCtx.olderRSs = append(roCtx.olderRSs, newerRS)
+
+	rs, err := roCtx.syncReplicaSetRevision()
+	require.NoError(t, err)
+	assert.Equal(t, "3", rs.Annotations[annotations.RevisionAnnotation])
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutRollbacksTotal.WithLabelValues("default", ro.Name, "")))
+
+	// the revision of the ReplicaSet of an update is not bumped again
+	roCtx.newRS = rs
+	_, err = roCtx.syncReplicaSetRevision()
+	require.NoError(t, err)
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutRollbacksTotal.WithLabelValues("default", ro.Name, "")))
+}
+
+func TestRecordAnalysisRunFailure(t *testing.T) {
+	ro := newLifecycleRolloutContext("lifecycle-analysis").rollout
+	ar := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{annotations.AnalysisTemplatesAnnotation: "success-rate,latency"},
+		},
+		Status: v1alpha1.AnalysisRunStatus{Phase: v1alpha1.AnalysisPhaseFailed},
+	}
+	recordAnalysisRunFailure(ro, ar)
+	ar.Status.Phase = v1alpha1.AnalysisPhaseSuccessful
+	recordAnalysisRunFailure(ro, ar)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutAnalysisFailuresTotal.WithLabelValues("default", ro.Name, "", "success-rate", "Failed")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(metrics.MetricRolloutAnalysisFailuresTotal.WithLabelValues("default", ro.Name, "", "latency", "Failed")))
+}
+
+func TestCanaryStepType(t *testing.T) {
+	assert.Equal(t, "setWeight", canaryStepType(v1alpha1.CanaryStep{SetWeight: pointer.Int32(10)}))
+	assert.Equal(t, "experiment", canaryStepType(v1alpha1.CanaryStep{Experiment: &v1alpha1.RolloutExperimentStep{}}))
+	assert.Equal(t, "plugin", canaryStepType(v1alpha1.CanaryStep{Plugin: &v1alpha1.PluginStep{}}))
+	assert.Equal(t, "unknown", canaryStepType(v1alpha1.CanaryStep{}))
+}
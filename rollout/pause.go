@@ -20,6 +20,9 @@ type pauseContext struct {
 	addAbort             bool
 	removeAbort          bool
 	abortMessage         string
+	abortReason          v1alpha1.RolloutAbortReason
+	// abortCause is the cause of the abort reported by the lifecycle metrics
+	abortCause string
 }
 
 func (pCtx *pauseContext) HasAddPause() bool {
@@ -41,6 +44,18 @@ func (pCtx *pauseContext) AddAbort(message string) {
 	pCtx.abortMessage = message
 }
 
+// AddAbortWithReason aborts the update for a reason which can be retried by the retryPolicy
+func (pCtx *pauseContext) AddAbortWithReason(reason v1alpha1.RolloutAbortReason, message string) {
+	pCtx.AddAbortWithCause(string(reason), message)
+	pCtx.abortReason = reason
+}
+
+// AddAbortWithCause aborts the update, reporting the cause in the lifecycle metrics
+func (pCtx *pauseContext) AddAbortWithCause(cause, message string) {
+	pCtx.AddAbort(message)
+	pCtx.abortCause = cause
+}
+
 func (pCtx *pauseContext) RemoveAbort() {
 	pCtx.removeAbort = true
 }
@@ -135,7 +150,17 @@ func (c *rolloutContext) completedPrePromotionAnalysis() bool {
 	if currentAr != nil && currentAr.Status.Phase == v1alpha1.AnalysisPhaseSuccessful {
 		return true
 	}
-	return false
+	return c.completedTimedOutStep(prePromotionStep, c.rollout.Spec.Strategy.BlueGreen.PrePromotionTimeout)
+}
+
+// completedPostPromotionAnalysis returns whether the post-promotion analysis was successful, or whether its
+// timeout expired with the Skip policy, or with the Pause policy and the rollout was promoted since.
+func (c *rolloutContext) completedPostPromotionAnalysis() bool {
+	currentAr := c.currentArs.BlueGreenPostPromotion
+	if currentAr != nil && currentAr.Status.Phase == v1alpha1.AnalysisPhaseSuccessful {
+		return true
+	}
+	return c.completedTimedOutStep(postPromotionStep, c.rollout.Spec.Strategy.BlueGreen.PostPromotionTimeout)
 }
 
 // CompletedBlueGreenPause returns true if we have already completed our automated pause, either
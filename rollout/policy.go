@@ -0,0 +1,60 @@
+package rollout
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/policy"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// enforcePolicies evaluates the RolloutPolicies of the namespace of the rollout and the ClusterRolloutPolicies against
+// a new revision of the rollout. The violations of the rules enforced with Warn are emitted as warning events, while
+// the violations of the rules enforced with Deny are returned as a validation error, which blocks the new revision
+// until the rollout or the policies are fixed. The revisions which already started are not blocked, so that adding a
+// policy does not interrupt the updates in progress.
+func (c *rolloutContext) enforcePolicies() error {
+	podHash := hash.ComputePodTemplateHash(&c.rollout.Spec.Template, c.rollout.Status.CollisionCount)
+	if podHash == c.rollout.Status.CurrentPodHash {
+		return nil
+	}
+	policies, err := c.getRolloutPolicies()
+	if err != nil {
+		return err
+	}
+	violations, err := policy.Evaluate(c.rollout, policies)
+	if err != nil {
+		return err
+	}
+
+	var denied []string
+	for _, v := range violations {
+		if v.EnforcementAction == v1alpha1.PolicyEnforcementActionDeny {
+			denied = append(denied, v.String())
+			continue
+		}
+		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutPolicyViolationReason}, "New revision %s violates %s", podHash, v)
+	}
+	if len(denied) > 0 {
+		return field.Forbidden(field.NewPath("spec"), "violates "+strings.Join(denied, "; "))
+	}
+	return nil
+}
+
+// getRolloutPolicies returns the RolloutPolicies of the namespace of the rollout and the ClusterRolloutPolicies
+func (c *rolloutContext) getRolloutPolicies() ([]policy.Policy, error) {
+	rps, err := c.rolloutPolicyLister.RolloutPolicies(c.rollout.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	crps, err := c.clusterRolloutPolicyLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	return append(policy.FromRolloutPolicies(rps), policy.FromClusterRolloutPolicies(crps)...), nil
+}
@@ -0,0 +1,106 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+func analysisStepPolicy(action v1alpha1.PolicyEnforcementAction) v1alpha1.RolloutPolicySpec {
+	return v1alpha1.RolloutPolicySpec{
+		EnforcementAction: action,
+		Rules: []v1alpha1.RolloutPolicyRule{{
+			Name:       "analysis-step",
+			Expression: `plan.steps.exists(s, s.type == "analysis" && s.weight < 50)`,
+			Message:    "an analysis step is required before 50%",
+		}},
+	}
+}
+
+func TestRolloutPolicyDeny(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 10, nil, []v1alpha1.CanaryStep{{SetWeight: int32Ptr(60)}}, nil, intstr.FromInt(1), intstr.FromInt(0))
+	r.Status.CurrentPodHash = ""
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+	crp := &v1alpha1.ClusterRolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec:       analysisStepPolicy(""),
+	}
+	f.clusterRolloutPolicyLister = append(f.clusterRolloutPolicyLister, crp)
+	f.objects = append(f.objects, crp)
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.runExpectError(getKey(r, t), true)
+
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patchedRollout.Status, v1alpha1.InvalidSpec)
+	assert.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, conditions.RolloutPolicyViolationReason, cond.Reason)
+	assert.Equal(t, `The Rollout "foo" is invalid: spec: Forbidden: violates ClusterRolloutPolicy/production rule 'analysis-step': an analysis step is required before 50%`, cond.Message)
+}
+
+func TestRolloutPolicyWarn(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 10, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	r.Status.CurrentPodHash = ""
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+	rp := &v1alpha1.RolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "analysis", Namespace: r.Namespace},
+		Spec:       analysisStepPolicy(v1alpha1.PolicyEnforcementActionWarn),
+	}
+	// the policies of the other namespaces do not apply
+	otherRP := &v1alpha1.RolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "analysis", Namespace: "other"},
+		Spec:       analysisStepPolicy(v1alpha1.PolicyEnforcementActionDeny),
+	}
+	f.rolloutPolicyLister = append(f.rolloutPolicyLister, rp, otherRP)
+	f.objects = append(f.objects, rp, otherRP)
+
+	rs := newReplicaSet(r, 1)
+	f.expectCreateReplicaSetAction(rs)
+	f.expectUpdateReplicaSetAction(rs)
+	f.expectUpdateRolloutStatusAction(r)
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	assert.Contains(t, f.events, conditions.RolloutPolicyViolationReason)
+}
+
+func TestRolloutPolicyStartedRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 10, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	rs := newReplicaSetWithStatus(r, 10, 10)
+	r.Status.CurrentPodHash = rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r.Status.StableRS = r.Status.CurrentPodHash
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+	f.kubeobjects = append(f.kubeobjects, rs)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	crp := &v1alpha1.ClusterRolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec:       analysisStepPolicy(v1alpha1.PolicyEnforcementActionDeny),
+	}
+	f.clusterRolloutPolicyLister = append(f.clusterRolloutPolicyLister, crp)
+	f.objects = append(f.objects, crp)
+
+	// the policies are only enforced on new revisions
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	assert.NotContains(t, f.events, conditions.RolloutPolicyViolationReason)
+}
@@ -0,0 +1,76 @@
+package rollout
+
+import (
+	"strconv"
+
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/queue"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// NewQueuePriorityFunc returns the priority function of the rollout workqueue, which classifies the queued
+// namespace/name keys with the rollouts of the lister
+func NewQueuePriorityFunc(rolloutLister listers.RolloutLister) queue.PriorityFunc {
+	return func(item any) queue.Priority {
+		key, ok := item.(string)
+		if !ok {
+			return queue.PriorityNormal
+		}
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return queue.PriorityNormal
+		}
+		rollout, err := rolloutLister.Rollouts(namespace).Get(name)
+		if err != nil {
+			return queue.PriorityNormal
+		}
+		return rolloutQueuePriority(rollout)
+	}
+}
+
+// rolloutQueuePriority returns the high priority class for the rollouts which are progressing, paused until a timer
+// elapses or which have a pending promote, abort or spec change, and the low priority class for the healthy rollouts
+// whose reconciliations are steady-state resyncs
+func rolloutQueuePriority(rollout *v1alpha1.Rollout) queue.Priority {
+	status := rollout.Status
+	switch {
+	case status.ObservedGeneration != strconv.Itoa(int(rollout.Generation)):
+		return queue.PriorityHigh
+	case status.Abort && status.AbortedAt == nil:
+		return queue.PriorityHigh
+	case status.PromoteFull:
+		return queue.PriorityHigh
+	case status.ControllerPause && len(status.PauseConditions) == 0:
+		// the pause conditions were cleared by a promote
+		return queue.PriorityHigh
+	case status.Phase == v1alpha1.RolloutPhaseProgressing:
+		return queue.PriorityHigh
+	case status.Phase == v1alpha1.RolloutPhasePaused && hasTimedPause(rollout):
+		return queue.PriorityHigh
+	case status.Phase == v1alpha1.RolloutPhaseHealthy:
+		return queue.PriorityLow
+	}
+	return queue.PriorityNormal
+}
+
+// hasTimedPause returns whether the rollout is paused until the duration of a pause elapses
+func hasTimedPause(rollout *v1alpha1.Rollout) bool {
+	for _, cond := range rollout.Status.PauseConditions {
+		switch cond.Reason {
+		case v1alpha1.PauseReasonCanaryPauseStep:
+			if step, _ := replicasetutil.GetCurrentCanaryStep(rollout); step != nil && step.Pause != nil && step.Pause.Duration != nil {
+				return true
+			}
+		case v1alpha1.PauseReasonBlueGreenPause:
+			if rollout.Spec.Strategy.BlueGreen != nil && rollout.Spec.Strategy.BlueGreen.AutoPromotionSeconds > 0 {
+				return true
+			}
+		case v1alpha1.PauseReasonBlueGreenTrafficStepPause:
+			return true
+		}
+	}
+	return false
+}
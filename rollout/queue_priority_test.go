@@ -0,0 +1,98 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/queue"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func TestRolloutQueuePriority(t *testing.T) {
+	steadyRollout := func(phase v1alpha1.RolloutPhase) *v1alpha1.Rollout {
+		steps := []v1alpha1.CanaryStep{
+			{SetWeight: pointer.Int32Ptr(10)},
+			{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromInt(60)}},
+			{Pause: &v1alpha1.RolloutPause{}},
+		}
+		ro := newCanaryRollout("foo", 1, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+		ro.Generation = 1
+		ro.Status.ObservedGeneration = "1"
+		ro.Status.Phase = phase
+		return ro
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(ro *v1alpha1.Rollout)
+		phase    v1alpha1.RolloutPhase
+		expected queue.Priority
+	}{
+		{name: "healthy", phase: v1alpha1.RolloutPhaseHealthy, expected: queue.PriorityLow},
+		{name: "degraded", phase: v1alpha1.RolloutPhaseDegraded, expected: queue.PriorityNormal},
+		{name: "progressing", phase: v1alpha1.RolloutPhaseProgressing, expected: queue.PriorityHigh},
+		{name: "spec change", phase: v1alpha1.RolloutPhaseHealthy, expected: queue.PriorityHigh, mutate: func(ro *v1alpha1.Rollout) {
+			ro.Generation = 2
+		}},
+		{name: "pending abort", phase: v1alpha1.RolloutPhaseProgressing, expected: queue.PriorityHigh, mutate: func(ro *v1alpha1.Rollout) {
+			ro.Status.Abort = true
+		}},
+		{name: "aborted", phase: v1alpha1.RolloutPhaseDegraded, expected: queue.PriorityNormal, mutate: func(ro *v1alpha1.Rollout) {
+			ro.Status.Abort = true
+			abortedAt := timeutil.MetaNow()
+			ro.Status.AbortedAt = &abortedAt
+		}},
+		{name: "promote full", phase: v1alpha1.RolloutPhaseDegraded, expected: queue.PriorityHigh, mutate: func(ro *v1alpha1.Rollout) {
+			ro.Status.PromoteFull = true
+		}},
+		{name: "pending promote", phase: v1alpha1.RolloutPhasePaused, expected: queue.PriorityHigh, mutate: func(ro *v1alpha1.Rollout) {
+			ro.Status.CurrentStepIndex = pointer.Int32Ptr(2)
+			ro.Status.ControllerPause = true
+		}},
+		{name: "timed pause", phase: v1alpha1.RolloutPhasePaused, expected: queue.PriorityHigh, mutate: func(ro *v1alpha1.Rollout) {
+			ro.Status.CurrentStepIndex = pointer.Int32Ptr(1)
+			ro.Status.ControllerPause = true
+			ro.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonCanaryPauseStep, StartTime: timeutil.MetaNow()}}
+		}},
+		{name: "indefinite pause", phase: v1alpha1.RolloutPhasePaused, expected: queue.PriorityNormal, mutate: func(ro *v1alpha1.Rollout) {
+			ro.Status.CurrentStepIndex = pointer.Int32Ptr(2)
+			ro.Status.ControllerPause = true
+			ro.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonCanaryPauseStep, StartTime: timeutil.MetaNow()}}
+		}},
+		{name: "blue-green auto promotion", phase: v1alpha1.RolloutPhasePaused, expected: queue.PriorityHigh, mutate: func(ro *v1alpha1.Rollout) {
+			ro.Spec.Strategy = v1alpha1.RolloutStrategy{BlueGreen: &v1alpha1.BlueGreenStrategy{AutoPromotionSeconds: 30}}
+			ro.Status.ControllerPause = true
+			ro.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonBlueGreenPause, StartTime: timeutil.MetaNow()}}
+		}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ro := steadyRollout(test.phase)
+			if test.mutate != nil {
+				test.mutate(ro)
+			}
+			assert.Equal(t, test.expected, rolloutQueuePriority(ro))
+		})
+	}
+}
+
+func TestNewQueuePriorityFunc(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.NoError(t, indexer.Add(&v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", Generation: 1},
+		Status:     v1alpha1.RolloutStatus{ObservedGeneration: "1", Phase: v1alpha1.RolloutPhaseHealthy},
+	}))
+	priorityFunc := NewQueuePriorityFunc(listers.NewRolloutLister(indexer))
+
+	assert.Equal(t, queue.PriorityLow, priorityFunc("default/foo"))
+	assert.Equal(t, queue.PriorityNormal, priorityFunc("default/missing"))
+	assert.Equal(t, queue.PriorityNormal, priorityFunc("a/b/c"))
+	assert.Equal(t, queue.PriorityNormal, priorityFunc(1))
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/kubernetes/pkg/controller"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
@@ -27,14 +28,45 @@ const (
 	removeScaleDownAtAnnotationsPatch = `[{ "op": "remove", "path": "/metadata/annotations/%s"}]`
 )
 
+// replicaSetApplyFields are the fields of the ReplicaSets written by the controller once they are created. They are
+// all applied on every write, since an apply releases the fields it omits.
+var replicaSetApplyFields = [][]string{
+	{"metadata", "annotations"},
+	{"spec", "replicas"},
+	{"spec", "minReadySeconds"},
+	{"spec", "template", "metadata", "labels"},
+	{"spec", "template", "metadata", "annotations"},
+	{"spec", "template", "spec", "affinity"},
+}
+
+// applyReplicaSet writes the fields of the ReplicaSet owned by the controller with server-side apply. The conflicts
+// are always forced, since the fields were set by the controller itself when it created the ReplicaSet.
+func (c *rolloutContext) applyReplicaSet(ctx context.Context, rs *appsv1.ReplicaSet) (*appsv1.ReplicaSet, error) {
+	patch, err := apply.Patch(rs, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"), replicaSetApplyFields...)
+	if err != nil {
+		return nil, err
+	}
+	opts := apply.PatchOptions()
+	force := true
+	opts.Force = &force
+	return c.kubeclientset.AppsV1().ReplicaSets(rs.Namespace).Patch(ctx, rs.Name, patchtypes.ApplyPatchType, patch, opts)
+}
+
 // removeScaleDownDelay removes the `scale-down-deadline` annotation from the ReplicaSet (if it exists)
 func (c *rolloutContext) removeScaleDownDelay(rs *appsv1.ReplicaSet) error {
-	ctx := context.TODO()
+	ctx := c.context()
 	if !replicasetutil.HasScaleDownDeadline(rs) {
 		return nil
 	}
-	patch := fmt.Sprintf(removeScaleDownAtAnnotationsPatch, v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey)
-	rs, err := c.kubeclientset.AppsV1().ReplicaSets(rs.Namespace).Patch(ctx, rs.Name, patchtypes.JSONPatchType, []byte(patch), metav1.PatchOptions{})
+	var err error
+	if apply.Enabled() {
+		rsCopy := rs.DeepCopy()
+		delete(rsCopy.Annotations, v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey)
+		_, err = c.applyReplicaSet(ctx, rsCopy)
+	} else {
+		patch := fmt.Sprintf(removeScaleDownAtAnnotationsPatch, v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey)
+		_, err = c.kubeclientset.AppsV1().ReplicaSets(rs.Namespace).Patch(ctx, rs.Name, patchtypes.JSONPatchType, []byte(patch), metav1.PatchOptions{})
+	}
 	if err != nil {
 		return fmt.Errorf("error removing scale-down-deadline annotation from RS '%s': %w", rs.Name, err)
 	}
@@ -48,7 +80,7 @@ func (c *rolloutContext) addScaleDownDelay(rs *appsv1.ReplicaSet, scaleDownDelay
 	if rs == nil {
 		return nil
 	}
-	ctx := context.TODO()
+	ctx := c.context()
 	if scaleDownDelaySeconds == 0 {
 		// If scaledown deadline is zero, it means we need to remove any replicasets with the delay
 		// This might happen if we switch from canary with traffic routing to basic canary
@@ -58,8 +90,18 @@ func (c *rolloutContext) addScaleDownDelay(rs *appsv1.ReplicaSet, scaleDownDelay
 		return nil
 	}
 	deadline := timeutil.MetaNow().Add(scaleDownDelaySeconds).UTC().Format(time.RFC3339)
-	patch := fmt.Sprintf(addScaleDownAtAnnotationsPatch, v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey, deadline)
-	rs, err := c.kubeclientset.AppsV1().ReplicaSets(rs.Namespace).Patch(ctx, rs.Name, patchtypes.JSONPatchType, []byte(patch), metav1.PatchOptions{})
+	var err error
+	if apply.Enabled() {
+		rsCopy := rs.DeepCopy()
+		if rsCopy.Annotations == nil {
+			rsCopy.Annotations = map[string]string{}
+		}
+		rsCopy.Annotations[v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey] = deadline
+		_, err = c.applyReplicaSet(ctx, rsCopy)
+	} else {
+		patch := fmt.Sprintf(addScaleDownAtAnnotationsPatch, v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey, deadline)
+		_, err = c.kubeclientset.AppsV1().ReplicaSets(rs.Namespace).Patch(ctx, rs.Name, patchtypes.JSONPatchType, []byte(patch), metav1.PatchOptions{})
+	}
 	if err != nil {
 		return fmt.Errorf("error adding scale-down-deadline annotation to RS '%s': %w", rs.Name, err)
 	}
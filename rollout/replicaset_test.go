@@ -11,6 +11,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sinformers "k8s.io/client-go/informers"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -20,6 +21,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -649,3 +651,52 @@ func TestScaleDownProgressively(t *testing.T) {
 
 	}
 }
+
+func TestAddScaleDownDelayServerSideApply(t *testing.T) {
+	defaults.SetServerSideApplyEnabled(true)
+	defer defaults.SetServerSideApplyEnabled(false)
+
+	ro := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(1))
+	rs := newReplicaSetWithStatus(ro, 1, 1)
+
+	f := newFixture(t)
+	defer f.Close()
+	f.kubeobjects = append(f.kubeobjects, rs)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	f.objects = append(f.objects, ro)
+	f.rolloutLister = append(f.rolloutLister, ro)
+
+	ctrl, _, _ := f.newController(noResyncPeriodFunc)
+	roCtx, err := ctrl.newRolloutContext(ro)
+	assert.NoError(t, err)
+	f.kubeclient.ClearActions()
+
+	err = roCtx.addScaleDownDelay(rs, 30*time.Second)
+	assert.NoError(t, err)
+	deadline := timeutil.MetaNow().Add(30 * time.Second).UTC().Format(time.RFC3339)
+
+	actions := filterInformerActions(f.kubeclient.Actions())
+	assert.Len(t, actions, 1)
+	patchAction, ok := actions[0].(core.PatchAction)
+	assert.True(t, ok)
+	assert.Equal(t, types.ApplyPatchType, patchAction.GetPatchType())
+	// the fields written by the other updates of the ReplicaSet are applied along with the annotation, since an
+	// apply releases the fields it omits
+	assert.JSONEq(t, `{
+		"apiVersion": "apps/v1",
+		"kind": "ReplicaSet",
+		"metadata": {
+			"name": "`+rs.Name+`",
+			"namespace": "default",
+			"annotations": {
+				"`+annotations.DesiredReplicasAnnotation+`": "1",
+				"`+annotations.RevisionAnnotation+`": "1",
+				"`+v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey+`": "`+deadline+`"
+			}
+		},
+		"spec": {
+			"replicas": 1,
+			"template": {"metadata": {"labels": {"foo": "bar", "`+v1alpha1.DefaultRolloutUniqueLabelKey+`": "`+rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]+`"}}}
+		}
+	}`, string(patchAction.GetPatch()))
+}
@@ -0,0 +1,143 @@
+package rollout
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// defaultRetryBackoffDuration is the delay before the first retry of a retryPolicy without backoff duration
+	defaultRetryBackoffDuration = 30 * time.Second
+	// defaultRetryBackoffFactor is the factor of the backoff of a retryPolicy without backoff factor
+	defaultRetryBackoffFactor = int32(2)
+
+	retryScheduledReason = "RolloutRetryScheduled"
+	retriedReason        = "RolloutRetried"
+	retryExhaustedReason = "RolloutRetriesExhausted"
+)
+
+// defaultRetryOn are the reasons of the aborts retried by a retryPolicy without retryOn
+var defaultRetryOn = []v1alpha1.RolloutAbortReason{
+	v1alpha1.RolloutAbortReasonAnalysisError,
+	v1alpha1.RolloutAbortReasonAnalysisInconclusive,
+}
+
+// retryOn returns whether the retry policy retries the aborts with the given reason
+func retryOn(policy *v1alpha1.RolloutRetryPolicy, reason v1alpha1.RolloutAbortReason) bool {
+	reasons := policy.RetryOn
+	if len(reasons) == 0 {
+		reasons = defaultRetryOn
+	}
+	for _, r := range reasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// retryBackoff returns the delay before a retry of an update which was already retried the given number of times
+func retryBackoff(policy *v1alpha1.RolloutRetryPolicy, attempts int32) time.Duration {
+	delay := defaultRetryBackoffDuration
+	factor := defaultRetryBackoffFactor
+	var maxDelay time.Duration
+	if backoff := policy.Backoff; backoff != nil {
+		if backoff.Duration != "" {
+			if d, err := backoff.Duration.Duration(); err == nil {
+				delay = d
+			}
+		}
+		if backoff.Factor != nil {
+			factor = *backoff.Factor
+		}
+		if backoff.MaxDuration != "" {
+			if d, err := backoff.MaxDuration.Duration(); err == nil {
+				maxDelay = d
+			}
+		}
+	}
+	for i := int32(0); i < attempts; i++ {
+		delay *= time.Duration(factor)
+		if maxDelay > 0 && delay >= maxDelay {
+			break
+		}
+	}
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+	return delay
+}
+
+// retryAttempts returns the number of automatic retries of the update of the revision with the given pod template hash
+func retryAttempts(status *v1alpha1.RolloutRetryStatus, podHash string) int32 {
+	if status == nil || status.PodTemplateHash != podHash {
+		return 0
+	}
+	return status.Attempts
+}
+
+// retriesAbort returns whether an abort of the current update with the given reason is retried by the retryPolicy
+func (c *rolloutContext) retriesAbort(reason v1alpha1.RolloutAbortReason) bool {
+	policy := c.rollout.Spec.RetryPolicy
+	if policy == nil || !retryOn(policy, reason) {
+		return false
+	}
+	return retryAttempts(c.rollout.Status.Retry, c.rollout.Status.CurrentPodHash) < policy.Limit
+}
+
+// calculateRetryStatus schedules the retry of an update which was aborted for a reason retried by the retryPolicy,
+// and retries the update once the backoff elapsed. An update is retried the same way as by the retry command: the
+// abort is cleared while the abort time is kept, so that the steps and the AnalysisRuns start over.
+func (c *rolloutContext) calculateRetryStatus(newStatus *v1alpha1.RolloutStatus) {
+	policy := c.rollout.Spec.RetryPolicy
+	if policy == nil {
+		newStatus.Retry = nil
+		return
+	}
+	retry := c.rollout.Status.Retry.DeepCopy()
+	if retry == nil || retry.PodTemplateHash != newStatus.CurrentPodHash {
+		retry = &v1alpha1.RolloutRetryStatus{PodTemplateHash: newStatus.CurrentPodHash}
+	}
+	newStatus.Retry = retry
+	if !newStatus.Abort {
+		retry.NextRetryAt = nil
+		return
+	}
+
+	now := timeutil.MetaNow()
+	if c.pauseContext.addAbort && !c.rollout.Status.Abort {
+		reason := c.pauseContext.abortReason
+		retry.LastAbortReason = reason
+		retry.NextRetryAt = nil
+		if reason == "" || !retryOn(policy, reason) {
+			return
+		}
+		if retry.Attempts >= policy.Limit {
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: retryExhaustedReason, EventAction: record.ActionRetry}, "Rollout aborted (%s) after %d retries", reason, retry.Attempts)
+			return
+		}
+		delay := retryBackoff(policy, retry.Attempts)
+		nextRetryAt := metav1.NewTime(now.Add(delay))
+		retry.NextRetryAt = &nextRetryAt
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: retryScheduledReason, EventAction: record.ActionRetry}, "Rollout aborted (%s), retrying in %s (attempt %d/%d)", reason, delay, retry.Attempts+1, policy.Limit)
+		c.enqueueRolloutAfter(c.rollout, delay)
+		return
+	}
+
+	if retry.NextRetryAt == nil {
+		return
+	}
+	if remaining := retry.NextRetryAt.Sub(now.Time); remaining > 0 {
+		c.enqueueRolloutAfter(c.rollout, remaining)
+		return
+	}
+	retry.Attempts++
+	retry.NextRetryAt = nil
+	newStatus.Abort = false
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: retriedReason, EventAction: record.ActionRetry}, "Retrying the aborted update (attempt %d/%d)", retry.Attempts, policy.Limit)
+}
@@ -0,0 +1,208 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newRetryRolloutFixture returns a fixture for an update of a canary rollout with the given retryPolicy, whose
+// analysis step completed in the given phase
+func newRetryRolloutFixture(t *testing.T, policy *v1alpha1.RolloutRetryPolicy, phase v1alpha1.AnalysisPhase, updateStatus func(*v1alpha1.Rollout, string)) (*fixture, *v1alpha1.Rollout) {
+	f := newFixture(t)
+
+	at := analysisTemplate("bar")
+	steps := []v1alpha1.CanaryStep{{
+		Analysis: &v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: at.Name}},
+		},
+	}}
+	r1 := newCanaryRollout("foo", 1, nil, steps, pointer.Int32(0), intstr.FromInt(0), intstr.FromInt(1))
+	r1.Spec.RetryPolicy = policy
+	r2 := bumpVersion(r1)
+	ar := analysisRun(at, v1alpha1.RolloutTypeStepLabel, r2)
+	ar.Status = v1alpha1.AnalysisRunStatus{Phase: phase, Message: string(phase)}
+
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, 0, 0)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 1, 0, 1, false)
+	r2.Status.CurrentPodHash = rs2PodHash
+	r2.Status.Canary.CurrentStepAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: phase,
+	}
+	if updateStatus != nil {
+		updateStatus(r2, rs2PodHash)
+	}
+
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, r2, at, ar)
+	return f, r2
+}
+
+func TestRetryScheduledOnAnalysisError(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{Limit: 2}
+	f, r2 := newRetryRolloutFixture(t, policy, v1alpha1.AnalysisPhaseError, nil)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.Abort)
+	assert.NotNil(t, patched.Status.Retry)
+	assert.Equal(t, r2.Status.CurrentPodHash, patched.Status.Retry.PodTemplateHash)
+	assert.Equal(t, int32(0), patched.Status.Retry.Attempts)
+	assert.Equal(t, v1alpha1.RolloutAbortReasonAnalysisError, patched.Status.Retry.LastAbortReason)
+	assert.NotNil(t, patched.Status.Retry.NextRetryAt)
+	assert.Contains(t, f.events, retryScheduledReason)
+}
+
+func TestRetryNotScheduledOnAnalysisFailure(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{Limit: 2}
+	f, r2 := newRetryRolloutFixture(t, policy, v1alpha1.AnalysisPhaseFailed, nil)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// Failed AnalysisRuns are not retried by default
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.Abort)
+	assert.Equal(t, v1alpha1.RolloutAbortReasonAnalysisFailed, patched.Status.Retry.LastAbortReason)
+	assert.Nil(t, patched.Status.Retry.NextRetryAt)
+	assert.NotContains(t, f.events, retryScheduledReason)
+}
+
+func TestRetryAbortsOnInconclusiveAnalysis(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{Limit: 1}
+	f, r2 := newRetryRolloutFixture(t, policy, v1alpha1.AnalysisPhaseInconclusive, nil)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.Abort)
+	assert.Empty(t, patched.Status.PauseConditions)
+	assert.Equal(t, v1alpha1.RolloutAbortReasonAnalysisInconclusive, patched.Status.Retry.LastAbortReason)
+	assert.NotNil(t, patched.Status.Retry.NextRetryAt)
+
+	// the rollout pauses as without retryPolicy once the retries are exhausted
+	f, r2 = newRetryRolloutFixture(t, policy, v1alpha1.AnalysisPhaseInconclusive, func(ro *v1alpha1.Rollout, podHash string) {
+		ro.Status.Retry = &v1alpha1.RolloutRetryStatus{PodTemplateHash: podHash, Attempts: 1}
+	})
+	defer f.Close()
+
+	patchIndex = f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched = f.getPatchedRolloutAsObject(patchIndex)
+	assert.False(t, patched.Status.Abort)
+	assert.Len(t, patched.Status.PauseConditions, 1)
+	assert.Equal(t, v1alpha1.PauseReasonInconclusiveAnalysis, patched.Status.PauseConditions[0].Reason)
+}
+
+func TestRetryExhausted(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{Limit: 1}
+	f, r2 := newRetryRolloutFixture(t, policy, v1alpha1.AnalysisPhaseError, func(ro *v1alpha1.Rollout, podHash string) {
+		ro.Status.Retry = &v1alpha1.RolloutRetryStatus{PodTemplateHash: podHash, Attempts: 1}
+	})
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.Abort)
+	assert.Nil(t, patched.Status.Retry.NextRetryAt)
+	assert.Contains(t, f.events, retryExhaustedReason)
+}
+
+func TestRetryAbortedUpdate(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{Limit: 2}
+	f, r2 := newRetryRolloutFixture(t, policy, v1alpha1.AnalysisPhaseError, func(ro *v1alpha1.Rollout, podHash string) {
+		abortedAt := metav1.NewTime(timeutil.Now().Add(-time.Minute))
+		nextRetryAt := metav1.NewTime(timeutil.Now().Add(-time.Second))
+		ro.Status.Abort = true
+		ro.Status.AbortedAt = &abortedAt
+		ro.Status.Retry = &v1alpha1.RolloutRetryStatus{
+			PodTemplateHash: podHash,
+			LastAbortReason: v1alpha1.RolloutAbortReasonAnalysisError,
+			NextRetryAt:     &nextRetryAt,
+		}
+	})
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	// the abort is cleared as by the retry command, so that the AnalysisRun is created again
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.False(t, patched.Status.Abort)
+	assert.Equal(t, int32(1), patched.Status.Retry.Attempts)
+	assert.Nil(t, patched.Status.Retry.NextRetryAt)
+	assert.Contains(t, f.events, retriedReason)
+}
+
+func TestRetryWaitsForBackoff(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{Limit: 2}
+	nextRetryAt := metav1.NewTime(timeutil.Now().Add(time.Minute))
+	f, r2 := newRetryRolloutFixture(t, policy, v1alpha1.AnalysisPhaseError, func(ro *v1alpha1.Rollout, podHash string) {
+		abortedAt := metav1.NewTime(timeutil.Now().Add(-time.Minute))
+		ro.Status.Abort = true
+		ro.Status.AbortedAt = &abortedAt
+		ro.Status.Retry = &v1alpha1.RolloutRetryStatus{
+			PodTemplateHash: podHash,
+			LastAbortReason: v1alpha1.RolloutAbortReasonAnalysisError,
+			NextRetryAt:     &nextRetryAt,
+		}
+	})
+	defer f.Close()
+
+	f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+	assert.NotContains(t, f.events, retriedReason)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{Limit: 5}
+	assert.Equal(t, 30*time.Second, retryBackoff(policy, 0))
+	assert.Equal(t, 2*time.Minute, retryBackoff(policy, 2))
+
+	policy.Backoff = &v1alpha1.RolloutRetryBackoff{
+		Duration:    "1m",
+		Factor:      pointer.Int32(3),
+		MaxDuration: "5m",
+	}
+	assert.Equal(t, time.Minute, retryBackoff(policy, 0))
+	assert.Equal(t, 3*time.Minute, retryBackoff(policy, 1))
+	assert.Equal(t, 5*time.Minute, retryBackoff(policy, 2))
+	assert.Equal(t, 5*time.Minute, retryBackoff(policy, 40))
+}
+
+func TestRetryOn(t *testing.T) {
+	policy := &v1alpha1.RolloutRetryPolicy{}
+	assert.True(t, retryOn(policy, v1alpha1.RolloutAbortReasonAnalysisError))
+	assert.True(t, retryOn(policy, v1alpha1.RolloutAbortReasonAnalysisInconclusive))
+	assert.False(t, retryOn(policy, v1alpha1.RolloutAbortReasonAnalysisFailed))
+	assert.False(t, retryOn(policy, v1alpha1.RolloutAbortReasonProgressDeadlineExceeded))
+
+	policy.RetryOn = []v1alpha1.RolloutAbortReason{v1alpha1.RolloutAbortReasonProgressDeadlineExceeded}
+	assert.False(t, retryOn(policy, v1alpha1.RolloutAbortReasonAnalysisError))
+	assert.True(t, retryOn(policy, v1alpha1.RolloutAbortReasonProgressDeadlineExceeded))
+}
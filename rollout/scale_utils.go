@@ -1,15 +1,13 @@
 package rollout
 
 import (
-	"context"
-
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func (c *rolloutContext) scaleDeployment(targetScale *int32) error {
 	deploymentName := c.rollout.Spec.WorkloadRef.Name
 	namespace := c.rollout.Namespace
-	deployment, err := c.kubeclientset.AppsV1().Deployments(namespace).Get(context.TODO(), deploymentName, metav1.GetOptions{})
+	deployment, err := c.kubeclientset.AppsV1().Deployments(namespace).Get(c.context(), deploymentName, metav1.GetOptions{})
 	if err != nil {
 		c.log.Warnf("Failed to fetch deployment %s: %s", deploymentName, err.Error())
 		return err
@@ -27,7 +25,7 @@ func (c *rolloutContext) scaleDeployment(targetScale *int32) error {
 	c.log.Infof("Scaling deployment %s to %d replicas", deploymentName, newReplicasCount)
 	*deployment.Spec.Replicas = newReplicasCount
 
-	_, err = c.kubeclientset.AppsV1().Deployments(namespace).Update(context.TODO(), deployment, metav1.UpdateOptions{})
+	_, err = c.kubeclientset.AppsV1().Deployments(namespace).Update(c.context(), deployment, metav1.UpdateOptions{})
 	if err != nil {
 		c.log.Warnf("Failed to update deployment %s: %s", deploymentName, err.Error())
 		return err
@@ -1,7 +1,6 @@
 package rollout
 
 import (
-	"context"
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -13,6 +12,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	"github.com/argoproj/argo-rollouts/utils/aws"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
@@ -52,9 +52,28 @@ func generatePatch(service *corev1.Service, newRolloutUniqueLabelValue string, r
 	return fmt.Sprintf(switchSelectorPatch, newRolloutUniqueLabelValue)
 }
 
+// generateApplyPatch generates the apply patch of the managed-by annotation and the selector of a service. The whole
+// selector is applied since it is an atomic map.
+func generateApplyPatch(service *corev1.Service, newRolloutUniqueLabelValue string, r *v1alpha1.Rollout) ([]byte, error) {
+	desired := service.DeepCopy()
+	if desired.Annotations == nil {
+		desired.Annotations = make(map[string]string)
+	}
+	if _, ok := desired.Annotations[v1alpha1.ManagedByRolloutsKey]; !ok {
+		desired.Annotations[v1alpha1.ManagedByRolloutsKey] = r.Name
+	}
+	if desired.Spec.Selector == nil {
+		desired.Spec.Selector = make(map[string]string)
+	}
+	desired.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey] = newRolloutUniqueLabelValue
+	return apply.Patch(desired, corev1.SchemeGroupVersion.WithKind("Service"),
+		[]string{"metadata", "annotations", v1alpha1.ManagedByRolloutsKey},
+		[]string{"spec", "selector"})
+}
+
 // switchSelector switch the selector on an existing service to a new value
 func (c rolloutContext) switchServiceSelector(service *corev1.Service, newRolloutUniqueLabelValue string, r *v1alpha1.Rollout) error {
-	ctx := context.TODO()
+	ctx := c.context()
 	if service.Spec.Selector == nil {
 		service.Spec.Selector = make(map[string]string)
 	}
@@ -63,8 +82,18 @@ func (c rolloutContext) switchServiceSelector(service *corev1.Service, newRollou
 	if ok && oldPodHash == newRolloutUniqueLabelValue && hasManagedRollout {
 		return nil
 	}
-	patch := generatePatch(service, newRolloutUniqueLabelValue, r)
-	_, err := c.kubeclientset.CoreV1().Services(service.Namespace).Patch(ctx, service.Name, patchtypes.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	var err error
+	if apply.Enabled() {
+		var patch []byte
+		patch, err = generateApplyPatch(service, newRolloutUniqueLabelValue, r)
+		if err != nil {
+			return err
+		}
+		_, err = c.kubeclientset.CoreV1().Services(service.Namespace).Patch(ctx, service.Name, patchtypes.ApplyPatchType, patch, apply.PatchOptions())
+	} else {
+		patch := generatePatch(service, newRolloutUniqueLabelValue, r)
+		_, err = c.kubeclientset.CoreV1().Services(service.Namespace).Patch(ctx, service.Name, patchtypes.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	}
 	if err != nil {
 		return err
 	}
@@ -105,7 +134,12 @@ func (c *rolloutContext) reconcileActiveService(activeSvc *corev1.Service) error
 	if c.isBlueGreenFastTracked(activeSvc) {
 		newPodHash = c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 	}
-	if c.pauseContext.CompletedBlueGreenPause() && c.completedPrePromotionAnalysis() {
+	if usesBlueGreenTrafficSteps(c.rollout) {
+		// the active service is switched once the traffic was shifted to the preview service
+		if c.completedBlueGreenTrafficSteps() {
+			newPodHash = c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+		}
+	} else if c.pauseContext.CompletedBlueGreenPause() && c.completedPrePromotionAnalysis() {
 		newPodHash = c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
 	}
 
@@ -117,6 +151,9 @@ func (c *rolloutContext) reconcileActiveService(activeSvc *corev1.Service) error
 	if err != nil {
 		return err
 	}
+	if newPodHash == c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] {
+		c.newStatus.BlueGreen.TrafficStepIndex = nil
+	}
 	return nil
 }
 
@@ -137,7 +174,7 @@ func (c *rolloutContext) awsVerifyTargetGroups(svc *corev1.Service) error {
 	logCtx := c.log.WithField(logutil.ServiceKey, svc.Name)
 	logCtx.Infof("Verifying target group")
 
-	ctx := context.TODO()
+	ctx := c.context()
 	// find all TargetGroupBindings in the namespace which reference the service name + port
 	tgBindings, err := aws.GetTargetGroupBindingsByService(ctx, c.dynamicclientset, *svc)
 	if err != nil {
@@ -15,7 +15,9 @@ import (
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	core "k8s.io/client-go/testing"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -393,7 +395,7 @@ func TestBlueGreenAWSVerifyTargetGroupsReady(t *testing.T) {
 	f.run(getKey(r2, t))
 
 	patch := f.getPatchedRollout(patchIndex)
-	expectedPatch := fmt.Sprintf(`{"status":{"message":null,"phase":"Healthy","stableRS":"%s"}}`, rs2PodHash)
+	expectedPatch := fmt.Sprintf(`{"status":{"lifecycle":null,"message":null,"phase":"Healthy","stableRS":"%s"}}`, rs2PodHash)
 	assert.Equal(t, expectedPatch, patch)
 	f.assertEvents([]string{
 		conditions.TargetGroupVerifiedReason,
@@ -869,3 +871,45 @@ func TestDelayCanaryStableServiceDelayOnAdoptedService(t *testing.T) {
 	})
 
 }
+
+func TestSwitchServiceSelectorServerSideApply(t *testing.T) {
+	defaults.SetServerSideApplyEnabled(true)
+	defer defaults.SetServerSideApplyEnabled(false)
+
+	ro := newCanaryRollout("foo", 3, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(1))
+	svc := newService("stable", 80, map[string]string{"app": "foo"}, nil)
+	svc.Labels = map[string]string{"team": "payments"}
+
+	f := newFixture(t)
+	defer f.Close()
+	f.kubeobjects = append(f.kubeobjects, svc)
+	f.serviceLister = append(f.serviceLister, svc)
+	f.objects = append(f.objects, ro)
+	f.rolloutLister = append(f.rolloutLister, ro)
+
+	ctrl, _, _ := f.newController(noResyncPeriodFunc)
+	roCtx, err := ctrl.newRolloutContext(ro)
+	assert.NoError(t, err)
+	f.kubeclient.ClearActions()
+
+	err = roCtx.switchServiceSelector(svc, "abc123", ro)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", svc.Spec.Selector[v1alpha1.DefaultRolloutUniqueLabelKey])
+
+	actions := filterInformerActions(f.kubeclient.Actions())
+	assert.Len(t, actions, 1)
+	patchAction, ok := actions[0].(core.PatchAction)
+	assert.True(t, ok)
+	assert.Equal(t, types.ApplyPatchType, patchAction.GetPatchType())
+	// the whole selector is applied since it is atomic, along with the managed-by annotation
+	assert.JSONEq(t, `{
+		"apiVersion": "v1",
+		"kind": "Service",
+		"metadata": {
+			"name": "stable",
+			"namespace": "default",
+			"annotations": {"`+v1alpha1.ManagedByRolloutsKey+`": "foo"}
+		},
+		"spec": {"selector": {"app": "foo", "`+v1alpha1.DefaultRolloutUniqueLabelKey+`": "abc123"}}
+	}`, string(patchAction.GetPatch()))
+}
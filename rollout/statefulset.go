@@ -0,0 +1,342 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+const (
+	// statefulSetCheckTime is how often a Rollout progressing a StatefulSet is requeued. The Rollout is not enqueued
+	// on the changes of the pods of the StatefulSet, so it is requeued until all the pods reached their desired
+	// revision.
+	statefulSetCheckTime = 10 * time.Second
+
+	statefulSetPartitionUpdatedReason = "StatefulSetPartitionUpdated"
+	statefulSetPodEvictedReason       = "StatefulSetPodEvicted"
+)
+
+// rolloutStatefulSet progresses a Rollout whose workloadRef is a StatefulSet. The canary steps are implemented by
+// moving the partition of the RollingUpdate strategy of the StatefulSet: all the pods with an ordinal greater or
+// equal to the partition are updated to the update revision. The revisions of the StatefulSet are presented to the
+// rest of the controller as ReplicaSets (see newStatefulSetRevisionReplicaSet) so that analysis, pauses and the
+// status of the Rollout are reconciled the same way as for canary Rollouts.
+func (c *rolloutContext) rolloutStatefulSet() error {
+	ctx := c.context()
+	sts, err := c.statefulSetLister.StatefulSets(c.rollout.Namespace).Get(c.rollout.Spec.WorkloadRef.Name)
+	if err != nil {
+		return err
+	}
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return fmt.Errorf("StatefulSet %s must use the %s update strategy", sts.Name, appsv1.RollingUpdateStatefulSetStrategyType)
+	}
+	if sts.Status.ObservedGeneration < sts.Generation || sts.Status.UpdateRevision == "" {
+		c.log.Infof("Waiting for StatefulSet %s to observe generation %d", sts.Name, sts.Generation)
+		c.enqueueRolloutAfter(c.rollout, statefulSetCheckTime)
+		return nil
+	}
+
+	pods, err := c.getStatefulSetPods(sts)
+	if err != nil {
+		return err
+	}
+	c.setStatefulSetRevisionReplicaSets(sts, pods)
+
+	if err := c.syncStatefulSetRevision(sts); err != nil {
+		return err
+	}
+
+	if replicasetutil.PodTemplateOrStepsChanged(c.rollout, c.newRS) {
+		return c.syncRolloutStatusCanary()
+	}
+
+	err = c.reconcileAnalysisRuns()
+	if c.pauseContext.HasAddPause() {
+		c.log.Info("Detected pause due to inconclusive AnalysisRun")
+		return c.syncRolloutStatusCanary()
+	}
+	if err != nil {
+		return err
+	}
+
+	updated, err := c.reconcileStatefulSetPartition(ctx, sts, pods)
+	if err != nil {
+		return err
+	}
+	if updated || !c.statefulSetPodsAtDesiredRevision(pods) {
+		c.enqueueRolloutAfter(c.rollout, statefulSetCheckTime)
+	}
+
+	if stillReconciling := c.reconcileCanaryPause(); stillReconciling {
+		c.log.Infof("Not finished reconciling Canary Pause")
+	}
+
+	return c.syncRolloutStatusCanary()
+}
+
+// getStatefulSetPods returns the pods controlled by the StatefulSet
+func (c *rolloutContext) getStatefulSetPods(sts *appsv1.StatefulSet) ([]*corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	podList, err := c.podLister.Pods(sts.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	var pods []*corev1.Pod
+	for _, pod := range podList {
+		if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil && controllerRef.UID == sts.UID {
+			pods = append(pods, pod)
+		}
+	}
+	return pods, nil
+}
+
+// setStatefulSetRevisionReplicaSets sets the new, stable and older ReplicaSets of the context from the revisions of
+// the StatefulSet. The update revision is the new ReplicaSet and, once the Rollout has a stable revision, the current
+// revision of the StatefulSet is the stable ReplicaSet.
+func (c *rolloutContext) setStatefulSetRevisionReplicaSets(sts *appsv1.StatefulSet, pods []*corev1.Pod) {
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	desiredUpdated := replicas - c.desiredStatefulSetPartition(sts)
+
+	revisions := map[string]bool{}
+	for _, pod := range pods {
+		revisions[pod.Labels[appsv1.ControllerRevisionHashLabelKey]] = true
+	}
+	delete(revisions, sts.Status.UpdateRevision)
+	delete(revisions, sts.Status.CurrentRevision)
+
+	c.newRS = newStatefulSetRevisionReplicaSet(sts, sts.Status.UpdateRevision, desiredUpdated, pods)
+	c.stableRS = nil
+	c.olderRSs = nil
+	c.allRSs = []*appsv1.ReplicaSet{c.newRS}
+	if sts.Status.CurrentRevision != sts.Status.UpdateRevision {
+		currentRS := newStatefulSetRevisionReplicaSet(sts, sts.Status.CurrentRevision, replicas-desiredUpdated, pods)
+		c.olderRSs = append(c.olderRSs, currentRS)
+		c.allRSs = append(c.allRSs, currentRS)
+	}
+	outdatedRevisions := make([]string, 0, len(revisions))
+	for revision := range revisions {
+		outdatedRevisions = append(outdatedRevisions, revision)
+	}
+	sort.Strings(outdatedRevisions)
+	for _, revision := range outdatedRevisions {
+		rs := newStatefulSetRevisionReplicaSet(sts, revision, 0, pods)
+		c.olderRSs = append(c.olderRSs, rs)
+		c.allRSs = append(c.allRSs, rs)
+	}
+	if c.rollout.Status.StableRS != "" {
+		c.stableRS = replicasetutil.GetStableRS(c.rollout, c.newRS, c.olderRSs)
+	}
+	c.otherRSs = replicasetutil.GetOtherRSs(c.rollout, c.newRS, c.stableRS, c.allRSs)
+}
+
+// newStatefulSetRevisionReplicaSet returns a ReplicaSet describing the pods of a StatefulSet revision. The
+// ReplicaSet only exists in memory and is never created in the cluster.
+func newStatefulSetRevisionReplicaSet(sts *appsv1.StatefulSet, revision string, desired int32, pods []*corev1.Pod) *appsv1.ReplicaSet {
+	podHash := statefulSetRevisionHash(sts, revision)
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      revision,
+			Namespace: sts.Namespace,
+			Labels:    map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: podHash},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas:        &desired,
+			MinReadySeconds: sts.Spec.MinReadySeconds,
+			Selector:        sts.Spec.Selector,
+			Template:        sts.Spec.Template,
+		},
+	}
+	now := timeutil.MetaNow()
+	for _, pod := range pods {
+		if pod.Labels[appsv1.ControllerRevisionHashLabelKey] != revision || pod.DeletionTimestamp != nil {
+			continue
+		}
+		rs.Status.Replicas++
+		if podutil.IsPodReady(pod) {
+			rs.Status.ReadyReplicas++
+		}
+		if podutil.IsPodAvailable(pod, sts.Spec.MinReadySeconds, now) {
+			rs.Status.AvailableReplicas++
+		}
+	}
+	rs.Status.FullyLabeledReplicas = rs.Status.Replicas
+	return rs
+}
+
+// statefulSetRevisionHash returns the hash of a StatefulSet revision, which are named <statefulset>-<hash>
+func statefulSetRevisionHash(sts *appsv1.StatefulSet, revision string) string {
+	return strings.TrimPrefix(revision, sts.Name+"-")
+}
+
+// syncStatefulSetRevision sets the revision of the Rollout to the revision of the update ControllerRevision
+func (c *rolloutContext) syncStatefulSetRevision(sts *appsv1.StatefulSet) error {
+	controllerRevision, err := c.controllerRevisionLister.ControllerRevisions(sts.Namespace).Get(sts.Status.UpdateRevision)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.log.Warnf("ControllerRevision %s of StatefulSet %s not found", sts.Status.UpdateRevision, sts.Name)
+			return nil
+		}
+		return err
+	}
+	return c.setRolloutRevision(strconv.FormatInt(controllerRevision.Revision, 10))
+}
+
+// desiredStatefulSetPartition returns the partition of the StatefulSet for the current state of the Rollout:
+//   - the number of replicas when the update revision is fully promoted and the StatefulSet is at rest, so that the
+//     next change of the pod template is not rolled out before the controller reconciles it
+//   - 0 while a promoted update revision is rolled out to all the pods, or on the initial deploy
+//   - the number of replicas minus the replicas of the current setWeight, rounded up, during the canary steps
+//   - the number of replicas when the Rollout is aborted, since the weight of an aborted Rollout is 0
+func (c *rolloutContext) desiredStatefulSetPartition(sts *appsv1.StatefulSet) int32 {
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	updateHash := statefulSetRevisionHash(sts, sts.Status.UpdateRevision)
+	if c.rollout.Status.StableRS == updateHash {
+		if sts.Status.CurrentRevision == sts.Status.UpdateRevision {
+			return replicas
+		}
+		return 0
+	}
+	if c.rollout.Status.StableRS == "" || c.rollout.Status.PromoteFull {
+		return 0
+	}
+	weight := replicasetutil.GetCurrentSetWeight(c.rollout)
+	updated := int32(math.Ceil(float64(replicas) * float64(weight) / float64(weightutil.MaxTrafficWeight(c.rollout))))
+	if updated > replicas {
+		updated = replicas
+	}
+	return replicas - updated
+}
+
+// reconcileStatefulSetPartition scales the StatefulSet to the replicas of the Rollout and moves its partition. While
+// all the pods are expected to be at the current revision, pods left at other revisions (e.g. the canary pods of an
+// aborted update) are evicted one at a time so that the StatefulSet recreates them at the current revision. It
+// returns whether the StatefulSet or its pods were modified.
+func (c *rolloutContext) reconcileStatefulSetPartition(ctx context.Context, sts *appsv1.StatefulSet, pods []*corev1.Pod) (bool, error) {
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	partition := c.desiredStatefulSetPartition(sts)
+
+	currentPartition := int32(0)
+	if sts.Spec.UpdateStrategy.RollingUpdate != nil && sts.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+		currentPartition = *sts.Spec.UpdateStrategy.RollingUpdate.Partition
+	}
+	if sts.Spec.Replicas == nil || *sts.Spec.Replicas != replicas || currentPartition != partition {
+		stsCopy := sts.DeepCopy()
+		stsCopy.Spec.Replicas = &replicas
+		stsCopy.Spec.UpdateStrategy.Type = appsv1.RollingUpdateStatefulSetStrategyType
+		if stsCopy.Spec.UpdateStrategy.RollingUpdate == nil {
+			stsCopy.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{}
+		}
+		stsCopy.Spec.UpdateStrategy.RollingUpdate.Partition = &partition
+		if _, err := c.kubeclientset.AppsV1().StatefulSets(sts.Namespace).Update(ctx, stsCopy, metav1.UpdateOptions{}); err != nil {
+			return false, err
+		}
+		msg := fmt.Sprintf("Updated StatefulSet %s partition to %d (replicas: %d)", sts.Name, partition, replicas)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: statefulSetPartitionUpdatedReason, EventAction: record.ActionUpdateWorkload, RelatedObject: sts}, msg)
+		return true, nil
+	}
+
+	if partition != replicas {
+		return false, nil
+	}
+	return c.evictOutdatedStatefulSetPod(ctx, sts, pods)
+}
+
+// evictOutdatedStatefulSetPod evicts the pod with the highest ordinal which is not at the current revision of the
+// StatefulSet, once all the other pods are available
+func (c *rolloutContext) evictOutdatedStatefulSetPod(ctx context.Context, sts *appsv1.StatefulSet, pods []*corev1.Pod) (bool, error) {
+	var outdated []*corev1.Pod
+	now := timeutil.MetaNow()
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			// wait for the pod to be recreated by the StatefulSet
+			return false, nil
+		}
+		if pod.Labels[appsv1.ControllerRevisionHashLabelKey] != sts.Status.CurrentRevision {
+			outdated = append(outdated, pod)
+		} else if !podutil.IsPodAvailable(pod, sts.Spec.MinReadySeconds, now) {
+			return false, nil
+		}
+	}
+	if len(outdated) == 0 {
+		return false, nil
+	}
+	sort.Slice(outdated, func(i, j int) bool {
+		return statefulSetPodOrdinal(outdated[i]) > statefulSetPodOrdinal(outdated[j])
+	})
+	pod := outdated[0]
+	evictTarget := policy.Eviction{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+	if err := c.kubeclientset.CoreV1().Pods(pod.Namespace).Evict(ctx, &evictTarget); err != nil {
+		if k8serrors.IsTooManyRequests(err) {
+			// A PodDisruptionBudget prevented us from evicting the pod, the rollout is requeued to try again later
+			c.log.Warn(err)
+			return true, nil
+		}
+		return false, err
+	}
+	msg := fmt.Sprintf("Evicted pod %s to restore StatefulSet revision %s", pod.Name, sts.Status.CurrentRevision)
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: statefulSetPodEvictedReason, EventAction: record.ActionUpdateWorkload, RelatedObject: pod}, msg)
+	return true, nil
+}
+
+// statefulSetPodsAtDesiredRevision returns whether the pods of the StatefulSet match the desired partition
+func (c *rolloutContext) statefulSetPodsAtDesiredRevision(pods []*corev1.Pod) bool {
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	if int32(len(pods)) != replicas {
+		return false
+	}
+	for _, rs := range c.allRSs {
+		if rs.Status.Replicas != *rs.Spec.Replicas || rs.Status.AvailableReplicas != *rs.Spec.Replicas {
+			return false
+		}
+	}
+	return true
+}
+
+// atDesiredStatefulSetPartition returns whether the pods below and above the partition of the StatefulSet are
+// available at their revision
+func (c *rolloutContext) atDesiredStatefulSetPartition() bool {
+	desiredUpdated := *c.newRS.Spec.Replicas
+	if c.newRS.Status.AvailableReplicas != desiredUpdated {
+		return false
+	}
+	replicas := defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas)
+	return replicasetutil.GetAvailableReplicaCountForReplicaSets(c.allRSs) == replicas
+}
+
+func statefulSetPodOrdinal(pod *corev1.Pod) int {
+	idx := strings.LastIndex(pod.Name, "-")
+	if idx < 0 {
+		return -1
+	}
+	ordinal, err := strconv.Atoi(pod.Name[idx+1:])
+	if err != nil {
+		return -1
+	}
+	return ordinal
+}
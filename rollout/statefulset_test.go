@@ -0,0 +1,368 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+func newStatefulSetRollout(stableRS string, stepIndex int32) *v1alpha1.Rollout {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: metav1.NamespaceDefault},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: pointer.Int32(4),
+			WorkloadRef: &v1alpha1.ObjectRef{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "db",
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{
+						{SetWeight: pointer.Int32(25)},
+						{Pause: &v1alpha1.RolloutPause{}},
+						{SetWeight: pointer.Int32(60)},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			StableRS:         stableRS,
+			CurrentStepIndex: &stepIndex,
+		},
+	}
+	return ro
+}
+
+func newStatefulSet(currentRevision, updateRevision string, partition int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: metav1.NamespaceDefault, UID: "sts-uid"},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: pointer.Int32(4),
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{
+			CurrentRevision: currentRevision,
+			UpdateRevision:  updateRevision,
+		},
+	}
+}
+
+func newStatefulSetPod(ordinal int, revision string, ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("db-%d", ordinal),
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				"app":                                 "db",
+				appsv1.ControllerRevisionHashLabelKey: revision,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "db",
+				UID:        types.UID("sts-uid"),
+				Controller: pointer.Bool(true),
+			}},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func newStatefulSetRolloutContext(ro *v1alpha1.Rollout, objs ...runtime.Object) (*rolloutContext, *k8sfake.Clientset) {
+	kubeclient := k8sfake.NewSimpleClientset(objs...)
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+	for _, obj := range objs {
+		switch obj.(type) {
+		case *corev1.Pod:
+			k8sI.Core().V1().Pods().Informer().GetIndexer().Add(obj)
+		case *appsv1.StatefulSet:
+			k8sI.Apps().V1().StatefulSets().Informer().GetIndexer().Add(obj)
+		case *appsv1.ControllerRevision:
+			k8sI.Apps().V1().ControllerRevisions().Informer().GetIndexer().Add(obj)
+		}
+	}
+	roCtx := &rolloutContext{
+		rollout:      ro,
+		pauseContext: &pauseContext{rollout: ro},
+		reconcilerBase: reconcilerBase{
+			argoprojclientset:        fake.NewSimpleClientset(ro),
+			kubeclientset:            kubeclient,
+			podLister:                k8sI.Core().V1().Pods().Lister(),
+			statefulSetLister:        k8sI.Apps().V1().StatefulSets().Lister(),
+			controllerRevisionLister: k8sI.Apps().V1().ControllerRevisions().Lister(),
+			recorder:                 record.NewFakeEventRecorder(),
+			refResolver:              &FakeWorkloadRefResolver{},
+			enqueueRolloutAfter:      func(obj any, duration time.Duration) {},
+		},
+		stepPluginContext: &stepPluginContext{},
+	}
+	roCtx.log = logutil.WithRollout(ro)
+	return roCtx, kubeclient
+}
+
+func TestDesiredStatefulSetPartition(t *testing.T) {
+	tests := []struct {
+		name      string
+		rollout   *v1alpha1.Rollout
+		sts       *appsv1.StatefulSet
+		partition int32
+	}{
+		{
+			name:      "at rest",
+			rollout:   newStatefulSetRollout("abc", 3),
+			sts:       newStatefulSet("db-abc", "db-abc", 4),
+			partition: 4,
+		},
+		{
+			name:      "initial deploy",
+			rollout:   newStatefulSetRollout("", 0),
+			sts:       newStatefulSet("db-abc", "db-def", 4),
+			partition: 0,
+		},
+		{
+			name:      "promoted revision rolling out",
+			rollout:   newStatefulSetRollout("def", 3),
+			sts:       newStatefulSet("db-abc", "db-def", 0),
+			partition: 0,
+		},
+		{
+			name:      "first setWeight",
+			rollout:   newStatefulSetRollout("abc", 0),
+			sts:       newStatefulSet("db-abc", "db-def", 4),
+			partition: 3,
+		},
+		{
+			name:      "pause keeps the previous setWeight",
+			rollout:   newStatefulSetRollout("abc", 1),
+			sts:       newStatefulSet("db-abc", "db-def", 3),
+			partition: 3,
+		},
+		{
+			name:      "setWeight rounds up",
+			rollout:   newStatefulSetRollout("abc", 2),
+			sts:       newStatefulSet("db-abc", "db-def", 3),
+			partition: 1,
+		},
+		{
+			name:      "completed steps",
+			rollout:   newStatefulSetRollout("abc", 3),
+			sts:       newStatefulSet("db-abc", "db-def", 1),
+			partition: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			roCtx, _ := newStatefulSetRolloutContext(test.rollout)
+			assert.Equal(t, test.partition, roCtx.desiredStatefulSetPartition(test.sts))
+		})
+	}
+
+	t.Run("aborted", func(t *testing.T) {
+		ro := newStatefulSetRollout("abc", 2)
+		ro.Status.Abort = true
+		roCtx, _ := newStatefulSetRolloutContext(ro)
+		assert.Equal(t, int32(4), roCtx.desiredStatefulSetPartition(newStatefulSet("db-abc", "db-def", 1)))
+	})
+	t.Run("promote full", func(t *testing.T) {
+		ro := newStatefulSetRollout("abc", 0)
+		ro.Status.PromoteFull = true
+		roCtx, _ := newStatefulSetRolloutContext(ro)
+		assert.Equal(t, int32(0), roCtx.desiredStatefulSetPartition(newStatefulSet("db-abc", "db-def", 3)))
+	})
+}
+
+func TestSetStatefulSetRevisionReplicaSets(t *testing.T) {
+	ro := newStatefulSetRollout("abc", 0)
+	roCtx, _ := newStatefulSetRolloutContext(ro)
+	sts := newStatefulSet("db-abc", "db-def", 3)
+	pods := []*corev1.Pod{
+		newStatefulSetPod(0, "db-abc", true),
+		newStatefulSetPod(1, "db-abc", true),
+		newStatefulSetPod(2, "db-old", true),
+		newStatefulSetPod(3, "db-def", false),
+	}
+	roCtx.setStatefulSetRevisionReplicaSets(sts, pods)
+
+	assert.Equal(t, "def", roCtx.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, int32(1), *roCtx.newRS.Spec.Replicas)
+	assert.Equal(t, int32(1), roCtx.newRS.Status.Replicas)
+	assert.Equal(t, int32(0), roCtx.newRS.Status.AvailableReplicas)
+
+	assert.Equal(t, "abc", roCtx.stableRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, int32(3), *roCtx.stableRS.Spec.Replicas)
+	assert.Equal(t, int32(2), roCtx.stableRS.Status.AvailableReplicas)
+
+	assert.Len(t, roCtx.allRSs, 3)
+	assert.Len(t, roCtx.otherRSs, 1)
+	assert.Equal(t, "db-old", roCtx.otherRSs[0].Name)
+	assert.False(t, roCtx.atDesiredStatefulSetPartition())
+	assert.False(t, roCtx.statefulSetPodsAtDesiredRevision(pods))
+
+	pods = []*corev1.Pod{
+		newStatefulSetPod(0, "db-abc", true),
+		newStatefulSetPod(1, "db-abc", true),
+		newStatefulSetPod(2, "db-abc", true),
+		newStatefulSetPod(3, "db-def", true),
+	}
+	roCtx.setStatefulSetRevisionReplicaSets(sts, pods)
+	assert.True(t, roCtx.atDesiredStatefulSetPartition())
+	assert.True(t, roCtx.statefulSetPodsAtDesiredRevision(pods))
+}
+
+func TestReconcileStatefulSetPartition(t *testing.T) {
+	t.Run("moves the partition", func(t *testing.T) {
+		ro := newStatefulSetRollout("abc", 0)
+		sts := newStatefulSet("db-abc", "db-def", 4)
+		sts.Spec.Replicas = pointer.Int32(2)
+		roCtx, kubeclient := newStatefulSetRolloutContext(ro, sts)
+
+		updated, err := roCtx.reconcileStatefulSetPartition(context.TODO(), sts, nil)
+		assert.NoError(t, err)
+		assert.True(t, updated)
+
+		updatedSts, err := kubeclient.AppsV1().StatefulSets(sts.Namespace).Get(context.TODO(), sts.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int32(4), *updatedSts.Spec.Replicas)
+		assert.Equal(t, int32(3), *updatedSts.Spec.UpdateStrategy.RollingUpdate.Partition)
+	})
+	t.Run("at desired partition", func(t *testing.T) {
+		ro := newStatefulSetRollout("abc", 0)
+		sts := newStatefulSet("db-abc", "db-def", 3)
+		roCtx, kubeclient := newStatefulSetRolloutContext(ro, sts)
+
+		updated, err := roCtx.reconcileStatefulSetPartition(context.TODO(), sts, nil)
+		assert.NoError(t, err)
+		assert.False(t, updated)
+		assert.Empty(t, kubeclient.Actions())
+	})
+	t.Run("evicts canary pods of an aborted update", func(t *testing.T) {
+		ro := newStatefulSetRollout("abc", 0)
+		ro.Status.Abort = true
+		sts := newStatefulSet("db-abc", "db-def", 4)
+		pods := []*corev1.Pod{
+			newStatefulSetPod(0, "db-abc", true),
+			newStatefulSetPod(1, "db-abc", true),
+			newStatefulSetPod(2, "db-def", true),
+			newStatefulSetPod(3, "db-def", true),
+		}
+		roCtx, kubeclient := newStatefulSetRolloutContext(ro, sts, pods[2], pods[3])
+
+		updated, err := roCtx.reconcileStatefulSetPartition(context.TODO(), sts, pods)
+		assert.NoError(t, err)
+		assert.True(t, updated)
+		actions := kubeclient.Actions()
+		assert.Len(t, actions, 1)
+		assert.Equal(t, "eviction", actions[0].GetSubresource())
+		assert.Equal(t, "db-3", actions[0].(k8stesting.CreateAction).GetObject().(metav1.Object).GetName())
+	})
+	t.Run("waits for pods to be available before evicting", func(t *testing.T) {
+		ro := newStatefulSetRollout("abc", 0)
+		ro.Status.Abort = true
+		sts := newStatefulSet("db-abc", "db-def", 4)
+		pods := []*corev1.Pod{
+			newStatefulSetPod(0, "db-abc", true),
+			newStatefulSetPod(1, "db-abc", true),
+			newStatefulSetPod(2, "db-def", true),
+			newStatefulSetPod(3, "db-abc", false),
+		}
+		roCtx, kubeclient := newStatefulSetRolloutContext(ro, sts)
+
+		updated, err := roCtx.reconcileStatefulSetPartition(context.TODO(), sts, pods)
+		assert.NoError(t, err)
+		assert.False(t, updated)
+		assert.Empty(t, kubeclient.Actions())
+	})
+}
+
+func TestRolloutStatefulSet(t *testing.T) {
+	ro := newStatefulSetRollout("abc", 0)
+	ro.Status.CurrentPodHash = "def"
+	ro.Status.CurrentStepHash = conditions.ComputeStepHash(ro)
+	sts := newStatefulSet("db-abc", "db-def", 4)
+	controllerRevision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-def", Namespace: metav1.NamespaceDefault},
+		Revision:   2,
+	}
+	pods := []runtime.Object{
+		newStatefulSetPod(0, "db-abc", true),
+		newStatefulSetPod(1, "db-abc", true),
+		newStatefulSetPod(2, "db-abc", true),
+		newStatefulSetPod(3, "db-abc", true),
+	}
+	roCtx, kubeclient := newStatefulSetRolloutContext(ro, append(pods, sts, controllerRevision)...)
+
+	assert.NoError(t, roCtx.rolloutStatefulSet())
+	// the StatefulSet, its pods and revisions are read from the listers
+	actions := kubeclient.Actions()
+	assert.Len(t, actions, 1)
+	assert.Equal(t, "update", actions[0].GetVerb())
+
+	assert.Equal(t, "2", roCtx.rollout.Annotations[annotations.RevisionAnnotation])
+	updatedSts, err := kubeclient.AppsV1().StatefulSets(sts.Namespace).Get(context.TODO(), sts.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), *updatedSts.Spec.UpdateStrategy.RollingUpdate.Partition)
+
+	assert.NotNil(t, roCtx.newRollout)
+	status := roCtx.newRollout.Status
+	assert.Equal(t, "def", status.CurrentPodHash)
+	assert.Equal(t, "abc", status.StableRS)
+	assert.Equal(t, int32(0), *status.CurrentStepIndex)
+	assert.Equal(t, int32(4), status.Replicas)
+	assert.Equal(t, int32(0), status.UpdatedReplicas)
+	assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status.Phase)
+}
+
+func TestStatefulSetPodOrdinal(t *testing.T) {
+	assert.Equal(t, 12, statefulSetPodOrdinal(newStatefulSetPod(12, "db-abc", true)))
+	assert.Equal(t, -1, statefulSetPodOrdinal(&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "db"}}))
+}
+
+func TestStartWorkloadInformers(t *testing.T) {
+	kubeclient := k8sfake.NewSimpleClientset(newStatefulSet("db-1", "db-1", 0))
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+	statefulSetLister := k8sI.Apps().V1().StatefulSets().Lister()
+	c := &Controller{workloadInformers: controllerutil.NewLazyInformerFactory(k8sI)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, c.startWorkloadInformers(ctx, newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))))
+	_, err := statefulSetLister.StatefulSets(metav1.NamespaceDefault).Get("db")
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	assert.NoError(t, c.startWorkloadInformers(ctx, newStatefulSetRollout("", 0)))
+	_, err = statefulSetLister.StatefulSets(metav1.NamespaceDefault).Get("db")
+	assert.NoError(t, err)
+}
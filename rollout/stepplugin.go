@@ -5,6 +5,7 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/steps/plugin"
@@ -12,6 +13,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
 )
 
 var (
@@ -48,7 +50,9 @@ func (spc *stepPluginContext) reconcile(c *rolloutContext) error {
 			if err != nil {
 				return spc.handleError(c, fmt.Errorf("could not create step plugin at index %d : %w", pluginStatus.Index, err))
 			}
-			status, err := stepPlugin.Abort(rollout)
+			status, err := traceStepPlugin(c, "Abort", pluginStatus.Index, pluginStep.Plugin, func() (*v1alpha1.StepPluginStatus, error) {
+				return stepPlugin.Abort(rollout)
+			})
 			if err != nil {
 				return spc.handleError(c, fmt.Errorf("failed to abort plugin: %w", err))
 			}
@@ -77,7 +81,9 @@ func (spc *stepPluginContext) reconcile(c *rolloutContext) error {
 			return spc.handleError(c, fmt.Errorf("could not create step plugin at index %d : %w", *stepIndex, err))
 		}
 
-		status, err := stepPlugin.Terminate(rollout)
+		status, err := traceStepPlugin(c, "Terminate", *stepIndex, pluginStep.Plugin, func() (*v1alpha1.StepPluginStatus, error) {
+			return stepPlugin.Terminate(rollout)
+		})
 		if err != nil {
 			return spc.handleError(c, fmt.Errorf("failed to terminate plugin: %w", err))
 		}
@@ -107,7 +113,9 @@ func (spc *stepPluginContext) reconcile(c *rolloutContext) error {
 	if err != nil {
 		return spc.handleError(c, fmt.Errorf("could not create step plugin at index %d : %w", *currentStepIndex, err))
 	}
-	status, err := stepPlugin.Run(rollout)
+	status, err := traceStepPlugin(c, "Run", *currentStepIndex, currentStep.Plugin, func() (*v1alpha1.StepPluginStatus, error) {
+		return stepPlugin.Run(rollout)
+	})
 	if err != nil {
 		return spc.handleError(c, fmt.Errorf("failed to run plugin: %w", err))
 	}
@@ -135,7 +143,7 @@ func (spc *stepPluginContext) reconcile(c *rolloutContext) error {
 	}
 
 	if status.Phase == v1alpha1.StepPluginPhaseFailed {
-		c.pauseContext.AddAbort(fmt.Sprintf("Step Plugin %d (%s) failed: %s", status.Index+1, status.Name, status.Message))
+		c.pauseContext.AddAbortWithCause(abortCauseStepPluginFailed, fmt.Sprintf("Step Plugin %d (%s) failed: %s", status.Index+1, status.Name, status.Message))
 	}
 
 	return nil
@@ -279,3 +287,14 @@ func (spc *stepPluginContext) cleanStatusForRetry(rollout *v1alpha1.Rollout) {
 		spc.stepPluginStatuses = []v1alpha1.StepPluginStatus{}
 	}
 }
+
+// traceStepPlugin records a span for an operation of a step plugin
+func traceStepPlugin(c *rolloutContext, operation string, index int32, step *v1alpha1.PluginStep, call func() (*v1alpha1.StepPluginStatus, error)) (*v1alpha1.StepPluginStatus, error) {
+	_, span := tracing.StartSpan(c.context(), "stepplugin."+operation, attribute.String("plugin.name", step.Name), attribute.Int("plugin.step_index", int(index)))
+	status, err := call()
+	if status != nil {
+		span.SetAttributes(attribute.String("plugin.phase", string(status.Phase)))
+	}
+	tracing.EndSpan(span, err)
+	return status, err
+}
@@ -0,0 +1,132 @@
+package rollout
+
+import (
+	"fmt"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// prePromotionStep identifies the pre-promotion phase of a blue-green update in status.currentStep
+	prePromotionStep = "prePromotion"
+	// postPromotionStep identifies the post-promotion phase of a blue-green update in status.currentStep
+	postPromotionStep = "postPromotion"
+)
+
+// canaryStepName identifies a canary step in status.currentStep
+func canaryStepName(index int32) string {
+	return fmt.Sprintf("steps[%d]", index)
+}
+
+// canaryStepIndex returns the index of a canary step identified in status.currentStep
+func canaryStepIndex(step string) (int32, bool) {
+	var index int32
+	if _, err := fmt.Sscanf(step, "steps[%d]", &index); err != nil {
+		return 0, false
+	}
+	return index, true
+}
+
+// canaryStepTimeout returns the timeout of a canary step, if any
+func canaryStepTimeout(step *v1alpha1.CanaryStep) *v1alpha1.StepTimeout {
+	if step == nil || step.Timeout == "" {
+		return nil
+	}
+	return &v1alpha1.StepTimeout{Timeout: step.Timeout, OnTimeout: step.OnTimeout}
+}
+
+// reconcileStepTimeout records the start of the given step of the update in c.newStatus.CurrentStep, and applies
+// the onTimeout policy once the step exceeds its timeout, if any. The status is cleared when there is no step.
+func (c *rolloutContext) reconcileStepTimeout(step string, stepTimeout *v1alpha1.StepTimeout) {
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	if step == "" || podHash == "" {
+		c.newStatus.CurrentStep = nil
+		return
+	}
+	now := timeutil.MetaNow()
+	status := c.rollout.Status.CurrentStep.DeepCopy()
+	if status == nil || status.Step != step || status.PodTemplateHash != podHash {
+		status = &v1alpha1.RolloutStepStatus{
+			Step:            step,
+			PodTemplateHash: podHash,
+			StartedAt:       now,
+		}
+	}
+	c.newStatus.CurrentStep = status
+	if stepTimeout == nil || status.TimedOutAt != nil {
+		return
+	}
+
+	timeout, err := stepTimeout.Timeout.Duration()
+	if err != nil {
+		c.log.Warnf("Ignoring invalid timeout of %s: %v", step, err)
+		return
+	}
+	remaining := status.StartedAt.Add(timeout).Sub(now.Time)
+	if remaining > 0 {
+		c.enqueueRolloutAfter(c.rollout, remaining)
+		return
+	}
+	status.TimedOutAt = &now
+	message := fmt.Sprintf(conditions.StepTimedOutMessage, step, stepTimeout.Timeout)
+	c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.StepTimedOutReason}, message)
+	switch stepTimeout.OnTimeout {
+	case v1alpha1.StepTimeoutPolicySkip:
+	case v1alpha1.StepTimeoutPolicyPause:
+		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonStepTimeout)
+	default:
+		c.pauseContext.AddAbortWithReason(v1alpha1.RolloutAbortReasonStepTimeout, message)
+	}
+}
+
+// completedTimedOutStep returns whether the given step timed out with the Skip policy, or with the Pause policy and
+// the rollout was promoted since.
+func (c *rolloutContext) completedTimedOutStep(step string, stepTimeout *v1alpha1.StepTimeout) bool {
+	status := c.newStatus.CurrentStep
+	if stepTimeout == nil || status == nil || status.TimedOutAt == nil {
+		return false
+	}
+	if status.Step != step || status.PodTemplateHash != replicasetutil.GetPodTemplateHash(c.newRS) {
+		return false
+	}
+	switch stepTimeout.OnTimeout {
+	case v1alpha1.StepTimeoutPolicySkip:
+		return true
+	case v1alpha1.StepTimeoutPolicyPause:
+		if c.pauseContext.HasAddPause() {
+			return false
+		}
+		return c.rollout.Status.ControllerPause && getPauseCondition(c.rollout, v1alpha1.PauseReasonStepTimeout) == nil
+	}
+	return false
+}
+
+// stepTimedOutAbort returns whether the update is aborted by a step which exceeded its timeout
+func (c *rolloutContext) stepTimedOutAbort() bool {
+	if c.pauseContext.abortReason == v1alpha1.RolloutAbortReasonStepTimeout {
+		return true
+	}
+	progCond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.RolloutProgressing)
+	return c.rollout.Status.Abort && progCond != nil && progCond.Reason == conditions.StepTimedOutReason
+}
+
+// reconcileBlueGreenStepTimeout enforces the timeouts of the pre-promotion and post-promotion phases of a
+// blue-green update
+func (c *rolloutContext) reconcileBlueGreenStepTimeout() {
+	blueGreen := c.rollout.Spec.Strategy.BlueGreen
+	switch {
+	case c.pauseContext.IsAborted():
+		c.reconcileStepTimeout("", nil)
+	case !skipPrePromotionAnalysisRun(c.rollout, c.newRS):
+		// the pre-promotion phase starts once the preview ReplicaSet is available
+		c.reconcileStepTimeout(prePromotionStep, blueGreen.PrePromotionTimeout)
+	case blueGreen.PostPromotionAnalysis != nil && !skipPostPromotionAnalysisRun(c.rollout, c.newRS):
+		c.reconcileStepTimeout(postPromotionStep, blueGreen.PostPromotionTimeout)
+	default:
+		c.reconcileStepTimeout("", nil)
+	}
+}
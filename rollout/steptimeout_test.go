@@ -0,0 +1,259 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newStepTimeoutRolloutFixture returns a fixture for an update of a canary rollout at an indefinite pause step,
+// which is bounded by a timeout of one minute with the given policy
+func newStepTimeoutRolloutFixture(t *testing.T, policy v1alpha1.StepTimeoutPolicy, updateStatus func(*v1alpha1.Rollout, string)) (*fixture, *v1alpha1.Rollout) {
+	f := newFixture(t)
+
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32(10)},
+		{Pause: &v1alpha1.RolloutPause{}, Timeout: "1m", OnTimeout: policy},
+		{SetWeight: pointer.Int32(50)},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32(1), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, true)
+	progressingCondition, _ := newProgressingCondition(conditions.RolloutPausedReason, rs2, "")
+	conditions.SetRolloutCondition(&r2.Status, progressingCondition)
+	pausedCondition, _ := newPausedCondition(true)
+	conditions.SetRolloutCondition(&r2.Status, pausedCondition)
+	availableCondition, _ := newAvailableCondition(true)
+	conditions.SetRolloutCondition(&r2.Status, availableCondition)
+	completedCondition, _ := newCompletedCondition(false)
+	conditions.SetRolloutCondition(&r2.Status, completedCondition)
+	if updateStatus != nil {
+		updateStatus(r2, rs2PodHash)
+	}
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	return f, r2
+}
+
+// expiredStepStatus returns the status of the pause step of the fixture, started two minutes ago
+func expiredStepStatus(ro *v1alpha1.Rollout, podHash string) {
+	ro.Status.CurrentStep = &v1alpha1.RolloutStepStatus{
+		Step:            "steps[1]",
+		PodTemplateHash: podHash,
+		StartedAt:       metav1.NewTime(timeutil.Now().Add(-2 * time.Minute)),
+	}
+}
+
+func TestCanaryStepTimeoutRecordsStart(t *testing.T) {
+	// the start of the step was not recorded yet
+	f, r2 := newStepTimeoutRolloutFixture(t, "", func(ro *v1alpha1.Rollout, podHash string) {
+		ro.Status.CurrentStep = nil
+	})
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	require.NotNil(t, patched.Status.CurrentStep)
+	assert.Equal(t, "steps[1]", patched.Status.CurrentStep.Step)
+	assert.Equal(t, r2.Status.CurrentPodHash, patched.Status.CurrentStep.PodTemplateHash)
+	assert.Nil(t, patched.Status.CurrentStep.TimedOutAt)
+	assert.NotContains(t, f.events, conditions.StepTimedOutReason)
+}
+
+func TestCanaryStepTimeoutAbort(t *testing.T) {
+	f, r2 := newStepTimeoutRolloutFixture(t, "", expiredStepStatus)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.True(t, patched.Status.Abort)
+	assert.NotNil(t, patched.Status.CurrentStep.TimedOutAt)
+	progressing := conditions.GetRolloutCondition(patched.Status, v1alpha1.RolloutProgressing)
+	assert.Equal(t, conditions.StepTimedOutReason, progressing.Reason)
+	assert.Contains(t, progressing.Message, "Step steps[1] timed out after 1m")
+	assert.Contains(t, f.events, conditions.StepTimedOutReason)
+}
+
+func TestCanaryStepTimeoutPause(t *testing.T) {
+	f, r2 := newStepTimeoutRolloutFixture(t, v1alpha1.StepTimeoutPolicyPause, expiredStepStatus)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.False(t, patched.Status.Abort)
+	assert.NotNil(t, getPauseCondition(patched, v1alpha1.PauseReasonStepTimeout))
+	assert.Nil(t, patched.Status.CurrentStepIndex)
+}
+
+func TestCanaryStepTimeoutPauseCompletedOnPromotion(t *testing.T) {
+	f, r2 := newStepTimeoutRolloutFixture(t, v1alpha1.StepTimeoutPolicyPause, func(ro *v1alpha1.Rollout, podHash string) {
+		expiredStepStatus(ro, podHash)
+		timedOutAt := metav1.NewTime(timeutil.Now().Add(-time.Minute))
+		ro.Status.CurrentStep.TimedOutAt = &timedOutAt
+		// the pause conditions were cleared by the promotion
+		ro.Status.PauseConditions = nil
+		ro.Status.ControllerPause = true
+	})
+	defer f.Close()
+
+	// the resumed condition is patched before the status
+	f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(2), *patched.Status.CurrentStepIndex)
+}
+
+func TestCanaryStepTimeoutSkip(t *testing.T) {
+	f, r2 := newStepTimeoutRolloutFixture(t, v1alpha1.StepTimeoutPolicySkip, expiredStepStatus)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.False(t, patched.Status.Abort)
+	assert.Equal(t, int32(2), *patched.Status.CurrentStepIndex)
+	assert.Contains(t, f.events, conditions.StepTimedOutReason)
+}
+
+func newStepTimeoutRolloutContext(ro *v1alpha1.Rollout, newRS *appsv1.ReplicaSet) (*rolloutContext, *[]time.Duration) {
+	logCtx := logutil.WithRollout(ro)
+	var requeues []time.Duration
+	roCtx := &rolloutContext{
+		rollout: ro,
+		newRS:   newRS,
+		log:     logCtx,
+		pauseContext: &pauseContext{
+			rollout: ro,
+			log:     logCtx,
+		},
+		reconcilerBase: reconcilerBase{
+			recorder: record.NewFakeEventRecorder(),
+			enqueueRolloutAfter: func(obj any, duration time.Duration) {
+				requeues = append(requeues, duration)
+			},
+		},
+	}
+	return roCtx, &requeues
+}
+
+func TestReconcileStepTimeout(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	rs := newReplicaSetWithStatus(ro, 1, 1)
+	podHash := rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	stepTimeout := &v1alpha1.StepTimeout{Timeout: "10m"}
+
+	t.Run("Start", func(t *testing.T) {
+		roCtx, requeues := newStepTimeoutRolloutContext(ro, rs)
+		roCtx.reconcileStepTimeout("steps[0]", stepTimeout)
+		assert.Equal(t, "steps[0]", roCtx.newStatus.CurrentStep.Step)
+		assert.Equal(t, []time.Duration{10 * time.Minute}, *requeues)
+	})
+	t.Run("Restarted with a new step", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Status.CurrentStep = &v1alpha1.RolloutStepStatus{
+			Step:            "steps[0]",
+			PodTemplateHash: podHash,
+			StartedAt:       metav1.NewTime(timeutil.Now().Add(-time.Hour)),
+		}
+		roCtx, _ := newStepTimeoutRolloutContext(ro, rs)
+		roCtx.reconcileStepTimeout("steps[1]", stepTimeout)
+		assert.Equal(t, "steps[1]", roCtx.newStatus.CurrentStep.Step)
+		assert.Nil(t, roCtx.newStatus.CurrentStep.TimedOutAt)
+		assert.False(t, roCtx.pauseContext.IsAborted())
+	})
+	t.Run("Recorded without timeout", func(t *testing.T) {
+		roCtx, requeues := newStepTimeoutRolloutContext(ro, rs)
+		roCtx.reconcileStepTimeout("steps[1]", nil)
+		assert.Equal(t, "steps[1]", roCtx.newStatus.CurrentStep.Step)
+		assert.Empty(t, *requeues)
+	})
+	t.Run("Cleared without step", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Status.CurrentStep = &v1alpha1.RolloutStepStatus{Step: "steps[0]", PodTemplateHash: podHash}
+		roCtx, _ := newStepTimeoutRolloutContext(ro, rs)
+		roCtx.newStatus.CurrentStep = ro.Status.CurrentStep
+		roCtx.reconcileStepTimeout("", nil)
+		assert.Nil(t, roCtx.newStatus.CurrentStep)
+	})
+	t.Run("Timed out once", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		timedOutAt := metav1.NewTime(timeutil.Now().Add(-time.Minute))
+		ro.Status.CurrentStep = &v1alpha1.RolloutStepStatus{
+			Step:            "steps[0]",
+			PodTemplateHash: podHash,
+			StartedAt:       metav1.NewTime(timeutil.Now().Add(-time.Hour)),
+			TimedOutAt:      &timedOutAt,
+		}
+		roCtx, requeues := newStepTimeoutRolloutContext(ro, rs)
+		roCtx.reconcileStepTimeout("steps[0]", stepTimeout)
+		assert.False(t, roCtx.pauseContext.IsAborted())
+		assert.Empty(t, *requeues)
+	})
+}
+
+func TestBlueGreenStepTimeout(t *testing.T) {
+	ro := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	ro.Spec.Strategy.BlueGreen.PrePromotionTimeout = &v1alpha1.StepTimeout{Timeout: "1m", OnTimeout: v1alpha1.StepTimeoutPolicySkip}
+	ro.Spec.Strategy.BlueGreen.PrePromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "test"}},
+	}
+	rs := newReplicaSetWithStatus(ro, 1, 1)
+	podHash := rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	ro.Status.StableRS = "stable"
+	ro.Status.BlueGreen.ActiveSelector = "stable"
+	ro.Status.CurrentStep = &v1alpha1.RolloutStepStatus{
+		Step:            prePromotionStep,
+		PodTemplateHash: podHash,
+		StartedAt:       metav1.NewTime(timeutil.Now().Add(-2 * time.Minute)),
+	}
+
+	roCtx, _ := newStepTimeoutRolloutContext(ro, rs)
+	assert.False(t, roCtx.completedPrePromotionAnalysis())
+	roCtx.reconcileBlueGreenStepTimeout()
+	assert.Equal(t, prePromotionStep, roCtx.newStatus.CurrentStep.Step)
+	assert.NotNil(t, roCtx.newStatus.CurrentStep.TimedOutAt)
+	// the pre-promotion analysis is skipped
+	assert.True(t, roCtx.completedPrePromotionAnalysis())
+	assert.False(t, roCtx.pauseContext.IsAborted())
+
+	// the post-promotion phase starts once the active service is switched
+	ro.Status.BlueGreen.ActiveSelector = podHash
+	ro.Spec.Strategy.BlueGreen.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "test"}},
+	}
+	ro.Spec.Strategy.BlueGreen.PostPromotionTimeout = &v1alpha1.StepTimeout{Timeout: "1m"}
+	roCtx, requeues := newStepTimeoutRolloutContext(ro, rs)
+	roCtx.reconcileBlueGreenStepTimeout()
+	assert.Equal(t, postPromotionStep, roCtx.newStatus.CurrentStep.Step)
+	assert.Nil(t, roCtx.newStatus.CurrentStep.TimedOutAt)
+	assert.Equal(t, []time.Duration{time.Minute}, *requeues)
+	assert.False(t, roCtx.completedPostPromotionAnalysis())
+}
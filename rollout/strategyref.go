@@ -0,0 +1,176 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+)
+
+const (
+	strategySnapshotCreatedReason = "StrategySnapshotCreated"
+	strategySnapshotDeletedReason = "StrategySnapshotDeleted"
+)
+
+// resolveStrategyRef resolves the strategy of the Rollout from the template referenced by its StrategyRef, with the
+// overrides of the StrategyRef applied. The strategy of the template is pinned to the current revision: it is kept in
+// a ControllerRevision owned by the Rollout, whose hash is recorded in the status along with the generation of the
+// template, so a change of the template only applies to the next revisions. The strategy is only set in memory (see
+// SetResolvedStrategy). It returns the status of the pinned strategy, which is nil when there is no StrategyRef.
+func (c *reconcilerBase) resolveStrategyRef(rollout *v1alpha1.Rollout) (*v1alpha1.RolloutStrategyTemplateStatus, error) {
+	ref := rollout.Spec.StrategyRef
+	if ref == nil {
+		return nil, nil
+	}
+	ctx := context.TODO()
+	podHash := hash.ComputePodTemplateHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
+	pinned := rollout.Status.StrategyTemplate
+	if pinned != nil && pinned.PodTemplateHash == podHash && pinned.Name == ref.Name && pinned.ClusterScope == ref.ClusterScope {
+		revision, err := c.getStrategySnapshot(ctx, rollout, pinned.StrategyHash)
+		if err != nil {
+			return nil, err
+		}
+		// the strategy is pinned again from the template if its snapshot was deleted
+		if revision != nil {
+			strategy, err := rolloututil.GetStrategyFromRevision(revision)
+			if err != nil {
+				return nil, err
+			}
+			if err := setStrategyFromTemplate(rollout, strategy); err != nil {
+				return nil, err
+			}
+			return pinned.DeepCopy(), nil
+		}
+	}
+	status, strategy, err := c.getStrategyTemplate(rollout.Namespace, *ref)
+	if err != nil {
+		return nil, err
+	}
+	status.PodTemplateHash = podHash
+	if err := setStrategyFromTemplate(rollout, strategy); err != nil {
+		return nil, err
+	}
+	if err := c.snapshotStrategy(ctx, rollout, status, strategy); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// setStrategyFromTemplate sets the strategy of the Rollout to the strategy of its template, with the overrides of its
+// StrategyRef
+func setStrategyFromTemplate(rollout *v1alpha1.Rollout, strategy v1alpha1.RolloutStrategy) error {
+	strategy, err := rolloututil.ApplyStrategyOverrides(strategy, *rollout.Spec.StrategyRef)
+	if err != nil {
+		return err
+	}
+	rollout.Spec.SetResolvedStrategy(strategy)
+	return nil
+}
+
+// keepResolvedStrategy sets the strategy resolved for a rollout on the copy of the rollout returned by an update,
+// since the resolved strategy is not persisted
+func keepResolvedStrategy(from, to *v1alpha1.Rollout) {
+	if from.Spec.StrategyResolvedFromRef {
+		to.Spec.SetResolvedStrategy(*from.Spec.Strategy.DeepCopy())
+	}
+}
+
+// getStrategyTemplate returns the status and the current strategy of the template referenced by a StrategyRef
+func (c *reconcilerBase) getStrategyTemplate(namespace string, ref v1alpha1.StrategyRef) (*v1alpha1.RolloutStrategyTemplateStatus, v1alpha1.RolloutStrategy, error) {
+	status := &v1alpha1.RolloutStrategyTemplateStatus{
+		Name:         ref.Name,
+		ClusterScope: ref.ClusterScope,
+	}
+	var generation int64
+	var strategy v1alpha1.RolloutStrategy
+	if ref.ClusterScope {
+		template, err := c.clusterRolloutStrategyTemplateLister.Get(ref.Name)
+		if err != nil {
+			return nil, strategy, err
+		}
+		generation = template.Generation
+		strategy = *template.Spec.Strategy.DeepCopy()
+	} else {
+		template, err := c.rolloutStrategyTemplateLister.RolloutStrategyTemplates(namespace).Get(ref.Name)
+		if err != nil {
+			return nil, strategy, err
+		}
+		generation = template.Generation
+		strategy = *template.Spec.Strategy.DeepCopy()
+	}
+	status.Generation = generation
+	status.StrategyHash = rolloututil.ComputeStrategyHash(strategy)
+	return status, strategy, nil
+}
+
+// getStrategySnapshot returns the ControllerRevision holding a strategy pinned by the Rollout, or nil if it does not
+// exist. The ControllerRevision is read from the API when it is not in the cache yet, since it may have been created
+// by the previous reconciliation.
+func (c *reconcilerBase) getStrategySnapshot(ctx context.Context, rollout *v1alpha1.Rollout, strategyHash string) (*appsv1.ControllerRevision, error) {
+	name := rolloututil.StrategyRevisionName(rollout.Name, strategyHash)
+	revision, err := c.controllerRevisionLister.ControllerRevisions(rollout.Namespace).Get(name)
+	if err == nil || !k8serrors.IsNotFound(err) {
+		return revision, err
+	}
+	revision, err = c.kubeclientset.AppsV1().ControllerRevisions(rollout.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return revision, err
+}
+
+// snapshotStrategy creates the ControllerRevision holding the strategy of the template pinned by the Rollout, if it
+// does not exist yet
+func (c *reconcilerBase) snapshotStrategy(ctx context.Context, rollout *v1alpha1.Rollout, status *v1alpha1.RolloutStrategyTemplateStatus, strategy v1alpha1.RolloutStrategy) error {
+	revision, err := rolloututil.NewStrategyRevision(rollout, status, strategy)
+	if err != nil {
+		return err
+	}
+	revision.OwnerReferences = []metav1.OwnerReference{rolloutOwnerReference(rollout)}
+	if _, err := c.controllerRevisionLister.ControllerRevisions(rollout.Namespace).Get(revision.Name); err == nil || !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if _, err := c.kubeclientset.AppsV1().ControllerRevisions(rollout.Namespace).Create(ctx, revision, metav1.CreateOptions{}); err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			// the snapshot was created by a previous reconciliation and is not in the cache yet
+			return nil
+		}
+		return err
+	}
+	msg := fmt.Sprintf("Created ControllerRevision %s from the generation %d of the strategy template %s", revision.Name, status.Generation, status.Name)
+	c.recorder.Eventf(rollout, record.EventOptions{EventReason: strategySnapshotCreatedReason}, msg)
+	return nil
+}
+
+// reconcileStrategySnapshots deletes the ControllerRevisions holding the strategies which are no longer pinned by the
+// Rollout
+func (c *rolloutContext) reconcileStrategySnapshots() error {
+	ctx := c.context()
+	pinned := ""
+	if status := c.newStatus.StrategyTemplate; status != nil {
+		pinned = rolloututil.StrategyRevisionName(c.rollout.Name, status.StrategyHash)
+	}
+	selector := labels.SelectorFromSet(labels.Set{rolloututil.StrategySnapshotOfLabelKey: c.rollout.Name})
+	revisions, err := c.controllerRevisionLister.ControllerRevisions(c.rollout.Namespace).List(selector)
+	if err != nil {
+		return err
+	}
+	for _, revision := range revisions {
+		if revision.Name == pinned {
+			continue
+		}
+		if err := c.kubeclientset.AppsV1().ControllerRevisions(revision.Namespace).Delete(ctx, revision.Name, metav1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return err
+		}
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: strategySnapshotDeletedReason}, "Deleted ControllerRevision %s", revision.Name)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package rollout
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+)
+
+func newStrategyTemplate(steps ...v1alpha1.CanaryStep) *v1alpha1.RolloutStrategyTemplate {
+	return &v1alpha1.RolloutStrategyTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "canary", Namespace: metav1.NamespaceDefault, Generation: 1},
+		Spec: v1alpha1.RolloutStrategyTemplateSpec{
+			Strategy: v1alpha1.RolloutStrategy{Canary: &v1alpha1.CanaryStrategy{Steps: steps}},
+		},
+	}
+}
+
+// newStrategyRefReconciler returns a reconciler whose ControllerRevisions are only in the API, as if they were not in
+// the cache yet
+func newStrategyRefReconciler(kubeclient *k8sfake.Clientset, templates ...*v1alpha1.RolloutStrategyTemplate) reconcilerBase {
+	i := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0)
+	for _, template := range templates {
+		i.Argoproj().V1alpha1().RolloutStrategyTemplates().Informer().GetIndexer().Add(template)
+	}
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+	return reconcilerBase{
+		kubeclientset:                        kubeclient,
+		controllerRevisionLister:             k8sI.Apps().V1().ControllerRevisions().Lister(),
+		rolloutStrategyTemplateLister:        i.Argoproj().V1alpha1().RolloutStrategyTemplates().Lister(),
+		clusterRolloutStrategyTemplateLister: i.Argoproj().V1alpha1().ClusterRolloutStrategyTemplates().Lister(),
+		recorder:                             record.NewFakeEventRecorder(),
+	}
+}
+
+func TestResolveStrategyRef(t *testing.T) {
+	template := newStrategyTemplate(v1alpha1.CanaryStep{SetWeight: int32Ptr(20)}, v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{}})
+	kubeclient := k8sfake.NewSimpleClientset()
+	c := newStrategyRefReconciler(kubeclient, template)
+	r := newRollout("foo", 1, nil, map[string]string{"foo": "bar"})
+	r.Spec.StrategyRef = &v1alpha1.StrategyRef{Name: "canary", Weights: []int32{10}}
+	podHash := hash.ComputePodTemplateHash(&r.Spec.Template, r.Status.CollisionCount)
+
+	pinned, err := c.resolveStrategyRef(r)
+	require.NoError(t, err)
+	assert.True(t, r.Spec.StrategyResolvedFromRef)
+	assert.Equal(t, int32(10), *r.Spec.Strategy.Canary.Steps[0].SetWeight)
+	assert.Equal(t, podHash, pinned.PodTemplateHash)
+	assert.Equal(t, "canary", pinned.Name)
+	assert.Equal(t, int64(1), pinned.Generation)
+	assert.Equal(t, rolloututil.ComputeStrategyHash(template.Spec.Strategy), pinned.StrategyHash)
+
+	// the strategy of the template is kept in a ControllerRevision owned by the Rollout
+	revision, err := kubeclient.AppsV1().ControllerRevisions(r.Namespace).Get(context.TODO(), "foo-strategy-"+pinned.StrategyHash, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), revision.Revision)
+	assert.Equal(t, r.UID, revision.OwnerReferences[0].UID)
+	assert.Equal(t, "foo", revision.Labels[rolloututil.StrategySnapshotOfLabelKey])
+	strategy, err := rolloututil.GetStrategyFromRevision(revision)
+	require.NoError(t, err)
+	assert.Equal(t, template.Spec.Strategy, strategy)
+
+	// a change of the template does not apply to the current revision
+	updated := newStrategyTemplate(v1alpha1.CanaryStep{SetWeight: int32Ptr(50)})
+	updated.Generation = 2
+	c = newStrategyRefReconciler(kubeclient, updated)
+	r = newRollout("foo", 1, nil, map[string]string{"foo": "bar"})
+	r.Spec.StrategyRef = &v1alpha1.StrategyRef{Name: "canary", Weights: []int32{10}}
+	r.Status.StrategyTemplate = pinned
+	resolved, err := c.resolveStrategyRef(r)
+	require.NoError(t, err)
+	assert.Equal(t, pinned, resolved)
+	assert.Len(t, r.Spec.Strategy.Canary.Steps, 2)
+
+	// but applies to the next revision
+	r = newRollout("foo", 1, nil, map[string]string{"foo": "bar"})
+	r.Spec.StrategyRef = &v1alpha1.StrategyRef{Name: "canary", Weights: []int32{10}}
+	r.Spec.Template.Spec.Containers[0].Image = "bar:v2"
+	r.Status.StrategyTemplate = pinned
+	resolved, err = c.resolveStrategyRef(r)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), resolved.Generation)
+	assert.NotEqual(t, pinned.PodTemplateHash, resolved.PodTemplateHash)
+	assert.Len(t, r.Spec.Strategy.Canary.Steps, 1)
+	assert.Equal(t, int32(10), *r.Spec.Strategy.Canary.Steps[0].SetWeight)
+
+	// the strategy is pinned again from the template when its ControllerRevision was deleted
+	c = newStrategyRefReconciler(k8sfake.NewSimpleClientset(), updated)
+	r = newRollout("foo", 1, nil, map[string]string{"foo": "bar"})
+	r.Spec.StrategyRef = &v1alpha1.StrategyRef{Name: "canary", Weights: []int32{10}}
+	r.Status.StrategyTemplate = pinned
+	resolved, err = c.resolveStrategyRef(r)
+	require.NoError(t, err)
+	assert.Equal(t, pinned.PodTemplateHash, resolved.PodTemplateHash)
+	assert.Equal(t, int64(2), resolved.Generation)
+	assert.Len(t, r.Spec.Strategy.Canary.Steps, 1)
+}
+
+func TestResolveStrategyRefMissingTemplate(t *testing.T) {
+	c := newStrategyRefReconciler(k8sfake.NewSimpleClientset())
+	r := newRollout("foo", 1, nil, map[string]string{"foo": "bar"})
+	r.Spec.StrategyRef = &v1alpha1.StrategyRef{Name: "canary", ClusterScope: true}
+	_, err := c.resolveStrategyRef(r)
+	assert.EqualError(t, err, `clusterrolloutstrategytemplate.argoproj.io "canary" not found`)
+	assert.False(t, r.Spec.StrategyResolvedFromRef)
+
+	pinned, err := c.resolveStrategyRef(newRollout("bar", 1, nil, map[string]string{"foo": "bar"}))
+	assert.NoError(t, err)
+	assert.Nil(t, pinned)
+}
+
+func TestStrategyRefNewRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 10, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	r.Status.CurrentPodHash = ""
+	crst := &v1alpha1.ClusterRolloutStrategyTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "canary", Generation: 3},
+		Spec:       v1alpha1.RolloutStrategyTemplateSpec{Strategy: r.Spec.Strategy},
+	}
+	r.Spec.Strategy = v1alpha1.RolloutStrategy{}
+	r.Spec.StrategyRef = &v1alpha1.StrategyRef{Name: "canary", ClusterScope: true}
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+	f.clusterRolloutStrategyTemplateLister = append(f.clusterRolloutStrategyTemplateLister, crst)
+	f.objects = append(f.objects, crst)
+
+	rs := newReplicaSet(r, 1)
+	f.expectCreateControllerRevisionAction()
+	f.expectCreateReplicaSetAction(rs)
+	f.expectUpdateReplicaSetAction(rs)
+	f.expectUpdateRolloutStatusAction(r)
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	pinned := patchedRollout.Status.StrategyTemplate
+	require.NotNil(t, pinned)
+	assert.Equal(t, "canary", pinned.Name)
+	assert.True(t, pinned.ClusterScope)
+	assert.Equal(t, int64(3), pinned.Generation)
+	assert.Equal(t, rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], pinned.PodTemplateHash)
+	assert.Equal(t, rolloututil.ComputeStrategyHash(crst.Spec.Strategy), pinned.StrategyHash)
+}
+
+func TestStrategyRefMissingTemplate(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 10, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	r.Status.CurrentPodHash = ""
+	r.Spec.Strategy = v1alpha1.RolloutStrategy{}
+	r.Spec.StrategyRef = &v1alpha1.StrategyRef{Name: "canary"}
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	// no ReplicaSet is created without the strategy
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.runExpectError(getKey(r, t), true)
+
+	patchedRollout := f.getPatchedRolloutAsObject(patchIndex)
+	cond := conditions.GetRolloutCondition(patchedRollout.Status, v1alpha1.InvalidSpec)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, `The Rollout "foo" is invalid: rolloutstrategytemplate.argoproj.io "canary" not found`, cond.Message)
+}
+
+func TestReconcileStrategySnapshots(t *testing.T) {
+	ro := newRollout("foo", 1, nil, map[string]string{"foo": "bar"})
+	ro.Spec.StrategyRef = &v1alpha1.StrategyRef{Name: "canary"}
+	snapshot := func(strategyHash string) *appsv1.ControllerRevision {
+		return &appsv1.ControllerRevision{ObjectMeta: metav1.ObjectMeta{
+			Name:      rolloututil.StrategyRevisionName(ro.Name, strategyHash),
+			Namespace: ro.Namespace,
+			Labels:    map[string]string{rolloututil.StrategySnapshotOfLabelKey: ro.Name},
+		}}
+	}
+	pinned, unpinned := snapshot("abc"), snapshot("def")
+	kubeclient := k8sfake.NewSimpleClientset(pinned, unpinned)
+	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+	k8sI.Apps().V1().ControllerRevisions().Informer().GetIndexer().Add(pinned)
+	k8sI.Apps().V1().ControllerRevisions().Informer().GetIndexer().Add(unpinned)
+	roCtx := &rolloutContext{
+		rollout: ro,
+		log:     logutil.WithRollout(ro),
+		newStatus: v1alpha1.RolloutStatus{
+			StrategyTemplate: &v1alpha1.RolloutStrategyTemplateStatus{Name: "canary", StrategyHash: "abc"},
+		},
+		reconcilerBase: reconcilerBase{
+			kubeclientset:            kubeclient,
+			controllerRevisionLister: k8sI.Apps().V1().ControllerRevisions().Lister(),
+			recorder:                 record.NewFakeEventRecorder(),
+		},
+	}
+
+	assert.NoError(t, roCtx.reconcileStrategySnapshots())
+	_, err := kubeclient.AppsV1().ControllerRevisions(ro.Namespace).Get(context.TODO(), pinned.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	_, err = kubeclient.AppsV1().ControllerRevisions(ro.Namespace).Get(context.TODO(), unpinned.Name, metav1.GetOptions{})
+	assert.Error(t, err)
+}
@@ -1,7 +1,6 @@
 package rollout
 
 import (
-	"context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -16,6 +15,7 @@ import (
 	labelsutil "k8s.io/kubernetes/pkg/util/labels"
 	"k8s.io/utils/pointer"
 
+	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
@@ -59,7 +59,7 @@ func (c *rolloutContext) syncReplicaSetRevision() (*appsv1.ReplicaSet, error) {
 	if c.newRS == nil {
 		return nil, nil
 	}
-	ctx := context.TODO()
+	ctx := c.context()
 
 	// Calculate the max revision number among all old RSes
 	maxOldRevision := replicasetutil.MaxRevision(c.olderRSs)
@@ -86,6 +86,10 @@ func (c *rolloutContext) syncReplicaSetRevision() (*appsv1.ReplicaSet, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed to update replicaset revision on %s: %w", rsCopy.Name, err)
 		}
+		// an existing ReplicaSet of an older revision is the new ReplicaSet of a rollback
+		if oldRevision, err := replicasetutil.Revision(c.newRS); err == nil && oldRevision > 0 && oldRevision < maxOldRevision {
+			metrics.IncRolloutRollback(c.rollout)
+		}
 		return rs, nil
 	}
 
@@ -107,7 +111,9 @@ func (c *rolloutContext) syncReplicaSetRevision() (*appsv1.ReplicaSet, error) {
 			c.log.WithError(err).Error("Error: updating rollout status in syncReplicaSetRevision")
 			return nil, err
 		}
-		c.rollout = updatedRollout
+		resolved := c.rollout
+		c.rollout = updatedRollout.DeepCopy()
+		keepResolvedStrategy(resolved, c.rollout)
 		c.newRollout = updatedRollout
 		c.log.Infof("Initialized Progressing condition: %v", condition)
 	}
@@ -116,15 +122,20 @@ func (c *rolloutContext) syncReplicaSetRevision() (*appsv1.ReplicaSet, error) {
 
 func (c *rolloutContext) setRolloutRevision(revision string) error {
 	if annotations.SetRolloutRevision(c.rollout, revision) {
-		updatedRollout, err := c.argoprojclientset.ArgoprojV1alpha1().Rollouts(c.rollout.Namespace).Update(context.TODO(), c.rollout, metav1.UpdateOptions{})
+		updatedRollout, err := c.argoprojclientset.ArgoprojV1alpha1().Rollouts(c.rollout.Namespace).Update(c.context(), c.rollout, metav1.UpdateOptions{})
 		if err != nil {
 			c.log.WithError(err).Error("Error: updating rollout revision")
 			return err
 		}
+		resolved := c.rollout
 		c.rollout = updatedRollout.DeepCopy()
 		if err := c.refResolver.Resolve(c.rollout); err != nil {
 			return err
 		}
+		if err := c.resolveConfigRefs(c.rollout); err != nil {
+			return err
+		}
+		keepResolvedStrategy(resolved, c.rollout)
 		c.newRollout = updatedRollout
 		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutUpdatedReason}, conditions.RolloutUpdatedMessage, revision)
 	}
@@ -132,7 +143,7 @@ func (c *rolloutContext) setRolloutRevision(revision string) error {
 }
 
 func (c *rolloutContext) createDesiredReplicaSet() (*appsv1.ReplicaSet, error) {
-	ctx := context.TODO()
+	ctx := c.context()
 	// Calculate the max revision number among all old RSes
 	maxOldRevision := replicasetutil.MaxRevision(c.olderRSs)
 	// Calculate revision number for this new replica set
@@ -249,7 +260,7 @@ func (c *rolloutContext) createDesiredReplicaSet() (*appsv1.ReplicaSet, error) {
 
 	if !alreadyExists {
 		revision, _ := replicasetutil.Revision(createdRS)
-		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.NewReplicaSetReason}, conditions.NewReplicaSetDetailedMessage, createdRS.Name, revision)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.NewReplicaSetReason, RelatedObject: createdRS}, conditions.NewReplicaSetDetailedMessage, createdRS.Name, revision)
 
 		msg := fmt.Sprintf(conditions.NewReplicaSetMessage, createdRS.Name)
 		condition := conditions.NewRolloutCondition(v1alpha1.RolloutProgressing, corev1.ConditionTrue, conditions.NewReplicaSetReason, msg)
@@ -258,10 +269,12 @@ func (c *rolloutContext) createDesiredReplicaSet() (*appsv1.ReplicaSet, error) {
 		if err != nil {
 			return nil, err
 		}
+		resolved := c.rollout
 		c.rollout = updatedRollout.DeepCopy()
 		if err := c.refResolver.Resolve(c.rollout); err != nil {
 			return nil, err
 		}
+		keepResolvedStrategy(resolved, c.rollout)
 		c.newRollout = updatedRollout
 		c.log.Infof("Set rollout condition: %v", condition)
 	}
@@ -358,7 +371,7 @@ func (c *rolloutContext) scaleReplicaSetAndRecordEvent(rs *appsv1.ReplicaSet, ne
 }
 
 func (c *rolloutContext) scaleReplicaSet(rs *appsv1.ReplicaSet, newScale int32, rollout *v1alpha1.Rollout, scalingOperation string) (bool, *appsv1.ReplicaSet, error) {
-	ctx := context.TODO()
+	ctx := c.context()
 	sizeNeedsUpdate := *(rs.Spec.Replicas) != newScale
 	fullScaleDown := newScale == int32(0)
 	rolloutReplicas := defaults.GetReplicasOrDefault(rollout.Spec.Replicas)
@@ -384,7 +397,7 @@ func (c *rolloutContext) scaleReplicaSet(rs *appsv1.ReplicaSet, newScale int32,
 		if sizeNeedsUpdate {
 			scaled = true
 			revision, _ := replicasetutil.Revision(rs)
-			c.recorder.Eventf(rollout, record.EventOptions{EventReason: conditions.ScalingReplicaSetReason}, conditions.ScalingReplicaSetMessage, scalingOperation, rs.Name, revision, oldScale, newScale)
+			c.recorder.Eventf(rollout, record.EventOptions{EventReason: conditions.ScalingReplicaSetReason, RelatedObject: rs}, conditions.ScalingReplicaSetMessage, scalingOperation, rs.Name, revision, oldScale, newScale)
 		}
 	}
 	return scaled, rs, err
@@ -427,7 +440,7 @@ func (c *rolloutContext) calculateBaseStatus() v1alpha1.RolloutStatus {
 // where N=r.Spec.RevisionHistoryLimit. Old replica sets are older versions of the podtemplate of a rollout kept
 // around by default 1) for historical reasons.
 func (c *rolloutContext) reconcileRevisionHistoryLimit(oldRSs []*appsv1.ReplicaSet) error {
-	ctx := context.TODO()
+	ctx := c.context()
 	revHistoryLimit := defaults.GetRevisionHistoryLimitOrDefault(c.rollout)
 
 	// Avoid deleting replica set with deletion timestamp set
@@ -488,7 +501,7 @@ func (c *rolloutContext) checkPausedConditions() error {
 	progCondPaused := progCond != nil && progCond.Reason == conditions.RolloutPausedReason
 
 	isPaused := len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Spec.Paused
-	abortCondExists := progCond != nil && progCond.Reason == conditions.RolloutAbortedReason
+	abortCondExists := progCond != nil && (progCond.Reason == conditions.RolloutAbortedReason || progCond.Reason == conditions.StepTimedOutReason)
 
 	var updatedConditions []*v1alpha1.RolloutCondition
 
@@ -525,7 +538,7 @@ func (c *rolloutContext) checkPausedConditions() error {
 }
 
 func (c *rolloutContext) patchCondition(r *v1alpha1.Rollout, newStatus *v1alpha1.RolloutStatus, conditionList ...*v1alpha1.RolloutCondition) error {
-	ctx := context.TODO()
+	ctx := c.context()
 	for _, condition := range conditionList {
 		conditions.SetRolloutCondition(newStatus, *condition)
 	}
@@ -590,7 +603,7 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 
 	var becameUnhealthy bool // remember if we transitioned from healthy to unhealthy
 	completeCond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.RolloutHealthy)
-	if !isPaused && conditions.RolloutHealthy(c.rollout, &newStatus) {
+	if !isPaused && conditions.RolloutHealthy(c.rollout, &newStatus, c.desiredReplicas()) {
 		updateHealthyCond := conditions.NewRolloutCondition(v1alpha1.RolloutHealthy, corev1.ConditionTrue, conditions.RolloutHealthyReason, conditions.RolloutHealthyMessage)
 		conditions.SetRolloutCondition(&newStatus, *updateHealthyCond)
 		// If we ever wanted to emit a healthy event here it would be noisy and somewhat unpredictable for tests and so should probably be skipped
@@ -610,9 +623,13 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 		if c.pauseContext.abortMessage != "" {
 			message = fmt.Sprintf("%s: %s", message, c.pauseContext.abortMessage)
 		}
-		condition := conditions.NewRolloutCondition(v1alpha1.RolloutProgressing, corev1.ConditionFalse, conditions.RolloutAbortedReason, message)
+		reason := conditions.RolloutAbortedReason
+		if c.stepTimedOutAbort() {
+			reason = conditions.StepTimedOutReason
+		}
+		condition := conditions.NewRolloutCondition(v1alpha1.RolloutProgressing, corev1.ConditionFalse, reason, message)
 		if conditions.SetRolloutCondition(&newStatus, *condition) {
-			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutAbortedReason}, message)
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: reason}, message)
 		}
 	}
 
@@ -625,7 +642,7 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 	// Check for progress. Only do this if the latest rollout hasn't completed yet and it is not aborted
 	if !isHealthyRollout && !isAborted {
 		switch {
-		case conditions.RolloutHealthy(c.rollout, &newStatus):
+		case conditions.RolloutHealthy(c.rollout, &newStatus, c.desiredReplicas()):
 			// Update the rollout conditions with a message for the new replica set that
 			// was successfully deployed. If the condition already exists, we ignore this update.
 			rsName := ""
@@ -684,14 +701,14 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 			// If condition is changed and ProgressDeadlineAbort is set, abort the update
 			if condChanged {
 				if c.rollout.Spec.ProgressDeadlineAbort {
-					c.pauseContext.AddAbort(msg)
+					c.pauseContext.AddAbortWithReason(v1alpha1.RolloutAbortReasonProgressDeadlineExceeded, msg)
 					c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutAbortedReason}, msg)
 				}
 			} else {
 				// Although condition is unchanged, ProgressDeadlineAbort can be set after
 				// an existing update timeout. In this case if update is not aborted, we need to abort.
 				if c.rollout.Spec.ProgressDeadlineAbort && c.pauseContext != nil && !c.pauseContext.IsAborted() {
-					c.pauseContext.AddAbort(msg)
+					c.pauseContext.AddAbortWithReason(v1alpha1.RolloutAbortReasonProgressDeadlineExceeded, msg)
 					c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutAbortedReason}, msg)
 				}
 			}
@@ -702,7 +719,7 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 	if c.rollout.Spec.Strategy.BlueGreen != nil && activeRS != nil && annotations.IsSaturated(c.rollout, activeRS) {
 		availability := conditions.NewRolloutCondition(v1alpha1.RolloutAvailable, corev1.ConditionTrue, conditions.AvailableReason, conditions.AvailableMessage)
 		conditions.SetRolloutCondition(&newStatus, *availability)
-	} else if c.rollout.Spec.Strategy.Canary != nil && replicasetutil.GetAvailableReplicaCountForReplicaSets(c.allRSs) >= defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas) {
+	} else if c.rollout.Spec.Strategy.Canary != nil && replicasetutil.GetAvailableReplicaCountForReplicaSets(c.allRSs) >= c.desiredReplicas() {
 		availability := conditions.NewRolloutCondition(v1alpha1.RolloutAvailable, corev1.ConditionTrue, conditions.AvailableReason, conditions.AvailableMessage)
 		conditions.SetRolloutCondition(&newStatus, *availability)
 	} else {
@@ -739,11 +756,12 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 
 // persistRolloutStatus persists updates to rollout status. If no changes were made, it is a no-op
 func (c *rolloutContext) persistRolloutStatus(newStatus *v1alpha1.RolloutStatus) error {
-	ctx := context.TODO()
+	ctx := c.context()
 	logCtx := logutil.WithVersionFields(c.log, c.rollout)
 
 	prevStatus := c.rollout.Status
 	c.pauseContext.CalculatePauseStatus(newStatus)
+	c.calculateRetryStatus(newStatus)
 	if c.rollout.Spec.TemplateResolvedFromRef {
 		workloadRefObservation, _ := annotations.GetWorkloadGenerationAnnotation(c.rollout)
 		currentWorkloadObservedGeneration, _ := strconv.ParseInt(newStatus.WorkloadObservedGeneration, 10, 32)
@@ -754,6 +772,8 @@ func (c *rolloutContext) persistRolloutStatus(newStatus *v1alpha1.RolloutStatus)
 		newStatus.WorkloadObservedGeneration = ""
 	}
 
+	c.calculateLifecycleStatus(newStatus)
+
 	newStatus.ObservedGeneration = strconv.Itoa(int(c.rollout.Generation))
 	newStatus.Phase, newStatus.Message = rolloututil.CalculateRolloutPhase(c.rollout.Spec, *newStatus)
 
@@ -781,6 +801,7 @@ func (c *rolloutContext) persistRolloutStatus(newStatus *v1alpha1.RolloutStatus)
 	}
 
 	c.sendStateChangeEvents(&prevStatus, newStatus)
+	c.recordLifecycleMetrics(&prevStatus, newStatus)
 	logCtx.Infof("Patched: %s", patch)
 	c.newRollout = newRollout
 	return nil
@@ -816,7 +837,7 @@ func (c *rolloutContext) requeueStuckRollout(newStatus v1alpha1.RolloutStatus) t
 	}
 	// No need to estimate progress if the rollout is complete or already timed out.
 	isPaused := len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Spec.Paused
-	if conditions.RolloutHealthy(c.rollout, &newStatus) || currentCond.Reason == conditions.TimedOutReason || isPaused || c.rollout.Status.Abort || isIndefiniteStep(c.rollout) {
+	if conditions.RolloutHealthy(c.rollout, &newStatus, c.desiredReplicas()) || currentCond.Reason == conditions.TimedOutReason || isPaused || c.rollout.Status.Abort || isIndefiniteStep(c.rollout) {
 		return time.Duration(-1)
 	}
 	// If there is no sign of progress at this point then there is a high chance that the
@@ -894,9 +915,12 @@ func (c *rolloutContext) resetRolloutStatus(newStatus *v1alpha1.RolloutStatus) {
 	newStatus.BlueGreen.PrePromotionAnalysisRunStatus = nil
 	newStatus.BlueGreen.PostPromotionAnalysisRunStatus = nil
 	newStatus.BlueGreen.ScaleUpPreviewCheckPoint = false
+	newStatus.BlueGreen.TrafficStepIndex = nil
+	newStatus.BlueGreen.TrafficStepAnalysisRunStatus = nil
 	newStatus.Canary.CurrentStepAnalysisRunStatus = nil
 	newStatus.Canary.CurrentBackgroundAnalysisRunStatus = nil
 	newStatus.Canary.StepPluginStatuses = nil
+	newStatus.CurrentStep = nil
 	newStatus.CurrentStepIndex = replicasetutil.ResetCurrentStepIndex(c.rollout)
 }
 
@@ -942,7 +966,7 @@ func (c *rolloutContext) shouldFullPromote(newStatus v1alpha1.RolloutStatus) str
 		if c.pauseContext.IsAborted() {
 			return ""
 		}
-		if c.newRS == nil || c.newRS.Status.AvailableReplicas != defaults.GetReplicasOrDefault(c.rollout.Spec.Replicas) {
+		if c.newRS == nil || c.newRS.Status.AvailableReplicas != c.desiredReplicas() {
 			return ""
 		}
 		if c.rollout.Status.PromoteFull {
@@ -987,8 +1011,7 @@ func (c *rolloutContext) shouldFullPromote(newStatus v1alpha1.RolloutStatus) str
 			if replicasetutil.HasScaleDownDeadline(c.newRS) {
 				return fmt.Sprintf("Rollback to '%s' within scaleDownDelay", c.newRS.Name)
 			}
-			currentPostPromotionAnalysisRun := c.currentArs.BlueGreenPostPromotion
-			if currentPostPromotionAnalysisRun == nil || currentPostPromotionAnalysisRun.Status.Phase != v1alpha1.AnalysisPhaseSuccessful {
+			if !c.completedPostPromotionAnalysis() {
 				// we have yet to start post-promotion analysis or post-promotion was not successful
 				return ""
 			}
@@ -1005,6 +1028,7 @@ func (c *rolloutContext) promoteStable(newStatus *v1alpha1.RolloutStatus, reason
 	c.pauseContext.RemoveAbort()
 	newStatus.PromoteFull = false
 	newStatus.BlueGreen.ScaleUpPreviewCheckPoint = false
+	newStatus.CurrentStep = nil
 	if c.rollout.Spec.Strategy.Canary != nil {
 		stepCount := int32(len(c.rollout.Spec.Strategy.Canary.Steps))
 		if stepCount > 0 {
@@ -48,6 +48,9 @@ var (
 		{Group: "apps", Kind: "ReplicaSet"}: {
 			TemplatePath: []string{"spec", "template"}, SelectorPath: []string{"spec", "selector"},
 		},
+		{Group: "apps", Kind: "StatefulSet"}: {
+			TemplatePath: []string{"spec", "template"}, SelectorPath: []string{"spec", "selector"},
+		},
 	}
 )
 
@@ -57,6 +60,7 @@ type informerBasedTemplateResolver struct {
 	informerSyncTimeout    time.Duration
 	informersLock          sync.Mutex
 	informers              map[schema.GroupVersionKind]func() (informers.GenericInformer, error)
+	apiResources           map[schema.GroupVersionKind]v1.APIResource
 	dynamicClient          dynamic.Interface
 	discoClient            discovery.DiscoveryInterface
 	ctx                    context.Context
@@ -87,6 +91,7 @@ func NewInformerBasedWorkloadRefResolver(
 	}
 	return &informerBasedTemplateResolver{
 		informers:              map[schema.GroupVersionKind]func() (informers.GenericInformer, error){},
+		apiResources:           map[schema.GroupVersionKind]v1.APIResource{},
 		namespace:              namespace,
 		ctx:                    ctx,
 		cancelContext:          cancelContext,
@@ -186,21 +191,48 @@ func (r *informerBasedTemplateResolver) Resolve(rollout *v1alpha1.Rollout) error
 	return nil
 }
 
-// newInformerForGVK create an informer for a given group version kind
-func (r *informerBasedTemplateResolver) newInformerForGVK(gvk schema.GroupVersionKind) (informers.GenericInformer, error) {
+// GetResource gets a resource of any group version kind from the API server, so that the resources referenced by the
+// waitFor steps are not listed and watched cluster-wide. The namespace is ignored for cluster-scoped resources.
+func (r *informerBasedTemplateResolver) GetResource(gvk schema.GroupVersionKind, namespace, name string) (*unstructured.Unstructured, error) {
+	apiResource, err := r.getAPIResource(gvk)
+	if err != nil {
+		return nil, err
+	}
+	gvr := schema.GroupVersionResource{Group: gvk.Group, Version: gvk.Version, Resource: apiResource.Name}
+	if !apiResource.Namespaced {
+		return r.dynamicClient.Resource(gvr).Get(context.TODO(), name, v1.GetOptions{})
+	}
+	return r.dynamicClient.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, v1.GetOptions{})
+}
+
+// getAPIResource discovers the API resource of a group version kind. The resources which exist are cached.
+func (r *informerBasedTemplateResolver) getAPIResource(gvk schema.GroupVersionKind) (*v1.APIResource, error) {
+	r.informersLock.Lock()
+	apiResource, ok := r.apiResources[gvk]
+	r.informersLock.Unlock()
+	if ok {
+		return &apiResource, nil
+	}
 	resources, err := r.discoClient.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
 	if err != nil {
 		return nil, err
 	}
-	var apiResource *v1.APIResource
-	for _, r := range resources.APIResources {
-		if r.Kind == gvk.Kind {
-			apiResource = &r
-			break
+	for _, resource := range resources.APIResources {
+		if resource.Kind == gvk.Kind {
+			r.informersLock.Lock()
+			r.apiResources[gvk] = resource
+			r.informersLock.Unlock()
+			return &resource, nil
 		}
 	}
-	if apiResource == nil {
-		return nil, errors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, "")
+	return nil, errors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, "")
+}
+
+// newInformerForGVK create an informer for a given group version kind
+func (r *informerBasedTemplateResolver) newInformerForGVK(gvk schema.GroupVersionKind) (informers.GenericInformer, error) {
+	apiResource, err := r.getAPIResource(gvk)
+	if err != nil {
+		return nil, err
 	}
 	informer := dynamicinformer.NewFilteredDynamicInformer(
 		r.dynamicClient,
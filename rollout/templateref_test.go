@@ -48,6 +48,7 @@ func newFakeDiscoClient() *discofake.FakeDiscovery {
 					APIResources: []metav1.APIResource{
 						{Name: "deployments", Namespaced: true, Kind: "Deployment"},
 						{Name: "replicasets", Namespaced: true, Kind: "ReplicaSet"},
+						{Name: "statefulsets", Namespaced: true, Kind: "StatefulSet"},
 					},
 				},
 			},
@@ -274,6 +275,51 @@ func TestResolve_ReplicaSetRef(t *testing.T) {
 	assert.Equal(t, rs.Spec.Template, rollout.Spec.Template)
 }
 
+func TestResolve_StatefulSetRef(t *testing.T) {
+	rollout := v1alpha1.Rollout{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: v1alpha1.RolloutSpec{
+			WorkloadRef: &v1alpha1.ObjectRef{
+				Name:       "my-sts",
+				Kind:       "StatefulSet",
+				APIVersion: "apps/v1",
+			},
+		},
+	}
+
+	sts := &appsv1.StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "StatefulSet",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-sts",
+			Namespace: "default",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "db"}},
+			},
+		},
+	}
+
+	discoveryClient := newFakeDiscoClient()
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, sts)
+
+	resolver, cancel := newResolver(dynamicClient, discoveryClient, fake.NewSimpleClientset())
+	defer cancel()
+
+	err := resolver.Resolve(&rollout)
+
+	assert.NoError(t, err)
+	assert.Equal(t, sts.Spec.Template, rollout.Spec.Template)
+	assert.Equal(t, sts.Spec.Selector, rollout.Spec.Selector)
+	assert.True(t, rollout.Spec.IsStatefulSetWorkload())
+}
+
 func TestResolveRefDeployment_PodTemplate(t *testing.T) {
 	rollout := v1alpha1.Rollout{
 		ObjectMeta: v1.ObjectMeta{
@@ -474,3 +520,35 @@ func TestResolve_WorkloadWithTemplate(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, "template must be empty for workload reference rollout", err.Error())
 }
+
+func TestGetResource(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-deployment",
+			Namespace: "default",
+		},
+	}
+
+	discoveryClient := newFakeDiscoClient()
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(scheme.Scheme, deployment)
+
+	resolver, cancel := newResolver(dynamicClient, discoveryClient, fake.NewSimpleClientset())
+	defer cancel()
+
+	gvk := appsv1.SchemeGroupVersion.WithKind("Deployment")
+	un, err := resolver.GetResource(gvk, "default", "my-deployment")
+	require.NoError(t, err)
+	assert.Equal(t, "my-deployment", un.GetName())
+
+	_, err = resolver.GetResource(gvk, "other", "my-deployment")
+	assert.True(t, errors.IsNotFound(err))
+	// the resources are read with a GET, without starting an informer
+	assert.Empty(t, resolver.informers)
+
+	_, err = resolver.GetResource(schema.GroupVersionKind{Group: "unknown.io", Version: "v1", Kind: "Unknown"}, "default", "my-deployment")
+	assert.Error(t, err)
+}
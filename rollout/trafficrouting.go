@@ -18,7 +18,11 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/ambassador"
 	a6 "github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/contour"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/dns"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/kong"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/linkerd"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/nginx"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik"
@@ -34,6 +38,12 @@ import (
 // NewTrafficRoutingReconciler identifies return the TrafficRouting Plugin that the rollout wants to modify
 func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]trafficrouting.TrafficRoutingReconciler, error) {
 	rollout := roCtx.rollout
+	if rollout.Spec.Strategy.BlueGreen != nil {
+		if rollout.Spec.Strategy.BlueGreen.TrafficRouting == nil {
+			return nil, nil
+		}
+		rollout = blueGreenTrafficRoutingRollout(rollout)
+	}
 	// define an empty list of trafficReconcilers to be populated
 	// by the ones declared in the rolloutContext
 	trafficReconcilers := []trafficrouting.TrafficRoutingReconciler{}
@@ -112,6 +122,42 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 		}))
 	}
 
+	if rollout.Spec.Strategy.Canary.TrafficRouting.Contour != nil {
+		dynamicClient := contour.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		trafficReconcilers = append(trafficReconcilers, contour.NewReconciler(&contour.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   dynamicClient,
+			Recorder: c.recorder,
+		}))
+	}
+
+	if rollout.Spec.Strategy.Canary.TrafficRouting.Kong != nil {
+		dynamicClient := kong.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		trafficReconcilers = append(trafficReconcilers, kong.NewReconciler(&kong.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   dynamicClient,
+			Recorder: c.recorder,
+		}))
+	}
+
+	if rollout.Spec.Strategy.Canary.TrafficRouting.Linkerd != nil {
+		dynamicClient := linkerd.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		trafficReconcilers = append(trafficReconcilers, linkerd.NewReconciler(&linkerd.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   dynamicClient,
+			Recorder: c.recorder,
+		}))
+	}
+
+	if rollout.Spec.Strategy.Canary.TrafficRouting.DNS != nil {
+		dynamicClient := dns.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		trafficReconcilers = append(trafficReconcilers, dns.NewReconciler(&dns.ReconcilerConfig{
+			Rollout:  rollout,
+			Client:   dynamicClient,
+			Recorder: c.recorder,
+		}))
+	}
+
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Plugins != nil {
 		for pluginName := range rollout.Spec.Strategy.Canary.TrafficRouting.Plugins {
 			pluginReconciler, err := plugin.NewReconciler(&plugin.ReconcilerConfig{
@@ -152,9 +198,23 @@ func (c *rolloutContext) checkReplicasAvailable(rs *appsv1.ReplicaSet, desiredWe
 
 }
 
+// getTrafficRoutingReconcilers returns the traffic routing reconcilers of the rollout, whose calls are traced as
+// part of the reconciliation
+func (c *rolloutContext) getTrafficRoutingReconcilers() ([]trafficrouting.TrafficRoutingReconciler, error) {
+	reconcilers, err := c.newTrafficRoutingReconciler(c)
+	if err != nil {
+		return nil, err
+	}
+	traced := make([]trafficrouting.TrafficRoutingReconciler, len(reconcilers))
+	for i, reconciler := range reconcilers {
+		traced[i] = trafficrouting.NewTracedReconciler(c.context(), reconciler)
+	}
+	return traced, nil
+}
+
 // this currently only be used in the canary strategy
 func (c *rolloutContext) reconcileTrafficRouting() error {
-	reconcilers, err := c.newTrafficRoutingReconciler(c)
+	reconcilers, err := c.getTrafficRoutingReconcilers()
 	// a return here does ensure that all trafficReconcilers are healthy
 	// and same in syntax
 	if err != nil {
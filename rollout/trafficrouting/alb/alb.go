@@ -19,6 +19,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	"github.com/argoproj/argo-rollouts/utils/aws"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
@@ -120,7 +121,15 @@ func (r *Reconciler) SetWeightPerIngress(desiredWeight int32, ingresses []string
 		r.log.WithField("desiredWeight", desiredWeight).Info("updating ALB Ingress")
 		r.cfg.Recorder.Eventf(rollout, record.EventOptions{EventReason: "PatchingALBIngress"}, "Updating Ingress `%s` to desiredWeight '%d'", ingressName, desiredWeight)
 
-		_, err = r.cfg.IngressWrapper.Patch(ctx, ingress.GetNamespace(), ingress.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+		if apply.Enabled() {
+			patch, err = ingressutil.BuildManagedALBAnnotationsApplyPatch(ingress.Mode(), ingress, desiredAnnotations)
+			if err != nil {
+				return err
+			}
+			_, err = r.cfg.IngressWrapper.Patch(ctx, ingress.GetNamespace(), ingress.GetName(), types.ApplyPatchType, patch, apply.PatchOptions())
+		} else {
+			_, err = r.cfg.IngressWrapper.Patch(ctx, ingress.GetNamespace(), ingress.GetName(), types.MergePatchType, patch, metav1.PatchOptions{})
+		}
 		if err != nil {
 			r.log.WithField("err", err.Error()).Error("error patching alb ingress")
 			return fmt.Errorf("error patching alb ingress `%s`: %v", ingressName, err)
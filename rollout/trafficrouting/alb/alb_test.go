@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
@@ -24,6 +25,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/aws"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	jsonutil "github.com/argoproj/argo-rollouts/utils/json"
 	"github.com/argoproj/argo-rollouts/utils/record"
@@ -569,6 +571,47 @@ func TestUpdateDesiredWeight(t *testing.T) {
 	assert.Len(t, client.Actions(), 1)
 }
 
+func TestUpdateDesiredWeightServerSideApply(t *testing.T) {
+	defaults.SetServerSideApplyEnabled(true)
+	defer defaults.SetServerSideApplyEnabled(false)
+
+	ro := fakeRollout(STABLE_SVC, CANARY_SVC, nil, "ingress", 443)
+	i := ingress("ingress", STABLE_SVC, CANARY_SVC, STABLE_SVC, 443, 5, ro.Name, false)
+	i.Annotations["kubernetes.io/ingress.class"] = "alb"
+	client := fake.NewSimpleClientset(i)
+	k8sI := kubeinformers.NewSharedInformerFactory(client, 0)
+	k8sI.Networking().V1().Ingresses().Informer().GetIndexer().Add(i)
+	ingressWrapper, err := ingressutil.NewIngressWrapper(ingressutil.IngressModeNetworking, client, k8sI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewReconciler(ReconcilerConfig{
+		Rollout:        ro,
+		Client:         client,
+		Recorder:       record.NewFakeEventRecorder(),
+		ControllerKind: schema.GroupVersionKind{Group: "foo", Version: "v1", Kind: "Bar"},
+		IngressWrapper: ingressWrapper,
+	})
+	assert.NoError(t, err)
+	err = r.SetWeight(10)
+	assert.Nil(t, err)
+	assert.Len(t, client.Actions(), 1)
+
+	patchAction, ok := client.Actions()[0].(k8stesting.PatchAction)
+	assert.True(t, ok)
+	assert.Equal(t, types.ApplyPatchType, patchAction.GetPatchType())
+	var applied networkingv1.Ingress
+	assert.NoError(t, json.Unmarshal(patchAction.GetPatch(), &applied))
+	assert.Equal(t, "networking.k8s.io/v1", applied.APIVersion)
+	assert.Equal(t, "Ingress", applied.Kind)
+	assert.Empty(t, applied.Spec.Rules)
+	// only the annotations managed by rollouts are applied
+	assert.Len(t, applied.Annotations, 2)
+	assert.Contains(t, applied.Annotations, ingressutil.ManagedAnnotations)
+	expectedAction := fmt.Sprintf(actionTemplate, CANARY_SVC, 443, 10, STABLE_SVC, 443, 90)
+	assert.JSONEq(t, expectedAction, applied.Annotations[albActionAnnotation(STABLE_SVC)])
+}
+
 func TestUpdateDesiredWeightMultiIngress(t *testing.T) {
 	ro := fakeRolloutWithMultiIngress(STABLE_SVC, CANARY_SVC, nil, []string{"ingress", "multi-ingress"}, 443)
 	i := ingress("ingress", STABLE_SVC, CANARY_SVC, STABLE_SVC, 443, 5, ro.Name, false)
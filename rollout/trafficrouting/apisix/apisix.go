@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	"github.com/argoproj/argo-rollouts/utils/record"
 )
 
@@ -83,7 +84,7 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	if err != nil {
 		return err
 	}
-	_, err = r.Client.Update(ctx, apisixRoute, metav1.UpdateOptions{})
+	_, err = apply.Update(ctx, r.Client, apisixRoute, []string{"spec", "http"})
 	if err != nil {
 		msg := fmt.Sprintf("Error updating apisix route %q: %s", apisixRoute.GetName(), err)
 		r.sendWarningEvent(apisixRouteUpdateError, msg)
@@ -8,6 +8,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/dynamic"
 
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	appmeshutil "github.com/argoproj/argo-rollouts/utils/appmesh"
 )
 
@@ -41,12 +43,14 @@ func (rc *ResourceClient) GetVirtualNodeCR(ctx context.Context, namespace string
 
 func (rc *ResourceClient) UpdateVirtualRouterCR(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	client := rc.client.Resource(appmeshutil.GetAppMeshVirtualRouterGVR()).Namespace(obj.GetNamespace())
-	return client.Update(ctx, obj, metav1.UpdateOptions{})
+	return apply.Update(ctx, client, obj, []string{"spec", "routes"})
 }
 
 func (rc *ResourceClient) UpdateVirtualNodeCR(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
 	client := rc.client.Resource(appmeshutil.GetAppMeshVirtualNodeGVR()).Namespace(obj.GetNamespace())
-	return client.Update(ctx, obj, metav1.UpdateOptions{})
+	return apply.Update(ctx, client, obj,
+		[]string{"metadata", "annotations", v1alpha1.ManagedByRolloutsKey},
+		[]string{"spec", "podSelector", "matchLabels", v1alpha1.DefaultRolloutUniqueLabelKey})
 }
 
 func (rc *ResourceClient) GetVirtualRouterCRForVirtualService(ctx context.Context, uVsvc *unstructured.Unstructured) (*unstructured.Unstructured, error) {
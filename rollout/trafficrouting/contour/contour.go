@@ -0,0 +1,464 @@
+package contour
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	"github.com/argoproj/argo-rollouts/utils/apply"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// Type holds this controller type
+const Type = "Contour"
+
+const (
+	httpProxies          = "httpproxies"
+	httpProxyUpdateError = "ContourHTTPProxyUpdateError"
+	failedToTypeAssert   = "Failed type assertion for Contour HTTPProxy %s"
+)
+
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   ClientInterface
+	Recorder record.EventRecorder
+}
+
+type Reconciler struct {
+	Rollout  *v1alpha1.Rollout
+	Client   ClientInterface
+	Recorder record.EventRecorder
+}
+
+type ClientInterface interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error)
+	Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error)
+}
+
+func NewReconciler(cfg *ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		Rollout:  cfg.Rollout,
+		Client:   cfg.Client,
+		Recorder: cfg.Recorder,
+	}
+}
+
+func NewDynamicClient(di dynamic.Interface, namespace string) dynamic.ResourceInterface {
+	return di.Resource(GetHTTPProxyGVR()).Namespace(namespace)
+}
+
+func GetHTTPProxyGVR() schema.GroupVersionResource {
+	parts := strings.Split(defaults.DefaultContourVersion, "/")
+	return schema.GroupVersionResource{
+		Group:    defaults.DefaultContourAPIGroup,
+		Version:  parts[len(parts)-1],
+		Resource: httpProxies,
+	}
+}
+
+func (r *Reconciler) sendWarningEvent(id, msg string) {
+	r.Recorder.Eventf(r.Rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: id}, msg)
+}
+
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+// SetWeight sets the weight of the canary and stable services on every route of the HTTPProxies that
+// references both of them. The other weighted services of these routes are replaced by the services of the
+// additional destinations, i.e. the services of the experiments.
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	canaryService := r.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.Rollout.Spec.Strategy.Canary.StableService
+	stableWeight := 100 - desiredWeight
+	for _, dest := range additionalDestinations {
+		stableWeight -= dest.Weight
+	}
+	return r.updateHTTPProxies(func(httpProxy *unstructured.Unstructured, routes []any) ([]any, error) {
+		found := false
+		for _, route := range routes {
+			typedRoute, ok := route.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf(failedToTypeAssert, "route")
+			}
+			services, err := getServices(typedRoute)
+			if err != nil {
+				return nil, err
+			}
+			canary, stable := findService(services, canaryService), findService(services, stableService)
+			if canary == nil || stable == nil {
+				continue
+			}
+			found = true
+			setServiceWeight(canary, int64(desiredWeight))
+			setServiceWeight(stable, int64(stableWeight))
+			typedRoute["services"] = destinationServices(services, canary, []string{canaryService, stableService}, additionalDestinations)
+		}
+		if !found {
+			return nil, fmt.Errorf("HTTPProxy %q has no route with services %q and %q", httpProxy.GetName(), stableService, canaryService)
+		}
+		return routes, nil
+	})
+}
+
+// SetHeaderRoute adds a route in front of the user defined routes which sends all requests with the
+// matching headers to the canary service
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	return r.updateManagedRoute(headerRouting.Name, func(baseRoute map[string]any) ([]any, error) {
+		if headerRouting.Match == nil {
+			return nil, nil
+		}
+		canary := findService(mustGetServices(baseRoute), r.Rollout.Spec.Strategy.Canary.CanaryService)
+		canary = runtime.DeepCopyJSONValue(canary).(map[string]any)
+		canary["weight"] = int64(100)
+		delete(canary, "mirror")
+
+		conditions := getConditions(baseRoute)
+		for _, match := range headerRouting.Match {
+			conditions = append(conditions, headerCondition(match.HeaderName, match.HeaderValue))
+		}
+		route := runtime.DeepCopyJSONValue(baseRoute).(map[string]any)
+		route["conditions"] = conditions
+		route["services"] = []any{canary}
+		return []any{route}, nil
+	})
+}
+
+// SetMirrorRoute adds a route for each match in front of the user defined routes which mirrors the matching
+// requests to the canary service
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	return r.updateManagedRoute(setMirrorRoute.Name, func(baseRoute map[string]any) ([]any, error) {
+		if setMirrorRoute.Match == nil {
+			return nil, nil
+		}
+		mirror := findService(mustGetServices(baseRoute), r.Rollout.Spec.Strategy.Canary.CanaryService)
+		mirror = runtime.DeepCopyJSONValue(mirror).(map[string]any)
+		mirror["mirror"] = true
+		delete(mirror, "weight")
+		if setMirrorRoute.Percentage != nil {
+			mirror["weight"] = int64(*setMirrorRoute.Percentage)
+		}
+
+		routes := []any{}
+		for _, match := range setMirrorRoute.Match {
+			route := runtime.DeepCopyJSONValue(baseRoute).(map[string]any)
+			services := []any{}
+			for _, service := range mustGetServices(route) {
+				if isMirror, _, _ := unstructured.NestedBool(service, "mirror"); !isMirror {
+					services = append(services, service)
+				}
+			}
+			route["services"] = append(services, mirror)
+			route["conditions"] = matchConditions(getConditions(baseRoute), match)
+			routes = append(routes, route)
+		}
+		return routes, nil
+	})
+}
+
+// VerifyWeight verifies that Contour accepted the current generation of the HTTPProxies, i.e. that their Valid
+// condition is true for the generation which holds the weights. Contour does not report the weights it applied.
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	ctx := context.TODO()
+	verified := true
+	for _, name := range r.Rollout.Spec.Strategy.Canary.TrafficRouting.Contour.HTTPProxies {
+		httpProxy, err := r.Client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if !isValid(httpProxy) {
+			verified = false
+		}
+	}
+	return &verified, nil
+}
+
+// RemoveManagedRoutes removes every route recorded in the managed routes annotation of the HTTPProxies
+func (r *Reconciler) RemoveManagedRoutes() error {
+	if len(r.Rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes) == 0 {
+		return nil
+	}
+	return r.updateHTTPProxies(func(httpProxy *unstructured.Unstructured, routes []any) ([]any, error) {
+		names := trafficrouting.GetManagedRouteNames(httpProxy)
+		trafficrouting.SetManagedRouteNames(httpProxy, nil)
+		return trafficrouting.RemoveAllManagedRoutes(routes, names), nil
+	})
+}
+
+func (r *Reconciler) Type() string {
+	return Type
+}
+
+// updateManagedRoute regenerates the managed routes recorded under name from the first user defined route which
+// references both the stable and canary services
+func (r *Reconciler) updateManagedRoute(name string, generate func(baseRoute map[string]any) ([]any, error)) error {
+	canaryService := r.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.Rollout.Spec.Strategy.Canary.StableService
+	return r.updateHTTPProxies(func(httpProxy *unstructured.Unstructured, routes []any) ([]any, error) {
+		names := trafficrouting.GetManagedRouteNames(httpProxy)
+		var baseRoute map[string]any
+		for _, route := range trafficrouting.RemoveAllManagedRoutes(routes, names) {
+			typedRoute, ok := route.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf(failedToTypeAssert, "route")
+			}
+			services, err := getServices(typedRoute)
+			if err != nil {
+				return nil, err
+			}
+			if findService(services, canaryService) != nil && findService(services, stableService) != nil {
+				baseRoute = typedRoute
+				break
+			}
+		}
+		if baseRoute == nil {
+			return nil, fmt.Errorf("HTTPProxy %q has no route with services %q and %q", httpProxy.GetName(), stableService, canaryService)
+		}
+		newRoutes, err := generate(baseRoute)
+		if err != nil {
+			return nil, err
+		}
+		routes, names = trafficrouting.ReplaceManagedRoutes(routes, names, name, newRoutes, r.Rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes)
+		trafficrouting.SetManagedRouteNames(httpProxy, names)
+		return routes, nil
+	})
+}
+
+// updateHTTPProxies applies the mutation to spec.routes of every HTTPProxy referenced by the rollout and only updates
+// the HTTPProxies which were changed
+func (r *Reconciler) updateHTTPProxies(mutate func(httpProxy *unstructured.Unstructured, routes []any) ([]any, error)) error {
+	ctx := context.TODO()
+	for _, name := range r.Rollout.Spec.Strategy.Canary.TrafficRouting.Contour.HTTPProxies {
+		httpProxy, err := r.Client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		routes, isFound, err := unstructured.NestedSlice(httpProxy.Object, "spec", "routes")
+		if err != nil {
+			return err
+		}
+		if !isFound {
+			return errors.New("spec.routes was not found in Contour HTTPProxy manifest")
+		}
+		newHTTPProxy := httpProxy.DeepCopy()
+		newRoutes, err := mutate(newHTTPProxy, routes)
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedSlice(newHTTPProxy.Object, newRoutes, "spec", "routes"); err != nil {
+			return err
+		}
+		if reflect.DeepEqual(httpProxy.Object, newHTTPProxy.Object) {
+			continue
+		}
+		_, err = apply.Update(ctx, r.Client, newHTTPProxy, []string{"spec", "routes"})
+		if err != nil {
+			msg := fmt.Sprintf("Error updating Contour HTTPProxy %q: %s", newHTTPProxy.GetName(), err)
+			r.sendWarningEvent(httpProxyUpdateError, msg)
+			return err
+		}
+	}
+	return nil
+}
+
+func getServices(route map[string]any) ([]map[string]any, error) {
+	rawServices, ok := route["services"]
+	if !ok {
+		return nil, nil
+	}
+	typedServices, ok := rawServices.([]any)
+	if !ok {
+		return nil, fmt.Errorf(failedToTypeAssert, "services")
+	}
+	services := make([]map[string]any, 0, len(typedServices))
+	for _, service := range typedServices {
+		typedService, ok := service.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(failedToTypeAssert, "service")
+		}
+		services = append(services, typedService)
+	}
+	return services, nil
+}
+
+// mustGetServices returns the services of a route which was already validated by getServices
+func mustGetServices(route map[string]any) []map[string]any {
+	services, _ := getServices(route)
+	return services
+}
+
+// findService returns the weighted (i.e. not mirrored) service entry with the given name
+func findService(services []map[string]any, name string) map[string]any {
+	for _, service := range services {
+		serviceName, _, _ := unstructured.NestedString(service, "name")
+		isMirror, _, _ := unstructured.NestedBool(service, "mirror")
+		if serviceName == name && !isMirror {
+			return service
+		}
+	}
+	return nil
+}
+
+// setServiceWeight sets the weight of the service unless it is already set to the same value, which could have been
+// decoded as a float
+func setServiceWeight(service map[string]any, weight int64) {
+	switch current := service["weight"].(type) {
+	case int64:
+		if current == weight {
+			return
+		}
+	case float64:
+		if current == float64(weight) {
+			return
+		}
+	}
+	service["weight"] = weight
+}
+
+// destinationServices returns the services of a weighted route: the canary, stable and mirror services and a service
+// for each additional destination, which is copied from the canary service. The services keep their order so that
+// the route is only updated when a weight changes.
+func destinationServices(services []map[string]any, canary map[string]any, names []string, additionalDestinations []v1alpha1.WeightDestination) []any {
+	weights := map[string]int32{}
+	for _, dest := range additionalDestinations {
+		weights[dest.ServiceName] = dest.Weight
+	}
+	newServices := []any{}
+	for _, service := range services {
+		name, _, _ := unstructured.NestedString(service, "name")
+		isMirror, _, _ := unstructured.NestedBool(service, "mirror")
+		if weight, ok := weights[name]; ok && !isMirror {
+			setServiceWeight(service, int64(weight))
+			delete(weights, name)
+		} else if !isMirror && !slices.Contains(names, name) {
+			continue
+		}
+		newServices = append(newServices, service)
+	}
+	for _, dest := range additionalDestinations {
+		if _, ok := weights[dest.ServiceName]; !ok {
+			continue
+		}
+		service := map[string]any{}
+		for _, key := range []string{"port", "protocol"} {
+			if value, ok := canary[key]; ok {
+				service[key] = runtime.DeepCopyJSONValue(value)
+			}
+		}
+		service["name"] = dest.ServiceName
+		service["weight"] = int64(dest.Weight)
+		newServices = append(newServices, service)
+	}
+	return newServices
+}
+
+// isValid returns true if the Valid condition of the HTTPProxy has status True and was observed for its current
+// generation
+func isValid(httpProxy *unstructured.Unstructured) bool {
+	conditions, _, _ := unstructured.NestedSlice(httpProxy.Object, "status", "conditions")
+	for _, condition := range conditions {
+		typedCondition, ok := condition.(map[string]any)
+		if !ok {
+			continue
+		}
+		typ, _, _ := unstructured.NestedString(typedCondition, "type")
+		status, _, _ := unstructured.NestedString(typedCondition, "status")
+		if typ != "Valid" || status != string(metav1.ConditionTrue) {
+			continue
+		}
+		var observedGeneration int64
+		switch value := typedCondition["observedGeneration"].(type) {
+		case int64:
+			observedGeneration = value
+		case float64:
+			observedGeneration = int64(value)
+		}
+		return observedGeneration >= httpProxy.GetGeneration()
+	}
+	return false
+}
+
+func getConditions(route map[string]any) []any {
+	conditions, _, _ := unstructured.NestedSlice(route, "conditions")
+	if conditions == nil {
+		conditions = []any{}
+	}
+	return conditions
+}
+
+// matchConditions returns the route conditions for a mirror match. A path in the match replaces the path condition
+// of the base route since Contour allows a single path condition per route. The method matches are rejected by the
+// validation, since Contour does not match the :method pseudo-header.
+func matchConditions(baseConditions []any, match v1alpha1.RouteMatch) []any {
+	conditions := []any{}
+	for _, condition := range baseConditions {
+		typedCondition, ok := condition.(map[string]any)
+		if ok && match.Path != nil && isPathCondition(typedCondition) {
+			continue
+		}
+		conditions = append(conditions, condition)
+	}
+	if match.Path != nil {
+		switch {
+		case match.Path.Exact != "":
+			conditions = append(conditions, map[string]any{"exact": match.Path.Exact})
+		case match.Path.Prefix != "":
+			conditions = append(conditions, map[string]any{"prefix": match.Path.Prefix})
+		case match.Path.Regex != "":
+			conditions = append(conditions, map[string]any{"regex": match.Path.Regex})
+		}
+	}
+	headerNames := make([]string, 0, len(match.Headers))
+	for name := range match.Headers {
+		headerNames = append(headerNames, name)
+	}
+	sort.Strings(headerNames)
+	for _, name := range headerNames {
+		value := match.Headers[name]
+		conditions = append(conditions, headerCondition(name, &value))
+	}
+	return conditions
+}
+
+func isPathCondition(condition map[string]any) bool {
+	for _, key := range []string{"prefix", "exact", "regex"} {
+		if _, ok := condition[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// headerCondition converts a StringMatch into a Contour header condition. Contour has no prefix match for headers,
+// so prefixes are converted into a regular expression.
+func headerCondition(name string, value *v1alpha1.StringMatch) map[string]any {
+	header := map[string]any{"name": name}
+	switch {
+	case value == nil:
+		header["present"] = true
+	case value.Exact != "":
+		header["exact"] = value.Exact
+	case value.Regex != "":
+		header["regex"] = value.Regex
+	case value.Prefix != "":
+		header["regex"] = regexp.QuoteMeta(value.Prefix) + ".*"
+	default:
+		header["present"] = true
+	}
+	return map[string]any{"header": header}
+}
@@ -0,0 +1,340 @@
+package contour
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const httpProxy = `
+apiVersion: projectcontour.io/v1
+kind: HTTPProxy
+metadata:
+  name: proxy
+  namespace: default
+spec:
+  virtualhost:
+    fqdn: example.com
+  routes:
+  - conditions:
+    - prefix: /
+    services:
+    - name: stable-service
+      port: 80
+      weight: 100
+    - name: canary-service
+      port: 80
+      weight: 0
+`
+
+const httpProxyWithoutCanary = `
+apiVersion: projectcontour.io/v1
+kind: HTTPProxy
+metadata:
+  name: proxy
+  namespace: default
+spec:
+  routes:
+  - conditions:
+    - prefix: /
+    services:
+    - name: stable-service
+      port: 80
+`
+
+const httpProxyWithoutRoutes = `
+apiVersion: projectcontour.io/v1
+kind: HTTPProxy
+metadata:
+  name: proxy
+  namespace: default
+spec:
+  virtualhost:
+    fqdn: example.com
+`
+
+func newRollout(managedRoutes ...string) *v1alpha1.Rollout {
+	routes := []v1alpha1.MangedRoutes{}
+	for _, name := range managedRoutes {
+		routes = append(routes, v1alpha1.MangedRoutes{Name: name})
+	}
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "stable-service",
+					CanaryService: "canary-service",
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						Contour: &v1alpha1.ContourTrafficRouting{
+							HTTPProxies: []string{"proxy"},
+						},
+						ManagedRoutes: routes,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newReconciler(ro *v1alpha1.Rollout, objs ...runtime.Object) (*Reconciler, *k8stesting.Fake) {
+	client := testutil.NewFakeDynamicClient(objs...)
+	r := NewReconciler(&ReconcilerConfig{
+		Rollout:  ro,
+		Client:   NewDynamicClient(client, ro.Namespace),
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	return r, &client.Fake
+}
+
+func getRoutes(t *testing.T, r *Reconciler) (*unstructured.Unstructured, []any) {
+	t.Helper()
+	obj, err := r.Client.Get(context.TODO(), "proxy", metav1.GetOptions{})
+	assert.NoError(t, err)
+	routes, _, err := unstructured.NestedSlice(obj.Object, "spec", "routes")
+	assert.NoError(t, err)
+	return obj, routes
+}
+
+func serviceWeights(t *testing.T, route any) map[string]any {
+	t.Helper()
+	weights := map[string]any{}
+	services, _, _ := unstructured.NestedSlice(route.(map[string]any), "services")
+	for _, service := range services {
+		typedService := service.(map[string]any)
+		key := typedService["name"].(string)
+		if mirror, _ := typedService["mirror"].(bool); mirror {
+			key += "-mirror"
+		}
+		// weights of unchanged services keep the float type from the yaml fixture
+		switch weight := typedService["weight"].(type) {
+		case float64:
+			weights[key] = int64(weight)
+		default:
+			weights[key] = weight
+		}
+	}
+	return weights
+}
+
+func TestGetHTTPProxyGVR(t *testing.T) {
+	gvr := GetHTTPProxyGVR()
+	assert.Equal(t, "projectcontour.io", gvr.Group)
+	assert.Equal(t, "v1", gvr.Version)
+	assert.Equal(t, "httpproxies", gvr.Resource)
+}
+
+func TestType(t *testing.T) {
+	r, _ := newReconciler(newRollout())
+	assert.Equal(t, Type, r.Type())
+}
+
+func TestUpdateHash(t *testing.T) {
+	r, fake := newReconciler(newRollout())
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+	assert.Empty(t, fake.Actions())
+}
+
+func TestVerifyWeight(t *testing.T) {
+	newHTTPProxy := func(status string, observedGeneration int64) *unstructured.Unstructured {
+		obj := unstructuredutil.StrToUnstructuredUnsafe(httpProxy)
+		obj.SetGeneration(2)
+		unstructured.SetNestedSlice(obj.Object, []any{map[string]any{
+			"type":               "Valid",
+			"status":             status,
+			"observedGeneration": observedGeneration,
+		}}, "status", "conditions")
+		return obj
+	}
+	t.Run("Valid", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), newHTTPProxy("True", 2))
+		verified, err := r.VerifyWeight(10)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+	t.Run("PreviousGeneration", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), newHTTPProxy("True", 1))
+		verified, err := r.VerifyWeight(10)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), newHTTPProxy("False", 2))
+		verified, err := r.VerifyWeight(10)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("NoStatus", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpProxy))
+		verified, err := r.VerifyWeight(10)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("MissingHTTPProxy", func(t *testing.T) {
+		r, _ := newReconciler(newRollout())
+		_, err := r.VerifyWeight(10)
+		assert.Error(t, err)
+	})
+}
+
+func TestSetWeight(t *testing.T) {
+	t.Run("SetWeight", func(t *testing.T) {
+		r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpProxy))
+		assert.NoError(t, r.SetWeight(30))
+		_, routes := getRoutes(t, r)
+		assert.Equal(t, map[string]any{"stable-service": int64(70), "canary-service": int64(30)}, serviceWeights(t, routes[0]))
+		assert.True(t, fake.Actions()[1].Matches("update", "httpproxies"))
+	})
+	t.Run("SetWeightUnchanged", func(t *testing.T) {
+		r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpProxy))
+		assert.NoError(t, r.SetWeight(0))
+		assert.Len(t, fake.Actions(), 1)
+		assert.True(t, fake.Actions()[0].Matches("get", "httpproxies"))
+	})
+	t.Run("SetWeightAdditionalDestinations", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpProxy))
+		assert.NoError(t, r.SetWeight(20, v1alpha1.WeightDestination{ServiceName: "ex-service", Weight: 10}))
+		_, routes := getRoutes(t, r)
+		assert.Equal(t, map[string]any{"stable-service": int64(70), "canary-service": int64(20), "ex-service": int64(10)}, serviceWeights(t, routes[0]))
+		services, _, _ := unstructured.NestedSlice(routes[0].(map[string]any), "services")
+		assert.Equal(t, map[string]any{"name": "ex-service", "port": float64(80), "weight": int64(10)}, services[2])
+
+		// the services of the experiment are removed at the end of the experiment
+		assert.NoError(t, r.SetWeight(20))
+		_, routes = getRoutes(t, r)
+		assert.Equal(t, map[string]any{"stable-service": int64(80), "canary-service": int64(20)}, serviceWeights(t, routes[0]))
+	})
+	t.Run("SetWeightMissingHTTPProxy", func(t *testing.T) {
+		r, _ := newReconciler(newRollout())
+		assert.Error(t, r.SetWeight(30))
+	})
+	t.Run("SetWeightMissingRoutes", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpProxyWithoutRoutes))
+		assert.EqualError(t, r.SetWeight(30), "spec.routes was not found in Contour HTTPProxy manifest")
+	})
+	t.Run("SetWeightMissingCanaryService", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpProxyWithoutCanary))
+		assert.EqualError(t, r.SetWeight(30), `HTTPProxy "proxy" has no route with services "stable-service" and "canary-service"`)
+	})
+	t.Run("SetWeightUpdateError", func(t *testing.T) {
+		r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpProxy))
+		fake.PrependReactor("update", "httpproxies", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("update failed")
+		})
+		assert.EqualError(t, r.SetWeight(30), "update failed")
+	})
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	ro := newRollout("header-route")
+	r, _ := newReconciler(ro, unstructuredutil.StrToUnstructuredUnsafe(httpProxy))
+	headerRoute := &v1alpha1.SetHeaderRoute{
+		Name: "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "firefox"}},
+			{HeaderName: "version", HeaderValue: &v1alpha1.StringMatch{Prefix: "v1."}},
+		},
+	}
+	assert.NoError(t, r.SetHeaderRoute(headerRoute))
+	obj, routes := getRoutes(t, r)
+	assert.Len(t, routes, 2)
+	assert.Equal(t, []string{"header-route"}, trafficrouting.GetManagedRouteNames(obj))
+	assert.Equal(t, map[string]any{"canary-service": int64(100)}, serviceWeights(t, routes[0]))
+	conditions, _, _ := unstructured.NestedSlice(routes[0].(map[string]any), "conditions")
+	assert.Equal(t, []any{
+		map[string]any{"prefix": "/"},
+		map[string]any{"header": map[string]any{"name": "agent", "exact": "firefox"}},
+		map[string]any{"header": map[string]any{"name": "version", "regex": `v1\..*`}},
+	}, conditions)
+
+	// weight changes leave the header route untouched
+	assert.NoError(t, r.SetWeight(20))
+	_, routes = getRoutes(t, r)
+	assert.Equal(t, map[string]any{"canary-service": int64(100)}, serviceWeights(t, routes[0]))
+	assert.Equal(t, map[string]any{"stable-service": int64(80), "canary-service": int64(20)}, serviceWeights(t, routes[1]))
+
+	// a header route without matches removes the route
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header-route"}))
+	obj, routes = getRoutes(t, r)
+	assert.Len(t, routes, 1)
+	assert.Nil(t, trafficrouting.GetManagedRouteNames(obj))
+}
+
+func TestSetMirrorRoute(t *testing.T) {
+	ro := newRollout("header-route", "mirror-route")
+	r, _ := newReconciler(ro, unstructuredutil.StrToUnstructuredUnsafe(httpProxy))
+	percentage := int32(50)
+	mirrorRoute := &v1alpha1.SetMirrorRoute{
+		Name:       "mirror-route",
+		Percentage: &percentage,
+		Match: []v1alpha1.RouteMatch{
+			{
+				Path: &v1alpha1.StringMatch{Prefix: "/api"},
+				Headers: map[string]v1alpha1.StringMatch{
+					"b-header": {Regex: "b.*"},
+					"a-header": {Exact: "a"},
+				},
+			},
+			{Path: &v1alpha1.StringMatch{Exact: "/health"}},
+		},
+	}
+	assert.NoError(t, r.SetMirrorRoute(mirrorRoute))
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Regex: "fire.*"}}},
+	}))
+	obj, routes := getRoutes(t, r)
+	assert.Len(t, routes, 4)
+	assert.Equal(t, []string{"header-route", "mirror-route", "mirror-route"}, trafficrouting.GetManagedRouteNames(obj))
+
+	assert.Equal(t, map[string]any{"stable-service": int64(100), "canary-service": int64(0), "canary-service-mirror": int64(50)}, serviceWeights(t, routes[1]))
+	conditions, _, _ := unstructured.NestedSlice(routes[1].(map[string]any), "conditions")
+	assert.Equal(t, []any{
+		map[string]any{"prefix": "/api"},
+		map[string]any{"header": map[string]any{"name": "a-header", "exact": "a"}},
+		map[string]any{"header": map[string]any{"name": "b-header", "regex": "b.*"}},
+	}, conditions)
+	conditions, _, _ = unstructured.NestedSlice(routes[2].(map[string]any), "conditions")
+	assert.Equal(t, []any{map[string]any{"exact": "/health"}}, conditions)
+
+	// weight changes are applied to the mirror routes
+	assert.NoError(t, r.SetWeight(10))
+	_, routes = getRoutes(t, r)
+	assert.Equal(t, map[string]any{"stable-service": int64(90), "canary-service": int64(10), "canary-service-mirror": int64(50)}, serviceWeights(t, routes[1]))
+
+	assert.NoError(t, r.RemoveManagedRoutes())
+	obj, routes = getRoutes(t, r)
+	assert.Len(t, routes, 1)
+	assert.Nil(t, trafficrouting.GetManagedRouteNames(obj))
+	assert.Equal(t, map[string]any{"stable-service": int64(90), "canary-service": int64(10)}, serviceWeights(t, routes[0]))
+}
+
+func TestSetManagedRouteWithoutBaseRoute(t *testing.T) {
+	r, _ := newReconciler(newRollout("header-route"), unstructuredutil.StrToUnstructuredUnsafe(httpProxyWithoutCanary))
+	err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "firefox"}}},
+	})
+	assert.EqualError(t, err, `HTTPProxy "proxy" has no route with services "stable-service" and "canary-service"`)
+}
+
+func TestRemoveManagedRoutesWithoutManagedRoutes(t *testing.T) {
+	r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpProxy))
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Empty(t, fake.Actions())
+}
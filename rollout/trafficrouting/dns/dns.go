@@ -0,0 +1,313 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/apply"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	"github.com/argoproj/argo-rollouts/utils/weightutil"
+)
+
+// Type holds this controller type
+const Type = "DNS"
+
+// WeightUpdatedAtAnnotation records on the DNSEndpoint when the weights were last changed by the controller. The
+// weights are only verified once the TTL of the records has elapsed since then.
+const WeightUpdatedAtAnnotation = "rollouts.argoproj.io/weight-updated-at"
+
+const (
+	dnsEndpoints           = "dnsendpoints"
+	dnsEndpointUpdateError = "DNSEndpointUpdateError"
+	failedToTypeAssert     = "Failed type assertion for DNSEndpoint %s"
+)
+
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   ClientInterface
+	Recorder record.EventRecorder
+}
+
+type Reconciler struct {
+	Rollout  *v1alpha1.Rollout
+	Client   ClientInterface
+	Recorder record.EventRecorder
+}
+
+type ClientInterface interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error)
+	Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error)
+}
+
+func NewReconciler(cfg *ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		Rollout:  cfg.Rollout,
+		Client:   cfg.Client,
+		Recorder: cfg.Recorder,
+	}
+}
+
+func NewDynamicClient(di dynamic.Interface, namespace string) dynamic.ResourceInterface {
+	return di.Resource(GetDNSEndpointGVR()).Namespace(namespace)
+}
+
+func GetDNSEndpointGVR() schema.GroupVersionResource {
+	parts := strings.Split(defaults.DefaultExternalDNSVersion, "/")
+	return schema.GroupVersionResource{
+		Group:    defaults.DefaultExternalDNSAPIGroup,
+		Version:  parts[len(parts)-1],
+		Resource: dnsEndpoints,
+	}
+}
+
+func (r *Reconciler) sendWarningEvent(id, msg string) {
+	r.Recorder.Eventf(r.Rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: id}, msg)
+}
+
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+// SetWeight sets the weight of the stable and canary records of the DNSEndpoint and records when the weights changed
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	ctx := context.TODO()
+	dnsEndpoint, err := r.Client.Get(ctx, r.config().DNSEndpoint, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	endpoints, err := getEndpoints(dnsEndpoint)
+	if err != nil {
+		return err
+	}
+	stableEndpoints, canaryEndpoints := r.weightedEndpoints(endpoints)
+	if len(stableEndpoints) == 0 || len(canaryEndpoints) == 0 {
+		return r.missingRecordsError(dnsEndpoint)
+	}
+
+	weightProperty := r.weightProperty()
+	modified := false
+	for _, endpoint := range stableEndpoints {
+		modified = setWeight(endpoint, weightProperty, weightutil.MaxTrafficWeight(r.Rollout)-desiredWeight) || modified
+	}
+	for _, endpoint := range canaryEndpoints {
+		modified = setWeight(endpoint, weightProperty, desiredWeight) || modified
+	}
+	if !modified {
+		return nil
+	}
+
+	newDNSEndpoint := dnsEndpoint.DeepCopy()
+	if err := unstructured.SetNestedSlice(newDNSEndpoint.Object, endpoints, "spec", "endpoints"); err != nil {
+		return err
+	}
+	annotations := newDNSEndpoint.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[WeightUpdatedAtAnnotation] = timeutil.Now().UTC().Format(time.RFC3339)
+	newDNSEndpoint.SetAnnotations(annotations)
+	_, err = apply.Update(ctx, r.Client, newDNSEndpoint, []string{"spec", "endpoints"}, []string{"metadata", "annotations", WeightUpdatedAtAnnotation})
+	if err != nil {
+		msg := fmt.Sprintf("Error updating DNSEndpoint %q: %s", newDNSEndpoint.GetName(), err)
+		r.sendWarningEvent(dnsEndpointUpdateError, msg)
+		return err
+	}
+	return nil
+}
+
+// SetHeaderRoute is not supported since DNS records can not match requests
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	return nil
+}
+
+// SetMirrorRoute is not supported since DNS records can not mirror requests
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	return nil
+}
+
+// VerifyWeight returns true once external-dns synced the current generation of the DNSEndpoint and the TTL of the
+// records has elapsed since the weights were changed
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	dnsEndpoint, err := r.Client.Get(context.TODO(), r.config().DNSEndpoint, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	endpoints, err := getEndpoints(dnsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	stableEndpoints, canaryEndpoints := r.weightedEndpoints(endpoints)
+	if len(stableEndpoints) == 0 || len(canaryEndpoints) == 0 {
+		return nil, r.missingRecordsError(dnsEndpoint)
+	}
+
+	verified := false
+	weightProperty := r.weightProperty()
+	for _, endpoint := range stableEndpoints {
+		if getWeight(endpoint, weightProperty) != weightutil.MaxTrafficWeight(r.Rollout)-desiredWeight {
+			return &verified, nil
+		}
+	}
+	for _, endpoint := range canaryEndpoints {
+		if getWeight(endpoint, weightProperty) != desiredWeight {
+			return &verified, nil
+		}
+	}
+
+	rawObservedGeneration, _, _ := unstructured.NestedFieldNoCopy(dnsEndpoint.Object, "status", "observedGeneration")
+	if observedGeneration, _ := toInt64(rawObservedGeneration); observedGeneration < dnsEndpoint.GetGeneration() {
+		return &verified, nil
+	}
+
+	if updatedAt, ok := dnsEndpoint.GetAnnotations()[WeightUpdatedAtAnnotation]; ok {
+		updatedAtTime, err := time.Parse(time.RFC3339, updatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation on DNSEndpoint %q: %w", WeightUpdatedAtAnnotation, dnsEndpoint.GetName(), err)
+		}
+		ttl := time.Duration(r.ttlSeconds(append(stableEndpoints, canaryEndpoints...))) * time.Second
+		if timeutil.Now().Before(updatedAtTime.Add(ttl)) {
+			return &verified, nil
+		}
+	}
+	verified = true
+	return &verified, nil
+}
+
+// RemoveManagedRoutes is a no-op since the DNS router does not support managed routes
+func (r *Reconciler) RemoveManagedRoutes() error {
+	return nil
+}
+
+func (r *Reconciler) Type() string {
+	return Type
+}
+
+func (r *Reconciler) config() *v1alpha1.DNSTrafficRouting {
+	return r.Rollout.Spec.Strategy.Canary.TrafficRouting.DNS
+}
+
+func (r *Reconciler) weightProperty() string {
+	return defaults.GetStringOrDefault(r.config().WeightProperty, defaults.DefaultDNSWeightProperty)
+}
+
+// ttlSeconds returns the configured TTL or the highest TTL of the weighted records
+func (r *Reconciler) ttlSeconds(endpoints []map[string]any) int64 {
+	if r.config().TTLSeconds != nil {
+		return int64(*r.config().TTLSeconds)
+	}
+	ttl := int64(0)
+	for _, endpoint := range endpoints {
+		recordTTL, ok := toInt64(endpoint["recordTTL"])
+		if !ok || recordTTL == 0 {
+			recordTTL = defaults.DefaultDNSRecordTTLSeconds
+		}
+		if recordTTL > ttl {
+			ttl = recordTTL
+		}
+	}
+	return ttl
+}
+
+// weightedEndpoints returns the records of the stable and canary set identifiers
+func (r *Reconciler) weightedEndpoints(endpoints []any) ([]map[string]any, []map[string]any) {
+	var stableEndpoints, canaryEndpoints []map[string]any
+	for _, endpoint := range endpoints {
+		typedEndpoint := endpoint.(map[string]any)
+		setIdentifier, _, _ := unstructured.NestedString(typedEndpoint, "setIdentifier")
+		switch setIdentifier {
+		case r.config().StableSetIdentifier:
+			stableEndpoints = append(stableEndpoints, typedEndpoint)
+		case r.config().CanarySetIdentifier:
+			canaryEndpoints = append(canaryEndpoints, typedEndpoint)
+		}
+	}
+	return stableEndpoints, canaryEndpoints
+}
+
+func (r *Reconciler) missingRecordsError(dnsEndpoint *unstructured.Unstructured) error {
+	return fmt.Errorf("DNSEndpoint %q has no records with set identifiers %q and %q", dnsEndpoint.GetName(), r.config().StableSetIdentifier, r.config().CanarySetIdentifier)
+}
+
+func getEndpoints(dnsEndpoint *unstructured.Unstructured) ([]any, error) {
+	endpoints, isFound, err := unstructured.NestedSlice(dnsEndpoint.Object, "spec", "endpoints")
+	if err != nil {
+		return nil, err
+	}
+	if !isFound {
+		return nil, fmt.Errorf("spec.endpoints was not found in DNSEndpoint %q", dnsEndpoint.GetName())
+	}
+	for _, endpoint := range endpoints {
+		if _, ok := endpoint.(map[string]any); !ok {
+			return nil, fmt.Errorf(failedToTypeAssert, "endpoint")
+		}
+	}
+	return endpoints, nil
+}
+
+// getWeight returns the weight held by the provider specific property of the record, or -1 if it is not set
+func getWeight(endpoint map[string]any, weightProperty string) int32 {
+	properties, _, _ := unstructured.NestedSlice(endpoint, "providerSpecific")
+	for _, property := range properties {
+		typedProperty, ok := property.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(typedProperty, "name"); name != weightProperty {
+			continue
+		}
+		value, _, _ := unstructured.NestedString(typedProperty, "value")
+		weight, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return -1
+		}
+		return int32(weight)
+	}
+	return -1
+}
+
+// setWeight sets the provider specific weight property of the record and returns whether it was changed
+func setWeight(endpoint map[string]any, weightProperty string, weight int32) bool {
+	if getWeight(endpoint, weightProperty) == weight {
+		return false
+	}
+	value := strconv.FormatInt(int64(weight), 10)
+	properties, _, _ := unstructured.NestedSlice(endpoint, "providerSpecific")
+	found := false
+	for _, property := range properties {
+		typedProperty, ok := property.(map[string]any)
+		if !ok {
+			continue
+		}
+		if name, _, _ := unstructured.NestedString(typedProperty, "name"); name == weightProperty {
+			typedProperty["value"] = value
+			found = true
+		}
+	}
+	if !found {
+		properties = append(properties, map[string]any{"name": weightProperty, "value": value})
+	}
+	endpoint["providerSpecific"] = properties
+	return true
+}
+
+func toInt64(value any) (int64, bool) {
+	switch typedValue := value.(type) {
+	case int64:
+		return typedValue, true
+	case float64:
+		return int64(typedValue), true
+	}
+	return 0, false
+}
@@ -0,0 +1,257 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const dnsEndpoint = `
+apiVersion: externaldns.k8s.io/v1alpha1
+kind: DNSEndpoint
+metadata:
+  name: records
+  namespace: default
+  generation: 1
+spec:
+  endpoints:
+  - dnsName: app.example.com
+    recordType: CNAME
+    recordTTL: 60
+    setIdentifier: stable
+    targets:
+    - stable.elb.amazonaws.com
+    providerSpecific:
+    - name: aws/weight
+      value: "100"
+  - dnsName: app.example.com
+    recordType: CNAME
+    recordTTL: 30
+    setIdentifier: canary
+    targets:
+    - canary.elb.amazonaws.com
+  - dnsName: other.example.com
+    recordType: A
+    targets:
+    - 10.0.0.1
+status:
+  observedGeneration: 1
+`
+
+func newRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "stable-service",
+					CanaryService: "canary-service",
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						DNS: &v1alpha1.DNSTrafficRouting{
+							DNSEndpoint:         "records",
+							StableSetIdentifier: "stable",
+							CanarySetIdentifier: "canary",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newReconciler(ro *v1alpha1.Rollout, objs ...runtime.Object) (*Reconciler, *k8stesting.Fake) {
+	client := testutil.NewFakeDynamicClient(objs...)
+	r := NewReconciler(&ReconcilerConfig{
+		Rollout:  ro,
+		Client:   NewDynamicClient(client, ro.Namespace),
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	return r, &client.Fake
+}
+
+func getDNSEndpoint(t *testing.T, r *Reconciler) *unstructured.Unstructured {
+	t.Helper()
+	obj, err := r.Client.Get(context.TODO(), "records", metav1.GetOptions{})
+	assert.NoError(t, err)
+	return obj
+}
+
+func weights(t *testing.T, obj *unstructured.Unstructured) map[string]int32 {
+	t.Helper()
+	result := map[string]int32{}
+	endpoints, _, _ := unstructured.NestedSlice(obj.Object, "spec", "endpoints")
+	for _, endpoint := range endpoints {
+		typedEndpoint := endpoint.(map[string]any)
+		name, _, _ := unstructured.NestedString(typedEndpoint, "setIdentifier")
+		result[name] = getWeight(typedEndpoint, "aws/weight")
+	}
+	return result
+}
+
+func setNow(t *testing.T, now time.Time) {
+	timeutil.SetNowTimeFunc(func() time.Time { return now })
+	t.Cleanup(func() { timeutil.SetNowTimeFunc(time.Now) })
+}
+
+func TestGetDNSEndpointGVR(t *testing.T) {
+	gvr := GetDNSEndpointGVR()
+	assert.Equal(t, "externaldns.k8s.io", gvr.Group)
+	assert.Equal(t, "v1alpha1", gvr.Version)
+	assert.Equal(t, "dnsendpoints", gvr.Resource)
+}
+
+func TestUnsupportedOperations(t *testing.T) {
+	r, fake := newReconciler(newRollout())
+	assert.Equal(t, Type, r.Type())
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header"}))
+	assert.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{Name: "mirror"}))
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Empty(t, fake.Actions())
+}
+
+func TestSetWeight(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	setNow(t, now)
+
+	t.Run("SetWeight", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(dnsEndpoint))
+		assert.NoError(t, r.SetWeight(20))
+		obj := getDNSEndpoint(t, r)
+		assert.Equal(t, map[string]int32{"stable": 80, "canary": 20, "": -1}, weights(t, obj))
+		assert.Equal(t, "2024-01-01T00:00:00Z", obj.GetAnnotations()[WeightUpdatedAtAnnotation])
+	})
+	t.Run("SetWeightUnchanged", func(t *testing.T) {
+		r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(dnsEndpoint))
+		assert.NoError(t, r.SetWeight(10))
+		assert.Len(t, fake.Actions(), 2)
+		assert.NoError(t, r.SetWeight(10))
+		assert.Len(t, fake.Actions(), 3)
+		assert.True(t, fake.Actions()[2].Matches("get", "dnsendpoints"))
+	})
+	t.Run("SetWeightMaxTrafficWeight", func(t *testing.T) {
+		ro := newRollout()
+		ro.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight = pointer.Int32(255)
+		ro.Spec.Strategy.Canary.TrafficRouting.DNS.WeightProperty = "weight"
+		r, _ := newReconciler(ro, unstructuredutil.StrToUnstructuredUnsafe(dnsEndpoint))
+		assert.NoError(t, r.SetWeight(55))
+		endpoints, _, _ := unstructured.NestedSlice(getDNSEndpoint(t, r).Object, "spec", "endpoints")
+		assert.Equal(t, int32(200), getWeight(endpoints[0].(map[string]any), "weight"))
+		assert.Equal(t, int32(55), getWeight(endpoints[1].(map[string]any), "weight"))
+		// the aws/weight property is left untouched
+		assert.Equal(t, int32(100), getWeight(endpoints[0].(map[string]any), "aws/weight"))
+	})
+	t.Run("SetWeightMissingRecords", func(t *testing.T) {
+		ro := newRollout()
+		ro.Spec.Strategy.Canary.TrafficRouting.DNS.CanarySetIdentifier = "missing"
+		r, _ := newReconciler(ro, unstructuredutil.StrToUnstructuredUnsafe(dnsEndpoint))
+		assert.EqualError(t, r.SetWeight(20), `DNSEndpoint "records" has no records with set identifiers "stable" and "missing"`)
+	})
+	t.Run("SetWeightMissingDNSEndpoint", func(t *testing.T) {
+		r, _ := newReconciler(newRollout())
+		assert.Error(t, r.SetWeight(20))
+	})
+	t.Run("SetWeightUpdateError", func(t *testing.T) {
+		r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(dnsEndpoint))
+		fake.PrependReactor("update", "dnsendpoints", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("update failed")
+		})
+		assert.EqualError(t, r.SetWeight(20), "update failed")
+	})
+}
+
+func TestVerifyWeight(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newDNSEndpoint := func(generation int64, annotations map[string]string) *unstructured.Unstructured {
+		obj := unstructuredutil.StrToUnstructuredUnsafe(dnsEndpoint)
+		obj.SetGeneration(generation)
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+	updatedAtAnnotation := map[string]string{WeightUpdatedAtAnnotation: updatedAt.Format(time.RFC3339)}
+
+	t.Run("weights not applied", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), newDNSEndpoint(1, nil))
+		verified, err := r.VerifyWeight(20)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("not synced by external-dns", func(t *testing.T) {
+		setNow(t, updatedAt.Add(time.Hour))
+		r, _ := newReconciler(newRollout(), newDNSEndpoint(1, nil))
+		assert.NoError(t, r.SetWeight(20))
+		obj := getDNSEndpoint(t, r)
+		obj.SetGeneration(2)
+		_, err := r.Client.Update(context.TODO(), obj, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		verified, err := r.VerifyWeight(20)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+	})
+	t.Run("waits for the highest record TTL", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), newDNSEndpoint(1, updatedAtAnnotation))
+		setNow(t, updatedAt)
+		assert.NoError(t, r.SetWeight(20))
+
+		setNow(t, updatedAt.Add(59*time.Second))
+		verified, err := r.VerifyWeight(20)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+
+		setNow(t, updatedAt.Add(60*time.Second))
+		verified, err = r.VerifyWeight(20)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+	t.Run("waits for the configured TTL", func(t *testing.T) {
+		ro := newRollout()
+		ro.Spec.Strategy.Canary.TrafficRouting.DNS.TTLSeconds = pointer.Int32(120)
+		r, _ := newReconciler(ro, newDNSEndpoint(1, nil))
+		setNow(t, updatedAt)
+		assert.NoError(t, r.SetWeight(20))
+
+		setNow(t, updatedAt.Add(119*time.Second))
+		verified, err := r.VerifyWeight(20)
+		assert.NoError(t, err)
+		assert.False(t, *verified)
+
+		setNow(t, updatedAt.Add(120*time.Second))
+		verified, err = r.VerifyWeight(20)
+		assert.NoError(t, err)
+		assert.True(t, *verified)
+	})
+	t.Run("invalid annotation", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), newDNSEndpoint(1, nil))
+		assert.NoError(t, r.SetWeight(20))
+		obj := getDNSEndpoint(t, r)
+		obj.SetAnnotations(map[string]string{WeightUpdatedAtAnnotation: "yesterday"})
+		_, err := r.Client.Update(context.TODO(), obj, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		verified, err := r.VerifyWeight(20)
+		assert.Error(t, err)
+		assert.Nil(t, verified)
+	})
+}
+
+func TestTTLSecondsDefault(t *testing.T) {
+	r, _ := newReconciler(newRollout())
+	assert.Equal(t, int64(300), r.ttlSeconds([]map[string]any{{"recordTTL": int64(60)}, {}}))
+	assert.Equal(t, int64(60), r.ttlSeconds([]map[string]any{{"recordTTL": int64(60)}, {"recordTTL": float64(30)}}))
+}
@@ -0,0 +1,489 @@
+// Package httproute implements the traffic routing operations for routers which split traffic through the weighted
+// backendRefs of HTTPRoutes following the Gateway API schema (e.g. Kong's gateway.networking.k8s.io HTTPRoutes
+// and Linkerd's policy.linkerd.io HTTPRoutes)
+package httproute
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	"github.com/argoproj/argo-rollouts/utils/apply"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+const (
+	failedToTypeAssert = "Failed type assertion for HTTPRoute %s"
+	httpRouteUpdateErr = "%sHTTPRouteUpdateError"
+	acceptedCondition  = "Accepted"
+)
+
+type ClientInterface interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions, subresources ...string) (*unstructured.Unstructured, error)
+	Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error)
+}
+
+type ReconcilerConfig struct {
+	Rollout    *v1alpha1.Rollout
+	Client     ClientInterface
+	Recorder   record.EventRecorder
+	HTTPRoutes []string
+	// Provider is the name of the traffic router used in events and error messages
+	Provider string
+}
+
+// Reconciler manages the weights and the managed routes of the HTTPRoutes. The managed routes are kept as rules at
+// the head of spec.rules and are tracked with the trafficrouting.ManagedRoutesAnnotation.
+type Reconciler struct {
+	Rollout    *v1alpha1.Rollout
+	Client     ClientInterface
+	Recorder   record.EventRecorder
+	HTTPRoutes []string
+	Provider   string
+}
+
+func NewReconciler(cfg *ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		Rollout:    cfg.Rollout,
+		Client:     cfg.Client,
+		Recorder:   cfg.Recorder,
+		HTTPRoutes: cfg.HTTPRoutes,
+		Provider:   cfg.Provider,
+	}
+}
+
+func (r *Reconciler) sendWarningEvent(id, msg string) {
+	r.Recorder.Eventf(r.Rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: id}, msg)
+}
+
+// SetWeight sets the weight of the canary and stable backendRefs on every rule of the HTTPRoutes that references
+// both of them. Like the destinations of the Istio routes, the other backendRefs of these rules are replaced by the
+// backendRefs of the additional destinations, i.e. the services of the experiments.
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	canaryService := r.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.Rollout.Spec.Strategy.Canary.StableService
+	stableWeight := 100 - desiredWeight
+	for _, dest := range additionalDestinations {
+		stableWeight -= dest.Weight
+	}
+	return r.updateHTTPRoutes(func(httpRoute *unstructured.Unstructured, rules []any) ([]any, error) {
+		found := false
+		for _, rule := range rules {
+			typedRule, ok := rule.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf(failedToTypeAssert, "rule")
+			}
+			backendRefs, err := getBackendRefs(typedRule)
+			if err != nil {
+				return nil, err
+			}
+			canary, stable := findBackendRef(backendRefs, canaryService), findBackendRef(backendRefs, stableService)
+			if canary == nil || stable == nil {
+				continue
+			}
+			found = true
+			setBackendRefWeight(canary, int64(desiredWeight))
+			setBackendRefWeight(stable, int64(stableWeight))
+			typedRule["backendRefs"] = destinationBackendRefs(backendRefs, canary, []string{canaryService, stableService}, additionalDestinations)
+		}
+		if !found {
+			return nil, r.noBaseRuleError(httpRoute)
+		}
+		return rules, nil
+	})
+}
+
+// SetHeaderRoute adds a rule in front of the user defined rules which sends all requests with the matching headers
+// to the canary service
+func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) error {
+	return r.updateManagedRoute(headerRouting.Name, func(baseRule map[string]any) ([]any, error) {
+		if headerRouting.Match == nil {
+			return nil, nil
+		}
+		canary := findBackendRef(mustGetBackendRefs(baseRule), r.Rollout.Spec.Strategy.Canary.CanaryService)
+		canary = runtime.DeepCopyJSONValue(canary).(map[string]any)
+		canary["weight"] = int64(100)
+
+		headers := []any{}
+		for _, match := range headerRouting.Match {
+			headers = append(headers, headerMatch(match.HeaderName, match.HeaderValue))
+		}
+		matches := []any{}
+		for _, match := range getMatches(baseRule) {
+			match := runtime.DeepCopyJSONValue(match).(map[string]any)
+			baseHeaders, _, _ := unstructured.NestedSlice(match, "headers")
+			match["headers"] = append(baseHeaders, runtime.DeepCopyJSONValue(headers).([]any)...)
+			matches = append(matches, match)
+		}
+		rule := runtime.DeepCopyJSONValue(baseRule).(map[string]any)
+		rule["matches"] = matches
+		rule["backendRefs"] = []any{canary}
+		return []any{rule}, nil
+	})
+}
+
+// SetMirrorRoute adds a rule in front of the user defined rules which mirrors the matching requests to the canary
+// service with a RequestMirror filter
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	return r.updateManagedRoute(setMirrorRoute.Name, func(baseRule map[string]any) ([]any, error) {
+		if setMirrorRoute.Match == nil {
+			return nil, nil
+		}
+		canary := findBackendRef(mustGetBackendRefs(baseRule), r.Rollout.Spec.Strategy.Canary.CanaryService)
+		mirrorBackendRef := map[string]any{}
+		for _, key := range []string{"group", "kind", "name", "namespace", "port"} {
+			if value, ok := canary[key]; ok {
+				mirrorBackendRef[key] = runtime.DeepCopyJSONValue(value)
+			}
+		}
+		requestMirror := map[string]any{"backendRef": mirrorBackendRef}
+		if setMirrorRoute.Percentage != nil {
+			requestMirror["percent"] = int64(*setMirrorRoute.Percentage)
+		}
+
+		matches := []any{}
+		for _, match := range setMirrorRoute.Match {
+			matches = append(matches, routeMatch(match))
+		}
+		rule := runtime.DeepCopyJSONValue(baseRule).(map[string]any)
+		filters, _, _ := unstructured.NestedSlice(rule, "filters")
+		rule["filters"] = append(filters, map[string]any{
+			"type":          "RequestMirror",
+			"requestMirror": requestMirror,
+		})
+		rule["matches"] = matches
+		return []any{rule}, nil
+	})
+}
+
+// VerifyAccepted returns true once every parent of the HTTPRoutes reports an Accepted condition for the current
+// generation of the HTTPRoute, i.e. once the weights were accepted by the data plane
+func (r *Reconciler) VerifyAccepted() (*bool, error) {
+	return r.VerifyConditions("", acceptedCondition)
+}
+
+// VerifyConditions returns true once the parents of the HTTPRoutes managed by the given controller, or every parent
+// when controllerName is empty, report the conditions with status True for the current generation of the HTTPRoute
+func (r *Reconciler) VerifyConditions(controllerName string, conditionTypes ...string) (*bool, error) {
+	ctx := context.TODO()
+	verified := true
+	for _, name := range r.HTTPRoutes {
+		httpRoute, err := r.Client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if !hasConditions(httpRoute, controllerName, conditionTypes) {
+			verified = false
+		}
+	}
+	return &verified, nil
+}
+
+// RemoveManagedRoutes removes every rule recorded in the managed routes annotation of the HTTPRoutes
+func (r *Reconciler) RemoveManagedRoutes() error {
+	if len(r.Rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes) == 0 {
+		return nil
+	}
+	return r.updateHTTPRoutes(func(httpRoute *unstructured.Unstructured, rules []any) ([]any, error) {
+		names := trafficrouting.GetManagedRouteNames(httpRoute)
+		trafficrouting.SetManagedRouteNames(httpRoute, nil)
+		return trafficrouting.RemoveAllManagedRoutes(rules, names), nil
+	})
+}
+
+// updateManagedRoute regenerates the managed rules recorded under name from the first user defined rule which
+// references both the stable and canary services
+func (r *Reconciler) updateManagedRoute(name string, generate func(baseRule map[string]any) ([]any, error)) error {
+	canaryService := r.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.Rollout.Spec.Strategy.Canary.StableService
+	return r.updateHTTPRoutes(func(httpRoute *unstructured.Unstructured, rules []any) ([]any, error) {
+		names := trafficrouting.GetManagedRouteNames(httpRoute)
+		var baseRule map[string]any
+		for _, rule := range trafficrouting.RemoveAllManagedRoutes(rules, names) {
+			typedRule, ok := rule.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf(failedToTypeAssert, "rule")
+			}
+			backendRefs, err := getBackendRefs(typedRule)
+			if err != nil {
+				return nil, err
+			}
+			if findBackendRef(backendRefs, canaryService) != nil && findBackendRef(backendRefs, stableService) != nil {
+				baseRule = typedRule
+				break
+			}
+		}
+		if baseRule == nil {
+			return nil, r.noBaseRuleError(httpRoute)
+		}
+		newRules, err := generate(baseRule)
+		if err != nil {
+			return nil, err
+		}
+		rules, names = trafficrouting.ReplaceManagedRoutes(rules, names, name, newRules, r.Rollout.Spec.Strategy.Canary.TrafficRouting.ManagedRoutes)
+		trafficrouting.SetManagedRouteNames(httpRoute, names)
+		return rules, nil
+	})
+}
+
+// updateHTTPRoutes applies the mutation to spec.rules of every HTTPRoute and only updates the HTTPRoutes which were
+// changed
+func (r *Reconciler) updateHTTPRoutes(mutate func(httpRoute *unstructured.Unstructured, rules []any) ([]any, error)) error {
+	ctx := context.TODO()
+	for _, name := range r.HTTPRoutes {
+		httpRoute, err := r.Client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		rules, isFound, err := unstructured.NestedSlice(httpRoute.Object, "spec", "rules")
+		if err != nil {
+			return err
+		}
+		if !isFound {
+			return errors.New("spec.rules was not found in HTTPRoute manifest")
+		}
+		newHTTPRoute := httpRoute.DeepCopy()
+		newRules, err := mutate(newHTTPRoute, rules)
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedSlice(newHTTPRoute.Object, newRules, "spec", "rules"); err != nil {
+			return err
+		}
+		if reflect.DeepEqual(httpRoute.Object, newHTTPRoute.Object) {
+			continue
+		}
+		_, err = apply.Update(ctx, r.Client, newHTTPRoute, []string{"spec", "rules"})
+		if err != nil {
+			msg := fmt.Sprintf("Error updating %s HTTPRoute %q: %s", r.Provider, newHTTPRoute.GetName(), err)
+			r.sendWarningEvent(fmt.Sprintf(httpRouteUpdateErr, r.Provider), msg)
+			return err
+		}
+	}
+	return nil
+}
+
+// hasConditions returns true if the parents in the HTTPRoute status which are managed by the controller have every
+// condition with status True, observed for the current generation
+func hasConditions(httpRoute *unstructured.Unstructured, controllerName string, conditionTypes []string) bool {
+	parents, _, _ := unstructured.NestedSlice(httpRoute.Object, "status", "parents")
+	found := false
+	for _, parent := range parents {
+		typedParent, ok := parent.(map[string]any)
+		if !ok {
+			return false
+		}
+		if parentController, _, _ := unstructured.NestedString(typedParent, "controllerName"); controllerName != "" && parentController != controllerName {
+			continue
+		}
+		found = true
+		conditions, _, _ := unstructured.NestedSlice(typedParent, "conditions")
+		for _, conditionType := range conditionTypes {
+			if !hasCondition(conditions, conditionType, httpRoute.GetGeneration()) {
+				return false
+			}
+		}
+	}
+	return found
+}
+
+// hasCondition returns true if the condition has status True and was observed for the generation
+func hasCondition(conditions []any, conditionType string, generation int64) bool {
+	for _, condition := range conditions {
+		typedCondition, ok := condition.(map[string]any)
+		if !ok {
+			continue
+		}
+		typ, _, _ := unstructured.NestedString(typedCondition, "type")
+		status, _, _ := unstructured.NestedString(typedCondition, "status")
+		if typ != conditionType || status != string(metav1.ConditionTrue) {
+			continue
+		}
+		observedGeneration, _, _ := unstructured.NestedFieldNoCopy(typedCondition, "observedGeneration")
+		return toInt64(observedGeneration) >= generation
+	}
+	return false
+}
+
+func toInt64(value any) int64 {
+	switch typedValue := value.(type) {
+	case int64:
+		return typedValue
+	case float64:
+		return int64(typedValue)
+	}
+	return 0
+}
+
+func (r *Reconciler) noBaseRuleError(httpRoute *unstructured.Unstructured) error {
+	canaryService := r.Rollout.Spec.Strategy.Canary.CanaryService
+	stableService := r.Rollout.Spec.Strategy.Canary.StableService
+	return fmt.Errorf("HTTPRoute %q has no rule with backendRefs %q and %q", httpRoute.GetName(), stableService, canaryService)
+}
+
+func getBackendRefs(rule map[string]any) ([]map[string]any, error) {
+	rawBackendRefs, ok := rule["backendRefs"]
+	if !ok {
+		return nil, nil
+	}
+	typedBackendRefs, ok := rawBackendRefs.([]any)
+	if !ok {
+		return nil, fmt.Errorf(failedToTypeAssert, "backendRefs")
+	}
+	backendRefs := make([]map[string]any, 0, len(typedBackendRefs))
+	for _, backendRef := range typedBackendRefs {
+		typedBackendRef, ok := backendRef.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(failedToTypeAssert, "backendRef")
+		}
+		backendRefs = append(backendRefs, typedBackendRef)
+	}
+	return backendRefs, nil
+}
+
+// mustGetBackendRefs returns the backendRefs of a rule which was already validated by getBackendRefs
+func mustGetBackendRefs(rule map[string]any) []map[string]any {
+	backendRefs, _ := getBackendRefs(rule)
+	return backendRefs
+}
+
+// findBackendRef returns the backendRef of the Service with the given name
+func findBackendRef(backendRefs []map[string]any, name string) map[string]any {
+	for _, backendRef := range backendRefs {
+		backendRefName, _, _ := unstructured.NestedString(backendRef, "name")
+		kind, _, _ := unstructured.NestedString(backendRef, "kind")
+		if backendRefName == name && (kind == "" || kind == "Service") {
+			return backendRef
+		}
+	}
+	return nil
+}
+
+// setBackendRefWeight sets the weight of the backendRef unless it is already set to the same value, which could have
+// been decoded as a float
+func setBackendRefWeight(backendRef map[string]any, weight int64) {
+	switch current := backendRef["weight"].(type) {
+	case int64:
+		if current == weight {
+			return
+		}
+	case float64:
+		if current == float64(weight) {
+			return
+		}
+	}
+	backendRef["weight"] = weight
+}
+
+// destinationBackendRefs returns the backendRefs of a weighted rule: the canary and stable backendRefs and a
+// backendRef for each additional destination, which is copied from the canary backendRef. The backendRefs keep their
+// order so that the rule is only updated when a weight changes.
+func destinationBackendRefs(backendRefs []map[string]any, canary map[string]any, services []string, additionalDestinations []v1alpha1.WeightDestination) []any {
+	weights := map[string]int32{}
+	for _, dest := range additionalDestinations {
+		weights[dest.ServiceName] = dest.Weight
+	}
+	newBackendRefs := []any{}
+	for _, backendRef := range backendRefs {
+		name, _, _ := unstructured.NestedString(backendRef, "name")
+		if weight, ok := weights[name]; ok {
+			setBackendRefWeight(backendRef, int64(weight))
+			delete(weights, name)
+		} else if !slices.Contains(services, name) {
+			continue
+		}
+		newBackendRefs = append(newBackendRefs, backendRef)
+	}
+	for _, dest := range additionalDestinations {
+		if _, ok := weights[dest.ServiceName]; !ok {
+			continue
+		}
+		backendRef := map[string]any{}
+		for _, key := range []string{"group", "kind", "namespace", "port"} {
+			if value, ok := canary[key]; ok {
+				backendRef[key] = runtime.DeepCopyJSONValue(value)
+			}
+		}
+		backendRef["name"] = dest.ServiceName
+		backendRef["weight"] = int64(dest.Weight)
+		newBackendRefs = append(newBackendRefs, backendRef)
+	}
+	return newBackendRefs
+}
+
+// getMatches returns the matches of the rule. A rule without matches matches every request, which is represented
+// by a single empty match.
+func getMatches(rule map[string]any) []any {
+	matches, _, _ := unstructured.NestedSlice(rule, "matches")
+	if len(matches) == 0 {
+		matches = []any{map[string]any{}}
+	}
+	return matches
+}
+
+// routeMatch converts a RouteMatch into an HTTPRoute match
+func routeMatch(match v1alpha1.RouteMatch) map[string]any {
+	httpRouteMatch := map[string]any{}
+	if match.Path != nil {
+		switch {
+		case match.Path.Exact != "":
+			httpRouteMatch["path"] = map[string]any{"type": "Exact", "value": match.Path.Exact}
+		case match.Path.Prefix != "":
+			httpRouteMatch["path"] = map[string]any{"type": "PathPrefix", "value": match.Path.Prefix}
+		case match.Path.Regex != "":
+			httpRouteMatch["path"] = map[string]any{"type": "RegularExpression", "value": match.Path.Regex}
+		}
+	}
+	if match.Method != nil && match.Method.Exact != "" {
+		httpRouteMatch["method"] = match.Method.Exact
+	}
+	if len(match.Headers) > 0 {
+		headerNames := make([]string, 0, len(match.Headers))
+		for name := range match.Headers {
+			headerNames = append(headerNames, name)
+		}
+		sort.Strings(headerNames)
+		headers := []any{}
+		for _, name := range headerNames {
+			value := match.Headers[name]
+			headers = append(headers, headerMatch(name, &value))
+		}
+		httpRouteMatch["headers"] = headers
+	}
+	return httpRouteMatch
+}
+
+// headerMatch converts a StringMatch into an HTTPRoute header match. HTTPRoutes have no prefix match for headers,
+// so prefixes are converted into a regular expression and a missing value matches any value.
+func headerMatch(name string, value *v1alpha1.StringMatch) map[string]any {
+	match := map[string]any{"name": name}
+	switch {
+	case value == nil:
+		match["type"] = "RegularExpression"
+		match["value"] = ".*"
+	case value.Exact != "":
+		match["type"] = "Exact"
+		match["value"] = value.Exact
+	case value.Regex != "":
+		match["type"] = "RegularExpression"
+		match["value"] = value.Regex
+	case value.Prefix != "":
+		match["type"] = "RegularExpression"
+		match["value"] = regexp.QuoteMeta(value.Prefix) + ".*"
+	default:
+		match["type"] = "RegularExpression"
+		match["value"] = ".*"
+	}
+	return match
+}
@@ -0,0 +1,357 @@
+package httproute
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const httpRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: default
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /
+    backendRefs:
+    - name: stable-service
+      port: 80
+      weight: 100
+    - name: canary-service
+      port: 80
+      weight: 0
+`
+
+const httpRouteWithoutCanary = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: default
+spec:
+  rules:
+  - backendRefs:
+    - name: stable-service
+      port: 80
+`
+
+const httpRouteWithoutRules = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: route
+  namespace: default
+spec:
+  parentRefs:
+  - name: gateway
+`
+
+var httpRouteGVR = schema.GroupVersionResource{Group: "gateway.networking.k8s.io", Version: "v1", Resource: "httproutes"}
+
+func newRollout(managedRoutes ...string) *v1alpha1.Rollout {
+	routes := []v1alpha1.MangedRoutes{}
+	for _, name := range managedRoutes {
+		routes = append(routes, v1alpha1.MangedRoutes{Name: name})
+	}
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "stable-service",
+					CanaryService: "canary-service",
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						ManagedRoutes: routes,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newReconciler(ro *v1alpha1.Rollout, objs ...runtime.Object) (*Reconciler, *k8stesting.Fake) {
+	client := testutil.NewFakeDynamicClient(objs...)
+	r := NewReconciler(&ReconcilerConfig{
+		Rollout:    ro,
+		Client:     client.Resource(httpRouteGVR).Namespace(ro.Namespace),
+		Recorder:   record.NewFakeEventRecorder(),
+		HTTPRoutes: []string{"route"},
+		Provider:   "Test",
+	})
+	return r, &client.Fake
+}
+
+func getRules(t *testing.T, r *Reconciler) (*unstructured.Unstructured, []any) {
+	t.Helper()
+	obj, err := r.Client.Get(context.TODO(), "route", metav1.GetOptions{})
+	assert.NoError(t, err)
+	rules, _, err := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	assert.NoError(t, err)
+	return obj, rules
+}
+
+func backendRefWeights(t *testing.T, rule any) map[string]any {
+	t.Helper()
+	weights := map[string]any{}
+	backendRefs, _, _ := unstructured.NestedSlice(rule.(map[string]any), "backendRefs")
+	for _, backendRef := range backendRefs {
+		typedBackendRef := backendRef.(map[string]any)
+		// weights of unchanged backendRefs keep the float type from the yaml fixture
+		switch weight := typedBackendRef["weight"].(type) {
+		case float64:
+			weights[typedBackendRef["name"].(string)] = int64(weight)
+		default:
+			weights[typedBackendRef["name"].(string)] = weight
+		}
+	}
+	return weights
+}
+
+func TestSetWeight(t *testing.T) {
+	t.Run("SetWeight", func(t *testing.T) {
+		r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+		assert.NoError(t, r.SetWeight(30))
+		_, rules := getRules(t, r)
+		assert.Equal(t, map[string]any{"stable-service": int64(70), "canary-service": int64(30)}, backendRefWeights(t, rules[0]))
+		assert.True(t, fake.Actions()[1].Matches("update", "httproutes"))
+	})
+	t.Run("SetWeightUnchanged", func(t *testing.T) {
+		r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+		assert.NoError(t, r.SetWeight(0))
+		assert.Len(t, fake.Actions(), 1)
+	})
+	t.Run("SetWeightMissingHTTPRoute", func(t *testing.T) {
+		r, _ := newReconciler(newRollout())
+		assert.Error(t, r.SetWeight(30))
+	})
+	t.Run("SetWeightMissingRules", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpRouteWithoutRules))
+		assert.EqualError(t, r.SetWeight(30), "spec.rules was not found in HTTPRoute manifest")
+	})
+	t.Run("SetWeightMissingCanaryService", func(t *testing.T) {
+		r, _ := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpRouteWithoutCanary))
+		assert.EqualError(t, r.SetWeight(30), `HTTPRoute "route" has no rule with backendRefs "stable-service" and "canary-service"`)
+	})
+	t.Run("SetWeightUpdateError", func(t *testing.T) {
+		r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+		fake.PrependReactor("update", "httproutes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.New("update failed")
+		})
+		assert.EqualError(t, r.SetWeight(30), "update failed")
+	})
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	r, _ := newReconciler(newRollout("header-route"), unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name: "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{
+			{HeaderName: "agent", HeaderValue: &v1alpha1.StringMatch{Exact: "firefox"}},
+			{HeaderName: "version", HeaderValue: &v1alpha1.StringMatch{Prefix: "v1."}},
+		},
+	}))
+	obj, rules := getRules(t, r)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, []string{"header-route"}, trafficrouting.GetManagedRouteNames(obj))
+	assert.Equal(t, map[string]any{"canary-service": int64(100)}, backendRefWeights(t, rules[0]))
+	matches, _, _ := unstructured.NestedSlice(rules[0].(map[string]any), "matches")
+	assert.Equal(t, []any{map[string]any{
+		"path": map[string]any{"type": "PathPrefix", "value": "/"},
+		"headers": []any{
+			map[string]any{"name": "agent", "type": "Exact", "value": "firefox"},
+			map[string]any{"name": "version", "type": "RegularExpression", "value": `v1\..*`},
+		},
+	}}, matches)
+
+	// weight changes leave the header rule untouched
+	assert.NoError(t, r.SetWeight(20))
+	_, rules = getRules(t, r)
+	assert.Equal(t, map[string]any{"canary-service": int64(100)}, backendRefWeights(t, rules[0]))
+	assert.Equal(t, map[string]any{"stable-service": int64(80), "canary-service": int64(20)}, backendRefWeights(t, rules[1]))
+
+	// a header route without matches removes the rule
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{Name: "header-route"}))
+	obj, rules = getRules(t, r)
+	assert.Len(t, rules, 1)
+	assert.Nil(t, trafficrouting.GetManagedRouteNames(obj))
+}
+
+func TestSetHeaderRouteWithoutBaseRule(t *testing.T) {
+	r, _ := newReconciler(newRollout("header-route"), unstructuredutil.StrToUnstructuredUnsafe(httpRouteWithoutCanary))
+	err := r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "agent"}},
+	})
+	assert.EqualError(t, err, `HTTPRoute "route" has no rule with backendRefs "stable-service" and "canary-service"`)
+}
+
+func TestSetMirrorRoute(t *testing.T) {
+	r, _ := newReconciler(newRollout("mirror-route"), unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+	percentage := int32(50)
+	assert.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name:       "mirror-route",
+		Percentage: &percentage,
+		Match: []v1alpha1.RouteMatch{
+			{
+				Method: &v1alpha1.StringMatch{Exact: "GET"},
+				Path:   &v1alpha1.StringMatch{Prefix: "/api"},
+				Headers: map[string]v1alpha1.StringMatch{
+					"b-header": {Regex: "b.*"},
+					"a-header": {Exact: "a"},
+				},
+			},
+			{Path: &v1alpha1.StringMatch{Exact: "/health"}},
+		},
+	}))
+	obj, rules := getRules(t, r)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, []string{"mirror-route"}, trafficrouting.GetManagedRouteNames(obj))
+	rule := rules[0].(map[string]any)
+	assert.Equal(t, []any{
+		map[string]any{
+			"method": "GET",
+			"path":   map[string]any{"type": "PathPrefix", "value": "/api"},
+			"headers": []any{
+				map[string]any{"name": "a-header", "type": "Exact", "value": "a"},
+				map[string]any{"name": "b-header", "type": "RegularExpression", "value": "b.*"},
+			},
+		},
+		map[string]any{"path": map[string]any{"type": "Exact", "value": "/health"}},
+	}, rule["matches"])
+	assert.Equal(t, []any{map[string]any{
+		"type": "RequestMirror",
+		"requestMirror": map[string]any{
+			"backendRef": map[string]any{"name": "canary-service", "port": float64(80)},
+			"percent":    int64(50),
+		},
+	}}, rule["filters"])
+
+	// weight changes are applied to the mirror rule
+	assert.NoError(t, r.SetWeight(10))
+	_, rules = getRules(t, r)
+	assert.Equal(t, map[string]any{"stable-service": int64(90), "canary-service": int64(10)}, backendRefWeights(t, rules[0]))
+
+	assert.NoError(t, r.RemoveManagedRoutes())
+	obj, rules = getRules(t, r)
+	assert.Len(t, rules, 1)
+	assert.Nil(t, trafficrouting.GetManagedRouteNames(obj))
+}
+
+func TestRemoveManagedRoutesWithoutManagedRoutes(t *testing.T) {
+	r, fake := newReconciler(newRollout(), unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+	assert.NoError(t, r.RemoveManagedRoutes())
+	assert.Empty(t, fake.Actions())
+}
+
+func TestHeaderMatch(t *testing.T) {
+	assert.Equal(t, map[string]any{"name": "a", "type": "RegularExpression", "value": ".*"}, headerMatch("a", nil))
+	assert.Equal(t, map[string]any{"name": "a", "type": "RegularExpression", "value": ".*"}, headerMatch("a", &v1alpha1.StringMatch{}))
+	assert.Equal(t, map[string]any{"name": "a", "type": "RegularExpression", "value": "b+"}, headerMatch("a", &v1alpha1.StringMatch{Regex: "b+"}))
+}
+
+func TestVerifyAccepted(t *testing.T) {
+	newHTTPRoute := func(status string) *unstructured.Unstructured {
+		obj := unstructuredutil.StrToUnstructuredUnsafe(httpRoute + status)
+		obj.SetGeneration(2)
+		return obj
+	}
+	tests := []struct {
+		name     string
+		status   string
+		verified bool
+	}{
+		{
+			name:     "no status",
+			verified: false,
+		},
+		{
+			name: "accepted by every parent",
+			status: `
+status:
+  parents:
+  - parentRef:
+      name: gateway
+    conditions:
+    - type: Accepted
+      status: "True"
+      observedGeneration: 2
+    - type: ResolvedRefs
+      status: "True"
+      observedGeneration: 2
+`,
+			verified: true,
+		},
+		{
+			name: "accepted for a previous generation",
+			status: `
+status:
+  parents:
+  - parentRef:
+      name: gateway
+    conditions:
+    - type: Accepted
+      status: "True"
+      observedGeneration: 1
+`,
+			verified: false,
+		},
+		{
+			name: "not accepted by one parent",
+			status: `
+status:
+  parents:
+  - parentRef:
+      name: gateway
+    conditions:
+    - type: Accepted
+      status: "True"
+      observedGeneration: 2
+  - parentRef:
+      name: other-gateway
+    conditions:
+    - type: Accepted
+      status: "False"
+      observedGeneration: 2
+`,
+			verified: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			r, _ := newReconciler(newRollout(), newHTTPRoute(test.status))
+			verified, err := r.VerifyAccepted()
+			assert.NoError(t, err)
+			assert.Equal(t, test.verified, *verified)
+		})
+	}
+
+	t.Run("missing HTTPRoute", func(t *testing.T) {
+		r, _ := newReconciler(newRollout())
+		verified, err := r.VerifyAccepted()
+		assert.Error(t, err)
+		assert.Nil(t, verified)
+	})
+}
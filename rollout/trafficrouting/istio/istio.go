@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/mitchellh/mapstructure"
@@ -18,10 +20,13 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamiclister"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/apply"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	evalUtils "github.com/argoproj/argo-rollouts/utils/evaluate"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
@@ -35,6 +40,19 @@ const Type = "Istio"
 
 const SpecHttpNotFound = "spec.http not found"
 
+// StickyRouteName is the name of the HTTP routes which keep the users holding the stickiness cookie on the canary
+const StickyRouteName = "argo-rollouts-sticky"
+
+const setCookieHeader = "Set-Cookie"
+
+var (
+	// virtualServiceFields are the fields of the VirtualServices applied by the controller. The routes are atomic lists,
+	// so they are applied whole.
+	virtualServiceFields = [][]string{{"spec", Http}, {"spec", Tls}, {"spec", Tcp}}
+	// destinationRuleFields are the fields of the DestinationRules applied by the controller
+	destinationRuleFields = [][]string{{"metadata", "annotations", v1alpha1.ManagedByRolloutsKey}, {"spec", "subsets"}}
+)
+
 // NewReconciler returns a reconciler struct that brings the Virtual Service into the desired state
 func NewReconciler(r *v1alpha1.Rollout, client dynamic.Interface, recorder record.EventRecorder, virtualServiceLister, destinationRuleLister dynamiclister.Lister, replicaSets []*appsv1.ReplicaSet) *Reconciler {
 	return &Reconciler{
@@ -58,6 +76,8 @@ type Reconciler struct {
 	virtualServiceLister  dynamiclister.Lister
 	destinationRuleLister dynamiclister.Lister
 	replicaSets           []*appsv1.ReplicaSet
+	// canaryHash is the pod template hash of the canary passed to UpdateHash, which the stickiness cookie holds
+	canaryHash string
 }
 
 type virtualServicePatch struct {
@@ -321,6 +341,7 @@ func (r *Reconciler) reconcileVirtualService(obj *unstructured.Unstructured, vsv
 }
 
 func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	r.canaryHash = canaryHash
 	// We need to check if the replicasets are ready here as well if we didn't define any services in the rollout
 	// See: https://github.com/argoproj/argo-rollouts/issues/2507
 	if r.rollout.Spec.Strategy.Canary.CanaryService == "" && r.rollout.Spec.Strategy.Canary.StableService == "" {
@@ -463,7 +484,7 @@ func updateDestinationRule(ctx context.Context, client dynamic.ResourceInterface
 	if err != nil {
 		return false, err
 	}
-	_, err = client.Update(ctx, &newDRuleUn, metav1.UpdateOptions{})
+	_, err = apply.Update(ctx, client, &newDRuleUn, destinationRuleFields...)
 	if err != nil {
 		return false, err
 	}
@@ -686,24 +707,300 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 		if err != nil {
 			return err
 		}
-		if !modified {
+		stickyModified, err := r.reconcileStickyRoutes(modifiedVirtualService, virtualService.Routes, desiredWeight)
+		if err != nil {
+			return fmt.Errorf("[SetWeight] failed to reconcile sticky routes: %w", err)
+		}
+		if !modified && !stickyModified {
 			continue
 		}
 
 		if err := r.orderRoutes(modifiedVirtualService); err != nil && err.Error() != SpecHttpNotFound {
 			return fmt.Errorf("[SetWeight] failed to order routes: %w", err)
 		}
-		_, err = client.Update(ctx, modifiedVirtualService, metav1.UpdateOptions{})
+		_, err = apply.Update(ctx, client, modifiedVirtualService, virtualServiceFields...)
 		if err == nil {
 			r.log.Debugf("Updated VirtualService: %s", modifiedVirtualService)
-			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService"}, "VirtualService `%s` set to desiredWeight '%d'", vsvcName, desiredWeight)
+			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService", RelatedObject: modifiedVirtualService}, "VirtualService `%s` set to desiredWeight '%d'", vsvcName, desiredWeight)
 		} else {
 			return err
 		}
 	}
+	return r.reconcileLocalities(desiredWeight)
+}
+
+// reconcileStickyRoutes keeps the users who were served by the canary on the canary while the weight changes. The canary
+// destination of each weighted HTTP route sets the stickiness cookie to the canary pod template hash, and a sticky route
+// placed ahead of the weighted route sends the requests holding that cookie to the canary. The sticky routes and the
+// cookie are removed when the canary gets no traffic.
+func (r *Reconciler) reconcileStickyRoutes(obj *unstructured.Unstructured, routeNames []string, desiredWeight int32) (bool, error) {
+	httpRoutesI, err := GetHttpRoutesI(obj)
+	if err != nil {
+		// The cookie only applies to HTTP routes
+		return false, nil
+	}
+	httpRoutes, err := GetHttpRoutes(httpRoutesI)
+	if err != nil {
+		return false, err
+	}
+	// err can be ignored because the routes were validated by reconcileVirtualService
+	routeIndexes, _ := getHttpRouteIndexesToPatch(routeNames, httpRoutes)
+
+	stickiness := r.rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness
+	cookieName := defaults.GetStickinessCookieNameOrDefault(r.rollout)
+	var setCookie string
+	if stickiness != nil && desiredWeight > 0 && r.canaryHash != "" {
+		setCookie = fmt.Sprintf("%s=%s; Path=/", cookieName, r.canaryHash)
+		if stickiness.DurationSeconds > 0 {
+			setCookie = fmt.Sprintf("%s; Max-Age=%d", setCookie, stickiness.DurationSeconds)
+		}
+	}
+	_, canarySvc := trafficrouting.GetStableAndCanaryServices(r.rollout, false)
+	var canarySubset string
+	if r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule != nil {
+		canarySubset = r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule.CanarySubsetName
+	}
+
+	var newHttpRoutesI []any
+	for idx, routeI := range httpRoutesI {
+		route, ok := routeI.(map[string]any)
+		if !ok {
+			return false, fmt.Errorf(invalidCasting, "http[]", "map[string]interface")
+		}
+		if name, _ := route["name"].(string); isStickyRoute(name) {
+			continue
+		}
+		if !slices.Contains(routeIndexes, idx) {
+			newHttpRoutesI = append(newHttpRoutesI, route)
+			continue
+		}
+		destinations, ok := route["route"].([]any)
+		if !ok {
+			return false, fmt.Errorf(invalidCasting, "http[].route", "[]interface")
+		}
+		var canaryDestination map[string]any
+		for destIdx, destination := range httpRoutes[idx].Route {
+			subset := destination.Destination.Subset
+			if getHost(destination) != canarySvc && (subset == "" || subset != canarySubset) {
+				continue
+			}
+			canaryDestination, ok = destinations[destIdx].(map[string]any)
+			if !ok {
+				return false, fmt.Errorf(invalidCasting, "http[].route[]", "map[string]interface")
+			}
+			setStickyCookieHeader(canaryDestination, cookieName, setCookie)
+		}
+		if setCookie != "" && canaryDestination != nil {
+			newHttpRoutesI = append(newHttpRoutesI, createStickyRoute(route, canaryDestination, cookieName, r.canaryHash))
+		}
+		newHttpRoutesI = append(newHttpRoutesI, route)
+	}
+
+	origHttpRoutesI, _, _ := unstructured.NestedFieldNoCopy(obj.Object, "spec", Http)
+	// The routes are compared as JSON because the numbers read from the cluster and the ones set above differ in type
+	origBytes, err := json.Marshal(origHttpRoutesI)
+	if err != nil {
+		return false, err
+	}
+	newBytes, err := json.Marshal(newHttpRoutesI)
+	if err != nil {
+		return false, err
+	}
+	if string(origBytes) == string(newBytes) {
+		return false, nil
+	}
+	if err := unstructured.SetNestedSlice(obj.Object, newHttpRoutesI, "spec", Http); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// isStickyRoute returns whether the HTTP route is a sticky route managed by reconcileStickyRoutes
+func isStickyRoute(name string) bool {
+	return name == StickyRouteName || strings.HasPrefix(name, StickyRouteName+"-")
+}
+
+// setStickyCookieHeader sets the stickiness cookie on the responses of the destination, or removes it when setCookie
+// is empty
+func setStickyCookieHeader(destination map[string]any, cookieName, setCookie string) {
+	headers, _ := destination["headers"].(map[string]any)
+	response, _ := headers["response"].(map[string]any)
+	add, _ := response["add"].(map[string]any)
+	if setCookie != "" {
+		if add == nil {
+			add = map[string]any{}
+		}
+		add[setCookieHeader] = setCookie
+	} else if value, _ := add[setCookieHeader].(string); strings.HasPrefix(value, cookieName+"=") {
+		delete(add, setCookieHeader)
+	} else {
+		return
+	}
+	if len(add) == 0 {
+		delete(response, "add")
+	} else {
+		if response == nil {
+			response = map[string]any{}
+		}
+		response["add"] = add
+	}
+	if len(response) == 0 {
+		delete(headers, "response")
+	} else {
+		if headers == nil {
+			headers = map[string]any{}
+		}
+		headers["response"] = response
+	}
+	if len(headers) == 0 {
+		delete(destination, "headers")
+	} else {
+		destination["headers"] = headers
+	}
+}
+
+// createStickyRoute returns a copy of the weighted route which only matches the requests holding the stickiness cookie
+// of the canary and sends them to the canary
+func createStickyRoute(route map[string]any, canaryDestination map[string]any, cookieName, canaryHash string) map[string]any {
+	stickyRoute := runtime.DeepCopyJSONValue(route).(map[string]any)
+	name := StickyRouteName
+	if routeName, _ := route["name"].(string); routeName != "" {
+		name = fmt.Sprintf("%s-%s", StickyRouteName, routeName)
+	}
+	stickyRoute["name"] = name
+
+	cookieMatch := map[string]any{
+		"regex": fmt.Sprintf(`^(.*;\s*)?%s=%s(;.*)?$`, regexp.QuoteMeta(cookieName), canaryHash),
+	}
+	// The cookie is added to every match of the weighted route, so that the sticky route matches the same requests
+	matches, _ := stickyRoute["match"].([]any)
+	if len(matches) == 0 {
+		matches = []any{map[string]any{}}
+	}
+	for i, matchI := range matches {
+		match, ok := matchI.(map[string]any)
+		if !ok {
+			match = map[string]any{}
+		}
+		headers, _ := match["headers"].(map[string]any)
+		if headers == nil {
+			headers = map[string]any{}
+		}
+		headers["cookie"] = cookieMatch
+		match["headers"] = headers
+		matches[i] = match
+	}
+	stickyRoute["match"] = matches
+
+	destination := runtime.DeepCopyJSONValue(canaryDestination).(map[string]any)
+	destination["weight"] = float64(100)
+	stickyRoute["route"] = []any{destination}
+	return stickyRoute
+}
+
+// reconcileLocalities restricts the canary subset of the DestinationRule to the canary pods of the localities which
+// are enabled at the desired weight
+func (r *Reconciler) reconcileLocalities(desiredWeight int32) error {
+	istio := r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio
+	if len(istio.Localities) == 0 || istio.DestinationRule == nil {
+		return nil
+	}
+	ctx := context.TODO()
+	client := r.client.Resource(istioutil.GetIstioDestinationRuleGVR()).Namespace(r.rollout.Namespace)
+	origBytes, dRule, dRuleNew, err := r.getDestinationRule(istio.DestinationRule, client, ctx)
+	if err != nil {
+		return err
+	}
+	localities := enabledLocalities(istio.Localities, desiredWeight)
+	for i := range dRuleNew.Spec.Subsets {
+		if dRuleNew.Spec.Subsets[i].Name == istio.DestinationRule.CanarySubsetName {
+			setLocalityDistribution(&dRuleNew.Spec.Subsets[i], localities)
+		}
+	}
+	modified, err := updateDestinationRule(ctx, client, origBytes, dRule, dRuleNew)
+	if err != nil {
+		return err
+	}
+	if modified {
+		r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "UpdatedDestinationRule"},
+			"DestinationRule %s subset %s restricted to localities [%s]", istio.DestinationRule.Name, istio.DestinationRule.CanarySubsetName, strings.Join(localities, ", "))
+	}
 	return nil
 }
 
+// enabledLocalities returns the localities whose canary pods receive traffic at the desired weight. No locality is
+// returned once the canary does not receive traffic anymore so that the restriction is lifted after the promotion.
+func enabledLocalities(localities []v1alpha1.IstioLocality, desiredWeight int32) []string {
+	var enabled []string
+	if desiredWeight == 0 {
+		return enabled
+	}
+	for _, locality := range localities {
+		if desiredWeight >= locality.MinWeight {
+			enabled = append(enabled, localityPattern(locality.Name))
+		}
+	}
+	return enabled
+}
+
+// localityPattern matches all the sub-localities of a region or zone
+func localityPattern(name string) string {
+	if strings.HasSuffix(name, "*") || strings.Count(name, "/") >= 2 {
+		return name
+	}
+	return name + "/*"
+}
+
+// setLocalityDistribution sends the traffic of the subset from every locality to the given localities, evenly
+// distributed. The locality load balancer setting is removed when no locality is given.
+func setLocalityDistribution(subset *Subset, localities []string) {
+	trafficPolicy, _ := subset.Extra["trafficPolicy"].(map[string]any)
+	loadBalancer, _ := trafficPolicy["loadBalancer"].(map[string]any)
+	if len(localities) == 0 {
+		if loadBalancer == nil {
+			return
+		}
+		delete(loadBalancer, "localityLbSetting")
+		if len(loadBalancer) == 0 {
+			delete(trafficPolicy, "loadBalancer")
+		}
+		if len(trafficPolicy) == 0 {
+			delete(subset.Extra, "trafficPolicy")
+		}
+		if len(subset.Extra) == 0 {
+			subset.Extra = nil
+		}
+		return
+	}
+
+	// Istio requires the weights of a distribution to add up to 100
+	to := map[string]any{}
+	for i, locality := range localities {
+		weight := 100 / len(localities)
+		if i < 100%len(localities) {
+			weight++
+		}
+		to[locality] = int64(weight)
+	}
+	if subset.Extra == nil {
+		subset.Extra = map[string]any{}
+	}
+	if trafficPolicy == nil {
+		trafficPolicy = map[string]any{}
+	}
+	if loadBalancer == nil {
+		loadBalancer = map[string]any{}
+	}
+	loadBalancer["localityLbSetting"] = map[string]any{
+		"enabled": true,
+		"distribute": []any{
+			map[string]any{"from": "*", "to": to},
+		},
+	}
+	trafficPolicy["loadBalancer"] = loadBalancer
+	subset.Extra["trafficPolicy"] = trafficPolicy
+}
+
 func (r *Reconciler) getVirtualServices() []v1alpha1.IstioVirtualService {
 	if istioutil.MultipleVirtualServiceConfigured(r.rollout) {
 		return r.rollout.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualServices
@@ -798,10 +1095,10 @@ func (r *Reconciler) SetHeaderRoute(headerRouting *v1alpha1.SetHeaderRoute) erro
 		if err := r.orderRoutes(vsvc); err != nil && err.Error() != SpecHttpNotFound {
 			return fmt.Errorf("[SetHeaderRoute] failed to order routes: %w", err)
 		}
-		_, err = client.Update(ctx, vsvc, metav1.UpdateOptions{})
+		_, err = apply.Update(ctx, client, vsvc, virtualServiceFields...)
 		if err == nil {
 			r.log.Debugf("Updated VirtualService: %s", vsvc)
-			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService"}, "VirtualService `%s` set headerRoute '%v'", vsvcName, headerRouting.Name)
+			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService", RelatedObject: vsvc}, "VirtualService `%s` set headerRoute '%v'", vsvcName, headerRouting.Name)
 		} else {
 			return fmt.Errorf("[SetHeaderRoute] failed to update routes: %w", err)
 		}
@@ -906,9 +1203,16 @@ func getHttpRouteIndexesToPatch(routeNames []string, httpRoutes []VirtualService
 	//We have no routes listed in spec.strategy.canary.trafficRouting.istio.virtualService.routes so find index
 	//of the first empty named route.
 	// If there is only one HTTPRoute defined in the VirtualService, then we can patch it without a name.
+	// The sticky routes are not taken into account since the controller adds them next to the weighted route.
 	if len(routeNames) == 0 {
-		if len(httpRoutes) == 1 {
-			return []int{0}, nil
+		var routeIndexes []int
+		for i, route := range httpRoutes {
+			if !isStickyRoute(route.Name) {
+				routeIndexes = append(routeIndexes, i)
+			}
+		}
+		if len(routeIndexes) == 1 {
+			return routeIndexes, nil
 		}
 
 		for i, route := range httpRoutes {
@@ -1073,6 +1377,10 @@ func ValidateHTTPRoutes(r *v1alpha1.Rollout, routeNames []string, httpRoutes []V
 	if err != nil {
 		return fmt.Errorf("[ValidateHTTPRoutes] failed to split managed and non-managed routes: %w", err)
 	}
+	httpRoutesNotWithinManagedRoutes = slices.DeleteFunc(httpRoutesNotWithinManagedRoutes, func(route map[string]any) bool {
+		name, _ := route["name"].(string)
+		return isStickyRoute(name)
+	})
 
 	if len(routeNames) == 0 && len(httpRoutesNotWithinManagedRoutes) > 1 {
 		return fmt.Errorf("spec.http[] should be set in VirtualService and it must have exactly one route when omitting spec.strategy.canary.trafficRouting.istio.virtualService.routes")
@@ -1194,10 +1502,10 @@ func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) err
 		if err := r.orderRoutes(istioVirtualSvc); err != nil && err.Error() != SpecHttpNotFound {
 			return fmt.Errorf("[SetMirrorRoute] failed to order routes based on managedRoute order: %w", err)
 		}
-		_, err = client.Update(ctx, istioVirtualSvc, metav1.UpdateOptions{})
+		_, err = apply.Update(ctx, client, istioVirtualSvc, virtualServiceFields...)
 		if err == nil {
 			r.log.Debugf("Updated VirtualService: %s", istioVirtualSvc)
-			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService"}, "VirtualService `%s` set mirrorRoute '%v'", vsvcName, setMirrorRoute.Name)
+			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService", RelatedObject: istioVirtualSvc}, "VirtualService `%s` set mirrorRoute '%v'", vsvcName, setMirrorRoute.Name)
 		} else {
 			return fmt.Errorf("[SetMirrorRoute] failed to update virtual service %w", err)
 		}
@@ -1559,10 +1867,10 @@ func (r *Reconciler) RemoveManagedRoutes() error {
 			return fmt.Errorf("[RemoveManagedRoutes] failed to set nested slice on virtual service to remove managed routes: %w", err)
 		}
 
-		_, err = client.Update(ctx, istioVirtualService, metav1.UpdateOptions{})
+		_, err = apply.Update(ctx, client, istioVirtualService, virtualServiceFields...)
 		if err == nil {
 			r.log.Debugf("Updated VirtualService: %s", istioVirtualService)
-			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService"}, "VirtualService `%s` removed all managed routes.", vsvcName)
+			r.recorder.Eventf(r.rollout, record.EventOptions{EventReason: "Updated VirtualService", RelatedObject: istioVirtualService}, "VirtualService `%s` removed all managed routes.", vsvcName)
 		} else {
 			return fmt.Errorf("[RemoveManagedRoutes] failed to update kubernetes virtual service: %w", err)
 		}
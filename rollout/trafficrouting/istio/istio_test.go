@@ -12,14 +12,18 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/dynamic/dynamiclister"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	evalUtils "github.com/argoproj/argo-rollouts/utils/evaluate"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	"github.com/argoproj/argo-rollouts/utils/record"
@@ -871,6 +875,85 @@ func TestHttpReconcileHeaderRouteHostBased(t *testing.T) {
 	assert.Equal(t, httpRoutes[1].Name, "secondary")
 }
 
+func TestSetWeightWithLocalities(t *testing.T) {
+	ro := rolloutWithDestinationRule()
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Name = "vsvc"
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.VirtualService.Routes = nil
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule.StableSubsetName = "stable-subset"
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.DestinationRule.CanarySubsetName = "canary-subset"
+	ro.Spec.Strategy.Canary.TrafficRouting.Istio.Localities = []v1alpha1.IstioLocality{
+		{Name: "us-east1/us-east1-b"},
+		{Name: "us-east1/us-east1-c", MinWeight: 30},
+		{Name: "us-east1/us-east1-d/*", MinWeight: 60},
+	}
+	dRule := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: networking.istio.io/v1alpha3
+kind: DestinationRule
+metadata:
+  name: istio-destrule
+  namespace: default
+spec:
+  host: rollout-service
+  subsets:
+  - name: stable-subset
+  - name: canary-subset
+    trafficPolicy:
+      loadBalancer:
+        simple: LEAST_REQUEST
+`)
+	client := testutil.NewFakeDynamicClient(unstructuredutil.StrToUnstructuredUnsafe(singleRouteSubsetVsvc), dRule)
+
+	getDistribution := func(t *testing.T) (map[string]any, bool) {
+		t.Helper()
+		dRuleUn, err := client.Resource(istioutil.GetIstioDestinationRuleGVR()).Namespace(ro.Namespace).Get(context.TODO(), "istio-destrule", metav1.GetOptions{})
+		assert.NoError(t, err)
+		subsets, _, _ := unstructured.NestedSlice(dRuleUn.Object, "spec", "subsets")
+		assert.Len(t, subsets, 2)
+		_, found, _ := unstructured.NestedMap(subsets[0].(map[string]any), "trafficPolicy")
+		assert.False(t, found, "stable subset is not restricted")
+		simple, _, _ := unstructured.NestedString(subsets[1].(map[string]any), "trafficPolicy", "loadBalancer", "simple")
+		assert.Equal(t, "LEAST_REQUEST", simple)
+		distribute, found, _ := unstructured.NestedSlice(subsets[1].(map[string]any), "trafficPolicy", "loadBalancer", "localityLbSetting", "distribute")
+		if !found {
+			return nil, false
+		}
+		assert.Len(t, distribute, 1)
+		assert.Equal(t, "*", distribute[0].(map[string]any)["from"])
+		return distribute[0].(map[string]any)["to"].(map[string]any), true
+	}
+
+	for _, test := range []struct {
+		weight   int32
+		expected map[string]any
+	}{
+		{10, map[string]any{"us-east1/us-east1-b/*": float64(100)}},
+		{30, map[string]any{"us-east1/us-east1-b/*": float64(50), "us-east1/us-east1-c/*": float64(50)}},
+		{60, map[string]any{"us-east1/us-east1-b/*": float64(34), "us-east1/us-east1-c/*": float64(33), "us-east1/us-east1-d/*": float64(33)}},
+	} {
+		vsvcLister, druleLister := getIstioListers(client)
+		r := NewReconciler(ro, client, record.NewFakeEventRecorder(), vsvcLister, druleLister, nil)
+		assert.NoError(t, r.SetWeight(test.weight))
+		to, found := getDistribution(t)
+		assert.True(t, found)
+		assert.Equal(t, test.expected, to)
+	}
+
+	// the restriction is lifted once the canary does not receive traffic anymore
+	vsvcLister, druleLister := getIstioListers(client)
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), vsvcLister, druleLister, nil)
+	assert.NoError(t, r.SetWeight(0))
+	_, found := getDistribution(t)
+	assert.False(t, found)
+}
+
+func TestSetLocalityDistributionRemovesEmptyTrafficPolicy(t *testing.T) {
+	subset := Subset{Name: "canary"}
+	setLocalityDistribution(&subset, []string{"us-east1/us-east1-b/*"})
+	assert.NotNil(t, subset.Extra)
+	setLocalityDistribution(&subset, nil)
+	assert.Nil(t, subset.Extra)
+}
+
 func TestHttpReconcileHeaderRouteSubsetBased(t *testing.T) {
 	ro := rolloutWithDestinationRule()
 	const StableSubsetName = "stable-subset"
@@ -1316,6 +1399,35 @@ func AssertReconcileUpdateVirtualService(t *testing.T, vsvc string, ro *v1alpha1
 	return client
 }
 
+func TestReconcileApplyVirtualService(t *testing.T) {
+	defaults.SetServerSideApplyEnabled(true)
+	defer defaults.SetServerSideApplyEnabled(false)
+
+	ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
+	obj := unstructuredutil.StrToUnstructuredUnsafe(regularVsvc)
+	client := testutil.NewFakeDynamicClient(obj)
+	client.PrependReactor("patch", "virtualservices", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, obj, nil
+	})
+	vsvcLister, druleLister := getIstioListers(client)
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), vsvcLister, druleLister, nil)
+	client.ClearActions()
+	err := r.SetWeight(10)
+	assert.Nil(t, err)
+	actions := client.Actions()
+	assert.Len(t, actions, 1)
+	patchAction, ok := actions[0].(k8stesting.PatchAction)
+	assert.True(t, ok)
+	assert.Equal(t, types.ApplyPatchType, patchAction.GetPatchType())
+
+	// only the routes of the virtual service are applied
+	applied := unstructuredutil.StrToUnstructuredUnsafe(string(patchAction.GetPatch()))
+	assert.Equal(t, "vsvc", applied.GetName())
+	spec, _, _ := unstructured.NestedMap(applied.Object, "spec")
+	assert.Len(t, spec, 1)
+	assertHttpRouteWeightChanges(t, extractHttpRoutes(t, applied)[0], "primary", 10, 90)
+}
+
 func TestReconcileNoChanges(t *testing.T) {
 	obj := unstructuredutil.StrToUnstructuredUnsafe(regularVsvc)
 	client := testutil.NewFakeDynamicClient(obj)
@@ -2001,6 +2113,121 @@ func rolloutWithDestinationRule() *v1alpha1.Rollout {
 	}
 }
 
+const stickyVsvc = `apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: vsvc
+  namespace: default
+spec:
+  hosts:
+  - istio-rollout.dev.argoproj.io
+  http:
+  - name: primary
+    match:
+    - uri:
+        prefix: /api
+    route:
+    - destination:
+        host: stable
+      weight: 100
+    - destination:
+        host: canary
+      weight: 0
+  - name: secondary
+    route:
+    - destination:
+        host: stable
+      weight: 100`
+
+func getHttpRoutesUnstructured(t *testing.T, client dynamic.Interface) []any {
+	vsvc, err := client.Resource(istioutil.GetIstioVirtualServiceGVR()).Namespace("default").Get(context.TODO(), "vsvc", metav1.GetOptions{})
+	assert.NoError(t, err)
+	httpRoutes, _, err := unstructured.NestedSlice(vsvc.Object, "spec", Http)
+	assert.NoError(t, err)
+	return httpRoutes
+}
+
+// TestSetWeightWithStickiness verifies the canary sets the stickiness cookie and a sticky route keeps the requests
+// holding it on the canary
+func TestSetWeightWithStickiness(t *testing.T) {
+	ro := rolloutWithHttpRoutes("stable", "canary", "vsvc", []string{"primary"})
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{DurationSeconds: 3600}
+	client := testutil.NewFakeDynamicClient(unstructuredutil.StrToUnstructuredUnsafe(stickyVsvc))
+	vsvcLister, druleLister := getIstioListers(client)
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), vsvcLister, druleLister, nil)
+
+	assert.NoError(t, r.UpdateHash("abc123", "def456"))
+	assert.NoError(t, r.SetWeight(20))
+
+	httpRoutes := getHttpRoutesUnstructured(t, client)
+	assert.Len(t, httpRoutes, 3)
+	expectedDestination := map[string]any{
+		"destination": map[string]any{"host": "canary"},
+		"weight":      float64(20),
+		"headers": map[string]any{
+			"response": map[string]any{
+				"add": map[string]any{"Set-Cookie": "argo-rollouts-sticky=abc123; Path=/; Max-Age=3600"},
+			},
+		},
+	}
+	stickyRoute := httpRoutes[0].(map[string]any)
+	assert.Equal(t, "argo-rollouts-sticky-primary", stickyRoute["name"])
+	assert.Equal(t, []any{map[string]any{
+		"uri":     map[string]any{"prefix": "/api"},
+		"headers": map[string]any{"cookie": map[string]any{"regex": `^(.*;\s*)?argo-rollouts-sticky=abc123(;.*)?$`}},
+	}}, stickyRoute["match"])
+	expectedDestination["weight"] = float64(100)
+	assert.Equal(t, []any{expectedDestination}, stickyRoute["route"])
+
+	weightedRoute := httpRoutes[1].(map[string]any)
+	assert.Equal(t, "primary", weightedRoute["name"])
+	expectedDestination["weight"] = float64(20)
+	assert.Equal(t, expectedDestination, weightedRoute["route"].([]any)[1])
+	assert.Equal(t, "secondary", httpRoutes[2].(map[string]any)["name"])
+
+	// the VirtualService is not updated again at the same weight
+	client.ClearActions()
+	r = NewReconciler(ro, client, record.NewFakeEventRecorder(), nil, nil, nil)
+	assert.NoError(t, r.UpdateHash("abc123", "def456"))
+	assert.NoError(t, r.SetWeight(20))
+	assert.Len(t, client.Actions(), 1)
+	assert.Equal(t, "get", client.Actions()[0].GetVerb())
+
+	// the sticky route and the cookie are removed when the canary gets no traffic
+	assert.NoError(t, r.SetWeight(0))
+	httpRoutes = getHttpRoutesUnstructured(t, client)
+	assert.Len(t, httpRoutes, 2)
+	weightedRoute = httpRoutes[0].(map[string]any)
+	assert.Equal(t, "primary", weightedRoute["name"])
+	assert.Equal(t, map[string]any{"destination": map[string]any{"host": "canary"}, "weight": float64(0)}, weightedRoute["route"].([]any)[1])
+}
+
+// TestSetWeightWithStickinessSingleRoute verifies the sticky route of an unnamed route and a session cookie
+func TestSetWeightWithStickinessSingleRoute(t *testing.T) {
+	ro := rollout("stable", "canary", &v1alpha1.IstioVirtualService{Name: "vsvc"})
+	ro.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{CookieName: "app.version"}
+	client := testutil.NewFakeDynamicClient(unstructuredutil.StrToUnstructuredUnsafe(singleRouteVsvc))
+	vsvcLister, druleLister := getIstioListers(client)
+	r := NewReconciler(ro, client, record.NewFakeEventRecorder(), vsvcLister, druleLister, nil)
+
+	assert.NoError(t, r.UpdateHash("abc123", "def456"))
+	assert.NoError(t, r.SetWeight(10))
+	// the weighted route is still found next to the sticky route
+	assert.NoError(t, r.SetWeight(50))
+
+	httpRoutes := getHttpRoutesUnstructured(t, client)
+	assert.Len(t, httpRoutes, 2)
+	stickyRoute := httpRoutes[0].(map[string]any)
+	assert.Equal(t, StickyRouteName, stickyRoute["name"])
+	assert.Equal(t, []any{map[string]any{
+		"headers": map[string]any{"cookie": map[string]any{"regex": `^(.*;\s*)?app\.version=abc123(;.*)?$`}},
+	}}, stickyRoute["match"])
+	canaryDestination := httpRoutes[1].(map[string]any)["route"].([]any)[1].(map[string]any)
+	assert.Equal(t, float64(50), canaryDestination["weight"])
+	setCookie, _, _ := unstructured.NestedString(canaryDestination, "headers", "response", "add", "Set-Cookie")
+	assert.Equal(t, "app.version=abc123; Path=/", setCookie)
+}
+
 // TestUpdateHashWithListers verifies behavior of UpdateHash when using informers/listers
 func TestUpdateHashAdditionalFieldsWithListers(t *testing.T) {
 	ro := rolloutWithDestinationRule()
@@ -0,0 +1,84 @@
+package kong
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/httproute"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// Type holds this controller type
+const Type = "Kong"
+
+const (
+	httpRoutes = "httproutes"
+	// ControllerName is the default controller name of the Gateway API controller of the Kong Ingress Controller,
+	// which reports the status of the HTTPRoutes attached to Kong Gateways
+	ControllerName = "konghq.com/kic-gateway-controller"
+	// resolvedRefsCondition is reported by Kong once the backendRefs of a route are translated into upstream targets
+	resolvedRefsCondition = "ResolvedRefs"
+	acceptedCondition     = "Accepted"
+)
+
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   httproute.ClientInterface
+	Recorder record.EventRecorder
+}
+
+// Reconciler splits traffic through the weighted backendRefs of the Gateway API HTTPRoutes served by the Kong
+// Ingress Controller, which translates them into weighted Kong upstream targets
+type Reconciler struct {
+	*httproute.Reconciler
+}
+
+func NewReconciler(cfg *ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		Reconciler: httproute.NewReconciler(&httproute.ReconcilerConfig{
+			Rollout:    cfg.Rollout,
+			Client:     cfg.Client,
+			Recorder:   cfg.Recorder,
+			HTTPRoutes: cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Kong.HTTPRoutes,
+			Provider:   Type,
+		}),
+	}
+}
+
+func NewDynamicClient(di dynamic.Interface, namespace string) dynamic.ResourceInterface {
+	return di.Resource(GetHTTPRouteGVR()).Namespace(namespace)
+}
+
+func GetHTTPRouteGVR() schema.GroupVersionResource {
+	parts := strings.Split(defaults.DefaultGatewayAPIVersion, "/")
+	return schema.GroupVersionResource{
+		Group:    defaults.DefaultGatewayAPIGroup,
+		Version:  parts[len(parts)-1],
+		Resource: httpRoutes,
+	}
+}
+
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+// SetWeight sets the weights of the stable, canary and experiment backendRefs, which Kong translates into the
+// weights of the targets of a single upstream
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return r.Reconciler.SetWeight(desiredWeight, additionalDestinations...)
+}
+
+// VerifyWeight verifies that the Kong Ingress Controller accepted the current generation of the HTTPRoutes and
+// resolved their backendRefs, i.e. that the weighted targets were pushed to the Kong upstream. The parents of other
+// Gateway controllers are ignored.
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	return r.VerifyConditions(ControllerName, acceptedCondition, resolvedRefsCondition)
+}
+
+func (r *Reconciler) Type() string {
+	return Type
+}
@@ -0,0 +1,162 @@
+package kong
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const httpRoute = `
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: kong-route
+  namespace: default
+  annotations:
+    konghq.com/strip-path: "true"
+spec:
+  parentRefs:
+  - name: kong
+  rules:
+  - backendRefs:
+    - name: stable-service
+      kind: Service
+      port: 80
+      weight: 100
+    - name: canary-service
+      kind: Service
+      port: 80
+      weight: 0
+`
+
+func newRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "stable-service",
+					CanaryService: "canary-service",
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						Kong: &v1alpha1.KongTrafficRouting{
+							HTTPRoutes: []string{"kong-route"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGetHTTPRouteGVR(t *testing.T) {
+	gvr := GetHTTPRouteGVR()
+	assert.Equal(t, "gateway.networking.k8s.io", gvr.Group)
+	assert.Equal(t, "v1", gvr.Version)
+	assert.Equal(t, "httproutes", gvr.Resource)
+}
+
+func TestReconciler(t *testing.T) {
+	ro := newRollout()
+	client := testutil.NewFakeDynamicClient(unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+	r := NewReconciler(&ReconcilerConfig{
+		Rollout:  ro,
+		Client:   NewDynamicClient(client, ro.Namespace),
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	assert.Equal(t, Type, r.Type())
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+
+	assert.NoError(t, r.SetWeight(40))
+	obj, err := r.Client.Get(context.TODO(), "kong-route", metav1.GetOptions{})
+	assert.NoError(t, err)
+	rules, _, _ := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	backendRefs, _, _ := unstructured.NestedSlice(rules[0].(map[string]any), "backendRefs")
+	assert.Equal(t, int64(60), backendRefs[0].(map[string]any)["weight"])
+	assert.Equal(t, int64(40), backendRefs[1].(map[string]any)["weight"])
+}
+
+func TestSetWeightWithExperimentServices(t *testing.T) {
+	ro := newRollout()
+	client := testutil.NewFakeDynamicClient(unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+	r := NewReconciler(&ReconcilerConfig{
+		Rollout:  ro,
+		Client:   NewDynamicClient(client, ro.Namespace),
+		Recorder: record.NewFakeEventRecorder(),
+	})
+	getBackendRefs := func() []any {
+		obj, err := r.Client.Get(context.TODO(), "kong-route", metav1.GetOptions{})
+		assert.NoError(t, err)
+		rules, _, _ := unstructured.NestedSlice(obj.Object, "spec", "rules")
+		backendRefs, _, _ := unstructured.NestedSlice(rules[0].(map[string]any), "backendRefs")
+		return backendRefs
+	}
+
+	assert.NoError(t, r.SetWeight(20, v1alpha1.WeightDestination{ServiceName: "experiment-baseline", Weight: 10}, v1alpha1.WeightDestination{ServiceName: "experiment-canary", Weight: 10}))
+	backendRefs := getBackendRefs()
+	assert.Len(t, backendRefs, 4)
+	assert.Equal(t, int64(60), backendRefs[0].(map[string]any)["weight"])
+	assert.Equal(t, int64(20), backendRefs[1].(map[string]any)["weight"])
+	for i, name := range []string{"experiment-baseline", "experiment-canary"} {
+		backendRef := backendRefs[i+2].(map[string]any)
+		assert.Equal(t, name, backendRef["name"])
+		assert.Equal(t, "Service", backendRef["kind"])
+		assert.NotNil(t, backendRef["port"])
+		assert.Equal(t, int64(10), backendRef["weight"])
+	}
+
+	// the backendRefs of the experiments are removed once the experiments are done
+	assert.NoError(t, r.SetWeight(20))
+	backendRefs = getBackendRefs()
+	assert.Len(t, backendRefs, 2)
+	assert.Equal(t, int64(80), backendRefs[0].(map[string]any)["weight"])
+	assert.Equal(t, int64(20), backendRefs[1].(map[string]any)["weight"])
+}
+
+func TestVerifyWeight(t *testing.T) {
+	ro := newRollout()
+	obj := unstructuredutil.StrToUnstructuredUnsafe(httpRoute)
+	obj.SetGeneration(2)
+	parent := func(controllerName string, observedGeneration int64, resolvedRefs string) any {
+		return map[string]any{
+			"controllerName": controllerName,
+			"parentRef":      map[string]any{"name": "gateway"},
+			"conditions": []any{
+				map[string]any{"type": "Accepted", "status": "True", "observedGeneration": observedGeneration},
+				map[string]any{"type": "ResolvedRefs", "status": resolvedRefs, "observedGeneration": observedGeneration},
+			},
+		}
+	}
+	verify := func(parents ...any) *bool {
+		route := obj.DeepCopy()
+		assert.NoError(t, unstructured.SetNestedSlice(route.Object, parents, "status", "parents"))
+		r := NewReconciler(&ReconcilerConfig{
+			Rollout:  ro,
+			Client:   NewDynamicClient(testutil.NewFakeDynamicClient(route), ro.Namespace),
+			Recorder: record.NewFakeEventRecorder(),
+		})
+		verified, err := r.VerifyWeight(10)
+		assert.NoError(t, err)
+		return verified
+	}
+
+	assert.False(t, *verify())
+	assert.True(t, *verify(parent(ControllerName, 2, "True")))
+	// the previous generation was reconciled by Kong
+	assert.False(t, *verify(parent(ControllerName, 1, "True")))
+	// a backendRef, e.g. the service of an experiment, does not exist
+	assert.False(t, *verify(parent(ControllerName, 2, "False")))
+	// the parents of other Gateway controllers are ignored
+	assert.True(t, *verify(parent(ControllerName, 2, "True"), parent("example.com/gateway-controller", 1, "False")))
+	assert.False(t, *verify(parent("example.com/gateway-controller", 2, "True")))
+}
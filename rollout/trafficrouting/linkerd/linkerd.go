@@ -0,0 +1,74 @@
+package linkerd
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/httproute"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// Type holds this controller type
+const Type = "Linkerd"
+
+const httpRoutes = "httproutes"
+
+type ReconcilerConfig struct {
+	Rollout  *v1alpha1.Rollout
+	Client   httproute.ClientInterface
+	Recorder record.EventRecorder
+}
+
+// Reconciler splits traffic through the weighted backendRefs of the policy.linkerd.io HTTPRoutes attached to the
+// stable service. It replaces the SMI TrafficSplit integration which is no longer supported by Linkerd.
+type Reconciler struct {
+	*httproute.Reconciler
+}
+
+func NewReconciler(cfg *ReconcilerConfig) *Reconciler {
+	return &Reconciler{
+		Reconciler: httproute.NewReconciler(&httproute.ReconcilerConfig{
+			Rollout:    cfg.Rollout,
+			Client:     cfg.Client,
+			Recorder:   cfg.Recorder,
+			HTTPRoutes: cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Linkerd.HTTPRoutes,
+			Provider:   Type,
+		}),
+	}
+}
+
+func NewDynamicClient(di dynamic.Interface, namespace string) dynamic.ResourceInterface {
+	return di.Resource(GetHTTPRouteGVR()).Namespace(namespace)
+}
+
+func GetHTTPRouteGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    defaults.DefaultLinkerdAPIGroup,
+		Version:  defaults.GetLinkerdHTTPRouteVersion(),
+		Resource: httpRoutes,
+	}
+}
+
+func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return nil
+}
+
+func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	return r.Reconciler.SetWeight(desiredWeight)
+}
+
+// SetMirrorRoute is not supported since Linkerd HTTPRoutes do not support the RequestMirror filter
+func (r *Reconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	return nil
+}
+
+// VerifyWeight verifies that the Linkerd policy controller accepted the current generation of the HTTPRoutes
+func (r *Reconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	return r.VerifyAccepted()
+}
+
+func (r *Reconciler) Type() string {
+	return Type
+}
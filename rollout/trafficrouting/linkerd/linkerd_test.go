@@ -0,0 +1,152 @@
+package linkerd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const httpRoute = `
+apiVersion: policy.linkerd.io/v1beta3
+kind: HTTPRoute
+metadata:
+  name: linkerd-route
+  namespace: default
+  generation: 1
+spec:
+  parentRefs:
+  - name: root-service
+    kind: Service
+    group: core
+    port: 80
+  rules:
+  - backendRefs:
+    - name: stable-service
+      port: 80
+      weight: 100
+    - name: canary-service
+      port: 80
+      weight: 0
+status:
+  parents:
+  - parentRef:
+      name: root-service
+      kind: Service
+      group: core
+    controllerName: linkerd.io/policy-controller
+    conditions:
+    - type: Accepted
+      status: "True"
+      observedGeneration: 1
+`
+
+func newRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "stable-service",
+					CanaryService: "canary-service",
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						Linkerd: &v1alpha1.LinkerdTrafficRouting{
+							HTTPRoutes: []string{"linkerd-route"},
+						},
+						ManagedRoutes: []v1alpha1.MangedRoutes{{Name: "header-route"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newReconciler(ro *v1alpha1.Rollout) *Reconciler {
+	client := testutil.NewFakeDynamicClient(unstructuredutil.StrToUnstructuredUnsafe(httpRoute))
+	return NewReconciler(&ReconcilerConfig{
+		Rollout:  ro,
+		Client:   NewDynamicClient(client, ro.Namespace),
+		Recorder: record.NewFakeEventRecorder(),
+	})
+}
+
+func getRules(t *testing.T, r *Reconciler) (*unstructured.Unstructured, []any) {
+	t.Helper()
+	obj, err := r.Client.Get(context.TODO(), "linkerd-route", metav1.GetOptions{})
+	assert.NoError(t, err)
+	rules, _, _ := unstructured.NestedSlice(obj.Object, "spec", "rules")
+	return obj, rules
+}
+
+func TestGetHTTPRouteGVR(t *testing.T) {
+	gvr := GetHTTPRouteGVR()
+	assert.Equal(t, "policy.linkerd.io", gvr.Group)
+	assert.Equal(t, "v1beta3", gvr.Version)
+	assert.Equal(t, "httproutes", gvr.Resource)
+
+	defaults.SetLinkerdHTTPRouteVersion("v1beta2")
+	defer defaults.SetLinkerdHTTPRouteVersion(defaults.DefaultLinkerdHTTPRouteVersion)
+	assert.Equal(t, "v1beta2", GetHTTPRouteGVR().Version)
+}
+
+func TestType(t *testing.T) {
+	r := newReconciler(newRollout())
+	assert.Equal(t, Type, r.Type())
+	assert.NoError(t, r.UpdateHash("canary", "stable"))
+}
+
+func TestSetWeight(t *testing.T) {
+	r := newReconciler(newRollout())
+	assert.NoError(t, r.SetWeight(25))
+	_, rules := getRules(t, r)
+	backendRefs, _, _ := unstructured.NestedSlice(rules[0].(map[string]any), "backendRefs")
+	assert.Equal(t, int64(75), backendRefs[0].(map[string]any)["weight"])
+	assert.Equal(t, int64(25), backendRefs[1].(map[string]any)["weight"])
+}
+
+func TestSetHeaderRoute(t *testing.T) {
+	r := newReconciler(newRollout())
+	assert.NoError(t, r.SetHeaderRoute(&v1alpha1.SetHeaderRoute{
+		Name:  "header-route",
+		Match: []v1alpha1.HeaderRoutingMatch{{HeaderName: "x-canary", HeaderValue: &v1alpha1.StringMatch{Exact: "true"}}},
+	}))
+	obj, rules := getRules(t, r)
+	assert.Len(t, rules, 2)
+	assert.Equal(t, []string{"header-route"}, trafficrouting.GetManagedRouteNames(obj))
+	assert.Equal(t, []any{map[string]any{
+		"headers": []any{map[string]any{"name": "x-canary", "type": "Exact", "value": "true"}},
+	}}, rules[0].(map[string]any)["matches"])
+
+	assert.NoError(t, r.RemoveManagedRoutes())
+	_, rules = getRules(t, r)
+	assert.Len(t, rules, 1)
+}
+
+func TestSetMirrorRoute(t *testing.T) {
+	r := newReconciler(newRollout())
+	assert.NoError(t, r.SetMirrorRoute(&v1alpha1.SetMirrorRoute{
+		Name:  "mirror-route",
+		Match: []v1alpha1.RouteMatch{{Path: &v1alpha1.StringMatch{Prefix: "/"}}},
+	}))
+	_, rules := getRules(t, r)
+	assert.Len(t, rules, 1)
+}
+
+func TestVerifyWeight(t *testing.T) {
+	r := newReconciler(newRollout())
+	verified, err := r.VerifyWeight(0)
+	assert.NoError(t, err)
+	assert.True(t, *verified)
+}
@@ -0,0 +1,99 @@
+package trafficrouting
+
+import (
+	"encoding/json"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// ManagedRoutesAnnotation is set by traffic routers whose route lists do not support naming individual
+// routes (e.g. Contour HTTPProxy routes, HTTPRoute rules). It records, in order, the names of the
+// managed routes which are kept at the head of the route list of the annotated object.
+const ManagedRoutesAnnotation = "rollouts.argoproj.io/managed-routes"
+
+// GetManagedRouteNames returns the names of the managed routes recorded on the object. The i-th name
+// belongs to the i-th route of the object's route list.
+func GetManagedRouteNames(obj metav1.Object) []string {
+	value, ok := obj.GetAnnotations()[ManagedRoutesAnnotation]
+	if !ok || value == "" {
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal([]byte(value), &names); err != nil {
+		return nil
+	}
+	return names
+}
+
+// SetManagedRouteNames records the names of the managed routes on the object, removing the
+// annotation when there are none left
+func SetManagedRouteNames(obj metav1.Object, names []string) {
+	annotations := obj.GetAnnotations()
+	if len(names) == 0 {
+		if _, ok := annotations[ManagedRoutesAnnotation]; ok {
+			delete(annotations, ManagedRoutesAnnotation)
+			obj.SetAnnotations(annotations)
+		}
+		return
+	}
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	value, _ := json.Marshal(names)
+	annotations[ManagedRoutesAnnotation] = string(value)
+	obj.SetAnnotations(annotations)
+}
+
+// ReplaceManagedRoutes replaces every route recorded under name with newRoutes. Managed routes stay at the head of
+// the route list, ordered by their precedence in spec.strategy.canary.trafficRouting.managedRoutes, so that they are
+// evaluated before the user defined routes. Passing no newRoutes removes the managed route. The updated route list
+// and managed route names are returned.
+func ReplaceManagedRoutes(routes []any, names []string, name string, newRoutes []any, managedRoutes []v1alpha1.MangedRoutes) ([]any, []string) {
+	if len(names) > len(routes) {
+		names = names[:len(routes)]
+	}
+	type managedRoute struct {
+		name  string
+		route any
+	}
+	managed := []managedRoute{}
+	for i, routeName := range names {
+		if routeName != name {
+			managed = append(managed, managedRoute{name: routeName, route: routes[i]})
+		}
+	}
+	for _, route := range newRoutes {
+		managed = append(managed, managedRoute{name: name, route: route})
+	}
+	precedence := func(routeName string) int {
+		for i, managedRoute := range managedRoutes {
+			if managedRoute.Name == routeName {
+				return i
+			}
+		}
+		return len(managedRoutes)
+	}
+	sort.SliceStable(managed, func(i, j int) bool {
+		return precedence(managed[i].name) < precedence(managed[j].name)
+	})
+
+	newNames := make([]string, 0, len(managed))
+	newRouteList := make([]any, 0, len(managed)+len(routes)-len(names))
+	for _, route := range managed {
+		newNames = append(newNames, route.name)
+		newRouteList = append(newRouteList, route.route)
+	}
+	newRouteList = append(newRouteList, routes[len(names):]...)
+	return newRouteList, newNames
+}
+
+// RemoveAllManagedRoutes returns the route list without the managed routes recorded in names
+func RemoveAllManagedRoutes(routes []any, names []string) []any {
+	if len(names) > len(routes) {
+		return []any{}
+	}
+	return routes[len(names):]
+}
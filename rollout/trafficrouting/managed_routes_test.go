@@ -0,0 +1,54 @@
+package trafficrouting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestManagedRouteNames(t *testing.T) {
+	obj := &metav1.ObjectMeta{}
+	assert.Nil(t, GetManagedRouteNames(obj))
+
+	SetManagedRouteNames(obj, []string{"header", "mirror"})
+	assert.Equal(t, `["header","mirror"]`, obj.Annotations[ManagedRoutesAnnotation])
+	assert.Equal(t, []string{"header", "mirror"}, GetManagedRouteNames(obj))
+
+	SetManagedRouteNames(obj, nil)
+	_, ok := obj.Annotations[ManagedRoutesAnnotation]
+	assert.False(t, ok)
+
+	obj.Annotations = map[string]string{ManagedRoutesAnnotation: "not-json"}
+	assert.Nil(t, GetManagedRouteNames(obj))
+}
+
+func TestReplaceManagedRoutes(t *testing.T) {
+	managedRoutes := []v1alpha1.MangedRoutes{{Name: "first"}, {Name: "second"}}
+	routes := []any{"primary"}
+
+	// adding a lower precedence route first keeps it ahead of the user defined routes
+	routes, names := ReplaceManagedRoutes(routes, nil, "second", []any{"second-a", "second-b"}, managedRoutes)
+	assert.Equal(t, []any{"second-a", "second-b", "primary"}, routes)
+	assert.Equal(t, []string{"second", "second"}, names)
+
+	// higher precedence routes are placed ahead of lower precedence routes
+	routes, names = ReplaceManagedRoutes(routes, names, "first", []any{"first-a"}, managedRoutes)
+	assert.Equal(t, []any{"first-a", "second-a", "second-b", "primary"}, routes)
+	assert.Equal(t, []string{"first", "second", "second"}, names)
+
+	// replacing a route replaces every route recorded under the name
+	routes, names = ReplaceManagedRoutes(routes, names, "second", []any{"second-c"}, managedRoutes)
+	assert.Equal(t, []any{"first-a", "second-c", "primary"}, routes)
+	assert.Equal(t, []string{"first", "second"}, names)
+
+	// no new routes removes the managed route
+	routes, names = ReplaceManagedRoutes(routes, names, "first", nil, managedRoutes)
+	assert.Equal(t, []any{"second-c", "primary"}, routes)
+	assert.Equal(t, []string{"second"}, names)
+
+	assert.Equal(t, []any{"primary"}, RemoveAllManagedRoutes(routes, names))
+	assert.Equal(t, []any{}, RemoveAllManagedRoutes(routes, []string{"a", "b", "c"}))
+}
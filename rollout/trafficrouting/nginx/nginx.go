@@ -135,6 +135,8 @@ func (r *Reconciler) buildCanaryIngress(stableIngress *networkingv1.Ingress, nam
 		desiredCanaryIngress.Annotations[k] = v
 	}
 
+	r.setStickinessAnnotations(desiredCanaryIngress.Annotations, annotationPrefix)
+
 	// Always set `canary` and `canary-weight` - `canary-by-header` and `canary-by-cookie`, if set,  will always take precedence
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary", annotationPrefix)] = "true"
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary-weight", annotationPrefix)] = fmt.Sprintf("%d", desiredWeight)
@@ -146,6 +148,21 @@ func (r *Reconciler) buildCanaryIngress(stableIngress *networkingv1.Ingress, nam
 	return ingressutil.NewIngress(desiredCanaryIngress), nil
 }
 
+// setStickinessAnnotations enables the cookie affinity of the canary ingress, so that ingress-nginx sets the stickiness
+// cookie on the responses of the canary and keeps sending the users holding it to the canary
+func (r *Reconciler) setStickinessAnnotations(annotations map[string]string, annotationPrefix string) {
+	stickiness := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness
+	if stickiness == nil {
+		return
+	}
+	annotations[fmt.Sprintf("%s/affinity", annotationPrefix)] = "cookie"
+	annotations[fmt.Sprintf("%s/affinity-canary-behavior", annotationPrefix)] = "sticky"
+	annotations[fmt.Sprintf("%s/session-cookie-name", annotationPrefix)] = defaults.GetStickinessCookieNameOrDefault(r.cfg.Rollout)
+	if stickiness.DurationSeconds > 0 {
+		annotations[fmt.Sprintf("%s/session-cookie-max-age", annotationPrefix)] = fmt.Sprintf("%d", stickiness.DurationSeconds)
+	}
+}
+
 func (r *Reconciler) buildLegacyCanaryIngress(stableIngress *extensionsv1beta1.Ingress, name string, desiredWeight int32) (*ingressutil.Ingress, error) {
 	stableIngressName := r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Nginx.StableIngress
 	stableServiceName := r.cfg.Rollout.Spec.Strategy.Canary.StableService
@@ -221,6 +238,8 @@ func (r *Reconciler) buildLegacyCanaryIngress(stableIngress *extensionsv1beta1.I
 		desiredCanaryIngress.Annotations[k] = v
 	}
 
+	r.setStickinessAnnotations(desiredCanaryIngress.Annotations, annotationPrefix)
+
 	// Always set `canary` and `canary-weight` - `canary-by-header` and `canary-by-cookie`, if set,  will always take precedence
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary", annotationPrefix)] = "true"
 	desiredCanaryIngress.Annotations[fmt.Sprintf("%s/canary-weight", annotationPrefix)] = fmt.Sprintf("%d", desiredWeight)
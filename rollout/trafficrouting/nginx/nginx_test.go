@@ -614,6 +614,41 @@ func TestCanaryIngressAdditionalAnnotationsNewIngress(t *testing.T) {
 	}
 }
 
+func TestCanaryIngressStickiness(t *testing.T) {
+	tests := generateMultiIngressTestData()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, ing := range test.ingresses {
+				r := Reconciler{
+					cfg: ReconcilerConfig{
+						Rollout: fakeRollout(stableService, canaryService, test.singleIngress, test.multiIngress),
+					},
+				}
+				r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{}
+
+				stable := networkingIngress(StableIngress, 80, stableService)
+				desiredCanaryIngress, err := r.canaryIngress(ingressutil.NewIngress(stable), ingressutil.GetCanaryIngressName(r.cfg.Rollout.GetName(), ing), 15)
+				assert.Nil(t, err, "No error returned when calling canaryIngress")
+				annotations := desiredCanaryIngress.GetAnnotations()
+				assert.Equal(t, "cookie", annotations["nginx.ingress.kubernetes.io/affinity"], "affinity annotation set to cookie")
+				assert.Equal(t, "sticky", annotations["nginx.ingress.kubernetes.io/affinity-canary-behavior"], "affinity-canary-behavior annotation set to sticky")
+				assert.Equal(t, "argo-rollouts-sticky", annotations["nginx.ingress.kubernetes.io/session-cookie-name"], "session-cookie-name annotation set to the default cookie")
+				assert.NotContains(t, annotations, "nginx.ingress.kubernetes.io/session-cookie-max-age", "session cookie by default")
+				assert.Equal(t, "15", annotations["nginx.ingress.kubernetes.io/canary-weight"], "canary-weight annotation set to expected value")
+
+				r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness.CookieName = "session-version"
+				r.cfg.Rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness.DurationSeconds = 3600
+				legacyStable := extensionsIngress(ing, 80, stableService)
+				desiredCanaryIngress, err = r.canaryIngress(ingressutil.NewLegacyIngress(legacyStable), ingressutil.GetCanaryIngressName(r.cfg.Rollout.GetName(), ing), 15)
+				assert.Nil(t, err, "No error returned when calling canaryIngress")
+				annotations = desiredCanaryIngress.GetAnnotations()
+				assert.Equal(t, "session-version", annotations["nginx.ingress.kubernetes.io/session-cookie-name"], "session-cookie-name annotation set to the configured cookie")
+				assert.Equal(t, "3600", annotations["nginx.ingress.kubernetes.io/session-cookie-max-age"], "session-cookie-max-age annotation set to the duration")
+			}
+		})
+	}
+}
+
 func TestCanaryIngressRetainCurrentAnnotations(t *testing.T) {
 	tests := generateMultiIngressTestData()
 	for _, test := range tests {
@@ -0,0 +1,77 @@
+package trafficrouting
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
+)
+
+// tracedReconciler records a span for each call to a traffic routing reconciler
+type tracedReconciler struct {
+	ctx        context.Context
+	reconciler TrafficRoutingReconciler
+}
+
+// NewTracedReconciler wraps a traffic routing reconciler to record its calls as children of the span of the context
+func NewTracedReconciler(ctx context.Context, reconciler TrafficRoutingReconciler) TrafficRoutingReconciler {
+	return &tracedReconciler{ctx: ctx, reconciler: reconciler}
+}
+
+func (r *tracedReconciler) startSpan(operation string, attrs ...attribute.KeyValue) trace.Span {
+	attrs = append(attrs, attribute.String("trafficrouting.type", r.reconciler.Type()))
+	_, span := tracing.StartSpan(r.ctx, "trafficrouting."+operation, attrs...)
+	return span
+}
+
+func (r *tracedReconciler) UpdateHash(canaryHash, stableHash string, additionalDestinations ...v1alpha1.WeightDestination) error {
+	span := r.startSpan("UpdateHash", attribute.String("trafficrouting.canary_hash", canaryHash), attribute.String("trafficrouting.stable_hash", stableHash))
+	err := r.reconciler.UpdateHash(canaryHash, stableHash, additionalDestinations...)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracedReconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) error {
+	span := r.startSpan("SetWeight", attribute.Int("trafficrouting.desired_weight", int(desiredWeight)))
+	err := r.reconciler.SetWeight(desiredWeight, additionalDestinations...)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracedReconciler) SetHeaderRoute(setHeaderRoute *v1alpha1.SetHeaderRoute) error {
+	span := r.startSpan("SetHeaderRoute")
+	err := r.reconciler.SetHeaderRoute(setHeaderRoute)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracedReconciler) SetMirrorRoute(setMirrorRoute *v1alpha1.SetMirrorRoute) error {
+	span := r.startSpan("SetMirrorRoute")
+	err := r.reconciler.SetMirrorRoute(setMirrorRoute)
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracedReconciler) VerifyWeight(desiredWeight int32, additionalDestinations ...v1alpha1.WeightDestination) (*bool, error) {
+	span := r.startSpan("VerifyWeight", attribute.Int("trafficrouting.desired_weight", int(desiredWeight)))
+	verified, err := r.reconciler.VerifyWeight(desiredWeight, additionalDestinations...)
+	if verified != nil {
+		span.SetAttributes(attribute.Bool("trafficrouting.weight_verified", *verified))
+	}
+	tracing.EndSpan(span, err)
+	return verified, err
+}
+
+func (r *tracedReconciler) RemoveManagedRoutes() error {
+	span := r.startSpan("RemoveManagedRoutes")
+	err := r.reconciler.RemoveManagedRoutes()
+	tracing.EndSpan(span, err)
+	return err
+}
+
+func (r *tracedReconciler) Type() string {
+	return r.reconciler.Type()
+}
@@ -0,0 +1,47 @@
+package trafficrouting
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/argoproj/argo-rollouts/rollout/mocks"
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+	"github.com/argoproj/argo-rollouts/utils/tracing"
+)
+
+func TestTracedReconciler(t *testing.T) {
+	exporter := testutil.RecordSpans(t)
+	reconciler := &mocks.TrafficRoutingReconciler{}
+	reconciler.On("Type").Return("Istio")
+	reconciler.On("UpdateHash", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	reconciler.On("SetWeight", mock.Anything, mock.Anything).Return(errors.New("intentional error"))
+	verified := true
+	reconciler.On("VerifyWeight", mock.Anything, mock.Anything).Return(&verified, nil)
+
+	ctx, span := tracing.StartSpan(context.TODO(), "rollout.reconcile")
+	traced := NewTracedReconciler(ctx, reconciler)
+	assert.Equal(t, "Istio", traced.Type())
+	assert.NoError(t, traced.UpdateHash("canary", "stable"))
+	assert.EqualError(t, traced.SetWeight(10), "intentional error")
+	weightVerified, err := traced.VerifyWeight(10)
+	assert.NoError(t, err)
+	assert.True(t, *weightVerified)
+	span.End()
+
+	spans := exporter.GetSpans()
+	require.Equal(t, []string{"trafficrouting.UpdateHash", "trafficrouting.SetWeight", "trafficrouting.VerifyWeight", "rollout.reconcile"}, testutil.SpanNames(spans))
+	for _, s := range spans[:3] {
+		assert.Equal(t, spans[3].SpanContext.SpanID(), s.Parent.SpanID())
+		assert.Contains(t, s.Attributes, attribute.String("trafficrouting.type", "Istio"))
+	}
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+	assert.Contains(t, spans[1].Attributes, attribute.Int("trafficrouting.desired_weight", 10))
+	assert.Contains(t, spans[2].Attributes, attribute.Bool("trafficrouting.weight_verified", true))
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/client-go/dynamic"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/record"
 )
@@ -128,7 +129,22 @@ func (r *Reconciler) SetWeight(desiredWeight int32, additionalDestinations ...v1
 	if err != nil {
 		return err
 	}
-	_, err = r.Client.Update(ctx, traefikService, metav1.UpdateOptions{})
+	fields := [][]string{{"spec", "weighted", "services"}}
+	if stickiness := rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness; stickiness != nil {
+		// Traefik sets the cookie on the first response so that the user keeps being sent to the same service
+		cookie := map[string]any{
+			"name": defaults.GetStickinessCookieNameOrDefault(rollout),
+		}
+		if stickiness.DurationSeconds > 0 {
+			cookie["maxAge"] = stickiness.DurationSeconds
+		}
+		err = unstructured.SetNestedMap(traefikService.Object, cookie, "spec", "weighted", "sticky", "cookie")
+		if err != nil {
+			return err
+		}
+		fields = append(fields, []string{"spec", "weighted", "sticky", "cookie"})
+	}
+	_, err = apply.Update(ctx, r.Client, traefikService, fields...)
 	if err != nil {
 		msg := fmt.Sprintf("Error updating traefik service %q: %s", traefikService.GetName(), err)
 		r.sendWarningEvent(TraefikServiceUpdateError, msg)
@@ -107,6 +107,34 @@ func TestSetWeight(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, int64(70), stableServiceWeight)
 		assert.Equal(t, int64(30), canaryServiceWeight)
+		_, isFound, err = unstructured.NestedMap(mocks.TraefikServiceObj.Object, "spec", "weighted", "sticky")
+		assert.NoError(t, err)
+		assert.False(t, isFound)
+	})
+	t.Run("SetWeightWithStickiness", func(t *testing.T) {
+		// Given
+		mocks.TraefikServiceObj = toUnstructured(t, traefikService)
+		defer func() { mocks.TraefikServiceObj = toUnstructured(t, traefikService) }()
+		rollout := newRollout(stableServiceName, canaryServiceName, traefikServiceName)
+		rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness = &v1alpha1.TrafficStickiness{
+			CookieName:      "session-version",
+			DurationSeconds: 3600,
+		}
+		cfg := ReconcilerConfig{
+			Rollout: rollout,
+			Client:  &mocks.FakeClient{},
+		}
+		r := NewReconciler(&cfg)
+
+		// When
+		err := r.SetWeight(30)
+
+		// Then
+		assert.NoError(t, err)
+		cookie, isFound, err := unstructured.NestedMap(mocks.TraefikServiceObj.Object, "spec", "weighted", "sticky", "cookie")
+		assert.NoError(t, err)
+		assert.True(t, isFound)
+		assert.Equal(t, map[string]any{"name": "session-version", "maxAge": int64(3600)}, cookie)
 	})
 	t.Run("SetWeightWithError", func(t *testing.T) {
 		// Given
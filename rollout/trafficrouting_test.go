@@ -15,6 +15,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/dynamic/dynamicinformer"
@@ -26,7 +28,11 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/alb"
 	apisixMocks "github.com/argoproj/argo-rollouts/rollout/trafficrouting/apisix/mocks"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/appmesh"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/contour"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/dns"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/kong"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/linkerd"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/nginx"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/smi"
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik"
@@ -104,6 +110,27 @@ func TestReconcileTrafficRoutingSetWeightErr(t *testing.T) {
 	f.runExpectError(getKey(ro, t), true)
 }
 
+// verify the calls to the traffic routers are traced as part of the reconciliation
+func TestReconcileTrafficRoutingTracing(t *testing.T) {
+	exporter := testutil.RecordSpans(t)
+	f, ro := newTrafficWeightFixture(t)
+	defer f.Close()
+	f.fakeTrafficRouting = newUnmockedFakeTrafficRoutingReconciler()
+	f.fakeTrafficRouting.On("UpdateHash", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	f.fakeTrafficRouting.On("SetWeight", mock.Anything, mock.Anything).Return(errors.New("Error message"))
+	f.runExpectError(getKey(ro, t), true)
+
+	spans := exporter.GetSpans()
+	assert.Equal(t, []string{"trafficrouting.UpdateHash", "trafficrouting.SetWeight", "rollout.reconcile"}, testutil.SpanNames(spans))
+	reconcileSpan := spans[2]
+	assert.Contains(t, reconcileSpan.Attributes, attribute.String("rollout.name", ro.Name))
+	assert.Equal(t, codes.Error, reconcileSpan.Status.Code)
+	for _, span := range spans[:2] {
+		assert.Equal(t, reconcileSpan.SpanContext.SpanID(), span.Parent.SpanID())
+	}
+	assert.Equal(t, codes.Error, spans[1].Status.Code)
+}
+
 // verify error is not returned when VerifyWeight returns error (so that we can continue reconciling)
 func TestReconcileTrafficRoutingVerifyWeightErr(t *testing.T) {
 	f, ro := newTrafficWeightFixture(t)
@@ -750,6 +777,92 @@ func TestNewTrafficRoutingReconciler(t *testing.T) {
 			assert.Equal(t, apisix.Type, networkReconciler.Type())
 		}
 	}
+	{
+		tsController := Controller{
+			reconcilerBase: reconcilerBase{
+				dynamicclientset: testutil.NewFakeDynamicClient(),
+			},
+		}
+		r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+		r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			Contour: &v1alpha1.ContourTrafficRouting{
+				HTTPProxies: []string{"contour-proxy"},
+			},
+		}
+		roCtx := &rolloutContext{
+			rollout: r,
+			log:     logutil.WithRollout(r),
+		}
+		networkReconcilerList, err := tsController.NewTrafficRoutingReconciler(roCtx)
+		assert.Nil(t, err)
+		assert.Len(t, networkReconcilerList, 1)
+		assert.Equal(t, contour.Type, networkReconcilerList[0].Type())
+	}
+	{
+		tsController := Controller{
+			reconcilerBase: reconcilerBase{
+				dynamicclientset: testutil.NewFakeDynamicClient(),
+			},
+		}
+		r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+		r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			Kong: &v1alpha1.KongTrafficRouting{
+				HTTPRoutes: []string{"kong-route"},
+			},
+		}
+		roCtx := &rolloutContext{
+			rollout: r,
+			log:     logutil.WithRollout(r),
+		}
+		networkReconcilerList, err := tsController.NewTrafficRoutingReconciler(roCtx)
+		assert.Nil(t, err)
+		assert.Len(t, networkReconcilerList, 1)
+		assert.Equal(t, kong.Type, networkReconcilerList[0].Type())
+	}
+	{
+		tsController := Controller{
+			reconcilerBase: reconcilerBase{
+				dynamicclientset: testutil.NewFakeDynamicClient(),
+			},
+		}
+		r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+		r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			Linkerd: &v1alpha1.LinkerdTrafficRouting{
+				HTTPRoutes: []string{"linkerd-route"},
+			},
+		}
+		roCtx := &rolloutContext{
+			rollout: r,
+			log:     logutil.WithRollout(r),
+		}
+		networkReconcilerList, err := tsController.NewTrafficRoutingReconciler(roCtx)
+		assert.Nil(t, err)
+		assert.Len(t, networkReconcilerList, 1)
+		assert.Equal(t, linkerd.Type, networkReconcilerList[0].Type())
+	}
+	{
+		tsController := Controller{
+			reconcilerBase: reconcilerBase{
+				dynamicclientset: testutil.NewFakeDynamicClient(),
+			},
+		}
+		r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+		r.Spec.Strategy.Canary.TrafficRouting = &v1alpha1.RolloutTrafficRouting{
+			DNS: &v1alpha1.DNSTrafficRouting{
+				DNSEndpoint:         "records",
+				StableSetIdentifier: "stable",
+				CanarySetIdentifier: "canary",
+			},
+		}
+		roCtx := &rolloutContext{
+			rollout: r,
+			log:     logutil.WithRollout(r),
+		}
+		networkReconcilerList, err := tsController.NewTrafficRoutingReconciler(roCtx)
+		assert.Nil(t, err)
+		assert.Len(t, networkReconcilerList, 1)
+		assert.Equal(t, dns.Type, networkReconcilerList[0].Type())
+	}
 	{
 		// (2) Multiple Reconcilers (Nginx + SMI)
 		tsController := Controller{}
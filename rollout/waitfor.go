@@ -0,0 +1,113 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/evaluate"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// waitForCheckTime is how often the condition of a waitFor step is evaluated. The resources are read with a GET
+	// each time the condition is evaluated, not watched.
+	waitForCheckTime = 10 * time.Second
+
+	waitForConditionMetReason = "WaitForConditionMet"
+	waitForTimedOutReason     = "WaitForTimedOut"
+)
+
+// reconcileWaitForStep evaluates the condition of the current waitFor step, and records the result in
+// c.newStatus.Canary.WaitFor. A step which times out aborts or pauses the update, depending on its onTimeout policy.
+func (c *rolloutContext) reconcileWaitForStep() {
+	currentStep, currentStepIndex := replicasetutil.GetCurrentCanaryStep(c.rollout)
+	if currentStep == nil || currentStep.WaitFor == nil {
+		c.newStatus.Canary.WaitFor = nil
+		return
+	}
+	now := timeutil.MetaNow()
+	status := c.rollout.Status.Canary.WaitFor.DeepCopy()
+	if status == nil || status.Index != *currentStepIndex {
+		status = &v1alpha1.WaitForStatus{
+			Index:     *currentStepIndex,
+			StartedAt: now,
+		}
+	}
+	c.newStatus.Canary.WaitFor = status
+	if status.Met || status.TimedOut {
+		return
+	}
+
+	waitFor := currentStep.WaitFor
+	met, message := c.evaluateWaitFor(*waitFor)
+	if met {
+		status.Met = true
+		status.Message = ""
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: waitForConditionMetReason, EventAction: record.ActionProgress}, "Condition of waitFor step %d on %s %s is met", *currentStepIndex+1, waitFor.Kind, waitFor.Name)
+		return
+	}
+	status.Message = message
+
+	requeueAfter := waitForCheckTime
+	if waitFor.Timeout != "" {
+		timeout, err := waitFor.Timeout.Duration()
+		if err != nil {
+			status.Message = fmt.Sprintf("invalid timeout: %v", err)
+			return
+		}
+		remaining := status.StartedAt.Add(timeout).Sub(now.Time)
+		if remaining <= 0 {
+			status.TimedOut = true
+			c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: waitForTimedOutReason, EventAction: record.ActionProgress}, "waitFor step %d timed out after %s: %s", *currentStepIndex+1, waitFor.Timeout, message)
+			if waitFor.OnTimeout == v1alpha1.WaitForTimeoutPolicyPause {
+				c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonWaitForTimeout)
+			} else {
+				c.pauseContext.AddAbortWithCause(abortCauseWaitForTimedOut, fmt.Sprintf("waitFor step %d timed out: %s", *currentStepIndex+1, message))
+			}
+			return
+		}
+		if remaining < requeueAfter {
+			requeueAfter = remaining
+		}
+	}
+	c.enqueueRolloutAfter(c.rollout, requeueAfter)
+}
+
+// evaluateWaitFor evaluates the condition of a waitFor step, and returns why it is not met
+func (c *rolloutContext) evaluateWaitFor(waitFor v1alpha1.WaitForStep) (bool, string) {
+	namespace := waitFor.Namespace
+	if namespace == "" {
+		namespace = c.rollout.Namespace
+	}
+	gvk := schema.FromAPIVersionAndKind(waitFor.APIVersion, waitFor.Kind)
+	obj, err := c.refResolver.GetResource(gvk, namespace, waitFor.Name)
+	if err != nil {
+		return false, err.Error()
+	}
+	met, message, err := evaluate.EvalWaitFor(waitFor, obj.Object)
+	if err != nil {
+		return false, fmt.Sprintf("failed to evaluate condition: %v", err)
+	}
+	return met, message
+}
+
+// completedWaitForStep returns whether the condition of the current waitFor step is met, or whether the step timed
+// out with the Pause policy and the rollout was promoted since.
+func (c *rolloutContext) completedWaitForStep() bool {
+	status := c.newStatus.Canary.WaitFor
+	if status == nil {
+		return false
+	}
+	if status.Met {
+		return true
+	}
+	if !status.TimedOut || c.pauseContext.HasAddPause() {
+		return false
+	}
+	return c.rollout.Status.ControllerPause && getPauseCondition(c.rollout, v1alpha1.PauseReasonWaitForTimeout) == nil
+}
@@ -0,0 +1,228 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+func newCertificate(ready string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata": map[string]any{
+			"name":      "guestbook-tls",
+			"namespace": metav1.NamespaceDefault,
+		},
+		"status": map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Ready", "status": ready},
+			},
+		},
+	}}
+}
+
+func newWaitForRolloutContext(waitFor v1alpha1.WaitForStep, resources ...*unstructured.Unstructured) (*rolloutContext, *[]time.Duration) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32(10)}, {WaitFor: &waitFor}}
+	ro := newCanaryRollout("foo", 1, nil, steps, pointer.Int32(1), intstr.FromInt(1), intstr.FromInt(0))
+	logCtx := logutil.WithRollout(ro)
+	var requeues []time.Duration
+	roCtx := &rolloutContext{
+		rollout: ro,
+		log:     logCtx,
+		pauseContext: &pauseContext{
+			rollout: ro,
+			log:     logCtx,
+		},
+		reconcilerBase: reconcilerBase{
+			argoprojclientset: fake.NewSimpleClientset(ro),
+			recorder:          record.NewFakeEventRecorder(),
+			refResolver:       &FakeWorkloadRefResolver{resources: resources},
+			enqueueRolloutAfter: func(obj any, duration time.Duration) {
+				requeues = append(requeues, duration)
+			},
+		},
+	}
+	return roCtx, &requeues
+}
+
+func newCertificateWaitFor() v1alpha1.WaitForStep {
+	return v1alpha1.WaitForStep{
+		APIVersion: "cert-manager.io/v1",
+		Kind:       "Certificate",
+		Name:       "guestbook-tls",
+		Condition:  `object.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`,
+	}
+}
+
+func TestReconcileWaitForStep(t *testing.T) {
+	t.Run("ConditionMet", func(t *testing.T) {
+		roCtx, _ := newWaitForRolloutContext(newCertificateWaitFor(), newCertificate("True"))
+		roCtx.reconcileWaitForStep()
+		status := roCtx.newStatus.Canary.WaitFor
+		assert.Equal(t, int32(1), status.Index)
+		assert.True(t, status.Met)
+		assert.True(t, roCtx.completedWaitForStep())
+	})
+	t.Run("ConditionNotMet", func(t *testing.T) {
+		roCtx, requeues := newWaitForRolloutContext(newCertificateWaitFor(), newCertificate("False"))
+		roCtx.reconcileWaitForStep()
+		status := roCtx.newStatus.Canary.WaitFor
+		assert.False(t, status.Met)
+		assert.Contains(t, status.Message, "is false")
+		assert.Equal(t, []time.Duration{waitForCheckTime}, *requeues)
+		assert.False(t, roCtx.completedWaitForStep())
+	})
+	t.Run("ResourceNotFound", func(t *testing.T) {
+		roCtx, _ := newWaitForRolloutContext(newCertificateWaitFor())
+		roCtx.reconcileWaitForStep()
+		assert.Contains(t, roCtx.newStatus.Canary.WaitFor.Message, "not found")
+		assert.False(t, roCtx.completedWaitForStep())
+	})
+	t.Run("JSONPath", func(t *testing.T) {
+		waitFor := newCertificateWaitFor()
+		waitFor.Condition = ""
+		waitFor.JSONPath = `{.status.conditions[?(@.type=="Ready")].status}`
+		waitFor.Value = "True"
+		roCtx, _ := newWaitForRolloutContext(waitFor, newCertificate("True"))
+		roCtx.reconcileWaitForStep()
+		assert.True(t, roCtx.newStatus.Canary.WaitFor.Met)
+	})
+	t.Run("TimeoutAborts", func(t *testing.T) {
+		waitFor := newCertificateWaitFor()
+		waitFor.Timeout = "1m"
+		roCtx, _ := newWaitForRolloutContext(waitFor, newCertificate("False"))
+		roCtx.rollout.Status.Canary.WaitFor = &v1alpha1.WaitForStatus{
+			Index:     1,
+			StartedAt: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		}
+		roCtx.reconcileWaitForStep()
+		assert.True(t, roCtx.newStatus.Canary.WaitFor.TimedOut)
+		assert.True(t, roCtx.pauseContext.IsAborted())
+		assert.Contains(t, roCtx.pauseContext.abortMessage, "waitFor step 2 timed out")
+		assert.False(t, roCtx.completedWaitForStep())
+	})
+	t.Run("TimeoutRequeue", func(t *testing.T) {
+		waitFor := newCertificateWaitFor()
+		waitFor.Timeout = "1m"
+		roCtx, requeues := newWaitForRolloutContext(waitFor, newCertificate("False"))
+		roCtx.rollout.Status.Canary.WaitFor = &v1alpha1.WaitForStatus{
+			Index:     1,
+			StartedAt: metav1.NewTime(time.Now().Add(-55 * time.Second)),
+		}
+		roCtx.reconcileWaitForStep()
+		assert.False(t, roCtx.newStatus.Canary.WaitFor.TimedOut)
+		assert.Len(t, *requeues, 1)
+		assert.Less(t, (*requeues)[0], waitForCheckTime)
+	})
+	t.Run("TimeoutPauses", func(t *testing.T) {
+		waitFor := newCertificateWaitFor()
+		waitFor.Timeout = "1m"
+		waitFor.OnTimeout = v1alpha1.WaitForTimeoutPolicyPause
+		roCtx, _ := newWaitForRolloutContext(waitFor, newCertificate("False"))
+		roCtx.rollout.Status.ControllerPause = true
+		roCtx.rollout.Status.Canary.WaitFor = &v1alpha1.WaitForStatus{
+			Index:     1,
+			StartedAt: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		}
+		roCtx.reconcileWaitForStep()
+		assert.True(t, roCtx.newStatus.Canary.WaitFor.TimedOut)
+		assert.False(t, roCtx.pauseContext.IsAborted())
+		assert.Equal(t, []v1alpha1.PauseReason{v1alpha1.PauseReasonWaitForTimeout}, roCtx.pauseContext.addPauseReasons)
+		assert.False(t, roCtx.completedWaitForStep())
+	})
+	t.Run("TimedOutStepCompletesOnPromote", func(t *testing.T) {
+		waitFor := newCertificateWaitFor()
+		waitFor.Timeout = "1m"
+		waitFor.OnTimeout = v1alpha1.WaitForTimeoutPolicyPause
+		roCtx, _ := newWaitForRolloutContext(waitFor, newCertificate("False"))
+		roCtx.rollout.Status.ControllerPause = true
+		roCtx.rollout.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonWaitForTimeout}}
+		roCtx.rollout.Status.Canary.WaitFor = &v1alpha1.WaitForStatus{
+			Index:     1,
+			StartedAt: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+			TimedOut:  true,
+		}
+		roCtx.reconcileWaitForStep()
+		assert.False(t, roCtx.completedWaitForStep())
+
+		// promoting the rollout removes the pause condition
+		roCtx.rollout.Status.PauseConditions = nil
+		assert.True(t, roCtx.completedWaitForStep())
+	})
+	t.Run("StatusOfPreviousStepIsReset", func(t *testing.T) {
+		roCtx, _ := newWaitForRolloutContext(newCertificateWaitFor(), newCertificate("False"))
+		roCtx.rollout.Status.Canary.WaitFor = &v1alpha1.WaitForStatus{Index: 0, Met: true}
+		roCtx.reconcileWaitForStep()
+		assert.Equal(t, int32(1), roCtx.newStatus.Canary.WaitFor.Index)
+		assert.False(t, roCtx.newStatus.Canary.WaitFor.Met)
+	})
+}
+
+func TestCanaryRolloutCompletesWaitForStep(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	waitFor := newCertificateWaitFor()
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}, {WaitFor: &waitFor}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.refResources = append(f.refResources, newCertificate("True"))
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Equal(t, int32(2), *patched.Status.CurrentStepIndex)
+	assert.Nil(t, patched.Status.Canary.WaitFor)
+}
+
+func TestCanaryRolloutWaitsForCondition(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	waitFor := newCertificateWaitFor()
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}, {WaitFor: &waitFor}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.refResources = append(f.refResources, newCertificate("False"))
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, false)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.Nil(t, patched.Status.CurrentStepIndex)
+	assert.Equal(t, int32(1), patched.Status.Canary.WaitFor.Index)
+	assert.False(t, patched.Status.Canary.WaitFor.Met)
+	assert.Contains(t, patched.Status.Canary.WaitFor.Message, "is false")
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/viewcontroller"
 	"github.com/argoproj/argo-rollouts/utils/errors"
 	"github.com/argoproj/argo-rollouts/utils/json"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 	versionutils "github.com/argoproj/argo-rollouts/utils/version"
 )
 
@@ -253,7 +254,11 @@ func (s *ArgoRolloutsServer) ListRolloutInfos(ctx context.Context, q *rollout.Ro
 	var riList []*rollout.RolloutInfo
 	for i := range rolloutList.Items {
 		cur := rolloutList.Items[i]
-		ri := info.NewRolloutInfo(&cur, nil, nil, nil, nil, nil)
+		strategyRevisions, err := s.getStrategyRevisions(ctx, &cur)
+		if err != nil {
+			return nil, err
+		}
+		ri := info.NewRolloutInfo(&cur, nil, nil, nil, nil, nil, strategyRevisions)
 		ri.ReplicaSets = info.GetReplicaSetInfo(cur.UID, &cur, allReplicaSets, allPods)
 		riList = append(riList, ri)
 	}
@@ -329,8 +334,13 @@ func (s *ArgoRolloutsServer) WatchRolloutInfos(q *rollout.RolloutInfoListQuery,
 				return err
 			}
 
+			strategyRevisions, err := s.getStrategyRevisions(ctx, ro)
+			if err != nil {
+				return err
+			}
+
 			// get shallow rollout info
-			ri := info.NewRolloutInfo(ro, allReplicaSets, allPods, nil, nil, nil)
+			ri := info.NewRolloutInfo(ro, allReplicaSets, allPods, nil, nil, nil, strategyRevisions)
 			send(ri)
 		}
 	}
@@ -342,7 +352,21 @@ func (s *ArgoRolloutsServer) RolloutToRolloutInfo(ro *v1alpha1.Rollout) (*rollou
 	if err != nil {
 		return nil, err
 	}
-	return info.NewRolloutInfo(ro, allReplicaSets, allPods, nil, nil, nil), nil
+	strategyRevisions, err := s.getStrategyRevisions(ctx, ro)
+	if err != nil {
+		return nil, err
+	}
+	return info.NewRolloutInfo(ro, allReplicaSets, allPods, nil, nil, nil, strategyRevisions), nil
+}
+
+// getStrategyRevisions returns the ControllerRevision holding the strategy pinned for a Rollout with a StrategyRef,
+// so that its steps are shown
+func (s *ArgoRolloutsServer) getStrategyRevisions(ctx context.Context, ro *v1alpha1.Rollout) ([]*appsv1.ControllerRevision, error) {
+	revision, err := rolloututil.GetStrategyRevision(ctx, s.Options.KubeClientset, ro)
+	if err != nil || revision == nil {
+		return nil, err
+	}
+	return []*appsv1.ControllerRevision{revision}, nil
 }
 
 func (s *ArgoRolloutsServer) GetNamespace(ctx context.Context, e *empty.Empty) (*rollout.NamespaceInfo, error) {
@@ -365,7 +389,7 @@ func (s *ArgoRolloutsServer) GetNamespace(ctx context.Context, e *empty.Empty) (
 
 func (s *ArgoRolloutsServer) PromoteRollout(ctx context.Context, q *rollout.PromoteRolloutRequest) (*v1alpha1.Rollout, error) {
 	rolloutIf := s.Options.RolloutsClientset.ArgoprojV1alpha1().Rollouts(q.GetNamespace())
-	return promote.PromoteRollout(rolloutIf, q.GetName(), false, false, q.GetFull())
+	return promote.PromoteRollout(rolloutIf, s.Options.KubeClientset, q.GetName(), false, false, q.GetFull())
 }
 
 func (s *ArgoRolloutsServer) AbortRollout(ctx context.Context, q *rollout.AbortRolloutRequest) (*v1alpha1.Rollout, error) {
@@ -41,6 +41,8 @@ func FilterCurrentRolloutAnalysisRuns(analysisRuns []*v1alpha1.AnalysisRun, r *v
 				currArs.BlueGreenPrePromotion = ar
 			case getArName(r.Status.BlueGreen.PostPromotionAnalysisRunStatus):
 				currArs.BlueGreenPostPromotion = ar
+			case getArName(r.Status.BlueGreen.TrafficStepAnalysisRunStatus):
+				currArs.BlueGreenTrafficStep = ar
 			default:
 				otherArs = append(otherArs, ar)
 			}
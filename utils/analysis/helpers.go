@@ -23,6 +23,7 @@ import (
 type CurrentAnalysisRuns struct {
 	BlueGreenPrePromotion  *v1alpha1.AnalysisRun
 	BlueGreenPostPromotion *v1alpha1.AnalysisRun
+	BlueGreenTrafficStep   *v1alpha1.AnalysisRun
 	CanaryStep             *v1alpha1.AnalysisRun
 	CanaryBackground       *v1alpha1.AnalysisRun
 }
@@ -35,6 +36,9 @@ func (c CurrentAnalysisRuns) ToArray() []*v1alpha1.AnalysisRun {
 	if c.BlueGreenPrePromotion != nil {
 		currentAnalysisRuns = append(currentAnalysisRuns, c.BlueGreenPrePromotion)
 	}
+	if c.BlueGreenTrafficStep != nil {
+		currentAnalysisRuns = append(currentAnalysisRuns, c.BlueGreenTrafficStep)
+	}
 	if c.CanaryStep != nil {
 		currentAnalysisRuns = append(currentAnalysisRuns, c.CanaryStep)
 	}
@@ -28,6 +28,9 @@ const (
 	DesiredReplicasAnnotation = RolloutLabel + "/desired-replicas"
 	// WorkloadGenerationAnnotation is the generation of the referenced workload
 	WorkloadGenerationAnnotation = RolloutLabel + "/workload-generation"
+	// AnalysisTemplatesAnnotation records the comma-separated names of the templates of an AnalysisRun created by a
+	// rollout, to attribute its failures to the templates
+	AnalysisTemplatesAnnotation = RolloutLabel + "/analysis-templates"
 	// NotificationEngineAnnotation the annotation notification engine uses to determine if it should notify
 	NotificationEngineAnnotation = "notified.notifications.argoproj.io"
 )
@@ -0,0 +1,107 @@
+package apply
+
+import (
+	"context"
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+// FieldManager is the field manager of the fields applied by the controller
+const FieldManager = "argo-rollouts-controller"
+
+// Enabled returns whether the controller writes the fields it owns with server-side apply
+func Enabled() bool {
+	return defaults.IsServerSideApplyEnabled()
+}
+
+// PatchOptions returns the options of the apply patches of the controller
+func PatchOptions() metav1.PatchOptions {
+	force := defaults.IsServerSideApplyForce()
+	return metav1.PatchOptions{FieldManager: FieldManager, Force: &force}
+}
+
+// ApplyOptions returns the options of the applies of the controller through dynamic clients
+func ApplyOptions() metav1.ApplyOptions {
+	return metav1.ApplyOptions{FieldManager: FieldManager, Force: defaults.IsServerSideApplyForce()}
+}
+
+// Configuration returns the apply configuration of an object made of its fields at the given paths. A path may end
+// with the key of a map, such as an annotation, so that only that key is owned. The paths which are not set in the
+// object are left out of the configuration, so that the fields previously applied there are removed.
+//
+// An apply configuration must hold all the fields owned by the field manager, so the same paths must be applied to
+// an object every time.
+func Configuration(obj *unstructured.Unstructured, fields ...[]string) (*unstructured.Unstructured, error) {
+	config := &unstructured.Unstructured{Object: map[string]any{}}
+	config.SetAPIVersion(obj.GetAPIVersion())
+	config.SetKind(obj.GetKind())
+	config.SetName(obj.GetName())
+	if obj.GetNamespace() != "" {
+		config.SetNamespace(obj.GetNamespace())
+	}
+	for _, field := range fields {
+		value, ok, err := unstructured.NestedFieldNoCopy(obj.Object, field...)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if err := unstructured.SetNestedField(config.Object, runtime.DeepCopyJSONValue(value), field...); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// Patch returns the apply patch of a typed object made of its fields at the given paths (see Configuration). The
+// kind of the object is given since the objects returned by clientsets have no type metadata.
+func Patch(obj runtime.Object, gvk schema.GroupVersionKind, fields ...[]string) ([]byte, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	un := &unstructured.Unstructured{Object: content}
+	un.SetGroupVersionKind(gvk)
+	config, err := Configuration(un, fields...)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(config.Object)
+}
+
+// Updater updates unstructured objects, such as the resources of the traffic routers
+type Updater interface {
+	Update(ctx context.Context, obj *unstructured.Unstructured, options metav1.UpdateOptions, subresources ...string) (*unstructured.Unstructured, error)
+}
+
+// Applier applies unstructured objects, such as dynamic.ResourceInterface
+type Applier interface {
+	Apply(ctx context.Context, name string, obj *unstructured.Unstructured, options metav1.ApplyOptions, subresources ...string) (*unstructured.Unstructured, error)
+}
+
+// Update writes the fields at the given paths of a modified object. When server-side apply is enabled and the client
+// supports it, only those fields are applied, otherwise the whole object is updated.
+//
+// The fields of the traffic routers are mostly atomic lists, such as the routes of a VirtualService, which are
+// rewritten whole from the object as it was read. The resource version of the object is thus applied as a
+// precondition: like an update, the apply fails with a conflict when the object was changed in the meantime, instead
+// of overwriting the changes made to the lists by other field managers.
+func Update(ctx context.Context, client Updater, obj *unstructured.Unstructured, fields ...[]string) (*unstructured.Unstructured, error) {
+	applier, ok := client.(Applier)
+	if !Enabled() || !ok {
+		return client.Update(ctx, obj, metav1.UpdateOptions{})
+	}
+	config, err := Configuration(obj, fields...)
+	if err != nil {
+		return nil, err
+	}
+	config.SetResourceVersion(obj.GetResourceVersion())
+	return applier.Apply(ctx, obj.GetName(), config, ApplyOptions())
+}
@@ -0,0 +1,140 @@
+package apply
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
+)
+
+const virtualService = `apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: vsvc
+  namespace: default
+  annotations:
+    owner: gitops
+  labels:
+    app: foo
+spec:
+  gateways:
+  - gateway
+  http:
+  - name: primary
+    route:
+    - destination:
+        host: stable
+      weight: 90
+    - destination:
+        host: canary
+      weight: 10`
+
+func TestConfiguration(t *testing.T) {
+	obj := unstructuredutil.StrToUnstructuredUnsafe(virtualService)
+	config, err := Configuration(obj, []string{"spec", "http"}, []string{"spec", "tcp"}, []string{"metadata", "labels", "app"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"apiVersion": "networking.istio.io/v1alpha3",
+		"kind":       "VirtualService",
+		"metadata": map[string]any{
+			"name":      "vsvc",
+			"namespace": "default",
+			"labels":    map[string]any{"app": "foo"},
+		},
+		"spec": map[string]any{
+			"http": obj.Object["spec"].(map[string]any)["http"],
+		},
+	}, config.Object)
+
+	// the configuration is a copy
+	unstructured.RemoveNestedField(config.Object, "spec", "http")
+	_, ok, _ := unstructured.NestedSlice(obj.Object, "spec", "http")
+	assert.True(t, ok)
+
+	_, err = Configuration(obj, []string{"spec", "gateways", "gateway"})
+	assert.Error(t, err)
+}
+
+func TestPatch(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "stable",
+			Namespace:   "default",
+			Annotations: map[string]string{"owner": "gitops"},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "foo"},
+			Type:     corev1.ServiceTypeClusterIP,
+		},
+	}
+	patch, err := Patch(svc, corev1.SchemeGroupVersion.WithKind("Service"), []string{"spec", "selector"})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"apiVersion":"v1","kind":"Service","metadata":{"name":"stable","namespace":"default"},"spec":{"selector":{"app":"foo"}}}`, string(patch))
+}
+
+func TestPatchOptions(t *testing.T) {
+	assert.Equal(t, FieldManager, PatchOptions().FieldManager)
+	assert.False(t, *PatchOptions().Force)
+	assert.False(t, ApplyOptions().Force)
+
+	defaults.SetServerSideApplyForce(true)
+	defer defaults.SetServerSideApplyForce(false)
+	assert.True(t, *PatchOptions().Force)
+	assert.True(t, ApplyOptions().Force)
+}
+
+type updateOnlyClient struct {
+	updated *unstructured.Unstructured
+}
+
+func (c *updateOnlyClient) Update(_ context.Context, obj *unstructured.Unstructured, _ metav1.UpdateOptions, _ ...string) (*unstructured.Unstructured, error) {
+	c.updated = obj
+	return obj, nil
+}
+
+func TestUpdate(t *testing.T) {
+	gvr := schema.GroupVersionResource{Group: "networking.istio.io", Version: "v1alpha3", Resource: "virtualservices"}
+	obj := unstructuredutil.StrToUnstructuredUnsafe(virtualService)
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{gvr: "VirtualServiceList"}, obj)
+	client.PrependReactor("patch", "virtualservices", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, obj, nil
+	})
+	resourceClient := client.Resource(gvr).Namespace("default")
+
+	_, err := Update(context.Background(), resourceClient, obj, []string{"spec", "http"})
+	assert.NoError(t, err)
+	assert.Equal(t, "update", client.Actions()[0].GetVerb())
+
+	defaults.SetServerSideApplyEnabled(true)
+	defer defaults.SetServerSideApplyEnabled(false)
+	client.ClearActions()
+	modified := obj.DeepCopy()
+	modified.SetResourceVersion("42")
+	_, err = Update(context.Background(), resourceClient, modified, []string{"spec", "http"})
+	assert.NoError(t, err)
+	patchAction, ok := client.Actions()[0].(k8stesting.PatchAction)
+	assert.True(t, ok)
+	applied := unstructuredutil.StrToUnstructuredUnsafe(string(patchAction.GetPatch()))
+	// the routes are rewritten whole, so the apply is conditioned on the resource version of the modified object
+	assert.Equal(t, "42", applied.GetResourceVersion())
+	_, ok, _ = unstructured.NestedSlice(applied.Object, "spec", "http")
+	assert.True(t, ok)
+	_, ok, _ = unstructured.NestedSlice(applied.Object, "spec", "gateways")
+	assert.False(t, ok)
+
+	// clients which cannot apply update the whole object
+	updateOnly := &updateOnlyClient{}
+	_, err = Update(context.Background(), updateOnly, obj, []string{"spec", "http"})
+	assert.NoError(t, err)
+	assert.Equal(t, obj, updateOnly.updated)
+}
@@ -0,0 +1,81 @@
+package cel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	celgo "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// costLimit is the maximum runtime cost of the evaluation of an expression, as estimated by CEL. It is the cost
+	// limit of a single validation rule of the Kubernetes API server.
+	costLimit uint64 = 1000000
+	// interruptCheckFrequency is the number of iterations of a comprehension after which the evaluation checks
+	// whether it was interrupted by evaluationTimeout
+	interruptCheckFrequency uint = 100
+	// evaluationTimeout is the maximum duration of the evaluation of an expression
+	evaluationTimeout = time.Second
+	// programCacheSize is the number of compiled programs kept by EvaluateBool
+	programCacheSize = 1000
+)
+
+// programs caches the programs compiled by EvaluateBool, by expression and variables
+var programs = lru.New(programCacheSize)
+
+// Compile compiles a CEL expression which must evaluate to a boolean. The variables are declared with a dynamic type.
+func Compile(expression string, variables ...string) (celgo.Program, error) {
+	opts := make([]celgo.EnvOption, 0, len(variables))
+	for _, v := range variables {
+		opts = append(opts, celgo.Variable(v, celgo.DynType))
+	}
+	env, err := celgo.NewEnv(opts...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != celgo.BoolType && ast.OutputType() != celgo.DynType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not %s", ast.OutputType())
+	}
+	return env.Program(ast, celgo.CostLimit(costLimit), celgo.InterruptCheckFrequency(interruptCheckFrequency))
+}
+
+// EvaluateBool evaluates a CEL expression which must evaluate to a boolean. The compiled programs are cached.
+func EvaluateBool(expression string, variables map[string]any) (bool, error) {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	key := strings.Join(names, ",") + ":" + expression
+	var prg celgo.Program
+	if cached, ok := programs.Get(key); ok {
+		prg = cached.(celgo.Program)
+	} else {
+		var err error
+		prg, err = Compile(expression, names...)
+		if err != nil {
+			return false, err
+		}
+		programs.Add(key, prg)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), evaluationTimeout)
+	defer cancel()
+	out, _, err := prg.ContextEval(ctx, variables)
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.(types.Bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %s, not a bool", out.Type())
+	}
+	return bool(result), nil
+}
@@ -0,0 +1,66 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompile(t *testing.T) {
+	_, err := Compile(`object.status.ready == true`, "object")
+	assert.NoError(t, err)
+
+	_, err = Compile(`object.status.ready ==`, "object")
+	assert.Error(t, err)
+
+	_, err = Compile(`"not a bool"`, "object")
+	assert.EqualError(t, err, "expression must evaluate to a bool, not string")
+
+	_, err = Compile(`unknown.field == 1`, "object")
+	assert.Error(t, err)
+}
+
+func TestEvaluateBool(t *testing.T) {
+	object := map[string]any{
+		"status": map[string]any{
+			"replicas":   int64(3),
+			"conditions": []any{map[string]any{"type": "Ready", "status": "True"}},
+		},
+	}
+	met, err := EvaluateBool(`object.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`, map[string]any{"object": object})
+	assert.NoError(t, err)
+	assert.True(t, met)
+
+	met, err = EvaluateBool(`object.status.replicas > 3`, map[string]any{"object": object})
+	assert.NoError(t, err)
+	assert.False(t, met)
+
+	_, err = EvaluateBool(`object.status.missing == 1`, map[string]any{"object": object})
+	assert.Error(t, err)
+
+	_, err = EvaluateBool(`object.status.replicas`, map[string]any{"object": object})
+	assert.EqualError(t, err, "expression evaluated to int, not a bool")
+}
+
+func TestEvaluateBoolCache(t *testing.T) {
+	programs.Clear()
+	for _, replicas := range []int64{1, 2} {
+		_, err := EvaluateBool(`object.replicas > 1`, map[string]any{"object": map[string]any{"replicas": replicas}})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, programs.Len())
+
+	// the same expression with other variables is compiled again
+	_, err := EvaluateBool(`object.replicas > 1`, map[string]any{"object": map[string]any{"replicas": int64(1)}, "other": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, programs.Len())
+}
+
+func TestEvaluateBoolCostLimit(t *testing.T) {
+	items := make([]any, 1000)
+	for i := range items {
+		items[i] = int64(i)
+	}
+	_, err := EvaluateBool(`object.items.all(a, object.items.all(b, object.items.all(c, a + b + c >= 0)))`, map[string]any{"object": map[string]any{"items": items}})
+	assert.ErrorContains(t, err, "cost limit exceeded")
+}
@@ -20,6 +20,10 @@ import (
 const (
 	// InvalidSpecReason indicates that the spec is invalid
 	InvalidSpecReason = "InvalidSpec"
+	// RolloutPolicyViolationReason indicates that the new revision of the rollout violates a rule of a RolloutPolicy
+	// or ClusterRolloutPolicy. It is the reason of the InvalidSpec condition when the rule is enforced with Deny, and
+	// of a warning event when it is enforced with Warn.
+	RolloutPolicyViolationReason = "RolloutPolicyViolation"
 	// MissingFieldMessage the message to indicate rollout is missing a field
 	MissingFieldMessage = "Rollout has missing field '%s'"
 	// RolloutSelectAllMessage the message to indicate that the rollout has an empty selector
@@ -145,6 +149,11 @@ const (
 	// within the given deadline (progressDeadlineSeconds).
 	RolloutTimeOutMessage = "Rollout %q has timed out progressing."
 
+	// StepTimedOutReason is added in a rollout when the update is aborted by a step which exceeded its timeout
+	StepTimedOutReason = "StepTimedOut"
+	// StepTimedOutMessage is added in a rollout when a step exceeds its timeout
+	StepTimedOutMessage = "Step %s timed out after %s"
+
 	RolloutDeletedReason  = "RolloutDeleted"
 	RolloutDeletedMessage = "Rollout %s/%s is deleted."
 
@@ -283,9 +292,8 @@ func RolloutProgressing(rollout *v1alpha1.Rollout, newStatus *v1alpha1.RolloutSt
 
 // RolloutHealthy considers a rollout to be healthy once all of its desired replicas
 // are updated, available, and receiving traffic from the active service, and no old pods are running.
-func RolloutHealthy(rollout *v1alpha1.Rollout, newStatus *v1alpha1.RolloutStatus) bool {
+func RolloutHealthy(rollout *v1alpha1.Rollout, newStatus *v1alpha1.RolloutStatus, replicas int32) bool {
 	completedStrategy := true
-	replicas := defaults.GetReplicasOrDefault(rollout.Spec.Replicas)
 
 	if rollout.Spec.Strategy.BlueGreen != nil {
 		activeSelectorComplete := newStatus.BlueGreen.ActiveSelector == newStatus.CurrentPodHash
@@ -346,7 +354,7 @@ func RolloutTimedOut(rollout *v1alpha1.Rollout, newStatus *v1alpha1.RolloutStatu
 	// When a rollout is retried, the controller should not evaluate for a timeout based on the
 	// aborted condition because the abort could have happened a while back and the rollout should
 	// not enter degraded as a result of that
-	if condition == nil || condition.Reason == RolloutAbortedReason || condition.Reason == RolloutPausedReason {
+	if condition == nil || condition.Reason == RolloutAbortedReason || condition.Reason == StepTimedOutReason || condition.Reason == RolloutPausedReason {
 		return false
 	}
 
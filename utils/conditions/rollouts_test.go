@@ -11,6 +11,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/hash"
 )
 
@@ -475,7 +476,7 @@ func TestRolloutHealthy(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			assert.Equal(t, test.expected, RolloutHealthy(test.r, &test.r.Status))
+			assert.Equal(t, test.expected, RolloutHealthy(test.r, &test.r.Status, defaults.GetReplicasOrDefault(test.r.Spec.Replicas)))
 		})
 	}
 
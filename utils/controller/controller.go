@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -18,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
+	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
@@ -286,3 +288,39 @@ func InstanceIDRequirement(instanceID string) labels.Requirement {
 	}
 	return *instanceIDReq
 }
+
+// LazyInformerFactory starts the informers of a shared informer factory the first time they are needed, so that the
+// resources which are only read for some kinds of Rollouts are not listed and watched by every controller
+type LazyInformerFactory struct {
+	factory kubeinformers.SharedInformerFactory
+	lock    sync.Mutex
+	synced  bool
+}
+
+// NewLazyInformerFactory returns a LazyInformerFactory for the given factory. The informers must be obtained from the
+// factory before the first call to Start.
+func NewLazyInformerFactory(factory kubeinformers.SharedInformerFactory) *LazyInformerFactory {
+	return &LazyInformerFactory{factory: factory}
+}
+
+// Start starts the informers of the factory if they are not started yet, and waits for their caches to sync. The
+// informers are stopped when the context is done. Start is a no-op on a nil LazyInformerFactory.
+func (f *LazyInformerFactory) Start(ctx context.Context) error {
+	if f == nil {
+		return nil
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.synced {
+		return nil
+	}
+	log.Info("Starting lazily started informers")
+	f.factory.Start(ctx.Done())
+	for informerType, synced := range f.factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to wait for the %v informer to sync", informerType)
+		}
+	}
+	f.synced = true
+	return nil
+}
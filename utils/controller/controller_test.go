@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -20,6 +21,8 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	dynamicinformers "k8s.io/client-go/dynamic/dynamicinformer"
 	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubeinformers "k8s.io/client-go/informers"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/util/workqueue"
 
@@ -466,3 +469,24 @@ func TestProcessNextWatchObj(t *testing.T) {
 		assert.Equal(t, 0, wq.Len())
 	}
 }
+
+func TestLazyInformerFactory(t *testing.T) {
+	var nilFactory *LazyInformerFactory
+	assert.NoError(t, nilFactory.Start(context.Background()))
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+	kubeclient := k8sfake.NewSimpleClientset(pod)
+	factory := kubeinformers.NewSharedInformerFactory(kubeclient, 0)
+	podLister := factory.Core().V1().Pods().Lister()
+	lazyFactory := NewLazyInformerFactory(factory)
+
+	_, err := podLister.Pods("default").Get("foo")
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, lazyFactory.Start(ctx))
+	_, err = podLister.Pods("default").Get("foo")
+	assert.NoError(t, err)
+	assert.NoError(t, lazyFactory.Start(ctx))
+}
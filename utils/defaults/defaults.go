@@ -63,6 +63,20 @@ const (
 	DefaultTraefikVersion               = "traefik.containo.us/v1alpha1"
 	DefaultApisixAPIGroup               = "apisix.apache.org"
 	DefaultApisixVersion                = "apisix.apache.org/v2"
+	DefaultContourAPIGroup              = "projectcontour.io"
+	DefaultContourVersion               = "projectcontour.io/v1"
+	DefaultGatewayAPIGroup              = "gateway.networking.k8s.io"
+	DefaultGatewayAPIVersion            = "gateway.networking.k8s.io/v1"
+	DefaultLinkerdAPIGroup              = "policy.linkerd.io"
+	DefaultLinkerdHTTPRouteVersion      = "v1beta3"
+	DefaultExternalDNSAPIGroup          = "externaldns.k8s.io"
+	DefaultExternalDNSVersion           = "externaldns.k8s.io/v1alpha1"
+	// DefaultDNSWeightProperty is the provider specific property used by the external-dns Route53 provider for weights
+	DefaultDNSWeightProperty = "aws/weight"
+	// DefaultDNSRecordTTLSeconds is the TTL external-dns applies to records without a recordTTL
+	DefaultDNSRecordTTLSeconds = int64(300)
+	// DefaultStickinessCookieName is the name of the cookie which pins users to a version when traffic stickiness is enabled
+	DefaultStickinessCookieName = "argo-rollouts-sticky"
 )
 
 var (
@@ -74,8 +88,13 @@ var (
 	smiAPIVersion                = DefaultSMITrafficSplitVersion
 	targetGroupBindingAPIVersion = DefaultTargetGroupBindingAPIVersion
 	appmeshCRDVersion            = DefaultAppMeshCRDVersion
+	linkerdHTTPRouteVersion      = DefaultLinkerdHTTPRouteVersion
 	defaultMetricCleanupDelay    = DefaultMetricCleanupDelay
 	defaultDescribeTagsLimit     = DefaultDescribeTagsLimit
+	metricsTeamLabelKey          = ""
+	rolloutPriorityQueueEnabled  = false
+	serverSideApplyEnabled       = false
+	serverSideApplyForce         = false
 )
 
 const (
@@ -161,6 +180,14 @@ func GetCanaryIngressAnnotationPrefixOrDefault(rollout *v1alpha1.Rollout) string
 	return "nginx.ingress.kubernetes.io"
 }
 
+// GetStickinessCookieNameOrDefault returns the name of the cookie which pins users to a version
+func GetStickinessCookieNameOrDefault(rollout *v1alpha1.Rollout) string {
+	if rollout.Spec.Strategy.Canary != nil && rollout.Spec.Strategy.Canary.TrafficRouting != nil && rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness != nil && rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness.CookieName != "" {
+		return rollout.Spec.Strategy.Canary.TrafficRouting.Stickiness.CookieName
+	}
+	return DefaultStickinessCookieName
+}
+
 func GetProgressDeadlineSecondsOrDefault(rollout *v1alpha1.Rollout) int32 {
 	if rollout.Spec.ProgressDeadlineSeconds != nil {
 		return *rollout.Spec.ProgressDeadlineSeconds
@@ -323,6 +350,14 @@ func GetTraefikAPIGroup() string {
 	return traefikAPIGroup
 }
 
+func SetLinkerdHTTPRouteVersion(apiVersion string) {
+	linkerdHTTPRouteVersion = apiVersion
+}
+
+func GetLinkerdHTTPRouteVersion() string {
+	return linkerdHTTPRouteVersion
+}
+
 func SetTargetGroupBindingAPIVersion(apiVersion string) {
 	targetGroupBindingAPIVersion = apiVersion
 }
@@ -345,6 +380,52 @@ func SetMetricCleanupDelaySeconds(seconds int32) {
 	defaultMetricCleanupDelay = seconds
 }
 
+// GetMetricsTeamLabelKey returns the key of the label of rollouts whose value is the team of the lifecycle metrics
+func GetMetricsTeamLabelKey() string {
+	return metricsTeamLabelKey
+}
+
+// SetMetricsTeamLabelKey sets the key of the label of rollouts whose value is the team of the lifecycle metrics
+func SetMetricsTeamLabelKey(key string) {
+	metricsTeamLabelKey = key
+}
+
+// IsRolloutPriorityQueueEnabled returns whether the rollout workqueue dequeues the active rollouts ahead of the
+// steady-state ones, fairly across namespaces
+func IsRolloutPriorityQueueEnabled() bool {
+	return rolloutPriorityQueueEnabled
+}
+
+// SetRolloutPriorityQueueEnabled sets whether the rollout workqueue dequeues the active rollouts ahead of the
+// steady-state ones, fairly across namespaces
+func SetRolloutPriorityQueueEnabled(enabled bool) {
+	rolloutPriorityQueueEnabled = enabled
+}
+
+// IsServerSideApplyEnabled returns whether the controller writes the fields it owns in the objects it does not create
+// with server-side apply
+func IsServerSideApplyEnabled() bool {
+	return serverSideApplyEnabled
+}
+
+// SetServerSideApplyEnabled sets whether the controller writes the fields it owns in the objects it does not create
+// with server-side apply
+func SetServerSideApplyEnabled(enabled bool) {
+	serverSideApplyEnabled = enabled
+}
+
+// IsServerSideApplyForce returns whether the server-side applies of the controller take the ownership of the fields
+// owned by other field managers, instead of failing with a conflict
+func IsServerSideApplyForce() bool {
+	return serverSideApplyForce
+}
+
+// SetServerSideApplyForce sets whether the server-side applies of the controller take the ownership of the fields
+// owned by other field managers, instead of failing with a conflict
+func SetServerSideApplyForce(force bool) {
+	serverSideApplyForce = force
+}
+
 // GetDescribeTagsLimit returns limit of resources can be requested in a single call
 func GetDescribeTagsLimit() int {
 	return defaultDescribeTagsLimit
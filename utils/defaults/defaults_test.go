@@ -145,6 +145,26 @@ func TestGetCanaryIngressAnnotationPrefixOrDefault(t *testing.T) {
 	assert.Equal(t, "nginx.ingress.kubernetes.io", GetCanaryIngressAnnotationPrefixOrDefault(rolloutDefaultValue))
 }
 
+func TestGetStickinessCookieNameOrDefault(t *testing.T) {
+	rolloutNonDefaultValue := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						Stickiness: &v1alpha1.TrafficStickiness{
+							CookieName: "session-version",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, "session-version", GetStickinessCookieNameOrDefault(rolloutNonDefaultValue))
+	rolloutDefaultValue := &v1alpha1.Rollout{}
+	assert.Equal(t, DefaultStickinessCookieName, GetStickinessCookieNameOrDefault(rolloutDefaultValue))
+}
+
 func TestGetProgressDeadlineSecondsOrDefault(t *testing.T) {
 	seconds := int32(2)
 	rolloutNonDefaultValue := &v1alpha1.Rollout{
@@ -0,0 +1,64 @@
+package evaluate
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/cel"
+)
+
+// waitForObjectVariable is the name of the CEL variable holding the resource of a waitFor step
+const waitForObjectVariable = "object"
+
+// ValidateWaitFor checks that the condition of a waitFor step compiles
+func ValidateWaitFor(waitFor v1alpha1.WaitForStep) error {
+	if waitFor.Condition != "" {
+		_, err := cel.Compile(waitFor.Condition, waitForObjectVariable)
+		return err
+	}
+	return jsonpath.New("waitFor").Parse(relaxedJSONPath(waitFor.JSONPath))
+}
+
+// EvalWaitFor evaluates the condition of a waitFor step against a resource. When the condition is not met, the
+// returned message explains why.
+func EvalWaitFor(waitFor v1alpha1.WaitForStep, obj map[string]any) (bool, string, error) {
+	if waitFor.Condition != "" {
+		met, err := cel.EvaluateBool(waitFor.Condition, map[string]any{waitForObjectVariable: obj})
+		if err != nil {
+			return false, "", err
+		}
+		if !met {
+			return false, fmt.Sprintf("condition '%s' is false", waitFor.Condition), nil
+		}
+		return true, "", nil
+	}
+
+	jp := jsonpath.New("waitFor").AllowMissingKeys(true)
+	if err := jp.Parse(relaxedJSONPath(waitFor.JSONPath)); err != nil {
+		return false, "", err
+	}
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, obj); err != nil {
+		return false, "", err
+	}
+	if buf.String() != waitFor.Value {
+		return false, fmt.Sprintf("%s is '%s', expected '%s'", waitFor.JSONPath, buf.String(), waitFor.Value), nil
+	}
+	return true, "", nil
+}
+
+// relaxedJSONPath adds the braces of a JSONPath template, so that both '.status.phase' and '{.status.phase}' are
+// accepted as kubectl does
+func relaxedJSONPath(path string) string {
+	if strings.HasPrefix(path, "{") {
+		return path
+	}
+	if !strings.HasPrefix(path, ".") {
+		path = "." + path
+	}
+	return "{" + path + "}"
+}
@@ -0,0 +1,50 @@
+package evaluate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestValidateWaitFor(t *testing.T) {
+	assert.NoError(t, ValidateWaitFor(v1alpha1.WaitForStep{Condition: `object.status.phase == "Ready"`}))
+	assert.Error(t, ValidateWaitFor(v1alpha1.WaitForStep{Condition: `object.status.phase ==`}))
+	assert.NoError(t, ValidateWaitFor(v1alpha1.WaitForStep{JSONPath: ".status.phase"}))
+	assert.NoError(t, ValidateWaitFor(v1alpha1.WaitForStep{JSONPath: "{.status.phase}"}))
+	assert.Error(t, ValidateWaitFor(v1alpha1.WaitForStep{JSONPath: "{.status[?(@.type=="}))
+}
+
+func TestEvalWaitFor(t *testing.T) {
+	obj := map[string]any{
+		"status": map[string]any{
+			"phase":      "Ready",
+			"conditions": []any{map[string]any{"type": "Complete", "status": "True"}},
+		},
+	}
+
+	met, message, err := EvalWaitFor(v1alpha1.WaitForStep{Condition: `object.status.phase == "Ready"`}, obj)
+	assert.NoError(t, err)
+	assert.True(t, met)
+	assert.Empty(t, message)
+
+	met, message, err = EvalWaitFor(v1alpha1.WaitForStep{Condition: `object.status.phase == "Pending"`}, obj)
+	assert.NoError(t, err)
+	assert.False(t, met)
+	assert.Equal(t, `condition 'object.status.phase == "Pending"' is false`, message)
+
+	met, _, err = EvalWaitFor(v1alpha1.WaitForStep{JSONPath: `{.status.conditions[?(@.type=="Complete")].status}`, Value: "True"}, obj)
+	assert.NoError(t, err)
+	assert.True(t, met)
+
+	met, message, err = EvalWaitFor(v1alpha1.WaitForStep{JSONPath: "status.phase", Value: "Pending"}, obj)
+	assert.NoError(t, err)
+	assert.False(t, met)
+	assert.Equal(t, "status.phase is 'Ready', expected 'Pending'", message)
+
+	// missing keys evaluate to an empty string
+	met, _, err = EvalWaitFor(v1alpha1.WaitForStep{JSONPath: ".status.missing", Value: ""}, obj)
+	assert.NoError(t, err)
+	assert.True(t, met)
+}
@@ -32,3 +32,18 @@ func ComputePodTemplateHash(template *corev1.PodTemplateSpec, collisionCount *in
 	}
 	return rand.SafeEncodeString(fmt.Sprint(podTemplateSpecHasher.Sum32()))
 }
+
+// ComputeConfigHash returns a hash value calculated from the data of a ConfigMap or Secret. The hash is the suffix
+// of the snapshots of the ConfigRefs of a Rollout, so it is part of the hash of the pod templates referencing them.
+func ComputeConfigHash(data any) string {
+	configHasher := fnv.New32a()
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+	_, err = configHasher.Write(dataBytes)
+	if err != nil {
+		panic(err)
+	}
+	return rand.SafeEncodeString(fmt.Sprint(configHasher.Sum32()))
+}
@@ -24,6 +24,12 @@ func TestHashUtils(t *testing.T) {
 	})
 }
 
+func TestComputeConfigHash(t *testing.T) {
+	hash := ComputeConfigHash(map[string]string{"key": "value"})
+	assert.Equal(t, hash, ComputeConfigHash(map[string]string{"key": "value"}))
+	assert.NotEqual(t, hash, ComputeConfigHash(map[string]string{"key": "other"}))
+}
+
 func generatePodTemplate(image string) corev1.PodTemplateSpec {
 	podLabels := map[string]string{"name": image}
 
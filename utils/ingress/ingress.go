@@ -9,9 +9,12 @@ import (
 
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/apply"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/diff"
 	"github.com/argoproj/argo-rollouts/utils/json"
@@ -319,6 +322,37 @@ func BuildIngressPatch(mode IngressMode, current, desired *Ingress, opts ...Patc
 	}
 }
 
+// BuildManagedALBAnnotationsApplyPatch builds the apply patch of the ALB annotations managed by rollouts in the
+// desired annotations of an ingress. The annotations of all the rollouts sharing the ingress are applied together,
+// since they share the field manager of the controller.
+func BuildManagedALBAnnotationsApplyPatch(mode IngressMode, current *Ingress, desiredAnnotations map[string]string) ([]byte, error) {
+	var gvk schema.GroupVersionKind
+	var obj runtime.Object
+	switch mode {
+	case IngressModeNetworking:
+		desired := current.ingress.DeepCopy()
+		desired.Annotations = desiredAnnotations
+		gvk, obj = networkingv1.SchemeGroupVersion.WithKind("Ingress"), desired
+	case IngressModeExtensions:
+		desired := current.legacyIngress.DeepCopy()
+		desired.Annotations = desiredAnnotations
+		gvk, obj = extensionsv1beta1.SchemeGroupVersion.WithKind("Ingress"), desired
+	default:
+		return nil, errors.New("error building annotations apply patch: undefined ingress mode")
+	}
+	managed, err := NewManagedALBAnnotations(desiredAnnotations[ManagedAnnotations])
+	if err != nil {
+		return nil, err
+	}
+	fields := [][]string{{"metadata", "annotations", ManagedAnnotations}}
+	for _, keys := range managed {
+		for _, key := range keys {
+			fields = append(fields, []string{"metadata", "annotations", key})
+		}
+	}
+	return apply.Patch(obj, gvk, fields...)
+}
+
 func buildIngressPatch(current, desired *networkingv1.Ingress, cfg *patchConfig) ([]byte, bool, error) {
 	cur := &networkingv1.Ingress{}
 	des := &networkingv1.Ingress{}
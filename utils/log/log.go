@@ -1,6 +1,7 @@
 package log
 
 import (
+	"context"
 	"flag"
 	"strconv"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"github.com/bombsimon/logrusr/v4"
 
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
@@ -28,6 +30,10 @@ const (
 	IngressKey = "ingress"
 	// NamespaceKey defines the key for the namespace field
 	NamespaceKey = "namespace"
+	// TraceIDKey defines the key for the trace ID field
+	TraceIDKey = "trace_id"
+	// SpanIDKey defines the key for the span ID field
+	SpanIDKey = "span_id"
 )
 
 // SetKLogLogger set the klog logger for the k8s go-client
@@ -117,6 +123,19 @@ func WithRedactor(entry log.Entry, secrets []string) *log.Entry {
 	return &entry
 }
 
+// WithTrace returns a log entry with the IDs of the trace and span of the context, so that log entries can be
+// correlated with traces. The entry is returned as is when the context is not traced.
+func WithTrace(ctx context.Context, entry *log.Entry) *log.Entry {
+	spanCtx := trace.SpanContextFromContext(ctx)
+	if !spanCtx.IsValid() {
+		return entry
+	}
+	return entry.WithFields(map[string]any{
+		TraceIDKey: spanCtx.TraceID().String(),
+		SpanIDKey:  spanCtx.SpanID().String(),
+	})
+}
+
 func WithVersionFields(entry *log.Entry, r *v1alpha1.Rollout) *log.Entry {
 	return entry.WithFields(map[string]any{
 		"resourceVersion": r.ResourceVersion,
@@ -2,6 +2,7 @@ package log
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -10,6 +11,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -287,3 +289,15 @@ func TestKLogLogger(t *testing.T) {
 	logMessage := buf.String()
 	assert.Contains(t, logMessage, "Logging from klog")
 }
+
+func TestWithTrace(t *testing.T) {
+	entry := log.NewEntry(log.New())
+	assert.Equal(t, entry, WithTrace(context.TODO(), entry))
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.TODO(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+	logCtx := WithTrace(ctx, entry)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", logCtx.Data[TraceIDKey])
+	assert.Equal(t, "00f067aa0ba902b7", logCtx.Data[SpanIDKey])
+}
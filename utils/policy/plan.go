@@ -0,0 +1,164 @@
+package policy
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// Sources of the AnalysisTemplates of the plan of a Rollout
+const (
+	AnalysisSourceBackground    = "background"
+	AnalysisSourceStep          = "step"
+	AnalysisSourceExperiment    = "experiment"
+	AnalysisSourcePrePromotion  = "prePromotion"
+	AnalysisSourcePostPromotion = "postPromotion"
+)
+
+// stepOptionFields are the fields of a step which are options of the step rather than its type
+var stepOptionFields = map[string]bool{
+	"timeout":   true,
+	"onTimeout": true,
+}
+
+// Plan resolves the plan of a Rollout, which is the information the rules of the policies need about how the Rollout
+// progresses but which is not directly available in its spec:
+//
+//   - strategy: canary or blueGreen
+//   - replicas: the replicas of the Rollout, defaulted
+//   - steps: the steps of the canary, or the traffic steps of the blue-green, as in the spec with their index, their
+//     type, e.g. setWeight or analysis, and the weight of the traffic of the new revision once the step is reached
+//   - analysisTemplates: the templates of every analysis of the Rollout, with their templateName, clusterScope and
+//     source, which is one of background, step, experiment, prePromotion and postPromotion
+//   - maxSurge and maxUnavailable: the pods, resolved against the replicas, and their percent of the replicas
+func Plan(ro *v1alpha1.Rollout) (map[string]any, error) {
+	replicas := defaults.GetReplicasOrDefault(ro.Spec.Replicas)
+	plan := map[string]any{
+		"replicas": int64(replicas),
+	}
+
+	var steps []any
+	var templates []any
+	addTemplates := func(refs []v1alpha1.AnalysisTemplateRef, source string) {
+		for _, ref := range refs {
+			templates = append(templates, analysisTemplate(ref.TemplateName, ref.ClusterScope, source))
+		}
+	}
+	var maxSurge, maxUnavailable *intstr.IntOrString
+	maxSurgePods := replicasetutil.MaxSurge(ro)
+
+	switch {
+	case ro.Spec.Strategy.Canary != nil:
+		canary := ro.Spec.Strategy.Canary
+		plan["strategy"] = "canary"
+		if canary.Analysis != nil {
+			addTemplates(canary.Analysis.Templates, AnalysisSourceBackground)
+		}
+		weight := int32(0)
+		for i, step := range canary.Steps {
+			if step.SetWeight != nil {
+				weight = *step.SetWeight
+			}
+			if step.Analysis != nil {
+				addTemplates(step.Analysis.Templates, AnalysisSourceStep)
+			}
+			if step.Experiment != nil {
+				for _, analysis := range step.Experiment.Analyses {
+					templates = append(templates, analysisTemplate(analysis.TemplateName, analysis.ClusterScope, AnalysisSourceExperiment))
+				}
+			}
+			s, err := planStep(&step, i, weight)
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, s)
+		}
+		maxSurge = defaults.GetMaxSurgeOrDefault(ro)
+		maxUnavailable = defaults.GetMaxUnavailableOrDefault(ro)
+	case ro.Spec.Strategy.BlueGreen != nil:
+		blueGreen := ro.Spec.Strategy.BlueGreen
+		plan["strategy"] = "blueGreen"
+		if blueGreen.PrePromotionAnalysis != nil {
+			addTemplates(blueGreen.PrePromotionAnalysis.Templates, AnalysisSourcePrePromotion)
+		}
+		if blueGreen.PostPromotionAnalysis != nil {
+			addTemplates(blueGreen.PostPromotionAnalysis.Templates, AnalysisSourcePostPromotion)
+		}
+		weight := int32(0)
+		for i, step := range blueGreen.TrafficSteps {
+			if step.SetWeight != nil {
+				weight = *step.SetWeight
+			}
+			if step.Analysis != nil {
+				addTemplates(step.Analysis.Templates, AnalysisSourceStep)
+			}
+			s, err := planStep(&step, i, weight)
+			if err != nil {
+				return nil, err
+			}
+			steps = append(steps, s)
+		}
+		// the preview stack of a blue-green is the surge of the Rollout
+		maxSurgePods = replicas
+		if blueGreen.PreviewReplicaCount != nil {
+			maxSurgePods = *blueGreen.PreviewReplicaCount
+		}
+		maxUnavailable = defaults.GetMaxUnavailableOrDefault(ro)
+	}
+
+	maxUnavailablePods := replicasetutil.MaxUnavailable(ro)
+	plan["steps"] = append([]any{}, steps...)
+	plan["analysisTemplates"] = append([]any{}, templates...)
+	plan["maxSurge"] = int64(maxSurgePods)
+	plan["maxSurgePercent"] = percentOfReplicas(maxSurge, maxSurgePods, replicas)
+	plan["maxUnavailable"] = int64(maxUnavailablePods)
+	plan["maxUnavailablePercent"] = percentOfReplicas(maxUnavailable, maxUnavailablePods, replicas)
+	return plan, nil
+}
+
+// planStep returns a step of the plan, which is the step as in the spec with its index, type and weight
+func planStep(step any, index int, weight int32) (map[string]any, error) {
+	s, err := runtime.DefaultUnstructuredConverter.ToUnstructured(step)
+	if err != nil {
+		return nil, err
+	}
+	stepType := ""
+	for field := range s {
+		if !stepOptionFields[field] {
+			stepType = field
+		}
+	}
+	s["index"] = int64(index)
+	s["type"] = stepType
+	s["weight"] = int64(weight)
+	return s, nil
+}
+
+func analysisTemplate(templateName string, clusterScope bool, source string) map[string]any {
+	return map[string]any{
+		"templateName": templateName,
+		"clusterScope": clusterScope,
+		"source":       source,
+	}
+}
+
+// percentOfReplicas returns the percent of a maxSurge or maxUnavailable, which is its value when it is a percent, or
+// else the percent of the replicas its pods are, rounded up. Like the defaults, percents may omit the % suffix.
+func percentOfReplicas(value *intstr.IntOrString, pods int32, replicas int32) int64 {
+	if value != nil && value.Type == intstr.String {
+		if percent, err := strconv.Atoi(strings.TrimSuffix(value.StrVal, "%")); err == nil {
+			return int64(percent)
+		}
+	}
+	if replicas == 0 {
+		return 0
+	}
+	return int64(math.Ceil(float64(pods) * 100 / float64(replicas)))
+}
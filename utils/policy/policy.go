@@ -0,0 +1,151 @@
+package policy
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/cel"
+)
+
+// Variables of the CEL expressions of the rules
+const (
+	// RolloutVariable holds the Rollout
+	RolloutVariable = "rollout"
+	// PlanVariable holds the plan of the Rollout, see Plan
+	PlanVariable = "plan"
+)
+
+// Policy is a RolloutPolicy or a ClusterRolloutPolicy
+type Policy struct {
+	Kind string
+	Name string
+	Spec v1alpha1.RolloutPolicySpec
+}
+
+// FromRolloutPolicies returns the policies of RolloutPolicies
+func FromRolloutPolicies(rps []*v1alpha1.RolloutPolicy) []Policy {
+	policies := make([]Policy, 0, len(rps))
+	for _, rp := range rps {
+		policies = append(policies, Policy{Kind: rollouts.RolloutPolicyKind, Name: rp.Name, Spec: rp.Spec})
+	}
+	return policies
+}
+
+// FromClusterRolloutPolicies returns the policies of ClusterRolloutPolicies
+func FromClusterRolloutPolicies(crps []*v1alpha1.ClusterRolloutPolicy) []Policy {
+	policies := make([]Policy, 0, len(crps))
+	for _, crp := range crps {
+		policies = append(policies, Policy{Kind: rollouts.ClusterRolloutPolicyKind, Name: crp.Name, Spec: crp.Spec})
+	}
+	return policies
+}
+
+func (p Policy) String() string {
+	return fmt.Sprintf("%s/%s", p.Kind, p.Name)
+}
+
+// Selects returns whether the policy applies to a Rollout
+func (p Policy) Selects(ro *v1alpha1.Rollout) (bool, error) {
+	if p.Spec.Selector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(p.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(ro.Labels)), nil
+}
+
+// Violation is a rule of a policy which a Rollout does not satisfy
+type Violation struct {
+	Policy            string
+	Rule              string
+	Message           string
+	EnforcementAction v1alpha1.PolicyEnforcementAction
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s rule '%s': %s", v.Policy, v.Rule, v.Message)
+}
+
+// Evaluate evaluates the rules of the policies which select a Rollout. The rules which cannot be evaluated, e.g.
+// because their expression does not compile, are violations as well, so that a broken policy is not silently ignored.
+func Evaluate(ro *v1alpha1.Rollout, policies []Policy) ([]Violation, error) {
+	var selected []Policy
+	for _, p := range policies {
+		selects, err := p.Selects(ro)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector of %s: %w", p, err)
+		}
+		if selects {
+			selected = append(selected, p)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, nil
+	}
+
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(ro)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := Plan(ro)
+	if err != nil {
+		return nil, err
+	}
+	variables := map[string]any{
+		RolloutVariable: obj,
+		PlanVariable:    plan,
+	}
+
+	var violations []Violation
+	for _, p := range selected {
+		for _, rule := range p.Spec.Rules {
+			satisfied, err := cel.EvaluateBool(rule.Expression, variables)
+			if err == nil && satisfied {
+				continue
+			}
+			message := rule.Message
+			if message == "" {
+				message = rule.Expression
+			}
+			if err != nil {
+				message = fmt.Sprintf("failed to evaluate '%s': %v", rule.Expression, err)
+			}
+			violations = append(violations, Violation{
+				Policy:            p.String(),
+				Rule:              rule.Name,
+				Message:           message,
+				EnforcementAction: enforcementAction(p.Spec, rule),
+			})
+		}
+	}
+	return violations, nil
+}
+
+// Denied returns the violations whose enforcement action is Deny
+func Denied(violations []Violation) []Violation {
+	var denied []Violation
+	for _, v := range violations {
+		if v.EnforcementAction == v1alpha1.PolicyEnforcementActionDeny {
+			denied = append(denied, v)
+		}
+	}
+	return denied
+}
+
+// enforcementAction returns the enforcement action of a rule, which defaults to the one of its policy, and then Deny
+func enforcementAction(spec v1alpha1.RolloutPolicySpec, rule v1alpha1.RolloutPolicyRule) v1alpha1.PolicyEnforcementAction {
+	if rule.EnforcementAction != "" {
+		return rule.EnforcementAction
+	}
+	if spec.EnforcementAction != "" {
+		return spec.EnforcementAction
+	}
+	return v1alpha1.PolicyEnforcementActionDeny
+}
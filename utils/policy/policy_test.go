@@ -0,0 +1,181 @@
+package policy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newCanaryRollout() *v1alpha1.Rollout {
+	maxSurge := intstr.FromInt(1)
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: "default",
+			Labels:    map[string]string{"env": "production"},
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: pointer.Int32(3),
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					MaxSurge: &maxSurge,
+					Analysis: &v1alpha1.RolloutAnalysisBackground{
+						RolloutAnalysis: v1alpha1.RolloutAnalysis{
+							Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "errors", ClusterScope: true}},
+						},
+					},
+					Steps: []v1alpha1.CanaryStep{
+						{SetWeight: pointer.Int32(20)},
+						{Pause: &v1alpha1.RolloutPause{}},
+						{Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "latency"}}}},
+						{SetWeight: pointer.Int32(60), Timeout: "1m"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestPlanCanary(t *testing.T) {
+	plan, err := Plan(newCanaryRollout())
+	require.NoError(t, err)
+	assert.Equal(t, "canary", plan["strategy"])
+	assert.Equal(t, int64(3), plan["replicas"])
+	assert.Equal(t, int64(1), plan["maxSurge"])
+	assert.Equal(t, int64(34), plan["maxSurgePercent"])
+	assert.Equal(t, int64(0), plan["maxUnavailable"])
+	assert.Equal(t, int64(25), plan["maxUnavailablePercent"])
+
+	steps := plan["steps"].([]any)
+	require.Len(t, steps, 4)
+	types := []string{"setWeight", "pause", "analysis", "setWeight"}
+	weights := []int64{20, 20, 20, 60}
+	for i, s := range steps {
+		step := s.(map[string]any)
+		assert.Equal(t, int64(i), step["index"])
+		assert.Equal(t, types[i], step["type"])
+		assert.Equal(t, weights[i], step["weight"])
+	}
+	assert.Equal(t, "1m", steps[3].(map[string]any)["timeout"])
+
+	assert.Equal(t, []any{
+		map[string]any{"templateName": "errors", "clusterScope": true, "source": AnalysisSourceBackground},
+		map[string]any{"templateName": "latency", "clusterScope": false, "source": AnalysisSourceStep},
+	}, plan["analysisTemplates"])
+}
+
+func TestPlanBlueGreen(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Replicas: pointer.Int32(4),
+			Strategy: v1alpha1.RolloutStrategy{
+				BlueGreen: &v1alpha1.BlueGreenStrategy{
+					PreviewReplicaCount: pointer.Int32(1),
+					PrePromotionAnalysis: &v1alpha1.RolloutAnalysis{
+						Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "smoke"}},
+					},
+				},
+			},
+		},
+	}
+	plan, err := Plan(ro)
+	require.NoError(t, err)
+	assert.Equal(t, "blueGreen", plan["strategy"])
+	assert.Equal(t, []any{}, plan["steps"])
+	assert.Equal(t, int64(1), plan["maxSurge"])
+	assert.Equal(t, int64(25), plan["maxSurgePercent"])
+	assert.Equal(t, []any{
+		map[string]any{"templateName": "smoke", "clusterScope": false, "source": AnalysisSourcePrePromotion},
+	}, plan["analysisTemplates"])
+}
+
+func TestEvaluate(t *testing.T) {
+	policies := []Policy{
+		{
+			Kind: "ClusterRolloutPolicy",
+			Name: "production",
+			Spec: v1alpha1.RolloutPolicySpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "production"}},
+				Rules: []v1alpha1.RolloutPolicyRule{
+					{
+						Name:       "analysis-before-50",
+						Expression: `plan.steps.exists(s, s.type == "analysis" && s.weight < 50)`,
+					},
+					{
+						Name:       "max-surge",
+						Expression: `plan.maxSurgePercent <= 25`,
+						Message:    "maxSurge must be at most 25%",
+					},
+					{
+						Name:              "allowed-templates",
+						Expression:        `plan.analysisTemplates.all(t, t.templateName in ["errors", "latency"])`,
+						EnforcementAction: v1alpha1.PolicyEnforcementActionWarn,
+					},
+				},
+			},
+		},
+		{
+			Kind: "RolloutPolicy",
+			Name: "staging",
+			Spec: v1alpha1.RolloutPolicySpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "staging"}},
+				Rules:    []v1alpha1.RolloutPolicyRule{{Name: "never", Expression: `false`}},
+			},
+		},
+		{
+			Kind: "RolloutPolicy",
+			Name: "naming",
+			Spec: v1alpha1.RolloutPolicySpec{
+				EnforcementAction: v1alpha1.PolicyEnforcementActionWarn,
+				Rules: []v1alpha1.RolloutPolicyRule{
+					{Name: "name", Expression: `rollout.metadata.name.startsWith("guest")`},
+					{Name: "broken", Expression: `rollout.spec.missing == 1`},
+				},
+			},
+		},
+	}
+
+	violations, err := Evaluate(newCanaryRollout(), policies)
+	require.NoError(t, err)
+	require.Len(t, violations, 2)
+	assert.Equal(t, Violation{
+		Policy:            "ClusterRolloutPolicy/production",
+		Rule:              "max-surge",
+		Message:           "maxSurge must be at most 25%",
+		EnforcementAction: v1alpha1.PolicyEnforcementActionDeny,
+	}, violations[0])
+	assert.Equal(t, "RolloutPolicy/naming", violations[1].Policy)
+	assert.Equal(t, "broken", violations[1].Rule)
+	assert.Contains(t, violations[1].Message, "failed to evaluate 'rollout.spec.missing == 1'")
+	assert.Equal(t, v1alpha1.PolicyEnforcementActionWarn, violations[1].EnforcementAction)
+	assert.Equal(t, violations[:1], Denied(violations))
+	assert.Equal(t, "ClusterRolloutPolicy/production rule 'max-surge': maxSurge must be at most 25%", violations[0].String())
+
+	ro := newCanaryRollout()
+	ro.Spec.Strategy.Canary.Analysis.Templates[0].TemplateName = "unknown"
+	ro.Spec.Strategy.Canary.MaxSurge = nil
+	violations, err = Evaluate(ro, policies[:1])
+	require.NoError(t, err)
+	require.Len(t, violations, 1)
+	assert.Equal(t, "allowed-templates", violations[0].Rule)
+	assert.Equal(t, `plan.analysisTemplates.all(t, t.templateName in ["errors", "latency"])`, violations[0].Message)
+	assert.Equal(t, v1alpha1.PolicyEnforcementActionWarn, violations[0].EnforcementAction)
+}
+
+func TestEvaluateInvalidSelector(t *testing.T) {
+	policies := []Policy{{
+		Kind: "RolloutPolicy",
+		Name: "invalid",
+		Spec: v1alpha1.RolloutPolicySpec{
+			Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Unknown"}}},
+		},
+	}}
+	_, err := Evaluate(newCanaryRollout(), policies)
+	assert.ErrorContains(t, err, "invalid selector of RolloutPolicy/invalid")
+}
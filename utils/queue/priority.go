@@ -0,0 +1,287 @@
+package queue
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Priority is the priority class of an item of a PriorityQueue. The items of a higher priority class are always
+// dequeued ahead of the items of a lower one.
+type Priority int
+
+const (
+	// PriorityLow is the priority class of the periodic resyncs of objects in a steady state
+	PriorityLow Priority = iota
+	// PriorityNormal is the default priority class
+	PriorityNormal
+	// PriorityHigh is the priority class of the objects which are actively being updated, or which have pending user
+	// actions
+	PriorityHigh
+)
+
+// Priorities are the priority classes, from the highest to the lowest
+var Priorities = []Priority{PriorityHigh, PriorityNormal, PriorityLow}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityHigh:
+		return "high"
+	case PriorityLow:
+		return "low"
+	default:
+		return "normal"
+	}
+}
+
+// PriorityFunc returns the priority class of an item when it is added to a PriorityQueue
+type PriorityFunc func(item any) Priority
+
+// PriorityMetricsProvider records the depth of the priority classes of a PriorityQueue, and how long their items
+// waited before being dequeued
+type PriorityMetricsProvider interface {
+	SetDepth(priority Priority, depth int)
+	ObserveLatency(priority Priority, latency time.Duration)
+}
+
+type noopPriorityMetrics struct{}
+
+func (noopPriorityMetrics) SetDepth(Priority, int)                 {}
+func (noopPriorityMetrics) ObserveLatency(Priority, time.Duration) {}
+
+// NewPriorityRateLimitingQueue returns a rate limited workqueue whose items are dequeued by priority class, and,
+// within a priority class, in a round-robin across the namespaces of their namespace/name keys. So the objects of a
+// namespace with many queued objects do not delay the objects of other namespaces.
+func NewPriorityRateLimitingQueue(rateLimiter workqueue.RateLimiter, name string, priorityFunc PriorityFunc, metrics PriorityMetricsProvider) workqueue.RateLimitingInterface {
+	delayingQueue := workqueue.NewDelayingQueueWithConfig(workqueue.DelayingQueueConfig{
+		Name:  name,
+		Queue: NewPriorityQueue(priorityFunc, metrics),
+	})
+	return workqueue.NewRateLimitingQueueWithConfig(rateLimiter, workqueue.RateLimitingQueueConfig{
+		Name:          name,
+		DelayingQueue: delayingQueue,
+	})
+}
+
+// priorityItem is an item waiting in a PriorityQueue
+type priorityItem struct {
+	priority Priority
+	addedAt  time.Time
+}
+
+// fairQueue is the queue of a priority class, which is a FIFO queue per namespace, dequeued in a round-robin across
+// the namespaces
+type fairQueue struct {
+	namespaces map[string][]any
+	// ring is the namespaces with queued items, in the order they are dequeued
+	ring []string
+	len  int
+}
+
+func newFairQueue() *fairQueue {
+	return &fairQueue{namespaces: map[string][]any{}}
+}
+
+func (q *fairQueue) push(namespace string, item any) {
+	if len(q.namespaces[namespace]) == 0 {
+		q.ring = append(q.ring, namespace)
+	}
+	q.namespaces[namespace] = append(q.namespaces[namespace], item)
+	q.len++
+}
+
+func (q *fairQueue) pop() any {
+	namespace := q.ring[0]
+	q.ring = q.ring[1:]
+	items := q.namespaces[namespace]
+	item := items[0]
+	if len(items) == 1 {
+		delete(q.namespaces, namespace)
+	} else {
+		q.namespaces[namespace] = items[1:]
+		q.ring = append(q.ring, namespace)
+	}
+	q.len--
+	return item
+}
+
+func (q *fairQueue) remove(namespace string, item any) {
+	items := q.namespaces[namespace]
+	for i := range items {
+		if items[i] != item {
+			continue
+		}
+		if len(items) == 1 {
+			delete(q.namespaces, namespace)
+			for j := range q.ring {
+				if q.ring[j] == namespace {
+					q.ring = append(q.ring[:j], q.ring[j+1:]...)
+					break
+				}
+			}
+		} else {
+			q.namespaces[namespace] = append(items[:i:i], items[i+1:]...)
+		}
+		q.len--
+		return
+	}
+}
+
+// PriorityQueue is a workqueue.Interface with the same guarantees as the workqueue of client-go: an item is queued
+// at most once, and is never processed concurrently. An item added again while it is queued keeps its place, unless
+// its priority class is raised.
+type PriorityQueue struct {
+	priorityFunc PriorityFunc
+	metrics      PriorityMetricsProvider
+
+	cond    *sync.Cond
+	classes map[Priority]*fairQueue
+	// queued is the items waiting in the classes
+	queued map[any]priorityItem
+	// dirty is the items added while being processed, which are queued again when they are done
+	dirty        map[any]Priority
+	processing   map[any]struct{}
+	shuttingDown bool
+	drain        bool
+}
+
+// NewPriorityQueue returns a PriorityQueue classifying its items with priorityFunc
+func NewPriorityQueue(priorityFunc PriorityFunc, metrics PriorityMetricsProvider) *PriorityQueue {
+	if metrics == nil {
+		metrics = noopPriorityMetrics{}
+	}
+	q := &PriorityQueue{
+		priorityFunc: priorityFunc,
+		metrics:      metrics,
+		cond:         sync.NewCond(&sync.Mutex{}),
+		classes:      map[Priority]*fairQueue{},
+		queued:       map[any]priorityItem{},
+		dirty:        map[any]Priority{},
+		processing:   map[any]struct{}{},
+	}
+	for _, priority := range Priorities {
+		q.classes[priority] = newFairQueue()
+	}
+	return q
+}
+
+func itemNamespace(item any) string {
+	if key, ok := item.(string); ok {
+		namespace, _, _ := cache.SplitMetaNamespaceKey(key)
+		return namespace
+	}
+	return ""
+}
+
+// Add queues an item, or raises the priority class of an already queued item
+func (q *PriorityQueue) Add(item any) {
+	// the priority function reads from informers, so it is called without holding the lock
+	priority := q.priorityFunc(item)
+
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	if _, ok := q.processing[item]; ok {
+		if dirty, ok := q.dirty[item]; !ok || priority > dirty {
+			q.dirty[item] = priority
+		}
+		return
+	}
+	if queued, ok := q.queued[item]; ok {
+		if priority <= queued.priority {
+			return
+		}
+		q.classes[queued.priority].remove(itemNamespace(item), item)
+		q.metrics.SetDepth(queued.priority, q.classes[queued.priority].len)
+		q.push(item, priority, queued.addedAt)
+		return
+	}
+	q.push(item, priority, time.Now())
+	q.cond.Signal()
+}
+
+func (q *PriorityQueue) push(item any, priority Priority, addedAt time.Time) {
+	q.queued[item] = priorityItem{priority: priority, addedAt: addedAt}
+	q.classes[priority].push(itemNamespace(item), item)
+	q.metrics.SetDepth(priority, q.classes[priority].len)
+}
+
+// Len returns the number of queued items
+func (q *PriorityQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return len(q.queued)
+}
+
+// Get blocks until an item can be processed, and returns the item of the highest priority class. The item must be
+// marked as done once it is processed.
+func (q *PriorityQueue) Get() (any, bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for len(q.queued) == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	if len(q.queued) == 0 {
+		return nil, true
+	}
+	for _, priority := range Priorities {
+		class := q.classes[priority]
+		if class.len == 0 {
+			continue
+		}
+		item := class.pop()
+		queued := q.queued[item]
+		delete(q.queued, item)
+		q.processing[item] = struct{}{}
+		q.metrics.SetDepth(priority, class.len)
+		q.metrics.ObserveLatency(priority, time.Since(queued.addedAt))
+		return item, false
+	}
+	return nil, true
+}
+
+// Done marks an item as processed, and queues it again if it was added while being processed
+func (q *PriorityQueue) Done(item any) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	delete(q.processing, item)
+	if priority, ok := q.dirty[item]; ok {
+		delete(q.dirty, item)
+		q.push(item, priority, time.Now())
+		q.cond.Signal()
+	} else if len(q.processing) == 0 {
+		q.cond.Signal()
+	}
+}
+
+// ShutDown stops the queue from accepting items, and unblocks the workers once the queued items are dequeued
+func (q *PriorityQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = false
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain shuts the queue down, and waits until the items being processed are done
+func (q *PriorityQueue) ShutDownWithDrain() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = true
+	q.shuttingDown = true
+	q.cond.Broadcast()
+	for len(q.processing) != 0 && q.drain {
+		q.cond.Wait()
+	}
+}
+
+// ShuttingDown returns whether the queue is shutting down
+func (q *PriorityQueue) ShuttingDown() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.shuttingDown
+}
@@ -0,0 +1,196 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type fakePriorityMetrics struct {
+	mu        sync.Mutex
+	depth     map[Priority]int
+	latencies map[Priority]int
+}
+
+func newFakePriorityMetrics() *fakePriorityMetrics {
+	return &fakePriorityMetrics{depth: map[Priority]int{}, latencies: map[Priority]int{}}
+}
+
+func (m *fakePriorityMetrics) SetDepth(priority Priority, depth int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.depth[priority] = depth
+}
+
+func (m *fakePriorityMetrics) ObserveLatency(priority Priority, _ time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.latencies[priority]++
+}
+
+func newTestPriorityQueue(priorities map[string]Priority, metrics PriorityMetricsProvider) *PriorityQueue {
+	var mu sync.Mutex
+	return NewPriorityQueue(func(item any) Priority {
+		mu.Lock()
+		defer mu.Unlock()
+		if priority, ok := priorities[item.(string)]; ok {
+			return priority
+		}
+		return PriorityNormal
+	}, metrics)
+}
+
+func getAll(t *testing.T, q workqueue.Interface) []string {
+	var items []string
+	for q.Len() > 0 {
+		item, shutdown := q.Get()
+		assert.False(t, shutdown)
+		items = append(items, item.(string))
+		q.Done(item)
+	}
+	return items
+}
+
+func TestPriorityQueueOrdersByPriority(t *testing.T) {
+	metrics := newFakePriorityMetrics()
+	q := newTestPriorityQueue(map[string]Priority{
+		"default/idle":   PriorityLow,
+		"default/canary": PriorityHigh,
+	}, metrics)
+	q.Add("default/idle")
+	q.Add("default/degraded")
+	q.Add("default/canary")
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, 1, metrics.depth[PriorityHigh])
+
+	assert.Equal(t, []string{"default/canary", "default/degraded", "default/idle"}, getAll(t, q))
+	assert.Equal(t, 0, metrics.depth[PriorityHigh])
+	assert.Equal(t, 0, metrics.depth[PriorityLow])
+	assert.Equal(t, 1, metrics.latencies[PriorityHigh])
+	assert.Equal(t, 1, metrics.latencies[PriorityNormal])
+	assert.Equal(t, 1, metrics.latencies[PriorityLow])
+}
+
+func TestPriorityQueueNamespaceFairness(t *testing.T) {
+	q := newTestPriorityQueue(nil, nil)
+	for _, key := range []string{"busy/a", "busy/b", "busy/c", "quiet/a", "other/a", "quiet/b"} {
+		q.Add(key)
+	}
+	assert.Equal(t, []string{"busy/a", "quiet/a", "other/a", "busy/b", "quiet/b", "busy/c"}, getAll(t, q))
+}
+
+func TestPriorityQueueDeduplicates(t *testing.T) {
+	priorities := map[string]Priority{"default/a": PriorityLow}
+	q := newTestPriorityQueue(priorities, nil)
+	q.Add("default/a")
+	q.Add("default/b")
+	q.Add("default/a")
+	assert.Equal(t, 2, q.Len())
+
+	// raising the priority class of a queued item moves it ahead
+	priorities["default/a"] = PriorityHigh
+	q.Add("default/a")
+	assert.Equal(t, 2, q.Len())
+	// lowering it again keeps its place
+	priorities["default/a"] = PriorityLow
+	q.Add("default/a")
+	assert.Equal(t, []string{"default/a", "default/b"}, getAll(t, q))
+}
+
+func TestPriorityQueueRequeuesDirtyItems(t *testing.T) {
+	priorities := map[string]Priority{"default/a": PriorityLow}
+	q := newTestPriorityQueue(priorities, nil)
+	q.Add("default/a")
+	item, _ := q.Get()
+
+	// an item added while it is processed is not handed to another worker
+	q.Add("default/a")
+	assert.Equal(t, 0, q.Len())
+	priorities["default/a"] = PriorityHigh
+	q.Add("default/a")
+	q.Add("default/b")
+	assert.Equal(t, 1, q.Len())
+
+	q.Done(item)
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, []string{"default/a", "default/b"}, getAll(t, q))
+}
+
+func TestPriorityQueueShutDown(t *testing.T) {
+	q := newTestPriorityQueue(nil, nil)
+	q.Add("default/a")
+	q.ShutDown()
+	assert.True(t, q.ShuttingDown())
+	q.Add("default/b")
+	assert.Equal(t, 1, q.Len())
+
+	item, shutdown := q.Get()
+	assert.Equal(t, "default/a", item)
+	assert.False(t, shutdown)
+	q.Done(item)
+	_, shutdown = q.Get()
+	assert.True(t, shutdown)
+}
+
+func TestPriorityQueueShutDownWithDrain(t *testing.T) {
+	q := newTestPriorityQueue(nil, nil)
+	q.Add("default/a")
+	item, _ := q.Get()
+
+	drained := make(chan struct{})
+	go func() {
+		q.ShutDownWithDrain()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		t.Fatal("the queue was drained while an item was processed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	q.Done(item)
+	select {
+	case <-drained:
+	case <-time.After(time.Second):
+		t.Fatal("the queue was not drained")
+	}
+}
+
+func TestPriorityQueueBlocksUntilAdd(t *testing.T) {
+	q := newTestPriorityQueue(nil, nil)
+	items := make(chan any)
+	go func() {
+		item, _ := q.Get()
+		items <- item
+	}()
+	q.Add("default/a")
+	select {
+	case item := <-items:
+		assert.Equal(t, "default/a", item)
+	case <-time.After(time.Second):
+		t.Fatal("Get did not return the added item")
+	}
+}
+
+func TestNewPriorityRateLimitingQueue(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "", func(item any) Priority {
+		if item == "default/canary" {
+			return PriorityHigh
+		}
+		return PriorityLow
+	}, nil)
+	defer q.ShutDown()
+	q.Add("default/idle")
+	q.AddRateLimited("default/canary")
+	assert.Eventually(t, func() bool { return q.Len() == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, 1, q.NumRequeues("default/canary"))
+	assert.Equal(t, []string{"default/canary", "default/idle"}, getAll(t, q))
+}
+
+func TestPriorityString(t *testing.T) {
+	assert.Equal(t, "high", PriorityHigh.String())
+	assert.Equal(t, "normal", PriorityNormal.String())
+	assert.Equal(t, "low", PriorityLow.String())
+}
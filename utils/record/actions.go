@@ -0,0 +1,95 @@
+package record
+
+import (
+	"strings"
+
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+// Actions of the events emitted by the controller. The action of an event is what the controller did, or failed to
+// do, regarding the object of the event, while the reason of the event is why it did so. Unlike reasons, which are
+// also used as notification triggers, actions are only recorded by the events.k8s.io/v1 API.
+const (
+	// ActionReconcile is the action of events which are not about a specific action of the controller
+	ActionReconcile = "Reconcile"
+	// ActionCreateReplicaSet is the action of events about the creation of a ReplicaSet
+	ActionCreateReplicaSet = "CreateReplicaSet"
+	// ActionScaleReplicaSet is the action of events about the scaling of a ReplicaSet
+	ActionScaleReplicaSet = "ScaleReplicaSet"
+	// ActionUpdateWorkload is the action of events about the update of the pods of a StatefulSet or DaemonSet, or of
+	// the pods of an aborted ReplicaSet
+	ActionUpdateWorkload = "UpdateWorkload"
+	// ActionUpdateTrafficRouting is the action of events about the update of the traffic router resources, e.g. the
+	// weights of a VirtualService or the annotations of an Ingress
+	ActionUpdateTrafficRouting = "UpdateTrafficRouting"
+	// ActionVerifyTrafficRouting is the action of events about the verification of the traffic routed by the load
+	// balancers
+	ActionVerifyTrafficRouting = "VerifyTrafficRouting"
+	// ActionSwitchService is the action of events about the switch of the selector of the active or preview service
+	ActionSwitchService = "SwitchService"
+	// ActionRunAnalysis is the action of events about the AnalysisRuns of a Rollout or Experiment, and the
+	// measurements of an AnalysisRun
+	ActionRunAnalysis = "RunAnalysis"
+	// ActionRunExperiment is the action of events about the Experiments of a Rollout
+	ActionRunExperiment = "RunExperiment"
+	// ActionRunHook is the action of events about the hook Jobs of a Rollout
+	ActionRunHook = "RunHook"
+	// ActionProgress is the action of events about the progress of the steps of a Rollout
+	ActionProgress = "Progress"
+	// ActionRetry is the action of events about the automatic retries of an aborted Rollout
+	ActionRetry = "Retry"
+)
+
+// reasonActions are the actions of the reasons of the events, which are emitted with the action of their reason
+// unless an action is set in their EventOptions
+var reasonActions = map[string]string{
+	conditions.NewReplicaSetReason:              ActionCreateReplicaSet,
+	conditions.FailedRSCreateReason:             ActionCreateReplicaSet,
+	conditions.ScalingReplicaSetReason:          ActionScaleReplicaSet,
+	conditions.TrafficWeightUpdatedReason:       ActionUpdateTrafficRouting,
+	"TrafficRoutingError":                       ActionUpdateTrafficRouting,
+	"Updated VirtualService":                    ActionUpdateTrafficRouting,
+	"UpdatedDestinationRule":                    ActionUpdateTrafficRouting,
+	"PatchingALBIngress":                        ActionUpdateTrafficRouting,
+	"CreatingCanaryIngress":                     ActionUpdateTrafficRouting,
+	"PatchingCanaryIngress":                     ActionUpdateTrafficRouting,
+	"TrafficSplitCreated":                       ActionUpdateTrafficRouting,
+	"TrafficSplitNotCreated":                    ActionUpdateTrafficRouting,
+	conditions.WeightVerifyErrorReason:          ActionVerifyTrafficRouting,
+	conditions.TargetGroupVerifiedReason:        ActionVerifyTrafficRouting,
+	conditions.TargetGroupUnverifiedReason:      ActionVerifyTrafficRouting,
+	conditions.TargetGroupVerifyErrorReason:     ActionVerifyTrafficRouting,
+	conditions.LoadBalancerNotFoundReason:       ActionVerifyTrafficRouting,
+	"SwitchService":                             ActionSwitchService,
+	"ExperimentCreated":                         ActionRunExperiment,
+	conditions.RolloutExperimentFailedReason:    ActionRunExperiment,
+	conditions.RolloutUpdatedReason:             ActionProgress,
+	conditions.RolloutStepCompletedReason:       ActionProgress,
+	conditions.RolloutPausedReason:              ActionProgress,
+	conditions.RolloutResumedReason:             ActionProgress,
+	conditions.RolloutAbortedReason:             ActionProgress,
+	conditions.RolloutCompletedReason:           ActionProgress,
+	conditions.RolloutNotCompletedReason:        ActionProgress,
+	conditions.RolloutHealthyReason:             ActionProgress,
+	conditions.StepTimedOutReason:               ActionProgress,
+	conditions.TimedOutReason:                   ActionProgress,
+	conditions.StepPluginTransitionReason:       ActionProgress,
+	"SkipSteps":                                 ActionProgress,
+	conditions.RolloutRetryReason:               ActionRetry,
+	conditions.RolloutReconciliationErrorReason: ActionReconcile,
+}
+
+// eventAction returns the action of an event, which is the action of its options, or else the action of its reason
+func eventAction(opts EventOptions) string {
+	if opts.EventAction != "" {
+		return opts.EventAction
+	}
+	if action, ok := reasonActions[opts.EventReason]; ok {
+		return action
+	}
+	// the reasons of the events about AnalysisRuns are the phases of the AnalysisRuns, e.g. AnalysisRunFailed
+	if strings.HasPrefix(opts.EventReason, "AnalysisRun") {
+		return ActionRunAnalysis
+	}
+	return ActionReconcile
+}
@@ -0,0 +1,28 @@
+package record
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+func TestEventAction(t *testing.T) {
+	tests := []struct {
+		opts   EventOptions
+		action string
+	}{
+		{EventOptions{EventReason: conditions.ScalingReplicaSetReason}, ActionScaleReplicaSet},
+		{EventOptions{EventReason: conditions.TrafficWeightUpdatedReason}, ActionUpdateTrafficRouting},
+		{EventOptions{EventReason: "Updated VirtualService"}, ActionUpdateTrafficRouting},
+		{EventOptions{EventReason: "AnalysisRunFailed"}, ActionRunAnalysis},
+		{EventOptions{EventReason: "AnalysisRunTerminating"}, ActionRunAnalysis},
+		{EventOptions{EventReason: conditions.RolloutPausedReason}, ActionProgress},
+		{EventOptions{EventReason: "FooReason"}, ActionReconcile},
+		{EventOptions{EventReason: conditions.ScalingReplicaSetReason, EventAction: ActionUpdateWorkload}, ActionUpdateWorkload},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.action, eventAction(test.opts), test.opts.EventReason)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
@@ -28,6 +29,7 @@ import (
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/events"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/kubectl/pkg/scheme"
 
@@ -60,6 +62,12 @@ type EventOptions struct {
 	// capital letter). "reason" will be used to automate handling of events, so imagine people
 	// writing switch statements to handle them.
 	EventReason string
+	// EventAction is what action was taken, or failed to be taken, regarding the object of the event. Defaults to
+	// the action of EventReason, or to ActionReconcile
+	EventAction string
+	// RelatedObject is an optional secondary object of the event, e.g. the ReplicaSet scaled by a Rollout, or the
+	// AnalysisRun whose result is reported
+	RelatedObject runtime.Object
 }
 
 type EventRecorder interface {
@@ -70,8 +78,11 @@ type EventRecorder interface {
 
 // EventRecorderAdapter implements the EventRecorder interface
 type EventRecorderAdapter struct {
-	// Recorder is a K8s EventRecorder
+	// Recorder is a K8s EventRecorder of core/v1 Events
 	Recorder record.EventRecorder
+	// EventsRecorder is a K8s EventRecorder of events.k8s.io/v1 Events, which records the related objects and the
+	// actions of the events, and aggregates repeated events into series
+	EventsRecorder events.EventRecorder
 	// RolloutEventCounter is a counter to increment on events
 	RolloutEventCounter *prometheus.CounterVec
 	// NotificationFailCounter is a counter to increment on failing to send notifications
@@ -95,6 +106,7 @@ func NewEventRecorder(kubeclientset kubernetes.Interface, rolloutEventCounter *p
 	k8srecorder := eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: controllerAgentName})
 	recorder := &EventRecorderAdapter{
 		Recorder:                    k8srecorder,
+		EventsRecorder:              newEventsRecorder(kubeclientset, k8srecorder),
 		RolloutEventCounter:         rolloutEventCounter,
 		NotificationFailedCounter:   notificationFailedCounter,
 		NotificationSuccessCounter:  notificationSuccessCounter,
@@ -105,6 +117,21 @@ func NewEventRecorder(kubeclientset kubernetes.Interface, rolloutEventCounter *p
 	return recorder
 }
 
+// newEventsRecorder returns a recorder of events.k8s.io/v1 Events, or a recorder which emits core/v1 Events through
+// the legacy recorder when the API server does not serve the events.k8s.io/v1 API
+func newEventsRecorder(kubeclientset kubernetes.Interface, legacyRecorder record.EventRecorderLogger) events.EventRecorder {
+	if _, err := kubeclientset.Discovery().ServerResourcesForGroupVersion(eventsv1.SchemeGroupVersion.String()); err != nil {
+		log.Warnf("Failed to discover the %s API, emitting core/v1 events: %v", eventsv1.SchemeGroupVersion, err)
+		return record.NewEventRecorderAdapter(legacyRecorder)
+	}
+	eventBroadcaster := events.NewBroadcaster(&events.EventSinkImpl{Interface: kubeclientset.EventsV1()})
+	if err := eventBroadcaster.StartRecordingToSinkWithContext(context.Background()); err != nil {
+		log.Warnf("Failed to start recording %s events, emitting core/v1 events: %v", eventsv1.SchemeGroupVersion, err)
+		return record.NewEventRecorderAdapter(legacyRecorder)
+	}
+	return eventBroadcaster.NewRecorder(scheme.Scheme, controllerAgentName)
+}
+
 // FakeEventRecorder wraps EventRecorderAdapter but with a convenience function to get all the event
 // reasons which were emitted
 type FakeEventRecorder struct {
@@ -199,6 +226,7 @@ func NewFakeEventRecorder() *FakeEventRecorder {
 		NewFakeApiFactory(),
 	).(*EventRecorderAdapter)
 	recorder.Recorder = record.NewFakeRecorder(1000)
+	recorder.EventsRecorder = events.NewFakeRecorder(1000)
 	fakeRecorder := &FakeEventRecorder{}
 	recorder.eventf = func(object runtime.Object, warn bool, opts EventOptions, messageFmt string, args ...any) {
 		recorder.defaultEventf(object, warn, opts, messageFmt, args...)
@@ -227,7 +255,7 @@ func (e *EventRecorderAdapter) defaultEventf(object runtime.Object, warn bool, o
 
 	if opts.EventReason != "" {
 		logCtx = logCtx.WithField("event_reason", opts.EventReason)
-		e.Recorder.Eventf(object, opts.EventType, opts.EventReason, messageFmt, args...)
+		e.EventsRecorder.Eventf(object, opts.RelatedObject, opts.EventType, opts.EventReason, eventAction(opts), messageFmt, args...)
 
 		// Increment rollout_events_total counter
 		kind, namespace, name := logutil.KindNamespaceName(logCtx)
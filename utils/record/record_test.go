@@ -2,6 +2,7 @@ package record
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,12 +22,16 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
+	k8srecord "k8s.io/client-go/tools/record"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	argofake "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
@@ -81,6 +86,57 @@ func TestRecordLog(t *testing.T) {
 
 }
 
+func newTestEventRecorder(client *fake.Clientset) *EventRecorderAdapter {
+	labels := []string{"name", "namespace", "type", "reason"}
+	return NewEventRecorder(
+		client,
+		prometheus.NewCounterVec(prometheus.CounterOpts{Name: "rollout_events_total"}, labels),
+		prometheus.NewCounterVec(prometheus.CounterOpts{Name: "notification_send_error"}, labels),
+		prometheus.NewCounterVec(prometheus.CounterOpts{Name: "notification_send_success"}, labels),
+		prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "notification_send_performance"}, []string{"namespace", "name"}),
+		nil,
+	).(*EventRecorderAdapter)
+}
+
+func TestEventsRecorder(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{{
+		GroupVersion: eventsv1.SchemeGroupVersion.String(),
+		APIResources: []metav1.APIResource{{Name: "events", Namespaced: true, Kind: "Event"}},
+	}}
+	rec := newTestEventRecorder(client)
+
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default", UID: "ro-uid"}}
+	rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{Name: "guestbook-abc", Namespace: "default", UID: "rs-uid"}}
+	rec.Eventf(ro, EventOptions{EventReason: "ScalingReplicaSet", RelatedObject: rs}, "Scaled up ReplicaSet %s", rs.Name)
+
+	var event eventsv1.Event
+	assert.Eventually(t, func() bool {
+		list, err := client.EventsV1().Events("default").List(context.TODO(), metav1.ListOptions{})
+		if err != nil || len(list.Items) == 0 {
+			return false
+		}
+		event = list.Items[0]
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "Rollout", event.Regarding.Kind)
+	assert.Equal(t, "guestbook", event.Regarding.Name)
+	assert.Equal(t, "ReplicaSet", event.Related.Kind)
+	assert.Equal(t, "guestbook-abc", event.Related.Name)
+	assert.Equal(t, ActionScaleReplicaSet, event.Action)
+	assert.Equal(t, "ScalingReplicaSet", event.Reason)
+	assert.Equal(t, corev1.EventTypeNormal, event.Type)
+	assert.Equal(t, "Scaled up ReplicaSet guestbook-abc", event.Note)
+	assert.Equal(t, controllerAgentName, event.ReportingController)
+}
+
+func TestEventsRecorderFallback(t *testing.T) {
+	// the fake clientset does not serve the events.k8s.io/v1 API
+	rec := newTestEventRecorder(fake.NewSimpleClientset())
+	_, ok := rec.EventsRecorder.(*k8srecord.EventRecorderAdapter)
+	assert.True(t, ok)
+}
+
 func TestIncCounter(t *testing.T) {
 	r := v1alpha1.Rollout{
 		ObjectMeta: metav1.ObjectMeta{
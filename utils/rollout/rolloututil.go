@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strconv"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/argoproj/argo-rollouts/utils/weightutil"
 
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
@@ -95,10 +97,13 @@ func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutSta
 			return v1alpha1.RolloutPhaseDegraded, fmt.Sprintf("%s: %s", v1alpha1.InvalidSpec, cond.Message)
 		}
 		switch cond.Reason {
-		case conditions.RolloutAbortedReason, conditions.TimedOutReason:
+		case conditions.RolloutAbortedReason, conditions.StepTimedOutReason, conditions.TimedOutReason:
 			return v1alpha1.RolloutPhaseDegraded, fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
 		}
 	}
+	if hook := currentHookStatus(&ro, v1alpha1.RolloutHookTypePostPromotion); hook != nil && hook.Phase == v1alpha1.RolloutHookPhaseFailed {
+		return v1alpha1.RolloutPhaseDegraded, fmt.Sprintf("PostPromotionHookFailed: Job %s failed: %s", hook.JobName, hook.Message)
+	}
 	if ro.Spec.Paused {
 		return v1alpha1.RolloutPhasePaused, "manually paused"
 	}
@@ -108,6 +113,9 @@ func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutSta
 	if ro.Spec.RestartAt != nil && (ro.Status.RestartedAt == nil || !ro.Spec.RestartAt.Time.Equal(ro.Status.RestartedAt.Time)) {
 		return v1alpha1.RolloutPhaseProgressing, "rollout is restarting"
 	}
+	if hook := currentHookStatus(&ro, v1alpha1.RolloutHookTypePreRollout); hook != nil && hook.Phase == v1alpha1.RolloutHookPhaseRunning {
+		return v1alpha1.RolloutPhaseProgressing, "waiting for preRollout hook to complete"
+	}
 	if ro.Status.UpdatedReplicas < defaults.GetReplicasOrDefault(ro.Spec.Replicas) {
 		return v1alpha1.RolloutPhaseProgressing, "more replicas need to be updated"
 	}
@@ -141,9 +149,26 @@ func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutSta
 			return v1alpha1.RolloutPhaseProgressing, "waiting for all steps to complete"
 		}
 	}
+	if hook := currentHookStatus(&ro, v1alpha1.RolloutHookTypePostPromotion); hook != nil && hook.Phase == v1alpha1.RolloutHookPhaseRunning {
+		return v1alpha1.RolloutPhaseProgressing, "waiting for postPromotion hook to complete"
+	}
 	return v1alpha1.RolloutPhaseHealthy, ""
 }
 
+// currentHookStatus returns the status of the hook of the current revision. The postPromotion hook is only current once
+// the revision is stable.
+func currentHookStatus(ro *v1alpha1.Rollout, hookType v1alpha1.RolloutHookType) *v1alpha1.RolloutHookStatus {
+	if hookType == v1alpha1.RolloutHookTypePostPromotion && (ro.Status.StableRS == "" || !IsFullyPromoted(ro)) {
+		return nil
+	}
+	for i, hook := range ro.Status.Hooks {
+		if hook.Type == hookType && hook.PodTemplateHash == ro.Status.CurrentPodHash {
+			return &ro.Status.Hooks[i]
+		}
+	}
+	return nil
+}
+
 // waitingForBlueGreenPostPromotionAnalysis returns we are waiting for blue-green post promotion to complete
 func waitingForBlueGreenPostPromotionAnalysis(ro *v1alpha1.Rollout) bool {
 	if ro.Spec.Strategy.BlueGreen.PostPromotionAnalysis != nil {
@@ -184,6 +209,12 @@ func CanaryStepString(c v1alpha1.CanaryStep) string {
 	if c.Plugin != nil {
 		return fmt.Sprintf("plugin: %s", c.Plugin.Name)
 	}
+	if c.SetCanaryNodes != nil {
+		return fmt.Sprintf("setCanaryNodes: %s", metav1.FormatLabelSelector(&c.SetCanaryNodes.NodeSelector))
+	}
+	if c.WaitFor != nil {
+		return fmt.Sprintf("waitFor: %s/%s", c.WaitFor.Kind, c.WaitFor.Name)
+	}
 	return "invalid"
 }
 
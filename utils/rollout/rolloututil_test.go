@@ -347,6 +347,69 @@ func TestRolloutStatusHealthy(t *testing.T) {
 	}
 }
 
+func TestRolloutStatusHooks(t *testing.T) {
+	newHealthyRollout := func() *v1alpha1.Rollout {
+		ro := newBlueGreenRollout()
+		ro.Status.Replicas = 5
+		ro.Status.UpdatedReplicas = 5
+		ro.Status.AvailableReplicas = 5
+		ro.Status.ReadyReplicas = 5
+		ro.Status.BlueGreen.ActiveSelector = "abc1234"
+		ro.Status.CurrentPodHash = "abc1234"
+		ro.Status.StableRS = "abc1234"
+		return ro
+	}
+	{
+		ro := newHealthyRollout()
+		ro.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+			Type:            v1alpha1.RolloutHookTypePostPromotion,
+			PodTemplateHash: "abc1234",
+			JobName:         "bg-guestbook-abc1234-post-promotion",
+			Phase:           v1alpha1.RolloutHookPhaseRunning,
+		}}
+		status, message := GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
+		assert.Equal(t, "waiting for postPromotion hook to complete", message)
+	}
+	{
+		ro := newHealthyRollout()
+		ro.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+			Type:            v1alpha1.RolloutHookTypePostPromotion,
+			PodTemplateHash: "abc1234",
+			JobName:         "bg-guestbook-abc1234-post-promotion",
+			Phase:           v1alpha1.RolloutHookPhaseFailed,
+			Message:         "BackoffLimitExceeded",
+		}}
+		status, message := GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseDegraded, status)
+		assert.Equal(t, "PostPromotionHookFailed: Job bg-guestbook-abc1234-post-promotion failed: BackoffLimitExceeded", message)
+	}
+	{
+		// the hooks of a previous revision are ignored
+		ro := newHealthyRollout()
+		ro.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+			Type:            v1alpha1.RolloutHookTypePostPromotion,
+			PodTemplateHash: "def5678",
+			Phase:           v1alpha1.RolloutHookPhaseFailed,
+		}}
+		status, message := GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseHealthy, status)
+		assert.Equal(t, "", message)
+	}
+	{
+		ro := newHealthyRollout()
+		ro.Status.CurrentPodHash = "def5678"
+		ro.Status.Hooks = []v1alpha1.RolloutHookStatus{{
+			Type:            v1alpha1.RolloutHookTypePreRollout,
+			PodTemplateHash: "def5678",
+			Phase:           v1alpha1.RolloutHookPhaseRunning,
+		}}
+		status, message := GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
+		assert.Equal(t, "waiting for preRollout hook to complete", message)
+	}
+}
+
 func TestCanaryStepString(t *testing.T) {
 	ten := intstr.FromInt(10)
 	tenS := intstr.FromString("10s")
@@ -394,6 +457,14 @@ func TestCanaryStepString(t *testing.T) {
 			step:           v1alpha1.CanaryStep{Plugin: &v1alpha1.PluginStep{Name: "foo"}},
 			expectedString: "plugin: foo",
 		},
+		{
+			step:           v1alpha1.CanaryStep{SetCanaryNodes: &v1alpha1.SetCanaryNodes{NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "canary"}}}},
+			expectedString: "setCanaryNodes: pool=canary",
+		},
+		{
+			step:           v1alpha1.CanaryStep{WaitFor: &v1alpha1.WaitForStep{APIVersion: "cert-manager.io/v1", Kind: "Certificate", Name: "guestbook-tls"}},
+			expectedString: "waitFor: Certificate/guestbook-tls",
+		},
 	}
 	for _, test := range tests {
 		assert.Equal(t, test.expectedString, CanaryStepString(test.step))
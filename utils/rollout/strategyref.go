@@ -0,0 +1,206 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+)
+
+// StrategySnapshotOfLabelKey is the label of the ControllerRevisions holding the strategies of the templates pinned by
+// a Rollout, set to the name of the Rollout
+const StrategySnapshotOfLabelKey = annotations.RolloutLabel + "/strategy-snapshot-of"
+
+// ComputeStrategyHash returns the hash of the strategy of a template, which names the ControllerRevision holding it
+func ComputeStrategyHash(strategy v1alpha1.RolloutStrategy) string {
+	return hash.ComputeConfigHash(strategy)
+}
+
+// StrategyRevisionName returns the name of the ControllerRevision holding a strategy pinned by a Rollout
+func StrategyRevisionName(rolloutName string, strategyHash string) string {
+	return rolloutName + "-strategy-" + strategyHash
+}
+
+// NewStrategyRevision returns the ControllerRevision holding the strategy of a template pinned by a Rollout. Its
+// revision is the generation of the template.
+func NewStrategyRevision(ro *v1alpha1.Rollout, status *v1alpha1.RolloutStrategyTemplateStatus, strategy v1alpha1.RolloutStrategy) (*appsv1.ControllerRevision, error) {
+	data, err := json.Marshal(strategy)
+	if err != nil {
+		return nil, err
+	}
+	revision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      StrategyRevisionName(ro.Name, status.StrategyHash),
+			Namespace: ro.Namespace,
+			Labels:    map[string]string{StrategySnapshotOfLabelKey: ro.Name},
+		},
+		Revision: status.Generation,
+	}
+	revision.Data.Raw = data
+	return revision, nil
+}
+
+// GetStrategyFromRevision returns the strategy held by a ControllerRevision
+func GetStrategyFromRevision(revision *appsv1.ControllerRevision) (v1alpha1.RolloutStrategy, error) {
+	var strategy v1alpha1.RolloutStrategy
+	if err := json.Unmarshal(revision.Data.Raw, &strategy); err != nil {
+		return strategy, fmt.Errorf("invalid strategy in ControllerRevision %s: %w", revision.Name, err)
+	}
+	return strategy, nil
+}
+
+// SetStrategyFromRevisions sets the strategy of a Rollout with a StrategyRef to the strategy pinned for its current
+// revision, read from the given ControllerRevisions, with the overrides of the StrategyRef. It allows the clients
+// which read the Rollouts from the API, where their strategy is empty, to show and act on their steps. The Rollouts
+// without a StrategyRef, or whose strategy was not pinned by the controller yet, are left unchanged.
+func SetStrategyFromRevisions(ro *v1alpha1.Rollout, revisions []*appsv1.ControllerRevision) {
+	ref := ro.Spec.StrategyRef
+	if ref == nil || ro.Spec.StrategyResolvedFromRef || ro.Status.StrategyTemplate == nil {
+		return
+	}
+	name := StrategyRevisionName(ro.Name, ro.Status.StrategyTemplate.StrategyHash)
+	for _, revision := range revisions {
+		if revision == nil || revision.Name != name || revision.Namespace != ro.Namespace {
+			continue
+		}
+		strategy, err := GetStrategyFromRevision(revision)
+		if err != nil {
+			return
+		}
+		strategy, err = ApplyStrategyOverrides(strategy, *ref)
+		if err != nil {
+			return
+		}
+		ro.Spec.SetResolvedStrategy(strategy)
+		return
+	}
+}
+
+// GetStrategyRevision returns the ControllerRevision holding the strategy pinned for the current revision of a
+// Rollout with a StrategyRef, read from the cluster. It returns nil when there is no such strategy.
+func GetStrategyRevision(ctx context.Context, client kubernetes.Interface, ro *v1alpha1.Rollout) (*appsv1.ControllerRevision, error) {
+	if ro.Spec.StrategyRef == nil || ro.Status.StrategyTemplate == nil {
+		return nil, nil
+	}
+	name := StrategyRevisionName(ro.Name, ro.Status.StrategyTemplate.StrategyHash)
+	revision, err := client.AppsV1().ControllerRevisions(ro.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	return revision, err
+}
+
+// ApplyStrategyOverrides returns a copy of the strategy of a template with the overrides of a StrategyRef
+func ApplyStrategyOverrides(in v1alpha1.RolloutStrategy, ref v1alpha1.StrategyRef) (v1alpha1.RolloutStrategy, error) {
+	strategy := *in.DeepCopy()
+	if ref.CanaryService != "" || ref.StableService != "" || len(ref.Weights) > 0 {
+		if strategy.Canary == nil {
+			return strategy, fmt.Errorf("strategyRef %s overrides the canary strategy, but the template has no canary strategy", ref.Name)
+		}
+		if ref.CanaryService != "" {
+			strategy.Canary.CanaryService = ref.CanaryService
+		}
+		if ref.StableService != "" {
+			strategy.Canary.StableService = ref.StableService
+		}
+		if err := overrideWeights(strategy.Canary.Steps, ref); err != nil {
+			return strategy, err
+		}
+	}
+	if ref.ActiveService != "" || ref.PreviewService != "" {
+		if strategy.BlueGreen == nil {
+			return strategy, fmt.Errorf("strategyRef %s overrides the blue-green strategy, but the template has no blue-green strategy", ref.Name)
+		}
+		if ref.ActiveService != "" {
+			strategy.BlueGreen.ActiveService = ref.ActiveService
+		}
+		if ref.PreviewService != "" {
+			strategy.BlueGreen.PreviewService = ref.PreviewService
+		}
+	}
+	if len(ref.Args) > 0 {
+		visitStrategyAnalysisArgs(&strategy, func(args *[]v1alpha1.AnalysisRunArgument) {
+			*args = overrideArgs(*args, ref.Args)
+		})
+	}
+	return strategy, nil
+}
+
+// overrideWeights replaces the weights of the setWeight steps by the weights of a StrategyRef, in order
+func overrideWeights(steps []v1alpha1.CanaryStep, ref v1alpha1.StrategyRef) error {
+	if len(ref.Weights) == 0 {
+		return nil
+	}
+	var setWeightSteps []int
+	for i := range steps {
+		if steps[i].SetWeight != nil {
+			setWeightSteps = append(setWeightSteps, i)
+		}
+	}
+	if len(setWeightSteps) != len(ref.Weights) {
+		return fmt.Errorf("strategyRef %s has %d weights, but the template has %d setWeight steps", ref.Name, len(ref.Weights), len(setWeightSteps))
+	}
+	for i, stepIndex := range setWeightSteps {
+		weight := ref.Weights[i]
+		steps[stepIndex].SetWeight = &weight
+	}
+	return nil
+}
+
+// overrideArgs sets the values of the arguments of the same name, and adds the other arguments
+func overrideArgs(args []v1alpha1.AnalysisRunArgument, overrides []v1alpha1.AnalysisRunArgument) []v1alpha1.AnalysisRunArgument {
+	for _, override := range overrides {
+		found := false
+		for i := range args {
+			if args[i].Name == override.Name {
+				args[i] = *override.DeepCopy()
+				found = true
+			}
+		}
+		if !found {
+			args = append(args, *override.DeepCopy())
+		}
+	}
+	return args
+}
+
+// visitStrategyAnalysisArgs calls a function with the arguments of every analysis of a strategy
+func visitStrategyAnalysisArgs(strategy *v1alpha1.RolloutStrategy, visit func(args *[]v1alpha1.AnalysisRunArgument)) {
+	if canary := strategy.Canary; canary != nil {
+		if canary.Analysis != nil {
+			visit(&canary.Analysis.Args)
+		}
+		for i := range canary.Steps {
+			step := &canary.Steps[i]
+			if step.Analysis != nil {
+				visit(&step.Analysis.Args)
+			}
+			if step.Experiment != nil {
+				for j := range step.Experiment.Analyses {
+					visit(&step.Experiment.Analyses[j].Args)
+				}
+			}
+		}
+	}
+	if blueGreen := strategy.BlueGreen; blueGreen != nil {
+		if blueGreen.PrePromotionAnalysis != nil {
+			visit(&blueGreen.PrePromotionAnalysis.Args)
+		}
+		if blueGreen.PostPromotionAnalysis != nil {
+			visit(&blueGreen.PostPromotionAnalysis.Args)
+		}
+		for i := range blueGreen.TrafficSteps {
+			if blueGreen.TrafficSteps[i].Analysis != nil {
+				visit(&blueGreen.TrafficSteps[i].Analysis.Args)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newTemplateStrategy() v1alpha1.RolloutStrategy {
+	return v1alpha1.RolloutStrategy{
+		Canary: &v1alpha1.CanaryStrategy{
+			CanaryService: "template-canary",
+			StableService: "template-stable",
+			Analysis: &v1alpha1.RolloutAnalysisBackground{
+				RolloutAnalysis: v1alpha1.RolloutAnalysis{
+					Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "errors"}},
+					Args:      []v1alpha1.AnalysisRunArgument{{Name: "service", Value: "template"}},
+				},
+			},
+			Steps: []v1alpha1.CanaryStep{
+				{SetWeight: pointer.Int32(20)},
+				{Pause: &v1alpha1.RolloutPause{}},
+				{Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.AnalysisTemplateRef{{TemplateName: "latency"}}}},
+				{SetWeight: pointer.Int32(50)},
+				{Experiment: &v1alpha1.RolloutExperimentStep{
+					Analyses: []v1alpha1.RolloutExperimentStepAnalysisTemplateRef{{Name: "smoke", TemplateName: "smoke"}},
+				}},
+			},
+		},
+	}
+}
+
+func TestApplyStrategyOverrides(t *testing.T) {
+	template := newTemplateStrategy()
+	ref := v1alpha1.StrategyRef{
+		Name:          "canary",
+		Args:          []v1alpha1.AnalysisRunArgument{{Name: "service", Value: "guestbook"}, {Name: "threshold", Value: "0.1"}},
+		Weights:       []int32{10, 30},
+		CanaryService: "guestbook-canary",
+		StableService: "guestbook-stable",
+	}
+	strategy, err := ApplyStrategyOverrides(template, ref)
+	require.NoError(t, err)
+
+	canary := strategy.Canary
+	assert.Equal(t, "guestbook-canary", canary.CanaryService)
+	assert.Equal(t, "guestbook-stable", canary.StableService)
+	assert.Equal(t, int32(10), *canary.Steps[0].SetWeight)
+	assert.Equal(t, int32(30), *canary.Steps[3].SetWeight)
+	assert.Equal(t, ref.Args, canary.Analysis.Args)
+	assert.Equal(t, ref.Args, canary.Steps[2].Analysis.Args)
+	assert.Equal(t, ref.Args, canary.Steps[4].Experiment.Analyses[0].Args)
+
+	// the strategy of the template is not modified
+	assert.Equal(t, newTemplateStrategy(), template)
+}
+
+func TestApplyStrategyOverridesErrors(t *testing.T) {
+	_, err := ApplyStrategyOverrides(newTemplateStrategy(), v1alpha1.StrategyRef{Name: "canary", Weights: []int32{10}})
+	assert.EqualError(t, err, "strategyRef canary has 1 weights, but the template has 2 setWeight steps")
+
+	_, err = ApplyStrategyOverrides(newTemplateStrategy(), v1alpha1.StrategyRef{Name: "canary", ActiveService: "active"})
+	assert.EqualError(t, err, "strategyRef canary overrides the blue-green strategy, but the template has no blue-green strategy")
+
+	blueGreen := v1alpha1.RolloutStrategy{BlueGreen: &v1alpha1.BlueGreenStrategy{ActiveService: "template-active"}}
+	_, err = ApplyStrategyOverrides(blueGreen, v1alpha1.StrategyRef{Name: "blue-green", CanaryService: "canary"})
+	assert.EqualError(t, err, "strategyRef blue-green overrides the canary strategy, but the template has no canary strategy")
+
+	strategy, err := ApplyStrategyOverrides(blueGreen, v1alpha1.StrategyRef{Name: "blue-green", ActiveService: "active", PreviewService: "preview"})
+	require.NoError(t, err)
+	assert.Equal(t, "active", strategy.BlueGreen.ActiveService)
+	assert.Equal(t, "preview", strategy.BlueGreen.PreviewService)
+}
+
+func TestSetStrategyFromRevisions(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"},
+		Spec: v1alpha1.RolloutSpec{
+			StrategyRef: &v1alpha1.StrategyRef{Name: "canary", CanaryService: "guestbook-canary"},
+		},
+	}
+	status := &v1alpha1.RolloutStrategyTemplateStatus{Name: "canary", Generation: 3, StrategyHash: ComputeStrategyHash(newTemplateStrategy())}
+	revision, err := NewStrategyRevision(ro, status, newTemplateStrategy())
+	require.NoError(t, err)
+	assert.Equal(t, "guestbook-strategy-"+status.StrategyHash, revision.Name)
+	assert.Equal(t, int64(3), revision.Revision)
+	assert.Equal(t, "guestbook", revision.Labels[StrategySnapshotOfLabelKey])
+
+	SetStrategyFromRevisions(ro, []*appsv1.ControllerRevision{revision})
+	assert.Nil(t, ro.Spec.Strategy.Canary)
+
+	ro.Status.StrategyTemplate = status
+	SetStrategyFromRevisions(ro, nil)
+	assert.Nil(t, ro.Spec.Strategy.Canary)
+
+	SetStrategyFromRevisions(ro, []*appsv1.ControllerRevision{nil, revision})
+	assert.True(t, ro.Spec.StrategyResolvedFromRef)
+	assert.Equal(t, "guestbook-canary", ro.Spec.Strategy.Canary.CanaryService)
+	assert.Len(t, ro.Spec.Strategy.Canary.Steps, 5)
+}
@@ -0,0 +1,68 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantClusterRolloutPolicyInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.ClusterRolloutPolicyInformer {
+	return &tolerantClusterRolloutPolicyInformer{
+		delegate: factory.ForResource(v1alpha1.ClusterRolloutPolicyGVR),
+	}
+}
+
+type tolerantClusterRolloutPolicyInformer struct {
+	delegate informers.GenericInformer
+}
+
+func (i *tolerantClusterRolloutPolicyInformer) Informer() cache.SharedIndexInformer {
+	return i.delegate.Informer()
+}
+
+func (i *tolerantClusterRolloutPolicyInformer) Lister() rolloutlisters.ClusterRolloutPolicyLister {
+	return &tolerantClusterRolloutPolicyLister{
+		delegate: i.delegate.Lister(),
+	}
+}
+
+type tolerantClusterRolloutPolicyLister struct {
+	delegate cache.GenericLister
+}
+
+func (t *tolerantClusterRolloutPolicyLister) List(selector labels.Selector) ([]*v1alpha1.ClusterRolloutPolicy, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToClusterRolloutPolicies(objects)
+}
+
+func (t *tolerantClusterRolloutPolicyLister) Get(name string) (*v1alpha1.ClusterRolloutPolicy, error) {
+	object, err := t.delegate.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	v := &v1alpha1.ClusterRolloutPolicy{}
+	err = convertObject(object, v)
+	return v, err
+}
+
+func convertObjectsToClusterRolloutPolicies(objects []runtime.Object) ([]*v1alpha1.ClusterRolloutPolicy, error) {
+	var firstErr error
+	vs := make([]*v1alpha1.ClusterRolloutPolicy, len(objects))
+	for i, obj := range objects {
+		vs[i] = &v1alpha1.ClusterRolloutPolicy{}
+		err := convertObject(obj, vs[i])
+		if err != nil && firstErr != nil {
+			firstErr = err
+		}
+	}
+	return vs, firstErr
+}
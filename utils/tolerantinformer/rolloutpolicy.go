@@ -0,0 +1,86 @@
+package tolerantinformer
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	rolloutinformers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
+	rolloutlisters "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+)
+
+func NewTolerantRolloutPolicyInformer(factory dynamicinformer.DynamicSharedInformerFactory) rolloutinformers.RolloutPolicyInformer {
+	return &tolerantRolloutPolicyInformer{
+		delegate: factory.ForResource(v1alpha1.RolloutPolicyGVR),
+	}
+}
+
+type tolerantRolloutPolicyInformer struct {
+	delegate informers.GenericInformer
+}
+
+func (i *tolerantRolloutPolicyInformer) Informer() cache.SharedIndexInformer {
+	return i.delegate.Informer()
+}
+
+func (i *tolerantRolloutPolicyInformer) Lister() rolloutlisters.RolloutPolicyLister {
+	return &tolerantRolloutPolicyLister{
+		delegate: i.delegate.Lister(),
+	}
+}
+
+type tolerantRolloutPolicyLister struct {
+	delegate cache.GenericLister
+}
+
+func (t *tolerantRolloutPolicyLister) List(selector labels.Selector) ([]*v1alpha1.RolloutPolicy, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToRolloutPolicies(objects)
+}
+
+func (t *tolerantRolloutPolicyLister) RolloutPolicies(namespace string) rolloutlisters.RolloutPolicyNamespaceLister {
+	return &tolerantRolloutPolicyNamespaceLister{
+		delegate: t.delegate.ByNamespace(namespace),
+	}
+}
+
+type tolerantRolloutPolicyNamespaceLister struct {
+	delegate cache.GenericNamespaceLister
+}
+
+func (t *tolerantRolloutPolicyNamespaceLister) Get(name string) (*v1alpha1.RolloutPolicy, error) {
+	object, err := t.delegate.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	v := &v1alpha1.RolloutPolicy{}
+	err = convertObject(object, v)
+	return v, err
+}
+
+func (t *tolerantRolloutPolicyNamespaceLister) List(selector labels.Selector) ([]*v1alpha1.RolloutPolicy, error) {
+	objects, err := t.delegate.List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return convertObjectsToRolloutPolicies(objects)
+}
+
+func convertObjectsToRolloutPolicies(objects []runtime.Object) ([]*v1alpha1.RolloutPolicy, error) {
+	var firstErr error
+	vs := make([]*v1alpha1.RolloutPolicy, len(objects))
+	for i, obj := range objects {
+		vs[i] = &v1alpha1.RolloutPolicy{}
+		err := convertObject(obj, vs[i])
+		if err != nil && firstErr != nil {
+			firstErr = err
+		}
+	}
+	return vs, firstErr
+}
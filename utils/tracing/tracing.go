@@ -0,0 +1,138 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/argoproj/argo-rollouts/utils/version"
+)
+
+const (
+	// TracerName is the name of the tracer of the spans of the controller
+	TracerName = "github.com/argoproj/argo-rollouts"
+
+	// DefaultServiceName is the default service name of the spans of the controller
+	DefaultServiceName = "argo-rollouts"
+)
+
+// Options configures the export of the spans of the controller
+type Options struct {
+	// Address is the address of the OTLP gRPC collector. Tracing is disabled when empty
+	Address string
+	// Insecure disables the TLS of the connection to the collector
+	Insecure bool
+	// Headers are sent with every export request, e.g. for authentication
+	Headers map[string]string
+	// SampleRatio is the ratio of the reconciliations which are traced
+	SampleRatio float64
+	ServiceName string
+}
+
+// Init configures the global tracer provider to export the spans to an OTLP collector, and returns a function
+// flushing the pending spans on shutdown. Without an address, the spans are not recorded.
+func Init(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.Address == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if opts.ServiceName == "" {
+		opts.ServiceName = DefaultServiceName
+	}
+	exporterOpts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(opts.Address),
+		otlptracegrpc.WithHeaders(opts.Headers),
+	}
+	if opts.Insecure {
+		exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(opts.ServiceName),
+		semconv.ServiceVersion(version.GetVersion().Version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace resource: %w", err)
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	log.Infof("Exporting traces to %s", opts.Address)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the controller
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// StartSpan starts a span as a child of the span of the context
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the error of an operation, if any, and ends its span
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// transport is a http.RoundTripper recording a client span for each request made in the context of a span,
+// e.g. the requests made to the Kubernetes API while reconciling a Rollout
+type transport struct {
+	rt http.RoundTripper
+}
+
+// NewTransport wraps a http.RoundTripper to record the requests made in the context of a span. It is meant to be
+// set as the WrapTransport of a rest.Config.
+func NewTransport(rt http.RoundTripper) http.RoundTripper {
+	return &transport{rt: rt}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		// requests made outside of a reconciliation, e.g. by the informers, are not traced
+		return t.rt.RoundTrip(req)
+	}
+	ctx, span := Tracer().Start(ctx, req.Method, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(
+		semconv.HTTPRequestMethodKey.String(req.Method),
+		semconv.URLPath(req.URL.Path),
+		semconv.ServerAddress(req.URL.Hostname()),
+	))
+	defer span.End()
+	req = req.Clone(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	resp, err := t.rt.RoundTrip(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if resp.StatusCode >= http.StatusBadRequest {
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+
+	testutil "github.com/argoproj/argo-rollouts/test/util"
+)
+
+func TestInitWithoutAddress(t *testing.T) {
+	shutdown, err := Init(context.TODO(), Options{})
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.TODO()))
+}
+
+func TestStartAndEndSpan(t *testing.T) {
+	exporter := testutil.RecordSpans(t)
+
+	ctx, parent := StartSpan(context.TODO(), "parent")
+	_, child := StartSpan(ctx, "child")
+	EndSpan(child, errors.New("intentional error"))
+	EndSpan(parent, nil)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	assert.Equal(t, "intentional error", spans[0].Status.Description)
+	assert.Len(t, spans[0].Events, 1)
+	assert.Equal(t, codes.Unset, spans[1].Status.Code)
+}
+
+func TestTransport(t *testing.T) {
+	exporter := testutil.RecordSpans(t)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		if r.URL.Path == "/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client := &http.Client{Transport: NewTransport(http.DefaultTransport)}
+
+	// requests made outside of a span are not traced
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/apis", nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Empty(t, exporter.GetSpans())
+	assert.Empty(t, traceparent)
+
+	ctx, span := StartSpan(context.TODO(), "rollout.reconcile")
+	req, err = http.NewRequestWithContext(ctx, http.MethodPatch, server.URL+"/missing", nil)
+	require.NoError(t, err)
+	resp, err = client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	span.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "PATCH", spans[0].Name)
+	assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	assert.Equal(t, codes.Error, spans[0].Status.Code)
+	attrs := map[string]any{}
+	for _, attr := range spans[0].Attributes {
+		attrs[string(attr.Key)] = attr.Value.AsInterface()
+	}
+	assert.Equal(t, "/missing", attrs["url.path"])
+	assert.Equal(t, int64(http.StatusNotFound), attrs["http.response.status_code"])
+	assert.Contains(t, traceparent, spans[0].SpanContext.TraceID().String())
+}
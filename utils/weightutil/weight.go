@@ -9,5 +9,8 @@ func MaxTrafficWeight(ro *v1alpha1.Rollout) int32 {
 	if ro.Spec.Strategy.Canary != nil && ro.Spec.Strategy.Canary.TrafficRouting != nil && ro.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight != nil {
 		maxWeight = *ro.Spec.Strategy.Canary.TrafficRouting.MaxTrafficWeight
 	}
+	if ro.Spec.Strategy.BlueGreen != nil && ro.Spec.Strategy.BlueGreen.TrafficRouting != nil && ro.Spec.Strategy.BlueGreen.TrafficRouting.MaxTrafficWeight != nil {
+		maxWeight = *ro.Spec.Strategy.BlueGreen.TrafficRouting.MaxTrafficWeight
+	}
 	return maxWeight
 }